@@ -64,6 +64,8 @@ import (
 
 	"k8c.io/dashboard/v2/pkg/handler"
 	"k8c.io/dashboard/v2/pkg/handler/auth"
+	apimetrics "k8c.io/dashboard/v2/pkg/handler/metrics"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	v2 "k8c.io/dashboard/v2/pkg/handler/v2"
 	"k8c.io/dashboard/v2/pkg/provider"
@@ -72,6 +74,7 @@ import (
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
 	"k8c.io/dashboard/v2/pkg/serviceaccount"
 	kuberneteswatcher "k8c.io/dashboard/v2/pkg/watcher/kubernetes"
+	"k8c.io/dashboard/v2/pkg/webhook"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/cluster/client"
 	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
@@ -85,6 +88,7 @@ import (
 	osmv1alpha1 "k8c.io/operating-system-manager/pkg/crd/osm/v1alpha1"
 
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
@@ -150,6 +154,10 @@ func main() {
 		log.Fatalw("failed to register scheme", zap.Stringer("api", kubeovnv1.SchemeGroupVersion), zap.Error(err))
 	}
 
+	if err := apiextensionsv1.AddToScheme(scheme.Scheme); err != nil {
+		log.Fatalw("failed to register scheme", zap.Stringer("api", apiextensionsv1.SchemeGroupVersion), zap.Error(err))
+	}
+
 	masterCfg, err := ctrlruntime.GetConfig()
 	if err != nil {
 		log.Fatalw("unable to build client configuration from kubeconfig", zap.Error(err))
@@ -268,7 +276,11 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 	}
 
 	seedClientGetter := kubernetesprovider.SeedClientGetterFactory(seedKubeconfigGetter)
-	clusterProviderGetter := clusterProviderFactory(mgr.GetRESTMapper(), seedKubeconfigGetter, seedClientGetter, options)
+	var clusterListCache *kubernetesprovider.ClusterListCache
+	if options.featureGates.Enabled(clusterListCacheFeature) {
+		clusterListCache = kubernetesprovider.NewClusterListCache(options.clusterListCacheTTL)
+	}
+	clusterProviderGetter := clusterProviderFactory(mgr.GetRESTMapper(), seedKubeconfigGetter, seedClientGetter, clusterListCache, options)
 
 	presetProvider, err := kubernetesprovider.NewPresetProvider(client)
 	if err != nil {
@@ -431,6 +443,22 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 	policyTemplateProvider := policyTemplateProviderFactory(client)
 
 	policyBindingProvider := policyBindingProviderFactory(client)
+
+	privilegedWebhookProvider := kubernetesprovider.NewPrivilegedWebhookProvider(client)
+	webhookNotifier := webhook.NewNotifier(privilegedWebhookProvider, log)
+
+	clusterWatcher := kuberneteswatcher.NewClusterWatcher(log, seedsGetter, seedClientGetter, kuberneteswatcher.DefaultClusterResyncInterval,
+		kuberneteswatcher.NewInitialMachineDeploymentPromoter(log),
+		kuberneteswatcher.NewClusterHealthObserver(webhookNotifier),
+	)
+	// The watcher changes clusters, so it only runs in the API replica which holds the lease.
+	go func() {
+		if err := kuberneteswatcher.RunLeaderElected(ctx, log, kubeMasterClient, options.namespace, kuberneteswatcher.ClusterWatcherLeaseName, clusterWatcher.Run); err != nil {
+			log.Fatalw("failed to run the cluster watcher", zap.Error(err))
+		}
+	}()
+
+	priceCatalog := priceCatalogFactory()
 	return providers{
 		sshKey:                                         sshKeyProvider,
 		privilegedSSHKeyProvider:                       privilegedSSHKeyProvider,
@@ -476,6 +504,8 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 		backupStorageProvider:                          backupStorageProvider,
 		policyTemplateProvider:                         policyTemplateProvider,
 		policyBindingProvider:                          policyBindingProvider,
+		privilegedWebhookProvider:                      privilegedWebhookProvider,
+		webhookNotifier:                                webhookNotifier,
 		backupCredentialsProviderGetter:                backupCredentialsProviderGetter,
 		privilegedMLAAdminSettingProviderGetter:        privilegedMLAAdminSettingProviderGetter,
 		seedProvider:                                   seedProvider,
@@ -485,6 +515,8 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 		applicationDefinitionProvider:                  applicationDefinitionProvider,
 		privilegedOperatingSystemProfileProviderGetter: privilegedOperatingSystemProfileProviderGetter,
 		oidcIssuerVerifierProviderGetter:               oidcIssuerVerifierProviderGetter,
+		priceCatalog:                                   priceCatalog,
+		rateLimiter:                                    rateLimiterFactory(),
 	}, nil
 }
 
@@ -612,6 +644,10 @@ func createAPIHandler(
 		ApplicationDefinitionProvider:                  prov.applicationDefinitionProvider,
 		PrivilegedOperatingSystemProfileProviderGetter: prov.privilegedOperatingSystemProfileProviderGetter,
 		OIDCIssuerVerifierProviderGetter:               prov.oidcIssuerVerifierProviderGetter,
+		PrivilegedWebhookProvider:                      prov.privilegedWebhookProvider,
+		WebhookNotifier:                                prov.webhookNotifier,
+		PriceCatalog:                                   prov.priceCatalog,
+		RateLimiter:                                    prov.rateLimiter,
 		Versions:                                       options.versions,
 		CABundle:                                       options.caBundle.CertPool(),
 		Features:                                       options.featureGates,
@@ -637,12 +673,22 @@ func createAPIHandler(
 	}
 	v1Router := mainRouter.PathPrefix("/api/v1").Subrouter()
 	v2Router := mainRouter.PathPrefix("/api/v2").Subrouter()
+	// the rate limits are enforced once the user is authenticated, the buckets are kept per API replica
+	v1Router.Use(middleware.RateLimit(prov.rateLimiter, prov.settingsProvider))
+	v2Router.Use(middleware.RateLimit(prov.rateLimiter, prov.settingsProvider))
 	r.RegisterV1(v1Router, metrics)
 	r.RegisterV1Optional(v1Router, options.featureGates.Enabled(features.OIDCKubeCfgEndpoint))
 	r.RegisterV1Admin(v1Router)
 	r.RegisterV1Websocket(v1Router)
+	r.RegisterV2Websocket(v2Router)
 	rv2.RegisterV2(v2Router, options.featureGates.Enabled(features.OIDCKubeCfgEndpoint))
 
+	if options.metricsBearerToken != "" {
+		mainRouter.Methods(http.MethodGet).
+			Path("/metrics").
+			Handler(apimetrics.Handler(options.metricsBearerToken))
+	}
+
 	mainRouter.Methods(http.MethodGet).
 		Path("/api/swagger.json").
 		HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -702,7 +748,7 @@ func setSecureHeaders(next http.Handler) http.Handler {
 	})
 }
 
-func clusterProviderFactory(mapper meta.RESTMapper, seedKubeconfigGetter provider.SeedKubeconfigGetter, seedClientGetter provider.SeedClientGetter, options serverRunOptions) provider.ClusterProviderGetter {
+func clusterProviderFactory(mapper meta.RESTMapper, seedKubeconfigGetter provider.SeedKubeconfigGetter, seedClientGetter provider.SeedClientGetter, clusterListCache *kubernetesprovider.ClusterListCache, options serverRunOptions) provider.ClusterProviderGetter {
 	return func(seed *kubermaticv1.Seed) (provider.ClusterProvider, error) {
 		cfg, err := seedKubeconfigGetter(seed)
 		if err != nil {
@@ -724,7 +770,7 @@ func clusterProviderFactory(mapper meta.RESTMapper, seedKubeconfigGetter provide
 			return nil, fmt.Errorf("failed to get userClusterConnectionProvider: %w", err)
 		}
 
-		return kubernetesprovider.NewClusterProvider(
+		clusterProvider := kubernetesprovider.NewClusterProvider(
 			cfg,
 			defaultImpersonationClientForSeed.CreateImpersonatedClient,
 			userClusterConnectionProvider,
@@ -735,7 +781,12 @@ func clusterProviderFactory(mapper meta.RESTMapper, seedKubeconfigGetter provide
 			options.featureGates.Enabled(features.OIDCKubeCfgEndpoint),
 			options.versions,
 			seed,
-		), nil
+		)
+		if clusterListCache != nil {
+			clusterProvider = clusterProvider.WithListCache(clusterListCache)
+		}
+
+		return clusterProvider, nil
 	}
 }
 
@@ -21,15 +21,20 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/gorilla/securecookie"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v3"
 
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/provider"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
 	"k8c.io/dashboard/v2/pkg/serviceaccount"
 	"k8c.io/dashboard/v2/pkg/watcher"
+	"k8c.io/dashboard/v2/pkg/webhook"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/defaulting"
 	"k8c.io/kubermatic/v2/pkg/features"
@@ -72,14 +77,26 @@ type serverRunOptions struct {
 
 	featureGates features.FeatureGate
 	versions     kubermatic.Versions
+
+	// clusterListCacheTTL is the time for which the cluster lists are cached, if the clusterListCacheFeature is enabled
+	clusterListCacheTTL time.Duration
+
+	// metricsBearerToken protects the /metrics endpoint on the listen address, the endpoint is only exposed
+	// there if a token is configured
+	metricsBearerToken string
 }
 
+// clusterListCacheFeature if enabled caches the cluster lists of the projects per seed in memory to reduce the
+// load on the seed API servers.
+const clusterListCacheFeature = "ClusterListCache"
+
 func newServerRunOptions() (serverRunOptions, error) {
 	s := serverRunOptions{featureGates: features.FeatureGate{}}
 	var (
 		rawExposeStrategy string
 		caBundleFile      string
 		configFile        string
+		metricsTokenFile  string
 	)
 
 	s.log = kubermaticlog.NewDefaultOptions()
@@ -101,10 +118,12 @@ func newServerRunOptions() (serverRunOptions, error) {
 	flag.BoolVar(&s.oidcIssuerCookieSecureMode, "oidc-issuer-cookie-secure-mode", true, "When true cookie received only with HTTPS. Set false for local deployment with HTTP")
 	flag.BoolVar(&s.oidcIssuerOfflineAccessAsScope, "oidc-issuer-offline-access-as-scope", true, "Set it to false if OIDC provider requires to set \"access_type=offline\" query param when accessing the refresh token")
 	flag.Var(&s.featureGates, "feature-gates", "A set of key=value pairs that describe feature gates for various features.")
+	flag.DurationVar(&s.clusterListCacheTTL, "cluster-list-cache-ttl", kubernetesprovider.DefaultClusterListCacheTTL, "The time for which the cluster lists are cached if the ClusterListCache feature gate is enabled.")
 	flag.StringVar(&s.domain, "domain", "localhost", "A domain name on which the server is deployed")
 	flag.StringVar(&s.serviceAccountSigningKey, "service-account-signing-key", "", "Signing key authenticates the service account's token value using HMAC. It is recommended to use a key with 32 bytes or longer.")
 	flag.StringVar(&rawExposeStrategy, "expose-strategy", "NodePort", "The strategy to expose the controlplane with, either \"NodePort\" which creates NodePorts with a \"nodeport-proxy.k8s.io/expose: true\" annotation or \"LoadBalancer\", which creates a LoadBalancer")
 	flag.StringVar(&s.namespace, "namespace", "kubermatic", "The namespace kubermatic runs in, uses to determine where to look for datacenter custom resources")
+	flag.StringVar(&metricsTokenFile, "metrics-bearer-token-file", "", "The path to a file with the bearer token required to scrape /metrics on the listen address. If not set, the metrics are only served on the internal address")
 	flag.StringVar(&configFile, "kubermatic-configuration-file", "", "(for development only) path to a KubermaticConfiguration YAML file")
 	addFlags(flag.CommandLine)
 	flag.Parse()
@@ -122,6 +141,17 @@ func newServerRunOptions() (serverRunOptions, error) {
 		}
 	}
 
+	if metricsTokenFile != "" {
+		token, err := os.ReadFile(metricsTokenFile)
+		if err != nil {
+			return s, fmt.Errorf("failed to read metrics bearer token file '%s': %w", metricsTokenFile, err)
+		}
+		s.metricsBearerToken = strings.TrimSpace(string(token))
+		if s.metricsBearerToken == "" {
+			return s, fmt.Errorf("metrics bearer token file '%s' is empty", metricsTokenFile)
+		}
+	}
+
 	if len(caBundleFile) == 0 {
 		return s, errors.New("no -ca-bundle configured")
 	}
@@ -215,6 +245,10 @@ type providers struct {
 	oidcIssuerVerifierProviderGetter               provider.OIDCIssuerVerifierGetter
 	policyTemplateProvider                         provider.PolicyTemplateProvider
 	policyBindingProvider                          provider.PolicyBindingProvider
+	privilegedWebhookProvider                      provider.PrivilegedWebhookProvider
+	webhookNotifier                                *webhook.Notifier
+	priceCatalog                                   provider.PriceCatalog
+	rateLimiter                                    middleware.RateLimiter
 }
 
 func loadKubermaticConfiguration(filename string) (*kubermaticv1.KubermaticConfiguration, error) {
@@ -22,8 +22,10 @@ import (
 	"context"
 	"flag"
 
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/provider"
 	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/provider/pricing"
 
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -59,3 +61,13 @@ func policyTemplateProviderFactory(_ ctrlruntimeclient.Client) provider.PolicyTe
 func policyBindingProviderFactory(_ ctrlruntimeclient.Client) provider.PolicyBindingProvider {
 	return nil
 }
+
+func priceCatalogFactory() provider.PriceCatalog {
+	return pricing.NewStaticCatalog()
+}
+
+// rateLimiterFactory returns the store of the rate limit buckets of the API users. The in-memory store is not
+// shared between the replicas of the API.
+func rateLimiterFactory() middleware.RateLimiter {
+	return middleware.NewInMemoryRateLimiter()
+}
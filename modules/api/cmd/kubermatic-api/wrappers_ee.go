@@ -23,8 +23,10 @@ import (
 	"flag"
 
 	eeapi "k8c.io/dashboard/v2/pkg/ee/cmd/kubermatic-api"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/provider"
 	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/provider/pricing"
 
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -60,3 +62,13 @@ func policyTemplateProviderFactory(privilegedClient ctrlruntimeclient.Client) pr
 func policyBindingProviderFactory(privilegedClient ctrlruntimeclient.Client) provider.PolicyBindingProvider {
 	return eeapi.PolicyBindingProviderFactory(privilegedClient)
 }
+
+func priceCatalogFactory() provider.PriceCatalog {
+	return pricing.NewStaticCatalog()
+}
+
+// rateLimiterFactory returns the store of the rate limit buckets of the API users. The in-memory store is not
+// shared between the replicas of the API.
+func rateLimiterFactory() middleware.RateLimiter {
+	return middleware.NewInMemoryRateLimiter()
+}
@@ -127,6 +127,23 @@ type DatacenterSpec struct {
 	// Optional: KubeLB holds the configuration for the kubeLB at the data center level.
 	// Only available in Enterprise Edition.
 	KubeLB *kubermaticv1.KubeLBDatacenterSettings `json:"kubelb,omitempty"`
+
+	// Optional: NodeSpecDefaults overrides the built-in defaults of the node spec which is suggested for the
+	// machine deployments of the datacenter.
+	NodeSpecDefaults *NodeSpecDefaults `json:"nodeSpecDefaults,omitempty"`
+}
+
+// NodeSpecDefaults are the defaults of the node spec which is suggested for the machine deployments of a
+// datacenter. Empty fields fall back to the built-in defaults of the provider.
+// swagger:model NodeSpecDefaults
+type NodeSpecDefaults struct {
+	// InstanceType is the instance type, size or flavor of the nodes.
+	InstanceType string `json:"instanceType,omitempty"`
+	// DiskSize is the size of the disk of the nodes in GB. It is ignored for providers which derive the disk
+	// from the instance type.
+	DiskSize int `json:"diskSize,omitempty"`
+	// Image is the Ubuntu image of the nodes. It is ignored for providers which don't support custom images.
+	Image string `json:"image,omitempty"`
 }
 
 // DatacenterList represents a list of datacenters
@@ -279,6 +296,28 @@ type GCPMachineSize struct {
 	Description string `json:"description"`
 	Memory      int64  `json:"memory"`
 	VCPUs       int64  `json:"vcpus"`
+	// Custom is only set for the entries of the custom machine types of a machine series, e.g. n2-custom, and
+	// describes the shapes which can be used as machine type in the form [SERIES-]custom-VCPUS-MEMORY[-ext].
+	Custom *GCPCustomMachineTypeBounds `json:"custom,omitempty"`
+}
+
+// GCPCustomMachineTypeBounds represents the valid shapes of the custom machine types of a GCP machine series.
+// swagger:model GCPCustomMachineTypeBounds
+type GCPCustomMachineTypeBounds struct {
+	// Series of the custom machine types, e.g. n1 or n2.
+	Series string `json:"series"`
+	// VCPUs are the allowed vCPU counts in ascending order.
+	VCPUs []int64 `json:"vcpus"`
+	// MemoryStep is the granularity of the memory in MB, the memory has to be a multiple of it.
+	MemoryStep int64 `json:"memoryStep"`
+	// MinMemoryPerVCPU is the minimum memory per vCPU in MB.
+	MinMemoryPerVCPU float64 `json:"minMemoryPerVCPU"`
+	// MaxMemoryPerVCPU is the maximum memory per vCPU in MB without extended memory.
+	MaxMemoryPerVCPU float64 `json:"maxMemoryPerVCPU"`
+	// MaxMemory is the maximum total memory in MB, including extended memory.
+	MaxMemory int64 `json:"maxMemory"`
+	// ExtendedMemory is true if the series supports extended memory beyond MaxMemoryPerVCPU with the -ext suffix.
+	ExtendedMemory bool `json:"extendedMemory"`
 }
 
 // GCPZone represents a object of GCP zone.
@@ -389,6 +428,12 @@ type AzureAvailabilityZonesList struct {
 	Zones []string `json:"zones"`
 }
 
+// AzureProximityPlacementGroupsList is the object representing the proximity placement groups for vms in azure cloud provider
+// swagger:model AzureProximityPlacementGroupsList
+type AzureProximityPlacementGroupsList struct {
+	ProximityPlacementGroups []string `json:"proximityPlacementGroups"`
+}
+
 // AzureSizeList represents an array of Azure VM sizes.
 // swagger:model AzureSizeList
 type AzureSizeList []AzureSize
@@ -424,6 +469,29 @@ type HetznerSize struct {
 	Disk        int     `json:"disk"`
 }
 
+// HetznerNetworkList represents an array of Hetzner networks.
+// swagger:model HetznerNetworkList
+type HetznerNetworkList []HetznerNetwork
+
+// HetznerNetwork is the object representing a Hetzner network.
+// swagger:model HetznerNetwork
+type HetznerNetwork struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	IPRange string `json:"ipRange"`
+}
+
+// HetznerFirewallList represents an array of Hetzner firewalls.
+// swagger:model HetznerFirewallList
+type HetznerFirewallList []HetznerFirewall
+
+// HetznerFirewall is the object representing a Hetzner firewall.
+// swagger:model HetznerFirewall
+type HetznerFirewall struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
 // PacketSizeList represents an array of Packet VM sizes.
 // swagger:model PacketSizeList
 type PacketSizeList []PacketSize
@@ -564,6 +632,15 @@ type VMwareCloudDirectorStorageProfileList []VMwareCloudDirectorStorageProfile
 type SSHKey struct {
 	ObjectMeta
 	Spec SSHKeySpec `json:"spec"`
+	// UsedByClusters lists the clusters the key is assigned to. It is only set when the usage
+	// was requested explicitly.
+	UsedByClusters []SSHKeyCluster `json:"usedByClusters,omitempty"`
+}
+
+// SSHKeyCluster is a cluster to which a ssh key is assigned.
+type SSHKeyCluster struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
 }
 
 // SSHKeySpec represents the details of a ssh key.
@@ -702,6 +779,30 @@ type ProjectGroup struct {
 	GroupPrefix string `json:"group"`
 }
 
+// ProjectMemberRemoval reports how the cluster access of a member removed from a project was revoked
+// swagger:model ProjectMemberRemoval
+type ProjectMemberRemoval struct {
+	// Clusters lists the revocation status for every cluster of the project
+	Clusters []ClusterAccessRevocation `json:"clusters"`
+	// FailedSeeds lists the seeds whose clusters could not be listed, the access to these clusters was not revoked
+	FailedSeeds []string `json:"failedSeeds,omitempty"`
+}
+
+// ClusterAccessRevocation is the revocation status of a single cluster
+// swagger:model ClusterAccessRevocation
+type ClusterAccessRevocation struct {
+	// ClusterID is the ID of the cluster
+	ClusterID string `json:"clusterID"`
+	// ClusterName is the human readable name of the cluster
+	ClusterName string `json:"clusterName"`
+	// BindingsRevoked indicates that the member was removed from the role bindings in the user cluster
+	BindingsRevoked bool `json:"bindingsRevoked"`
+	// ViewerTokenRevoked indicates that the viewer token of the cluster was rotated
+	ViewerTokenRevoked bool `json:"viewerTokenRevoked,omitempty"`
+	// Error describes why the access could not be revoked
+	Error string `json:"error,omitempty"`
+}
+
 // These are the valid statuses of a ServiceAccount.
 const (
 	// ServiceAccountActive means the ServiceAccount is available for use in the system.
@@ -733,6 +834,11 @@ type PublicServiceAccountToken struct {
 	Expiry Time `json:"expiry,omitempty"`
 	// Invalidated indicates if the token must be regenerated
 	Invalidated bool `json:"invalidated,omitempty"`
+	// LastRotatedAt is the time when the token was regenerated the last time.
+	// swagger:strfmt date-time
+	LastRotatedAt *Time `json:"lastRotatedAt,omitempty"`
+	// RotationCount is the number of times the token was regenerated.
+	RotationCount int `json:"rotationCount,omitempty"`
 }
 
 // ServiceAccountToken represent an API service account token
@@ -769,6 +875,13 @@ type Kubeconfig struct {
 	Config []byte
 }
 
+// SupportBundle is a gzipped tarball with the JSON dumps of the resources related to a cluster
+// swagger:response SupportBundle
+type SupportBundle struct {
+	// in: body
+	Bundle []byte
+}
+
 // OpenstackSize is the object representing openstack's sizes.
 // swagger:model OpenstackSize
 type OpenstackSize struct {
@@ -956,7 +1069,10 @@ type MasterVersion struct {
 type CreateClusterSpec struct {
 	Cluster        Cluster         `json:"cluster"`
 	NodeDeployment *NodeDeployment `json:"nodeDeployment,omitempty"`
-	Applications   []Application   `json:"applications,omitempty"`
+	// MachineDeployments are created in the cluster, in addition to the NodeDeployment, once its control plane
+	// is healthy. The request is rejected as a whole if any of them is invalid.
+	MachineDeployments []NodeDeployment `json:"machineDeployments,omitempty"`
+	Applications       []Application    `json:"applications,omitempty"`
 }
 
 const (
@@ -981,6 +1097,35 @@ type Cluster struct {
 	Spec                   ClusterSpec   `json:"spec"`
 	Status                 ClusterStatus `json:"status"`
 	MachineDeploymentCount *int          `json:"machineDeploymentCount,omitempty"`
+	// FetchStatus is set to unknown if the cluster couldn't be fetched from its seed and the last known data is shown.
+	FetchStatus string `json:"fetchStatus,omitempty"`
+	// FetchError describes why the cluster couldn't be fetched. Only shown to admins.
+	FetchError *ClusterFetchError `json:"fetchError,omitempty"`
+}
+
+const (
+	// ClusterFetchStatusUnknown marks clusters whose current state couldn't be fetched from their seed.
+	ClusterFetchStatusUnknown = "unknown"
+
+	// ClusterFetchErrorSeedInvalid means the seed of the cluster is in an invalid phase.
+	ClusterFetchErrorSeedInvalid = "SeedInvalid"
+	// ClusterFetchErrorSeedUnreachable means no client for the seed of the cluster could be created.
+	ClusterFetchErrorSeedUnreachable = "SeedUnreachable"
+	// ClusterFetchErrorListFailed means listing the clusters or their machine deployments failed.
+	ClusterFetchErrorListFailed = "ListFailed"
+)
+
+// ClusterFetchError describes why a cluster couldn't be fetched from its seed.
+// swagger:model ClusterFetchError
+type ClusterFetchError struct {
+	// Reason is one of SeedInvalid, SeedUnreachable or ListFailed.
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+	Seed    string `json:"seed"`
+	// Retryable tells whether fetching the cluster again might succeed without an intervention of an admin.
+	Retryable bool `json:"retryable"`
+	// RetryAfterSeconds is the suggested delay before fetching the cluster again.
+	RetryAfterSeconds int `json:"retryAfterSeconds,omitempty"`
 }
 
 // ClusterSpec defines the cluster specification.
@@ -1082,6 +1227,24 @@ type ClusterSpec struct {
 
 	// Kyverno holds the configuration for the Kyverno policy management component.
 	Kyverno *kubermaticv1.KyvernoSettings `json:"kyverno,omitempty"`
+
+	// MaintenanceWindow restricts upgrades and restarts to the given time slots. It is read-only here and
+	// can only be changed through the maintenance window endpoint of the cluster.
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// MaintenanceWindow defines recurring time slots in which disruptive actions, like upgrades of the cluster
+// or the kubelets and restarts of machine deployments, are allowed.
+// swagger:model MaintenanceWindow
+type MaintenanceWindow struct {
+	// Weekdays on which the window starts, e.g. "Monday". An empty list means every day.
+	Weekdays []string `json:"weekdays,omitempty"`
+	// StartTime of the window in the HH:MM format.
+	StartTime string `json:"startTime"`
+	// Duration of the window, e.g. "4h" or "90m".
+	Duration string `json:"duration"`
+	// Timezone of the start time as IANA time zone name, e.g. "Europe/Berlin". Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
 }
 
 // MarshalJSON marshals ClusterSpec object into JSON. It is overwritten to control data
@@ -1114,6 +1277,7 @@ func (cs *ClusterSpec) MarshalJSON() ([]byte, error) {
 		APIServerAllowedIPRanges             *kubermaticv1.NetworkRanges            `json:"apiServerAllowedIPRanges,omitempty"`
 		DisableCSIDriver                     bool                                   `json:"disableCsiDriver,omitempty"`
 		Kyverno                              *kubermaticv1.KyvernoSettings          `json:"kyverno,omitempty"`
+		MaintenanceWindow                    *MaintenanceWindow                     `json:"maintenanceWindow,omitempty"`
 	}{
 		Cloud: PublicCloudSpec{
 			DatacenterName:      cs.Cloud.DatacenterName,
@@ -1160,6 +1324,7 @@ func (cs *ClusterSpec) MarshalJSON() ([]byte, error) {
 		APIServerAllowedIPRanges:             cs.APIServerAllowedIPRanges,
 		DisableCSIDriver:                     cs.DisableCSIDriver,
 		Kyverno:                              cs.Kyverno,
+		MaintenanceWindow:                    cs.MaintenanceWindow,
 	})
 
 	return ret, err
@@ -1468,6 +1633,42 @@ type ClusterHealth struct {
 	Kyverno                      *kubermaticv1.HealthStatus `json:"kyverno,omitempty"`
 }
 
+const (
+	// EtcdHealthStateAvailable means that the details were queried from etcd.
+	EtcdHealthStateAvailable = "available"
+	// EtcdHealthStateUnknown means that etcd could not be reached, only its health from the cluster status is known.
+	EtcdHealthStateUnknown = "unknown"
+)
+
+// EtcdHealth stores the health of the etcd ring of a cluster and the size of its database.
+// swagger:model EtcdHealth
+type EtcdHealth struct {
+	// Health is the health of etcd from the cluster status.
+	Health kubermaticv1.HealthStatus `json:"health"`
+	// State is available if the details were queried from etcd, or unknown if etcd could not be reached.
+	State string `json:"state"`
+	// Message is the reason why etcd could not be reached.
+	Message string `json:"message,omitempty"`
+	// DBSize is the size of the database in bytes, including the space which is not reclaimed yet.
+	DBSize *int64 `json:"dbSize,omitempty"`
+	// DBSizeInUse is the size of the database in bytes which is in use.
+	DBSizeInUse *int64 `json:"dbSizeInUse,omitempty"`
+	// MemberCount is the number of members of the etcd ring.
+	MemberCount *int `json:"memberCount,omitempty"`
+	// HasQuorum is whether the majority of the members is healthy.
+	HasQuorum *bool `json:"hasQuorum,omitempty"`
+	// Alarms are the active alarms of the members.
+	Alarms []EtcdAlarm `json:"alarms,omitempty"`
+}
+
+// EtcdAlarm is an active alarm of an etcd member.
+type EtcdAlarm struct {
+	// MemberID is the ID of the member in hex, like etcdctl prints it.
+	MemberID string `json:"memberID"`
+	// Alarm is the type of the alarm, NOSPACE or CORRUPT.
+	Alarm string `json:"alarm"`
+}
+
 // AccessibleAddons represents an array of addons that can be configured in the user clusters.
 // swagger:model AccessibleAddons
 type AccessibleAddons []string
@@ -1478,6 +1679,8 @@ type Addon struct {
 	ObjectMeta `json:",inline"`
 
 	Spec AddonSpec `json:"spec"`
+	// Status is only set when requested, as it has to be read from the user cluster.
+	Status *AddonStatus `json:"status,omitempty"`
 }
 
 // AddonSpec addon specification
@@ -1491,6 +1694,30 @@ type AddonSpec struct {
 	ContinuouslyReconcile bool `json:"continuouslyReconcile,omitempty"`
 }
 
+const (
+	// AddonHealthy means that all replicas of the workloads of the addon are available.
+	AddonHealthy = "healthy"
+	// AddonDegraded means that some replicas of the workloads of the addon are not available.
+	AddonDegraded = "degraded"
+	// AddonHealthUnknown means that the user cluster was not reachable.
+	AddonHealthUnknown = "unknown"
+)
+
+// AddonStatus represents the health of the Deployments and DaemonSets of an addon in the user cluster
+// swagger:model AddonStatus
+type AddonStatus struct {
+	// State is one of healthy, degraded or unknown.
+	State string `json:"state"`
+	// Healthy indicates whether all desired replicas are ready and available.
+	Healthy bool `json:"healthy"`
+	// Desired is the number of desired replicas of all workloads of the addon.
+	Desired int32 `json:"desired"`
+	// Ready is the number of ready replicas of all workloads of the addon.
+	Ready int32 `json:"ready"`
+	// Available is the number of available replicas of all workloads of the addon.
+	Available int32 `json:"available"`
+}
+
 // AddonConfig represents a addon configuration
 // swagger:model AddonConfig
 type AddonConfig struct {
@@ -1537,6 +1764,8 @@ type NodeCloudSpec struct {
 type UbuntuSpec struct {
 	// do a dist-upgrade on boot and reboot it required afterwards
 	DistUpgradeOnBoot bool `json:"distUpgradeOnBoot"`
+	// UbuntuProToken is the token used to attach the nodes to Ubuntu Pro. It is returned as true if set.
+	UbuntuProToken SecretString `json:"ubuntuProToken,omitempty"`
 }
 
 // FlatcarSpec contains Flatcar Linux specific settings
@@ -1554,10 +1783,11 @@ type FlatcarSpec struct {
 // swagger:model RHELSpec
 type RHELSpec struct {
 	// do a dist-upgrade on boot and reboot it required afterwards
-	DistUpgradeOnBoot               bool   `json:"distUpgradeOnBoot"`
-	RHELSubscriptionManagerUser     string `json:"rhelSubscriptionManagerUser,omitempty"`
-	RHELSubscriptionManagerPassword string `json:"rhelSubscriptionManagerPassword,omitempty"`
-	RHSMOfflineToken                string `json:"rhsmOfflineToken,omitempty"`
+	DistUpgradeOnBoot bool `json:"distUpgradeOnBoot"`
+	// The subscription-manager credentials are returned as true if set.
+	RHELSubscriptionManagerUser     SecretString `json:"rhelSubscriptionManagerUser,omitempty"`
+	RHELSubscriptionManagerPassword SecretString `json:"rhelSubscriptionManagerPassword,omitempty"`
+	RHSMOfflineToken                SecretString `json:"rhsmOfflineToken,omitempty"`
 }
 
 // RockyLinuxSpec contains rocky-linux specific settings
@@ -1584,6 +1814,74 @@ type OperatingSystemSpec struct {
 	RockyLinux  *RockyLinuxSpec  `json:"rockylinux,omitempty"`
 }
 
+// RedactSecrets replaces the subscription secrets of the operating system with a redacted value.
+func (spec *OperatingSystemSpec) RedactSecrets() {
+	if spec.Ubuntu != nil {
+		spec.Ubuntu.UbuntuProToken = spec.Ubuntu.UbuntuProToken.Redact()
+	}
+	if spec.RHEL != nil {
+		spec.RHEL.RHELSubscriptionManagerUser = spec.RHEL.RHELSubscriptionManagerUser.Redact()
+		spec.RHEL.RHELSubscriptionManagerPassword = spec.RHEL.RHELSubscriptionManagerPassword.Redact()
+		spec.RHEL.RHSMOfflineToken = spec.RHEL.RHSMOfflineToken.Redact()
+	}
+}
+
+// SecretString is a sensitive string like a password or a token. A redacted secret is serialized as true, so
+// responses only tell if the secret is set. Sending true back in a request keeps the redacted value.
+type SecretString string
+
+// redactedSecretString is the value of a redacted secret. It can not be set from JSON as a string.
+const redactedSecretString SecretString = "\x00redacted"
+
+// Redact returns the redacted value of the secret, unset secrets stay unset.
+func (s SecretString) Redact() SecretString {
+	if s == "" {
+		return ""
+	}
+	return redactedSecretString
+}
+
+// Value returns the secret, a redacted secret has no value.
+func (s SecretString) Value() string {
+	if s.IsRedacted() {
+		return ""
+	}
+	return string(s)
+}
+
+// IsRedacted returns true if the secret is redacted.
+func (s SecretString) IsRedacted() bool {
+	return s == redactedSecretString
+}
+
+func (s SecretString) MarshalJSON() ([]byte, error) {
+	if s.IsRedacted() {
+		return []byte("true"), nil
+	}
+	return json.Marshal(string(s))
+}
+
+func (s *SecretString) UnmarshalJSON(data []byte) error {
+	var redacted bool
+	if err := json.Unmarshal(data, &redacted); err == nil {
+		*s = ""
+		if redacted {
+			*s = redactedSecretString
+		}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if value == string(redactedSecretString) {
+		return fmt.Errorf("invalid secret value")
+	}
+	*s = SecretString(value)
+	return nil
+}
+
 // NodeVersionInfo node version information
 // swagger:model NodeVersionInfo
 type NodeVersionInfo struct {
@@ -1618,6 +1916,35 @@ type NodeSpec struct {
 	Annotations map[string]string `json:"annotations,omitempty"`
 	// List of taints to set on new nodes
 	Taints []TaintSpec `json:"taints,omitempty"`
+	// GPU driver settings for nodes backed by GPU instance types
+	// required: false
+	GPU *GPUSpec `json:"gpu,omitempty"`
+	// OSProfile is the name of the OperatingSystemProfile which is used by operating-system-manager to
+	// provision the nodes. The default profile of the operating system is used if it is empty.
+	// required: false
+	OSProfile string `json:"osProfile,omitempty"`
+	// AdditionalUserData is appended to the user data of the nodes, e.g. a #cloud-config snippet which installs
+	// extra packages. It is limited to 16KB and must not redefine the SSH login user.
+	// required: false
+	AdditionalUserData string `json:"additionalUserData,omitempty"`
+	// KubeletConfig configures the kubelet of the nodes. Supported keys are systemReserved and kubeReserved, e.g.
+	// cpu=200m,memory=1Gi, evictionHard, e.g. memory.available<500Mi,nodefs.available<10%, maxPods between 10 and
+	// 500 and containerLogMaxSize, e.g. 50Mi.
+	// required: false
+	KubeletConfig map[string]string `json:"kubeletConfig,omitempty"`
+}
+
+// GPUSpec GPU driver settings for a node
+// swagger:model GPUSpec
+type GPUSpec struct {
+	// Enabled installs the GPU driver on the nodes.
+	Enabled bool `json:"enabled"`
+	// DriverVersion is the version of the GPU driver to install, e.g. 550.54.15. Defaults to the provisioning default if empty.
+	// required: false
+	DriverVersion string `json:"driverVersion,omitempty"`
+	// MIGStrategy is the Multi-Instance GPU strategy. Can be one of none, single or mixed.
+	// required: false
+	MIGStrategy string `json:"migStrategy,omitempty"`
 }
 
 // DNSConfig contains a machine's DNS configuration.
@@ -1628,10 +1955,16 @@ type DNSConfig struct {
 // NetworkSpec machine static network configuration
 // swagger:model NetworkSpec
 type NetworkSpec struct {
-	CIDR     string                   `json:"cidr"`
-	Gateway  string                   `json:"gateway"`
-	DNS      providerconfig.DNSConfig `json:"dns"`
-	IPFamily string                   `json:"ipFamily,omitempty"`
+	CIDR    string                   `json:"cidr"`
+	Gateway string                   `json:"gateway"`
+	DNS     providerconfig.DNSConfig `json:"dns"`
+	// IPFamily of the machines, one of IPv4, IPv6 and IPv4+IPv6. The families have to be enabled in the cluster
+	// network. Defaults to the IP family of the cluster.
+	IPFamily string `json:"ipFamily,omitempty"`
+	// SecondaryCIDR is the IPv6 CIDR of the machines if the IPv4+IPv6 IP family is used.
+	SecondaryCIDR string `json:"secondaryCIDR,omitempty"`
+	// SecondaryGateway is the IPv6 gateway of the machines if the IPv4+IPv6 IP family is used.
+	SecondaryGateway string `json:"secondaryGateway,omitempty"`
 }
 
 // DigitaloceanNodeSpec digitalocean node settings
@@ -1690,6 +2023,9 @@ type HetznerNodeSpec struct {
 	// network name
 	// required: false
 	Network string `json:"network"`
+	// firewall name
+	// required: false
+	Firewall string `json:"firewall"`
 }
 
 func (spec *HetznerNodeSpec) MarshalJSON() ([]byte, error) {
@@ -1704,11 +2040,13 @@ func (spec *HetznerNodeSpec) MarshalJSON() ([]byte, error) {
 	}
 
 	res := struct {
-		Network string `json:"network,omitempty"`
-		Type    string `json:"type"`
+		Network  string `json:"network,omitempty"`
+		Firewall string `json:"firewall,omitempty"`
+		Type     string `json:"type"`
 	}{
-		Network: spec.Network,
-		Type:    spec.Type,
+		Network:  spec.Network,
+		Firewall: spec.Firewall,
+		Type:     spec.Type,
 	}
 
 	return json.Marshal(&res)
@@ -1860,6 +2198,10 @@ type VSphereNodeSpec struct {
 	// Automatically create anti affinity rules for machines.
 	VMAntiAffinity *bool  `json:"vmAntiAffinity"`
 	VMGroup        string `json:"vmGroup"`
+	// AdditionalNetworks are attached to the machines in addition to the networks of the cluster,
+	// e.g. a storage VLAN.
+	// required: false
+	AdditionalNetworks []string `json:"additionalNetworks,omitempty"`
 }
 
 // VSphereTag represents vsphere tag.
@@ -1895,21 +2237,23 @@ func (spec *VSphereNodeSpec) MarshalJSON() ([]byte, error) {
 	}
 
 	res := struct {
-		CPUs           int          `json:"cpus"`
-		Memory         int          `json:"memory"`
-		DiskSizeGB     *int64       `json:"diskSizeGB,omitempty"`
-		Template       string       `json:"template"`
-		Tags           []VSphereTag `json:"tags,omitempty"`
-		VMAntiAffinity *bool        `json:"vmAntiAffinity"`
-		VMGroup        string       `json:"vmGroup,omitempty"`
+		CPUs               int          `json:"cpus"`
+		Memory             int          `json:"memory"`
+		DiskSizeGB         *int64       `json:"diskSizeGB,omitempty"`
+		Template           string       `json:"template"`
+		Tags               []VSphereTag `json:"tags,omitempty"`
+		VMAntiAffinity     *bool        `json:"vmAntiAffinity"`
+		VMGroup            string       `json:"vmGroup,omitempty"`
+		AdditionalNetworks []string     `json:"additionalNetworks,omitempty"`
 	}{
-		CPUs:           spec.CPUs,
-		Memory:         spec.Memory,
-		DiskSizeGB:     spec.DiskSizeGB,
-		Template:       spec.Template,
-		Tags:           spec.Tags,
-		VMAntiAffinity: spec.VMAntiAffinity,
-		VMGroup:        spec.VMGroup,
+		CPUs:               spec.CPUs,
+		Memory:             spec.Memory,
+		DiskSizeGB:         spec.DiskSizeGB,
+		Template:           spec.Template,
+		Tags:               spec.Tags,
+		VMAntiAffinity:     spec.VMAntiAffinity,
+		VMGroup:            spec.VMGroup,
+		AdditionalNetworks: spec.AdditionalNetworks,
 	}
 
 	return json.Marshal(&res)
@@ -1942,9 +2286,13 @@ type OpenstackNodeSpec struct {
 	// Max time to wait for the instance to be ready, i.e. 10s/1m
 	// required: false
 	InstanceReadyCheckTimeout string `json:"instanceReadyCheckTimeout"`
-	// UUID of the server group, used to configure affinity or anti-affinity of the VM instances relative to hypervisor
+	// Name or UUID of the server group, used to configure affinity or anti-affinity of the VM instances relative to hypervisor.
+	// A name is replaced by the UUID of the server group when the node deployment is created.
 	// required: false
 	ServerGroup string `json:"serverGroup"`
+	// If set, the server group is created with the anti-affinity policy when it does not exist yet
+	// required: false
+	CreateServerGroup bool `json:"createServerGroup,omitempty"`
 	// ConfigDrive enables a configuration drive that will be attached to the instance when it boots.
 	// required: false
 	ConfigDrive bool `json:"configDrive"`
@@ -1975,6 +2323,7 @@ func (spec *OpenstackNodeSpec) MarshalJSON() ([]byte, error) {
 		InstanceReadyCheckPeriod  string            `json:"instanceReadyCheckPeriod"`
 		InstanceReadyCheckTimeout string            `json:"instanceReadyCheckTimeout"`
 		ServerGroup               string            `json:"serverGroup"`
+		CreateServerGroup         bool              `json:"createServerGroup,omitempty"`
 		ConfigDrive               bool              `json:"configDrive"`
 	}{
 		Flavor:                    spec.Flavor,
@@ -1986,6 +2335,7 @@ func (spec *OpenstackNodeSpec) MarshalJSON() ([]byte, error) {
 		InstanceReadyCheckPeriod:  spec.InstanceReadyCheckPeriod,
 		InstanceReadyCheckTimeout: spec.InstanceReadyCheckTimeout,
 		ServerGroup:               spec.ServerGroup,
+		CreateServerGroup:         spec.CreateServerGroup,
 		ConfigDrive:               spec.ConfigDrive,
 	}
 
@@ -2256,6 +2606,9 @@ type KubevirtNodeSpec struct {
 	// EvictionStrategy describes the strategy to follow when a node drain occurs. If not set the default
 	// value is External and the VM will be protected by a PDB.
 	EvictionStrategy string `json:"evictionStrategy,omitempty"`
+	// PriorityClassName is the name of the PriorityClass of the infra cluster used for the VM pods.
+	// required: false
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 }
 
 type KubevirtNodeSize struct {
@@ -2354,6 +2707,7 @@ func (spec *KubevirtNodeSpec) MarshalJSON() ([]byte, error) {
 		TopologySpreadConstraints   []TopologySpreadConstraint      `json:"topologySpreadConstraints"`
 		Subnet                      string                          `json:"subnet,omitempty"`
 		EvictionStrategy            string                          `json:"evictionStrategy,omitempty"`
+		PriorityClassName           string                          `json:"priorityClassName,omitempty"`
 	}{
 		FlavorName:                  spec.FlavorName,
 		FlavorProfile:               spec.FlavorProfile,
@@ -2371,6 +2725,7 @@ func (spec *KubevirtNodeSpec) MarshalJSON() ([]byte, error) {
 		TopologySpreadConstraints:   spec.TopologySpreadConstraints,
 		Subnet:                      spec.Subnet,
 		EvictionStrategy:            spec.EvictionStrategy,
+		PriorityClassName:           spec.PriorityClassName,
 	}
 
 	return json.Marshal(&res)
@@ -2731,11 +3086,64 @@ type NodeStatus struct {
 	Addresses []NodeAddress `json:"addresses,omitempty"`
 	// node versions and systems info
 	NodeInfo NodeSystemInfo `json:"nodeInfo,omitempty"`
+	// whether the node is cordoned and new pods are not scheduled on it
+	Unschedulable bool `json:"unschedulable,omitempty"`
 
 	// in case of a error this will contain a short error message
 	ErrorReason string `json:"errorReason,omitempty"`
 	// in case of a error this will contain a detailed error explanation
 	ErrorMessage string `json:"errorMessage,omitempty"`
+
+	// OSUpdateStatus is the state of the operating system updates of the node. It is only returned when listing
+	// the nodes of a machine deployment.
+	OSUpdateStatus *NodeOSUpdateStatus `json:"osUpdateStatus,omitempty"`
+
+	// SpotInterruptions are the latest interruption notices the AWS spot instance of the node received, taken from
+	// the events of its machine and node. They are only returned when listing the nodes of a machine deployment.
+	SpotInterruptions []NodeSpotInterruption `json:"spotInterruptions,omitempty"`
+}
+
+const (
+	// NodeSpotTerminationNotice means that the spot instance of the node is going to be terminated.
+	NodeSpotTerminationNotice = "terminationNotice"
+	// NodeSpotRebalanceRecommendation means that the spot instance of the node is at an elevated risk of being
+	// interrupted and the workload should be moved.
+	NodeSpotRebalanceRecommendation = "rebalanceRecommendation"
+)
+
+// NodeSpotInterruption is a notice about the interruption of the spot instance of a node.
+// swagger:model NodeSpotInterruption
+type NodeSpotInterruption struct {
+	// Type is one of terminationNotice or rebalanceRecommendation.
+	Type    string `json:"type"`
+	Message string `json:"message,omitempty"`
+	// Time is when the notice was received last.
+	Time Time `json:"time"`
+}
+
+const (
+	// NodeOSUpToDate means the node has no pending security updates and doesn't need a reboot.
+	NodeOSUpToDate = "upToDate"
+	// NodeOSUpdatesPending means security updates are available but not installed yet.
+	NodeOSUpdatesPending = "updatesPending"
+	// NodeOSRebootRequired means updates were installed, but the node has to be rebooted to apply them.
+	NodeOSRebootRequired = "rebootRequired"
+	// NodeOSUpdateUnknown means the node doesn't report the state of its operating system updates.
+	NodeOSUpdateUnknown = "unknown"
+)
+
+// NodeOSUpdateStatus is the state of the operating system updates of a node, as reported by the update agents of
+// the node in its annotations.
+// swagger:model NodeOSUpdateStatus
+type NodeOSUpdateStatus struct {
+	// State is one of upToDate, updatesPending, rebootRequired or unknown.
+	State string `json:"state"`
+	// RebootRequired is set if the node reports whether it has to be rebooted to apply installed updates.
+	RebootRequired *bool `json:"rebootRequired,omitempty"`
+	// LastUpdate is the time the operating system of the node was updated last.
+	LastUpdate *Time `json:"lastUpdate,omitempty"`
+	// PendingSecurityUpdates is the number of security updates which are available but not installed yet.
+	PendingSecurityUpdates *int `json:"pendingSecurityUpdates,omitempty"`
 }
 
 // NodeAddress contains information for the node's address.
@@ -2773,6 +3181,22 @@ type ControlPlaneMetrics struct {
 	MemoryTotalBytes int64 `json:"memoryTotalBytes,omitempty"`
 	// CPUTotalMillicores in m cores
 	CPUTotalMillicores int64 `json:"cpuTotalMillicores,omitempty"`
+	// Components are the metrics of the control plane components, e.g. the apiserver or etcd. They are omitted
+	// if the metrics are not available on the seed.
+	Components []ControlPlaneComponentMetrics `json:"components,omitempty"`
+}
+
+// ControlPlaneComponentMetrics defines a metric for the pods of a user cluster control plane component
+// swagger:model ControlPlaneComponentMetrics
+type ControlPlaneComponentMetrics struct {
+	// Name of the component, e.g. apiserver
+	Name string `json:"name"`
+	// PodCount is the number of pods of the component with metrics
+	PodCount int `json:"podCount"`
+	// MemoryTotalBytes in bytes
+	MemoryTotalBytes int64 `json:"memoryTotalBytes,omitempty"`
+	// CPUTotalMillicores in m cores
+	CPUTotalMillicores int64 `json:"cpuTotalMillicores,omitempty"`
 }
 
 // NodesMetric defines a metric for a group of nodes
@@ -2874,8 +3298,66 @@ type ApplicationRef struct {
 type NodeDeployment struct {
 	ObjectMeta `json:",inline"`
 
+	// GenerateName is used to generate a unique name for the node deployment when the name is not set. A random
+	// suffix is appended to it, the generated name is returned in the response.
+	// required: false
+	GenerateName string `json:"generateName,omitempty"`
+
+	// UseDefaults merges the default node spec of the datacenter into the node spec. Fields which are set are kept.
+	// required: false
+	UseDefaults bool `json:"useDefaults,omitempty"`
+
 	Spec   NodeDeploymentSpec                      `json:"spec"`
 	Status clusterv1alpha1.MachineDeploymentStatus `json:"status"`
+
+	// NodeStatus summarizes the readiness of the nodes of the node deployment. It is only set when explicitly requested.
+	// required: false
+	NodeStatus *NodeDeploymentNodeStatus `json:"nodeStatus,omitempty"`
+
+	// MachineErrors contains the errors the machine-controller reported for the machines of the node deployment.
+	// It is only set when explicitly requested for a single node deployment.
+	// required: false
+	MachineErrors []MachineError `json:"machineErrors,omitempty"`
+
+	// Warnings about the node deployment, e.g. about an operating system version reaching its end of life or an
+	// image which is no longer available.
+	// required: false
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// MachineError is an error the machine-controller reported for a machine.
+// swagger:model MachineError
+type MachineError struct {
+	// Machine is the name of the machine.
+	Machine string `json:"machine"`
+	// Reason is the machine-controller error reason, e.g. InvalidConfiguration or InsufficientResources.
+	Reason string `json:"reason"`
+	// Message is the detailed error message.
+	Message string `json:"message,omitempty"`
+	// Terminal is true for errors which are not resolved without changing the node deployment or the cloud
+	// account, e.g. invalid credentials or an exceeded quota.
+	Terminal bool `json:"terminal"`
+}
+
+// NodeDeploymentNodeStatus summarizes the readiness of the nodes which belong to a node deployment.
+// swagger:model NodeDeploymentNodeStatus
+type NodeDeploymentNodeStatus struct {
+	// ReadyNodes is the number of machines whose node is ready.
+	ReadyNodes int `json:"readyNodes"`
+	// TotalNodes is the number of machines which belong to the node deployment.
+	TotalNodes int `json:"totalNodes"`
+	// UnavailableNodes is the number of machines which don't have a ready node yet.
+	UnavailableNodes int `json:"unavailableNodes"`
+	// UpdatesPendingNodes is the number of nodes with pending security updates.
+	UpdatesPendingNodes int `json:"updatesPendingNodes"`
+	// RebootRequiredNodes is the number of nodes which have to be rebooted to apply installed updates.
+	RebootRequiredNodes int `json:"rebootRequiredNodes"`
+	// OSUpdateUnknownNodes is the number of machines whose node doesn't report the state of its operating system
+	// updates, including the machines without a node.
+	OSUpdateUnknownNodes int `json:"osUpdateUnknownNodes"`
+	// SpotInterruptedNodes is the number of machines which received a termination notice for their spot instance in
+	// the last 24 hours. It is only set for AWS node deployments with spot instances.
+	SpotInterruptedNodes *int `json:"spotInterruptedNodes,omitempty"`
 }
 
 // NodeDeploymentSpec node deployment specification
@@ -2894,6 +3376,27 @@ type NodeDeploymentSpec struct {
 	MinReplicas *uint32 `json:"minReplicas,omitempty"`
 	// required: false
 	MaxReplicas *uint32 `json:"maxReplicas,omitempty"`
+	// MaxSurge is the maximum number of machines that can be created above the desired number of replicas
+	// during a rollout. It is an absolute number (e.g. 1) or a percentage (e.g. 25%).
+	// required: false
+	MaxSurge string `json:"maxSurge,omitempty"`
+	// MaxUnavailable is the maximum number of machines that can be unavailable during a rollout. It is an
+	// absolute number (e.g. 1) or a percentage (e.g. 25%).
+	// required: false
+	MaxUnavailable string `json:"maxUnavailable,omitempty"`
+	// AutoRepair configures the replacement of machines whose nodes stay NotReady. Set it to null to disable it.
+	// required: false
+	AutoRepair *NodeDeploymentAutoRepair `json:"autoRepair,omitempty"`
+}
+
+// NodeDeploymentAutoRepair defines the auto-repair settings of a node deployment.
+// swagger:model NodeDeploymentAutoRepair
+type NodeDeploymentAutoRepair struct {
+	// Enabled replaces machines whose nodes are NotReady for longer than the unhealthy timeout.
+	Enabled bool `json:"enabled"`
+	// UnhealthyTimeout is the duration a node has to be NotReady before its machine is replaced, e.g. 10m.
+	// It has to be at least 5m.
+	UnhealthyTimeout string `json:"unhealthyTimeout"`
 }
 
 // Event is a report of an event somewhere in the cluster.
@@ -2916,6 +3419,10 @@ type Event struct {
 
 	// The number of times this event has occurred.
 	Count int32 `json:"count,omitempty"`
+
+	// Component is the control plane component the event belongs to. It is only set for events from the
+	// namespace of the cluster on the seed.
+	Component string `json:"component,omitempty"`
 }
 
 // ObjectReferenceResource contains basic information about referred object.
@@ -3172,6 +3679,13 @@ type MeteringReportConfiguration struct {
 	Types     []string `json:"types"`
 }
 
+// MeteringReportConfigurationDeletion holds the result of removing a report configuration
+// swagger:model MeteringReportConfigurationDeletion
+type MeteringReportConfigurationDeletion struct {
+	// DeletedReports is the number of already generated reports removed from the object store.
+	DeletedReports int `json:"deletedReports"`
+}
+
 // ReportURL represent an S3 pre signed URL to download a report
 // swagger:model MeteringReportURL
 type ReportURL string
@@ -3179,3 +3693,14 @@ type ReportURL string
 // JoiningScript represent an encoded joining script for machines
 // swagger:model JoiningScript
 type JoiningScript string
+
+// JoiningScriptToken represents the data needed to join a machine to the cluster using custom tooling
+// swagger:model JoiningScriptToken
+type JoiningScriptToken struct {
+	// Token is the bearer token used to fetch the machine bootstrap configuration
+	Token string `json:"token"`
+	// CACertHash is the SHA-256 hash of the cluster CA public key in the "sha256:<hex>" format
+	CACertHash string `json:"caCertHash"`
+	// Server is the address of the user cluster API server
+	Server string `json:"server"`
+}
@@ -211,6 +211,14 @@ func TestHetznerNodeSpec_MarshalJSON(t *testing.T) {
 			},
 			"{\"network\":\"test\",\"type\":\"test-type\"}",
 		},
+		{
+			"case 3: should marshal the firewall when it is provided",
+			&apiv1.HetznerNodeSpec{
+				Type:     "test-type",
+				Firewall: "test-firewall",
+			},
+			"{\"firewall\":\"test-firewall\",\"type\":\"test-type\"}",
+		},
 	}
 
 	for _, c := range cases {
@@ -1007,3 +1015,36 @@ func TestAnexiaNodeSpec_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestOperatingSystemSpec_RedactSecrets(t *testing.T) {
+	t.Parallel()
+
+	spec := apiv1.OperatingSystemSpec{
+		RHEL: &apiv1.RHELSpec{
+			DistUpgradeOnBoot:               true,
+			RHELSubscriptionManagerUser:     "user",
+			RHELSubscriptionManagerPassword: "password",
+		},
+	}
+	spec.RedactSecrets()
+
+	marshalledBytes, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatalf("failed to marshal operating system spec: %v", err)
+	}
+	expected := `{"rhel":{"distUpgradeOnBoot":true,"rhelSubscriptionManagerUser":true,"rhelSubscriptionManagerPassword":true}}`
+	if string(marshalledBytes) != expected {
+		t.Fatalf("expected: %v,\nbut got: %v", expected, string(marshalledBytes))
+	}
+
+	var roundTripped apiv1.OperatingSystemSpec
+	if err := json.Unmarshal(marshalledBytes, &roundTripped); err != nil {
+		t.Fatalf("failed to unmarshal operating system spec: %v", err)
+	}
+	if !roundTripped.RHEL.RHELSubscriptionManagerPassword.IsRedacted() || roundTripped.RHEL.RHELSubscriptionManagerPassword.Value() != "" {
+		t.Errorf("expected the password to stay redacted without a value, got %q", roundTripped.RHEL.RHELSubscriptionManagerPassword)
+	}
+	if roundTripped.RHEL.RHSMOfflineToken != "" {
+		t.Errorf("expected the unset offline token to stay unset, got %q", roundTripped.RHEL.RHSMOfflineToken)
+	}
+}
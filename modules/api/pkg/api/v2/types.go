@@ -69,6 +69,49 @@ type Violation struct {
 	Namespace         string `json:"namespace,omitempty"`
 }
 
+// ClusterViolations is the summary of the gatekeeper constraint violations found by the audit in a cluster.
+// swagger:model ClusterViolations
+type ClusterViolations struct {
+	// TotalViolations is the number of violations of all constraints.
+	TotalViolations int64 `json:"totalViolations"`
+	// Kinds holds the violations grouped by constraint kind.
+	Kinds []ConstraintKindViolations `json:"kinds"`
+}
+
+// ClusterPermissions are the effective capabilities of the current user for a cluster.
+// swagger:model ClusterPermissions
+type ClusterPermissions struct {
+	// IsAdmin is true if the user is a global admin, which grants all capabilities.
+	IsAdmin bool `json:"isAdmin"`
+	// CanPatchCluster is true if the user is allowed to patch the cluster.
+	CanPatchCluster bool `json:"canPatchCluster"`
+	// CanDeleteCluster is true if the user is allowed to delete the cluster.
+	CanDeleteCluster bool `json:"canDeleteCluster"`
+	// CanManageMachineDeployments is true if the user is allowed to create, patch and delete machine deployments.
+	CanManageMachineDeployments bool `json:"canManageMachineDeployments"`
+	// CanManageSSHKeys is true if the user is allowed to assign and detach SSH keys.
+	CanManageSSHKeys bool `json:"canManageSSHKeys"`
+	// CanViewMetrics is true if the user is allowed to read the cluster and node metrics.
+	CanViewMetrics bool `json:"canViewMetrics"`
+}
+
+// ConstraintKindViolations holds the violations of all constraints of a kind.
+type ConstraintKindViolations struct {
+	Kind            string                 `json:"kind"`
+	TotalViolations int64                  `json:"totalViolations"`
+	Constraints     []ConstraintViolations `json:"constraints"`
+}
+
+// ConstraintViolations holds the violations of a constraint.
+type ConstraintViolations struct {
+	Name            string      `json:"name"`
+	AuditTimestamp  string      `json:"auditTimestamp,omitempty"`
+	TotalViolations int64       `json:"totalViolations"`
+	Violations      []Violation `json:"violations,omitempty"`
+	// Truncated is set if not all violations of the constraint are listed.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
 // GatekeeperConfig represents a gatekeeper config
 // swagger:model GatekeeperConfig
 type GatekeeperConfig struct {
@@ -139,6 +182,8 @@ type Preset struct {
 	Name      string           `json:"name"`
 	Enabled   bool             `json:"enabled"`
 	Providers []PresetProvider `json:"providers"`
+	// RequiredEmails are the emails and domains of the users who can use the preset.
+	RequiredEmails []string `json:"requiredEmails,omitempty"`
 }
 
 // PresetBody represents the body of a created preset
@@ -162,6 +207,11 @@ type PresetProvider struct {
 	IsCustomizable      bool                          `json:"isCustomizable"`
 	VMwareCloudDirector *VMwareCloudDirectorAPIPreset `json:"vmwareCloudDirector,omitempty"`
 	OpenStack           *OpenStackAPIPreset           `json:"openstack,omitempty"`
+	// Datacenter is the datacenter the provider configuration is limited to, empty if it applies to all datacenters.
+	Datacenter string `json:"datacenter,omitempty"`
+	// Fields reports which fields of the provider configuration, e.g. the credentials, are set. Their values are
+	// never returned.
+	Fields map[string]bool `json:"fields,omitempty"`
 }
 
 // VMwareCloudDirectorPreset represents a preset for VMware Cloud Director
@@ -272,6 +322,34 @@ type ClusterTemplateInstance struct {
 	Spec kubermaticv1.ClusterTemplateInstanceSpec `json:"spec"`
 }
 
+const (
+	// ClusterTemplateInstanceReplicaCreated means the cluster for the replica exists.
+	ClusterTemplateInstanceReplicaCreated = "Created"
+	// ClusterTemplateInstanceReplicaPending means the cluster for the replica is still being created.
+	ClusterTemplateInstanceReplicaPending = "Pending"
+	// ClusterTemplateInstanceReplicaFailed means the controller failed to create the cluster for the replica.
+	ClusterTemplateInstanceReplicaFailed = "Failed"
+)
+
+// ClusterTemplateInstanceStatus represents the creation state of the clusters of a ClusterTemplateInstance
+// swagger:model ClusterTemplateInstanceStatus
+type ClusterTemplateInstanceStatus struct {
+	Name     string                                 `json:"name"`
+	Replicas []ClusterTemplateInstanceReplicaStatus `json:"replicas"`
+}
+
+// ClusterTemplateInstanceReplicaStatus represents the creation state of a single replica
+// swagger:model ClusterTemplateInstanceReplicaStatus
+type ClusterTemplateInstanceReplicaStatus struct {
+	Index int `json:"index"`
+	// Phase is one of Created, Pending or Failed.
+	Phase string `json:"phase"`
+	// ClusterID is the ID of the created cluster.
+	ClusterID string `json:"clusterID,omitempty"`
+	// Error describes why the replica failed.
+	Error string `json:"error,omitempty"`
+}
+
 // RuleGroup represents a rule group of recording and alerting rules.
 // swagger:model RuleGroup
 type RuleGroup struct {
@@ -390,6 +468,32 @@ type EtcdBackupConfigSpec struct {
 	Destination string `json:"destination,omitempty"`
 }
 
+// EtcdBackupPendingPhase is the phase of a manually triggered etcd backup whose job has not been started yet.
+const EtcdBackupPendingPhase kubermaticv1.BackupStatusPhase = "Pending"
+
+// EtcdBackupTrigger represents a request to take an etcd backup of a cluster right away
+// swagger:model EtcdBackupTrigger
+type EtcdBackupTrigger struct {
+	// Destination indicates where the backup will be stored. The destination name should correspond to a destination in
+	// the cluster's Seed.Spec.EtcdBackupRestore.
+	Destination string `json:"destination"`
+}
+
+// EtcdBackupStatus represents the progress of a manually triggered etcd backup
+// swagger:model EtcdBackupStatus
+type EtcdBackupStatus struct {
+	// ID of the etcd backup config which takes the backup, used to poll the status
+	ID string `json:"id"`
+	// Phase is one of Pending, Running, Completed or Failed
+	Phase kubermaticv1.BackupStatusPhase `json:"phase"`
+	// swagger:strfmt date-time
+	StartTime *apiv1.Time `json:"startTime,omitempty"`
+	// swagger:strfmt date-time
+	CompletionTime *apiv1.Time `json:"completionTime,omitempty"`
+	// ErrorMessage is the message of the backup job if it failed
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
 // EtcdRestore represents an object holding the configuration for etcd backup restore
 // swagger:model EtcdRestore
 type EtcdRestore struct {
@@ -554,6 +658,32 @@ type ExternalClusterSpec struct {
 	AKSClusterSpec *AKSClusterSpec `json:"aksclusterSpec,omitempty"`
 }
 
+const (
+	// ExternalClusterCheckPassed means that the check succeeded.
+	ExternalClusterCheckPassed = "passed"
+	// ExternalClusterCheckFailed means that the check failed, the message tells why.
+	ExternalClusterCheckFailed = "failed"
+	// ExternalClusterCheckSkipped means that the check was not run, because a check it depends on failed.
+	ExternalClusterCheckSkipped = "skipped"
+)
+
+// ExternalClusterCheck is a single check of the connection to an external cluster.
+// swagger:model ExternalClusterCheck
+type ExternalClusterCheck struct {
+	Name string `json:"name"`
+	// Status is one of passed, failed and skipped.
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// ExternalClusterCheckReport is the result of the checks of an external cluster before it is imported.
+// swagger:model ExternalClusterCheckReport
+type ExternalClusterCheckReport struct {
+	// Valid is true if all checks passed.
+	Valid  bool                   `json:"valid"`
+	Checks []ExternalClusterCheck `json:"checks"`
+}
+
 // ExternalClusterCloudSpec represents an object holding cluster cloud details
 // swagger:model ExternalClusterCloudSpec
 type ExternalClusterCloudSpec struct {
@@ -1765,6 +1895,17 @@ type KubeVirtSubnet struct {
 // swagger:model KubeVirtSubnetList
 type KubeVirtSubnetList []KubeVirtSubnet
 
+// KubeVirtPriorityClass represents a Kubernetes PriorityClass of the KubeVirt infra cluster.
+// swagger:model KubeVirtPriorityClass
+type KubeVirtPriorityClass struct {
+	Name  string `json:"name"`
+	Value int32  `json:"value"`
+}
+
+// KubeVirtPriorityClassList represents an array of KubeVirt PriorityClasses.
+// swagger:model KubeVirtPriorityClassList
+type KubeVirtPriorityClassList []KubeVirtPriorityClass
+
 // StorageClassList represents a list of Kubernetes StorageClass.
 // swagger:model StorageClassList
 type StorageClassList []StorageClass
@@ -1786,6 +1927,23 @@ type CNIVersions struct {
 	Versions []string `json:"versions"`
 }
 
+// CNISettings represents the type and version of a CNI plugin.
+// swagger:model CNISettings
+type CNISettings struct {
+	Type    string `json:"type"`
+	Version string `json:"version"`
+}
+
+// ClusterCNISettings represents the CNI plugin settings of a cluster.
+// swagger:model ClusterCNISettings
+type ClusterCNISettings struct {
+	CNISettings `json:",inline"`
+	// ProxyMode is the kube-proxy mode used by the cluster.
+	ProxyMode string `json:"proxyMode,omitempty"`
+	// UpgradeCandidates are the CNI plugin types and versions the cluster can be changed to.
+	UpgradeCandidates []CNISettings `json:"upgradeCandidates"`
+}
+
 // NetworkDefaults contains cluster network default settings.
 // swagger:model NetworkDefaults
 type NetworkDefaults struct {
@@ -2060,6 +2218,31 @@ type ClusterServiceAccount struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+// KubeconfigShare is a read-only kubeconfig of a cluster. It authenticates as a dedicated service account, which
+// is bound to the view cluster role, and expires at the chosen time.
+// swagger:model KubeconfigShare
+type KubeconfigShare struct {
+	// ID of the share, it is the name of the service account in the kube-system namespace.
+	ID string `json:"id"`
+	// CreatedBy is the email of the user who created the share.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// CreationTimestamp is the time the share was created.
+	CreationTimestamp apiv1.Time `json:"creationTimestamp"`
+	// ExpiresAt is the time the token of the kubeconfig expires.
+	ExpiresAt apiv1.Time `json:"expiresAt"`
+	// Expired is true once the token of the kubeconfig has expired.
+	Expired bool `json:"expired"`
+	// Kubeconfig is only returned when the share is created, it can't be retrieved afterwards.
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+}
+
+// CreateKubeconfigShareBody is the request body to create a kubeconfig share.
+// swagger:model CreateKubeconfigShareBody
+type CreateKubeconfigShareBody struct {
+	// ExpiresAt is the time the token of the kubeconfig expires, at most 30 days in the future.
+	ExpiresAt apiv1.Time `json:"expiresAt"`
+}
+
 // Permission represents the permissions (i.e. role and clusterRole) associated to an object.
 // swagger:model Permission
 type Permission struct {
@@ -2155,6 +2338,34 @@ type SeedStatus struct {
 	Phase kubermaticv1.SeedPhase `json:"phase"`
 }
 
+// MachineDeploymentOptions are the global options of machine deployments. The size limits are not part of the
+// KubermaticSetting spec, they are stored in an annotation of it.
+// swagger:model MachineDeploymentOptions
+type MachineDeploymentOptions struct {
+	kubermaticv1.MachineDeploymentOptions `json:",inline"`
+	MachineDeploymentSizeLimits           `json:",inline"`
+}
+
+// MachineDeploymentSizeLimits are the global limits of the size of machine deployments. Zero means no limit.
+type MachineDeploymentSizeLimits struct {
+	// MaxReplicas is the maximum number of replicas of a machine deployment.
+	MaxReplicas int32 `json:"maxReplicas,omitempty"`
+	// DefaultReplicas is the number of replicas of new machine deployments which don't specify any.
+	DefaultReplicas int32 `json:"defaultReplicas,omitempty"`
+	// MaxAutoscalerMax is the maximum the autoscaler may scale a machine deployment to.
+	MaxAutoscalerMax int32 `json:"maxAutoscalerMax,omitempty"`
+}
+
+// ProjectNodeQuota limits the nodes and machine deployments of all clusters of a project. Zero means no limit.
+// swagger:model ProjectNodeQuota
+type ProjectNodeQuota struct {
+	// MaxNodes is the maximum number of nodes of the project. The autoscaler maximum of a machine deployment is
+	// counted if it is higher than its replicas.
+	MaxNodes int32 `json:"maxNodes,omitempty"`
+	// MaxMachineDeployments is the maximum number of machine deployments of the project.
+	MaxMachineDeployments int32 `json:"maxMachineDeployments,omitempty"`
+}
+
 // GlobalSettings defines global settings
 // swagger:model GlobalSettings
 type GlobalSettings struct {
@@ -2227,7 +2438,7 @@ type GlobalSettings struct {
 	DefaultProjectResourceQuota *ProjectResourceQuota `json:"defaultQuota,omitempty"`
 
 	// +optional
-	MachineDeploymentOptions kubermaticv1.MachineDeploymentOptions `json:"machineDeploymentOptions,omitempty"`
+	MachineDeploymentOptions MachineDeploymentOptions `json:"machineDeploymentOptions,omitempty"`
 
 	// AllowedOperatingSystems shows the available operating systems to use in the machine deployment.
 	AllowedOperatingSystems map[providerconfig.OperatingSystem]bool `json:"allowedOperatingSystems,omitempty"`
@@ -2247,6 +2458,33 @@ type GlobalSettings struct {
 	// ClusterBackupOptions are the settings for cluster backup functionality.
 	// +optional
 	ClusterBackupOptions *kubermaticv1.ClusterBackupOptions `json:"clusterBackupOptions,omitempty"`
+
+	// APIRateLimits are the limits of the requests per minute of the users of the API.
+	// +optional
+	APIRateLimits *APIRateLimits `json:"apiRateLimits,omitempty"`
+}
+
+// APIRateLimits are the limits of the requests per minute of each user and service account of the API. Zero means
+// no limit.
+type APIRateLimits struct {
+	// RequestsPerMinute is the default limit of all users.
+	RequestsPerMinute int `json:"requestsPerMinute,omitempty"`
+	// UserOverrides are the limits of single users by their email, they take precedence over the default limit. An
+	// override of zero exempts the user from the default limit.
+	UserOverrides map[string]int `json:"userOverrides,omitempty"`
+}
+
+// APIRateLimitBucket is the current rate limit bucket of a user of the API.
+// swagger:model APIRateLimitBucket
+type APIRateLimitBucket struct {
+	// User is the email of the user or service account.
+	User string `json:"user"`
+	// Limit is the number of requests per minute of the user, zero means no limit.
+	Limit int `json:"limit"`
+	// Remaining is the number of requests the user can still make in the current window.
+	Remaining int `json:"remaining"`
+	// Reset is the time at which the current window ends, it is not set if the user has no bucket.
+	Reset *apiv1.Time `json:"reset,omitempty"`
 }
 
 // ApplicationSettings defines common settings for applications
@@ -2295,6 +2533,525 @@ type ProjectClusterList struct {
 	ErrorMessage *string           `json:"errorMessage,omitempty"`
 }
 
+const (
+	// SeedCircuitBreakerClosed means the seed is reachable and connected to on every request.
+	SeedCircuitBreakerClosed = "closed"
+	// SeedCircuitBreakerOpen means the seed failed too often in a row and is skipped until the cooldown ends.
+	SeedCircuitBreakerOpen = "open"
+	// SeedCircuitBreakerHalfOpen means the cooldown ended and the next request probes the seed again.
+	SeedCircuitBreakerHalfOpen = "half-open"
+)
+
+// SeedCircuitBreaker is the state of the circuit breaker of a seed. Seeds are skipped when listing the clusters
+// of a project while their circuit breaker is open, instead of waiting for the connection to time out.
+// swagger:model SeedCircuitBreaker
+type SeedCircuitBreaker struct {
+	// Seed is the name of the seed.
+	Seed string `json:"seed"`
+	// State is one of closed, open or half-open.
+	State string `json:"state"`
+	// ConsecutiveFailures is the number of failed connection attempts since the last successful one.
+	ConsecutiveFailures int `json:"consecutiveFailures"`
+	// OpenUntil is the end of the cooldown, it is only set while the circuit breaker is open.
+	OpenUntil *apiv1.Time `json:"openUntil,omitempty"`
+	// LastError is the error of the last failed connection attempt.
+	LastError string `json:"lastError,omitempty"`
+}
+
+const (
+	// DatacenterConnected means the seed of the datacenter answered the health check.
+	DatacenterConnected = "connected"
+	// DatacenterUnreachable means the seed of the datacenter could not be reached.
+	DatacenterUnreachable = "unreachable"
+	// DatacenterInvalid means the seed of the datacenter is in the invalid phase.
+	DatacenterInvalid = "invalid"
+)
+
+// DatacenterHealth is the connectivity of the seed of a datacenter and the number of clusters it hosts. It helps
+// to pick a datacenter which can take new clusters.
+// swagger:model DatacenterHealth
+type DatacenterHealth struct {
+	// Datacenter is the name of the datacenter.
+	Datacenter string `json:"datacenter"`
+	// Provider is the cloud provider of the datacenter.
+	Provider string `json:"provider"`
+	// Seed is the name of the seed hosting the clusters of the datacenter.
+	Seed string `json:"seed"`
+	// Connectivity is one of connected, unreachable or invalid.
+	Connectivity string `json:"connectivity"`
+	// Clusters is the number of clusters in the datacenter. It is only set if the seed is connected.
+	Clusters *int `json:"clusters,omitempty"`
+	// MaxClusters is the number of clusters the datacenter should host at most, as configured by the admins.
+	MaxClusters *int `json:"maxClusters,omitempty"`
+	// Full is true if the datacenter hosts at least MaxClusters clusters.
+	Full bool `json:"full"`
+	// Error is the reason the seed is not connected. It is only returned to admins.
+	Error string `json:"error,omitempty"`
+}
+
+// DatacenterCapacity are the capacity hints of a datacenter, which are configured by the admins.
+// swagger:model DatacenterCapacity
+type DatacenterCapacity struct {
+	// MaxClusters is the number of clusters the datacenter should host at most. The datacenter is reported as full
+	// once it is reached, but new clusters are not rejected.
+	MaxClusters *int `json:"maxClusters,omitempty"`
+}
+
+const (
+	// ClusterUsageAvailable means that the usage was read from the user cluster.
+	ClusterUsageAvailable = "available"
+	// ClusterUsageUnknown means that the user cluster was not reachable, its usage is not part of the totals.
+	ClusterUsageUnknown = "unknown"
+)
+
+// ProjectUsage is the current resource usage of all clusters of a project.
+// An error message is added to the response in case when there was a problem with creating client for any of seeds.
+// swagger:model ProjectUsage
+type ProjectUsage struct {
+	// ClusterCount is the number of clusters of the project.
+	ClusterCount int `json:"clusterCount"`
+	// MachineDeploymentCount is the number of machine deployments of all reachable clusters.
+	MachineDeploymentCount int `json:"machineDeploymentCount"`
+	// Replicas is the number of desired replicas of all machine deployments of all reachable clusters.
+	Replicas int32 `json:"replicas"`
+	// NodeCount is the number of nodes of all reachable clusters.
+	NodeCount int `json:"nodeCount"`
+	// Capacity is the summed up capacity of the nodes of all reachable clusters.
+	Capacity Quota `json:"capacity"`
+	// Clusters is the usage of the individual clusters.
+	Clusters     []ClusterUsage `json:"clusters"`
+	ErrorMessage *string        `json:"errorMessage,omitempty"`
+}
+
+// ClusterUsage is the current resource usage of a cluster.
+// swagger:model ClusterUsage
+type ClusterUsage struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Status is either "available" or "unknown", if the user cluster was not reachable.
+	Status                 string `json:"status"`
+	MachineDeploymentCount int    `json:"machineDeploymentCount"`
+	Replicas               int32  `json:"replicas"`
+	NodeCount              int    `json:"nodeCount"`
+	Capacity               Quota  `json:"capacity"`
+}
+
+// ProjectMachineDeployment is a machine deployment of one of the clusters of a project. Seeds and clusters which could
+// not be reached are reported with an error instead of a machine deployment.
+// swagger:model ProjectMachineDeployment
+type ProjectMachineDeployment struct {
+	ClusterID   string `json:"clusterID,omitempty"`
+	ClusterName string `json:"clusterName,omitempty"`
+	Seed        string `json:"seed"`
+
+	MachineDeployment *apiv1.NodeDeployment `json:"machineDeployment,omitempty"`
+
+	// Error is set if the clusters of the seed or the machine deployments of the cluster could not be listed.
+	Error string `json:"error,omitempty"`
+}
+
+// AdminCluster is a cluster together with the ID of the project owning it.
+// swagger:model AdminCluster
+type AdminCluster struct {
+	apiv1.Cluster `json:",inline"`
+
+	// ProjectID is the ID of the project the cluster belongs to.
+	ProjectID string `json:"projectID"`
+}
+
+// AdminClusterList contains the clusters of all projects and an optional error message.
+// An error message is added to the response in case when there was a problem with creating client for any of seeds.
+// swagger:model AdminClusterList
+type AdminClusterList struct {
+	Clusters     []AdminCluster `json:"clusters"`
+	ErrorMessage *string        `json:"errorMessage,omitempty"`
+}
+
+// ClusterDeletionPreview lists what would be cleaned up when deleting a cluster with the given DeleteVolumes and
+// DeleteLoadBalancers headers.
+// swagger:model ClusterDeletionPreview
+type ClusterDeletionPreview struct {
+	MachineDeploymentCount int `json:"machineDeploymentCount"`
+	NodeCount              int `json:"nodeCount"`
+	PersistentVolumeCount  int `json:"persistentVolumeCount"`
+	// PersistentVolumeSize is the summed up capacity of all persistent volumes. Denoted in GB, rounded to 2 decimal places.
+	PersistentVolumeSize float64 `json:"persistentVolumeSize"`
+	// LoadBalancers are the namespaced names of the services of type LoadBalancer.
+	LoadBalancers []string `json:"loadBalancers"`
+	// VolumesOrphaned is true if the persistent volumes would be left behind at the cloud provider.
+	VolumesOrphaned bool `json:"volumesOrphaned"`
+	// LoadBalancersOrphaned is true if the load balancers would be left behind at the cloud provider.
+	LoadBalancersOrphaned bool `json:"loadBalancersOrphaned"`
+}
+
+// ClusterPatchPreview lists the fields of a cluster which a patch would change.
+// swagger:model ClusterPatchPreview
+type ClusterPatchPreview struct {
+	Changes []ClusterFieldChange `json:"changes"`
+}
+
+// ClusterFieldChange is a field of a cluster which is changed by a patch. Cloud provider credentials are redacted.
+// swagger:model ClusterFieldChange
+type ClusterFieldChange struct {
+	// Path of the field as JSON pointer, e.g. "/spec/version".
+	Path string `json:"path"`
+	// Old is the current value of the field. It is left out if the field is added.
+	Old interface{} `json:"old,omitempty"`
+	// New is the value of the field after the patch. It is left out if the field is removed.
+	New interface{} `json:"new,omitempty"`
+}
+
+// SearchResult contains the projects, clusters and machine deployments matching a search query.
+// An error message is added to the response in case when there was a problem with creating client for any of seeds.
+// swagger:model SearchResult
+type SearchResult struct {
+	Projects           []SearchResultProject           `json:"projects"`
+	Clusters           []SearchResultCluster           `json:"clusters"`
+	MachineDeployments []SearchResultMachineDeployment `json:"machineDeployments"`
+	ErrorMessage       *string                         `json:"errorMessage,omitempty"`
+}
+
+// SearchResultProject is a project matching a search query.
+// swagger:model SearchResultProject
+type SearchResultProject struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// SearchResultCluster is a cluster matching a search query.
+// swagger:model SearchResultCluster
+type SearchResultCluster struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	ProjectID string `json:"projectID"`
+	// Seed is the name of the seed the cluster is running on.
+	Seed string `json:"seed"`
+}
+
+// SearchResultMachineDeployment is a machine deployment matching a search query.
+// swagger:model SearchResultMachineDeployment
+type SearchResultMachineDeployment struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	ProjectID string `json:"projectID"`
+	ClusterID string `json:"clusterID"`
+	// Seed is the name of the seed the cluster of the machine deployment is running on.
+	Seed string `json:"seed"`
+}
+
+const (
+	ProviderQuotaInstances       = "instances"
+	ProviderQuotaVCPUs           = "vcpus"
+	ProviderQuotaVolumes         = "volumes"
+	ProviderQuotaVolumeGigabytes = "volumeGigabytes"
+	ProviderQuotaFloatingIPs     = "floatingIPs"
+)
+
+// ConsoleLog is the tail of the console log of a machine's instance.
+// swagger:response ConsoleLog
+type ConsoleLog struct {
+	// in: body
+	Log []byte
+}
+
+// ProviderQuota represents the usage and the limits of the cloud provider resources available to a cluster.
+// swagger:model ProviderQuota
+type ProviderQuota struct {
+	// Provider is the name of the cloud provider.
+	Provider string `json:"provider"`
+	// Region is the region or location the quota applies to.
+	Region    string                  `json:"region,omitempty"`
+	Resources []ProviderQuotaResource `json:"resources"`
+}
+
+// ProviderQuotaResource represents the usage and the limit of a single cloud provider resource.
+// swagger:model ProviderQuotaResource
+type ProviderQuotaResource struct {
+	// Name is the name of the resource, one of: instances, vcpus, volumes, volumeGigabytes, floatingIPs.
+	Name string `json:"name"`
+	Used int64  `json:"used"`
+	// Limit is the maximum amount of the resource. It is empty if the resource is unlimited or the provider doesn't expose the limit.
+	Limit *int64 `json:"limit,omitempty"`
+}
+
+// NodeExportRow is a single row of the cluster nodes export.
+// swagger:model NodeExportRow
+type NodeExportRow struct {
+	Name              string `json:"name"`
+	MachineDeployment string `json:"machineDeployment,omitempty"`
+	CloudProvider     string `json:"cloudProvider,omitempty"`
+	// InstanceSize is the instance type, flavor or CPU and memory configuration of the node.
+	InstanceSize      string     `json:"instanceSize,omitempty"`
+	KubeletVersion    string     `json:"kubeletVersion,omitempty"`
+	CreationTimestamp apiv1.Time `json:"creationTimestamp"`
+	// CPUCapacityMillicores is the CPU capacity of the node in millicores.
+	CPUCapacityMillicores int64 `json:"cpuCapacityMillicores"`
+	// MemoryCapacityBytes is the memory capacity of the node in bytes.
+	MemoryCapacityBytes int64 `json:"memoryCapacityBytes"`
+	// CPUUsageMillicores is the current CPU usage of the node in millicores. It is empty if no metrics are available.
+	CPUUsageMillicores *int64 `json:"cpuUsageMillicores,omitempty"`
+	// MemoryUsageBytes is the current memory usage of the node in bytes. It is empty if no metrics are available.
+	MemoryUsageBytes *int64 `json:"memoryUsageBytes,omitempty"`
+}
+
+// MachineDeploymentManifest is a machine deployment of the machine deployments export. It only contains the
+// fields which are needed to create the machine deployment again, e.g. in a restored cluster.
+// swagger:model MachineDeploymentManifest
+type MachineDeploymentManifest struct {
+	Name        string                   `json:"name"`
+	Annotations map[string]string        `json:"annotations,omitempty"`
+	Spec        apiv1.NodeDeploymentSpec `json:"spec"`
+}
+
+const (
+	// MachineDeploymentImportCreated means that the machine deployment was created.
+	MachineDeploymentImportCreated = "created"
+	// MachineDeploymentImportUpdated means that the existing machine deployment was overwritten.
+	MachineDeploymentImportUpdated = "updated"
+	// MachineDeploymentImportSkipped means that the machine deployment already exists and was left untouched.
+	MachineDeploymentImportSkipped = "skipped"
+	// MachineDeploymentImportFailed means that the machine deployment could not be imported.
+	MachineDeploymentImportFailed = "failed"
+)
+
+// MachineDeploymentImportResult is the result of the import of a single machine deployment.
+// swagger:model MachineDeploymentImportResult
+type MachineDeploymentImportResult struct {
+	Name string `json:"name"`
+	// Status is one of created, updated, skipped and failed.
+	Status string `json:"status"`
+	// Error tells why the import of the machine deployment failed.
+	Error string `json:"error,omitempty"`
+}
+
+// MachineDeploymentImportResultList represents a list of machine deployment import results.
+// swagger:model MachineDeploymentImportResultList
+type MachineDeploymentImportResultList []MachineDeploymentImportResult
+
+// MachineDeploymentRevision is a revision of a machine deployment, i.e. one of the machine sets it owns.
+// swagger:model MachineDeploymentRevision
+type MachineDeploymentRevision struct {
+	Revision          int64      `json:"revision"`
+	MachineSetName    string     `json:"machineSetName"`
+	CreationTimestamp apiv1.Time `json:"creationTimestamp"`
+	Replicas          int32      `json:"replicas"`
+	ReadyReplicas     int32      `json:"readyReplicas"`
+	AvailableReplicas int32      `json:"availableReplicas"`
+	// Current is true if the template of the revision is the template of the machine deployment.
+	Current bool `json:"current"`
+	// Changes are the fields of the node template which differ between the revision and the current spec of the
+	// machine deployment, e.g. "versions.kubelet: v1.28.4 -> v1.29.1".
+	Changes []string `json:"changes,omitempty"`
+}
+
+// MachineDeploymentRevisionList represents a list of machine deployment revisions.
+// swagger:model MachineDeploymentRevisionList
+type MachineDeploymentRevisionList []MachineDeploymentRevision
+
+// The types of the events sent by the machine deployment websocket.
+const (
+	MachineDeploymentWatchEventSync     = "SYNC"
+	MachineDeploymentWatchEventAdded    = "ADDED"
+	MachineDeploymentWatchEventModified = "MODIFIED"
+	MachineDeploymentWatchEventDeleted  = "DELETED"
+)
+
+// MachineDeploymentWatchEvent is sent by the machine deployment websocket. The SYNC event carries all machine
+// deployments of the cluster, every other event a single changed machine deployment or node.
+type MachineDeploymentWatchEvent struct {
+	Type            string                  `json:"type"`
+	NodeDeployments []*apiv1.NodeDeployment `json:"nodeDeployments,omitempty"`
+	NodeDeployment  *apiv1.NodeDeployment   `json:"nodeDeployment,omitempty"`
+	// Node is a node of the machine deployment with the ID MachineDeploymentID.
+	Node                *apiv1.Node `json:"node,omitempty"`
+	MachineDeploymentID string      `json:"machineDeploymentID,omitempty"`
+}
+
+// MachineDeploymentCostEstimate is the approximate monthly cost of a machine deployment. The costs are left out if
+// the price of the size is unknown, the warnings tell why.
+// swagger:model MachineDeploymentCostEstimate
+type MachineDeploymentCostEstimate struct {
+	Provider string `json:"provider"`
+	Region   string `json:"region,omitempty"`
+	Size     string `json:"size,omitempty"`
+	// Currency of the costs, e.g. USD.
+	Currency string `json:"currency,omitempty"`
+	// HourlyPrice is the price of a single node per hour.
+	HourlyPrice *float64 `json:"hourlyPrice,omitempty"`
+	// MinReplicas and MaxReplicas are the same unless the machine deployment is autoscaled.
+	MinReplicas int32 `json:"minReplicas"`
+	MaxReplicas int32 `json:"maxReplicas"`
+	// MinMonthlyCost is the cost of the machine deployment per month with MinReplicas nodes.
+	MinMonthlyCost *float64 `json:"minMonthlyCost,omitempty"`
+	// MaxMonthlyCost is the cost of the machine deployment per month with MaxReplicas nodes.
+	MaxMonthlyCost *float64 `json:"maxMonthlyCost,omitempty"`
+	// Assumptions the estimate is based on, e.g. the hours of a month.
+	Assumptions []string `json:"assumptions,omitempty"`
+	Warnings    []string `json:"warnings,omitempty"`
+}
+
+// NodePod is a pod which is scheduled on a node of a user cluster.
+// swagger:model NodePod
+type NodePod struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Phase     string `json:"phase"`
+	// Restarts is the sum of the restarts of all containers of the pod.
+	Restarts int32 `json:"restarts"`
+	// CPURequestMillicores is the CPU requested by the containers of the pod in millicores.
+	CPURequestMillicores int64 `json:"cpuRequestMillicores"`
+	// MemoryRequestBytes is the memory requested by the containers of the pod in bytes.
+	MemoryRequestBytes int64 `json:"memoryRequestBytes"`
+}
+
+// NodePodList represents a list of the pods of a node.
+// swagger:model NodePodList
+type NodePodList []NodePod
+
+// ClusterUpgradePlan describes a possible control plane upgrade of a cluster together with the machine deployments
+// which have to be upgraded first, because their kubelet would not be compatible with the new control plane.
+// swagger:model ClusterUpgradePlan
+type ClusterUpgradePlan struct {
+	Version                        ksemver.Semver             `json:"version"`
+	IncompatibleMachineDeployments []MachineDeploymentUpgrade `json:"incompatibleMachineDeployments,omitempty"`
+}
+
+// MachineDeploymentUpgrade describes a machine deployment which has to be upgraded before the control plane.
+// swagger:model MachineDeploymentUpgrade
+type MachineDeploymentUpgrade struct {
+	Name           string `json:"name"`
+	KubeletVersion string `json:"kubeletVersion"`
+	// RequiredKubeletVersion is the kubelet version the machine deployment has to be upgraded to before the
+	// control plane is upgraded. It is empty if no kubelet version is compatible with both the current and the
+	// new control plane version.
+	RequiredKubeletVersion string `json:"requiredKubeletVersion,omitempty"`
+}
+
+const (
+	// MachineDeploymentKubeletCompatible means that the kubelet was left as it is compatible with the control plane.
+	MachineDeploymentKubeletCompatible = "compatible"
+	// MachineDeploymentKubeletUpgraded means that the kubelet was upgraded to the control plane version.
+	MachineDeploymentKubeletUpgraded = "upgraded"
+	// MachineDeploymentKubeletSkipped means that the machine deployment is paused and was left as it is.
+	MachineDeploymentKubeletSkipped = "skipped"
+	// MachineDeploymentKubeletFailed means that the kubelet could not be upgraded.
+	MachineDeploymentKubeletFailed = "failed"
+)
+
+// MachineDeploymentKubeletUpgrade is the result of upgrading the kubelet of a machine deployment together with the
+// control plane.
+// swagger:model MachineDeploymentKubeletUpgrade
+type MachineDeploymentKubeletUpgrade struct {
+	Name string `json:"name"`
+	// KubeletVersion is the kubelet version of the machine deployment before the upgrade.
+	KubeletVersion string `json:"kubeletVersion"`
+	// Status is one of compatible, upgraded, skipped and failed.
+	Status string `json:"status"`
+	// Message tells why the machine deployment was skipped or could not be upgraded.
+	Message string `json:"message,omitempty"`
+}
+
+// PatchedCluster is a patched cluster together with the results of upgrading the kubelets of its machine
+// deployments.
+// swagger:model PatchedCluster
+type PatchedCluster struct {
+	apiv1.Cluster `json:",inline"`
+
+	// MachineDeploymentUpgrades is only set if the machine deployments were upgraded together with the control plane.
+	MachineDeploymentUpgrades []MachineDeploymentKubeletUpgrade `json:"machineDeploymentUpgrades,omitempty"`
+}
+
+const (
+	// UpgradePreflightPass means that the check found nothing which would affect the upgrade.
+	UpgradePreflightPass = "pass"
+	// UpgradePreflightWarn means that the upgrade is possible, but the details should be reviewed first.
+	UpgradePreflightWarn = "warn"
+	// UpgradePreflightFail means that the upgrade is expected to fail or to break workloads.
+	UpgradePreflightFail = "fail"
+)
+
+// UpgradePreflightCheck is a single check which is run before the control plane of a cluster is upgraded.
+// swagger:model UpgradePreflightCheck
+type UpgradePreflightCheck struct {
+	Name string `json:"name"`
+	// Status is one of pass, warn and fail.
+	Status  string   `json:"status"`
+	Message string   `json:"message"`
+	Details []string `json:"details,omitempty"`
+}
+
+// UpgradePreflightReport is the result of the checks which are run before the control plane of a cluster is
+// upgraded to a version.
+// swagger:model UpgradePreflightReport
+type UpgradePreflightReport struct {
+	Version ksemver.Semver `json:"version"`
+	// Status is the worst status of the checks.
+	Status string                  `json:"status"`
+	Checks []UpgradePreflightCheck `json:"checks"`
+}
+
+const (
+	// ClusterAdvisoryInfo means that nothing has to be done now.
+	ClusterAdvisoryInfo = "info"
+	// ClusterAdvisoryWarning means that the cluster should be taken care of soon.
+	ClusterAdvisoryWarning = "warning"
+	// ClusterAdvisoryCritical means that the cluster is affected already.
+	ClusterAdvisoryCritical = "critical"
+)
+
+// ClusterAdvisory is an issue of a cluster together with a hint how to remediate it.
+// swagger:model ClusterAdvisory
+type ClusterAdvisory struct {
+	// ID identifies the advisory, it stays the same as long as the cause of the advisory does not change.
+	ID string `json:"id"`
+	// Severity is one of info, warning and critical.
+	Severity     string                         `json:"severity"`
+	Message      string                         `json:"message"`
+	Remediation  string                         `json:"remediation,omitempty"`
+	Acknowledged *ClusterAdvisoryAcknowledgment `json:"acknowledged,omitempty"`
+}
+
+// ClusterAdvisoryAcknowledgment tells who acknowledged an advisory and when.
+// swagger:model ClusterAdvisoryAcknowledgment
+type ClusterAdvisoryAcknowledgment struct {
+	By string     `json:"by"`
+	At apiv1.Time `json:"at"`
+}
+
+// ClusterCRD is a custom resource definition installed in a user cluster.
+// swagger:model ClusterCRD
+type ClusterCRD struct {
+	Name  string `json:"name"`
+	Group string `json:"group"`
+	Kind  string `json:"kind"`
+	// Versions are the served versions of the definition.
+	Versions []string `json:"versions"`
+	// Scope is either Namespaced or Cluster.
+	Scope       string `json:"scope"`
+	Established bool   `json:"established"`
+	// Instances is the number of custom resources of the definition. It is only set when the instances were
+	// requested and could be counted in time.
+	Instances *int `json:"instances,omitempty"`
+}
+
+// ProjectWebhook is a webhook which is notified about the lifecycle events of the clusters in a project.
+// swagger:model ProjectWebhook
+type ProjectWebhook struct {
+	ID string `json:"id,omitempty"`
+	// URL receives the events as HTTP POST requests.
+	URL string `json:"url"`
+	// Secret is used to sign the requests with HMAC-SHA256. The signature is sent in the X-Kubermatic-Signature
+	// header. It is required on creation, kept when it is empty on update and never returned.
+	Secret string `json:"secret,omitempty"`
+	// EventTypes are the events the webhook is subscribed to: cluster.created, cluster.deleted and cluster.unhealthy.
+	EventTypes        []string   `json:"eventTypes"`
+	CreationTimestamp apiv1.Time `json:"creationTimestamp,omitempty"`
+}
+
+// ProjectWebhookList represents a list of project webhooks.
+// swagger:model ProjectWebhookList
+type ProjectWebhookList []ProjectWebhook
+
 // ClusterBackupStorageLocation is the object representing a Cluster Backup Storage Location.
 // swagger:model ClusterBackupStorageLocation
 type ClusterBackupStorageLocation struct {
@@ -2362,3 +3119,57 @@ type BackupStorageLocationBucketObject struct {
 // BackupStorageLocationBucketObjectList represents an array of Backup Storage Location Bucket Objects.
 // swagger:model BackupStorageLocationBucketObjectList
 type BackupStorageLocationBucketObjectList []BackupStorageLocationBucketObject
+
+// InstanceTypeFilter restricts the instance types which can be used by machine deployments in a datacenter.
+// swagger:model InstanceTypeFilter
+type InstanceTypeFilter struct {
+	// Allowed instance types. If set, no other instance types can be used.
+	Allowed []string `json:"allowed,omitempty"`
+	// Denied instance types. They can not be used, even if they are allowed.
+	Denied []string `json:"denied,omitempty"`
+}
+
+// SSHUserNames maps the operating systems to the SSH login user of their nodes on a cloud provider. The value is
+// "unknown" if the cloud provider has no default image for the operating system.
+// swagger:model SSHUserNames
+type SSHUserNames map[string]string
+
+// AddonSchema is the JSON schema of the variables of an addon. It is derived from the form controls of the
+// AddonConfig of the addon. Addons without form controls accept any variables.
+// swagger:model AddonSchema
+type AddonSchema struct {
+	// Name of the addon.
+	Name string `json:"name"`
+	// Type is always "object".
+	Type string `json:"type"`
+	// Properties are the variables of the addon.
+	Properties map[string]AddonSchemaProperty `json:"properties,omitempty"`
+	// Required are the names of the variables which have to be set.
+	Required []string `json:"required,omitempty"`
+	// AdditionalProperties is true if variables which are not in the properties are accepted.
+	AdditionalProperties bool `json:"additionalProperties"`
+}
+
+// AddonSchemaProperty is the JSON schema of a variable of an addon.
+// swagger:model AddonSchemaProperty
+type AddonSchemaProperty struct {
+	// Type is one of string, number or boolean. It is empty if the form control type is unknown, then any value is
+	// accepted.
+	Type string `json:"type,omitempty"`
+	// Title is the display name of the form control.
+	Title string `json:"title,omitempty"`
+	// Description is the help text of the form control.
+	Description string `json:"description,omitempty"`
+	// FormControl is the type of the form control, e.g. text-area.
+	FormControl string `json:"formControl,omitempty"`
+}
+
+// MachineDeploymentDeletion is the result of a graceful deletion of a machine deployment.
+// swagger:model MachineDeploymentDeletion
+type MachineDeploymentDeletion struct {
+	// TimeoutExceeded is true if the machines were not removed within the timeout and the machine deployment was
+	// deleted anyway.
+	TimeoutExceeded bool `json:"timeoutExceeded"`
+	// RemainingReplicas is the number of machines which were left when the machine deployment was deleted.
+	RemainingReplicas int32 `json:"remainingReplicas"`
+}
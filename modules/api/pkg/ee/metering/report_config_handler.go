@@ -43,6 +43,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/util/sets"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -60,6 +61,10 @@ type deleteMeteringReportConfig struct {
 	// in: path
 	// required: true
 	Name string `json:"name"`
+
+	// Purge also removes the reports generated for the configuration from the object store.
+	// in: query
+	Purge bool `json:"purge,omitempty"`
 }
 
 // swagger:parameters createMeteringReportConfiguration
@@ -68,6 +73,10 @@ type createReportConfigurationReq struct {
 	// required: true
 	Name string `json:"name"`
 
+	// DryRun runs the validation and returns the resulting configuration without persisting it.
+	// in: query
+	DryRun bool `json:"dry_run,omitempty"`
+
 	// in: body
 	Body struct {
 		Schedule  string    `json:"schedule"`
@@ -118,6 +127,10 @@ type updateReportConfigurationReq struct {
 	// required: true
 	Name string `json:"name"`
 
+	// DryRun runs the validation and returns the resulting configuration without persisting it.
+	// in: query
+	DryRun bool `json:"dry_run,omitempty"`
+
 	// in: body
 	Body struct {
 		Schedule  string    `json:"schedule,omitempty"`
@@ -187,6 +200,8 @@ func DecodeCreateMeteringReportConfigurationReq(r *http.Request) (interface{}, e
 		return nil, utilerrors.NewBadRequest("`name` cannot be empty")
 	}
 
+	req.DryRun = strings.EqualFold(r.URL.Query().Get("dry_run"), "true")
+
 	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
 		return nil, utilerrors.NewBadRequest("%v", err)
 	}
@@ -203,6 +218,8 @@ func DecodeUpdateMeteringReportConfigurationReq(r *http.Request) (interface{}, e
 		return nil, utilerrors.NewBadRequest("`name` cannot be empty")
 	}
 
+	req.DryRun = strings.EqualFold(r.URL.Query().Get("dry_run"), "true")
+
 	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
 		return nil, utilerrors.NewBadRequest("%v", err)
 	}
@@ -219,6 +236,8 @@ func DecodeDeleteMeteringReportConfigurationReq(r *http.Request) (interface{}, e
 		return nil, utilerrors.NewBadRequest("`name` cannot be empty")
 	}
 
+	req.Purge = strings.EqualFold(r.URL.Query().Get("purge"), "true")
+
 	return req, nil
 }
 
@@ -348,25 +367,36 @@ func UpdateMeteringReportConfiguration(ctx context.Context, request interface{},
 }
 
 // DeleteMeteringReportConfiguration removes metering report configuration from the existing map.
+// If requested, the reports already generated for the configuration are removed from the object store as well.
 func DeleteMeteringReportConfiguration(ctx context.Context, request interface{}, seedsGetter provider.SeedsGetter,
-	masterClient ctrlruntimeclient.Client) error {
+	seedClientGetter provider.SeedClientGetter, masterClient ctrlruntimeclient.Client) (*apiv1.MeteringReportConfigurationDeletion, error) {
 	req, ok := request.(deleteMeteringReportConfig)
 	if !ok {
-		return utilerrors.NewBadRequest("invalid request")
+		return nil, utilerrors.NewBadRequest("invalid request")
 	}
 
 	seeds, err := seedsGetter()
 	if err != nil {
-		return fmt.Errorf("failed listing seeds: %w", err)
+		return nil, fmt.Errorf("failed listing seeds: %w", err)
+	}
+
+	result := &apiv1.MeteringReportConfigurationDeletion{}
+
+	// Reports are purged before the configuration is removed, so a failed purge can be retried.
+	if req.Purge {
+		result.DeletedReports, err = purgeReports(ctx, req.Name, seeds, seedClientGetter)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	for _, seed := range seeds {
 		if err := deleteMeteringReportConfiguration(ctx, req.Name, seed, masterClient); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
 func createMeteringReportConfiguration(ctx context.Context, reportCfgReq createReportConfigurationReq,
@@ -390,24 +420,25 @@ func createMeteringReportConfiguration(ctx context.Context, reportCfgReq createR
 		reportCfgReq.Body.Types = &defaultTypes
 	}
 
+	if duplicate := findDuplicateReportConfiguration(seed.Spec.Metering.ReportConfigurations, reportCfgReq.Name, reportCfgReq.Body.Schedule, *reportCfgReq.Body.Types); duplicate != "" {
+		return nil, utilerrors.New(
+			http.StatusConflict,
+			fmt.Sprintf("report configuration %q has the same schedule and types", duplicate))
+	}
+
+	var retention *uint32
 	if reportCfgReq.Body.Retention != nil {
-		retention := uint32(*reportCfgReq.Body.Retention)
-		seed.Spec.Metering.ReportConfigurations[reportCfgReq.Name] = kubermaticv1.MeteringReportConfiguration{
-			Interval:  uint32(reportCfgReq.Body.Interval),
-			Schedule:  reportCfgReq.Body.Schedule,
-			Retention: &retention,
-			Types:     *reportCfgReq.Body.Types,
-		}
-	} else {
-		seed.Spec.Metering.ReportConfigurations[reportCfgReq.Name] = kubermaticv1.MeteringReportConfiguration{
-			Interval:  uint32(reportCfgReq.Body.Interval),
-			Schedule:  reportCfgReq.Body.Schedule,
-			Retention: nil,
-			Types:     *reportCfgReq.Body.Types,
-		}
+		retention = ptr.To(uint32(*reportCfgReq.Body.Retention))
 	}
 
-	if err := masterClient.Update(ctx, seed); err != nil {
+	seed.Spec.Metering.ReportConfigurations[reportCfgReq.Name] = kubermaticv1.MeteringReportConfiguration{
+		Interval:  uint32(reportCfgReq.Body.Interval),
+		Schedule:  reportCfgReq.Body.Schedule,
+		Retention: retention,
+		Types:     *reportCfgReq.Body.Types,
+	}
+
+	if err := masterClient.Update(ctx, seed, updateOptions(reportCfgReq.DryRun)...); err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
 
@@ -443,19 +474,25 @@ func updateMeteringReportConfiguration(ctx context.Context, reportCfgReq updateR
 		reportConfiguration.Interval = uint32(*reportCfgReq.Body.Interval)
 	}
 
+	if reportCfgReq.Body.Types != nil && len(*reportCfgReq.Body.Types) > 0 {
+		reportConfiguration.Types = *reportCfgReq.Body.Types
+	}
+
 	if reportCfgReq.Body.Retention == nil {
 		reportConfiguration.Retention = nil
-	} else if *reportCfgReq.Body.Retention >= 1 {
-		retention := uint32(*reportCfgReq.Body.Retention)
-		reportConfiguration.Retention = &retention
+	} else {
+		reportConfiguration.Retention = ptr.To(uint32(*reportCfgReq.Body.Retention))
 	}
 
-	if reportCfgReq.Body.Types != nil && len(*reportCfgReq.Body.Types) > 0 {
-		reportConfiguration.Types = *reportCfgReq.Body.Types
+	if duplicate := findDuplicateReportConfiguration(seed.Spec.Metering.ReportConfigurations, reportCfgReq.Name, reportConfiguration.Schedule, reportConfiguration.Types); duplicate != "" {
+		return nil, utilerrors.New(
+			http.StatusConflict,
+			fmt.Sprintf("report configuration %q has the same schedule and types", duplicate))
 	}
 
 	seed.Spec.Metering.ReportConfigurations[reportCfgReq.Name] = reportConfiguration
-	if err := masterClient.Update(ctx, seed); err != nil {
+
+	if err := masterClient.Update(ctx, seed, updateOptions(reportCfgReq.DryRun)...); err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
 
@@ -469,6 +506,30 @@ func updateMeteringReportConfiguration(ctx context.Context, reportCfgReq updateR
 	}, nil
 }
 
+// updateOptions returns the options for updating the Seed. A dry run still sends the update, so that it is
+// validated and admitted by the API server like a real one, without persisting it.
+func updateOptions(dryRun bool) []ctrlruntimeclient.UpdateOption {
+	if dryRun {
+		return []ctrlruntimeclient.UpdateOption{ctrlruntimeclient.DryRunAll}
+	}
+	return nil
+}
+
+// findDuplicateReportConfiguration returns the name of a report configuration, other than the given one,
+// that has exactly the same schedule and report types. Such configurations would produce duplicate reports.
+func findDuplicateReportConfiguration(reportConfigs map[string]kubermaticv1.MeteringReportConfiguration, name, schedule string, types []string) string {
+	for existingName, existing := range reportConfigs {
+		if existingName == name {
+			continue
+		}
+		if existing.Schedule == schedule && sets.New(existing.Types...).Equal(sets.New(types...)) {
+			return existingName
+		}
+	}
+
+	return ""
+}
+
 func deleteMeteringReportConfiguration(ctx context.Context, reportConfigName string, seed *kubermaticv1.Seed, masterClient ctrlruntimeclient.Client) error {
 	if seed.Spec.Metering == nil || seed.Spec.Metering.ReportConfigurations == nil {
 		return fmt.Errorf("metering report configuration map for %q does not exist", seed.Name)
@@ -480,6 +541,7 @@ func deleteMeteringReportConfiguration(ctx context.Context, reportConfigName str
 	}
 
 	delete(seed.Spec.Metering.ReportConfigurations, reportConfigName)
+
 	if err := masterClient.Update(ctx, seed); err != nil {
 		return common.KubernetesErrorToHTTPError(err)
 	}
@@ -25,9 +25,11 @@
 package metering_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -37,6 +39,7 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -161,6 +164,7 @@ func TestCreateMeteringReportConfigEndpoint(t *testing.T) {
 	testcases := []struct {
 		name                   string
 		reportName             string
+		dryRun                 bool
 		body                   string
 		existingKubermaticObjs []ctrlruntimeclient.Object
 		existingAPIUser        *apiv1.User
@@ -274,6 +278,35 @@ func TestCreateMeteringReportConfigEndpoint(t *testing.T) {
 			httpStatus:             http.StatusBadRequest,
 			expectedResponse:       `{"error":{"code":400,"message":"invalid metering type: invalid_type"}}`,
 		},
+		// scenario 9
+		{
+			name:       "Create new metering report configuration. Same schedule and types as existing one.",
+			reportName: "weekly-copy",
+			body: `{
+				"interval": 14,
+				"schedule": "0 1 * * 6",
+				"types": ["namespace","cluster"]
+			}`,
+			existingKubermaticObjs: []ctrlruntimeclient.Object{testSeed},
+			existingAPIUser:        test.GenDefaultAdminAPIUser(),
+			httpStatus:             http.StatusConflict,
+			expectedResponse:       `{"error":{"code":409,"message":"report configuration \"weekly\" has the same schedule and types"}}`,
+		},
+		// scenario 10
+		{
+			name:       "Create new metering report configuration. Dry run.",
+			reportName: "monthly",
+			dryRun:     true,
+			body: `{
+				"interval": 30,
+				"schedule": "1 1 1 * *",
+				"retention": 60
+			}`,
+			existingKubermaticObjs: []ctrlruntimeclient.Object{testSeed},
+			existingAPIUser:        test.GenDefaultAdminAPIUser(),
+			httpStatus:             http.StatusCreated,
+			expectedResponse:       `{"name":"monthly","schedule":"1 1 1 * *","interval":30,"retention":60,"types":["cluster","namespace"]}`,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -282,10 +315,13 @@ func TestCreateMeteringReportConfigEndpoint(t *testing.T) {
 			if tc.reportName != "" {
 				reqURL += "/" + tc.reportName
 			}
+			if tc.dryRun {
+				reqURL += "?dry_run=true"
+			}
 			req := httptest.NewRequest(http.MethodPost, reqURL, strings.NewReader(tc.body))
 			res := httptest.NewRecorder()
 
-			router, err := test.CreateTestEndpoint(*tc.existingAPIUser, nil, tc.existingKubermaticObjs, nil, hack.NewTestRouting)
+			router, clients, err := test.CreateTestEndpointAndGetClients(*tc.existingAPIUser, nil, nil, nil, tc.existingKubermaticObjs, nil, hack.NewTestRouting)
 			if err != nil {
 				t.Fatalf("failed to create test endpoint")
 			}
@@ -296,6 +332,10 @@ func TestCreateMeteringReportConfigEndpoint(t *testing.T) {
 			}
 
 			test.CompareWithResult(t, res, tc.expectedResponse)
+
+			if tc.dryRun {
+				assertReportConfigurationNotPersisted(t, clients.FakeClient, tc.reportName, nil)
+			}
 		})
 	}
 }
@@ -316,6 +356,11 @@ func TestUpdateMeteringReportConfigEndpoint(t *testing.T) {
 					Retention: &retention,
 					Types:     sets.List(metering.ReportTypes),
 				},
+				"daily": {
+					Schedule: "0 1 * * *",
+					Interval: 1,
+					Types:    []string{"cluster"},
+				},
 			},
 		}
 	})
@@ -323,6 +368,7 @@ func TestUpdateMeteringReportConfigEndpoint(t *testing.T) {
 	testcases := []struct {
 		name                   string
 		reportName             string
+		dryRun                 bool
 		body                   string
 		existingKubermaticObjs []ctrlruntimeclient.Object
 		existingAPIUser        *apiv1.User
@@ -440,15 +486,46 @@ func TestUpdateMeteringReportConfigEndpoint(t *testing.T) {
 			httpStatus:             http.StatusBadRequest,
 			expectedResponse:       `{"error":{"code":400,"message":"invalid metering type: invalid"}}`,
 		},
+		// scenario 10
+		{
+			name:       "Update existing metering report configuration. Same schedule and types as other one.",
+			reportName: "weekly",
+			body: `{
+				"schedule": "0 1 * * *",
+				"types": ["cluster"]
+			}`,
+			existingKubermaticObjs: []ctrlruntimeclient.Object{testSeed},
+			existingAPIUser:        test.GenDefaultAdminAPIUser(),
+			httpStatus:             http.StatusConflict,
+			expectedResponse:       `{"error":{"code":409,"message":"report configuration \"daily\" has the same schedule and types"}}`,
+		},
+		// scenario 11
+		{
+			name:       "Update existing metering report configuration. Dry run.",
+			reportName: "weekly",
+			dryRun:     true,
+			body: `{
+				"interval": 30,
+				"schedule": "1 1 1 * *",
+				"retention": 180
+			}`,
+			existingKubermaticObjs: []ctrlruntimeclient.Object{testSeed},
+			existingAPIUser:        test.GenDefaultAdminAPIUser(),
+			httpStatus:             http.StatusOK,
+			expectedResponse:       `{"name":"weekly","schedule":"1 1 1 * *","interval":30,"retention":180,"types":["cluster","namespace"]}`,
+		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
 			reqURL := fmt.Sprintf("/api/v1/admin/metering/configurations/reports/%s", tc.reportName)
+			if tc.dryRun {
+				reqURL += "?dry_run=true"
+			}
 			req := httptest.NewRequest(http.MethodPut, reqURL, strings.NewReader(tc.body))
 			res := httptest.NewRecorder()
 
-			router, err := test.CreateTestEndpoint(*tc.existingAPIUser, nil, tc.existingKubermaticObjs, nil, hack.NewTestRouting)
+			router, clients, err := test.CreateTestEndpointAndGetClients(*tc.existingAPIUser, nil, nil, nil, tc.existingKubermaticObjs, nil, hack.NewTestRouting)
 			if err != nil {
 				t.Fatalf("failed to create test endpoint")
 			}
@@ -459,10 +536,38 @@ func TestUpdateMeteringReportConfigEndpoint(t *testing.T) {
 			}
 
 			test.CompareWithResult(t, res, tc.expectedResponse)
+
+			if tc.dryRun {
+				original := testSeed.Spec.Metering.ReportConfigurations[tc.reportName]
+				assertReportConfigurationNotPersisted(t, clients.FakeClient, tc.reportName, &original)
+			}
 		})
 	}
 }
 
+// assertReportConfigurationNotPersisted checks that the Seed still holds the expected report configuration,
+// or none at all when expected is nil.
+func assertReportConfigurationNotPersisted(t *testing.T, client ctrlruntimeclient.Client, name string, expected *kubermaticv1.MeteringReportConfiguration) {
+	t.Helper()
+
+	seed := &kubermaticv1.Seed{}
+	if err := client.Get(context.Background(), types.NamespacedName{Name: test.GenTestSeed().Name, Namespace: test.GenTestSeed().Namespace}, seed); err != nil {
+		t.Fatalf("failed to get seed: %v", err)
+	}
+
+	current, exists := seed.Spec.Metering.ReportConfigurations[name]
+	if expected == nil {
+		if exists {
+			t.Fatalf("expected report configuration %q not to be persisted", name)
+		}
+		return
+	}
+
+	if !exists || !reflect.DeepEqual(current, *expected) {
+		t.Fatalf("expected report configuration %q to stay unchanged, got %+v", name, current)
+	}
+}
+
 func TestDeleteMeteringReportConfigEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -481,13 +586,23 @@ func TestDeleteMeteringReportConfigEndpoint(t *testing.T) {
 		}
 	})
 
+	store := &fakeObjectStore{keys: []string{
+		"monthly/kubermatic-cluster-2024-01-01.csv",
+		"weekly/kubermatic-cluster-2024-01-01.csv",
+		"weekly/kubermatic-namespace-2024-01-01.csv",
+	}}
+	server := httptest.NewServer(store)
+	defer server.Close()
+
 	testcases := []struct {
 		name                   string
 		reportName             string
+		purge                  bool
 		existingKubermaticObjs []ctrlruntimeclient.Object
 		existingAPIUser        *apiv1.User
 		httpStatus             int
 		expectedResponse       string
+		expectedRemainingKeys  []string
 	}{
 		// scenario 1
 		{
@@ -496,7 +611,7 @@ func TestDeleteMeteringReportConfigEndpoint(t *testing.T) {
 			existingKubermaticObjs: []ctrlruntimeclient.Object{testSeed},
 			existingAPIUser:        test.GenDefaultAdminAPIUser(),
 			httpStatus:             http.StatusOK,
-			expectedResponse:       `{}`,
+			expectedResponse:       `{"deletedReports":0}`,
 		},
 		// scenario 2
 		{
@@ -505,17 +620,31 @@ func TestDeleteMeteringReportConfigEndpoint(t *testing.T) {
 			existingKubermaticObjs: []ctrlruntimeclient.Object{testSeed},
 			existingAPIUser:        test.GenDefaultAdminAPIUser(),
 			httpStatus:             http.StatusOK,
-			expectedResponse:       `{}`,
+			expectedResponse:       `{"deletedReports":0}`,
+		},
+		// scenario 3
+		{
+			name:                   "Delete existing metering report configuration and purge its reports.",
+			reportName:             "weekly",
+			purge:                  true,
+			existingKubermaticObjs: []ctrlruntimeclient.Object{testSeed},
+			existingAPIUser:        test.GenDefaultAdminAPIUser(),
+			httpStatus:             http.StatusOK,
+			expectedResponse:       `{"deletedReports":2}`,
+			expectedRemainingKeys:  []string{"monthly/kubermatic-cluster-2024-01-01.csv"},
 		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
 			reqURL := fmt.Sprintf("/api/v1/admin/metering/configurations/reports/%s", tc.reportName)
+			if tc.purge {
+				reqURL += "?purge=true"
+			}
 			req := httptest.NewRequest(http.MethodDelete, reqURL, strings.NewReader(""))
 			res := httptest.NewRecorder()
 
-			router, err := test.CreateTestEndpoint(*tc.existingAPIUser, nil, tc.existingKubermaticObjs, nil, hack.NewTestRouting)
+			router, err := test.CreateTestEndpoint(*tc.existingAPIUser, genMeteringS3Objects(t, server.URL), tc.existingKubermaticObjs, nil, hack.NewTestRouting)
 			if err != nil {
 				t.Fatalf("failed to create test endpoint")
 			}
@@ -526,6 +655,12 @@ func TestDeleteMeteringReportConfigEndpoint(t *testing.T) {
 			}
 
 			test.CompareWithResult(t, res, tc.expectedResponse)
+
+			if tc.expectedRemainingKeys != nil {
+				if remaining := store.remainingKeys(); !reflect.DeepEqual(remaining, tc.expectedRemainingKeys) {
+					t.Fatalf("expected remaining reports %v, got %v", tc.expectedRemainingKeys, remaining)
+				}
+			}
 		})
 	}
 }
@@ -28,6 +28,8 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"path"
+	"regexp"
 	"strconv"
 	"time"
 
@@ -49,6 +51,56 @@ import (
 
 var urlValidTime = time.Hour * 1
 
+const (
+	ReportTypeCluster   = "cluster"
+	ReportTypeNamespace = "namespace"
+)
+
+var (
+	// Report file names embed the report type and the date of the report, e.g. "kubermatic-cluster-2024-01-31.csv".
+	reportDateRegexp = regexp.MustCompile(`\d{4}-\d{2}-\d{2}`)
+	reportTypeRegexp = regexp.MustCompile(`(?:^|[-_.])(cluster|namespace)(?:[-_.]|$)`)
+)
+
+// reportFilter filters reports by the date and the type embedded in their file name.
+type reportFilter struct {
+	From time.Time
+	To   time.Time
+	Type string
+}
+
+func (f reportFilter) empty() bool {
+	return f.From.IsZero() && f.To.IsZero() && f.Type == ""
+}
+
+func (f reportFilter) matches(key string) bool {
+	name := path.Base(key)
+
+	if f.Type != "" {
+		match := reportTypeRegexp.FindStringSubmatch(name)
+		if match == nil || match[1] != f.Type {
+			return false
+		}
+	}
+
+	if f.From.IsZero() && f.To.IsZero() {
+		return true
+	}
+
+	date, err := time.Parse(time.DateOnly, reportDateRegexp.FindString(name))
+	if err != nil {
+		return false
+	}
+	if !f.From.IsZero() && date.Before(f.From.UTC().Truncate(24*time.Hour)) {
+		return false
+	}
+	if !f.To.IsZero() && date.After(f.To.UTC()) {
+		return false
+	}
+
+	return true
+}
+
 // ListReports returns a list of all reports generated by metering
 // Assumes all Seeds uses the same secrets.
 func ListReports(ctx context.Context, req interface{}, seedsGetter provider.SeedsGetter, seedClientGetter provider.SeedClientGetter) ([]apiv1.MeteringReport, error) {
@@ -77,11 +129,16 @@ func ListReports(ctx context.Context, req interface{}, seedsGetter provider.Seed
 		prefix = request.ConfigurationName + "/"
 	}
 
+	// The report date and type are not part of the prefix, so the filter is applied to the listed reports. The
+	// listing therefore isn't limited to a single page of the object store, it continues until the requested
+	// number of matching reports is found. Without a filter, the requested number of reports fits on one page.
 	listOptions := minio.ListObjectsOptions{
-		MaxKeys:    request.MaxKeys,
 		StartAfter: request.StartAfter,
 		Prefix:     prefix,
 	}
+	if request.filter().empty() {
+		listOptions.MaxKeys = request.MaxKeys
+	}
 
 	for _, seed := range seedsMap {
 		seedClient, err := seedClientGetter(seed)
@@ -89,7 +146,7 @@ func ListReports(ctx context.Context, req interface{}, seedsGetter provider.Seed
 			return nil, err
 		}
 
-		reports, err := getReportsForSeed(ctx, listOptions, seed, seedClient)
+		reports, err := getReportsForSeed(ctx, listOptions, request.filter(), request.MaxKeys, seed, seedClient)
 		if err != nil {
 			return nil, err
 		}
@@ -190,7 +247,46 @@ func DeleteReport(ctx context.Context, req interface{}, seedsGetter provider.See
 	return utilerrors.New(http.StatusNotFound, "report not found")
 }
 
-func getReportsForSeed(ctx context.Context, options minio.ListObjectsOptions, seed *kubermaticv1.Seed, seedClient ctrlruntimeclient.Client) ([]apiv1.MeteringReport, error) {
+// purgeReports removes all reports generated for the given report configuration. Assumes all Seeds use the same
+// object store, so only the reports of the first Seed are removed.
+func purgeReports(ctx context.Context, reportConfigName string, seeds map[string]*kubermaticv1.Seed, seedClientGetter provider.SeedClientGetter) (int, error) {
+	if seedClientGetter == nil {
+		return 0, errors.New("parameter seedClientGetter cannot be nil")
+	}
+
+	for _, seed := range seeds {
+		seedClient, err := seedClientGetter(seed)
+		if err != nil {
+			return 0, err
+		}
+
+		mc, bucket, err := getS3DataFromSeed(ctx, seed, seedClient)
+		if err != nil {
+			return 0, err
+		}
+
+		mcCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var deleted int
+		for report := range mc.ListObjects(mcCtx, bucket, minio.ListObjectsOptions{Prefix: reportConfigName + "/", Recursive: true}) {
+			if report.Err != nil {
+				return deleted, errors.New(report.Err.Error())
+			}
+
+			if err := mc.RemoveObject(ctx, bucket, report.Key, minio.RemoveObjectOptions{}); err != nil {
+				return deleted, err
+			}
+			deleted++
+		}
+
+		return deleted, nil
+	}
+
+	return 0, nil
+}
+
+func getReportsForSeed(ctx context.Context, options minio.ListObjectsOptions, filter reportFilter, limit int, seed *kubermaticv1.Seed, seedClient ctrlruntimeclient.Client) ([]apiv1.MeteringReport, error) {
 	mc, s3bucket, err := getS3DataFromSeed(ctx, seed, seedClient)
 	if err != nil {
 		return nil, err
@@ -206,12 +302,16 @@ func getReportsForSeed(ctx context.Context, options minio.ListObjectsOptions, se
 			return nil, errors.New(report.Err.Error())
 		}
 
+		if !filter.matches(report.Key) {
+			continue
+		}
+
 		reports = append(reports, apiv1.MeteringReport{
 			Name:         report.Key,
 			LastModified: report.LastModified,
 			Size:         report.Size,
 		})
-		if len(reports) == options.MaxKeys {
+		if len(reports) == limit {
 			break
 		}
 	}
@@ -257,6 +357,23 @@ type listReportReq struct {
 	MaxKeys int `json:"max_keys"`
 	// in: query
 	ConfigurationName string `json:"configuration_name"`
+	// Only list reports of this date or later, in RFC3339 format.
+	// in: query
+	From time.Time `json:"from"`
+	// Only list reports of this date or earlier, in RFC3339 format.
+	// in: query
+	To time.Time `json:"to"`
+	// Only list reports of this type. Can be either cluster or namespace.
+	// in: query
+	Type string `json:"type"`
+}
+
+func (r listReportReq) filter() reportFilter {
+	return reportFilter{
+		From: r.From,
+		To:   r.To,
+		Type: r.Type,
+	}
 }
 
 // swagger:parameters getMeteringReport
@@ -296,9 +413,44 @@ func DecodeListMeteringReportReq(r *http.Request) (interface{}, error) {
 
 	req.ConfigurationName = r.URL.Query().Get("configuration_name")
 
+	var err error
+	req.From, err = decodeReportTime(r, "from")
+	if err != nil {
+		return nil, err
+	}
+	req.To, err = decodeReportTime(r, "to")
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.From.IsZero() && !req.To.IsZero() && req.From.After(req.To) {
+		return nil, utilerrors.NewBadRequest("invalid date range, `from` must not be after `to`")
+	}
+
+	req.Type = r.URL.Query().Get("type")
+	switch req.Type {
+	case "", ReportTypeCluster, ReportTypeNamespace:
+	default:
+		return nil, utilerrors.NewBadRequest("invalid value for `type`, must be one of: %s, %s", ReportTypeCluster, ReportTypeNamespace)
+	}
+
 	return req, nil
 }
 
+func decodeReportTime(r *http.Request, param string) (time.Time, error) {
+	value := r.URL.Query().Get(param)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, utilerrors.NewBadRequest("invalid value for `%s`, must be a RFC3339 timestamp", param)
+	}
+
+	return t, nil
+}
+
 func DecodeGetMeteringReportReq(r *http.Request) (interface{}, error) {
 	var req getReportReq
 	req.ReportName = mux.Vars(r)["report_name"]
@@ -0,0 +1,336 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2022 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package metering_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/ee/metering"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testBucket = "metering"
+
+// fakeObjectStore is a minimal S3 server which supports listing and removing the objects of a single bucket.
+type fakeObjectStore struct {
+	lock sync.Mutex
+	keys []string
+}
+
+type fakeListBucketResult struct {
+	XMLName     xml.Name            `xml:"ListBucketResult"`
+	Name        string              `xml:"Name"`
+	Prefix      string              `xml:"Prefix"`
+	KeyCount    int                 `xml:"KeyCount"`
+	MaxKeys     int                 `xml:"MaxKeys"`
+	IsTruncated bool                `xml:"IsTruncated"`
+	Contents    []fakeObjectContent `xml:"Contents"`
+
+	NextContinuationToken string `xml:"NextContinuationToken,omitempty"`
+}
+
+type fakeObjectContent struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	Size         int64  `xml:"Size"`
+}
+
+func (s *fakeObjectStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	if _, ok := query["location"]; ok {
+		w.Header().Set("Content-Type", "application/xml")
+		fmt.Fprint(w, `<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/">us-east-1</LocationConstraint>`)
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if r.Method == http.MethodDelete {
+		key := strings.TrimPrefix(r.URL.Path, "/"+testBucket+"/")
+		for i := range s.keys {
+			if s.keys[i] == key {
+				s.keys = append(s.keys[:i], s.keys[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if strings.Trim(r.URL.Path, "/") != testBucket || query.Get("list-type") != "2" {
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+
+	// The pages are kept small to exercise listing reports over multiple pages.
+	result := fakeListBucketResult{
+		Name:    testBucket,
+		Prefix:  query.Get("prefix"),
+		MaxKeys: 2,
+	}
+	if maxKeys, err := strconv.Atoi(query.Get("max-keys")); err == nil && maxKeys < result.MaxKeys {
+		result.MaxKeys = maxKeys
+	}
+
+	// The continuation token is the last key of the previous page.
+	startAfter := query.Get("start-after")
+	if token := query.Get("continuation-token"); token != "" {
+		startAfter = token
+	}
+
+	for _, key := range s.keys {
+		if !strings.HasPrefix(key, query.Get("prefix")) || key <= startAfter {
+			continue
+		}
+		if len(result.Contents) == result.MaxKeys {
+			result.IsTruncated = true
+			result.NextContinuationToken = result.Contents[len(result.Contents)-1].Key
+			break
+		}
+		result.Contents = append(result.Contents, fakeObjectContent{
+			Key:          key,
+			LastModified: "2024-06-01T00:00:00.000Z",
+			Size:         1,
+		})
+	}
+	result.KeyCount = len(result.Contents)
+
+	w.Header().Set("Content-Type", "application/xml")
+	if err := xml.NewEncoder(w).Encode(result); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *fakeObjectStore) remainingKeys() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]string{}, s.keys...)
+}
+
+func genMeteringS3Objects(t *testing.T, endpoint string) []ctrlruntimeclient.Object {
+	ca, err := triple.NewCA("test-ca")
+	if err != nil {
+		t.Fatalf("failed to generate CA: %v", err)
+	}
+
+	return []ctrlruntimeclient.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      metering.SecretName,
+				Namespace: "kubermatic",
+			},
+			Data: map[string][]byte{
+				metering.AccessKey: []byte("access-key"),
+				metering.SecretKey: []byte("secret-key"),
+				metering.Bucket:    []byte(testBucket),
+				metering.Endpoint:  []byte(endpoint),
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resources.CABundleConfigMapName,
+				Namespace: "kubermatic",
+			},
+			Data: map[string]string{
+				resources.CABundleConfigMapKey: string(triple.EncodeCertPEM(ca.Cert)),
+			},
+		},
+	}
+}
+
+func TestListMeteringReportsEndpoint(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeObjectStore{}
+	for _, month := range []time.Month{time.January, time.February, time.March, time.April} {
+		for _, reportType := range []string{metering.ReportTypeCluster, metering.ReportTypeNamespace} {
+			store.keys = append(store.keys, fmt.Sprintf("monthly/kubermatic-%s-%s.csv", reportType, time.Date(2024, month, 1, 0, 0, 0, 0, time.UTC).Format(time.DateOnly)))
+		}
+	}
+	sort.Strings(store.keys)
+
+	server := httptest.NewServer(store)
+	defer server.Close()
+
+	testcases := []struct {
+		name            string
+		query           string
+		expectedReports []string
+		httpStatus      int
+		expectedError   string
+	}{
+		{
+			name:  "scenario 1: list all reports",
+			query: "",
+			expectedReports: []string{
+				"monthly/kubermatic-cluster-2024-01-01.csv",
+				"monthly/kubermatic-cluster-2024-02-01.csv",
+				"monthly/kubermatic-cluster-2024-03-01.csv",
+				"monthly/kubermatic-cluster-2024-04-01.csv",
+				"monthly/kubermatic-namespace-2024-01-01.csv",
+				"monthly/kubermatic-namespace-2024-02-01.csv",
+				"monthly/kubermatic-namespace-2024-03-01.csv",
+				"monthly/kubermatic-namespace-2024-04-01.csv",
+			},
+			httpStatus: http.StatusOK,
+		},
+		{
+			name:  "scenario 2: filter reports by type",
+			query: "type=namespace",
+			expectedReports: []string{
+				"monthly/kubermatic-namespace-2024-01-01.csv",
+				"monthly/kubermatic-namespace-2024-02-01.csv",
+				"monthly/kubermatic-namespace-2024-03-01.csv",
+				"monthly/kubermatic-namespace-2024-04-01.csv",
+			},
+			httpStatus: http.StatusOK,
+		},
+		{
+			name:  "scenario 3: filter reports by date range",
+			query: "from=2024-02-01T00:00:00Z&to=2024-03-15T00:00:00Z",
+			expectedReports: []string{
+				"monthly/kubermatic-cluster-2024-02-01.csv",
+				"monthly/kubermatic-cluster-2024-03-01.csv",
+				"monthly/kubermatic-namespace-2024-02-01.csv",
+				"monthly/kubermatic-namespace-2024-03-01.csv",
+			},
+			httpStatus: http.StatusOK,
+		},
+		{
+			name:  "scenario 4: filter reports by date range and type",
+			query: "from=2024-01-15T12:00:00Z&type=cluster",
+			expectedReports: []string{
+				"monthly/kubermatic-cluster-2024-02-01.csv",
+				"monthly/kubermatic-cluster-2024-03-01.csv",
+				"monthly/kubermatic-cluster-2024-04-01.csv",
+			},
+			httpStatus: http.StatusOK,
+		},
+		{
+			name:            "scenario 5: date range without reports",
+			query:           "from=2024-05-01T00:00:00Z&to=2024-06-01T00:00:00Z",
+			expectedReports: []string{},
+			httpStatus:      http.StatusOK,
+		},
+		{
+			name:  "scenario 6: the listing continues until enough reports match the filter",
+			query: "type=namespace&max_keys=3",
+			expectedReports: []string{
+				"monthly/kubermatic-namespace-2024-01-01.csv",
+				"monthly/kubermatic-namespace-2024-02-01.csv",
+				"monthly/kubermatic-namespace-2024-03-01.csv",
+			},
+			httpStatus: http.StatusOK,
+		},
+		{
+			name:  "scenario 7: the listing continues after the given report",
+			query: "type=cluster&max_keys=2&start_after=monthly/kubermatic-cluster-2024-02-01.csv",
+			expectedReports: []string{
+				"monthly/kubermatic-cluster-2024-03-01.csv",
+				"monthly/kubermatic-cluster-2024-04-01.csv",
+			},
+			httpStatus: http.StatusOK,
+		},
+		{
+			name:          "scenario 8: from after to is rejected",
+			query:         "from=2024-03-01T00:00:00Z&to=2024-02-01T00:00:00Z",
+			httpStatus:    http.StatusBadRequest,
+			expectedError: "invalid date range, `from` must not be after `to`",
+		},
+		{
+			name:          "scenario 9: invalid date is rejected",
+			query:         "from=2024-03-01",
+			httpStatus:    http.StatusBadRequest,
+			expectedError: "invalid value for `from`, must be a RFC3339 timestamp",
+		},
+		{
+			name:          "scenario 10: invalid type is rejected",
+			query:         "type=pod",
+			httpStatus:    http.StatusBadRequest,
+			expectedError: "invalid value for `type`, must be one of: cluster, namespace",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/admin/metering/reports?configuration_name=monthly&%s", tc.query), nil)
+			res := httptest.NewRecorder()
+
+			kubeObjects := genMeteringS3Objects(t, server.URL)
+			kubermaticObjects := []ctrlruntimeclient.Object{test.GenTestSeed()}
+
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAdminAPIUser(), kubeObjects, kubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.httpStatus {
+				t.Fatalf("expected HTTP status code %d, got %d: %s", tc.httpStatus, res.Code, res.Body.String())
+			}
+
+			if tc.expectedError != "" {
+				test.CompareWithResult(t, res, fmt.Sprintf(`{"error":{"code":%d,"message":%q}}`, tc.httpStatus, tc.expectedError))
+				return
+			}
+
+			var reports []apiv1.MeteringReport
+			if err := json.Unmarshal(res.Body.Bytes(), &reports); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			names := []string{}
+			for _, report := range reports {
+				names = append(names, report.Name)
+			}
+
+			if strings.Join(names, ",") != strings.Join(tc.expectedReports, ",") {
+				t.Fatalf("expected reports %v, got %v", tc.expectedReports, names)
+			}
+		})
+	}
+}
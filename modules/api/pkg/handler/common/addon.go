@@ -19,23 +19,31 @@ package common
 import (
 	"context"
 
+	"go.uber.org/zap"
+
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 
+	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sjson "k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	addonEnsureLabelKey = "addons.kubermatic.io/ensure"
 	trueFlag            = "true"
+
+	// addonLabelKey is set by the addon controller on all objects of an addon, the value is the name of the addon.
+	addonLabelKey = "kubermatic-addon"
 )
 
-func PatchAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, addon apiv1.Addon, projectID, clusterID, addonID string) (interface{}, error) {
+func PatchAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, addonConfigProvider provider.AddonConfigProvider, addon apiv1.Addon, projectID, clusterID, addonID string) (interface{}, error) {
 	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
 	if err != nil {
 		return nil, err
@@ -45,6 +53,9 @@ func PatchAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGet
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
+	if err := validateAddonVariables(ctx, addonConfigProvider, apiAddon.Name, addon.Spec.Variables); err != nil {
+		return nil, err
+	}
 	rawVars, err := convertExternalVariablesToInternal(addon.Spec.Variables)
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
@@ -71,12 +82,16 @@ func PatchAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGet
 	return result, nil
 }
 
-func CreateAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, addon apiv1.Addon, projectID, clusterID string) (interface{}, error) {
+func CreateAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, addonConfigProvider provider.AddonConfigProvider, addon apiv1.Addon, projectID, clusterID string) (interface{}, error) {
 	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := validateAddonVariables(ctx, addonConfigProvider, addon.Name, addon.Spec.Variables); err != nil {
+		return nil, err
+	}
+
 	rawVars, err := convertExternalVariablesToInternal(addon.Spec.Variables)
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
@@ -98,7 +113,7 @@ func CreateAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGe
 	return result, nil
 }
 
-func ListAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) (interface{}, error) {
+func ListAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string, showStatus bool) (interface{}, error) {
 	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
 	if err != nil {
 		return nil, err
@@ -113,10 +128,13 @@ func ListAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGett
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
+	if showStatus {
+		setAddonsStatus(ctx, userInfoGetter, cluster, projectID, result)
+	}
 	return result, nil
 }
 
-func GetAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, addonID string) (interface{}, error) {
+func GetAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, addonID string, showStatus bool) (interface{}, error) {
 	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
 	if err != nil {
 		return nil, err
@@ -131,6 +149,9 @@ func GetAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGette
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
+	if showStatus {
+		setAddonsStatus(ctx, userInfoGetter, cluster, projectID, []*apiv1.Addon{result})
+	}
 	return result, nil
 }
 
@@ -269,6 +290,72 @@ func listAddons(ctx context.Context, userInfoGetter provider.UserInfoGetter, clu
 	return addonProvider.List(ctx, userInfo, cluster)
 }
 
+// setAddonsStatus sets the status of the addons based on the Deployments and DaemonSets labeled with their names in
+// the user cluster. The status is unknown if the user cluster can't be reached.
+func setAddonsStatus(ctx context.Context, userInfoGetter provider.UserInfoGetter, cluster *kubermaticv1.Cluster, projectID string, addons []*apiv1.Addon) {
+	statuses, err := getAddonWorkloadsStatus(ctx, userInfoGetter, cluster, projectID)
+	if err != nil {
+		kubermaticlog.Logger.Debugw("failed to get the status of the addons", "cluster", cluster.Name, zap.Error(err))
+	}
+
+	for _, addon := range addons {
+		if err != nil {
+			addon.Status = &apiv1.AddonStatus{State: apiv1.AddonHealthUnknown}
+			continue
+		}
+
+		status := statuses[addon.Name]
+		status.Healthy = status.Ready >= status.Desired && status.Available >= status.Desired
+		status.State = apiv1.AddonDegraded
+		if status.Healthy {
+			status.State = apiv1.AddonHealthy
+		}
+		addon.Status = &status
+	}
+}
+
+// getAddonWorkloadsStatus sums up the replicas of the Deployments and DaemonSets of each addon.
+func getAddonWorkloadsStatus(ctx context.Context, userInfoGetter provider.UserInfoGetter, cluster *kubermaticv1.Cluster, projectID string) (map[string]apiv1.AddonStatus, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	if err := client.List(ctx, deployments, ctrlruntimeclient.HasLabels{addonLabelKey}); err != nil {
+		return nil, err
+	}
+	daemonSets := &appsv1.DaemonSetList{}
+	if err := client.List(ctx, daemonSets, ctrlruntimeclient.HasLabels{addonLabelKey}); err != nil {
+		return nil, err
+	}
+
+	result := map[string]apiv1.AddonStatus{}
+	for _, deployment := range deployments.Items {
+		name := deployment.Labels[addonLabelKey]
+		status := result[name]
+		desired := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desired = *deployment.Spec.Replicas
+		}
+		status.Desired += desired
+		status.Ready += deployment.Status.ReadyReplicas
+		status.Available += deployment.Status.AvailableReplicas
+		result[name] = status
+	}
+	for _, daemonSet := range daemonSets.Items {
+		name := daemonSet.Labels[addonLabelKey]
+		status := result[name]
+		status.Desired += daemonSet.Status.DesiredNumberScheduled
+		status.Ready += daemonSet.Status.NumberReady
+		status.Available += daemonSet.Status.NumberAvailable
+		result[name] = status
+	}
+
+	return result, nil
+}
+
 func convertInternalAddonToExternal(internalAddon *kubermaticv1.Addon) (*apiv1.Addon, error) {
 	result := &apiv1.Addon{
 		ObjectMeta: apiv1.ObjectMeta{
@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	addonSchemaTypeString  = "string"
+	addonSchemaTypeNumber  = "number"
+	addonSchemaTypeBoolean = "boolean"
+)
+
+// addonFormControlTypes maps the types of the form controls the dashboard can render to JSON schema types.
+var addonFormControlTypes = map[string]string{
+	"text":      addonSchemaTypeString,
+	"text-area": addonSchemaTypeString,
+	"number":    addonSchemaTypeNumber,
+	"boolean":   addonSchemaTypeBoolean,
+}
+
+func GetAddonSchemaEndpoint(ctx context.Context, addonConfigProvider provider.AddonConfigProvider, addonName string) (interface{}, error) {
+	schema, err := getAddonSchema(ctx, addonConfigProvider, addonName)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return schema, nil
+}
+
+// getAddonSchema returns the schema of the variables of the addon. Addons without an AddonConfig get a schema which
+// accepts any variables.
+func getAddonSchema(ctx context.Context, addonConfigProvider provider.AddonConfigProvider, addonName string) (*apiv2.AddonSchema, error) {
+	addonConfig, err := addonConfigProvider.Get(ctx, addonName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return &apiv2.AddonSchema{Name: addonName, Type: "object", AdditionalProperties: true}, nil
+		}
+		return nil, err
+	}
+
+	return convertAddonConfigToSchema(addonConfig), nil
+}
+
+func convertAddonConfigToSchema(addonConfig *kubermaticv1.AddonConfig) *apiv2.AddonSchema {
+	schema := &apiv2.AddonSchema{
+		Name:                 addonConfig.Name,
+		Type:                 "object",
+		AdditionalProperties: len(addonConfig.Spec.Controls) == 0,
+	}
+
+	for _, control := range addonConfig.Spec.Controls {
+		if control.InternalName == "" {
+			continue
+		}
+		if schema.Properties == nil {
+			schema.Properties = map[string]apiv2.AddonSchemaProperty{}
+		}
+		schema.Properties[control.InternalName] = apiv2.AddonSchemaProperty{
+			Type:        addonFormControlTypes[control.Type],
+			Title:       control.DisplayName,
+			Description: control.HelpText,
+			FormControl: control.Type,
+		}
+		if control.Required {
+			schema.Required = append(schema.Required, control.InternalName)
+		}
+	}
+	sort.Strings(schema.Required)
+
+	return schema
+}
+
+// validateAddonVariables checks the variables of the addon against its schema and returns a bad request error which
+// lists all violations.
+func validateAddonVariables(ctx context.Context, addonConfigProvider provider.AddonConfigProvider, addonName string, variables map[string]interface{}) error {
+	schema, err := getAddonSchema(ctx, addonConfigProvider, addonName)
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+
+	if violations := addonSchemaViolations(schema, variables); len(violations) > 0 {
+		return utilerrors.NewBadRequest("invalid variables for addon %q: %s", addonName, strings.Join(violations, ", "))
+	}
+
+	return nil
+}
+
+// addonSchemaViolations returns the sorted violations of the variables against the schema. Empty values count as
+// not set, as the dashboard submits all form controls. Numbers may be given as strings, as number inputs of forms
+// return strings.
+func addonSchemaViolations(schema *apiv2.AddonSchema, variables map[string]interface{}) []string {
+	violations := []string{}
+
+	for _, name := range schema.Required {
+		if isEmptyAddonVariable(variables[name]) {
+			violations = append(violations, fmt.Sprintf("missing required variable %q", name))
+		}
+	}
+
+	for name, value := range variables {
+		property, ok := schema.Properties[name]
+		if !ok {
+			if !schema.AdditionalProperties {
+				violations = append(violations, fmt.Sprintf("unknown variable %q", name))
+			}
+			continue
+		}
+		if isEmptyAddonVariable(value) || property.Type == "" {
+			continue
+		}
+
+		valid := false
+		switch v := value.(type) {
+		case string:
+			if property.Type == addonSchemaTypeNumber {
+				_, err := strconv.ParseFloat(v, 64)
+				valid = err == nil
+			} else {
+				valid = property.Type == addonSchemaTypeString
+			}
+		case float64, int, int64:
+			valid = property.Type == addonSchemaTypeNumber
+		case bool:
+			valid = property.Type == addonSchemaTypeBoolean
+		}
+		if !valid {
+			violations = append(violations, fmt.Sprintf("variable %q must be a %s", name, property.Type))
+		}
+	}
+
+	sort.Strings(violations)
+	return violations
+}
+
+func isEmptyAddonVariable(value interface{}) bool {
+	return value == nil || value == ""
+}
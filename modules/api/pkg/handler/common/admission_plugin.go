@@ -0,0 +1,55 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+
+	semverlib "github.com/Masterminds/semver/v3"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// GetAdmissionPlugins returns the names of the admission plugins which are supported for the given Kubernetes version.
+func GetAdmissionPlugins(ctx context.Context, admissionPluginProvider provider.AdmissionPluginsProvider, version string) (sets.Set[string], error) {
+	pluginResponse, err := admissionPluginProvider.ListPluginNamesFromVersion(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	// for the backward compatibility we have to keep those plugins as a default
+	plugins := sets.New(
+		"PodNodeSelector",
+		"EventRateLimit",
+	)
+
+	v, err := semverlib.NewVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pod Security Policy was removed in k8s v1.25
+	gteKube125Condition, _ := semverlib.NewConstraint(">= 1.25")
+	if !gteKube125Condition.Check(v) {
+		plugins.Insert("PodSecurityPolicy")
+	}
+	plugins.Insert(pluginResponse...)
+
+	return plugins, nil
+}
@@ -374,6 +374,60 @@ func UnbindServiceAccountFromClusterRoles(ctx context.Context, client ctrlruntim
 	}
 	return nil
 }
+
+// UnbindUserFromRoles unbinds the user from all rolebindings labelled UserClusterComponentKey = UserClusterBindingComponentValue.
+func UnbindUserFromRoles(ctx context.Context, client ctrlruntimeclient.Client, userEmail string) error {
+	roleBindingList := &rbacv1.RoleBindingList{}
+	if err := client.List(ctx, roleBindingList, ctrlruntimeclient.MatchingLabels{UserClusterComponentKey: UserClusterBindingComponentValue}); err != nil {
+		return fmt.Errorf("failed to list rolebinding: %w", err)
+	}
+
+	for _, roleBinding := range roleBindingList.Items {
+		newSubjects, shouldUpdate := removeUserSubject(roleBinding.Subjects, userEmail)
+		if shouldUpdate {
+			binding := roleBinding.DeepCopy()
+			binding.Subjects = newSubjects
+			if err := client.Update(ctx, binding); err != nil {
+				return fmt.Errorf("failed to unbind user '%s' from role binding '%s/%s': %w", userEmail, roleBinding.Namespace, roleBinding.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// UnbindUserFromClusterRoles unbinds the user from all clusterRolebindings labelled UserClusterComponentKey = UserClusterBindingComponentValue.
+func UnbindUserFromClusterRoles(ctx context.Context, client ctrlruntimeclient.Client, userEmail string) error {
+	clusterRoleBindingList := &rbacv1.ClusterRoleBindingList{}
+	if err := client.List(ctx, clusterRoleBindingList, ctrlruntimeclient.MatchingLabels{UserClusterComponentKey: UserClusterBindingComponentValue}); err != nil {
+		return fmt.Errorf("failed to list clusterRoleBinding: %w", err)
+	}
+
+	for _, clusterRoleBinding := range clusterRoleBindingList.Items {
+		newSubjects, shouldUpdate := removeUserSubject(clusterRoleBinding.Subjects, userEmail)
+		if shouldUpdate {
+			binding := clusterRoleBinding.DeepCopy()
+			binding.Subjects = newSubjects
+			if err := client.Update(ctx, binding); err != nil {
+				return fmt.Errorf("failed to unbind user '%s' from cluster role binding '%s': %w", userEmail, clusterRoleBinding.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func removeUserSubject(subjects []rbacv1.Subject, userEmail string) ([]rbacv1.Subject, bool) {
+	removed := false
+	var newSubjects []rbacv1.Subject
+	for _, subject := range subjects {
+		if subject.Kind == rbacv1.UserKind && strings.EqualFold(subject.Name, userEmail) {
+			removed = true
+			continue
+		}
+		newSubjects = append(newSubjects, subject)
+	}
+	return newSubjects, removed
+}
+
 func ListRoleBindingEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 
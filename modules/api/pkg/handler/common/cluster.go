@@ -22,7 +22,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -30,6 +32,7 @@ import (
 	"go.uber.org/zap"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/handler/v1/label"
@@ -37,6 +40,7 @@ import (
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
 	"k8c.io/dashboard/v2/pkg/resources/cluster"
 	"k8c.io/dashboard/v2/pkg/resources/machine"
+	"k8c.io/dashboard/v2/pkg/webhook"
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
@@ -56,6 +60,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -87,6 +92,7 @@ func CreateEndpoint(
 	projectProvider provider.ProjectProvider,
 	privilegedProjectProvider provider.PrivilegedProjectProvider,
 	seedsGetter provider.SeedsGetter,
+	clusterProviderGetter provider.ClusterProviderGetter,
 	credentialManager provider.PresetProvider,
 	exposeStrategy kubermaticv1.ExposeStrategy,
 	userInfoGetter provider.UserInfoGetter,
@@ -94,6 +100,7 @@ func CreateEndpoint(
 	configGetter provider.KubermaticConfigurationGetter,
 	features features.FeatureGate,
 	settingsProvider provider.SettingsProvider,
+	notifier *webhook.Notifier,
 ) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
@@ -126,10 +133,23 @@ func CreateEndpoint(
 		return nil, utilerrors.NewAlreadyExists("cluster", partialCluster.Spec.HumanReadableName)
 	}
 
+	initialMachineDeployments, err := machine.GetInitialMachineDeployments(partialCluster)
+	if err != nil {
+		return nil, err
+	}
+	var initialNodes int32
+	for _, md := range initialMachineDeployments {
+		initialNodes += machine.MachineDeploymentNodes(md)
+	}
+	if err := enforceProjectNodeQuota(ctx, clusterProviderGetter, seedsGetter, project, initialNodes, int32(len(initialMachineDeployments))); err != nil {
+		return nil, err
+	}
+
 	newCluster, err := createNewCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, partialCluster)
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
+	notifier.Notify(project.Name, webhook.EventClusterCreated, newCluster)
 
 	log := kubermaticlog.Logger.With("cluster", newCluster.Name)
 
@@ -195,6 +215,9 @@ func GenerateCluster(
 	if body.Cluster.Annotations != nil {
 		partialCluster.Annotations = body.Cluster.Annotations
 	}
+	if err := machine.ValidateAllowMixedCloudProvidersAnnotation(adminUserInfo.IsAdmin, nil, partialCluster.Annotations); err != nil {
+		return nil, utilerrors.New(http.StatusForbidden, err.Error())
+	}
 
 	credentialName := body.Cluster.Credential
 	if len(credentialName) > 0 {
@@ -245,35 +268,28 @@ func GenerateCluster(
 	// Generate the name here so that it can be used below.
 	partialCluster.Name = utilcluster.MakeClusterName()
 
-	// Serialize initial machine deployment request into annotation if it is in the body and provider different than
-	// BringYourOwn was selected. The request will be transformed into machine deployment by the controller once cluster
-	// will be ready. To make it easier to determine if a machine deployment annotation has already been applied to
-	// the user cluster (in case errors happen and the controller needs to re-reconcile), we ensure that the MD
-	// has a proper name instead of relying on the GenerateName.
-	if body.NodeDeployment != nil {
+	// Serialize initial machine deployment requests into annotations if they are in the body and provider different
+	// than BringYourOwn was selected. The requests will be transformed into machine deployments by the controller once
+	// cluster will be ready. To make it easier to determine if a machine deployment annotation has already been applied
+	// to the user cluster (in case errors happen and the controller needs to re-reconcile), we ensure that the MDs
+	// have a proper name instead of relying on the GenerateName.
+	if body.NodeDeployment != nil || len(body.MachineDeployments) > 0 {
 		isBYO, err := common.IsBringYourOwnProvider(spec.Cloud)
 		if err != nil {
 			return nil, utilerrors.NewBadRequest("cannot verify the provider due to an invalid spec: %v", err)
 		}
+		if isBYO && len(body.MachineDeployments) > 0 {
+			return nil, utilerrors.NewBadRequest("You cannot create a node deployment for KubeAdm provider")
+		}
 		if !isBYO {
-			if body.NodeDeployment.Name == "" {
-				body.NodeDeployment.Name = fmt.Sprintf("%s-worker-%s", partialCluster.Name, rand.String(6))
-			}
-
-			// Convert NodeDeployment into a standard MachineDeployment; leave out the SSH keys as the
-			// controller in KKP will apply the currently assigned keys automatically when processing
-			// this annotation.
 			partialCluster.Spec = *spec
-			md, err := machine.Deployment(ctx, partialCluster, body.NodeDeployment, dc, nil, settingsProvider)
+			mds, err := generateInitialMachineDeployments(ctx, partialCluster, &body, seed, dc, adminUserInfo, settingsProvider)
 			if err != nil {
-				return nil, fmt.Errorf("cannot create machine deployment data: %w", err)
+				return nil, err
 			}
-
-			data, err := json.Marshal(md)
-			if err != nil {
-				return nil, fmt.Errorf("cannot marshal initial machine deployment: %w", err)
+			if err := machine.SetInitialMachineDeployments(partialCluster, mds); err != nil {
+				return nil, err
 			}
-			partialCluster.Annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation] = string(data)
 		}
 	}
 
@@ -331,6 +347,8 @@ func GenerateCluster(
 	return partialCluster, nil
 }
 
+// GetClusters lists the clusters of the project in the seed of the cluster provider. If only counting the machine
+// deployments fails, the clusters are returned along with the error.
 func GetClusters(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, projectID string, configGetter provider.KubermaticConfigurationGetter, includeMachineDeploymentCount bool) ([]*apiv1.Cluster, error) {
 	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
 	if err != nil {
@@ -390,7 +408,7 @@ func GetClusters(ctx context.Context, userInfoGetter provider.UserInfoGetter, cl
 
 		for _, er := range listErrs {
 			if er != nil {
-				return nil, er
+				return apiClusters, er
 			}
 		}
 	}
@@ -427,25 +445,27 @@ func GetCluster(ctx context.Context, projectProvider provider.ProjectProvider, p
 	return GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, options)
 }
 
-func GetEndpoint(ctx context.Context, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, configGetter provider.KubermaticConfigurationGetter) (interface{}, error) {
+// GetEndpoint returns the cluster together with its resource version, which clients can use to detect
+// conflicting changes when patching the cluster.
+func GetEndpoint(ctx context.Context, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, configGetter provider.KubermaticConfigurationGetter) (*apiv1.Cluster, string, error) {
 	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	adminUserInfo, err := userInfoGetter(ctx, "")
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, "", common.KubernetesErrorToHTTPError(err)
 	}
 	_, dc, err := provider.DatacenterFromSeedMap(adminUserInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, "", common.KubernetesErrorToHTTPError(err)
 	}
 	config, err := configGetter(ctx)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return ConvertInternalClusterToExternal(cluster, dc, true, version.NewFromConfiguration(config).GetIncompatibilities()...), nil
+	return ConvertInternalClusterToExternal(cluster, dc, true, version.NewFromConfiguration(config).GetIncompatibilities()...), cluster.ResourceVersion, nil
 }
 
 func DeleteEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, deleteVolumes, deleteLoadBalancers bool, sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
@@ -474,7 +494,94 @@ func DeleteEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
 		}
 	}
 
-	return nil, updateAndDeleteCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, existingCluster)
+	if err := updateAndDeleteCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, existingCluster); err != nil {
+		return nil, err
+	}
+
+	notifier.Notify(project.Name, webhook.EventClusterDeleted, existingCluster)
+	notifier.Forget(existingCluster)
+
+	return nil, nil
+}
+
+// DeletePreviewEndpoint returns what DeleteEndpoint would clean up for the given headers, without deleting anything.
+func DeletePreviewEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, deleteVolumes, deleteLoadBalancers bool, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (*apiv2.ClusterDeletionPreview, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	existingCluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &apiv2.ClusterDeletionPreview{
+		LoadBalancers: []string{},
+	}
+
+	// A cluster which was never up has no resources which could be cleaned up, see DeleteEndpoint.
+	if !kuberneteshelper.HasFinalizer(existingCluster, kubermaticv1.NodeDeletionFinalizer) {
+		return preview, nil
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, existingCluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	preview.MachineDeploymentCount = len(machineDeployments.Items)
+
+	nodes := &corev1.NodeList{}
+	if err := client.List(ctx, nodes); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	preview.NodeCount = len(nodes.Items)
+
+	pvs := &corev1.PersistentVolumeList{}
+	if err := client.List(ctx, pvs); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	var size resource.Quantity
+	for _, pv := range pvs.Items {
+		if storage, ok := pv.Spec.Capacity[corev1.ResourceStorage]; ok {
+			size.Add(storage)
+		}
+	}
+	preview.PersistentVolumeCount = len(pvs.Items)
+	// round to 2 decimal places
+	preview.PersistentVolumeSize = math.Round(float64(size.Value())/math.Pow10(int(resource.Giga))*100) / 100
+
+	services := &corev1.ServiceList{}
+	if err := client.List(ctx, services); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	for _, service := range services.Items {
+		if service.Spec.Type == corev1.ServiceTypeLoadBalancer {
+			preview.LoadBalancers = append(preview.LoadBalancers, ctrlruntimeclient.ObjectKeyFromObject(&service).String())
+		}
+	}
+
+	preview.VolumesOrphaned = !deleteVolumes && preview.PersistentVolumeCount > 0
+	preview.LoadBalancersOrphaned = !deleteLoadBalancers && len(preview.LoadBalancers) > 0
+
+	return preview, nil
+}
+
+// clusterPatchResult is a cluster with a patch applied, defaulted, mutated and validated, but not yet persisted.
+type clusterPatchResult struct {
+	project        *kubermaticv1.Project
+	oldCluster     *kubermaticv1.Cluster
+	newCluster     *kubermaticv1.Cluster
+	dc             *kubermaticv1.Datacenter
+	versionManager *version.Manager
 }
 
 func PatchEndpoint(
@@ -490,7 +597,48 @@ func PatchEndpoint(
 	configGetter provider.KubermaticConfigurationGetter,
 	features features.FeatureGate,
 	skipKubeletVersionValidation bool,
-) (interface{}, error) {
+	force bool,
+	resourceVersion string,
+	upgradeMachineDeployments bool,
+) (*apiv1.Cluster, string, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+
+	patched, err := patchInternalCluster(ctx, userInfoGetter, projectID, clusterID, patch, seedsGetter, projectProvider, privilegedProjectProvider,
+		caBundle, configGetter, features, skipKubeletVersionValidation, force, resourceVersion, upgradeMachineDeployments, false)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// update the Cluster resource
+	updatedCluster, err := updateCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, patched.project, patched.newCluster)
+	if err != nil {
+		return nil, "", common.KubernetesErrorToHTTPError(err)
+	}
+
+	return ConvertInternalClusterToExternal(updatedCluster, patched.dc, true, patched.versionManager.GetIncompatibilities()...), updatedCluster.ResourceVersion, nil
+}
+
+// patchInternalCluster applies the patch to the cluster and defaults, mutates and validates the result. In a dry
+// run the credentials of the cluster are validated, but not written.
+func patchInternalCluster(
+	ctx context.Context,
+	userInfoGetter provider.UserInfoGetter,
+	projectID string,
+	clusterID string,
+	patch json.RawMessage,
+	seedsGetter provider.SeedsGetter,
+	projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider,
+	caBundle *x509.CertPool,
+	configGetter provider.KubermaticConfigurationGetter,
+	features features.FeatureGate,
+	skipKubeletVersionValidation bool,
+	force bool,
+	resourceVersion string,
+	upgradeMachineDeployments bool,
+	dryRun bool,
+) (*clusterPatchResult, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
 
@@ -504,6 +652,12 @@ func PatchEndpoint(
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
 
+	// An empty resource version means that the client is not interested in conflict detection and the
+	// patch is applied to the current state of the cluster.
+	if resourceVersion != "" && resourceVersion != oldInternalCluster.ResourceVersion {
+		return nil, utilerrors.New(http.StatusConflict, fmt.Sprintf("cluster %s has been modified in the meantime, its current resource version is %s", clusterID, oldInternalCluster.ResourceVersion))
+	}
+
 	userInfo, err := userInfoGetter(ctx, "")
 	if err != nil {
 		return nil, utilerrors.New(http.StatusInternalServerError, err.Error())
@@ -522,15 +676,7 @@ func PatchEndpoint(
 	// Converting to API type as it is the type exposed externally.
 	externalCluster := ConvertInternalClusterToExternal(oldInternalCluster, dc, false, versionManager.GetIncompatibilities()...)
 
-	// Changing the type to patchCluster as during marshalling it doesn't remove the cloud provider authentication
-	// data that is required here for validation.
-	externalClusterSpec := (patchClusterSpec)(externalCluster.Spec)
-	clusterToPatch := patchCluster{
-		Cluster: *externalCluster,
-		Spec:    externalClusterSpec,
-	}
-
-	existingClusterJSON, err := json.Marshal(clusterToPatch)
+	existingClusterJSON, err := marshalPatchCluster(externalCluster)
 	if err != nil {
 		return nil, utilerrors.NewBadRequest("cannot decode existing cluster: %v", err)
 	}
@@ -545,6 +691,9 @@ func PatchEndpoint(
 	if err != nil {
 		return nil, utilerrors.NewBadRequest("cannot decode patched cluster: %v", err)
 	}
+	if err := machine.ValidateAllowMixedCloudProvidersAnnotation(userInfo.IsAdmin, oldInternalCluster.Annotations, patchedCluster.Annotations); err != nil {
+		return nil, utilerrors.New(http.StatusForbidden, err.Error())
+	}
 
 	// Only specific fields from old internal cluster will be updated by a patch.
 	// It prevents user from changing other fields like resource ID or version that should not be modified.
@@ -579,11 +728,24 @@ func PatchEndpoint(
 	newInternalCluster.Spec.DisableCSIDriver = patchedCluster.Spec.DisableCSIDriver
 	newInternalCluster.Spec.Kyverno = patchedCluster.Spec.Kyverno
 
+	keepMaintenanceWindow(oldInternalCluster, newInternalCluster)
+	keepAdvisoryAcknowledgments(oldInternalCluster, newInternalCluster)
+	if !newInternalCluster.Spec.Version.Equal(&oldInternalCluster.Spec.Version) {
+		if err := checkMaintenanceWindow(ctx, userInfoGetter, oldInternalCluster, projectID, force); err != nil {
+			return nil, err
+		}
+	}
+
 	// Checking kubelet versions on user cluster machines requires network connection between kubermatic-api and user cluster api-server.
 	// In case where the connection is blocked, we still want to be able to send a patch request. This can be achieved with an additional
 	// query param attached to the patch request: "skip_kubelet_version_validation=true"
+	// The machine deployments are left out if they are upgraded together with the control plane.
 	if !skipKubeletVersionValidation {
-		incompatibleKubelets, err := common.CheckClusterVersionSkew(ctx, userInfoGetter, clusterProvider, newInternalCluster, projectID)
+		checkVersionSkew := common.CheckClusterVersionSkew
+		if upgradeMachineDeployments {
+			checkVersionSkew = common.CheckMachineVersionSkew
+		}
+		incompatibleKubelets, err := checkVersionSkew(ctx, userInfoGetter, clusterProvider, newInternalCluster, projectID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to check existing nodes' version skew: %w", err)
 		}
@@ -617,7 +779,15 @@ func PatchEndpoint(
 		CABundle:   caBundle,
 	}
 
-	changed, err := kubernetesprovider.CreateOrUpdateCredentialSecretForClusterWithValidation(ctx, seedClient, newInternalCluster, validate)
+	credentialsClient := seedClient
+	credentialsCluster := newInternalCluster
+	if dryRun {
+		// The credentials are validated, but neither written to their secret nor moved out of the cluster, so that
+		// changed credentials are part of the preview.
+		credentialsClient = ctrlruntimeclient.NewDryRunClient(seedClient)
+		credentialsCluster = newInternalCluster.DeepCopy()
+	}
+	changed, err := kubernetesprovider.CreateOrUpdateCredentialSecretForClusterWithValidation(ctx, credentialsClient, credentialsCluster, validate)
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
@@ -644,16 +814,28 @@ func PatchEndpoint(
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
 
-	// update the Cluster resource
-	updatedCluster, err := updateCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, newInternalCluster)
-	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
-	}
+	return &clusterPatchResult{
+		project:        project,
+		oldCluster:     oldInternalCluster,
+		newCluster:     newInternalCluster,
+		dc:             dc,
+		versionManager: versionManager,
+	}, nil
+}
 
-	return ConvertInternalClusterToExternal(updatedCluster, dc, true, versionManager.GetIncompatibilities()...), nil
+// marshalPatchCluster marshals the cluster including the cloud provider authentication data, which the custom
+// MarshalJSON of ClusterSpec removes, as it is required to validate a patched cluster.
+func marshalPatchCluster(cluster *apiv1.Cluster) ([]byte, error) {
+	return json.Marshal(patchCluster{
+		Cluster: *cluster,
+		Spec:    (patchClusterSpec)(cluster.Spec),
+	})
 }
 
-func GetClusterEventsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID, eventType string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+// GetClusterEventsEndpoint lists the events of the cluster. The scope selects whether the events of the cluster
+// object, the events from the namespace of the cluster on the seed or both are returned. An empty scope is the
+// same as ClusterEventsScopeUser.
+func GetClusterEventsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID, eventType, scope string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
 	client := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
@@ -676,9 +858,26 @@ func GetClusterEventsEndpoint(ctx context.Context, userInfoGetter provider.UserI
 		eventTypeAPI = corev1.EventTypeNormal
 	}
 
-	events, err := common.GetEvents(ctx, client, cluster, metav1.NamespaceAll)
-	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+	events := make([]apiv1.Event, 0)
+	if scope != ClusterEventsScopeSeed {
+		clusterEvents, err := common.GetEvents(ctx, client, cluster, metav1.NamespaceAll)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		events = append(events, clusterEvents...)
+	}
+
+	if scope == ClusterEventsScopeSeed || scope == ClusterEventsScopeAll {
+		userInfo, err := userInfoGetter(ctx, projectID)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		seedEvents, err := getSeedNamespaceEvents(ctx, client, cluster, !userInfo.IsAdmin)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		events = append(events, seedEvents...)
 	}
 
 	if len(eventTypeAPI) > 0 {
@@ -723,6 +922,30 @@ func HealthEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
 	}, nil
 }
 
+// GetClusterPermissionsEndpoint returns the effective capabilities of the current user for the cluster. The
+// answers are derived from the same project roles the handlers (and the impersonated user cluster clients)
+// use for enforcement, so users which are not allowed to see the cluster get the usual error instead.
+func GetClusterPermissionsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (*apiv2.ClusterPermissions, error) {
+	if _, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil); err != nil {
+		return nil, err
+	}
+
+	userInfo, err := userInfoGetter(ctx, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	canModify := common.UserCanModifyProject(userInfo)
+	return &apiv2.ClusterPermissions{
+		IsAdmin:                     userInfo.IsAdmin,
+		CanPatchCluster:             canModify,
+		CanDeleteCluster:            canModify,
+		CanManageMachineDeployments: canModify,
+		CanManageSSHKeys:            canModify,
+		CanViewMetrics:              userInfo.IsAdmin || userInfo.Roles.HasAny(provider.OwnersRole, provider.EditorsRole, provider.ViewersRole),
+	}, nil
+}
+
 func GetMetricsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
 	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
@@ -767,12 +990,55 @@ func GetMetricsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGet
 	seedAdminClient := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
 	podMetricsList := &v1beta1.PodMetricsList{}
 	if err := seedAdminClient.List(ctx, podMetricsList, &ctrlruntimeclient.ListOptions{Namespace: fmt.Sprintf("cluster-%s", cluster.Name)}); err != nil {
-		// Happens during cluster creation when the CRD is not setup yet
-		if !meta.IsNoMatchError(err) {
+		// Happens if the seed has no metrics-server or it is not available
+		if !meta.IsNoMatchError(err) && !apierrors.IsServiceUnavailable(err) {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
 	}
-	return ConvertClusterMetrics(podMetricsList, allNodeMetricsList.Items, availableResources, cluster.Name)
+
+	clusterMetrics, err := ConvertClusterMetrics(podMetricsList, allNodeMetricsList.Items, availableResources, cluster.Name)
+	if err != nil {
+		return nil, err
+	}
+	clusterMetrics.ControlPlaneMetrics.Components = convertControlPlaneComponentMetrics(podMetricsList)
+
+	return clusterMetrics, nil
+}
+
+// convertControlPlaneComponentMetrics groups the metrics of the control plane pods by their component, which is
+// taken from the app label of the pods. Pods without the label are only part of the control plane totals.
+func convertControlPlaneComponentMetrics(podMetrics *v1beta1.PodMetricsList) []apiv1.ControlPlaneComponentMetrics {
+	components := map[string]*apiv1.ControlPlaneComponentMetrics{}
+	for _, pod := range podMetrics.Items {
+		name := pod.Labels[resources.AppLabelKey]
+		if name == "" {
+			continue
+		}
+
+		component, ok := components[name]
+		if !ok {
+			component = &apiv1.ControlPlaneComponentMetrics{Name: name}
+			components[name] = component
+		}
+		component.PodCount++
+
+		for _, container := range pod.Containers {
+			quantityCPU := container.Usage[corev1.ResourceCPU]
+			component.CPUTotalMillicores += quantityCPU.MilliValue()
+			quantityM := container.Usage[corev1.ResourceMemory]
+			component.MemoryTotalBytes += quantityM.Value() / (1024 * 1024)
+		}
+	}
+
+	var result []apiv1.ControlPlaneComponentMetrics
+	for _, component := range components {
+		result = append(result, *component)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
 }
 
 func MigrateEndpointToExternalCCM(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID,
@@ -959,6 +1225,59 @@ func DetachSSHKeyEndpoint(ctx context.Context, userInfoGetter provider.UserInfoG
 	return nil, nil
 }
 
+// SetSSHKeysEndpoint makes the given keys the complete set of SSH keys assigned to the cluster. Keys which are not
+// part of the set are detached. Nothing is changed if any of the keys doesn't belong to the project.
+func SetSSHKeysEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, keyIDs []string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	_, err = GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	projectSSHKeys, err := sshKeyProvider.List(ctx, project, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	// validate all keys before changing any of them
+	desiredKeyIDs := sets.New(keyIDs...)
+	projectKeyIDs := sets.New[string]()
+	for _, projectSSHKey := range projectSSHKeys {
+		projectKeyIDs.Insert(projectSSHKey.Name)
+	}
+	if foreignKeyIDs := desiredKeyIDs.Difference(projectKeyIDs); foreignKeyIDs.Len() > 0 {
+		return nil, utilerrors.NewBadRequest("the given ssh keys %v do not belong to the given project %s (%s)", sets.List(foreignKeyIDs), project.Spec.Name, project.Name)
+	}
+
+	for _, projectSSHKey := range projectSSHKeys {
+		desired := desiredKeyIDs.Has(projectSSHKey.Name)
+		if desired == projectSSHKey.IsUsedByCluster(clusterID) {
+			continue
+		}
+
+		sshKey, err := getSSHKey(ctx, userInfoGetter, sshKeyProvider, privilegedSSHKeyProvider, projectID, projectSSHKey.Name)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if desired {
+			sshKey.AddToCluster(clusterID)
+		} else {
+			sshKey.RemoveFromCluster(clusterID)
+		}
+		if err := UpdateClusterSSHKey(ctx, userInfoGetter, sshKeyProvider, privilegedSSHKeyProvider, sshKey, projectID); err != nil {
+			return nil, err
+		}
+	}
+
+	return ListSSHKeysEndpoint(ctx, userInfoGetter, projectID, clusterID, projectProvider, privilegedProjectProvider, sshKeyProvider)
+}
+
 func ListSSHKeysEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, sshKeyProvider provider.SSHKeyProvider) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
@@ -1074,7 +1393,7 @@ func GetInternalCluster(ctx context.Context, userInfoGetter provider.UserInfoGet
 	if adminUserInfo.IsAdmin {
 		cluster, err := privilegedClusterProvider.GetUnsecured(ctx, project, clusterID, options)
 		if err != nil {
-			return nil, common.KubernetesErrorToHTTPError(err)
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamSeed)
 		}
 		return cluster, nil
 	}
@@ -1092,11 +1411,11 @@ func getClusterForRegularUser(ctx context.Context, userInfoGetter provider.UserI
 		// Request came from the specified user. Instead `Not found` error status the `Forbidden` is returned.
 		// Next request with privileged user checks if the cluster doesn't exist or some other error occurred.
 		if !isStatus(err, http.StatusForbidden) {
-			return nil, common.KubernetesErrorToHTTPError(err)
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamSeed)
 		}
 		// Check if cluster really doesn't exist or some other error occurred.
 		if _, errGetUnsecured := privilegedClusterProvider.GetUnsecured(ctx, project, clusterID, options); errGetUnsecured != nil {
-			return nil, common.KubernetesErrorToHTTPError(errGetUnsecured)
+			return nil, common.UpstreamErrorToHTTPError(errGetUnsecured, common.UpstreamSeed)
 		}
 		// Cluster is not ready yet, return original error
 		return nil, common.KubernetesErrorToHTTPError(err)
@@ -1169,6 +1488,7 @@ func ConvertInternalClusterToExternal(internalCluster *kubermaticv1.Cluster, dat
 	if cluster.Annotations == nil {
 		cluster.Annotations = make(map[string]string)
 	}
+	cluster.Spec.MaintenanceWindow = outputMaintenanceWindow(internalCluster)
 
 	return cluster
 }
@@ -1303,6 +1623,70 @@ func GetClusterClientWithClusterID(ctx context.Context, userInfoGetter provider.
 	return client, nil
 }
 
+// generateInitialMachineDeployments converts the node deployments of the create cluster request into the machine
+// deployments which are created once the cluster is healthy. The SSH keys are left out, as the controller in KKP
+// applies the currently assigned keys automatically. The node deployments are validated like in
+// CreateMachineDeployment against the requested cluster version. The entries of MachineDeployments are rejected as a
+// whole if any of them is invalid.
+func generateInitialMachineDeployments(ctx context.Context, cluster *kubermaticv1.Cluster, body *apiv1.CreateClusterSpec, seed *kubermaticv1.Seed, dc *kubermaticv1.Datacenter, userInfo *provider.UserInfo, settingsProvider provider.SettingsProvider) ([]*clusterv1alpha1.MachineDeployment, error) {
+	var mds []*clusterv1alpha1.MachineDeployment
+	names := sets.New[string]()
+
+	if body.NodeDeployment != nil {
+		if body.NodeDeployment.Name == "" {
+			body.NodeDeployment.Name = fmt.Sprintf("%s-worker-%s", cluster.Name, rand.String(6))
+		}
+		if errs := validateNodeDeployment(cluster, body.NodeDeployment); len(errs) > 0 {
+			return nil, common.WithReason(validationErrorReason(errs[0]), utilerrors.NewBadRequest("%v", errs[0]))
+		}
+		if err := validateInstanceTypeFilter(seed, cluster.Spec.Cloud.DatacenterName, userInfo, false, body.NodeDeployment.Spec.Template.Cloud); err != nil {
+			return nil, err
+		}
+		md, err := machine.Deployment(ctx, cluster, body.NodeDeployment, dc, nil, settingsProvider)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create machine deployment data: %w", err)
+		}
+		mds = append(mds, md)
+		names.Insert(md.Name)
+	}
+
+	var details []string
+	for i := range body.MachineDeployments {
+		nd := &body.MachineDeployments[i]
+		if nd.Name == "" {
+			nd.Name = fmt.Sprintf("%s-worker-%s", cluster.Name, rand.String(6))
+		}
+
+		var errs []error
+		if names.Has(nd.Name) {
+			errs = append(errs, fmt.Errorf("machine deployment name %q is used more than once", nd.Name))
+		}
+		names.Insert(nd.Name)
+		errs = append(errs, validateNodeDeployment(cluster, nd)...)
+		if err := validateInstanceTypeFilter(seed, cluster.Spec.Cloud.DatacenterName, userInfo, false, nd.Spec.Template.Cloud); err != nil {
+			errs = append(errs, err)
+		}
+
+		if len(errs) == 0 {
+			md, err := machine.Deployment(ctx, cluster, nd, dc, nil, settingsProvider)
+			if err != nil {
+				errs = append(errs, err)
+			} else {
+				mds = append(mds, md)
+			}
+		}
+		for _, err := range errs {
+			details = append(details, fmt.Sprintf("machineDeployments[%d]: %v", i, err))
+		}
+	}
+
+	if len(details) > 0 {
+		return nil, utilerrors.NewWithDetails(http.StatusBadRequest, "machine deployment validation failed, please examine details field for more info", details)
+	}
+
+	return mds, nil
+}
+
 func checkIfPresetCustomized(ctx context.Context, projectID string, adminUserInfo provider.UserInfo, cloudSpec kubermaticv1.CloudSpec, credentialManager provider.PresetProvider, credentialName string) bool {
 	preset, _ := credentialManager.GetPreset(ctx, &adminUserInfo, &projectID, credentialName)
 
@@ -0,0 +1,324 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	clusterresources "k8c.io/dashboard/v2/pkg/resources/cluster"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/cni"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// kubernetesEndOfLifeWarningPeriod is how long before the end of life of a Kubernetes version users get warned.
+	kubernetesEndOfLifeWarningPeriod = 90 * 24 * time.Hour
+	// caCertificateExpiryWarningPeriod is how long before the expiry of the cluster CA certificate users get warned.
+	caCertificateExpiryWarningPeriod = 90 * 24 * time.Hour
+)
+
+// kubernetesEndOfLife contains the end of life dates of the Kubernetes minor versions.
+var kubernetesEndOfLife = map[string]time.Time{
+	"1.27": time.Date(2024, time.June, 28, 0, 0, 0, 0, time.UTC),
+	"1.28": time.Date(2024, time.October, 28, 0, 0, 0, 0, time.UTC),
+	"1.29": time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC),
+	"1.30": time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC),
+	"1.31": time.Date(2025, time.October, 28, 0, 0, 0, 0, time.UTC),
+	"1.32": time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC),
+	"1.33": time.Date(2026, time.June, 28, 0, 0, 0, 0, time.UTC),
+}
+
+// clusterAdvisorySeverityOrder sorts the advisories with the most severe first.
+var clusterAdvisorySeverityOrder = map[string]int{
+	apiv2.ClusterAdvisoryCritical: 0,
+	apiv2.ClusterAdvisoryWarning:  1,
+	apiv2.ClusterAdvisoryInfo:     2,
+}
+
+// AdvisoryNow returns the time the advisories are computed and acknowledged at, it is replaced in tests.
+var AdvisoryNow = time.Now
+
+// ListClusterAdvisoriesEndpoint returns the advisories of the cluster, which are computed from its conditions, its
+// Kubernetes and CNI versions and its CA certificate. Acknowledged advisories are only returned if includeAcked is set.
+func ListClusterAdvisoriesEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string, includeAcked bool) ([]apiv2.ClusterAdvisory, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	cluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	advisories, err := getClusterAdvisories(ctx, privilegedClusterProvider.GetSeedClusterAdminRuntimeClient(), cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []apiv2.ClusterAdvisory{}
+	for _, advisory := range advisories {
+		if advisory.Acknowledged != nil && !includeAcked {
+			continue
+		}
+		result = append(result, advisory)
+	}
+
+	return result, nil
+}
+
+// AcknowledgeClusterAdvisoryEndpoint stores who acknowledged the advisory of the cluster and when. Acknowledgments of
+// advisories which no longer apply are dropped at the same time.
+func AcknowledgeClusterAdvisoryEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, advisoryID string) (*apiv2.ClusterAdvisory, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	cluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	userInfo, err := userInfoGetter(ctx, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	advisories, err := getClusterAdvisories(ctx, privilegedClusterProvider.GetSeedClusterAdminRuntimeClient(), cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	var acknowledged *apiv2.ClusterAdvisory
+	acknowledgments := map[string]*apiv2.ClusterAdvisoryAcknowledgment{}
+	for i := range advisories {
+		advisory := &advisories[i]
+		if advisory.ID == advisoryID {
+			advisory.Acknowledged = &apiv2.ClusterAdvisoryAcknowledgment{
+				By: userInfo.Email,
+				At: apiv1.NewTime(AdvisoryNow().UTC()),
+			}
+			acknowledged = advisory
+		}
+		if advisory.Acknowledged != nil {
+			acknowledgments[advisory.ID] = advisory.Acknowledged
+		}
+	}
+	if acknowledged == nil {
+		return nil, utilerrors.NewNotFound("advisory", advisoryID)
+	}
+
+	if err := clusterresources.SetAdvisoryAcknowledgments(cluster, acknowledgments); err != nil {
+		return nil, err
+	}
+	if _, err := updateCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, cluster); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return acknowledged, nil
+}
+
+// getClusterAdvisories computes the advisories of the cluster and adds the stored acknowledgments. The advisories are
+// sorted by severity and ID.
+func getClusterAdvisories(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) ([]apiv2.ClusterAdvisory, error) {
+	now := AdvisoryNow()
+
+	advisories := conditionAdvisories(cluster)
+	advisories = append(advisories, kubernetesVersionAdvisories(cluster, now)...)
+	advisories = append(advisories, cniVersionAdvisories(cluster)...)
+
+	caAdvisories, err := caCertificateAdvisories(ctx, seedClient, cluster, now)
+	if err != nil {
+		return nil, err
+	}
+	advisories = append(advisories, caAdvisories...)
+
+	acknowledgments, err := clusterresources.GetAdvisoryAcknowledgments(cluster)
+	if err != nil {
+		return nil, err
+	}
+	for i := range advisories {
+		advisories[i].Acknowledged = acknowledgments[advisories[i].ID]
+	}
+
+	sort.Slice(advisories, func(i, j int) bool {
+		if advisories[i].Severity != advisories[j].Severity {
+			return clusterAdvisorySeverityOrder[advisories[i].Severity] < clusterAdvisorySeverityOrder[advisories[j].Severity]
+		}
+		return advisories[i].ID < advisories[j].ID
+	})
+
+	return advisories, nil
+}
+
+// conditionAdvisories returns a warning for every controller which failed to reconcile the cluster.
+func conditionAdvisories(cluster *kubermaticv1.Cluster) []apiv2.ClusterAdvisory {
+	advisories := []apiv2.ClusterAdvisory{}
+	for conditionType, condition := range cluster.Status.Conditions {
+		if !strings.HasSuffix(string(conditionType), "Successfully") || condition.Status != corev1.ConditionFalse {
+			continue
+		}
+
+		message := fmt.Sprintf("The condition %s of the cluster is false", conditionType)
+		if details := condition.Message; details != "" || condition.Reason != "" {
+			if details == "" {
+				details = condition.Reason
+			}
+			message = fmt.Sprintf("%s: %s", message, details)
+		}
+		advisories = append(advisories, apiv2.ClusterAdvisory{
+			ID:          fmt.Sprintf("condition-%s", conditionType),
+			Severity:    apiv2.ClusterAdvisoryWarning,
+			Message:     message,
+			Remediation: "Check the events of the cluster and the logs of the controller for the cause of the failure.",
+		})
+	}
+
+	return advisories
+}
+
+// kubernetesVersionAdvisories warns about a Kubernetes version which reaches its end of life soon and reports a
+// version past its end of life as critical. Both advisories have different IDs, so acknowledging the warning doesn't
+// hide the critical advisory.
+func kubernetesVersionAdvisories(cluster *kubermaticv1.Cluster, now time.Time) []apiv2.ClusterAdvisory {
+	minor := cluster.Spec.Version.MajorMinor()
+	endOfLife, ok := kubernetesEndOfLife[minor]
+	if !ok || now.Before(endOfLife.Add(-kubernetesEndOfLifeWarningPeriod)) {
+		return nil
+	}
+
+	advisory := apiv2.ClusterAdvisory{
+		ID:          fmt.Sprintf("kubernetes-eol-soon-%s", minor),
+		Severity:    apiv2.ClusterAdvisoryWarning,
+		Message:     fmt.Sprintf("Kubernetes %s reaches its end of life on %s", minor, endOfLife.Format(time.DateOnly)),
+		Remediation: "Upgrade the cluster to a newer Kubernetes version.",
+	}
+	if !now.Before(endOfLife) {
+		advisory.ID = fmt.Sprintf("kubernetes-eol-%s", minor)
+		advisory.Severity = apiv2.ClusterAdvisoryCritical
+		advisory.Message = fmt.Sprintf("Kubernetes %s reached its end of life on %s and no longer receives security fixes", minor, endOfLife.Format(time.DateOnly))
+	}
+
+	return []apiv2.ClusterAdvisory{advisory}
+}
+
+// cniVersionAdvisories warns about a CNI plugin version which is still accepted, but no longer supported.
+func cniVersionAdvisories(cluster *kubermaticv1.Cluster) []apiv2.ClusterAdvisory {
+	cniPlugin := cluster.Spec.CNIPlugin
+	if cniPlugin == nil {
+		return nil
+	}
+
+	supported, err := cni.GetSupportedCNIPluginVersions(cniPlugin.Type)
+	if err != nil || supported.Has(cniPlugin.Version) {
+		return nil
+	}
+	allowed, err := cni.GetAllowedCNIPluginVersions(cniPlugin.Type)
+	if err != nil || !allowed.Has(cniPlugin.Version) {
+		return nil
+	}
+
+	return []apiv2.ClusterAdvisory{{
+		ID:          fmt.Sprintf("cni-deprecated-%s-%s", cniPlugin.Type, cniPlugin.Version),
+		Severity:    apiv2.ClusterAdvisoryWarning,
+		Message:     fmt.Sprintf("The %s CNI plugin version %s is deprecated", cniPlugin.Type, cniPlugin.Version),
+		Remediation: fmt.Sprintf("Upgrade the CNI plugin to one of the supported versions: %s.", strings.Join(sets.List(supported), ", ")),
+	}}
+}
+
+// caCertificateAdvisories warns about a cluster CA certificate which expires soon and reports an expired certificate
+// as critical. Clusters whose CA has not been created yet have no advisory.
+func caCertificateAdvisories(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, now time.Time) ([]apiv2.ClusterAdvisory, error) {
+	if cluster.Status.NamespaceName == "" {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := seedClient.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: resources.CASecretName}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	block, _ := pem.Decode(secret.Data[resources.CACertSecretKey])
+	if block == nil {
+		return nil, errors.New("failed to decode the cluster CA certificate")
+	}
+	caCert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the cluster CA certificate: %w", err)
+	}
+
+	if now.Before(caCert.NotAfter.Add(-caCertificateExpiryWarningPeriod)) {
+		return nil, nil
+	}
+
+	advisory := apiv2.ClusterAdvisory{
+		ID:          fmt.Sprintf("ca-certificate-expiry-%s", caCert.NotAfter.UTC().Format(time.DateOnly)),
+		Severity:    apiv2.ClusterAdvisoryWarning,
+		Message:     fmt.Sprintf("The cluster CA certificate expires on %s", caCert.NotAfter.UTC().Format(time.DateOnly)),
+		Remediation: "Rotate the cluster CA certificate before it expires, the nodes have to be recreated afterwards.",
+	}
+	if !now.Before(caCert.NotAfter) {
+		advisory.Severity = apiv2.ClusterAdvisoryCritical
+		advisory.Message = fmt.Sprintf("The cluster CA certificate expired on %s", caCert.NotAfter.UTC().Format(time.DateOnly))
+	}
+
+	return []apiv2.ClusterAdvisory{advisory}, nil
+}
+
+// keepAdvisoryAcknowledgments copies the acknowledged advisories of the existing cluster to the patched one. The
+// advisories can only be acknowledged through their own endpoint.
+func keepAdvisoryAcknowledgments(oldCluster, newCluster *kubermaticv1.Cluster) {
+	value, ok := oldCluster.Annotations[clusterresources.AdvisoryAcknowledgmentsAnnotation]
+	if !ok {
+		delete(newCluster.Annotations, clusterresources.AdvisoryAcknowledgmentsAnnotation)
+		return
+	}
+	if newCluster.Annotations == nil {
+		newCluster.Annotations = map[string]string{}
+	}
+	newCluster.Annotations[clusterresources.AdvisoryAcknowledgmentsAnnotation] = value
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// crdInstancesLimit is the maximum number of custom resource definitions whose instances are counted.
+	crdInstancesLimit = 100
+	// crdInstancesTimeout is how long the instances of all custom resource definitions may be counted.
+	crdInstancesTimeout = 10 * time.Second
+)
+
+// ListClusterCRDsEndpoint returns the custom resource definitions of the user cluster, optionally only those of
+// the given group. If countInstances is set, the custom resources of every definition are counted as well.
+func ListClusterCRDsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, group string, countInstances bool) ([]apiv2.ClusterCRD, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	crdList := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := client.List(ctx, crdList); err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	result := []apiv2.ClusterCRD{}
+	crds := []apiextensionsv1.CustomResourceDefinition{}
+	for _, crd := range crdList.Items {
+		if group != "" && crd.Spec.Group != group {
+			continue
+		}
+		crds = append(crds, crd)
+	}
+	sort.Slice(crds, func(i, j int) bool {
+		return crds[i].Name < crds[j].Name
+	})
+
+	countCtx, cancel := context.WithTimeout(ctx, crdInstancesTimeout)
+	defer cancel()
+
+	for i, crd := range crds {
+		clusterCRD := convertInternalCRDToExternal(crd)
+		if countInstances && i < crdInstancesLimit {
+			clusterCRD.Instances = countCRDInstances(countCtx, client, crd)
+		}
+		result = append(result, clusterCRD)
+	}
+
+	return result, nil
+}
+
+func convertInternalCRDToExternal(crd apiextensionsv1.CustomResourceDefinition) apiv2.ClusterCRD {
+	clusterCRD := apiv2.ClusterCRD{
+		Name:     crd.Name,
+		Group:    crd.Spec.Group,
+		Kind:     crd.Spec.Names.Kind,
+		Versions: []string{},
+		Scope:    string(crd.Spec.Scope),
+	}
+	for _, version := range crd.Spec.Versions {
+		if version.Served {
+			clusterCRD.Versions = append(clusterCRD.Versions, version.Name)
+		}
+	}
+	for _, condition := range crd.Status.Conditions {
+		if condition.Type == apiextensionsv1.Established {
+			clusterCRD.Established = condition.Status == apiextensionsv1.ConditionTrue
+		}
+	}
+
+	return clusterCRD
+}
+
+// countCRDInstances returns the number of custom resources of the definition, or nil if they could not be counted.
+// Only a single resource is fetched, the API server reports how many remain.
+func countCRDInstances(ctx context.Context, client ctrlruntimeclient.Client, crd apiextensionsv1.CustomResourceDefinition) *int {
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	version := ""
+	for _, v := range crd.Spec.Versions {
+		if v.Served && (version == "" || v.Storage) {
+			version = v.Name
+		}
+	}
+	if version == "" {
+		return nil
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   crd.Spec.Group,
+		Version: version,
+		Kind:    crd.Spec.Names.ListKind,
+	})
+	if list.GetKind() == "" {
+		list.SetKind(crd.Spec.Names.Kind + "List")
+	}
+	if err := client.List(ctx, list, ctrlruntimeclient.Limit(1)); err != nil {
+		return nil
+	}
+
+	count := len(list.Items)
+	if list.GetContinue() != "" {
+		remaining := list.GetRemainingItemCount()
+		if remaining == nil {
+			return nil
+		}
+		count += int(*remaining)
+	}
+
+	return &count
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+)
+
+// clusterFetchRetryAfterSeconds is the suggested delay before listing the clusters of a broken seed again.
+const clusterFetchRetryAfterSeconds = 30
+
+// LastKnownClusters remembers the clusters of the last successful listing per seed and project. It allows to keep
+// showing the clusters of a seed which can't be reached instead of letting them disappear from the cluster list.
+type LastKnownClusters struct {
+	lock sync.RWMutex
+	// clusters maps seed names to project IDs to clusters.
+	clusters map[string]map[string][]apiv1.Cluster
+}
+
+func NewLastKnownClusters() *LastKnownClusters {
+	return &LastKnownClusters{
+		clusters: map[string]map[string][]apiv1.Cluster{},
+	}
+}
+
+// Set replaces the known clusters of the project in the seed. The machine deployment counts are not remembered as
+// they depend on the request.
+func (c *LastKnownClusters) Set(seed, projectID string, clusters []*apiv1.Cluster) {
+	known := make([]apiv1.Cluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		clusterCopy := *cluster
+		clusterCopy.MachineDeploymentCount = nil
+		known = append(known, clusterCopy)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.clusters[seed] == nil {
+		c.clusters[seed] = map[string][]apiv1.Cluster{}
+	}
+	c.clusters[seed][projectID] = known
+}
+
+// Get returns copies of the known clusters of the project in the seed.
+func (c *LastKnownClusters) Get(seed, projectID string) []*apiv1.Cluster {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	known := c.clusters[seed][projectID]
+	clusters := make([]*apiv1.Cluster, 0, len(known))
+	for i := range known {
+		clusterCopy := known[i]
+		clusters = append(clusters, &clusterCopy)
+	}
+
+	return clusters
+}
+
+// SeedClustersLister lists the clusters of a project in the seed using the cluster provider of the seed. It may return
+// the clusters it could convert together with an error.
+type SeedClustersLister func(ctx context.Context, seed *kubermaticv1.Seed, clusterProvider provider.ClusterProvider) ([]*apiv1.Cluster, error)
+
+// ListProjectClusters lists the clusters of the project in all seeds. The clusters of seeds which can't be listed are
+// taken from the last known clusters, or from the partial result of the lister, and marked with the unknown fetch
+// status. The fetch error is only added for admins. Seeds whose circuit breaker is open are not connected to and treated
+// as unreachable. The names of the broken seeds are returned along with the clusters.
+func ListProjectClusters(
+	ctx context.Context,
+	seeds map[string]*kubermaticv1.Seed,
+	clusterProviderGetter provider.ClusterProviderGetter,
+	userInfo *provider.UserInfo,
+	seedsGetter provider.SeedsGetter,
+	projectID string,
+	lastKnownClusters *LastKnownClusters,
+	seedCircuitBreaker *SeedCircuitBreaker,
+	listSeedClusters SeedClustersLister,
+) ([]*apiv1.Cluster, []string) {
+	seedNames := make([]string, 0, len(seeds))
+	for name := range seeds {
+		seedNames = append(seedNames, name)
+	}
+	sort.Strings(seedNames)
+
+	allClusters := make([]*apiv1.Cluster, 0)
+	brokenSeeds := []string{}
+	for _, name := range seedNames {
+		seed := seeds[name]
+
+		if seed.Status.Phase == kubermaticv1.SeedInvalidPhase {
+			kubermaticlog.Logger.Warnf("skipping seed %s as it is in an invalid phase", seed.Name)
+			brokenSeeds = append(brokenSeeds, seed.Name)
+			fetchErr := &apiv1.ClusterFetchError{
+				Reason:  apiv1.ClusterFetchErrorSeedInvalid,
+				Message: "seed is in an invalid phase",
+				Seed:    seed.Name,
+			}
+			allClusters = append(allClusters, unknownClusters(lastKnownClusters.Get(seed.Name, projectID), fetchErr, userInfo, seedsGetter)...)
+			continue
+		}
+
+		if allowed, cooldown := seedCircuitBreaker.Allow(seed.Name); !allowed {
+			brokenSeeds = append(brokenSeeds, seed.Name)
+			fetchErr := &apiv1.ClusterFetchError{
+				Reason:            apiv1.ClusterFetchErrorSeedUnreachable,
+				Message:           "seed is skipped after repeated connection failures",
+				Seed:              seed.Name,
+				Retryable:         true,
+				RetryAfterSeconds: int(math.Ceil(cooldown.Seconds())),
+			}
+			allClusters = append(allClusters, unknownClusters(lastKnownClusters.Get(seed.Name, projectID), fetchErr, userInfo, seedsGetter)...)
+			continue
+		}
+
+		seedClusterProvider, err := clusterProviderGetter(seed)
+		if err != nil {
+			kubermaticlog.Logger.Errorw("failed to create cluster provider", "seed", seed.Name, zap.Error(err))
+			seedCircuitBreaker.RecordFailure(seed.Name, err)
+			brokenSeeds = append(brokenSeeds, seed.Name)
+			fetchErr := &apiv1.ClusterFetchError{
+				Reason:            apiv1.ClusterFetchErrorSeedUnreachable,
+				Message:           err.Error(),
+				Seed:              seed.Name,
+				Retryable:         true,
+				RetryAfterSeconds: clusterFetchRetryAfterSeconds,
+			}
+			allClusters = append(allClusters, unknownClusters(lastKnownClusters.Get(seed.Name, projectID), fetchErr, userInfo, seedsGetter)...)
+			continue
+		}
+
+		seedClusters, err := listSeedClusters(ctx, seed, seedClusterProvider)
+		if err != nil {
+			kubermaticlog.Logger.Errorw("failed to get clusters from seed ", "seed", seed.Name, zap.Error(err))
+			seedCircuitBreaker.RecordFailure(seed.Name, err)
+			brokenSeeds = append(brokenSeeds, seed.Name)
+			fetchErr := &apiv1.ClusterFetchError{
+				Reason:            apiv1.ClusterFetchErrorListFailed,
+				Message:           err.Error(),
+				Seed:              seed.Name,
+				Retryable:         true,
+				RetryAfterSeconds: clusterFetchRetryAfterSeconds,
+			}
+			// prefer the partial result of the lister over the last known clusters
+			if len(seedClusters) == 0 {
+				seedClusters = lastKnownClusters.Get(seed.Name, projectID)
+			}
+			allClusters = append(allClusters, unknownClusters(seedClusters, fetchErr, userInfo, seedsGetter)...)
+			continue
+		}
+
+		seedCircuitBreaker.RecordSuccess(seed.Name)
+		lastKnownClusters.Set(seed.Name, projectID, seedClusters)
+		allClusters = append(allClusters, seedClusters...)
+	}
+
+	return allClusters, brokenSeeds
+}
+
+// unknownClusters marks the clusters with the unknown fetch status. Clusters in datacenters the user can't access are
+// left out, like GetClusters does for a successful listing.
+func unknownClusters(clusters []*apiv1.Cluster, fetchErr *apiv1.ClusterFetchError, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter) []*apiv1.Cluster {
+	result := make([]*apiv1.Cluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		if _, _, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName); err != nil {
+			continue
+		}
+
+		cluster.FetchStatus = apiv1.ClusterFetchStatusUnknown
+		if userInfo.IsAdmin {
+			cluster.FetchError = fetchErr
+		}
+		result = append(result, cluster)
+	}
+
+	return result
+}
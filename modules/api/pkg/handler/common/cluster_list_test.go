@@ -0,0 +1,310 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestListProjectClusters(t *testing.T) {
+	t.Parallel()
+
+	const projectID = "my-project"
+
+	genSeed := func(name, datacenter string) *kubermaticv1.Seed {
+		return &kubermaticv1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: kubermaticv1.SeedSpec{
+				Datacenters: map[string]kubermaticv1.Datacenter{
+					datacenter: {Spec: kubermaticv1.DatacenterSpec{Fake: &kubermaticv1.DatacenterSpecFake{}}},
+				},
+			},
+		}
+	}
+	genCluster := func(id, datacenter string) *apiv1.Cluster {
+		cluster := &apiv1.Cluster{ObjectMeta: apiv1.ObjectMeta{ID: id, Name: id}}
+		cluster.Spec.Cloud.DatacenterName = datacenter
+		return cluster
+	}
+	unknownCluster := func(id, datacenter string, fetchErr *apiv1.ClusterFetchError) *apiv1.Cluster {
+		cluster := genCluster(id, datacenter)
+		cluster.FetchStatus = apiv1.ClusterFetchStatusUnknown
+		cluster.FetchError = fetchErr
+		return cluster
+	}
+
+	testCases := []struct {
+		Name                string
+		IsAdmin             bool
+		WarmCache           bool
+		InvalidSeed         bool
+		UnreachableSeed     bool
+		ListError           error
+		PartialClusters     []*apiv1.Cluster
+		ExpectedClusters    []*apiv1.Cluster
+		ExpectedBrokenSeeds []string
+	}{
+		{
+			Name:      "scenario 1: the clusters of all seeds are listed",
+			WarmCache: true,
+			ExpectedClusters: []*apiv1.Cluster{
+				genCluster("healthy-1", "dc-a"),
+				genCluster("broken-1", "dc-b"),
+				genCluster("broken-2", "dc-b"),
+			},
+			ExpectedBrokenSeeds: []string{},
+		},
+		{
+			Name:            "scenario 2: the last known clusters of an unreachable seed are shown as unknown to a user",
+			WarmCache:       true,
+			UnreachableSeed: true,
+			ExpectedClusters: []*apiv1.Cluster{
+				genCluster("healthy-1", "dc-a"),
+				unknownCluster("broken-1", "dc-b", nil),
+				unknownCluster("broken-2", "dc-b", nil),
+			},
+			ExpectedBrokenSeeds: []string{"seed-b"},
+		},
+		{
+			Name:            "scenario 3: an admin gets the fetch error of the clusters of an unreachable seed",
+			IsAdmin:         true,
+			WarmCache:       true,
+			UnreachableSeed: true,
+			ExpectedClusters: []*apiv1.Cluster{
+				genCluster("healthy-1", "dc-a"),
+				unknownCluster("broken-1", "dc-b", &apiv1.ClusterFetchError{Reason: apiv1.ClusterFetchErrorSeedUnreachable, Message: "connection refused", Seed: "seed-b", Retryable: true, RetryAfterSeconds: 30}),
+				unknownCluster("broken-2", "dc-b", &apiv1.ClusterFetchError{Reason: apiv1.ClusterFetchErrorSeedUnreachable, Message: "connection refused", Seed: "seed-b", Retryable: true, RetryAfterSeconds: 30}),
+			},
+			ExpectedBrokenSeeds: []string{"seed-b"},
+		},
+		{
+			Name:        "scenario 4: the last known clusters of an invalid seed are not retryable",
+			IsAdmin:     true,
+			WarmCache:   true,
+			InvalidSeed: true,
+			ExpectedClusters: []*apiv1.Cluster{
+				genCluster("healthy-1", "dc-a"),
+				unknownCluster("broken-1", "dc-b", &apiv1.ClusterFetchError{Reason: apiv1.ClusterFetchErrorSeedInvalid, Message: "seed is in an invalid phase", Seed: "seed-b"}),
+				unknownCluster("broken-2", "dc-b", &apiv1.ClusterFetchError{Reason: apiv1.ClusterFetchErrorSeedInvalid, Message: "seed is in an invalid phase", Seed: "seed-b"}),
+			},
+			ExpectedBrokenSeeds: []string{"seed-b"},
+		},
+		{
+			Name:            "scenario 5: the partial result of a failed listing is preferred over the last known clusters",
+			IsAdmin:         true,
+			WarmCache:       true,
+			ListError:       errors.New("failed to list machine deployments"),
+			PartialClusters: []*apiv1.Cluster{genCluster("broken-3", "dc-b")},
+			ExpectedClusters: []*apiv1.Cluster{
+				genCluster("healthy-1", "dc-a"),
+				unknownCluster("broken-3", "dc-b", &apiv1.ClusterFetchError{Reason: apiv1.ClusterFetchErrorListFailed, Message: "failed to list machine deployments", Seed: "seed-b", Retryable: true, RetryAfterSeconds: 30}),
+			},
+			ExpectedBrokenSeeds: []string{"seed-b"},
+		},
+		{
+			Name:            "scenario 6: no clusters are shown for an unreachable seed without a successful listing",
+			UnreachableSeed: true,
+			ExpectedClusters: []*apiv1.Cluster{
+				genCluster("healthy-1", "dc-a"),
+			},
+			ExpectedBrokenSeeds: []string{"seed-b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			seeds := map[string]*kubermaticv1.Seed{
+				"seed-a": genSeed("seed-a", "dc-a"),
+				"seed-b": genSeed("seed-b", "dc-b"),
+			}
+			seedsGetter := func() (map[string]*kubermaticv1.Seed, error) {
+				return seeds, nil
+			}
+			userInfo := &provider.UserInfo{Email: "bob@acme.com", IsAdmin: tc.IsAdmin}
+
+			unreachable := false
+			clusterProviderGetter := func(seed *kubermaticv1.Seed) (provider.ClusterProvider, error) {
+				if unreachable && seed.Name == "seed-b" {
+					return nil, errors.New("connection refused")
+				}
+				return nil, nil
+			}
+			var listErr error
+			listSeedClusters := func(_ context.Context, seed *kubermaticv1.Seed, _ provider.ClusterProvider) ([]*apiv1.Cluster, error) {
+				if seed.Name == "seed-a" {
+					return []*apiv1.Cluster{genCluster("healthy-1", "dc-a")}, nil
+				}
+				if listErr != nil {
+					return tc.PartialClusters, listErr
+				}
+				return []*apiv1.Cluster{genCluster("broken-1", "dc-b"), genCluster("broken-2", "dc-b")}, nil
+			}
+
+			lastKnownClusters := NewLastKnownClusters()
+			if tc.WarmCache {
+				// a successful listing remembers the clusters of both seeds
+				ListProjectClusters(context.Background(), seeds, clusterProviderGetter, userInfo, seedsGetter, projectID, lastKnownClusters, NewSeedCircuitBreaker(SeedCircuitBreakerThreshold, SeedCircuitBreakerCooldown), listSeedClusters)
+			}
+
+			unreachable = tc.UnreachableSeed
+			listErr = tc.ListError
+			if tc.InvalidSeed {
+				seeds["seed-b"].Status.Phase = kubermaticv1.SeedInvalidPhase
+			}
+
+			clusters, brokenSeeds := ListProjectClusters(context.Background(), seeds, clusterProviderGetter, userInfo, seedsGetter, projectID, lastKnownClusters, NewSeedCircuitBreaker(SeedCircuitBreakerThreshold, SeedCircuitBreakerCooldown), listSeedClusters)
+			if !reflect.DeepEqual(clusters, tc.ExpectedClusters) {
+				t.Errorf("Expected clusters %+v, got %+v", tc.ExpectedClusters, clusters)
+			}
+			if !reflect.DeepEqual(brokenSeeds, tc.ExpectedBrokenSeeds) {
+				t.Errorf("Expected broken seeds %v, got %v", tc.ExpectedBrokenSeeds, brokenSeeds)
+			}
+		})
+	}
+}
+
+func TestLastKnownClustersOmitMachineDeploymentCount(t *testing.T) {
+	t.Parallel()
+
+	lastKnownClusters := NewLastKnownClusters()
+	lastKnownClusters.Set("seed-a", "my-project", []*apiv1.Cluster{{ObjectMeta: apiv1.ObjectMeta{ID: "cluster-1"}, MachineDeploymentCount: ptr.To(2)}})
+
+	clusters := lastKnownClusters.Get("seed-a", "my-project")
+	if len(clusters) != 1 || clusters[0].MachineDeploymentCount != nil {
+		t.Fatalf("Expected one cluster without machine deployment count, got %+v", clusters)
+	}
+	if other := lastKnownClusters.Get("seed-a", "other-project"); len(other) != 0 {
+		t.Fatalf("Expected no clusters for another project, got %+v", other)
+	}
+}
+
+func TestListProjectClustersSeedCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	const projectID = "my-project"
+
+	seeds := map[string]*kubermaticv1.Seed{
+		"seed-a": {ObjectMeta: metav1.ObjectMeta{Name: "seed-a"}},
+		"seed-b": {ObjectMeta: metav1.ObjectMeta{Name: "seed-b"}},
+	}
+	seedsGetter := func() (map[string]*kubermaticv1.Seed, error) {
+		return seeds, nil
+	}
+	userInfo := &provider.UserInfo{Email: "bob@acme.com", IsAdmin: true}
+
+	unreachable := true
+	attempts := map[string]int{}
+	clusterProviderGetter := func(seed *kubermaticv1.Seed) (provider.ClusterProvider, error) {
+		return nil, nil
+	}
+	// the clients are created lazily, so an unreachable seed only fails once its clusters are listed
+	listSeedClusters := func(_ context.Context, seed *kubermaticv1.Seed, _ provider.ClusterProvider) ([]*apiv1.Cluster, error) {
+		attempts[seed.Name]++
+		if unreachable && seed.Name == "seed-b" {
+			return nil, errors.New("connection timed out")
+		}
+		return nil, nil
+	}
+
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	breaker := NewSeedCircuitBreaker(2, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	list := func() []string {
+		_, brokenSeeds := ListProjectClusters(context.Background(), seeds, clusterProviderGetter, userInfo, seedsGetter, projectID, NewLastKnownClusters(), breaker, listSeedClusters)
+		return brokenSeeds
+	}
+	expectState := func(state string, failures int) {
+		t.Helper()
+		if got := breaker.State("seed-b"); got.State != state || got.ConsecutiveFailures != failures {
+			t.Fatalf("Expected breaker state %s with %d failures, got %+v", state, failures, got)
+		}
+	}
+
+	// the failures up to the threshold try to connect to the seed
+	for i := 0; i < 2; i++ {
+		if brokenSeeds := list(); !reflect.DeepEqual(brokenSeeds, []string{"seed-b"}) {
+			t.Fatalf("Expected seed-b to be broken, got %v", brokenSeeds)
+		}
+	}
+	expectState(apiv2.SeedCircuitBreakerOpen, 2)
+
+	// during the cooldown the seed fails fast without a connection attempt
+	now = now.Add(30 * time.Second)
+	if brokenSeeds := list(); !reflect.DeepEqual(brokenSeeds, []string{"seed-b"}) {
+		t.Fatalf("Expected seed-b to be broken, got %v", brokenSeeds)
+	}
+	if attempts["seed-b"] != 2 {
+		t.Fatalf("Expected no connection attempt during the cooldown, got %d attempts", attempts["seed-b"])
+	}
+	if attempts["seed-a"] != 3 {
+		t.Fatalf("Expected the healthy seed to be listed on every request, got %d attempts", attempts["seed-a"])
+	}
+
+	// a failed probe after the cooldown opens the breaker again
+	now = now.Add(time.Minute)
+	expectState(apiv2.SeedCircuitBreakerHalfOpen, 2)
+	list()
+	if attempts["seed-b"] != 3 {
+		t.Fatalf("Expected a probe after the cooldown, got %d attempts", attempts["seed-b"])
+	}
+	expectState(apiv2.SeedCircuitBreakerOpen, 3)
+
+	// a successful probe closes the breaker
+	unreachable = false
+	now = now.Add(time.Minute)
+	if brokenSeeds := list(); len(brokenSeeds) != 0 {
+		t.Fatalf("Expected no broken seeds after the recovery, got %v", brokenSeeds)
+	}
+	expectState(apiv2.SeedCircuitBreakerClosed, 0)
+}
+
+func TestSeedCircuitBreakerSingleProbe(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	breaker := NewSeedCircuitBreaker(1, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	breaker.RecordFailure("seed-a", errors.New("connection refused"))
+	if allowed, cooldown := breaker.Allow("seed-a"); allowed || cooldown != time.Minute {
+		t.Fatalf("Expected the seed to be rejected for a minute, got allowed %t and cooldown %s", allowed, cooldown)
+	}
+
+	now = now.Add(time.Minute)
+	if allowed, _ := breaker.Allow("seed-a"); !allowed {
+		t.Fatal("Expected the first request after the cooldown to probe the seed")
+	}
+	if allowed, _ := breaker.Allow("seed-a"); allowed {
+		t.Fatal("Expected other requests to be rejected while the seed is probed")
+	}
+	if state := breaker.State("seed-a"); state.LastError != "connection refused" {
+		t.Fatalf("Expected the last error to be kept, got %+v", state)
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/features"
+)
+
+const redactedCredential = "<redacted>"
+
+// cloudCredentialKeys are the (lowercase) substrings of the cloud spec fields which hold provider credentials.
+var cloudCredentialKeys = []string{
+	"password",
+	"secret",
+	"token",
+	"accesskey",
+	"apikey",
+	"privatekey",
+	"credential",
+	"kubeconfig",
+	"serviceaccount",
+}
+
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// PatchPreviewEndpoint applies the patch to the cluster exactly like PatchEndpoint, including defaulting and
+// validation, and returns the fields which would change. Neither the cluster nor its credentials are persisted.
+func PatchPreviewEndpoint(
+	ctx context.Context,
+	userInfoGetter provider.UserInfoGetter,
+	projectID string,
+	clusterID string,
+	patch json.RawMessage,
+	seedsGetter provider.SeedsGetter,
+	projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider,
+	caBundle *x509.CertPool,
+	configGetter provider.KubermaticConfigurationGetter,
+	features features.FeatureGate,
+	skipKubeletVersionValidation bool,
+	force bool,
+	resourceVersion string,
+	upgradeMachineDeployments bool,
+) (*apiv2.ClusterPatchPreview, error) {
+	patched, err := patchInternalCluster(ctx, userInfoGetter, projectID, clusterID, patch, seedsGetter, projectProvider, privilegedProjectProvider,
+		caBundle, configGetter, features, skipKubeletVersionValidation, force, resourceVersion, upgradeMachineDeployments, true)
+	if err != nil {
+		return nil, err
+	}
+
+	incompatibilities := patched.versionManager.GetIncompatibilities()
+	oldJSON, err := marshalPatchCluster(ConvertInternalClusterToExternal(patched.oldCluster, patched.dc, false, incompatibilities...))
+	if err != nil {
+		return nil, err
+	}
+	newJSON, err := marshalPatchCluster(ConvertInternalClusterToExternal(patched.newCluster, patched.dc, false, incompatibilities...))
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := clusterFieldChanges(oldJSON, newJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv2.ClusterPatchPreview{Changes: changes}, nil
+}
+
+// clusterFieldChanges compares the leaf fields of both clusters, lists are compared as a whole. The changes are
+// sorted by their path.
+func clusterFieldChanges(oldJSON, newJSON []byte) ([]apiv2.ClusterFieldChange, error) {
+	oldFields, err := flattenJSONPointers(oldJSON)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := flattenJSONPointers(newJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(newFields))
+	for path := range oldFields {
+		paths = append(paths, path)
+	}
+	for path := range newFields {
+		if _, ok := oldFields[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	changes := []apiv2.ClusterFieldChange{}
+	for _, path := range paths {
+		oldValue, newValue := oldFields[path], newFields[path]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		if isCloudCredential(path) {
+			oldValue, newValue = redactCredential(oldValue), redactCredential(newValue)
+		}
+		changes = append(changes, apiv2.ClusterFieldChange{Path: path, Old: oldValue, New: newValue})
+	}
+
+	return changes, nil
+}
+
+func flattenJSONPointers(raw []byte) (map[string]interface{}, error) {
+	var document interface{}
+	if err := json.Unmarshal(raw, &document); err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{}
+	flattenJSONPointer("", document, result)
+
+	return result, nil
+}
+
+func flattenJSONPointer(pointer string, value interface{}, result map[string]interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			flattenJSONPointer(pointer+"/"+jsonPointerEscaper.Replace(key), field, result)
+		}
+	case nil:
+	default:
+		result[pointer] = v
+	}
+}
+
+func isCloudCredential(pointer string) bool {
+	if !strings.HasPrefix(pointer, "/spec/cloud/") {
+		return false
+	}
+	pointer = strings.ToLower(pointer)
+	for _, key := range cloudCredentialKeys {
+		if strings.Contains(pointer, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func redactCredential(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	return redactedCredential
+}
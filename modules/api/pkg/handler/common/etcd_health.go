@@ -0,0 +1,232 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// etcdStatusTimeout is the time the etcd status may take, before the details are reported as unknown.
+const etcdStatusTimeout = 15 * time.Second
+
+// etcdAlarmTypes are the names of the alarm types of etcd by their number.
+var etcdAlarmTypes = map[int]string{
+	1: "NOSPACE",
+	2: "CORRUPT",
+}
+
+// EtcdStatus is the state of the etcd ring of a cluster.
+type EtcdStatus struct {
+	DBSize         int64
+	DBSizeInUse    int64
+	MemberCount    int
+	HealthyMembers int
+	Alarms         []apiv1.EtcdAlarm
+}
+
+// EtcdStatusGetter returns the state of the etcd ring of the cluster.
+type EtcdStatusGetter func(ctx context.Context, clusterProvider provider.ClusterProvider, seedClient kubernetes.Interface, cluster *kubermaticv1.Cluster) (*EtcdStatus, error)
+
+// GetEtcdStatus queries the etcd ring of the cluster with etcdctl in one of its etcd pods in the seed, it is
+// replaced in tests.
+var GetEtcdStatus EtcdStatusGetter = getEtcdStatusFromPod
+
+// EtcdHealthEndpoint returns the health of etcd from the cluster status along with the details queried from etcd.
+// If etcd cannot be reached the details are reported as unknown instead of failing the request.
+func EtcdHealthEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	existingCluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	health := apiv1.EtcdHealth{
+		Health: existingCluster.Status.ExtendedHealth.Etcd,
+		State:  apiv1.EtcdHealthStateUnknown,
+	}
+
+	statusCtx, cancel := context.WithTimeout(ctx, etcdStatusTimeout)
+	defer cancel()
+	status, err := GetEtcdStatus(statusCtx, clusterProvider, privilegedClusterProvider.GetSeedClusterAdminClient(), existingCluster)
+	if err != nil {
+		health.Message = fmt.Sprintf("failed to query etcd: %v", err)
+		return health, nil
+	}
+
+	hasQuorum := status.HealthyMembers > status.MemberCount/2
+	health.State = apiv1.EtcdHealthStateAvailable
+	health.DBSize = &status.DBSize
+	health.DBSizeInUse = &status.DBSizeInUse
+	health.MemberCount = &status.MemberCount
+	health.HasQuorum = &hasQuorum
+	health.Alarms = status.Alarms
+
+	return health, nil
+}
+
+type etcdMemberList struct {
+	Members []struct {
+		ID uint64 `json:"ID"`
+	} `json:"members"`
+}
+
+type etcdEndpointHealth struct {
+	Endpoint string `json:"endpoint"`
+	Health   bool   `json:"health"`
+}
+
+type etcdEndpointStatus struct {
+	Status struct {
+		DBSize      int64 `json:"dbSize"`
+		DBSizeInUse int64 `json:"dbSizeInUse"`
+	} `json:"Status"`
+}
+
+type etcdAlarmList struct {
+	Alarms []struct {
+		MemberID uint64 `json:"memberID"`
+		Alarm    int    `json:"alarm"`
+	} `json:"alarms"`
+}
+
+func getEtcdStatusFromPod(ctx context.Context, clusterProvider provider.ClusterProvider, seedClient kubernetes.Interface, cluster *kubermaticv1.Cluster) (*EtcdStatus, error) {
+	kubernetesClusterProvider, ok := clusterProvider.(*kubernetesprovider.ClusterProvider)
+	if !ok {
+		return nil, errors.New("the seed cluster can not be accessed")
+	}
+
+	labelSelector := fmt.Sprintf("%s=%s", resources.AppLabelKey, resources.EtcdStatefulSetName)
+	pod, err := common.GetReadyPod(ctx, seedClient.CoreV1().Pods(cluster.Status.NamespaceName), labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a ready etcd pod: %w", err)
+	}
+
+	etcdctl := func(out interface{}, args ...string) error {
+		stdout, err := execEtcdctl(ctx, kubernetesClusterProvider.SeedAdminConfig(), seedClient, pod, args...)
+		// etcdctl fails if any member is unhealthy, but still prints the state of all members
+		if jsonErr := json.Unmarshal(stdout, out); jsonErr != nil {
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("failed to parse the output of etcdctl %v: %w", args, jsonErr)
+		}
+		return nil
+	}
+
+	members := etcdMemberList{}
+	if err := etcdctl(&members, "member", "list"); err != nil {
+		return nil, err
+	}
+
+	endpointHealth := []etcdEndpointHealth{}
+	if err := etcdctl(&endpointHealth, "endpoint", "health", "--cluster"); err != nil {
+		return nil, err
+	}
+
+	endpointStatus := []etcdEndpointStatus{}
+	if err := etcdctl(&endpointStatus, "endpoint", "status"); err != nil {
+		return nil, err
+	}
+	if len(endpointStatus) == 0 {
+		return nil, errors.New("etcd did not report its status")
+	}
+
+	alarms := etcdAlarmList{}
+	if err := etcdctl(&alarms, "alarm", "list"); err != nil {
+		return nil, err
+	}
+
+	status := &EtcdStatus{
+		DBSize:      endpointStatus[0].Status.DBSize,
+		DBSizeInUse: endpointStatus[0].Status.DBSizeInUse,
+		MemberCount: len(members.Members),
+	}
+	for _, endpoint := range endpointHealth {
+		if endpoint.Health {
+			status.HealthyMembers++
+		}
+	}
+	for _, alarm := range alarms.Alarms {
+		alarmType, ok := etcdAlarmTypes[alarm.Alarm]
+		if !ok {
+			alarmType = strconv.Itoa(alarm.Alarm)
+		}
+		status.Alarms = append(status.Alarms, apiv1.EtcdAlarm{
+			MemberID: strconv.FormatUint(alarm.MemberID, 16),
+			Alarm:    alarmType,
+		})
+	}
+
+	return status, nil
+}
+
+// execEtcdctl runs etcdctl with JSON output in the etcd container of the pod. The container has the client
+// certificates configured in its environment.
+func execEtcdctl(ctx context.Context, cfg *rest.Config, seedClient kubernetes.Interface, pod *corev1.Pod, args ...string) ([]byte, error) {
+	req := seedClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(pod.Name).
+		Namespace(pod.Namespace).
+		SubResource("exec")
+
+	req.VersionedParams(&corev1.PodExecOptions{
+		Container: resources.EtcdStatefulSetName,
+		Command:   append([]string{"/usr/local/bin/etcdctl", "--command-timeout", "10s", "--write-out", "json"}, args...),
+		Stdout:    true,
+		Stderr:    true,
+	}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(cfg, http.MethodPost, req.URL())
+	if err != nil {
+		return nil, err
+	}
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if err := exec.StreamWithContext(ctx, remotecommand.StreamOptions{Stdout: stdout, Stderr: stderr}); err != nil {
+		return stdout.Bytes(), fmt.Errorf("etcdctl %v failed: %w: %s", args, err, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	return stdout.Bytes(), nil
+}
@@ -35,7 +35,9 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+	"k8c.io/dashboard/v2/pkg/serviceaccount"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 
@@ -161,6 +163,18 @@ func GetClusterSAKubeconfigEndpoint(ctx context.Context, userInfoGetter provider
 		return nil, err
 	}
 
+	saKubeConfig, err := NewServiceAccountKubeconfig(ctx, clusterProvider, cluster, serviceAccountName, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &encodeKubeConfigResponse{clientCfg: saKubeConfig, filePrefix: "sa-" + serviceAccountName}, nil
+}
+
+// NewServiceAccountKubeconfig returns a kubeconfig for the user cluster, which authenticates with the token of the
+// service account.
+func NewServiceAccountKubeconfig(ctx context.Context, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, serviceAccountName, token string) (*clientcmdapi.Config, error) {
+	clusterID := cluster.Name
 	adminKubeConfig, err := clusterProvider.GetAdminKubeconfigForUserCluster(ctx, cluster)
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
@@ -199,7 +213,7 @@ func GetClusterSAKubeconfigEndpoint(ctx context.Context, userInfoGetter provider
 	saKubeConfig.Contexts[clusterID] = clientCmdCtx
 	saKubeConfig.CurrentContext = clusterID
 
-	return &encodeKubeConfigResponse{clientCfg: saKubeConfig, filePrefix: userName}, nil
+	return saKubeConfig, nil
 }
 
 // getServiceAccountToken returns the token associated to the k8s service account named serviceAccountID in serviceAccountNamespace.
@@ -262,6 +276,49 @@ func GetOidcKubeconfigEndpoint(ctx context.Context, userInfoGetter provider.User
 	return &encodeKubeConfigResponse{clientCfg: adminClientCfg, filePrefix: oidc}, nil
 }
 
+// GetServiceAccountKubeconfigEndpoint returns the kubeconfig for the cluster which authenticates with the token of the
+// project service account sending the request. The service account is mapped to its project group.
+func GetServiceAccountKubeconfigEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, tokenAuthenticator serviceaccount.TokenAuthenticator) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	token, _ := ctx.Value(middleware.RawTokenContextKey).(string)
+	_, claims, err := tokenAuthenticator.Authenticate(token)
+	if err != nil {
+		return nil, utilerrors.New(http.StatusForbidden, fmt.Sprintf("invalid service account token: %v", err))
+	}
+	if !kubermaticv1helper.IsProjectServiceAccount(claims.Email) || claims.ProjectID != projectID {
+		return nil, utilerrors.New(http.StatusForbidden, fmt.Sprintf("the token doesn't belong to a service account of project %s", projectID))
+	}
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Labels[kubermaticv1.ProjectIDLabelKey] != claims.ProjectID {
+		return nil, utilerrors.New(http.StatusForbidden, fmt.Sprintf("cluster %s doesn't belong to project %s", clusterID, claims.ProjectID))
+	}
+
+	userInfo, err := userInfoGetter(ctx, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	clientCfg, err := clusterProvider.GetAdminKubeconfigForUserCluster(ctx, cluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	clientCmdAuth := clientcmdapi.NewAuthInfo()
+	clientCmdAuth.Token = token
+	clientCmdAuth.Impersonate = claims.Email
+	clientCmdAuth.ImpersonateGroups = userInfo.Groups
+
+	clientCfg.AuthInfos = map[string]*clientcmdapi.AuthInfo{}
+	clientCfg.AuthInfos["default"] = clientCmdAuth
+
+	return &encodeKubeConfigResponse{clientCfg: clientCfg, filePrefix: "serviceaccount"}, nil
+}
+
 func GetClusterOidcEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
 	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
 	if err != nil {
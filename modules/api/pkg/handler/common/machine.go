@@ -17,11 +17,18 @@ limitations under the License.
 package common
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -30,24 +37,39 @@ import (
 	jsonpatch "github.com/evanphx/json-patch"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/handler/v1/label"
 	machineconversions "k8c.io/dashboard/v2/pkg/machine"
 	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/azure"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/hetzner"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/kubevirt"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/openstack"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/vsphere"
 	"k8c.io/dashboard/v2/pkg/resources/machine"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 	"k8c.io/kubermatic/v2/pkg/validation/nodeupdate"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 	"k8c.io/machine-controller/sdk/bootstrap"
+	osmresources "k8c.io/operating-system-manager/pkg/controllers/osc/resources"
+	osmv1alpha1 "k8c.io/operating-system-manager/pkg/crd/osm/v1alpha1"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	"k8s.io/utils/ptr"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -60,51 +82,405 @@ const (
 
 	MachineDeploymentEventWarningType = "warning"
 	MachineDeploymentEventNormalType  = "normal"
+
+	JoiningScriptFormatShell     = "shell"
+	JoiningScriptFormatCloudInit = "cloud-init"
+	JoiningScriptFormatJSON      = "json"
+
+	// systemLabelPrefix is the prefix of the node labels which identify the cluster and project of a machine.
+	systemLabelPrefix = "system/"
+	// machineDeploymentControllerAnnotationPrefix is the prefix of the annotations which the machine-controller
+	// manages on machine deployments, e.g. the revision.
+	machineDeploymentControllerAnnotationPrefix = "machinedeployment.clusters.k8s.io/"
+
+	joiningScriptPath            = "/opt/bin/fetch-bootstrap-script.sh"
+	joiningScriptCAConfigMapName = "kube-root-ca.crt"
+	joiningScriptCAConfigMapKey  = "ca.crt"
+
+	// spotInterruptionsPeriod is the period in which the spot interruptions of a node deployment are counted.
+	spotInterruptionsPeriod = 24 * time.Hour
 )
 
-func CreateMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, sshKeyProvider provider.SSHKeyProvider, seedsGetter provider.SeedsGetter, machineDeployment apiv1.NodeDeployment, projectID, clusterID string, settingsProvider provider.SettingsProvider) (interface{}, error) {
+var joiningScriptTokenRegexp = regexp.MustCompile(`Authorization: Bearer ([^']+)' (\S+)/api/v1/`)
+
+// MachineDeploymentOptions controls the checks of machine deployment creates, validations and patches. The zero value
+// enforces all of them.
+type MachineDeploymentOptions struct {
+	// OverrideInstanceTypeFilter lets admins use instance types which the filter of the datacenter rejects.
+	OverrideInstanceTypeFilter bool
+	// OverrideSizeLimits lets admins exceed the global machine deployment size limits.
+	OverrideSizeLimits bool
+	// SkipCloudValidation skips the checks against the API of the cloud provider, e.g. if it can't be reached.
+	SkipCloudValidation bool
+	// Force applies a patch outside of the maintenance window of the cluster. It is only used by patches.
+	Force bool
+}
+
+// CreateMachineDeployment creates the machine deployment in the user cluster. The instance type filter of the
+// datacenter and the global size limits are enforced, unless an admin overrides them. The checks against the API of
+// the cloud provider can be skipped, if the API can't be reached.
+func CreateMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, sshKeyProvider provider.SSHKeyProvider, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, machineDeployment apiv1.NodeDeployment, projectID, clusterID string, settingsProvider provider.SettingsProvider, caBundle *x509.CertPool, opts MachineDeploymentOptions) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 
-	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	project, cluster, userInfo, err := getProjectAndClusterForMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, err
 	}
 
-	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, project.Name)
 	if err != nil {
 		return nil, err
 	}
 
-	isBYO, err := common.IsBringYourOwnProvider(cluster.Spec.Cloud)
+	if err := ensureMachineDeploymentNameIsFree(ctx, client, cluster, machineDeployment.Name); err != nil {
+		return nil, err
+	}
+
+	if err := applyNodeSpecDefaults(userInfo, seedsGetter, cluster, &machineDeployment); err != nil {
+		return nil, err
+	}
+
+	if err := applyMachineDeploymentSizeLimits(ctx, settingsProvider, userInfo, opts.OverrideSizeLimits, &machineDeployment.Spec, nil); err != nil {
+		return nil, err
+	}
+
+	errs := validateNodeDeployment(cluster, &machineDeployment)
+	if err := validateOperatingSystemProfile(ctx, client, machineDeployment.Spec.Template.OSProfile); err != nil {
+		if !errors.Is(err, errUnknownOperatingSystemProfile) {
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return nil, common.WithReason(validationErrorReason(errs[0]), utilerrors.NewBadRequest("%v", errs[0]))
+	}
+
+	if err := enforceProjectNodeQuota(ctx, clusterProviderGetter, seedsGetter, project, machine.NodeDeploymentNodes(&machineDeployment.Spec), 1); err != nil {
+		return nil, err
+	}
+
+	md, err := defaultMachineDeployment(ctx, sshKeyProvider, seedsGetter, settingsProvider, userInfo, project, cluster, &machineDeployment, caBundle, opts, false)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, err
 	}
-	if isBYO {
-		return nil, utilerrors.NewBadRequest("You cannot create a node deployment for KubeAdm provider")
+
+	if machineDeployment.GenerateName != "" {
+		err = machine.CreateWithGeneratedName(ctx, client, md, machineDeployment.GenerateName)
+	} else {
+		err = client.Create(ctx, md)
+	}
+	if err != nil {
+		// The name may have been taken since it was checked above.
+		if apierrors.IsAlreadyExists(err) {
+			return nil, newMachineDeploymentExistsError(cluster, md.Name)
+		}
+		return nil, common.UpstreamErrorToHTTPError(fmt.Errorf("failed to create machine deployment: %w", err), common.UpstreamUserCluster)
 	}
 
-	keys, err := sshKeyProvider.List(ctx, project, &provider.SSHKeyListOptions{ClusterName: clusterID})
+	return outputMachineDeploymentForUser(md, userInfo)
+}
+
+// CopyMachineDeployment creates a copy of the machine deployment in another cluster of the same project. The copy
+// is created through CreateMachineDeployment, so it is validated against the target cluster, e.g. the kubelet version
+// against its control plane version. The source machine deployment is left untouched. The target context has to
+// carry the cluster providers of the seed of the target cluster.
+func CopyMachineDeployment(ctx, targetCtx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, sshKeyProvider provider.SSHKeyProvider, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, projectID, clusterID, machineDeploymentID, targetClusterID string, settingsProvider provider.SettingsProvider, caBundle *x509.CertPool) (interface{}, error) {
+	if targetClusterID == clusterID {
+		return nil, utilerrors.NewBadRequest("the target cluster must differ from the source cluster")
+	}
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+	targetCluster, err := GetCluster(targetCtx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, targetClusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceProvider, err := kubermaticv1helper.ClusterCloudProviderName(cluster.Spec.Cloud)
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
+	targetProvider, err := kubermaticv1helper.ClusterCloudProviderName(targetCluster.Spec.Cloud)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if sourceProvider != targetProvider {
+		return nil, utilerrors.NewBadRequest("cannot copy machine deployment: cloud provider %q of the target cluster does not match cloud provider %q of the source cluster", targetProvider, sourceProvider)
+	}
+
+	rawNodeDeployment, err := GetMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, projectID, clusterID, machineDeploymentID, false)
+	if err != nil {
+		return nil, err
+	}
+	source := rawNodeDeployment.(*apiv1.NodeDeployment)
+
+	return CreateMachineDeployment(targetCtx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, clusterProviderGetter, copyNodeDeployment(source), projectID, targetClusterID, settingsProvider, caBundle, MachineDeploymentOptions{})
+}
+
+// copyNodeDeployment returns the node deployment without the fields which are specific to the source machine
+// deployment. The status, IDs and timestamps are dropped, as are the system labels and the annotations which are
+// generated from the spec, they are set again when the copy is created.
+func copyNodeDeployment(source *apiv1.NodeDeployment) apiv1.NodeDeployment {
+	nd := apiv1.NodeDeployment{
+		ObjectMeta: apiv1.ObjectMeta{
+			Name:        source.Name,
+			Annotations: map[string]string{},
+		},
+		Spec: source.Spec,
+	}
+
+	for key, value := range source.Annotations {
+		if strings.HasPrefix(key, machine.AutoscalerAnnotationPrefix) || strings.HasPrefix(key, machineDeploymentControllerAnnotationPrefix) {
+			continue
+		}
+		nd.Annotations[key] = value
+	}
+
+	templateLabels := map[string]string{}
+	for key, value := range source.Spec.Template.Labels {
+		if !strings.HasPrefix(key, systemLabelPrefix) {
+			templateLabels[key] = value
+		}
+	}
+	nd.Spec.Template.Labels = templateLabels
+
+	return nd
+}
+
+// ValidateMachineDeployment runs the same validation and defaulting as CreateMachineDeployment, without
+// creating anything. All validation errors are returned at once in the details of the error.
+func ValidateMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, sshKeyProvider provider.SSHKeyProvider, seedsGetter provider.SeedsGetter, machineDeployment apiv1.NodeDeployment, projectID, clusterID string, settingsProvider provider.SettingsProvider, caBundle *x509.CertPool, opts MachineDeploymentOptions) (*apiv1.NodeDeployment, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	project, cluster, userInfo, err := getProjectAndClusterForMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
 
 	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, project.Name)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := ensureMachineDeploymentNameIsFree(ctx, client, cluster, machineDeployment.Name); err != nil {
+		return nil, err
+	}
+
+	if err := applyNodeSpecDefaults(userInfo, seedsGetter, cluster, &machineDeployment); err != nil {
+		return nil, err
+	}
+
+	if err := applyMachineDeploymentSizeLimits(ctx, settingsProvider, userInfo, opts.OverrideSizeLimits, &machineDeployment.Spec, nil); err != nil {
+		return nil, err
+	}
+
+	errs := validateNodeDeployment(cluster, &machineDeployment)
+	if err := validateOperatingSystemProfile(ctx, client, machineDeployment.Spec.Template.OSProfile); err != nil {
+		if !errors.Is(err, errUnknownOperatingSystemProfile) {
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		details := make([]string, 0, len(errs))
+		for _, err := range errs {
+			details = append(details, err.Error())
+		}
+		return nil, utilerrors.NewWithDetails(http.StatusBadRequest, "node deployment validation failed, please examine details field for more info", details)
+	}
+
+	md, err := defaultMachineDeployment(ctx, sshKeyProvider, seedsGetter, settingsProvider, userInfo, project, cluster, &machineDeployment, caBundle, opts, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return outputMachineDeploymentForUser(md, userInfo)
+}
+
+// ensureMachineDeploymentNameIsFree returns a 409 if the cluster has a machine deployment with the name already. An
+// empty name is generated later on and always free.
+func ensureMachineDeploymentNameIsFree(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: name}, &clusterv1alpha1.MachineDeployment{})
+	switch {
+	case err == nil:
+		return newMachineDeploymentExistsError(cluster, name)
+	case apierrors.IsNotFound(err):
+		return nil
+	default:
+		return common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+}
+
+// newMachineDeploymentExistsError returns the 409 for a machine deployment name which is taken. Unlike the error of the
+// user cluster, it names the cluster instead of the namespace of the machine deployments.
+func newMachineDeploymentExistsError(cluster *kubermaticv1.Cluster, name string) error {
+	return utilerrors.New(http.StatusConflict, fmt.Sprintf("machine deployment %q already exists in cluster %s", name, cluster.Name))
+}
+
+// applyNodeSpecDefaults merges the default node spec of the datacenter of the cluster into the node spec of the node
+// deployment, if it asks for the defaults.
+func applyNodeSpecDefaults(userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, cluster *kubermaticv1.Cluster, nd *apiv1.NodeDeployment) error {
+	if !nd.UseDefaults {
+		return nil
+	}
+
+	seed, _, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName)
+	if err != nil {
+		return fmt.Errorf("error getting dc: %w", err)
+	}
+
+	defaults, err := machine.DefaultNodeSpec(seed, cluster.Spec.Cloud.DatacenterName)
+	if err != nil {
+		if errors.Is(err, machine.ErrNoDefaultNodeSpec) {
+			return utilerrors.NewBadRequest("%v", err)
+		}
+		return err
+	}
+	machine.MergeNodeSpecDefaults(&nd.Spec.Template, defaults)
+
+	return nil
+}
+
+func getProjectAndClusterForMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) (*kubermaticv1.Project, *kubermaticv1.Cluster, *provider.UserInfo, error) {
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, nil, nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	isBYO, err := common.IsBringYourOwnProvider(cluster.Spec.Cloud)
+	if err != nil {
+		return nil, nil, nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if isBYO {
+		return nil, nil, nil, utilerrors.NewBadRequest("You cannot create a node deployment for KubeAdm provider")
+	}
+
 	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, nil, nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return project, cluster, userInfo, nil
+}
+
+// validateNodeDeployment validates a node deployment before a machine deployment is created from it.
+// The checks are independent of each other, so that all problems can be reported at once. Note that
+// the node deployment is defaulted during the validation, e.g. the kubelet version is set.
+func validateNodeDeployment(cluster *kubermaticv1.Cluster, nd *apiv1.NodeDeployment) []error {
+	var errs []error
+
+	if errMsg := ValidateAutoscalingOptions(&nd.Spec); errMsg != "" {
+		errs = append(errs, common.WithReason(common.ReasonAutoscalerBounds, errors.New(errMsg)))
+	}
+
+	if err := machine.ValidateAutoscalerAnnotations(nil, nd.Annotations); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := machine.ValidateGenerateName(nd); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := machine.ValidateNetwork(cluster, nd.Spec.Template.Network); err != nil {
+		errs = append(errs, fmt.Errorf("node deployment validation failed: %w", err))
+	}
+
+	if err := machine.ValidateVSphereAdditionalNetworks(cluster, nd.Spec.Template.Cloud); err != nil {
+		errs = append(errs, fmt.Errorf("node deployment validation failed: %w", err))
+	}
+
+	if _, err := machine.Validate(nd, cluster.Spec.Version.Semver()); err != nil {
+		errs = append(errs, fmt.Errorf("node deployment validation failed: %w", err))
+	} else if err := machine.ValidateCloudProvider(cluster, nd); err != nil {
+		// The cloud provider can only be compared once the cloud spec has been validated.
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// validationErrorReason returns the reason of an error returned by validateNodeDeployment.
+func validationErrorReason(err error) string {
+	if errors.Is(err, nodeupdate.VersionSkewError{}) {
+		return common.ReasonVersionSkew
+	}
+	return common.ErrorReason(err)
+}
+
+var errUnknownOperatingSystemProfile = errors.New("operating system profile does not exist in the cluster")
+
+// validateOperatingSystemProfile ensures that the operating system profile exists in the user cluster. An
+// empty name is valid, the default profile of the operating system is used then.
+func validateOperatingSystemProfile(ctx context.Context, client ctrlruntimeclient.Client, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	ospList := &osmv1alpha1.OperatingSystemProfileList{}
+	if err := client.List(ctx, ospList, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return fmt.Errorf("failed to list operating system profiles: %w", err)
+	}
+
+	for _, osp := range ospList.Items {
+		if osp.Name == name {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %q", errUnknownOperatingSystemProfile, name)
+}
+
+// defaultMachineDeployment returns the machine deployment for a validated node deployment.
+func defaultMachineDeployment(ctx context.Context, sshKeyProvider provider.SSHKeyProvider, seedsGetter provider.SeedsGetter, settingsProvider provider.SettingsProvider, userInfo *provider.UserInfo, project *kubermaticv1.Project, cluster *kubermaticv1.Cluster, nd *apiv1.NodeDeployment, caBundle *x509.CertPool, opts MachineDeploymentOptions, dryRun bool) (*clusterv1alpha1.MachineDeployment, error) {
+	keys, err := sshKeyProvider.List(ctx, project, &provider.SSHKeyListOptions{ClusterName: cluster.Name})
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
-	_, dc, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName)
+
+	seed, dc, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName)
 	if err != nil {
 		return nil, fmt.Errorf("error getting dc: %w", err)
 	}
 
-	nd, err := machine.Validate(&machineDeployment, cluster.Spec.Version.Semver())
-	if err != nil {
-		return nil, utilerrors.NewBadRequest("node deployment validation failed: %s", err)
+	if err := validateInstanceTypeFilter(seed, cluster.Spec.Cloud.DatacenterName, userInfo, opts.OverrideInstanceTypeFilter, nd.Spec.Template.Cloud); err != nil {
+		return nil, err
+	}
+
+	if !opts.SkipCloudValidation {
+		if err := validateOpenstackFlavorAndZone(ctx, cluster, dc, nd.Spec.Template.Cloud.Openstack, nil, caBundle); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ensureOpenstackServerGroup(ctx, cluster, dc, nd, caBundle, dryRun); err != nil {
+		return nil, err
+	}
+
+	if err := validateAzureAvailabilityZones(ctx, cluster, dc, nd); err != nil {
+		return nil, err
+	}
+
+	if err := validateKubeVirtReferences(ctx, cluster, dc, nd.Spec.Template.Cloud.Kubevirt, nil); err != nil {
+		return nil, err
+	}
+
+	if err := validateVSphereAdditionalNetworks(ctx, cluster, dc, nd.Spec.Template.Cloud.VSphere, nil, caBundle); err != nil {
+		return nil, err
+	}
+
+	if err := validateHetznerReferences(ctx, cluster, nd.Spec.Template.Cloud.Hetzner, nil); err != nil {
+		return nil, err
 	}
 
 	md, err := machine.Deployment(ctx, cluster, nd, dc, keys, settingsProvider)
@@ -112,11 +488,402 @@ func CreateMachineDeployment(ctx context.Context, userInfoGetter provider.UserIn
 		return nil, fmt.Errorf("failed to create machine deployment from template: %w", err)
 	}
 
-	if err := client.Create(ctx, md); err != nil {
-		return nil, fmt.Errorf("failed to create machine deployment: %w", err)
+	return md, nil
+}
+
+// validateInstanceTypeFilter rejects instance types which are not allowed by the instance type filter of the
+// datacenter. Admins can override the filter.
+func validateInstanceTypeFilter(seed *kubermaticv1.Seed, datacenter string, userInfo *provider.UserInfo, override bool, cloud apiv1.NodeCloudSpec) error {
+	if override && userInfo.IsAdmin {
+		return nil
+	}
+
+	filter, err := machine.GetInstanceTypeFilter(seed, datacenter)
+	if err != nil {
+		return err
+	}
+	if err := machine.ValidateInstanceType(filter, datacenter, cloud); err != nil {
+		return utilerrors.New(http.StatusForbidden, err.Error())
+	}
+
+	return nil
+}
+
+// applyMachineDeploymentSizeLimits checks the size of the node deployment against the global size limits of the
+// admin settings, new node deployments get the default number of replicas first. For existing node deployments
+// only increased values are checked. Admins can override the limits.
+func applyMachineDeploymentSizeLimits(ctx context.Context, settingsProvider provider.SettingsProvider, userInfo *provider.UserInfo, override bool, spec, existing *apiv1.NodeDeploymentSpec) error {
+	settings, err := settingsProvider.GetGlobalSettings(ctx)
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+
+	limits, err := machine.GetMachineDeploymentSizeLimits(settings)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		machine.DefaultReplicas(limits, spec)
+	}
+
+	if override && userInfo.IsAdmin {
+		return nil
+	}
+	if err := machine.ValidateMachineDeploymentSize(limits, spec, existing); err != nil {
+		return common.WithReason(common.ReasonSizeLimit, utilerrors.NewBadRequest("%v", err))
+	}
+
+	return nil
+}
+
+// ensureOpenstackServerGroup checks that the server group of an OpenStack node deployment exists and replaces its
+// name with its ID, which is what the machine-controller expects. A missing server group is created if the node
+// deployment asks for it. In a dry run nothing is created and a missing server group which would be created is fine.
+func ensureOpenstackServerGroup(ctx context.Context, cluster *kubermaticv1.Cluster, dc *kubermaticv1.Datacenter, nd *apiv1.NodeDeployment, caBundle *x509.CertPool, dryRun bool) error {
+	spec := nd.Spec.Template.Cloud.Openstack
+	if spec == nil || spec.ServerGroup == "" || cluster.Spec.Cloud.Openstack == nil || dc.Spec.Openstack == nil {
+		return nil
+	}
+
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	secretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, privilegedClusterProvider.GetSeedClusterAdminRuntimeClient())
+	credentials, err := openstack.GetCredentialsForCluster(cluster.Spec.Cloud, secretKeySelector)
+	if err != nil {
+		return err
+	}
+
+	serverGroup, err := openstack.EnsureServerGroup(ctx, dc.Spec.Openstack.AuthURL, dc.Spec.Openstack.Region, credentials, caBundle, spec.ServerGroup, spec.CreateServerGroup && !dryRun)
+	if err != nil {
+		if errors.Is(err, openstack.ErrServerGroupNotFound) && spec.CreateServerGroup && dryRun {
+			return nil
+		}
+		if errors.Is(err, openstack.ErrServerGroupNotFound) || errors.Is(err, openstack.ErrServerGroupNameNotUnique) {
+			return utilerrors.NewBadRequest("node deployment validation failed: %v", err)
+		}
+		return err
+	}
+
+	spec.ServerGroup = serverGroup.ID
+	spec.CreateServerGroup = false
+	return nil
+}
+
+// ListOpenstackFlavors lists the flavors of an OpenStack region, it is replaced in tests.
+var ListOpenstackFlavors = openstack.GetFlavors
+
+// ListOpenstackAvailabilityZones lists the availability zones of an OpenStack region, it is replaced in tests.
+var ListOpenstackAvailabilityZones = openstack.GetAvailabilityZones
+
+// validateOpenstackFlavorAndZone checks that the flavor and the availability zone of an OpenStack node deployment
+// exist, so that a wrong flavor or zone doesn't fail only when nova schedules the instances. As for the KubeVirt
+// references, only values which differ from the existing node spec are checked. The flavor can be referenced by name
+// or ID. Without an availability zone the default zone of the datacenter is used, which is not checked.
+func validateOpenstackFlavorAndZone(ctx context.Context, cluster *kubermaticv1.Cluster, dc *kubermaticv1.Datacenter, spec, existing *apiv1.OpenstackNodeSpec, caBundle *x509.CertPool) error {
+	if spec == nil || cluster.Spec.Cloud.Openstack == nil || dc.Spec.Openstack == nil {
+		return nil
+	}
+	if existing == nil {
+		existing = &apiv1.OpenstackNodeSpec{}
+	}
+
+	flavor := ""
+	if spec.Flavor != existing.Flavor {
+		flavor = spec.Flavor
+	}
+	zone := ""
+	if spec.AvailabilityZone != existing.AvailabilityZone {
+		zone = spec.AvailabilityZone
+	}
+	if flavor == "" && zone == "" {
+		return nil
+	}
+
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	secretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, privilegedClusterProvider.GetSeedClusterAdminRuntimeClient())
+	credentials, err := openstack.GetCredentialsForCluster(cluster.Spec.Cloud, secretKeySelector)
+	if err != nil {
+		return err
+	}
+
+	if flavor != "" {
+		flavors, err := ListOpenstackFlavors(dc.Spec.Openstack.AuthURL, dc.Spec.Openstack.Region, credentials, caBundle)
+		if err != nil {
+			return fmt.Errorf("failed to list flavors: %w", err)
+		}
+		available := sets.New[string]()
+		references := sets.New[string]()
+		for _, f := range flavors {
+			available.Insert(f.Name)
+			references.Insert(f.Name, f.ID)
+		}
+		if !references.Has(flavor) {
+			return utilerrors.NewBadRequest("node deployment validation failed: flavor %q does not exist in the OpenStack region %q, available flavors are %v", flavor, dc.Spec.Openstack.Region, sets.List(available))
+		}
+	}
+
+	if zone != "" {
+		zones, err := ListOpenstackAvailabilityZones(ctx, dc.Spec.Openstack.AuthURL, dc.Spec.Openstack.Region, credentials, caBundle)
+		if err != nil {
+			return fmt.Errorf("failed to list availability zones: %w", err)
+		}
+		available := sets.New[string]()
+		for _, z := range zones {
+			available.Insert(z.ZoneName)
+		}
+		if !available.Has(zone) {
+			return utilerrors.NewBadRequest("node deployment validation failed: availability zone %q does not exist in the OpenStack region %q, available availability zones are %v", zone, dc.Spec.Openstack.Region, sets.List(available))
+		}
+	}
+
+	return nil
+}
+
+// validateAzureAvailabilityZones checks that the VM size of an Azure node deployment is available in the
+// selected availability zones, so that a wrong zone doesn't fail only when the machines are provisioned.
+func validateAzureAvailabilityZones(ctx context.Context, cluster *kubermaticv1.Cluster, dc *kubermaticv1.Datacenter, nd *apiv1.NodeDeployment) error {
+	spec := nd.Spec.Template.Cloud.Azure
+	if spec == nil || len(spec.Zones) == 0 || cluster.Spec.Cloud.Azure == nil || dc.Spec.Azure == nil {
+		return nil
+	}
+
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	secretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, privilegedClusterProvider.GetSeedClusterAdminRuntimeClient())
+	credentials, err := azure.GetCredentialsForCluster(cluster.Spec.Cloud, secretKeySelector)
+	if err != nil {
+		return err
+	}
+
+	available, err := azure.ListAvailabilityZones(ctx, credentials, dc.Spec.Azure.Location, spec.Size)
+	if err != nil {
+		return err
+	}
+
+	if err := azure.ValidateAvailabilityZones(spec.Zones, available, dc.Spec.Azure.Location, spec.Size); err != nil {
+		return utilerrors.NewBadRequest("node deployment validation failed: %v", err)
+	}
+
+	return nil
+}
+
+// NewKubeVirtClient creates the client for the KubeVirt infra cluster, it is replaced in tests.
+var NewKubeVirtClient = kubevirt.NewClient
+
+// validateKubeVirtReferences checks that the instancetype, preference and priority class of a KubeVirt node
+// deployment exist in the infra cluster, instead of letting the machine-controller fail to create the VMs. Only
+// references which differ from the existing node spec are checked, so existing node deployments can still be
+// patched if a referenced object has been removed in the meantime.
+func validateKubeVirtReferences(ctx context.Context, cluster *kubermaticv1.Cluster, dc *kubermaticv1.Datacenter, spec, existing *apiv1.KubevirtNodeSpec) error {
+	if spec == nil || cluster.Spec.Cloud.Kubevirt == nil {
+		return nil
+	}
+	if existing == nil {
+		existing = &apiv1.KubevirtNodeSpec{}
+	}
+
+	instancetype := ""
+	if spec.Instancetype != nil && (existing.Instancetype == nil || existing.Instancetype.Name != spec.Instancetype.Name) {
+		instancetype = spec.Instancetype.Name
+	}
+	preference := ""
+	if spec.Preference != nil && (existing.Preference == nil || existing.Preference.Name != spec.Preference.Name) {
+		preference = spec.Preference.Name
+	}
+	priorityClass := ""
+	if spec.PriorityClassName != existing.PriorityClassName {
+		priorityClass = spec.PriorityClassName
+	}
+	if instancetype == "" && preference == "" && priorityClass == "" {
+		return nil
+	}
+
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	secretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, privilegedClusterProvider.GetSeedClusterAdminRuntimeClient())
+	kubeconfig, err := kubevirt.GetCredentialsForCluster(cluster.Spec.Cloud, secretKeySelector)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewKubeVirtClient(kubeconfig, kubevirt.ClientOptions{})
+	if err != nil {
+		return err
+	}
+
+	if instancetype != "" {
+		available, err := kubevirt.ListInstancetypeNames(ctx, client, dc)
+		if err != nil {
+			return fmt.Errorf("failed to list instancetypes: %w", err)
+		}
+		if !sets.New(available...).Has(instancetype) {
+			return utilerrors.NewBadRequest("node deployment validation failed: instancetype %q does not exist in the infra cluster, available instancetypes are %v", instancetype, available)
+		}
+	}
+
+	if preference != "" {
+		available, err := kubevirt.ListPreferenceNames(ctx, client, dc)
+		if err != nil {
+			return fmt.Errorf("failed to list preferences: %w", err)
+		}
+		if !sets.New(available...).Has(preference) {
+			return utilerrors.NewBadRequest("node deployment validation failed: preference %q does not exist in the infra cluster, available preferences are %v", preference, available)
+		}
+	}
+
+	if priorityClass != "" {
+		priorityClasses, err := kubevirt.ListPriorityClasses(ctx, client)
+		if err != nil {
+			return fmt.Errorf("failed to list priority classes: %w", err)
+		}
+		available := make([]string, 0, len(priorityClasses))
+		for _, pc := range priorityClasses {
+			available = append(available, pc.Name)
+		}
+		if !sets.New(available...).Has(priorityClass) {
+			return utilerrors.NewBadRequest("node deployment validation failed: priority class %q does not exist in the infra cluster, available priority classes are %v", priorityClass, available)
+		}
+	}
+
+	return nil
+}
+
+// GetVSphereNetworks lists the networks of a VSphere datacenter, it is replaced in tests.
+var GetVSphereNetworks = vsphere.GetNetworks
+
+// validateVSphereAdditionalNetworks checks that the additional networks of a VSphere node deployment exist in the
+// datacenter, instead of letting the machine-controller fail to create the VMs. As for the KubeVirt references, only
+// networks which are not part of the existing node spec are checked.
+func validateVSphereAdditionalNetworks(ctx context.Context, cluster *kubermaticv1.Cluster, dc *kubermaticv1.Datacenter, spec, existing *apiv1.VSphereNodeSpec, caBundle *x509.CertPool) error {
+	if spec == nil || cluster.Spec.Cloud.VSphere == nil || dc.Spec.VSphere == nil {
+		return nil
+	}
+	if existing == nil {
+		existing = &apiv1.VSphereNodeSpec{}
+	}
+
+	added := sets.List(sets.New(spec.AdditionalNetworks...).Delete(existing.AdditionalNetworks...))
+	if len(added) == 0 {
+		return nil
+	}
+
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	secretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, privilegedClusterProvider.GetSeedClusterAdminRuntimeClient())
+	username, password, err := vsphere.GetCredentialsForCluster(cluster.Spec.Cloud, secretKeySelector, dc.Spec.VSphere)
+	if err != nil {
+		return err
+	}
+
+	networks, err := GetVSphereNetworks(ctx, dc.Spec.VSphere, username, password, caBundle)
+	if err != nil {
+		return fmt.Errorf("failed to list networks: %w", err)
+	}
+
+	// The machine-controller accepts the name as well as the relative or absolute path of a network.
+	available := sets.New[string]()
+	for _, network := range networks {
+		available.Insert(network.Name, network.RelativePath, network.AbsolutePath)
+	}
+	for _, network := range added {
+		if !available.Has(network) {
+			return utilerrors.NewBadRequest("node deployment validation failed: additional network %q does not exist in the vSphere datacenter", network)
+		}
+	}
+
+	return nil
+}
+
+// NewHetznerClient creates the client for the Hetzner API, it is replaced in tests.
+var NewHetznerClient = hetzner.NewClient
+
+// validateHetznerReferences checks that the network and firewall of a Hetzner node deployment exist in the Hetzner
+// project, instead of letting the machine-controller fail to create the servers. As for the KubeVirt references,
+// only references which differ from the existing node spec are checked. Networks and firewalls can be referenced by
+// name or ID.
+func validateHetznerReferences(ctx context.Context, cluster *kubermaticv1.Cluster, spec, existing *apiv1.HetznerNodeSpec) error {
+	if spec == nil || cluster.Spec.Cloud.Hetzner == nil {
+		return nil
+	}
+	if existing == nil {
+		existing = &apiv1.HetznerNodeSpec{}
+	}
+
+	network := ""
+	if spec.Network != existing.Network {
+		network = spec.Network
+	}
+	firewall := ""
+	if spec.Firewall != existing.Firewall {
+		firewall = spec.Firewall
+	}
+	if network == "" && firewall == "" {
+		return nil
+	}
+
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	secretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, privilegedClusterProvider.GetSeedClusterAdminRuntimeClient())
+	token, err := hetzner.GetCredentialsForCluster(cluster.Spec.Cloud, secretKeySelector)
+	if err != nil {
+		return err
+	}
+
+	client := NewHetznerClient(token)
+
+	if network != "" {
+		networks, err := client.ListNetworks(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list networks: %w", err)
+		}
+		available := make([]string, 0, len(networks))
+		references := sets.New[string]()
+		for _, n := range networks {
+			available = append(available, n.Name)
+			references.Insert(n.Name, strconv.Itoa(n.ID))
+		}
+		if !references.Has(network) {
+			return utilerrors.NewBadRequest("node deployment validation failed: network %q does not exist in the Hetzner project, available networks are %v", network, available)
+		}
+	}
+
+	if firewall != "" {
+		firewalls, err := client.ListFirewalls(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list firewalls: %w", err)
+		}
+		available := make([]string, 0, len(firewalls))
+		references := sets.New[string]()
+		for _, f := range firewalls {
+			available = append(available, f.Name)
+			references.Insert(f.Name, strconv.Itoa(f.ID))
+		}
+		if !references.Has(firewall) {
+			return utilerrors.NewBadRequest("node deployment validation failed: firewall %q does not exist in the Hetzner project, available firewalls are %v", firewall, available)
+		}
+	}
+
+	return nil
+}
+
+// outputMachineDeploymentForUser converts the machine deployment and removes the internal annotations
+// from it, unless the user is an admin.
+func outputMachineDeploymentForUser(md *clusterv1alpha1.MachineDeployment, userInfo *provider.UserInfo) (*apiv1.NodeDeployment, error) {
+	nd, err := OutputMachineDeployment(md)
+	if err != nil {
+		return nil, err
+	}
+	nd.Spec.Template.OperatingSystem.RedactSecrets()
+
+	if userInfo.IsAdmin {
+		return nd, nil
+	}
+
+	annotations := make(map[string]string, len(nd.Annotations))
+	for key, value := range nd.Annotations {
+		if !strings.HasPrefix(key, machine.InternalAnnotationPrefix) {
+			annotations[key] = value
+		}
 	}
+	nd.Annotations = annotations
 
-	return OutputMachineDeployment(md)
+	return nd, nil
 }
 
 func OutputMachineDeployment(md *clusterv1alpha1.MachineDeployment) (*apiv1.NodeDeployment, error) {
@@ -138,11 +905,24 @@ func OutputMachineDeployment(md *clusterv1alpha1.MachineDeployment) (*apiv1.Node
 	if err != nil {
 		return nil, fmt.Errorf("failed to get node cloud spec from machine deployment: %w", err)
 	}
+	machine.SetKubeVirtPriorityClassName(cloudSpec, md.Annotations)
+	machine.SetVSphereAdditionalNetworks(cloudSpec, md.Annotations)
 
 	networkSpec, err := machineconversions.GetAPIV2NodeNetworkSpec(md.Spec.Template.Spec)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get node network spec from machine deployment: %w", err)
 	}
+	machine.SetSecondaryNetwork(networkSpec, md.Annotations)
+
+	additionalUserData, err := machineconversions.GetAPIV1AdditionalUserData(md.Spec.Template.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get additional user data from machine deployment: %w", err)
+	}
+
+	gpu, err := machineconversions.GetAPIV1GPUSpec(md.Spec.Template.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GPU settings from machine deployment: %w", err)
+	}
 
 	taints := make([]apiv1.TaintSpec, len(md.Spec.Template.Spec.Taints))
 	for i, taint := range md.Spec.Template.Spec.Taints {
@@ -159,6 +939,7 @@ func OutputMachineDeployment(md *clusterv1alpha1.MachineDeployment) (*apiv1.Node
 	}
 
 	hasDynamicConfig := md.Spec.Template.Spec.ConfigSource != nil
+	maxSurge, maxUnavailable := machine.GetRollingUpdateStrategy(md)
 
 	return &apiv1.NodeDeployment{
 		ObjectMeta: apiv1.ObjectMeta{
@@ -177,14 +958,21 @@ func OutputMachineDeployment(md *clusterv1alpha1.MachineDeployment) (*apiv1.Node
 				Versions: apiv1.NodeVersionInfo{
 					Kubelet: md.Spec.Template.Spec.Versions.Kubelet,
 				},
-				OperatingSystem: *operatingSystemSpec,
-				Cloud:           *cloudSpec,
-				Network:         networkSpec,
+				OperatingSystem:    *operatingSystemSpec,
+				Cloud:              *cloudSpec,
+				Network:            networkSpec,
+				GPU:                gpu,
+				OSProfile:          md.Annotations[osmresources.MachineDeploymentOSPAnnotation],
+				AdditionalUserData: additionalUserData,
+				KubeletConfig:      machine.GetKubeletConfig(md.Annotations),
 			},
-			Paused:        &md.Spec.Paused,
-			DynamicConfig: &hasDynamicConfig,
-			MinReplicas:   minReplicaCount,
-			MaxReplicas:   maxReplicaCount,
+			Paused:         &md.Spec.Paused,
+			DynamicConfig:  &hasDynamicConfig,
+			MinReplicas:    minReplicaCount,
+			MaxReplicas:    maxReplicaCount,
+			MaxSurge:       maxSurge,
+			MaxUnavailable: maxUnavailable,
+			AutoRepair:     machine.GetAutoRepair(md.Annotations),
 		},
 		Status: md.Status,
 	}, nil
@@ -199,26 +987,143 @@ func DeleteMachineNode(ctx context.Context, userInfoGetter provider.UserInfoGett
 
 	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	machine, node, err := findMachineAndNode(ctx, machineID, client)
 	if err != nil {
-		return nil, err
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 	if machine == nil && node == nil {
 		return nil, utilerrors.NewNotFound("Node", machineID)
 	}
 
 	if machine != nil {
-		return nil, common.KubernetesErrorToHTTPError(client.Delete(ctx, machine))
+		if err := client.Delete(ctx, machine); err != nil {
+			// Errors which don't come from the API server don't explain much, the error reported by the
+			// machine-controller is more helpful in that case.
+			var apiStatus apierrors.APIStatus
+			if machineErr := common.NewMachineProvisioningError(machine); machineErr != nil && !errors.As(err, &apiStatus) {
+				return nil, common.KubernetesErrorToHTTPError(machineErr)
+			}
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+		}
+		return nil, nil
 	} else if node != nil {
-		return nil, common.KubernetesErrorToHTTPError(client.Delete(ctx, node))
+		return nil, common.UpstreamErrorToHTTPError(client.Delete(ctx, node), common.UpstreamUserCluster)
+	}
+	return nil, nil
+}
+
+// SetNodeUnschedulable cordons or uncordons the given node by setting its spec.unschedulable field.
+func SetNodeUnschedulable(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, nodeID string, unschedulable bool) (*apiv1.Node, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	machine, node, err := findMachineAndNode(ctx, nodeID, client)
+	if err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+	if node == nil {
+		return nil, utilerrors.NewNotFound("Node", nodeID)
+	}
+
+	if node.Spec.Unschedulable != unschedulable {
+		oldNode := node.DeepCopy()
+		node.Spec.Unschedulable = unschedulable
+		if err := client.Patch(ctx, node, ctrlruntimeclient.MergeFrom(oldNode)); err != nil {
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+		}
+	}
+
+	if machine != nil {
+		return outputMachine(machine, node, false)
+	}
+	return outputNode(node, false), nil
+}
+
+// protectedNodeLabelPrefixes lists the label prefixes reserved for Kubernetes, labels with these prefixes
+// can not be changed via the API.
+var protectedNodeLabelPrefixes = []string{
+	"kubernetes.io/",
+	"node.kubernetes.io/",
+}
+
+// PatchNodeLabels sets the given labels on the node of the user cluster, labels with a nil value are removed.
+func PatchNodeLabels(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, nodeID string, nodeLabels map[string]*string) (*apiv1.Node, error) {
+	if err := validateNodeLabels(nodeLabels); err != nil {
+		return nil, err
+	}
+
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	machine, node, err := findMachineAndNode(ctx, nodeID, client)
+	if err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+	if node == nil {
+		return nil, utilerrors.NewNotFound("Node", nodeID)
+	}
+
+	oldNode := node.DeepCopy()
+	if node.Labels == nil {
+		node.Labels = map[string]string{}
+	}
+	for key, value := range nodeLabels {
+		if value == nil {
+			delete(node.Labels, key)
+			continue
+		}
+		node.Labels[key] = *value
+	}
+	if err := client.Patch(ctx, node, ctrlruntimeclient.MergeFrom(oldNode)); err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	if machine != nil {
+		return outputMachine(machine, node, false)
+	}
+	return outputNode(node, false), nil
+}
+
+func validateNodeLabels(nodeLabels map[string]*string) error {
+	for key, value := range nodeLabels {
+		for _, prefix := range protectedNodeLabelPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				return utilerrors.NewBadRequest("label %q can not be changed, the prefix %q is reserved", key, prefix)
+			}
+		}
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return utilerrors.NewBadRequest("invalid label key %q: %s", key, strings.Join(errs, ", "))
+		}
+		if value == nil {
+			continue
+		}
+		if errs := validation.IsValidLabelValue(*value); len(errs) > 0 {
+			return utilerrors.NewBadRequest("invalid value for label %q: %s", key, strings.Join(errs, ", "))
+		}
 	}
-	return nil, nil
+	return nil
 }
 
-func ListMachineDeployments(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) (interface{}, error) {
+func ListMachineDeployments(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, projectID, clusterID string, showNodeStatus bool) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 
 	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
@@ -228,28 +1133,108 @@ func ListMachineDeployments(ctx context.Context, userInfoGetter provider.UserInf
 
 	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
 	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
 
 	nodeDeployments := make([]*apiv1.NodeDeployment, 0, len(machineDeployments.Items))
 	for i := range machineDeployments.Items {
-		nd, err := OutputMachineDeployment(&machineDeployments.Items[i])
+		nd, err := outputMachineDeploymentForUser(&machineDeployments.Items[i], userInfo)
 		if err != nil {
 			return nil, fmt.Errorf("failed to output machine deployment %s: %w", machineDeployments.Items[i].Name, err)
 		}
 
 		nodeDeployments = append(nodeDeployments, nd)
 	}
+	setNodeDeploymentWarnings(userInfo, seedsGetter, cluster, nodeDeployments...)
+
+	if showNodeStatus {
+		if err := setNodeDeploymentsNodeStatus(ctx, client, machineDeployments.Items, nodeDeployments); err != nil {
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+		}
+	}
 
 	return nodeDeployments, nil
 }
 
-func GetMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string) (interface{}, error) {
+// setNodeDeploymentsNodeStatus computes the node readiness and the state of the operating system updates of all
+// node deployments from a single list of the machines and nodes of the cluster. The node deployments must have the
+// same order as the machine deployments.
+func setNodeDeploymentsNodeStatus(ctx context.Context, client ctrlruntimeclient.Client, machineDeployments []clusterv1alpha1.MachineDeployment, nodeDeployments []*apiv1.NodeDeployment) error {
+	machineList := &clusterv1alpha1.MachineList{}
+	if err := client.List(ctx, machineList, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return fmt.Errorf("failed to load machines from cluster: %w", err)
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := client.List(ctx, nodeList); err != nil {
+		return fmt.Errorf("failed to load nodes from cluster: %w", err)
+	}
+
+	var spotEvents machine.SpotInterruptionEvents
+	selectors := make([]labels.Selector, len(machineDeployments))
+	for i := range machineDeployments {
+		selectors[i] = labels.SelectorFromSet(machineDeployments[i].Spec.Selector.MatchLabels)
+		nodeDeployments[i].NodeStatus = &apiv1.NodeDeploymentNodeStatus{}
+
+		if machine.IsAWSSpotInstance(nodeDeployments[i].Spec.Template.Cloud) {
+			nodeDeployments[i].NodeStatus.SpotInterruptedNodes = ptr.To(0)
+			if spotEvents == nil {
+				var err error
+				if spotEvents, err = listSpotInterruptionEvents(ctx, client); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	spotInterruptionsSince := time.Now().Add(-spotInterruptionsPeriod)
+
+	for i := range machineList.Items {
+		m := &machineList.Items[i]
+
+		for j, selector := range selectors {
+			if !selector.Matches(labels.Set(m.Labels)) {
+				continue
+			}
+
+			status := nodeDeployments[j].NodeStatus
+			status.TotalNodes++
+			node := getNodeForMachine(m, nodeList.Items)
+			if node != nil && kuberneteshelper.IsNodeReady(node) {
+				status.ReadyNodes++
+			} else {
+				status.UnavailableNodes++
+			}
+
+			switch machine.GetNodeOSUpdateStatus(node).State {
+			case apiv1.NodeOSUpdatesPending:
+				status.UpdatesPendingNodes++
+			case apiv1.NodeOSRebootRequired:
+				status.RebootRequiredNodes++
+			case apiv1.NodeOSUpdateUnknown:
+				status.OSUpdateUnknownNodes++
+			}
+
+			if status.SpotInterruptedNodes != nil && machine.HasSpotTerminationNoticeSince(spotEvents.ForMachine(m, node), spotInterruptionsSince) {
+				*status.SpotInterruptedNodes++
+			}
+			break
+		}
+	}
+
+	return nil
+}
+
+func GetMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, projectID, clusterID, machineDeploymentID string, showMachineErrors bool) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
 	if err != nil {
@@ -258,18 +1243,73 @@ func GetMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoG
 
 	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	machineDeployment := &clusterv1alpha1.MachineDeployment{}
 	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: machineDeploymentID}, machineDeployment); err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
 
-	return OutputMachineDeployment(machineDeployment)
+	nodeDeployment, err := outputMachineDeploymentForUser(machineDeployment, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if showMachineErrors {
+		machines := &clusterv1alpha1.MachineList{}
+		if err := client.List(ctx, machines, &ctrlruntimeclient.ListOptions{Namespace: metav1.NamespaceSystem, LabelSelector: labels.SelectorFromSet(machineDeployment.Spec.Selector.MatchLabels)}); err != nil {
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+		}
+		nodeDeployment.MachineErrors = outputMachineErrors(machines.Items)
+	}
+	setNodeDeploymentWarnings(userInfo, seedsGetter, cluster, nodeDeployment)
+
+	return nodeDeployment, nil
+}
+
+// setNodeDeploymentWarnings warns about operating system versions of the node deployments which reach their end
+// of life and about images which are no longer offered by the datacenter of the cluster. The image availability
+// is only checked if the datacenter can be looked up, a failed lookup never fails the request.
+func setNodeDeploymentWarnings(userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, cluster *kubermaticv1.Cluster, nodeDeployments ...*apiv1.NodeDeployment) {
+	var dc *kubermaticv1.Datacenter
+	if seedsGetter != nil {
+		if _, datacenter, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName); err == nil {
+			dc = datacenter
+		}
+	}
+
+	now := time.Now()
+	for _, nd := range nodeDeployments {
+		warnings := machine.OperatingSystemWarnings(nd, now)
+		if dc != nil {
+			warnings = append(warnings, machine.ImageAvailabilityWarnings(nd, dc)...)
+		}
+		nd.Warnings = warnings
+	}
+}
+
+func outputMachineErrors(machines []clusterv1alpha1.Machine) []apiv1.MachineError {
+	var machineErrors []apiv1.MachineError
+	for i := range machines {
+		if err := common.NewMachineProvisioningError(&machines[i]); err != nil {
+			machineErrors = append(machineErrors, apiv1.MachineError{
+				Machine:  err.Machine,
+				Reason:   string(err.Reason),
+				Message:  err.Message,
+				Terminal: err.Terminal(),
+			})
+		}
+	}
+	return machineErrors
 }
 
-func GetMachineDeploymentJoiningScript(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string) (interface{}, error) {
+func GetMachineDeploymentJoiningScript(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID, format string) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
 	if err != nil {
@@ -278,18 +1318,18 @@ func GetMachineDeploymentJoiningScript(ctx context.Context, userInfoGetter provi
 
 	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	machineDeployment := &clusterv1alpha1.MachineDeployment{}
 	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: machineDeploymentID}, machineDeployment); err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	scriptSecretName := fmt.Sprintf("edge-provider-script-%s-%s", machineDeployment.Name, machineDeployment.Namespace)
 	joiningScriptSecret := &corev1.Secret{}
 	if err := client.Get(ctx, types.NamespacedName{Name: scriptSecretName, Namespace: bootstrap.CloudInitSettingsNamespace}, joiningScriptSecret); err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	joiningScript := joiningScriptSecret.Data["fetch-bootstrap-script"]
@@ -297,7 +1337,57 @@ func GetMachineDeploymentJoiningScript(ctx context.Context, userInfoGetter provi
 		return nil, errors.New("machine joining script is not found")
 	}
 
-	return base64.StdEncoding.EncodeToString(joiningScript), nil
+	switch format {
+	case JoiningScriptFormatCloudInit:
+		return base64.StdEncoding.EncodeToString(renderJoiningScriptCloudConfig(joiningScript)), nil
+	case JoiningScriptFormatJSON:
+		caBundle := &corev1.ConfigMap{}
+		if err := client.Get(ctx, types.NamespacedName{Name: joiningScriptCAConfigMapName, Namespace: metav1.NamespaceSystem}, caBundle); err != nil {
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+		}
+		return renderJoiningScriptToken(joiningScript, []byte(caBundle.Data[joiningScriptCAConfigMapKey]))
+	default:
+		return base64.StdEncoding.EncodeToString(joiningScript), nil
+	}
+}
+
+// renderJoiningScriptCloudConfig wraps the joining script into a cloud-config document that
+// writes the script to disk and executes it on first boot.
+func renderJoiningScriptCloudConfig(joiningScript []byte) []byte {
+	return []byte(fmt.Sprintf(`#cloud-config
+write_files:
+- path: %[1]s
+  permissions: "0755"
+  encoding: b64
+  content: %[2]s
+runcmd:
+- %[1]s
+`, joiningScriptPath, base64.StdEncoding.EncodeToString(joiningScript)))
+}
+
+// renderJoiningScriptToken extracts the bootstrap token and the API server address from the joining
+// script and combines them with the hash of the cluster CA public key.
+func renderJoiningScriptToken(joiningScript, caBundle []byte) (*apiv1.JoiningScriptToken, error) {
+	matches := joiningScriptTokenRegexp.FindSubmatch(joiningScript)
+	if matches == nil {
+		return nil, errors.New("failed to find the bootstrap token in the machine joining script")
+	}
+
+	block, _ := pem.Decode(caBundle)
+	if block == nil {
+		return nil, errors.New("failed to decode the cluster CA certificate")
+	}
+	caCert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the cluster CA certificate: %w", err)
+	}
+	caCertHash := sha256.Sum256(caCert.RawSubjectPublicKeyInfo)
+
+	return &apiv1.JoiningScriptToken{
+		Token:      string(matches[1]),
+		CACertHash: "sha256:" + hex.EncodeToString(caCertHash[:]),
+		Server:     string(matches[2]),
+	}, nil
 }
 
 func ListMachineDeploymentNodes(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string, hideInitialConditions bool) (interface{}, error) {
@@ -310,21 +1400,35 @@ func ListMachineDeploymentNodes(ctx context.Context, userInfoGetter provider.Use
 
 	machines, err := getMachinesForNodeDeployment(ctx, clusterProvider, userInfoGetter, cluster, projectID, machineDeploymentID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	nodeList, err := getNodeList(ctx, cluster, clusterProvider)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
-	var nodesV1 []*apiv1.Node
+	var (
+		nodesV1    []*apiv1.Node
+		spotEvents machine.SpotInterruptionEvents
+	)
 	for i := range machines.Items {
 		node := getNodeForMachine(&machines.Items[i], nodeList.Items)
 		outNode, err := outputMachine(&machines.Items[i], node, hideInitialConditions)
 		if err != nil {
 			return nil, fmt.Errorf("failed to output machine %s: %w", machines.Items[i].Name, err)
 		}
+		outNode.Status.OSUpdateStatus = machine.GetNodeOSUpdateStatus(node)
+
+		if machine.IsAWSSpotInstance(outNode.Spec.Cloud) {
+			if spotEvents == nil {
+				spotEvents, err = getSpotInterruptionEvents(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+				if err != nil {
+					return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+				}
+			}
+			outNode.Status.SpotInterruptions = machine.GetNodeSpotInterruptions(spotEvents.ForMachine(&machines.Items[i], node))
+		}
 
 		nodesV1 = append(nodesV1, outNode)
 	}
@@ -332,7 +1436,54 @@ func ListMachineDeploymentNodes(ctx context.Context, userInfoGetter provider.Use
 	return nodesV1, nil
 }
 
-func ListNodesForCluster(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string, hideInitialConditions bool) (interface{}, error) {
+// getSpotInterruptionEvents returns the events about spot interruptions of the machines and nodes of the cluster.
+// The events of machines are in the kube-system namespace, the ones of nodes in the default namespace.
+func getSpotInterruptionEvents(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, projectID string) (machine.SpotInterruptionEvents, error) {
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return listSpotInterruptionEvents(ctx, client)
+}
+
+func listSpotInterruptionEvents(ctx context.Context, client ctrlruntimeclient.Client) (machine.SpotInterruptionEvents, error) {
+	var events []corev1.Event
+	for _, namespace := range []string{metav1.NamespaceSystem, metav1.NamespaceDefault} {
+		eventList := &corev1.EventList{}
+		if err := client.List(ctx, eventList, ctrlruntimeclient.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("failed to load events from cluster: %w", err)
+		}
+		events = append(events, eventList.Items...)
+	}
+
+	return machine.NewSpotInterruptionEvents(events), nil
+}
+
+// GetMachineDeploymentMachine returns the cluster and the machine with the given name which belongs to the machine deployment.
+func GetMachineDeploymentMachine(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID, machineID string) (*kubermaticv1.Cluster, *clusterv1alpha1.Machine, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	machines, err := getMachinesForNodeDeployment(ctx, clusterProvider, userInfoGetter, cluster, projectID, machineDeploymentID)
+	if err != nil {
+		return nil, nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	for i := range machines.Items {
+		if machines.Items[i].Name == machineID {
+			return cluster, &machines.Items[i], nil
+		}
+	}
+
+	return nil, nil, utilerrors.NewNotFound("Node", machineID)
+}
+
+func ListNodesForCluster(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string, hideInitialConditions bool, labelSelector labels.Selector) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 
 	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
@@ -342,17 +1493,18 @@ func ListNodesForCluster(ctx context.Context, userInfoGetter provider.UserInfoGe
 
 	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	machineList := &clusterv1alpha1.MachineList{}
 	if err := client.List(ctx, machineList, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
-		return nil, fmt.Errorf("failed to load machines from cluster: %w", err)
+		return nil, common.UpstreamErrorToHTTPError(fmt.Errorf("failed to load machines from cluster: %w", err), common.UpstreamUserCluster)
 	}
 
-	nodeList, err := getNodeList(ctx, cluster, clusterProvider)
+	// machines without a matching node are skipped below, so filtering the nodes is enough
+	nodeList, err := getNodeList(ctx, cluster, clusterProvider, ctrlruntimeclient.MatchingLabelsSelector{Selector: labelSelector})
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	// The following is a bit tricky. We might have a node which is not created by a machine and vice versa...
@@ -420,12 +1572,12 @@ func ListMachineDeploymentMetrics(ctx context.Context, userInfoGetter provider.U
 	// get metrics
 	machines, err := getMachinesForNodeDeployment(ctx, clusterProvider, userInfoGetter, cluster, projectID, machineDeploymentID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	nodeList, err := getNodeList(ctx, cluster, clusterProvider)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	availableResources := make(map[string]corev1.ResourceList)
@@ -438,7 +1590,7 @@ func ListMachineDeploymentMetrics(ctx context.Context, userInfoGetter provider.U
 
 	dynamicClient, err := clusterProvider.GetAdminClientForUserCluster(ctx, cluster)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	nodeDeploymentNodesMetrics := make([]v1beta1.NodeMetrics, 0)
@@ -446,7 +1598,7 @@ func ListMachineDeploymentMetrics(ctx context.Context, userInfoGetter provider.U
 	if err := dynamicClient.List(ctx, allNodeMetricsList); err != nil {
 		// Happens during cluster creation when the CRD is not setup yet
 		if !meta.IsNoMatchError(err) {
-			return nil, common.KubernetesErrorToHTTPError(err)
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 		}
 	}
 
@@ -459,130 +1611,382 @@ func ListMachineDeploymentMetrics(ctx context.Context, userInfoGetter provider.U
 	return ConvertNodeMetrics(nodeDeploymentNodesMetrics, availableResources)
 }
 
-func PatchMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, sshKeyProvider provider.SSHKeyProvider, seedsGetter provider.SeedsGetter, projectID, clusterID, machineDeploymentID string, patch json.RawMessage, settingsProvider provider.SettingsProvider) (interface{}, error) {
+// PatchMachineDeployment applies the JSON merge patch to the machine deployment. The instance type filter of the
+// datacenter is only enforced if the patch changes the instance type and the global size limits only if the patch
+// increases the size, unless an admin overrides them. The checks against the API of the cloud provider can be
+// skipped, if the API can't be reached.
+func PatchMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, sshKeyProvider provider.SSHKeyProvider, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, projectID, clusterID, machineDeploymentID string, patch json.RawMessage, settingsProvider provider.SettingsProvider, caBundle *x509.CertPool, opts MachineDeploymentOptions) (interface{}, error) {
+	nd, _, err := PatchMachineDeploymentWithRollout(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, clusterProviderGetter, projectID, clusterID, machineDeploymentID, patch, settingsProvider, caBundle, opts)
+	if err != nil {
+		return nil, err
+	}
+	return nd, nil
+}
+
+// PatchMachineDeploymentWithRollout patches the machine deployment like PatchMachineDeployment and also returns
+// whether the machine template was changed, which rolls out new machines.
+func PatchMachineDeploymentWithRollout(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, sshKeyProvider provider.SSHKeyProvider, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, projectID, clusterID, machineDeploymentID string, patch json.RawMessage, settingsProvider provider.SettingsProvider, caBundle *x509.CertPool, opts MachineDeploymentOptions) (*apiv1.NodeDeployment, bool, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	userInfo, err := userInfoGetter(ctx, "")
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, false, common.KubernetesErrorToHTTPError(err)
 	}
 
 	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, false, common.KubernetesErrorToHTTPError(err)
 	}
 
 	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, false, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	// We cannot use machineClient.ClusterV1alpha1().MachineDeployments().Patch() method as we are not exposing
 	// MachineDeployment type directly. API uses NodeDeployment type and we cannot ensure compatibility here.
 	machineDeployment := &clusterv1alpha1.MachineDeployment{}
 	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: machineDeploymentID}, machineDeployment); err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, false, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	nodeDeployment, err := OutputMachineDeployment(machineDeployment)
 	if err != nil {
-		return nil, fmt.Errorf("cannot output existing node deployment: %w", err)
+		return nil, false, fmt.Errorf("cannot output existing node deployment: %w", err)
 	}
 	var unmarshalPatched *apiv1.NodeDeployment
 	if err := json.Unmarshal(patch, &unmarshalPatched); err != nil {
-		return nil, utilerrors.NewBadRequest("cannot decode patched nodedeployment: %s", patch)
+		return nil, false, utilerrors.NewBadRequest("cannot decode patched nodedeployment: %s", patch)
+	}
+
+	if err := machine.ValidateAutoscalerAnnotations(machineDeployment.Annotations, unmarshalPatched.Annotations); err != nil {
+		return nil, false, utilerrors.NewBadRequest("%v", err)
 	}
 
 	selectedOperatingSystems := selectedOperatingSystems(unmarshalPatched.Spec.Template.OperatingSystem)
 
 	if selectedOperatingSystems > 1 {
-		return nil, fmt.Errorf("cannot have more than one os")
+		return nil, false, fmt.Errorf("cannot have more than one os")
 	}
 
+	// The subscription secrets of the operating system are redacted in responses, clients send them back as they are
+	// or leave them out. Both keep the existing values, only null clears them.
+	existingOperatingSystem := nodeDeployment.Spec.Template.OperatingSystem
 	if selectedOperatingSystems == 1 {
 		nodeDeployment.Spec.Template.OperatingSystem = unmarshalPatched.Spec.Template.OperatingSystem
+		restoreOperatingSystemSecrets(&nodeDeployment.Spec.Template.OperatingSystem, existingOperatingSystem, true)
 	}
 
 	nodeDeploymentJSON, err := json.Marshal(nodeDeployment)
 	if err != nil {
-		return nil, fmt.Errorf("cannot decode existing node deployment: %w", err)
+		return nil, false, fmt.Errorf("cannot decode existing node deployment: %w", err)
 	}
 
 	patchedNodeDeploymentJSON, err := jsonpatch.MergePatch(nodeDeploymentJSON, patch)
 	if err != nil {
-		return nil, fmt.Errorf("cannot patch node deployment: %w", err)
+		return nil, false, fmt.Errorf("cannot patch node deployment: %w", err)
 	}
 
 	var patchedNodeDeployment *apiv1.NodeDeployment
 	if err := json.Unmarshal(patchedNodeDeploymentJSON, &patchedNodeDeployment); err != nil {
-		return nil, fmt.Errorf("cannot decode patched cluster: %w", err)
+		return nil, false, fmt.Errorf("cannot decode patched cluster: %w", err)
 	}
+	restoreOperatingSystemSecrets(&patchedNodeDeployment.Spec.Template.OperatingSystem, existingOperatingSystem, false)
 
 	// validate min/max replicas
 	maxReplicas := patchedNodeDeployment.Spec.MaxReplicas
 	if maxReplicas != nil && patchedNodeDeployment.Spec.Replicas > int32(*maxReplicas) {
-		return nil, utilerrors.NewBadRequest("replica count (%d) cannot be higher then autoscaler maxreplicas (%d)", patchedNodeDeployment.Spec.Replicas, *maxReplicas)
+		return nil, false, common.WithReason(common.ReasonAutoscalerBounds, utilerrors.NewBadRequest("replica count (%d) cannot be higher then autoscaler maxreplicas (%d)", patchedNodeDeployment.Spec.Replicas, *maxReplicas))
 	}
 	if patchedNodeDeployment.Spec.MinReplicas != nil && patchedNodeDeployment.Spec.Replicas < int32(*patchedNodeDeployment.Spec.MinReplicas) {
-		return nil, utilerrors.NewBadRequest("replica count (%d) cannot be lower then autoscaler minreplicas (%d)", patchedNodeDeployment.Spec.Replicas, *patchedNodeDeployment.Spec.MinReplicas)
+		return nil, false, common.WithReason(common.ReasonAutoscalerBounds, utilerrors.NewBadRequest("replica count (%d) cannot be lower then autoscaler minreplicas (%d)", patchedNodeDeployment.Spec.Replicas, *patchedNodeDeployment.Spec.MinReplicas))
+	}
+	if err := applyMachineDeploymentSizeLimits(ctx, settingsProvider, userInfo, opts.OverrideSizeLimits, &patchedNodeDeployment.Spec, &nodeDeployment.Spec); err != nil {
+		return nil, false, err
+	}
+	addedNodes := machine.NodeDeploymentNodes(&patchedNodeDeployment.Spec) - machine.MachineDeploymentNodes(machineDeployment)
+	if err := enforceProjectNodeQuota(ctx, clusterProviderGetter, seedsGetter, project, addedNodes, 0); err != nil {
+		return nil, false, err
 	}
 
 	kversion, err := semverlib.NewVersion(patchedNodeDeployment.Spec.Template.Versions.Kubelet)
 	if err != nil {
-		return nil, utilerrors.NewBadRequest("failed to parse kubelet version: %v", err)
+		return nil, false, utilerrors.NewBadRequest("failed to parse kubelet version: %v", err)
 	}
 	if err = nodeupdate.EnsureVersionCompatible(cluster.Spec.Version.Semver(), kversion); err != nil {
-		return nil, utilerrors.NewBadRequest("%v", err)
+		return nil, false, common.WithReason(validationErrorReason(err), utilerrors.NewBadRequest("%v", err))
+	}
+	if patchedNodeDeployment.Spec.Template.Versions.Kubelet != nodeDeployment.Spec.Template.Versions.Kubelet {
+		if err := checkMaintenanceWindow(ctx, userInfoGetter, cluster, projectID, opts.Force); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if err := machine.ValidateCloudProvider(cluster, patchedNodeDeployment); err != nil {
+		return nil, false, utilerrors.NewBadRequest("%v", err)
+	}
+
+	if err := machine.ValidateGPU(patchedNodeDeployment.Spec.Template); err != nil {
+		return nil, false, utilerrors.NewBadRequest("node deployment validation failed: %s", err)
+	}
+	if err := machine.ValidateSpotInstance(patchedNodeDeployment.Spec.Template.Cloud); err != nil {
+		return nil, false, utilerrors.NewBadRequest("node deployment validation failed: %s", err)
+	}
+	if err := machine.ValidateGCPMachineType(patchedNodeDeployment.Spec.Template.Cloud); err != nil {
+		return nil, false, utilerrors.NewBadRequest("node deployment validation failed: %s", err)
+	}
+	if err := machine.ValidateRollingUpdate(patchedNodeDeployment.Spec); err != nil {
+		return nil, false, utilerrors.NewBadRequest("node deployment validation failed: %s", err)
+	}
+	if err := machine.ValidateAutoRepair(patchedNodeDeployment.Spec); err != nil {
+		return nil, false, utilerrors.NewBadRequest("node deployment validation failed: %s", err)
+	}
+	if err := machine.ValidateAdditionalUserData(patchedNodeDeployment.Spec.Template); err != nil {
+		return nil, false, utilerrors.NewBadRequest("node deployment validation failed: %s", err)
+	}
+	if err := machine.ValidateKubeletConfig(patchedNodeDeployment.Spec.Template); err != nil {
+		return nil, false, utilerrors.NewBadRequest("node deployment validation failed: %s", err)
+	}
+	if err := machine.ValidateNetwork(cluster, patchedNodeDeployment.Spec.Template.Network); err != nil {
+		return nil, false, utilerrors.NewBadRequest("node deployment validation failed: %s", err)
+	}
+	if err := machine.ValidateVSphereAdditionalNetworks(cluster, patchedNodeDeployment.Spec.Template.Cloud); err != nil {
+		return nil, false, utilerrors.NewBadRequest("node deployment validation failed: %s", err)
+	}
+	if patchedNodeDeployment.Spec.Template.OSProfile != nodeDeployment.Spec.Template.OSProfile {
+		if err := validateOperatingSystemProfile(ctx, client, patchedNodeDeployment.Spec.Template.OSProfile); err != nil {
+			if errors.Is(err, errUnknownOperatingSystemProfile) {
+				return nil, false, utilerrors.NewBadRequest("%v", err)
+			}
+			return nil, false, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+		}
+	}
+	// The selected OSP is stored in an annotation, so clearing it must remove the annotation as well, unless the
+	// patch sets the annotation itself.
+	if patchedNodeDeployment.Spec.Template.OSProfile == "" && nodeDeployment.Spec.Template.OSProfile != "" &&
+		patchedNodeDeployment.Annotations[osmresources.MachineDeploymentOSPAnnotation] == nodeDeployment.Spec.Template.OSProfile {
+		delete(patchedNodeDeployment.Annotations, osmresources.MachineDeploymentOSPAnnotation)
 	}
 
-	_, dc, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName)
+	seed, dc, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName)
 	if err != nil {
-		return nil, fmt.Errorf("error getting dc: %w", err)
+		return nil, false, fmt.Errorf("error getting dc: %w", err)
+	}
+
+	if machine.GetInstanceType(patchedNodeDeployment.Spec.Template.Cloud) != machine.GetInstanceType(nodeDeployment.Spec.Template.Cloud) {
+		if err := validateInstanceTypeFilter(seed, cluster.Spec.Cloud.DatacenterName, userInfo, opts.OverrideInstanceTypeFilter, patchedNodeDeployment.Spec.Template.Cloud); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if !opts.SkipCloudValidation {
+		if err := validateOpenstackFlavorAndZone(ctx, cluster, dc, patchedNodeDeployment.Spec.Template.Cloud.Openstack, nodeDeployment.Spec.Template.Cloud.Openstack, caBundle); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if err := validateKubeVirtReferences(ctx, cluster, dc, patchedNodeDeployment.Spec.Template.Cloud.Kubevirt, nodeDeployment.Spec.Template.Cloud.Kubevirt); err != nil {
+		return nil, false, err
+	}
+
+	if err := validateVSphereAdditionalNetworks(ctx, cluster, dc, patchedNodeDeployment.Spec.Template.Cloud.VSphere, nodeDeployment.Spec.Template.Cloud.VSphere, caBundle); err != nil {
+		return nil, false, err
+	}
+
+	if err := validateHetznerReferences(ctx, cluster, patchedNodeDeployment.Spec.Template.Cloud.Hetzner, nodeDeployment.Spec.Template.Cloud.Hetzner); err != nil {
+		return nil, false, err
 	}
 
 	keys, err := sshKeyProvider.List(ctx, project, &provider.SSHKeyListOptions{ClusterName: clusterID})
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, false, common.KubernetesErrorToHTTPError(err)
 	}
 
 	patchedMachineDeployment, err := machine.Deployment(ctx, cluster, patchedNodeDeployment, dc, keys, settingsProvider)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create machine deployment from template: %w", err)
+		return nil, false, fmt.Errorf("failed to create machine deployment from template: %w", err)
+	}
+
+	changes, err := newMachineDeploymentChanges(patch, machineDeployment, patchedMachineDeployment)
+	if err != nil {
+		return nil, false, utilerrors.NewBadRequest("cannot decode patched nodedeployment: %s", patch)
 	}
 
-	// Only the fields from NodeDeploymentSpec will be updated by a patch.
-	// It ensures that the name and resource version are set and the selector stays the same.
-	machineDeployment.Annotations = patchedMachineDeployment.Annotations
-	machineDeployment.Spec.Template.Spec = patchedMachineDeployment.Spec.Template.Spec
-	machineDeployment.Spec.Replicas = patchedMachineDeployment.Spec.Replicas
-	machineDeployment.Spec.Paused = patchedMachineDeployment.Spec.Paused
+	// The cluster-autoscaler updates the replicas and annotations of the machine deployment as well. Only the
+	// changes made by the patch are applied to the latest version of the object, so its updates are not lost.
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &clusterv1alpha1.MachineDeployment{}
+		if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: machineDeploymentID}, latest); err != nil {
+			return err
+		}
 
-	if err := client.Update(ctx, machineDeployment); err != nil {
-		return nil, fmt.Errorf("failed to update machine deployment: %w", err)
+		changes.apply(latest)
+		if err := client.Update(ctx, latest); err != nil {
+			return err
+		}
+
+		machineDeployment = latest
+		return nil
+	})
+	if err != nil {
+		return nil, false, common.UpstreamErrorToHTTPError(fmt.Errorf("failed to update machine deployment: %w", err), common.UpstreamUserCluster)
+	}
+
+	nd, err := outputMachineDeploymentForUser(machineDeployment, userInfo)
+	if err != nil {
+		return nil, false, err
+	}
+	return nd, changes.templateSpec != nil, nil
+}
+
+// machineDeploymentChanges holds the changes a node deployment patch makes to a machine deployment. Fields the
+// patch does not touch are nil, so they are left as they are.
+type machineDeploymentChanges struct {
+	setAnnotations    map[string]string
+	removeAnnotations []string
+	replicas          *int32
+	paused            *bool
+	strategy          **clusterv1alpha1.MachineDeploymentStrategy
+	templateSpec      *clusterv1alpha1.MachineSpec
+}
+
+// newMachineDeploymentChanges computes the changes from the patch document and the machine deployment generated for
+// the patched node deployment. Annotations are compared with the existing machine deployment, as they are also
+// derived from spec fields like the autoscaler or GPU settings, and so is the template.
+func newMachineDeploymentChanges(patch json.RawMessage, existing, patched *clusterv1alpha1.MachineDeployment) (*machineDeploymentChanges, error) {
+	var patchDocument struct {
+		Spec map[string]json.RawMessage `json:"spec"`
+	}
+	if err := json.Unmarshal(patch, &patchDocument); err != nil {
+		return nil, err
+	}
+	patchedSpecField := func(names ...string) bool {
+		for _, name := range names {
+			if _, ok := patchDocument.Spec[name]; ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	changes := &machineDeploymentChanges{setAnnotations: map[string]string{}}
+	for key, value := range patched.Annotations {
+		if existingValue, ok := existing.Annotations[key]; !ok || existingValue != value {
+			changes.setAnnotations[key] = value
+		}
+	}
+	for key := range existing.Annotations {
+		if _, ok := patched.Annotations[key]; !ok {
+			changes.removeAnnotations = append(changes.removeAnnotations, key)
+		}
+	}
+
+	if patchedSpecField("replicas") {
+		changes.replicas = patched.Spec.Replicas
+	}
+	if patchedSpecField("paused") {
+		changes.paused = &patched.Spec.Paused
+	}
+	if patchedSpecField("maxSurge", "maxUnavailable") {
+		changes.strategy = &patched.Spec.Strategy
+	}
+	// The template is regenerated from the cluster as well, e.g. to update the machine labels and cloud tags. It is
+	// only replaced if it changed, as replacing the template rolls out new machines.
+	if !machineTemplateSpecEqual(existing.Spec.Template.Spec, patched.Spec.Template.Spec) {
+		changes.templateSpec = &patched.Spec.Template.Spec
+	}
+
+	return changes, nil
+}
+
+// machineTemplateSpecEqual compares the machine specs semantically. The provider specs are compared decoded, so
+// that a rebuilt provider spec with another field order, formatting or explicit null values is not a change.
+func machineTemplateSpecEqual(a, b clusterv1alpha1.MachineSpec) bool {
+	aProviderSpec, bProviderSpec := a.ProviderSpec.Value, b.ProviderSpec.Value
+	a.ProviderSpec.Value, b.ProviderSpec.Value = nil, nil
+	if !equality.Semantic.DeepEqual(a, b) {
+		return false
+	}
+
+	if aProviderSpec == nil || bProviderSpec == nil {
+		return aProviderSpec == bProviderSpec
+	}
+	var aValue, bValue interface{}
+	if json.Unmarshal(aProviderSpec.Raw, &aValue) != nil || json.Unmarshal(bProviderSpec.Raw, &bValue) != nil {
+		return bytes.Equal(aProviderSpec.Raw, bProviderSpec.Raw)
+	}
+	return equality.Semantic.DeepEqual(withoutNullValues(aValue), withoutNullValues(bValue))
+}
+
+// withoutNullValues removes the null values from the objects of the decoded JSON value.
+func withoutNullValues(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if field == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = withoutNullValues(field)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = withoutNullValues(v[i])
+		}
+	}
+	return value
+}
+
+// apply applies the changes to the machine deployment. The name, resource version and selector stay the same.
+func (c *machineDeploymentChanges) apply(md *clusterv1alpha1.MachineDeployment) {
+	if len(c.setAnnotations) > 0 && md.Annotations == nil {
+		md.Annotations = map[string]string{}
+	}
+	for key, value := range c.setAnnotations {
+		md.Annotations[key] = value
+	}
+	for _, key := range c.removeAnnotations {
+		delete(md.Annotations, key)
 	}
 
-	return OutputMachineDeployment(machineDeployment)
+	if c.replicas != nil {
+		replicas := *c.replicas
+		md.Spec.Replicas = &replicas
+	}
+	if c.paused != nil {
+		md.Spec.Paused = *c.paused
+	}
+	if c.strategy != nil {
+		md.Spec.Strategy = *c.strategy
+	}
+	if c.templateSpec != nil {
+		md.Spec.Template.Spec = *c.templateSpec
+	}
 }
 
-func RestartMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string) (interface{}, error) {
+func RestartMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string, force bool) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := checkMaintenanceWindow(ctx, userInfoGetter, cluster, projectID, force); err != nil {
+		return nil, err
+	}
+
 	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	machineDeployment := &clusterv1alpha1.MachineDeployment{}
 	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: machineDeploymentID}, machineDeployment); err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	if machineDeployment.Spec.Template.Annotations == nil {
@@ -591,10 +1995,15 @@ func RestartMachineDeployment(ctx context.Context, userInfoGetter provider.UserI
 	machineDeployment.Spec.Template.Annotations[kubermaticv1.ForceRestartAnnotation] = strconv.FormatInt(time.Now().UnixNano(), 10)
 
 	if err := client.Update(ctx, machineDeployment); err != nil {
-		return nil, fmt.Errorf("failed to update machine deployment: %w", err)
+		return nil, common.UpstreamErrorToHTTPError(fmt.Errorf("failed to update machine deployment: %w", err), common.UpstreamUserCluster)
 	}
 
-	return OutputMachineDeployment(machineDeployment)
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return outputMachineDeploymentForUser(machineDeployment, userInfo)
 }
 
 func ListMachineDeploymentNodesEvents(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID, eventType string) (interface{}, error) {
@@ -607,22 +2016,22 @@ func ListMachineDeploymentNodesEvents(ctx context.Context, userInfoGetter provid
 
 	client, err := clusterProvider.GetAdminClientForUserCluster(ctx, cluster)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	machines, err := getMachinesForNodeDeployment(ctx, clusterProvider, userInfoGetter, cluster, projectID, machineDeploymentID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	machineSets, err := getMachineSetsForNodeDeployment(ctx, clusterProvider, userInfoGetter, cluster, projectID, machineDeploymentID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	machineDeployment, err := getMachineDeploymentForNodeDeployment(ctx, clusterProvider, userInfoGetter, cluster, projectID, machineDeploymentID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	apiEventType := ""
@@ -638,7 +2047,7 @@ func ListMachineDeploymentNodesEvents(ctx context.Context, userInfoGetter provid
 	for _, machine := range machines.Items {
 		kubermaticEvents, err := common.GetEvents(ctx, client, &machine, metav1.NamespaceSystem)
 		if err != nil {
-			return nil, common.KubernetesErrorToHTTPError(err)
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 		}
 
 		events = append(events, kubermaticEvents...)
@@ -647,7 +2056,7 @@ func ListMachineDeploymentNodesEvents(ctx context.Context, userInfoGetter provid
 	for _, machineSet := range machineSets.Items {
 		kubermaticEvents, err := common.GetEvents(ctx, client, &machineSet, metav1.NamespaceSystem)
 		if err != nil {
-			return nil, common.KubernetesErrorToHTTPError(err)
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 		}
 
 		events = append(events, kubermaticEvents...)
@@ -655,7 +2064,7 @@ func ListMachineDeploymentNodesEvents(ctx context.Context, userInfoGetter provid
 
 	kubermaticEvents, err := common.GetEvents(ctx, client, machineDeployment, metav1.NamespaceSystem)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
 	events = append(events, kubermaticEvents...)
@@ -676,10 +2085,69 @@ func DeleteMachineDeployment(ctx context.Context, userInfoGetter provider.UserIn
 
 	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	return nil, common.UpstreamErrorToHTTPError(client.Delete(ctx, &clusterv1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: machineDeploymentID}}), common.UpstreamUserCluster)
+}
+
+// GracefulDeletionPollInterval is the interval in which a graceful deletion checks whether the machines of the
+// machine deployment are gone, it is shortened in tests.
+var GracefulDeletionPollInterval = 5 * time.Second
+
+// GracefulDeleteMachineDeployment scales the machine deployment to zero first, so that the machine-controller drains
+// and removes the nodes at its own pace, and deletes it once all machines are gone. If that takes longer than the
+// timeout, the machine deployment is deleted anyway and the result reports the remaining machines.
+func GracefulDeleteMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string, timeout time.Duration) (*apiv2.MachineDeploymentDeletion, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	key := types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: machineDeploymentID}
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		machineDeployment := &clusterv1alpha1.MachineDeployment{}
+		if err := client.Get(ctx, key, machineDeployment); err != nil {
+			return err
+		}
+		if machineDeployment.Spec.Replicas != nil && *machineDeployment.Spec.Replicas == 0 {
+			return nil
+		}
+		machineDeployment.Spec.Replicas = ptr.To[int32](0)
+		return client.Update(ctx, machineDeployment)
+	})
+	if err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	deletion := &apiv2.MachineDeploymentDeletion{}
+	err = wait.PollUntilContextTimeout(ctx, GracefulDeletionPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		machineDeployment := &clusterv1alpha1.MachineDeployment{}
+		if err := client.Get(ctx, key, machineDeployment); err != nil {
+			return false, err
+		}
+		deletion.RemainingReplicas = machineDeployment.Status.Replicas
+		return machineDeployment.Status.Replicas == 0, nil
+	})
+	if err != nil {
+		// only the timeout of the deletion is expected, not errors of the user cluster or a canceled request
+		if !wait.Interrupted(err) || ctx.Err() != nil {
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+		}
+		deletion.TimeoutExceeded = true
+	}
+
+	if err := client.Delete(ctx, &clusterv1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: machineDeploymentID}}); err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
 	}
 
-	return nil, common.KubernetesErrorToHTTPError(client.Delete(ctx, &clusterv1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: machineDeploymentID}}))
+	return deletion, nil
 }
 
 func getMachineSetsForNodeDeployment(ctx context.Context, clusterProvider provider.ClusterProvider, userInfoGetter provider.UserInfoGetter, cluster *kubermaticv1.Cluster, projectID, nodeDeploymentID string) (*clusterv1alpha1.MachineSetList, error) {
@@ -734,6 +2202,7 @@ func outputMachine(machine *clusterv1alpha1.Machine, node *corev1.Node, hideInit
 	if err != nil {
 		return nil, fmt.Errorf("failed to get operating system spec from machine: %w", err)
 	}
+	operatingSystemSpec.RedactSecrets()
 
 	cloudSpec, err := machineconversions.GetAPIV2NodeCloudSpec(machine.Spec)
 	if err != nil {
@@ -814,17 +2283,18 @@ func apiNodeStatus(status apiv1.NodeStatus, inputNode *corev1.Node, hideInitialN
 	status.NodeInfo.Architecture = inputNode.Status.NodeInfo.Architecture
 	status.NodeInfo.ContainerRuntimeVersion = inputNode.Status.NodeInfo.ContainerRuntimeVersion
 	status.NodeInfo.KernelVersion = inputNode.Status.NodeInfo.KernelVersion
+	status.Unschedulable = inputNode.Spec.Unschedulable
 	return status
 }
 
-func getNodeList(ctx context.Context, cluster *kubermaticv1.Cluster, clusterProvider provider.ClusterProvider) (*corev1.NodeList, error) {
+func getNodeList(ctx context.Context, cluster *kubermaticv1.Cluster, clusterProvider provider.ClusterProvider, opts ...ctrlruntimeclient.ListOption) (*corev1.NodeList, error) {
 	client, err := clusterProvider.GetAdminClientForUserCluster(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
 
 	nodeList := &corev1.NodeList{}
-	if err := client.List(ctx, nodeList); err != nil {
+	if err := client.List(ctx, nodeList, opts...); err != nil {
 		return nil, err
 	}
 	return nodeList, nil
@@ -930,6 +2400,33 @@ func selectedOperatingSystems(os apiv1.OperatingSystemSpec) int {
 	return counter
 }
 
+// restoreOperatingSystemSecrets sets the redacted subscription secrets of the operating system, and the unset ones if
+// keepUnset is true, to their existing values. Secrets of a different operating system are not restored.
+func restoreOperatingSystemSecrets(os *apiv1.OperatingSystemSpec, existing apiv1.OperatingSystemSpec, keepUnset bool) {
+	restore := func(secret *apiv1.SecretString, existingSecret apiv1.SecretString) {
+		if secret.IsRedacted() || (keepUnset && *secret == "") {
+			*secret = existingSecret
+		}
+	}
+
+	if os.Ubuntu != nil {
+		var existingToken apiv1.SecretString
+		if existing.Ubuntu != nil {
+			existingToken = existing.Ubuntu.UbuntuProToken
+		}
+		restore(&os.Ubuntu.UbuntuProToken, existingToken)
+	}
+	if os.RHEL != nil {
+		existingRHEL := apiv1.RHELSpec{}
+		if existing.RHEL != nil {
+			existingRHEL = *existing.RHEL
+		}
+		restore(&os.RHEL.RHELSubscriptionManagerUser, existingRHEL.RHELSubscriptionManagerUser)
+		restore(&os.RHEL.RHELSubscriptionManagerPassword, existingRHEL.RHELSubscriptionManagerPassword)
+		restore(&os.RHEL.RHSMOfflineToken, existingRHEL.RHSMOfflineToken)
+	}
+}
+
 func getAutoscalingConfiguration(md *clusterv1alpha1.MachineDeployment) (*uint32, *uint32, error) {
 	var minReplicas *uint32
 	if minSize, ok := md.Annotations[machine.AutoscalerMinSizeAnnotation]; ok && minSize != "" {
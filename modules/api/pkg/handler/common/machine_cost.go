@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// hoursPerMonth is the average number of hours of a month, cloud providers use it to compute monthly prices.
+const hoursPerMonth = 730
+
+// EstimateMachineDeploymentCost estimates the monthly cost of the machine deployment in the cluster. The size of
+// the machine deployment is resolved to a price with the price catalog. If the price is unknown, the estimate is
+// returned without costs and with a warning.
+func EstimateMachineDeploymentCost(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, priceCatalog provider.PriceCatalog, nd apiv1.NodeDeployment, projectID, clusterID string) (*apiv2.MachineDeploymentCostEstimate, error) {
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	cloudProvider, err := machine.NodeCloudProviderName(nd.Spec.Template.Cloud)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("%v", err)
+	}
+	if err := machine.ValidateCloudProvider(cluster, &nd); err != nil {
+		return nil, utilerrors.NewBadRequest("%v", err)
+	}
+	if errMsg := ValidateAutoscalingOptions(&nd.Spec); errMsg != "" {
+		return nil, common.WithReason(common.ReasonAutoscalerBounds, utilerrors.NewBadRequest("%s", errMsg))
+	}
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	_, dc, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting dc: %w", err)
+	}
+
+	region, size := nodeDeploymentSize(nd.Spec.Template.Cloud, dc)
+	estimate := &apiv2.MachineDeploymentCostEstimate{
+		Provider:    cloudProvider,
+		Region:      region,
+		Size:        size,
+		MinReplicas: nd.Spec.Replicas,
+		MaxReplicas: nd.Spec.Replicas,
+	}
+	if nd.Spec.MinReplicas != nil && nd.Spec.MaxReplicas != nil {
+		estimate.MinReplicas = int32(*nd.Spec.MinReplicas)
+		estimate.MaxReplicas = int32(*nd.Spec.MaxReplicas)
+		estimate.Assumptions = append(estimate.Assumptions, fmt.Sprintf("the machine deployment is autoscaled between %d and %d nodes", estimate.MinReplicas, estimate.MaxReplicas))
+	} else {
+		estimate.Assumptions = append(estimate.Assumptions, fmt.Sprintf("the machine deployment runs %d nodes", nd.Spec.Replicas))
+	}
+
+	if size == "" {
+		estimate.Warnings = append(estimate.Warnings, fmt.Sprintf("the costs of %s machine deployments can not be estimated, they don't have a size", cloudProvider))
+		return estimate, nil
+	}
+
+	price, err := priceCatalog.InstancePrice(ctx, cloudProvider, region, size)
+	if errors.Is(err, provider.ErrNotFound) {
+		estimate.Warnings = append(estimate.Warnings, fmt.Sprintf("the price of size %s in region %s is unknown, the costs are not estimated", size, region))
+		return estimate, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the price of size %s: %w", size, err)
+	}
+
+	minCost := monthlyCost(price.Hourly, estimate.MinReplicas)
+	maxCost := monthlyCost(price.Hourly, estimate.MaxReplicas)
+	estimate.Currency = price.Currency
+	estimate.HourlyPrice = &price.Hourly
+	estimate.MinMonthlyCost = &minCost
+	estimate.MaxMonthlyCost = &maxCost
+	estimate.Assumptions = append(estimate.Assumptions,
+		fmt.Sprintf("a month has %d hours", hoursPerMonth),
+		fmt.Sprintf("the prices are taken from %s", price.Source),
+	)
+
+	return estimate, nil
+}
+
+// nodeDeploymentSize returns the region and the size of the node deployment, for cloud providers which have
+// sizes with a price. Empty strings are returned for all other cloud providers.
+func nodeDeploymentSize(spec apiv1.NodeCloudSpec, dc *kubermaticv1.Datacenter) (region, size string) {
+	switch {
+	case spec.AWS != nil && dc.Spec.AWS != nil:
+		return dc.Spec.AWS.Region, spec.AWS.InstanceType
+	case spec.Azure != nil && dc.Spec.Azure != nil:
+		return dc.Spec.Azure.Location, spec.Azure.Size
+	case spec.Digitalocean != nil && dc.Spec.Digitalocean != nil:
+		return dc.Spec.Digitalocean.Region, spec.Digitalocean.Size
+	case spec.GCP != nil && dc.Spec.GCP != nil:
+		return dc.Spec.GCP.Region, spec.GCP.MachineType
+	case spec.Hetzner != nil && dc.Spec.Hetzner != nil:
+		return dc.Spec.Hetzner.Location, spec.Hetzner.Type
+	}
+
+	return "", ""
+}
+
+// monthlyCost returns the monthly cost of the number of nodes, rounded to cents.
+func monthlyCost(hourlyPrice float64, replicas int32) float64 {
+	return math.Round(hourlyPrice*hoursPerMonth*float64(replicas)*100) / 100
+}
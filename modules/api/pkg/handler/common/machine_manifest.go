@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ExportMachineDeployments returns the manifests of all machine deployments of the cluster sorted by name. The
+// manifests only contain the fields which are needed to create the machine deployments again, the status, IDs,
+// timestamps and generated annotations and labels are dropped. Secrets stay redacted.
+func ExportMachineDeployments(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) ([]apiv2.MachineDeploymentManifest, error) {
+	rawNodeDeployments, err := ListMachineDeployments(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, nil, projectID, clusterID, false)
+	if err != nil {
+		return nil, err
+	}
+	nodeDeployments := rawNodeDeployments.([]*apiv1.NodeDeployment)
+
+	manifests := make([]apiv2.MachineDeploymentManifest, 0, len(nodeDeployments))
+	for _, nodeDeployment := range nodeDeployments {
+		nd := copyNodeDeployment(nodeDeployment)
+		manifest := apiv2.MachineDeploymentManifest{
+			Name: nd.Name,
+			Spec: nd.Spec,
+		}
+		if len(nd.Annotations) > 0 {
+			manifest.Annotations = nd.Annotations
+		}
+		manifests = append(manifests, manifest)
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].Name < manifests[j].Name
+	})
+
+	return manifests, nil
+}
+
+// ImportMachineDeployments creates the machine deployments of the manifests in the cluster. Every manifest is
+// imported on its own through the same validation as CreateMachineDeployment, so a failed manifest doesn't affect
+// the others. Existing machine deployments are skipped, unless overwrite is set, then they are patched with the
+// manifest. The result of every manifest is returned in the order of the manifests.
+func ImportMachineDeployments(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, sshKeyProvider provider.SSHKeyProvider, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, projectID, clusterID string, manifests []apiv2.MachineDeploymentManifest, overwrite bool, settingsProvider provider.SettingsProvider, caBundle *x509.CertPool) (apiv2.MachineDeploymentImportResultList, error) {
+	rawNodeDeployments, err := ListMachineDeployments(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, projectID, clusterID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := sets.New[string]()
+	for _, nd := range rawNodeDeployments.([]*apiv1.NodeDeployment) {
+		existing.Insert(nd.Name)
+	}
+
+	imported := sets.New[string]()
+	results := apiv2.MachineDeploymentImportResultList{}
+	for _, manifest := range manifests {
+		result := apiv2.MachineDeploymentImportResult{Name: manifest.Name}
+
+		switch {
+		case manifest.Name == "":
+			result.Status = apiv2.MachineDeploymentImportFailed
+			result.Error = "the name is required"
+		case imported.Has(manifest.Name):
+			result.Status = apiv2.MachineDeploymentImportFailed
+			result.Error = "the machine deployment is defined more than once"
+		case existing.Has(manifest.Name) && !overwrite:
+			result.Status = apiv2.MachineDeploymentImportSkipped
+		case existing.Has(manifest.Name):
+			result.Status = apiv2.MachineDeploymentImportUpdated
+			if _, err := overwriteMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, clusterProviderGetter, projectID, clusterID, manifest, settingsProvider, caBundle); err != nil {
+				result.Status = apiv2.MachineDeploymentImportFailed
+				result.Error = err.Error()
+			}
+		default:
+			result.Status = apiv2.MachineDeploymentImportCreated
+			if _, err := createMachineDeploymentFromManifest(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, clusterProviderGetter, projectID, clusterID, manifest, settingsProvider, caBundle); err != nil {
+				result.Status = apiv2.MachineDeploymentImportFailed
+				result.Error = err.Error()
+			}
+		}
+
+		if manifest.Name != "" {
+			imported.Insert(manifest.Name)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func createMachineDeploymentFromManifest(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, sshKeyProvider provider.SSHKeyProvider, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, projectID, clusterID string, manifest apiv2.MachineDeploymentManifest, settingsProvider provider.SettingsProvider, caBundle *x509.CertPool) (interface{}, error) {
+	nd := apiv1.NodeDeployment{
+		ObjectMeta: apiv1.ObjectMeta{
+			Name:        manifest.Name,
+			Annotations: manifest.Annotations,
+		},
+		Spec: manifest.Spec,
+	}
+	if errMsg := ValidateAutoscalingOptions(&nd.Spec); errMsg != "" {
+		return nil, errors.New(errMsg)
+	}
+
+	return CreateMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, clusterProviderGetter, nd, projectID, clusterID, settingsProvider, caBundle, MachineDeploymentOptions{})
+}
+
+func overwriteMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, sshKeyProvider provider.SSHKeyProvider, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, projectID, clusterID string, manifest apiv2.MachineDeploymentManifest, settingsProvider provider.SettingsProvider, caBundle *x509.CertPool) (interface{}, error) {
+	patch, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode machine deployment manifest: %w", err)
+	}
+
+	return PatchMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, clusterProviderGetter, projectID, clusterID, manifest.Name, patch, settingsProvider, caBundle, MachineDeploymentOptions{})
+}
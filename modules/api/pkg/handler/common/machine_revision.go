@@ -0,0 +1,301 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	jsonpatch "github.com/evanphx/json-patch"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// machineDeploymentRevisionAnnotation is set by the machine-controller on machine deployments and their machine
+// sets, the revision of a machine set is increased whenever its template becomes the current one again.
+const machineDeploymentRevisionAnnotation = machineDeploymentControllerAnnotationPrefix + "revision"
+
+// unsetTemplateField is shown in the changes of a revision for fields which are only set on one side.
+const unsetTemplateField = "<unset>"
+
+// machineDeploymentRevision is a machine set owned by a machine deployment together with its parsed revision.
+type machineDeploymentRevision struct {
+	revision   int64
+	machineSet *clusterv1alpha1.MachineSet
+}
+
+// ListMachineDeploymentRevisions returns the revisions of the machine deployment sorted by revision, like
+// `kubectl rollout history` does for deployments. Every revision is a machine set owned by the machine deployment,
+// machine sets without a revision are left out. The changes of a revision are computed against the current spec.
+func ListMachineDeploymentRevisions(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string) (apiv2.MachineDeploymentRevisionList, error) {
+	client, machineDeployment, err := getMachineDeploymentWithClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, machineDeploymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := listMachineDeploymentRevisions(ctx, client, machineDeployment)
+	if err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	current, err := OutputMachineDeployment(machineDeployment)
+	if err != nil {
+		return nil, fmt.Errorf("cannot output machine deployment: %w", err)
+	}
+	current.Spec.Template.OperatingSystem.RedactSecrets()
+
+	result := apiv2.MachineDeploymentRevisionList{}
+	for _, revision := range revisions {
+		ms := revision.machineSet
+		nd, err := outputMachineDeploymentRevision(machineDeployment, ms)
+		if err != nil {
+			return nil, err
+		}
+		nd.Spec.Template.OperatingSystem.RedactSecrets()
+
+		changes, err := nodeTemplateChanges(nd.Spec.Template, current.Spec.Template)
+		if err != nil {
+			return nil, fmt.Errorf("cannot compare revision %d with the current spec: %w", revision.revision, err)
+		}
+
+		var replicas int32
+		if ms.Spec.Replicas != nil {
+			replicas = *ms.Spec.Replicas
+		}
+		result = append(result, apiv2.MachineDeploymentRevision{
+			Revision:          revision.revision,
+			MachineSetName:    ms.Name,
+			CreationTimestamp: apiv1.NewTime(ms.CreationTimestamp.Time),
+			Replicas:          replicas,
+			ReadyReplicas:     ms.Status.ReadyReplicas,
+			AvailableReplicas: ms.Status.AvailableReplicas,
+			Current:           equality.Semantic.DeepEqual(ms.Spec.Template.Spec, machineDeployment.Spec.Template.Spec),
+			Changes:           changes,
+		})
+	}
+
+	return result, nil
+}
+
+// RollbackMachineDeployment copies the template of the machine set of the given revision back into the machine
+// deployment. The template is applied as a patch, so it goes through the same validation as any other change.
+func RollbackMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, sshKeyProvider provider.SSHKeyProvider, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, projectID, clusterID, machineDeploymentID string, revision int64, settingsProvider provider.SettingsProvider, caBundle *x509.CertPool) (interface{}, error) {
+	client, machineDeployment, err := getMachineDeploymentWithClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, machineDeploymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := listMachineDeploymentRevisions(ctx, client, machineDeployment)
+	if err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	var machineSet *clusterv1alpha1.MachineSet
+	for _, r := range revisions {
+		if r.revision == revision {
+			machineSet = r.machineSet
+		}
+	}
+	if machineSet == nil {
+		return nil, utilerrors.NewNotFound("MachineDeploymentRevision", strconv.FormatInt(revision, 10))
+	}
+
+	current, err := OutputMachineDeployment(machineDeployment)
+	if err != nil {
+		return nil, fmt.Errorf("cannot output machine deployment: %w", err)
+	}
+	target, err := outputMachineDeploymentRevision(machineDeployment, machineSet)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the template is compared, the merge patch removes the fields which are not set in the revision.
+	currentJSON, err := json.Marshal(apiv1.NodeDeployment{Spec: apiv1.NodeDeploymentSpec{Template: current.Spec.Template}})
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode machine deployment: %w", err)
+	}
+	targetJSON, err := json.Marshal(apiv1.NodeDeployment{Spec: apiv1.NodeDeploymentSpec{Template: target.Spec.Template}})
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode revision %d: %w", revision, err)
+	}
+	patch, err := jsonpatch.CreateMergePatch(currentJSON, targetJSON)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create patch for revision %d: %w", revision, err)
+	}
+
+	return PatchMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, clusterProviderGetter, projectID, clusterID, machineDeploymentID, patch, settingsProvider, caBundle, MachineDeploymentOptions{})
+}
+
+func getMachineDeploymentWithClient(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string) (ctrlruntimeclient.Client, *clusterv1alpha1.MachineDeployment, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	machineDeployment := &clusterv1alpha1.MachineDeployment{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: machineDeploymentID}, machineDeployment); err != nil {
+		return nil, nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	return client, machineDeployment, nil
+}
+
+// listMachineDeploymentRevisions returns the machine sets controlled by the machine deployment which have a
+// revision, sorted by revision.
+func listMachineDeploymentRevisions(ctx context.Context, client ctrlruntimeclient.Client, machineDeployment *clusterv1alpha1.MachineDeployment) ([]machineDeploymentRevision, error) {
+	machineSets := &clusterv1alpha1.MachineSetList{}
+	if err := client.List(ctx, machineSets, ctrlruntimeclient.InNamespace(machineDeployment.Namespace)); err != nil {
+		return nil, err
+	}
+
+	var revisions []machineDeploymentRevision
+	for i := range machineSets.Items {
+		ms := &machineSets.Items[i]
+		if !metav1.IsControlledBy(ms, machineDeployment) {
+			continue
+		}
+		revision, err := strconv.ParseInt(ms.Annotations[machineDeploymentRevisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+		revisions = append(revisions, machineDeploymentRevision{revision: revision, machineSet: ms})
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].revision < revisions[j].revision
+	})
+
+	return revisions, nil
+}
+
+// outputMachineDeploymentRevision returns the node deployment the machine deployment would be with the template of
+// the machine set.
+func outputMachineDeploymentRevision(machineDeployment *clusterv1alpha1.MachineDeployment, machineSet *clusterv1alpha1.MachineSet) (*apiv1.NodeDeployment, error) {
+	md := machineDeployment.DeepCopy()
+	md.Spec.Template.Spec = *machineSet.Spec.Template.Spec.DeepCopy()
+
+	nd, err := OutputMachineDeployment(md)
+	if err != nil {
+		return nil, fmt.Errorf("cannot output machine set %s: %w", machineSet.Name, err)
+	}
+
+	return nd, nil
+}
+
+// nodeTemplateChanges returns the fields which differ between the node templates as "path: from -> to", sorted by
+// path. Lists are compared as a whole.
+func nodeTemplateChanges(from, to apiv1.NodeSpec) ([]string, error) {
+	fromFields, err := flattenNodeTemplate(from)
+	if err != nil {
+		return nil, err
+	}
+	toFields, err := flattenNodeTemplate(to)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make(map[string]struct{}, len(fromFields))
+	for path := range fromFields {
+		paths[path] = struct{}{}
+	}
+	for path := range toFields {
+		paths[path] = struct{}{}
+	}
+
+	var changes []string
+	for path := range paths {
+		fromValue, fromOK := fromFields[path]
+		toValue, toOK := toFields[path]
+		if fromOK && toOK && fromValue == toValue {
+			continue
+		}
+		if !fromOK {
+			fromValue = unsetTemplateField
+		}
+		if !toOK {
+			toValue = unsetTemplateField
+		}
+		changes = append(changes, fmt.Sprintf("%s: %s -> %s", path, fromValue, toValue))
+	}
+	sort.Strings(changes)
+
+	return changes, nil
+}
+
+func flattenNodeTemplate(template apiv1.NodeSpec) (map[string]string, error) {
+	raw, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	if err := flattenFields("", fields, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func flattenFields(prefix string, value interface{}, result map[string]string) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			if err := flattenFields(path, field, result); err != nil {
+				return err
+			}
+		}
+	case nil:
+	case string:
+		result[prefix] = v
+	default:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		result[prefix] = string(raw)
+	}
+
+	return nil
+}
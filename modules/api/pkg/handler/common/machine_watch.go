@@ -0,0 +1,173 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WatchMachineDeployments streams the machine deployments of the user cluster and their nodes. All machine
+// deployments are sent in a SYNC event first, afterwards every change is sent as a single event. The objects are
+// converted like the REST endpoints do. Whenever a watch ends, e.g. because the API server closed it, everything is
+// listed and sent again. It returns once the context is done or an event can not be sent.
+func WatchMachineDeployments(ctx context.Context, client ctrlruntimeclient.WithWatch, userInfo *provider.UserInfo, send func(*apiv2.MachineDeploymentWatchEvent) error) error {
+	for ctx.Err() == nil {
+		if err := watchMachineDeployments(ctx, client, userInfo, send); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// watchMachineDeployments sends the SYNC event and the events of the watches until one of them ends. The watches are
+// started before the list, so that no change gets lost in between.
+func watchMachineDeployments(ctx context.Context, client ctrlruntimeclient.WithWatch, userInfo *provider.UserInfo, send func(*apiv2.MachineDeploymentWatchEvent) error) error {
+	machineDeploymentWatch, err := client.Watch(ctx, &clusterv1alpha1.MachineDeploymentList{}, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem))
+	if err != nil {
+		return fmt.Errorf("failed to watch machine deployments: %w", err)
+	}
+	defer machineDeploymentWatch.Stop()
+
+	machineWatch, err := client.Watch(ctx, &clusterv1alpha1.MachineList{}, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem))
+	if err != nil {
+		return fmt.Errorf("failed to watch machines: %w", err)
+	}
+	defer machineWatch.Stop()
+
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return fmt.Errorf("failed to list machine deployments: %w", err)
+	}
+
+	// The selectors of the machine deployments tell which machine deployment a machine belongs to.
+	selectors := map[string]labels.Selector{}
+	nodeDeployments := make([]*apiv1.NodeDeployment, 0, len(machineDeployments.Items))
+	for i := range machineDeployments.Items {
+		md := &machineDeployments.Items[i]
+		nd, err := outputMachineDeploymentForUser(md, userInfo)
+		if err != nil {
+			return fmt.Errorf("failed to output machine deployment %s: %w", md.Name, err)
+		}
+		nodeDeployments = append(nodeDeployments, nd)
+		selectors[md.Name] = labels.SelectorFromSet(md.Spec.Selector.MatchLabels)
+	}
+	if err := send(&apiv2.MachineDeploymentWatchEvent{Type: apiv2.MachineDeploymentWatchEventSync, NodeDeployments: nodeDeployments}); err != nil {
+		return err
+	}
+
+	for {
+		var event *apiv2.MachineDeploymentWatchEvent
+
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case e, ok := <-machineDeploymentWatch.ResultChan():
+			if !ok {
+				return nil
+			}
+			if e.Type == watch.Error {
+				return apierrors.FromObject(e.Object)
+			}
+			if e.Type == watch.Bookmark {
+				continue
+			}
+			md, ok := e.Object.(*clusterv1alpha1.MachineDeployment)
+			if !ok {
+				continue
+			}
+			if e.Type == watch.Deleted {
+				delete(selectors, md.Name)
+			} else {
+				selectors[md.Name] = labels.SelectorFromSet(md.Spec.Selector.MatchLabels)
+			}
+
+			nd, err := outputMachineDeploymentForUser(md, userInfo)
+			if err != nil {
+				return fmt.Errorf("failed to output machine deployment %s: %w", md.Name, err)
+			}
+			event = &apiv2.MachineDeploymentWatchEvent{Type: string(e.Type), NodeDeployment: nd}
+
+		case e, ok := <-machineWatch.ResultChan():
+			if !ok {
+				return nil
+			}
+			if e.Type == watch.Error {
+				return apierrors.FromObject(e.Object)
+			}
+			if e.Type == watch.Bookmark {
+				continue
+			}
+			machine, ok := e.Object.(*clusterv1alpha1.Machine)
+			if !ok {
+				continue
+			}
+			machineDeploymentID := machineDeploymentForMachine(machine, selectors)
+			if machineDeploymentID == "" {
+				continue
+			}
+
+			node, err := outputMachine(machine, getNodeOfMachine(ctx, client, machine), false)
+			if err != nil {
+				return fmt.Errorf("failed to output machine %s: %w", machine.Name, err)
+			}
+			event = &apiv2.MachineDeploymentWatchEvent{Type: string(e.Type), Node: node, MachineDeploymentID: machineDeploymentID}
+		}
+
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+}
+
+func machineDeploymentForMachine(machine *clusterv1alpha1.Machine, selectors map[string]labels.Selector) string {
+	for name, selector := range selectors {
+		if selector.Matches(labels.Set(machine.Labels)) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// getNodeOfMachine returns the node of the machine or nil if it has none or the node can not be read.
+func getNodeOfMachine(ctx context.Context, client ctrlruntimeclient.Client, machine *clusterv1alpha1.Machine) *corev1.Node {
+	if machine.Status.NodeRef == nil {
+		return nil
+	}
+
+	node := &corev1.Node{}
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKey{Name: machine.Status.NodeRef.Name}, node); err != nil {
+		return nil
+	}
+
+	return node
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	clusterresources "k8c.io/dashboard/v2/pkg/resources/cluster"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// MaintenanceWindowNow returns the time the maintenance windows are checked against, it is replaced in tests.
+var MaintenanceWindowNow = time.Now
+
+// UpdateMaintenanceWindowEndpoint replaces the maintenance window of the cluster. An empty window removes it. As the
+// window restricts the other members of the project, only project owners and admins can change it.
+func UpdateMaintenanceWindowEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string, window apiv1.MaintenanceWindow) (*apiv1.MaintenanceWindow, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+
+	if err := ensureProjectOwnerOrAdmin(ctx, userInfoGetter, projectID, "change the maintenance window"); err != nil {
+		return nil, err
+	}
+
+	var newWindow *apiv1.MaintenanceWindow
+	if !isEmptyMaintenanceWindow(window) {
+		if err := clusterresources.ValidateMaintenanceWindow(window); err != nil {
+			return nil, utilerrors.NewBadRequest("invalid maintenance window: %v", err)
+		}
+		newWindow = &window
+	}
+
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	cluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	if err := clusterresources.SetMaintenanceWindow(cluster, newWindow); err != nil {
+		return nil, err
+	}
+	if _, err := updateCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, cluster); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return &window, nil
+}
+
+// checkMaintenanceWindow rejects a disruptive action outside of the maintenance window of the cluster with 409,
+// telling when the next window starts. An invalid stored window rejects the action with 409 as well, until a new
+// window is set. Project owners and admins can force the action.
+func checkMaintenanceWindow(ctx context.Context, userInfoGetter provider.UserInfoGetter, cluster *kubermaticv1.Cluster, projectID string, force bool) error {
+	window, err := clusterresources.GetMaintenanceWindow(cluster)
+	if err == nil && window == nil {
+		return nil
+	}
+
+	var (
+		inside bool
+		next   time.Time
+	)
+	if err == nil {
+		inside, next, err = clusterresources.InMaintenanceWindow(*window, MaintenanceWindowNow())
+	}
+	if inside {
+		return nil
+	}
+
+	if force {
+		return ensureProjectOwnerOrAdmin(ctx, userInfoGetter, projectID, "bypass the maintenance window")
+	}
+
+	if err != nil {
+		return utilerrors.New(http.StatusConflict, fmt.Sprintf("the maintenance window stored for cluster %s is invalid, set a new maintenance window: %v", cluster.Name, err))
+	}
+
+	return utilerrors.New(http.StatusConflict, fmt.Sprintf("cluster %s is outside of its maintenance window, the next window starts at %s", cluster.Name, next.Format(time.RFC3339)))
+}
+
+// keepMaintenanceWindow copies the maintenance window annotation of the existing cluster to the patched one. The
+// window can only be changed through its own endpoint, which is restricted to project owners.
+func keepMaintenanceWindow(oldCluster, newCluster *kubermaticv1.Cluster) {
+	value, ok := oldCluster.Annotations[clusterresources.MaintenanceWindowAnnotation]
+	if !ok {
+		delete(newCluster.Annotations, clusterresources.MaintenanceWindowAnnotation)
+		return
+	}
+	if newCluster.Annotations == nil {
+		newCluster.Annotations = map[string]string{}
+	}
+	newCluster.Annotations[clusterresources.MaintenanceWindowAnnotation] = value
+}
+
+// outputMaintenanceWindow returns the maintenance window shown in the cluster spec. An unparsable window is left
+// out, the actions it restricts are rejected until a new window is set.
+func outputMaintenanceWindow(cluster *kubermaticv1.Cluster) *apiv1.MaintenanceWindow {
+	window, err := clusterresources.GetMaintenanceWindow(cluster)
+	if err != nil {
+		return nil
+	}
+
+	return window
+}
+
+func ensureProjectOwnerOrAdmin(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, action string) error {
+	userInfo, err := userInfoGetter(ctx, projectID)
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	if !userInfo.IsAdmin && !userInfo.Roles.Has("owners") {
+		return utilerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: only project owners and admins can %s", action))
+	}
+
+	return nil
+}
+
+func isEmptyMaintenanceWindow(window apiv1.MaintenanceWindow) bool {
+	return len(window.Weekdays) == 0 && window.StartTime == "" && window.Duration == "" && window.Timezone == ""
+}
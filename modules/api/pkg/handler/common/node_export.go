@@ -0,0 +1,250 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	machineconversions "k8c.io/dashboard/v2/pkg/machine"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NodeExport holds the objects of a user cluster which are needed to export its nodes.
+// The export rows are built one by one while they are written, so that the whole table
+// is never kept in memory.
+type NodeExport struct {
+	machines           []clusterv1alpha1.Machine
+	machineDeployments []clusterv1alpha1.MachineDeployment
+	nodes              []corev1.Node
+	nodeUsage          map[string]corev1.ResourceList
+}
+
+func GetNodeExport(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) (*NodeExport, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	machineList := &clusterv1alpha1.MachineList{}
+	if err := client.List(ctx, machineList, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return nil, common.UpstreamErrorToHTTPError(fmt.Errorf("failed to load machines from cluster: %w", err), common.UpstreamUserCluster)
+	}
+
+	machineDeploymentList := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, machineDeploymentList, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return nil, common.UpstreamErrorToHTTPError(fmt.Errorf("failed to load machine deployments from cluster: %w", err), common.UpstreamUserCluster)
+	}
+
+	nodeList, err := getNodeList(ctx, cluster, clusterProvider)
+	if err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	dynamicClient, err := clusterProvider.GetAdminClientForUserCluster(ctx, cluster)
+	if err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	nodeMetricsList := &v1beta1.NodeMetricsList{}
+	if err := dynamicClient.List(ctx, nodeMetricsList); err != nil {
+		// Happens during cluster creation when the CRD is not setup yet
+		if !meta.IsNoMatchError(err) {
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+		}
+	}
+
+	nodeUsage := make(map[string]corev1.ResourceList, len(nodeMetricsList.Items))
+	for _, m := range nodeMetricsList.Items {
+		nodeUsage[m.Name] = m.Usage
+	}
+
+	return &NodeExport{
+		machines:           machineList.Items,
+		machineDeployments: machineDeploymentList.Items,
+		nodes:              nodeList.Items,
+		nodeUsage:          nodeUsage,
+	}, nil
+}
+
+// WriteRows calls write for every node of the cluster. Nodes which were not created by a machine, e.g. for
+// kubeadm clusters, are exported without machine deployment and cloud provider.
+func (e *NodeExport) WriteRows(write func(apiv2.NodeExportRow) error) error {
+	nodesByUID := make(map[string]*corev1.Node, len(e.nodes))
+	nodesByName := make(map[string]*corev1.Node, len(e.nodes))
+	for i := range e.nodes {
+		nodesByUID[string(e.nodes[i].UID)] = &e.nodes[i]
+		nodesByName[e.nodes[i].Name] = &e.nodes[i]
+	}
+
+	selectors := make([]labels.Selector, len(e.machineDeployments))
+	for i, md := range e.machineDeployments {
+		selector, err := metav1.LabelSelectorAsSelector(&md.Spec.Selector)
+		if err != nil {
+			return fmt.Errorf("invalid selector of machine deployment %s: %w", md.Name, err)
+		}
+		selectors[i] = selector
+	}
+
+	matchedMachineNodes := sets.New[string]()
+	for i := range e.machines {
+		m := &e.machines[i]
+
+		var node *corev1.Node
+		if m.Status.NodeRef != nil {
+			node = nodesByUID[string(m.Status.NodeRef.UID)]
+		}
+		if node == nil {
+			node = nodesByName[m.Name]
+		}
+		if node == nil {
+			continue
+		}
+		matchedMachineNodes.Insert(string(node.UID))
+
+		row, err := e.machineRow(m, node, selectors)
+		if err != nil {
+			return fmt.Errorf("failed to export machine %s: %w", m.Name, err)
+		}
+		if err := write(row); err != nil {
+			return err
+		}
+	}
+
+	for i := range e.nodes {
+		if matchedMachineNodes.Has(string(e.nodes[i].UID)) {
+			continue
+		}
+		if err := write(e.nodeRow(&e.nodes[i])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *NodeExport) machineRow(m *clusterv1alpha1.Machine, node *corev1.Node, selectors []labels.Selector) (apiv2.NodeExportRow, error) {
+	row := e.nodeRow(node)
+
+	cloudSpec, err := machineconversions.GetAPIV2NodeCloudSpec(m.Spec)
+	if err != nil {
+		return row, fmt.Errorf("failed to get node cloud spec from machine: %w", err)
+	}
+	row.CloudProvider, err = machine.NodeCloudProviderName(*cloudSpec)
+	if err != nil {
+		return row, err
+	}
+	if size := nodeInstanceSize(cloudSpec); size != "" {
+		row.InstanceSize = size
+	}
+	if row.KubeletVersion == "" {
+		row.KubeletVersion = m.Spec.Versions.Kubelet
+	}
+
+	machineLabels := labels.Set(m.Labels)
+	for i, selector := range selectors {
+		if !selector.Empty() && selector.Matches(machineLabels) {
+			row.MachineDeployment = e.machineDeployments[i].Name
+			break
+		}
+	}
+
+	return row, nil
+}
+
+func (e *NodeExport) nodeRow(node *corev1.Node) apiv2.NodeExportRow {
+	row := apiv2.NodeExportRow{
+		Name:                  node.Name,
+		InstanceSize:          node.Labels[corev1.LabelInstanceTypeStable],
+		KubeletVersion:        node.Status.NodeInfo.KubeletVersion,
+		CreationTimestamp:     apiv1.NewTime(node.CreationTimestamp.Time),
+		CPUCapacityMillicores: node.Status.Capacity.Cpu().MilliValue(),
+		MemoryCapacityBytes:   node.Status.Capacity.Memory().Value(),
+	}
+
+	if usage, ok := e.nodeUsage[node.Name]; ok {
+		cpu := usage.Cpu().MilliValue()
+		memory := usage.Memory().Value()
+		row.CPUUsageMillicores = &cpu
+		row.MemoryUsageBytes = &memory
+	}
+
+	return row
+}
+
+// nodeInstanceSize returns the instance type of the node cloud spec. Providers without
+// instance types are described by their CPU and memory configuration.
+func nodeInstanceSize(cloud *apiv1.NodeCloudSpec) string {
+	switch {
+	case cloud.AWS != nil:
+		return cloud.AWS.InstanceType
+	case cloud.Alibaba != nil:
+		return cloud.Alibaba.InstanceType
+	case cloud.Anexia != nil:
+		return fmt.Sprintf("%d CPUs, %d MB", cloud.Anexia.CPUs, cloud.Anexia.Memory)
+	case cloud.Azure != nil:
+		return cloud.Azure.Size
+	case cloud.Digitalocean != nil:
+		return cloud.Digitalocean.Size
+	case cloud.GCP != nil:
+		return cloud.GCP.MachineType
+	case cloud.Hetzner != nil:
+		return cloud.Hetzner.Type
+	case cloud.Kubevirt != nil:
+		if cloud.Kubevirt.Instancetype != nil {
+			return cloud.Kubevirt.Instancetype.Name
+		}
+		return fmt.Sprintf("%s CPUs, %s", cloud.Kubevirt.CPUs, cloud.Kubevirt.Memory)
+	case cloud.Nutanix != nil:
+		return fmt.Sprintf("%d CPUs, %d MB", cloud.Nutanix.CPUs, cloud.Nutanix.MemoryMB)
+	case cloud.OpenNebula != nil:
+		if cloud.OpenNebula.VCPU != nil && cloud.OpenNebula.Memory != nil {
+			return fmt.Sprintf("%d CPUs, %d MB", *cloud.OpenNebula.VCPU, *cloud.OpenNebula.Memory)
+		}
+	case cloud.Openstack != nil:
+		return cloud.Openstack.Flavor
+	case cloud.Packet != nil:
+		return cloud.Packet.InstanceType
+	case cloud.VMwareCloudDirector != nil:
+		return fmt.Sprintf("%d CPUs, %d MB", cloud.VMwareCloudDirector.CPUs, cloud.VMwareCloudDirector.MemoryMB)
+	case cloud.VSphere != nil:
+		return fmt.Sprintf("%d CPUs, %d MB", cloud.VSphere.CPUs, cloud.VSphere.Memory)
+	}
+
+	return ""
+}
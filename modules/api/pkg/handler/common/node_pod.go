@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListNodePods returns the pods which are scheduled on the given node of the user cluster, sorted by namespace
+// and name.
+func ListNodePods(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, nodeID string) (apiv2.NodePodList, error) {
+	client, node, err := getNodeForPods(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	pods := &corev1.PodList{}
+	if err := client.List(ctx, pods, &ctrlruntimeclient.ListOptions{FieldSelector: fields.OneTermEqualSelector(common.PodNodeNameFieldIndexerKey, node.Name)}); err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	result := apiv2.NodePodList{}
+	for i := range pods.Items {
+		result = append(result, outputNodePod(&pods.Items[i]))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Namespace != result[j].Namespace {
+			return result[i].Namespace < result[j].Namespace
+		}
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+func outputNodePod(pod *corev1.Pod) apiv2.NodePod {
+	nodePod := apiv2.NodePod{
+		Name:      pod.Name,
+		Namespace: pod.Namespace,
+		Phase:     string(pod.Status.Phase),
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		nodePod.Restarts += status.RestartCount
+	}
+	for _, container := range pod.Spec.Containers {
+		nodePod.CPURequestMillicores += container.Resources.Requests.Cpu().MilliValue()
+		nodePod.MemoryRequestBytes += container.Resources.Requests.Memory().Value()
+	}
+
+	return nodePod
+}
+
+// EvictNodePod evicts the pod from the given node of the user cluster. The eviction honors the pod disruption
+// budgets, if one of them blocks it, a 429 error with the name of the budget is returned.
+func EvictNodePod(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, nodeID, namespace, podName string) error {
+	client, node, err := getNodeForPods(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, nodeID)
+	if err != nil {
+		return err
+	}
+
+	pod := &corev1.Pod{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: podName}, pod); err != nil {
+		return common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+	if pod.Spec.NodeName != node.Name {
+		return utilerrors.NewNotFound("Pod", fmt.Sprintf("%s/%s", namespace, podName))
+	}
+
+	eviction := &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+	}
+	if err := client.SubResource("eviction").Create(ctx, pod, eviction); err != nil {
+		if apierrors.IsTooManyRequests(err) {
+			return evictionBlockedError(ctx, client, pod, err)
+		}
+		return common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	return nil
+}
+
+// getNodeForPods returns the client of the user cluster and the node, which is looked up the same way as
+// nodes are deleted, i.e. by the name of its machine or its own name.
+func getNodeForPods(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, nodeID string) (ctrlruntimeclient.Client, *corev1.Node, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	_, node, err := findMachineAndNode(ctx, nodeID, client)
+	if err != nil {
+		return nil, nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+	if node == nil {
+		return nil, nil, utilerrors.NewNotFound("Node", nodeID)
+	}
+
+	return client, node, nil
+}
+
+// evictionBlockedError returns the error for an eviction which was rejected, because it would violate a pod
+// disruption budget. The API server doesn't return the name of the budget in a structured way, so it is
+// looked up by the labels of the pod.
+func evictionBlockedError(ctx context.Context, client ctrlruntimeclient.Client, pod *corev1.Pod, evictionErr error) error {
+	pdbs := &policyv1.PodDisruptionBudgetList{}
+	if err := client.List(ctx, pdbs, ctrlruntimeclient.InNamespace(pod.Namespace)); err != nil {
+		return utilerrors.New(http.StatusTooManyRequests, fmt.Sprintf("cannot evict pod %s/%s: %v", pod.Namespace, pod.Name, evictionErr))
+	}
+
+	var names []string
+	for _, pdb := range pdbs.Items {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		names = append(names, pdb.Name)
+	}
+	if len(names) == 0 {
+		return utilerrors.New(http.StatusTooManyRequests, fmt.Sprintf("cannot evict pod %s/%s: %v", pod.Namespace, pod.Name, evictionErr))
+	}
+	sort.Strings(names)
+
+	return utilerrors.New(http.StatusTooManyRequests, fmt.Sprintf("cannot evict pod %s/%s, it is blocked by the pod disruption budget %s", pod.Namespace, pod.Name, strings.Join(names, ", ")))
+}
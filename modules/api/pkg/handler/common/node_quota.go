@@ -0,0 +1,169 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// enforceProjectNodeQuota checks that the project stays within its node quota when the given number of nodes and
+// machine deployments is added. The usage is only determined if the project has a quota which the change adds to,
+// as it requires listing the machine deployments of all clusters of the project.
+func enforceProjectNodeQuota(ctx context.Context, clusterProviderGetter provider.ClusterProviderGetter, seedsGetter provider.SeedsGetter, project *kubermaticv1.Project, addedNodes, addedMachineDeployments int32) error {
+	quota, err := machine.GetProjectNodeQuota(project)
+	if err != nil {
+		return err
+	}
+	if (quota.MaxNodes == 0 || addedNodes <= 0) && (quota.MaxMachineDeployments == 0 || addedMachineDeployments <= 0) {
+		return nil
+	}
+
+	usage, err := getProjectNodeQuotaUsage(ctx, clusterProviderGetter, seedsGetter, project)
+	if err != nil {
+		return err
+	}
+
+	if err := machine.ValidateNodeQuota(quota, usage, addedNodes, addedMachineDeployments); err != nil {
+		details := []string{
+			fmt.Sprintf("nodes: %d, limit: %d", usage.Nodes, quota.MaxNodes),
+			fmt.Sprintf("machine deployments: %d, limit: %d", usage.MachineDeployments, quota.MaxMachineDeployments),
+		}
+		return common.WithReason(common.ReasonNodeQuota, utilerrors.NewWithDetails(http.StatusForbidden, fmt.Sprintf("node quota exceeded: %v", err), details))
+	}
+
+	return nil
+}
+
+// getProjectNodeQuotaUsage sums up the nodes and machine deployments of all clusters of the project. The initial
+// machine deployments which were not created yet are counted too, so that clusters which are still being created
+// count against the quota. Clusters whose API server is not up yet cannot have other machine deployments, the
+// machine deployments of the other clusters are listed by a bounded number of workers.
+func getProjectNodeQuotaUsage(ctx context.Context, clusterProviderGetter provider.ClusterProviderGetter, seedsGetter provider.SeedsGetter, project *kubermaticv1.Project) (machine.NodeQuotaUsage, error) {
+	usage := machine.NodeQuotaUsage{}
+
+	seeds, err := seedsGetter()
+	if err != nil {
+		return usage, common.KubernetesErrorToHTTPError(err)
+	}
+
+	var runningClusters []projectCluster
+	for seedName, seed := range seeds {
+		if seed.Status.Phase == kubermaticv1.SeedInvalidPhase {
+			kubermaticlog.Logger.Warnf("skipping seed %s as it is in an invalid phase", seedName)
+			continue
+		}
+
+		clusterProvider, err := clusterProviderGetter(seed)
+		if err != nil {
+			// if one or more Seeds are bad, continue with the request, log that a Seed is in error
+			kubermaticlog.Logger.Warnw("error getting cluster provider", "seed", seedName, "error", err)
+			continue
+		}
+
+		clusters, err := clusterProvider.List(ctx, project, nil)
+		if err != nil {
+			return usage, common.KubernetesErrorToHTTPError(err)
+		}
+
+		for i := range clusters.Items {
+			cluster := &clusters.Items[i]
+			if isBYO, err := common.IsBringYourOwnProvider(cluster.Spec.Cloud); err != nil || isBYO {
+				continue
+			}
+
+			initialMachineDeployments, err := machine.GetInitialMachineDeployments(cluster)
+			if err != nil {
+				kubermaticlog.Logger.Warnw("failed to count the initial machine deployments", "cluster", cluster.Name, "error", err)
+			}
+			for _, md := range initialMachineDeployments {
+				usage.Nodes += machine.MachineDeploymentNodes(md)
+				usage.MachineDeployments++
+			}
+
+			if cluster.Status.ExtendedHealth.Apiserver == kubermaticv1.HealthStatusUp {
+				runningClusters = append(runningClusters, projectCluster{seed: seedName, clusterProvider: clusterProvider, cluster: cluster})
+			}
+		}
+	}
+
+	clusterUsages := make([]machine.NodeQuotaUsage, len(runningClusters))
+	clusterErrs := make([]error, len(runningClusters))
+
+	var wg sync.WaitGroup
+	positions := make(chan int)
+	for range min(projectMachineDeploymentWorkers, len(runningClusters)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			for pos := range positions {
+				clusterUsages[pos], clusterErrs[pos] = getClusterNodeQuotaUsage(ctx, runningClusters[pos])
+			}
+		}()
+	}
+
+	for i := range runningClusters {
+		positions <- i
+	}
+	close(positions)
+	wg.Wait()
+
+	for i := range runningClusters {
+		if clusterErrs[i] != nil {
+			return usage, clusterErrs[i]
+		}
+		usage.Nodes += clusterUsages[i].Nodes
+		usage.MachineDeployments += clusterUsages[i].MachineDeployments
+	}
+
+	return usage, nil
+}
+
+func getClusterNodeQuotaUsage(ctx context.Context, pc projectCluster) (machine.NodeQuotaUsage, error) {
+	usage := machine.NodeQuotaUsage{}
+
+	client, err := pc.clusterProvider.GetAdminClientForUserCluster(ctx, pc.cluster)
+	if err != nil {
+		return usage, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return usage, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	for i := range machineDeployments.Items {
+		usage.Nodes += machine.MachineDeploymentNodes(&machineDeployments.Items[i])
+		usage.MachineDeployments++
+	}
+
+	return usage, nil
+}
@@ -80,6 +80,33 @@ func GetProviderPreset(p *kubermaticv1.Preset, providerType kubermaticv1.Provide
 	return &presetBase
 }
 
+// GetPresetProviderFields returns which fields of the provider configuration are set, keyed by their JSON name.
+// The values are never returned, so the result can be shown to users who must not see the credentials.
+func GetPresetProviderFields(p *kubermaticv1.Preset, providerType kubermaticv1.ProviderType) map[string]bool {
+	hasProvider, providerField := PresetHasProvider(p, providerType)
+	if !hasProvider {
+		return nil
+	}
+
+	provider := reflect.Indirect(providerField)
+	fields := map[string]bool{}
+	for i := 0; i < provider.NumField(); i++ {
+		field := provider.Type().Field(i)
+		// the embedded ProviderPreset holds the preset settings, not the provider configuration
+		if field.Anonymous {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = !provider.Field(i).IsZero()
+	}
+
+	return fields
+}
+
 func ValidatePreset(p *kubermaticv1.Preset, providerType kubermaticv1.ProviderType) error {
 	hasProvider, providerField := PresetHasProvider(p, providerType)
 	if !hasProvider {
@@ -0,0 +1,213 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	semverlib "github.com/Masterminds/semver/v3"
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+const (
+	// projectMachineDeploymentWorkers is the number of clusters whose machine deployments are listed at the same time.
+	projectMachineDeploymentWorkers = 10
+
+	seedNotReachableError    = "failed to list the clusters of the seed"
+	clusterNotReachableError = "failed to list the machine deployments of the cluster"
+)
+
+// projectCluster is a cluster of the project together with the provider of its seed.
+type projectCluster struct {
+	seed            string
+	clusterProvider provider.ClusterProvider
+	cluster         *kubermaticv1.Cluster
+}
+
+// ListProjectMachineDeployments lists the machine deployments of all clusters of the project. The seeds are traversed
+// like for the cluster list and the machine deployments of the clusters are listed concurrently by a bounded number of
+// workers. Seeds and clusters which can't be reached get an entry with the error instead of failing the whole request,
+// the details of the error are only added for admins. If kubeletOlderThan is set, only the machine deployments with an
+// older kubelet version are returned.
+func ListProjectMachineDeployments(
+	ctx context.Context,
+	userInfoGetter provider.UserInfoGetter,
+	projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider,
+	seedsGetter provider.SeedsGetter,
+	clusterProviderGetter provider.ClusterProviderGetter,
+	seedCircuitBreaker *SeedCircuitBreaker,
+	projectID string,
+	kubeletOlderThan *semverlib.Version,
+) ([]apiv2.ProjectMachineDeployment, error) {
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	seeds, err := seedsGetter()
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	projectClusters := []projectCluster{}
+	listSeedClusters := func(ctx context.Context, seed *kubermaticv1.Seed, seedClusterProvider provider.ClusterProvider) ([]*apiv1.Cluster, error) {
+		clusters, err := seedClusterProvider.List(ctx, project, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		seedClusters := make([]projectCluster, 0, len(clusters.Items))
+		for i := range clusters.Items {
+			cluster := &clusters.Items[i]
+			if _, _, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName); err != nil {
+				// Ignore 403 errors and omit clusters with not accessible datacenters in the result.
+				var errHttp *utilerrors.HTTPError
+				if errors.As(err, &errHttp) && errHttp.StatusCode() == http.StatusForbidden {
+					continue
+				}
+				return nil, err
+			}
+			seedClusters = append(seedClusters, projectCluster{seed: seed.Name, clusterProvider: seedClusterProvider, cluster: cluster})
+		}
+		projectClusters = append(projectClusters, seedClusters...)
+
+		return nil, nil
+	}
+	_, brokenSeeds := ListProjectClusters(ctx, seeds, clusterProviderGetter, userInfo, seedsGetter, projectID, NewLastKnownClusters(), seedCircuitBreaker, listSeedClusters)
+
+	result := []apiv2.ProjectMachineDeployment{}
+	for _, seed := range brokenSeeds {
+		result = append(result, apiv2.ProjectMachineDeployment{Seed: seed, Error: seedNotReachableError})
+	}
+
+	for _, entries := range listProjectClustersMachineDeployments(ctx, userInfoGetter, userInfo, projectClusters, projectID, kubeletOlderThan) {
+		result = append(result, entries...)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Seed != result[j].Seed {
+			return result[i].Seed < result[j].Seed
+		}
+		if result[i].ClusterName != result[j].ClusterName {
+			return result[i].ClusterName < result[j].ClusterName
+		}
+		if result[i].ClusterID != result[j].ClusterID {
+			return result[i].ClusterID < result[j].ClusterID
+		}
+		return machineDeploymentName(result[i]) < machineDeploymentName(result[j])
+	})
+
+	return result, nil
+}
+
+// listProjectClustersMachineDeployments lists the machine deployments of the clusters with a bounded number of workers.
+// The result has the same order as the clusters.
+func listProjectClustersMachineDeployments(ctx context.Context, userInfoGetter provider.UserInfoGetter, userInfo *provider.UserInfo, clusters []projectCluster, projectID string, kubeletOlderThan *semverlib.Version) [][]apiv2.ProjectMachineDeployment {
+	var wg sync.WaitGroup
+
+	result := make([][]apiv2.ProjectMachineDeployment, len(clusters))
+	positions := make(chan int)
+	for range min(projectMachineDeploymentWorkers, len(clusters)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			for pos := range positions {
+				result[pos] = listProjectClusterMachineDeployments(ctx, userInfoGetter, userInfo, clusters[pos], projectID, kubeletOlderThan)
+			}
+		}()
+	}
+
+	for i := range clusters {
+		positions <- i
+	}
+	close(positions)
+	wg.Wait()
+
+	return result
+}
+
+func listProjectClusterMachineDeployments(ctx context.Context, userInfoGetter provider.UserInfoGetter, userInfo *provider.UserInfo, pc projectCluster, projectID string, kubeletOlderThan *semverlib.Version) []apiv2.ProjectMachineDeployment {
+	errorEntry := func(err error) []apiv2.ProjectMachineDeployment {
+		kubermaticlog.Logger.Debugw("failed to list machine deployments", "cluster", pc.cluster.Name, zap.Error(err))
+		entry := apiv2.ProjectMachineDeployment{
+			ClusterID:   pc.cluster.Name,
+			ClusterName: pc.cluster.Spec.HumanReadableName,
+			Seed:        pc.seed,
+			Error:       clusterNotReachableError,
+		}
+		if userInfo.IsAdmin {
+			entry.Error = fmt.Sprintf("%s: %v", clusterNotReachableError, err)
+		}
+		return []apiv2.ProjectMachineDeployment{entry}
+	}
+
+	machineDeployments, err := listClusterMachineDeployments(ctx, userInfoGetter, pc.clusterProvider, pc.cluster, projectID)
+	if err != nil {
+		return errorEntry(err)
+	}
+
+	entries := make([]apiv2.ProjectMachineDeployment, 0, len(machineDeployments.Items))
+	for i := range machineDeployments.Items {
+		md := &machineDeployments.Items[i]
+		if kubeletOlderThan != nil {
+			kubeletVersion, err := semverlib.NewVersion(md.Spec.Template.Spec.Versions.Kubelet)
+			if err != nil || !kubeletVersion.LessThan(kubeletOlderThan) {
+				continue
+			}
+		}
+
+		nd, err := outputMachineDeploymentForUser(md, userInfo)
+		if err != nil {
+			return errorEntry(fmt.Errorf("failed to output machine deployment %s: %w", md.Name, err))
+		}
+		entries = append(entries, apiv2.ProjectMachineDeployment{
+			ClusterID:         pc.cluster.Name,
+			ClusterName:       pc.cluster.Spec.HumanReadableName,
+			Seed:              pc.seed,
+			MachineDeployment: nd,
+		})
+	}
+
+	return entries
+}
+
+func machineDeploymentName(entry apiv2.ProjectMachineDeployment) string {
+	if entry.MachineDeployment == nil {
+		return ""
+	}
+	return entry.MachineDeployment.Name
+}
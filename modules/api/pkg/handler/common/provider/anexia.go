@@ -36,6 +36,44 @@ import (
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 )
 
+// AnexiaClientSet lists the resources of an Anexia account.
+type AnexiaClientSet interface {
+	ListTemplates(ctx context.Context, locationID string) (apiv1.AnexiaTemplateList, error)
+}
+
+type anexiaClientImpl struct {
+	cli client.Client
+}
+
+var NewAnexiaClient = func(token string) (AnexiaClientSet, error) {
+	cli, err := getClient(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &anexiaClientImpl{cli: cli}, nil
+}
+
+func (a *anexiaClientImpl) ListTemplates(ctx context.Context, locationID string) (apiv1.AnexiaTemplateList, error) {
+	response := apiv1.AnexiaTemplateList{}
+
+	templates, err := templates.NewAPI(a.cli).List(ctx, locationID, "templates", 1, 1000)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, template := range templates {
+		apiTemplate := apiv1.AnexiaTemplate{
+			ID:    template.ID,
+			Name:  template.Name,
+			Build: template.Build,
+		}
+		response = append(response, apiTemplate)
+	}
+
+	return response, nil
+}
+
 func ListAnexiaVlans(ctx context.Context, token string) (apiv1.AnexiaVlanList, error) {
 	response := apiv1.AnexiaVlanList{}
 
@@ -60,27 +98,16 @@ func ListAnexiaVlans(ctx context.Context, token string) (apiv1.AnexiaVlanList, e
 }
 
 func ListAnexiaTemplates(ctx context.Context, token, locationID string) (apiv1.AnexiaTemplateList, error) {
-	response := apiv1.AnexiaTemplateList{}
-
-	cli, err := getClient(token)
+	cli, err := NewAnexiaClient(token)
 	if err != nil {
 		return nil, utilerrors.New(http.StatusInternalServerError, err.Error())
 	}
-	t := templates.NewAPI(cli)
-	templates, err := t.List(ctx, locationID, "templates", 1, 1000)
+
+	response, err := cli.ListTemplates(ctx, locationID)
 	if err != nil {
 		return nil, utilerrors.New(http.StatusInternalServerError, err.Error())
 	}
 
-	for _, template := range templates {
-		apiTemplate := apiv1.AnexiaTemplate{
-			ID:    template.ID,
-			Name:  template.Name,
-			Build: template.Build,
-		}
-		response = append(response, apiTemplate)
-	}
-
 	return response, nil
 }
 
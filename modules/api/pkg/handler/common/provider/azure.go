@@ -88,25 +88,53 @@ var NewAzureClientSet = func(subscriptionID, clientID, clientSecret, tenantID st
 		return nil, err
 	}
 
+	computeUsageClient, err := armcompute.NewUsageClient(subscriptionID, cred, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	networkUsagesClient, err := armnetwork.NewUsagesClient(subscriptionID, cred, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	virtualMachinesClient, err := armcompute.NewVirtualMachinesClient(subscriptionID, cred, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	proximityPlacementGroupsClient, err := armcompute.NewProximityPlacementGroupsClient(subscriptionID, cred, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	return &azureClientSetImpl{
-		vmSizeClient:         sizesClient,
-		skusClient:           skusClient,
-		securityGroupsClient: securityGroupsClient,
-		resourceGroupsClient: resourceGroupsClient,
-		subnetsClient:        subnetsClient,
-		vnetClient:           vnetClient,
-		routeTablesClient:    routeTablesClient,
+		vmSizeClient:                   sizesClient,
+		skusClient:                     skusClient,
+		securityGroupsClient:           securityGroupsClient,
+		resourceGroupsClient:           resourceGroupsClient,
+		subnetsClient:                  subnetsClient,
+		vnetClient:                     vnetClient,
+		routeTablesClient:              routeTablesClient,
+		computeUsageClient:             computeUsageClient,
+		networkUsagesClient:            networkUsagesClient,
+		virtualMachinesClient:          virtualMachinesClient,
+		proximityPlacementGroupsClient: proximityPlacementGroupsClient,
 	}, nil
 }
 
 type azureClientSetImpl struct {
-	vmSizeClient         *armcompute.VirtualMachineSizesClient
-	skusClient           *armcompute.ResourceSKUsClient
-	securityGroupsClient *armnetwork.SecurityGroupsClient
-	routeTablesClient    *armnetwork.RouteTablesClient
-	resourceGroupsClient *armresources.ResourceGroupsClient
-	subnetsClient        *armnetwork.SubnetsClient
-	vnetClient           *armnetwork.VirtualNetworksClient
+	vmSizeClient                   *armcompute.VirtualMachineSizesClient
+	skusClient                     *armcompute.ResourceSKUsClient
+	securityGroupsClient           *armnetwork.SecurityGroupsClient
+	routeTablesClient              *armnetwork.RouteTablesClient
+	resourceGroupsClient           *armresources.ResourceGroupsClient
+	subnetsClient                  *armnetwork.SubnetsClient
+	vnetClient                     *armnetwork.VirtualNetworksClient
+	computeUsageClient             *armcompute.UsageClient
+	networkUsagesClient            *armnetwork.UsagesClient
+	virtualMachinesClient          *armcompute.VirtualMachinesClient
+	proximityPlacementGroupsClient *armcompute.ProximityPlacementGroupsClient
 }
 
 type AzureClientSet interface {
@@ -117,6 +145,10 @@ type AzureClientSet interface {
 	ListRouteTables(ctx context.Context, resourceGroupName string) ([]armnetwork.RouteTable, error)
 	ListVnets(ctx context.Context, resourceGroupName string) ([]armnetwork.VirtualNetwork, error)
 	ListSubnets(ctx context.Context, resourceGroupName, virtualNetworkName string) ([]armnetwork.Subnet, error)
+	ListComputeUsages(ctx context.Context, location string) ([]armcompute.Usage, error)
+	ListNetworkUsages(ctx context.Context, location string) ([]armnetwork.Usage, error)
+	GetSerialConsoleLogURI(ctx context.Context, resourceGroupName, vmName string) (string, error)
+	ListProximityPlacementGroups(ctx context.Context, resourceGroupName string) ([]armcompute.ProximityPlacementGroup, error)
 }
 
 func (s *azureClientSetImpl) ListSKU(ctx context.Context, location string) ([]armcompute.ResourceSKU, error) {
@@ -157,6 +189,54 @@ func (s *azureClientSetImpl) ListVMSize(ctx context.Context, location string) ([
 	return result, nil
 }
 
+func (s *azureClientSetImpl) ListComputeUsages(ctx context.Context, location string) ([]armcompute.Usage, error) {
+	pager := s.computeUsageClient.NewListPager(location, nil)
+
+	result := []armcompute.Usage{}
+	for pager.More() {
+		nextResult, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list compute usages: %w", err)
+		}
+
+		for i := range nextResult.Value {
+			result = append(result, *nextResult.Value[i])
+		}
+	}
+
+	return result, nil
+}
+
+func (s *azureClientSetImpl) ListNetworkUsages(ctx context.Context, location string) ([]armnetwork.Usage, error) {
+	pager := s.networkUsagesClient.NewListPager(location, nil)
+
+	result := []armnetwork.Usage{}
+	for pager.More() {
+		nextResult, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list network usages: %w", err)
+		}
+
+		for i := range nextResult.Value {
+			result = append(result, *nextResult.Value[i])
+		}
+	}
+
+	return result, nil
+}
+
+func (s *azureClientSetImpl) GetSerialConsoleLogURI(ctx context.Context, resourceGroupName, vmName string) (string, error) {
+	result, err := s.virtualMachinesClient.RetrieveBootDiagnosticsData(ctx, resourceGroupName, vmName, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve boot diagnostics data: %w", err)
+	}
+	if result.SerialConsoleLogBlobURI == nil {
+		return "", fmt.Errorf("boot diagnostics are not enabled for virtual machine %s", vmName)
+	}
+
+	return *result.SerialConsoleLogBlobURI, nil
+}
+
 func (s *azureClientSetImpl) ListSecurityGroups(ctx context.Context, resourceGroupName string) ([]armnetwork.SecurityGroup, error) {
 	pager := s.securityGroupsClient.NewListPager(resourceGroupName, nil)
 
@@ -175,6 +255,24 @@ func (s *azureClientSetImpl) ListSecurityGroups(ctx context.Context, resourceGro
 	return result, nil
 }
 
+func (s *azureClientSetImpl) ListProximityPlacementGroups(ctx context.Context, resourceGroupName string) ([]armcompute.ProximityPlacementGroup, error) {
+	pager := s.proximityPlacementGroupsClient.NewListByResourceGroupPager(resourceGroupName, nil)
+
+	result := []armcompute.ProximityPlacementGroup{}
+	for pager.More() {
+		nextResult, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list proximity placement groups: %w", err)
+		}
+
+		for i := range nextResult.Value {
+			result = append(result, *nextResult.Value[i])
+		}
+	}
+
+	return result, nil
+}
+
 func (s *azureClientSetImpl) ListRouteTables(ctx context.Context, resourceGroupName string) ([]armnetwork.RouteTable, error) {
 	pager := s.routeTablesClient.NewListPager(resourceGroupName, nil)
 
@@ -475,23 +573,70 @@ func AzureSKUAvailabilityZones(ctx context.Context, subscriptionID, clientID, cl
 		return nil, fmt.Errorf("failed to list sku resource: %w", err)
 	}
 
-	var azZones = &apiv1.AzureAvailabilityZonesList{}
-	for _, sku := range skuList {
-		if skuName == *sku.Name {
-			for _, l := range sku.LocationInfo {
-				if isSameLocation(location, *l.Location) && l.Zones != nil && len(l.Zones) > 0 {
-					azZones.Zones = []string{}
-					for _, z := range l.Zones {
-						azZones.Zones = append(azZones.Zones, *z)
-					}
+	zones := azure.AvailabilityZones(skuList, location, skuName)
+	if len(zones) == 0 {
+		return nil, nil
+	}
 
-					return azZones, nil
-				}
-			}
+	return &apiv1.AzureAvailabilityZonesList{Zones: zones}, nil
+}
+
+func AzureProximityPlacementGroupsWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, projectID, clusterID string) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Spec.Cloud.Azure == nil {
+		return nil, utilerrors.NewNotFound("cloud spec for ", clusterID)
+	}
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	datacenter, err := dc.GetDatacenter(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName)
+	if err != nil {
+		return nil, utilerrors.New(http.StatusInternalServerError, err.Error())
+	}
+
+	if datacenter.Spec.Azure == nil {
+		return nil, utilerrors.NewNotFound("cloud spec (dc) for ", clusterID)
+	}
+
+	assertedClusterProvider, ok := clusterProvider.(*kubernetesprovider.ClusterProvider)
+	if !ok {
+		return nil, utilerrors.New(http.StatusInternalServerError, "failed to assert clusterProvider")
+	}
+
+	secretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, assertedClusterProvider.GetSeedClusterAdminRuntimeClient())
+	creds, err := azure.GetCredentialsForCluster(cluster.Spec.Cloud, secretKeySelector)
+	if err != nil {
+		return nil, err
+	}
+	return AzureProximityPlacementGroups(ctx, creds.SubscriptionID, creds.ClientID, creds.ClientSecret, creds.TenantID, datacenter.Spec.Azure.Location, cluster.Spec.Cloud.Azure.ResourceGroup)
+}
+
+func AzureProximityPlacementGroups(ctx context.Context, subscriptionID, clientID, clientSecret, tenantID, location, resourceGroup string) (*apiv1.AzureProximityPlacementGroupsList, error) {
+	clientSet, err := NewAzureClientSet(subscriptionID, clientID, clientSecret, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authorizer for proximity placement groups client: %w", err)
+	}
+
+	groupList, err := clientSet.ListProximityPlacementGroups(ctx, resourceGroup)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list proximity placement group resources: %w", err)
+	}
+
+	apiGroups := &apiv1.AzureProximityPlacementGroupsList{ProximityPlacementGroups: []string{}}
+	for _, group := range groupList {
+		if group.Name != nil && group.Location != nil && isSameLocation(location, *group.Location) {
+			apiGroups.ProximityPlacementGroups = append(apiGroups.ProximityPlacementGroups, *group.Name)
 		}
 	}
 
-	return nil, nil
+	return apiGroups, nil
 }
 
 func AzureSecurityGroupEndpoint(ctx context.Context, subscriptionID, clientID, clientSecret, tenantID, location, resourceGroup string) (*apiv1.AzureSecurityGroupsList, error) {
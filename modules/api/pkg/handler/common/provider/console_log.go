@@ -0,0 +1,250 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	machineconversions "k8c.io/dashboard/v2/pkg/machine"
+	"k8c.io/dashboard/v2/pkg/provider"
+	awsprovider "k8c.io/dashboard/v2/pkg/provider/cloud/aws"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/azure"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/gcp"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/openstack"
+	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+)
+
+// DefaultConsoleLogTailBytes is the number of bytes of the console log returned when no limit is requested.
+const DefaultConsoleLogTailBytes = 64 * 1024
+
+func MachineConsoleLogEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	seedsGetter provider.SeedsGetter, presetProvider provider.PresetProvider, projectID, clusterID, machineDeploymentID, machineID string, tailBytes int, caBundle *x509.CertPool) ([]byte, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	cluster, machine, err := handlercommon.GetMachineDeploymentMachine(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, machineDeploymentID, machineID)
+	if err != nil {
+		return nil, err
+	}
+
+	providerName, err := kubermaticv1helper.ClusterCloudProviderName(cluster.Spec.Cloud)
+	if err != nil {
+		return nil, utilerrors.New(http.StatusInternalServerError, err.Error())
+	}
+
+	switch kubermaticv1.ProviderType(providerName) {
+	case kubermaticv1.AWSCloudProvider, kubermaticv1.AzureCloudProvider, kubermaticv1.GCPCloudProvider, kubermaticv1.OpenstackCloudProvider:
+	default:
+		return nil, utilerrors.New(http.StatusNotImplemented, fmt.Sprintf("console logs are not supported for the %s provider", providerName))
+	}
+
+	userInfo, err := userInfoGetter(ctx, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	_, datacenter, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName)
+	if err != nil {
+		return nil, utilerrors.New(http.StatusInternalServerError, err.Error())
+	}
+
+	// Clusters created from a preset use the preset credentials, if the preset is not accessible anymore
+	// the credentials stored for the cluster are used instead.
+	cloudSpec := cluster.Spec.Cloud
+	if presetName := cluster.Annotations[kubermaticv1.PresetNameAnnotation]; presetName != "" {
+		presetCloudSpec, err := presetProvider.SetCloudCredentials(ctx, userInfo, projectID, presetName, cloudSpec, datacenter)
+		if err == nil {
+			cloudSpec = *presetCloudSpec
+		} else if !apierrors.IsNotFound(err) {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+	}
+
+	assertedClusterProvider, ok := clusterProvider.(*kubernetesprovider.ClusterProvider)
+	if !ok {
+		return nil, utilerrors.New(http.StatusInternalServerError, "failed to assert clusterProvider")
+	}
+	secretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, assertedClusterProvider.GetSeedClusterAdminRuntimeClient())
+
+	var consoleLog []byte
+	switch {
+	case cloudSpec.AWS != nil && datacenter.Spec.AWS != nil:
+		accessKeyID, secretAccessKey, assumeRoleARN, assumeRoleExternalID, err := awsprovider.GetCredentialsForCluster(cloudSpec, secretKeySelector)
+		if err != nil {
+			return nil, err
+		}
+		consoleLog, err = GetAWSConsoleLog(ctx, accessKeyID, secretAccessKey, assumeRoleARN, assumeRoleExternalID, datacenter.Spec.AWS.Region, string(machine.UID))
+		if err != nil {
+			return nil, err
+		}
+	case cloudSpec.Azure != nil && datacenter.Spec.Azure != nil:
+		creds, err := azure.GetCredentialsForCluster(cloudSpec, secretKeySelector)
+		if err != nil {
+			return nil, err
+		}
+		consoleLog, err = GetAzureConsoleLog(ctx, creds, cloudSpec.Azure.ResourceGroup, machine.Spec.Name)
+		if err != nil {
+			return nil, err
+		}
+	case cloudSpec.GCP != nil && datacenter.Spec.GCP != nil:
+		sa, err := gcp.GetCredentialsForCluster(cloudSpec, secretKeySelector)
+		if err != nil {
+			return nil, err
+		}
+		nodeCloudSpec, err := machineconversions.GetAPIV2NodeCloudSpec(machine.Spec)
+		if err != nil {
+			return nil, utilerrors.New(http.StatusInternalServerError, err.Error())
+		}
+		if nodeCloudSpec.GCP == nil {
+			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("machine %s has no GCP spec", machine.Name))
+		}
+		consoleLog, err = GetGCPConsoleLog(ctx, sa, nodeCloudSpec.GCP.Zone, machine.Spec.Name)
+		if err != nil {
+			return nil, err
+		}
+	case cloudSpec.Openstack != nil && datacenter.Spec.Openstack != nil:
+		creds, err := openstack.GetCredentialsForCluster(cloudSpec, secretKeySelector)
+		if err != nil {
+			return nil, err
+		}
+		output, err := openstack.GetServerConsoleOutput(ctx, datacenter.Spec.Openstack.AuthURL, datacenter.Spec.Openstack.Region, machine.Spec.Name, creds, caBundle)
+		if err != nil {
+			return nil, err
+		}
+		consoleLog = []byte(output)
+	default:
+		return nil, utilerrors.NewNotFound("cloud spec (dc) for ", clusterID)
+	}
+
+	return tailConsoleLog(consoleLog, tailBytes), nil
+}
+
+// GetAWSConsoleLog returns the latest console output of the instance created for the machine with the given UID.
+func GetAWSConsoleLog(ctx context.Context, accessKeyID, secretAccessKey, assumeRoleARN, assumeRoleExternalID, region, machineUID string) ([]byte, error) {
+	client, err := awsprovider.GetClientSet(ctx, accessKeyID, secretAccessKey, assumeRoleARN, assumeRoleExternalID, region)
+	if err != nil {
+		return nil, err
+	}
+
+	instances, err := client.EC2.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{
+			{Name: ptr.To("tag:Machine-UID"), Values: []string{machineUID}},
+			{Name: ptr.To("instance-state-name"), Values: []string{"pending", "running", "stopping", "stopped"}},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances: %w", err)
+	}
+
+	var instanceID string
+	for _, reservation := range instances.Reservations {
+		for _, instance := range reservation.Instances {
+			instanceID = ptr.Deref(instance.InstanceId, "")
+		}
+	}
+	if instanceID == "" {
+		return nil, utilerrors.NewNotFound("instance for machine", machineUID)
+	}
+
+	output, err := client.EC2.GetConsoleOutput(ctx, &ec2.GetConsoleOutputInput{
+		InstanceId: ptr.To(instanceID),
+		Latest:     ptr.To(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get console output of instance %s: %w", instanceID, err)
+	}
+
+	return decodeAWSConsoleOutput(ptr.Deref(output.Output, ""))
+}
+
+// GetAzureConsoleLog returns the serial console log of the virtual machine, boot diagnostics must be enabled for it.
+func GetAzureConsoleLog(ctx context.Context, credentials azure.Credentials, resourceGroup, vmName string) ([]byte, error) {
+	clientSet, err := NewAzureClientSet(credentials.SubscriptionID, credentials.ClientID, credentials.ClientSecret, credentials.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	logURI, err := clientSet.GetSerialConsoleLogURI(ctx, resourceGroup, vmName)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download serial console log: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download serial console log: unexpected status %s", resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// GetGCPConsoleLog returns the output of the first serial port of the instance.
+func GetGCPConsoleLog(ctx context.Context, sa, zone, instanceName string) ([]byte, error) {
+	computeService, project, err := gcp.ConnectToComputeService(ctx, sa)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := computeService.Instances.GetSerialPortOutput(project, zone, instanceName).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get serial port output of instance %s: %w", instanceName, err)
+	}
+
+	return []byte(output.Contents), nil
+}
+
+// decodeAWSConsoleOutput decodes the console output, which EC2 returns base64 encoded.
+func decodeAWSConsoleOutput(output string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(output)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode console output: %w", err)
+	}
+
+	return decoded, nil
+}
+
+// tailConsoleLog returns at most the last n bytes of the log.
+func tailConsoleLog(log []byte, n int) []byte {
+	if n <= 0 || len(log) <= n {
+		return log
+	}
+
+	return log[len(log)-n:]
+}
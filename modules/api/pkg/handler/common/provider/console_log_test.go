@@ -0,0 +1,72 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestTailConsoleLog(t *testing.T) {
+	tests := []struct {
+		name      string
+		log       string
+		tailBytes int
+		expected  string
+	}{
+		{
+			name:      "log shorter than the limit",
+			log:       "booting\n",
+			tailBytes: 64,
+			expected:  "booting\n",
+		},
+		{
+			name:      "log longer than the limit",
+			log:       "booting\nkubelet started\n",
+			tailBytes: 8,
+			expected:  "started\n",
+		},
+		{
+			name:      "no limit",
+			log:       "booting\n",
+			tailBytes: 0,
+			expected:  "booting\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := string(tailConsoleLog([]byte(test.log), test.tailBytes)); got != test.expected {
+				t.Fatalf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestDecodeAWSConsoleOutput(t *testing.T) {
+	got, err := decodeAWSConsoleOutput(base64.StdEncoding.EncodeToString([]byte("cloud-init finished\n")))
+	if err != nil {
+		t.Fatalf("failed to decode console output: %v", err)
+	}
+	if string(got) != "cloud-init finished\n" {
+		t.Fatalf("expected %q, got %q", "cloud-init finished\n", string(got))
+	}
+
+	if _, err := decodeAWSConsoleOutput("not base64!"); err == nil {
+		t.Fatal("expected an error for invalid output")
+	}
+}
@@ -35,17 +35,33 @@ import (
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 var reStandard = regexp.MustCompile("(^s|S)")
 var reOptimized = regexp.MustCompile("(^c|C)")
 
+// DigitaloceanSizeFilter narrows down the DigitalOcean sizes returned to the user.
+type DigitaloceanSizeFilter struct {
+	// Region is the slug of the region the sizes have to be available in.
+	Region string
+	// MinCPU is the minimal number of vCPUs.
+	MinCPU int
+	// MinMemory is the minimal amount of memory in GB.
+	MinMemory int
+}
+
 func ListDigitaloceanSizes(ctx context.Context, token string) ([]godo.Size, error) {
 	client, err := getDigitalOceanClient(ctx, token)
 	if err != nil {
 		return nil, err
 	}
 
+	return listDigitaloceanSizes(ctx, client)
+}
+
+func listDigitaloceanSizes(ctx context.Context, client *godo.Client) ([]godo.Size, error) {
 	listOptions := &godo.ListOptions{
 		Page:    1,
 		PerPage: 1000,
@@ -57,7 +73,26 @@ func ListDigitaloceanSizes(ctx context.Context, token string) ([]godo.Size, erro
 	return godoSizes, nil
 }
 
-func DigitaloceanSizeWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, settingsProvider provider.SettingsProvider, projectID, clusterID string) (interface{}, error) {
+func listDigitaloceanRegionSizes(ctx context.Context, client *godo.Client, region string) (sets.Set[string], error) {
+	listOptions := &godo.ListOptions{
+		Page:    1,
+		PerPage: 1000,
+	}
+	regions, _, err := client.Regions.List(ctx, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list digital ocean regions: %w", err)
+	}
+
+	for _, r := range regions {
+		if r.Slug == region {
+			return sets.New(r.Sizes...), nil
+		}
+	}
+
+	return nil, utilerrors.NewBadRequest("digital ocean region %q does not exist", region)
+}
+
+func DigitaloceanSizeWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, settingsProvider provider.SettingsProvider, projectID, clusterID string, sizeFilter DigitaloceanSizeFilter) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 
 	cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
@@ -98,15 +133,34 @@ func DigitaloceanSizeWithClusterCredentialsEndpoint(ctx context.Context, userInf
 	}
 
 	filter := handlercommon.DetermineMachineFlavorFilter(datacenter.Spec.MachineFlavorFilter, settings.Spec.MachineDeploymentVMResourceQuota)
-	return DigitaloceanSize(ctx, filter, accessToken)
+	sizeFilter.Region = datacenter.Spec.Digitalocean.Region
+	return DigitaloceanSize(ctx, filter, sizeFilter, accessToken)
 }
 
-func DigitaloceanSize(ctx context.Context, quota kubermaticv1.MachineFlavorFilter, token string) (apiv1.DigitaloceanSizeList, error) {
-	sizes, err := ListDigitaloceanSizes(ctx, token)
+func DigitaloceanSize(ctx context.Context, quota kubermaticv1.MachineFlavorFilter, sizeFilter DigitaloceanSizeFilter, token string) (apiv1.DigitaloceanSizeList, error) {
+	client, err := getDigitalOceanClient(ctx, token)
 	if err != nil {
 		return apiv1.DigitaloceanSizeList{}, err
 	}
 
+	return digitaloceanSize(ctx, client, quota, sizeFilter)
+}
+
+func digitaloceanSize(ctx context.Context, client *godo.Client, quota kubermaticv1.MachineFlavorFilter, sizeFilter DigitaloceanSizeFilter) (apiv1.DigitaloceanSizeList, error) {
+	sizes, err := listDigitaloceanSizes(ctx, client)
+	if err != nil {
+		return apiv1.DigitaloceanSizeList{}, err
+	}
+
+	// sizes are listed globally, only the region knows which of them can be provisioned there
+	var regionSizes sets.Set[string]
+	if sizeFilter.Region != "" {
+		regionSizes, err = listDigitaloceanRegionSizes(ctx, client, sizeFilter.Region)
+		if err != nil {
+			return apiv1.DigitaloceanSizeList{}, err
+		}
+	}
+
 	sizeList := apiv1.DigitaloceanSizeList{
 		Standard:  []apiv1.DigitaloceanSize{},
 		Optimized: []apiv1.DigitaloceanSize{},
@@ -115,6 +169,13 @@ func DigitaloceanSize(ctx context.Context, quota kubermaticv1.MachineFlavorFilte
 	// type 3 isn't listed in the pricing anymore and only will be available for legacy issues until July 1st, 2018
 	// therefore we might not want to log all cases that aren't starting with s or c
 	for k := range sizes {
+		if regionSizes != nil && !regionSizes.Has(sizes[k].Slug) {
+			continue
+		}
+		if sizes[k].Vcpus < sizeFilter.MinCPU || sizes[k].Memory/1024 < sizeFilter.MinMemory {
+			continue
+		}
+
 		s := apiv1.DigitaloceanSize{
 			Slug:         sizes[k].Slug,
 			Available:    sizes[k].Available,
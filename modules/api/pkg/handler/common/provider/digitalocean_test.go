@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/godo"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+func newFakeDigitaloceanAPI(t *testing.T) *httptest.Server {
+	sizes := []godo.Size{
+		{Slug: "s-1vcpu-1gb", Vcpus: 1, Memory: 1024, Available: true},
+		{Slug: "s-2vcpu-4gb", Vcpus: 2, Memory: 4096, Available: true},
+		{Slug: "s-4vcpu-8gb", Vcpus: 4, Memory: 8192, Available: true},
+		{Slug: "c-4", Vcpus: 4, Memory: 8192, Available: true},
+		{Slug: "c-8", Vcpus: 8, Memory: 16384, Available: true},
+	}
+	regions := []godo.Region{
+		{Slug: "fra1", Sizes: []string{"s-1vcpu-1gb", "s-2vcpu-4gb", "c-4"}},
+		{Slug: "ams3", Sizes: []string{"s-4vcpu-8gb", "c-8"}},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/sizes", func(w http.ResponseWriter, _ *http.Request) {
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{"sizes": sizes}); err != nil {
+			t.Error(err)
+		}
+	})
+	mux.HandleFunc("/v2/regions", func(w http.ResponseWriter, _ *http.Request) {
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{"regions": regions}); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestDigitaloceanSize(t *testing.T) {
+	server := newFakeDigitaloceanAPI(t)
+	defer server.Close()
+
+	client, err := godo.New(server.Client(), godo.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name              string
+		sizeFilter        DigitaloceanSizeFilter
+		quota             kubermaticv1.MachineFlavorFilter
+		expectedStandard  []string
+		expectedOptimized []string
+		expectedError     bool
+	}{
+		{
+			name:              "all sizes without region",
+			expectedStandard:  []string{"s-1vcpu-1gb", "s-2vcpu-4gb", "s-4vcpu-8gb"},
+			expectedOptimized: []string{"c-4", "c-8"},
+		},
+		{
+			name:              "sizes available in fra1",
+			sizeFilter:        DigitaloceanSizeFilter{Region: "fra1"},
+			expectedStandard:  []string{"s-1vcpu-1gb", "s-2vcpu-4gb"},
+			expectedOptimized: []string{"c-4"},
+		},
+		{
+			name:              "sizes available in ams3",
+			sizeFilter:        DigitaloceanSizeFilter{Region: "ams3"},
+			expectedStandard:  []string{"s-4vcpu-8gb"},
+			expectedOptimized: []string{"c-8"},
+		},
+		{
+			name:              "sizes available in fra1 with minimal resources",
+			sizeFilter:        DigitaloceanSizeFilter{Region: "fra1", MinCPU: 2, MinMemory: 4},
+			expectedStandard:  []string{"s-2vcpu-4gb"},
+			expectedOptimized: []string{"c-4"},
+		},
+		{
+			name:              "minimal resources and quota combined",
+			sizeFilter:        DigitaloceanSizeFilter{MinMemory: 8},
+			quota:             kubermaticv1.MachineFlavorFilter{MaxCPU: 4},
+			expectedStandard:  []string{"s-4vcpu-8gb"},
+			expectedOptimized: []string{"c-4"},
+		},
+		{
+			name:          "unknown region",
+			sizeFilter:    DigitaloceanSizeFilter{Region: "nyc9"},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sizeList, err := digitaloceanSize(context.Background(), client, tc.quota, tc.sizeFilter)
+			if tc.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			standard := []string{}
+			for _, s := range sizeList.Standard {
+				standard = append(standard, s.Slug)
+			}
+			optimized := []string{}
+			for _, s := range sizeList.Optimized {
+				optimized = append(optimized, s.Slug)
+			}
+
+			if !reflect.DeepEqual(standard, tc.expectedStandard) {
+				t.Errorf("expected standard sizes %v, got %v", tc.expectedStandard, standard)
+			}
+			if !reflect.DeepEqual(optimized, tc.expectedOptimized) {
+				t.Errorf("expected optimized sizes %v, got %v", tc.expectedOptimized, optimized)
+			}
+		})
+	}
+}
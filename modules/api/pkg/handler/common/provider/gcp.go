@@ -31,6 +31,7 @@ import (
 	"k8c.io/dashboard/v2/pkg/provider"
 	"k8c.io/dashboard/v2/pkg/provider/cloud/gcp"
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 
@@ -314,7 +315,12 @@ func ListGCPSizes(ctx context.Context, machineFilter kubermaticv1.MachineFlavorF
 		return nil
 	})
 
-	return filterGCPByQuota(sizes, machineFilter), err
+	if err != nil {
+		return sizes, err
+	}
+
+	// The entries of the custom machine types are not filtered, their shape is only picked by the user.
+	return append(filterGCPByQuota(sizes, machineFilter), machine.GCPCustomMachineTypeSizes(sizes)...), nil
 }
 
 func filterGCPByQuota(instances apiv1.GCPMachineSizeList, machineFilter kubermaticv1.MachineFlavorFilter) apiv1.GCPMachineSizeList {
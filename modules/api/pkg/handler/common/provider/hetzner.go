@@ -39,6 +39,9 @@ import (
 var reStandardSize = regexp.MustCompile("(^cx|^cpx)")
 var reDedicatedSize = regexp.MustCompile("(^ccx)")
 
+// NewHetznerClient creates the client for the Hetzner API, it is replaced in tests.
+var NewHetznerClient = hetzner.NewClient
+
 func HetznerSizeWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, settingsProvider provider.SettingsProvider, projectID, clusterID string) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 
@@ -84,6 +87,63 @@ func HetznerSizeWithClusterCredentialsEndpoint(ctx context.Context, userInfoGett
 	return HetznerSize(ctx, filter, hetznerToken)
 }
 
+func HetznerNetworksWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) (interface{}, error) {
+	token, err := getHetznerClusterToken(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return HetznerNetworks(ctx, token)
+}
+
+func HetznerFirewallsWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) (interface{}, error) {
+	token, err := getHetznerClusterToken(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return HetznerFirewalls(ctx, token)
+}
+
+func getHetznerClusterToken(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) (string, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
+	if err != nil {
+		return "", err
+	}
+
+	if cluster.Spec.Cloud.Hetzner == nil {
+		return "", utilerrors.NewNotFound("cloud spec for ", clusterID)
+	}
+
+	assertedClusterProvider, ok := clusterProvider.(*kubernetesprovider.ClusterProvider)
+	if !ok {
+		return "", utilerrors.New(http.StatusInternalServerError, "failed to assert clusterProvider")
+	}
+
+	secretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, assertedClusterProvider.GetSeedClusterAdminRuntimeClient())
+	return hetzner.GetCredentialsForCluster(cluster.Spec.Cloud, secretKeySelector)
+}
+
+func HetznerNetworks(ctx context.Context, token string) (apiv1.HetznerNetworkList, error) {
+	networks, err := NewHetznerClient(token).ListNetworks(ctx)
+	if err != nil {
+		return nil, utilerrors.New(http.StatusInternalServerError, err.Error())
+	}
+
+	return networks, nil
+}
+
+func HetznerFirewalls(ctx context.Context, token string) (apiv1.HetznerFirewallList, error) {
+	firewalls, err := NewHetznerClient(token).ListFirewalls(ctx)
+	if err != nil {
+		return nil, utilerrors.New(http.StatusInternalServerError, err.Error())
+	}
+
+	return firewalls, nil
+}
+
 func HetznerSize(ctx context.Context, machineFilter kubermaticv1.MachineFlavorFilter, token string) (apiv1.HetznerSizeList, error) {
 	client := hcloud.NewClient(hcloud.WithToken(token))
 
@@ -162,6 +162,16 @@ func KubeVirtVPCsWithClusterCredentialsEndpoint(ctx context.Context, userInfoGet
 	return KubeVirtVPCs(ctx, kvKubeconfig)
 }
 
+func KubeVirtPriorityClassesWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	projectID, clusterID string) (interface{}, error) {
+	kvKubeconfig, err := getKvKubeConfigFromCredentials(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return KubeVirtPriorityClasses(ctx, kvKubeconfig)
+}
+
 func KubeVirtSubnetsWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
 	seedsGetter provider.SeedsGetter, projectID, clusterID, storageClassName string) (interface{}, error) {
 	userInfo, err := userInfoGetter(ctx, projectID)
@@ -462,6 +472,16 @@ func KubeVirtVPCs(ctx context.Context, kubeconfig string) (apiv2.KubeVirtVPCList
 	return vpcAPIList, nil
 }
 
+// KubeVirtPriorityClasses returns the priority classes of the infra cluster which can be used for the VM pods.
+func KubeVirtPriorityClasses(ctx context.Context, kubeconfig string) (apiv2.KubeVirtPriorityClassList, error) {
+	client, err := NewKubeVirtClient(kubeconfig, kubevirt.ClientOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return kubevirt.ListPriorityClasses(ctx, client)
+}
+
 func KubeVirtVPCSubnets(ctx context.Context, kubeconfig string, vpcName string) (apiv2.KubeVirtSubnetList, error) {
 	client, err := NewKubeVirtClient(kubeconfig, kubevirt.ClientOptions{})
 	if err != nil {
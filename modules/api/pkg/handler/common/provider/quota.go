@@ -0,0 +1,349 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"google.golang.org/api/compute/v1"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	awsprovider "k8c.io/dashboard/v2/pkg/provider/cloud/aws"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/azure"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/gcp"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/openstack"
+	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+)
+
+func ProviderQuotaEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	seedsGetter provider.SeedsGetter, presetProvider provider.PresetProvider, projectID, clusterID, providerName string, caBundle *x509.CertPool) (*apiv2.ProviderQuota, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
+	if err != nil {
+		return nil, err
+	}
+
+	clusterProviderName, err := kubermaticv1helper.ClusterCloudProviderName(cluster.Spec.Cloud)
+	if err != nil {
+		return nil, utilerrors.New(http.StatusInternalServerError, err.Error())
+	}
+	if clusterProviderName != providerName {
+		return nil, utilerrors.NewBadRequest("cluster %s is running on %s, not on %s", clusterID, clusterProviderName, providerName)
+	}
+
+	switch kubermaticv1.ProviderType(providerName) {
+	case kubermaticv1.AWSCloudProvider, kubermaticv1.AzureCloudProvider, kubermaticv1.GCPCloudProvider, kubermaticv1.OpenstackCloudProvider:
+	default:
+		return nil, utilerrors.New(http.StatusNotImplemented, fmt.Sprintf("quotas are not supported for the %s provider", providerName))
+	}
+
+	userInfo, err := userInfoGetter(ctx, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	_, datacenter, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName)
+	if err != nil {
+		return nil, utilerrors.New(http.StatusInternalServerError, err.Error())
+	}
+
+	// Clusters created from a preset use the preset credentials, if the preset is not accessible anymore
+	// the credentials stored for the cluster are used instead.
+	cloudSpec := cluster.Spec.Cloud
+	if presetName := cluster.Annotations[kubermaticv1.PresetNameAnnotation]; presetName != "" {
+		presetCloudSpec, err := presetProvider.SetCloudCredentials(ctx, userInfo, projectID, presetName, cloudSpec, datacenter)
+		if err == nil {
+			cloudSpec = *presetCloudSpec
+		} else if !apierrors.IsNotFound(err) {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+	}
+
+	assertedClusterProvider, ok := clusterProvider.(*kubernetesprovider.ClusterProvider)
+	if !ok {
+		return nil, utilerrors.New(http.StatusInternalServerError, "failed to assert clusterProvider")
+	}
+	secretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, assertedClusterProvider.GetSeedClusterAdminRuntimeClient())
+
+	switch {
+	case cloudSpec.AWS != nil && datacenter.Spec.AWS != nil:
+		accessKeyID, secretAccessKey, assumeRoleARN, assumeRoleExternalID, err := awsprovider.GetCredentialsForCluster(cloudSpec, secretKeySelector)
+		if err != nil {
+			return nil, err
+		}
+		return GetAWSQuota(ctx, accessKeyID, secretAccessKey, assumeRoleARN, assumeRoleExternalID, datacenter.Spec.AWS.Region)
+	case cloudSpec.Azure != nil && datacenter.Spec.Azure != nil:
+		creds, err := azure.GetCredentialsForCluster(cloudSpec, secretKeySelector)
+		if err != nil {
+			return nil, err
+		}
+		return GetAzureQuota(ctx, creds, datacenter.Spec.Azure.Location)
+	case cloudSpec.GCP != nil && datacenter.Spec.GCP != nil:
+		sa, err := gcp.GetCredentialsForCluster(cloudSpec, secretKeySelector)
+		if err != nil {
+			return nil, err
+		}
+		return GetGCPQuota(ctx, sa, datacenter.Spec.GCP.Region)
+	case cloudSpec.Openstack != nil && datacenter.Spec.Openstack != nil:
+		creds, err := openstack.GetCredentialsForCluster(cloudSpec, secretKeySelector)
+		if err != nil {
+			return nil, err
+		}
+		return GetOpenstackQuota(ctx, datacenter.Spec.Openstack, creds, caBundle)
+	}
+
+	return nil, utilerrors.NewNotFound("cloud spec (dc) for ", clusterID)
+}
+
+// GetAWSQuota returns the usage of the EC2 resources in the given region. The vCPU and volume limits are only
+// exposed by the Service Quotas API and are therefore not part of the result.
+func GetAWSQuota(ctx context.Context, accessKeyID, secretAccessKey, assumeRoleARN, assumeRoleExternalID, region string) (*apiv2.ProviderQuota, error) {
+	client, err := awsprovider.GetClientSet(ctx, accessKeyID, secretAccessKey, assumeRoleARN, assumeRoleExternalID, region)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := client.EC2.DescribeAccountAttributes(ctx, &ec2.DescribeAccountAttributesInput{
+		AttributeNames: []ec2types.AccountAttributeName{"max-instances", "vpc-max-elastic-ips"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account attributes: %w", err)
+	}
+
+	limits := map[string]*int64{}
+	for _, attribute := range attributes.AccountAttributes {
+		if attribute.AttributeName == nil || len(attribute.AttributeValues) == 0 || attribute.AttributeValues[0].AttributeValue == nil {
+			continue
+		}
+		if limit, err := strconv.ParseInt(*attribute.AttributeValues[0].AttributeValue, 10, 64); err == nil {
+			limits[*attribute.AttributeName] = &limit
+		}
+	}
+
+	var instances, vcpus int64
+	instancesPaginator := ec2.NewDescribeInstancesPaginator(client.EC2, &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{{Name: ptr.To("instance-state-name"), Values: []string{"pending", "running"}}},
+	})
+	for instancesPaginator.HasMorePages() {
+		page, err := instancesPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list instances: %w", err)
+		}
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				instances++
+				if instance.CpuOptions != nil {
+					vcpus += int64(ptr.Deref(instance.CpuOptions.CoreCount, 0) * ptr.Deref(instance.CpuOptions.ThreadsPerCore, 1))
+				}
+			}
+		}
+	}
+
+	var volumes int64
+	volumesPaginator := ec2.NewDescribeVolumesPaginator(client.EC2, &ec2.DescribeVolumesInput{})
+	for volumesPaginator.HasMorePages() {
+		page, err := volumesPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list volumes: %w", err)
+		}
+		volumes += int64(len(page.Volumes))
+	}
+
+	addresses, err := client.EC2.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{
+		Filters: []ec2types.Filter{{Name: ptr.To("domain"), Values: []string{"vpc"}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list elastic IPs: %w", err)
+	}
+
+	return &apiv2.ProviderQuota{
+		Provider: string(kubermaticv1.AWSCloudProvider),
+		Region:   region,
+		Resources: orderedQuotaResources(map[string]apiv2.ProviderQuotaResource{
+			apiv2.ProviderQuotaInstances:   {Used: instances, Limit: limits["max-instances"]},
+			apiv2.ProviderQuotaVCPUs:       {Used: vcpus},
+			apiv2.ProviderQuotaVolumes:     {Used: volumes},
+			apiv2.ProviderQuotaFloatingIPs: {Used: int64(len(addresses.Addresses)), Limit: limits["vpc-max-elastic-ips"]},
+		}),
+	}, nil
+}
+
+// GetAzureQuota returns the usage of the compute and network resources in the given location.
+func GetAzureQuota(ctx context.Context, credentials azure.Credentials, location string) (*apiv2.ProviderQuota, error) {
+	clientSet, err := NewAzureClientSet(credentials.SubscriptionID, credentials.ClientID, credentials.ClientSecret, credentials.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	computeUsages, err := clientSet.ListComputeUsages(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	networkUsages, err := clientSet.ListNetworkUsages(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv2.ProviderQuota{
+		Provider:  string(kubermaticv1.AzureCloudProvider),
+		Region:    location,
+		Resources: azureQuotaResources(computeUsages, networkUsages),
+	}, nil
+}
+
+func azureQuotaResources(computeUsages []armcompute.Usage, networkUsages []armnetwork.Usage) []apiv2.ProviderQuotaResource {
+	resources := map[string]apiv2.ProviderQuotaResource{}
+
+	for _, usage := range computeUsages {
+		if usage.Name == nil || usage.Name.Value == nil || usage.CurrentValue == nil {
+			continue
+		}
+		switch *usage.Name.Value {
+		case "virtualMachines":
+			resources[apiv2.ProviderQuotaInstances] = apiv2.ProviderQuotaResource{Used: int64(*usage.CurrentValue), Limit: quotaLimit(usage.Limit)}
+		case "cores":
+			resources[apiv2.ProviderQuotaVCPUs] = apiv2.ProviderQuotaResource{Used: int64(*usage.CurrentValue), Limit: quotaLimit(usage.Limit)}
+		}
+	}
+
+	for _, usage := range networkUsages {
+		if usage.Name == nil || usage.Name.Value == nil || usage.CurrentValue == nil {
+			continue
+		}
+		if *usage.Name.Value == "PublicIPAddresses" {
+			resources[apiv2.ProviderQuotaFloatingIPs] = apiv2.ProviderQuotaResource{Used: *usage.CurrentValue, Limit: quotaLimit(usage.Limit)}
+		}
+	}
+
+	return orderedQuotaResources(resources)
+}
+
+// GetGCPQuota returns the usage of the compute resources in the given region.
+func GetGCPQuota(ctx context.Context, sa, region string) (*apiv2.ProviderQuota, error) {
+	computeService, project, err := gcp.ConnectToComputeService(ctx, sa)
+	if err != nil {
+		return nil, err
+	}
+
+	computeRegion, err := computeService.Regions.Get(project, region).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get region %s: %w", region, err)
+	}
+
+	return &apiv2.ProviderQuota{
+		Provider:  string(kubermaticv1.GCPCloudProvider),
+		Region:    region,
+		Resources: gcpQuotaResources(computeRegion.Quotas),
+	}, nil
+}
+
+func gcpQuotaResources(quotas []*compute.Quota) []apiv2.ProviderQuotaResource {
+	metrics := map[string]string{
+		"INSTANCES":        apiv2.ProviderQuotaInstances,
+		"CPUS":             apiv2.ProviderQuotaVCPUs,
+		"DISKS_TOTAL_GB":   apiv2.ProviderQuotaVolumeGigabytes,
+		"IN_USE_ADDRESSES": apiv2.ProviderQuotaFloatingIPs,
+	}
+
+	resources := map[string]apiv2.ProviderQuotaResource{}
+	for _, quota := range quotas {
+		if quota == nil {
+			continue
+		}
+		if name, ok := metrics[quota.Metric]; ok {
+			resources[name] = apiv2.ProviderQuotaResource{Used: int64(quota.Usage), Limit: quotaLimit(ptr.To(int64(quota.Limit)))}
+		}
+	}
+
+	return orderedQuotaResources(resources)
+}
+
+// GetOpenstackQuota returns the usage of the resources of the project the credentials are scoped to.
+func GetOpenstackQuota(ctx context.Context, datacenter *kubermaticv1.DatacenterSpecOpenstack, credentials *resources.OpenstackCredentials, caBundle *x509.CertPool) (*apiv2.ProviderQuota, error) {
+	quotas, err := openstack.GetQuotas(ctx, datacenter.AuthURL, datacenter.Region, credentials, caBundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv2.ProviderQuota{
+		Provider:  string(kubermaticv1.OpenstackCloudProvider),
+		Region:    datacenter.Region,
+		Resources: openstackQuotaResources(quotas),
+	}, nil
+}
+
+func openstackQuotaResources(quotas *openstack.Quotas) []apiv2.ProviderQuotaResource {
+	toResource := func(usage openstack.QuotaUsage) apiv2.ProviderQuotaResource {
+		return apiv2.ProviderQuotaResource{Used: int64(usage.InUse), Limit: quotaLimit(ptr.To(int64(usage.Limit)))}
+	}
+
+	resources := map[string]apiv2.ProviderQuotaResource{
+		apiv2.ProviderQuotaInstances: toResource(quotas.Instances),
+		apiv2.ProviderQuotaVCPUs:     toResource(quotas.Cores),
+	}
+	if quotas.Volumes != nil {
+		resources[apiv2.ProviderQuotaVolumes] = toResource(*quotas.Volumes)
+	}
+	if quotas.Gigabytes != nil {
+		resources[apiv2.ProviderQuotaVolumeGigabytes] = toResource(*quotas.Gigabytes)
+	}
+	if quotas.FloatingIPs != nil {
+		resources[apiv2.ProviderQuotaFloatingIPs] = toResource(*quotas.FloatingIPs)
+	}
+
+	return orderedQuotaResources(resources)
+}
+
+// quotaLimit drops negative limits, which providers use to mark unlimited resources.
+func quotaLimit(limit *int64) *int64 {
+	if limit == nil || *limit < 0 {
+		return nil
+	}
+	return limit
+}
+
+// orderedQuotaResources returns the resources in a fixed order, so that all providers are rendered the same way.
+func orderedQuotaResources(resources map[string]apiv2.ProviderQuotaResource) []apiv2.ProviderQuotaResource {
+	result := []apiv2.ProviderQuotaResource{}
+	for _, name := range []string{apiv2.ProviderQuotaInstances, apiv2.ProviderQuotaVCPUs, apiv2.ProviderQuotaVolumes, apiv2.ProviderQuotaVolumeGigabytes, apiv2.ProviderQuotaFloatingIPs} {
+		if resource, ok := resources[name]; ok {
+			resource.Name = name
+			result = append(result, resource)
+		}
+	}
+	return result
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
+	"google.golang.org/api/compute/v1"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/openstack"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestQuotaResources(t *testing.T) {
+	tests := []struct {
+		name     string
+		got      []apiv2.ProviderQuotaResource
+		expected []apiv2.ProviderQuotaResource
+	}{
+		{
+			name: "azure",
+			got: azureQuotaResources(
+				[]armcompute.Usage{
+					{Name: &armcompute.UsageName{Value: ptr.To("cores")}, CurrentValue: ptr.To[int32](12), Limit: ptr.To[int64](100)},
+					{Name: &armcompute.UsageName{Value: ptr.To("availabilitySets")}, CurrentValue: ptr.To[int32](1), Limit: ptr.To[int64](2500)},
+					{Name: &armcompute.UsageName{Value: ptr.To("virtualMachines")}, CurrentValue: ptr.To[int32](3), Limit: ptr.To[int64](25000)},
+				},
+				[]armnetwork.Usage{
+					{Name: &armnetwork.UsageName{Value: ptr.To("PublicIPAddresses")}, CurrentValue: ptr.To[int64](2), Limit: ptr.To[int64](10)},
+				},
+			),
+			expected: []apiv2.ProviderQuotaResource{
+				{Name: apiv2.ProviderQuotaInstances, Used: 3, Limit: ptr.To[int64](25000)},
+				{Name: apiv2.ProviderQuotaVCPUs, Used: 12, Limit: ptr.To[int64](100)},
+				{Name: apiv2.ProviderQuotaFloatingIPs, Used: 2, Limit: ptr.To[int64](10)},
+			},
+		},
+		{
+			name: "gcp",
+			got: gcpQuotaResources([]*compute.Quota{
+				{Metric: "CPUS", Usage: 8, Limit: 24},
+				{Metric: "DISKS_TOTAL_GB", Usage: 500, Limit: 4096},
+				{Metric: "SSD_TOTAL_GB", Usage: 0, Limit: 500},
+				{Metric: "INSTANCES", Usage: 4, Limit: 24},
+				{Metric: "IN_USE_ADDRESSES", Usage: 4, Limit: 8},
+			}),
+			expected: []apiv2.ProviderQuotaResource{
+				{Name: apiv2.ProviderQuotaInstances, Used: 4, Limit: ptr.To[int64](24)},
+				{Name: apiv2.ProviderQuotaVCPUs, Used: 8, Limit: ptr.To[int64](24)},
+				{Name: apiv2.ProviderQuotaVolumeGigabytes, Used: 500, Limit: ptr.To[int64](4096)},
+				{Name: apiv2.ProviderQuotaFloatingIPs, Used: 4, Limit: ptr.To[int64](8)},
+			},
+		},
+		{
+			name: "openstack with unlimited resources",
+			got: openstackQuotaResources(&openstack.Quotas{
+				Instances:   openstack.QuotaUsage{InUse: 3, Limit: 10},
+				Cores:       openstack.QuotaUsage{InUse: 6, Limit: -1},
+				Volumes:     &openstack.QuotaUsage{InUse: 2, Limit: 20},
+				Gigabytes:   &openstack.QuotaUsage{InUse: 40, Limit: 1000},
+				FloatingIPs: &openstack.QuotaUsage{InUse: 1, Limit: -1},
+			}),
+			expected: []apiv2.ProviderQuotaResource{
+				{Name: apiv2.ProviderQuotaInstances, Used: 3, Limit: ptr.To[int64](10)},
+				{Name: apiv2.ProviderQuotaVCPUs, Used: 6},
+				{Name: apiv2.ProviderQuotaVolumes, Used: 2, Limit: ptr.To[int64](20)},
+				{Name: apiv2.ProviderQuotaVolumeGigabytes, Used: 40, Limit: ptr.To[int64](1000)},
+				{Name: apiv2.ProviderQuotaFloatingIPs, Used: 1},
+			},
+		},
+		{
+			name: "openstack without block storage and network services",
+			got: openstackQuotaResources(&openstack.Quotas{
+				Instances: openstack.QuotaUsage{InUse: 3, Limit: 10},
+				Cores:     openstack.QuotaUsage{InUse: 6, Limit: 20},
+			}),
+			expected: []apiv2.ProviderQuotaResource{
+				{Name: apiv2.ProviderQuotaInstances, Used: 3, Limit: ptr.To[int64](10)},
+				{Name: apiv2.ProviderQuotaVCPUs, Used: 6, Limit: ptr.To[int64](20)},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if !diff.SemanticallyEqual(tc.expected, tc.got) {
+				t.Fatalf("Got unexpected quota resources:\n%v", diff.ObjectDiff(tc.expected, tc.got))
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"sync"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+)
+
+const (
+	// SeedCircuitBreakerThreshold is the number of consecutive connection failures after which a seed is skipped.
+	SeedCircuitBreakerThreshold = 3
+	// SeedCircuitBreakerCooldown is how long a seed is skipped before it is probed again.
+	SeedCircuitBreakerCooldown = 30 * time.Second
+)
+
+type seedCircuitState struct {
+	failures  int
+	openUntil time.Time
+	lastError string
+}
+
+// SeedCircuitBreaker keeps track of the connection failures per seed. After a number of consecutive failures the
+// seed is skipped for a cooldown period, so that requests don't wait for the connection to a flapping seed to time
+// out. Once the cooldown ended a single request probes the seed again, a successful probe closes the breaker.
+type SeedCircuitBreaker struct {
+	lock      sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+	seeds     map[string]*seedCircuitState
+}
+
+func NewSeedCircuitBreaker(threshold int, cooldown time.Duration) *SeedCircuitBreaker {
+	return &SeedCircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		seeds:     map[string]*seedCircuitState{},
+	}
+}
+
+// Allow returns whether the seed may be connected to. While the breaker of the seed is open it returns false and
+// the remaining cooldown. When the cooldown ended, only the first caller is allowed to probe the seed, the others
+// are rejected until the probe is recorded.
+func (b *SeedCircuitBreaker) Allow(seed string) (bool, time.Duration) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	state, ok := b.seeds[seed]
+	if !ok || state.failures < b.threshold {
+		return true, 0
+	}
+
+	now := b.now()
+	if now.Before(state.openUntil) {
+		return false, state.openUntil.Sub(now)
+	}
+
+	// reserve the probe, a failed probe opens the breaker again
+	state.openUntil = now.Add(b.cooldown)
+	return true, 0
+}
+
+// RecordSuccess closes the breaker of the seed.
+func (b *SeedCircuitBreaker) RecordSuccess(seed string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	delete(b.seeds, seed)
+}
+
+// RecordFailure counts a failed connection attempt to the seed and opens its breaker once the threshold is reached.
+func (b *SeedCircuitBreaker) RecordFailure(seed string, err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	state, ok := b.seeds[seed]
+	if !ok {
+		state = &seedCircuitState{}
+		b.seeds[seed] = state
+	}
+
+	state.failures++
+	state.lastError = err.Error()
+	if state.failures >= b.threshold {
+		state.openUntil = b.now().Add(b.cooldown)
+	}
+}
+
+// State returns the current state of the breaker of the seed.
+func (b *SeedCircuitBreaker) State(seed string) apiv2.SeedCircuitBreaker {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	result := apiv2.SeedCircuitBreaker{
+		Seed:  seed,
+		State: apiv2.SeedCircuitBreakerClosed,
+	}
+
+	state, ok := b.seeds[seed]
+	if !ok {
+		return result
+	}
+
+	result.ConsecutiveFailures = state.failures
+	result.LastError = state.lastError
+	if state.failures >= b.threshold {
+		if b.now().Before(state.openUntil) {
+			openUntil := apiv1.NewTime(state.openUntil)
+			result.State = apiv2.SeedCircuitBreakerOpen
+			result.OpenUntil = &openUntil
+		} else {
+			result.State = apiv2.SeedCircuitBreakerHalfOpen
+		}
+	}
+
+	return result
+}
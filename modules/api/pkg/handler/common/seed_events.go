@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ClusterEventsScopeUser selects the events of the cluster object.
+	ClusterEventsScopeUser = "user"
+	// ClusterEventsScopeSeed selects the events from the namespace of the cluster on the seed.
+	ClusterEventsScopeSeed = "seed"
+	// ClusterEventsScopeAll selects the events of both scopes.
+	ClusterEventsScopeAll = "all"
+
+	redactedNodeName = "<redacted>"
+)
+
+// controlPlaneComponents are the names of the control plane components running in the namespace of a cluster on
+// the seed. The objects of a component, e.g. its pods, replica sets and services, are prefixed with its name.
+var controlPlaneComponents = []string{
+	resources.ApiserverDeploymentName,
+	resources.ControllerManagerDeploymentName,
+	resources.SchedulerDeploymentName,
+	resources.EtcdStatefulSetName,
+	resources.MachineControllerDeploymentName,
+	resources.MachineControllerWebhookDeploymentName,
+	resources.OperatingSystemManagerDeploymentName,
+	resources.OperatingSystemManagerWebhookDeploymentName,
+	resources.OpenVPNServerDeploymentName,
+	resources.DNSResolverDeploymentName,
+	resources.UserClusterControllerDeploymentName,
+	resources.UserClusterWebhookDeploymentName,
+	resources.KubernetesDashboardDeploymentName,
+	resources.KubeStateMetricsDeploymentName,
+	resources.MetricsServerDeploymentName,
+	resources.KubeLBDeploymentName,
+	resources.PrometheusStatefulSetName,
+	resources.NodePortProxyEnvoyDeploymentName,
+}
+
+// getSeedNamespaceEvents returns the events from the namespace of the cluster on the seed, which are mostly about
+// the control plane components. The seed nodes are shared by all tenants, so their names can be redacted.
+func getSeedNamespaceEvents(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, redactNodeNames bool) ([]apiv1.Event, error) {
+	events := make([]apiv1.Event, 0)
+	if cluster.Status.NamespaceName == "" {
+		return events, nil
+	}
+
+	eventList := &corev1.EventList{}
+	if err := client.List(ctx, eventList, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
+		return nil, err
+	}
+
+	var nodeNames []string
+	if redactNodeNames {
+		nodes := &corev1.NodeList{}
+		if err := client.List(ctx, nodes); err != nil {
+			return nil, err
+		}
+		for _, node := range nodes.Items {
+			nodeNames = append(nodeNames, node.Name)
+		}
+		// replace longer names first, in case a node name is a prefix of another one
+		sort.Slice(nodeNames, func(i, j int) bool {
+			return len(nodeNames[i]) > len(nodeNames[j])
+		})
+	}
+
+	for _, event := range eventList.Items {
+		apiEvent := common.ConvertInternalEventToExternal(event)
+		apiEvent.Component = getControlPlaneComponent(event.InvolvedObject.Name)
+
+		if redactNodeNames {
+			for _, nodeName := range nodeNames {
+				apiEvent.Message = strings.ReplaceAll(apiEvent.Message, nodeName, redactedNodeName)
+			}
+			if event.InvolvedObject.Kind == "Node" {
+				apiEvent.InvolvedObject.Name = redactedNodeName
+			}
+		}
+
+		events = append(events, apiEvent)
+	}
+
+	return events, nil
+}
+
+// getControlPlaneComponent returns the control plane component the object belongs to, based on its name. It is
+// empty for objects which don't belong to a known component.
+func getControlPlaneComponent(name string) string {
+	var component string
+	for _, candidate := range controlPlaneComponents {
+		if (name == candidate || strings.HasPrefix(name, candidate+"-")) && len(candidate) > len(component) {
+			component = candidate
+		}
+	}
+
+	return component
+}
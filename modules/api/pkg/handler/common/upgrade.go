@@ -20,15 +20,18 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 
 	semverlib "github.com/Masterminds/semver/v3"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
+	ksemver "k8c.io/kubermatic/sdk/v2/semver"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 	"k8c.io/kubermatic/v2/pkg/validation/nodeupdate"
@@ -42,57 +45,99 @@ import (
 )
 
 func GetUpgradesEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, configGetter provider.KubermaticConfigurationGetter) (interface{}, error) {
+	_, machineDeployments, versions, err := getClusterUpgrades(ctx, userInfoGetter, projectID, clusterID, projectProvider, privilegedProjectProvider, configGetter)
+	if err != nil {
+		return nil, err
+	}
+	// Happens during cluster creation when the CRD is not setup yet
+	if machineDeployments == nil {
+		return nil, nil
+	}
+
+	upgrades := make([]*apiv1.MasterVersion, 0)
+	for _, v := range versions {
+		isRestricted, err := isRestrictedByKubeletVersions(v, machineDeployments.Items)
+		if err != nil {
+			return nil, err
+		}
+		upgrades = append(upgrades, &apiv1.MasterVersion{
+			Version:                    v.Version,
+			RestrictedByKubeletVersion: isRestricted,
+		})
+	}
+
+	return upgrades, nil
+}
+
+// GetUpgradePlanEndpoint returns the possible control plane upgrades of the cluster. For every version, the machine
+// deployments are listed which kubelet would be incompatible with it, together with the kubelet version they have
+// to be upgraded to first.
+func GetUpgradePlanEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, configGetter provider.KubermaticConfigurationGetter) (interface{}, error) {
+	cluster, machineDeployments, versions, err := getClusterUpgrades(ctx, userInfoGetter, projectID, clusterID, projectProvider, privilegedProjectProvider, configGetter)
+	if err != nil {
+		return nil, err
+	}
+	// Happens during cluster creation when the CRD is not setup yet
+	if machineDeployments == nil {
+		return nil, nil
+	}
+
+	plans := make([]apiv2.ClusterUpgradePlan, 0, len(versions))
+	for _, v := range versions {
+		incompatible, err := getIncompatibleMachineDeployments(cluster.Spec.Version.Semver(), v.Version, machineDeployments.Items)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, apiv2.ClusterUpgradePlan{
+			Version:                        *ksemver.NewSemverOrDie(v.Version.String()),
+			IncompatibleMachineDeployments: incompatible,
+		})
+	}
+
+	return plans, nil
+}
+
+// getClusterUpgrades returns the cluster, its machine deployments and the versions it can be upgraded to. The
+// machine deployments are nil if the machine deployment CRD doesn't exist yet.
+func getClusterUpgrades(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, configGetter provider.KubermaticConfigurationGetter) (*kubermaticv1.Cluster, *clusterv1alpha1.MachineDeploymentList, []*version.Version, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 
 	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
 	if err != nil {
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, nil, nil, common.KubernetesErrorToHTTPError(err)
 	}
 
 	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
 	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
-		// Happens during cluster creation when the CRD is not setup yet
 		if meta.IsNoMatchError(err) {
-			return nil, nil
+			return cluster, nil, nil, nil
 		}
-		return nil, common.KubernetesErrorToHTTPError(err)
+		return nil, nil, nil, common.KubernetesErrorToHTTPError(err)
 	}
 
 	providerName, err := kubermaticv1helper.ClusterCloudProviderName(cluster.Spec.Cloud)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get the cloud provider name: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to get the cloud provider name: %w", err)
 	}
 
 	config, err := configGetter(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	versionManager := version.NewFromConfiguration(config)
 
 	versions, err := versionManager.GetPossibleUpdates(cluster.Spec.Version.String(), kubermaticv1.ProviderType(providerName), clusterversion.GetVersionConditions(&cluster.Spec)...)
 	if err != nil {
-		return nil, err
-	}
-
-	upgrades := make([]*apiv1.MasterVersion, 0)
-	for _, v := range versions {
-		isRestricted, err := isRestrictedByKubeletVersions(v, machineDeployments.Items)
-		if err != nil {
-			return nil, err
-		}
-		upgrades = append(upgrades, &apiv1.MasterVersion{
-			Version:                    v.Version,
-			RestrictedByKubeletVersion: isRestricted,
-		})
+		return nil, nil, nil, err
 	}
 
-	return upgrades, nil
+	return cluster, machineDeployments, versions, nil
 }
 
 func UpgradeNodeDeploymentsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, version apiv1.MasterVersion, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
@@ -109,7 +154,7 @@ func UpgradeNodeDeploymentsEndpoint(ctx context.Context, userInfoGetter provider
 	}
 
 	if err = nodeupdate.EnsureVersionCompatible(cluster.Spec.Version.Semver(), requestedKubeletVersion); err != nil {
-		return nil, utilerrors.NewBadRequest("%v", err)
+		return nil, common.WithReason(validationErrorReason(err), utilerrors.NewBadRequest("%v", err))
 	}
 
 	client, err := clusterProvider.GetAdminClientForUserCluster(ctx, cluster)
@@ -137,6 +182,70 @@ func UpgradeNodeDeploymentsEndpoint(ctx context.Context, userInfoGetter provider
 	return nil, nil
 }
 
+// UpgradeIncompatibleMachineDeployments upgrades the kubelet of every machine deployment which is not compatible with
+// the control plane of the cluster to the control plane version. Paused machine deployments are skipped. The result
+// of every machine deployment is returned, sorted by name.
+func UpgradeIncompatibleMachineDeployments(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) ([]apiv2.MachineDeploymentKubeletUpgrade, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+	}
+
+	controlPlaneVersion := cluster.Spec.Version.Semver()
+	results := []apiv2.MachineDeploymentKubeletUpgrade{}
+	for _, md := range machineDeployments.Items {
+		result := apiv2.MachineDeploymentKubeletUpgrade{
+			Name:           md.Name,
+			KubeletVersion: md.Spec.Template.Spec.Versions.Kubelet,
+			Status:         apiv2.MachineDeploymentKubeletCompatible,
+		}
+
+		kubeletVersion, err := semverlib.NewVersion(md.Spec.Template.Spec.Versions.Kubelet)
+		if err != nil {
+			result.Status = apiv2.MachineDeploymentKubeletFailed
+			result.Message = fmt.Sprintf("invalid kubelet version: %v", err)
+			results = append(results, result)
+			continue
+		}
+		result.KubeletVersion = kubeletVersion.String()
+
+		err = nodeupdate.EnsureVersionCompatible(controlPlaneVersion, kubeletVersion)
+		switch {
+		case err == nil:
+		case md.Spec.Paused:
+			result.Status = apiv2.MachineDeploymentKubeletSkipped
+			result.Message = "the machine deployment is paused"
+		default:
+			md.Spec.Template.Spec.Versions.Kubelet = cluster.Spec.Version.String()
+			if err := client.Update(ctx, &md); err != nil {
+				result.Status = apiv2.MachineDeploymentKubeletFailed
+				result.Message = err.Error()
+			} else {
+				result.Status = apiv2.MachineDeploymentKubeletUpgraded
+			}
+		}
+		results = append(results, result)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Name < results[j].Name
+	})
+
+	return results, nil
+}
+
 func isRestrictedByKubeletVersions(controlPlaneVersion *version.Version, mds []clusterv1alpha1.MachineDeployment) (bool, error) {
 	for _, md := range mds {
 		kubeletVersion, err := semverlib.NewVersion(md.Spec.Template.Spec.Versions.Kubelet)
@@ -151,6 +260,38 @@ func isRestrictedByKubeletVersions(controlPlaneVersion *version.Version, mds []c
 	return false, nil
 }
 
+// getIncompatibleMachineDeployments returns the machine deployments which kubelet is not compatible with the
+// new control plane version. Kubelets can't be newer than the control plane, so the required kubelet version
+// is the current control plane version, if that one is compatible with the new control plane version.
+func getIncompatibleMachineDeployments(currentVersion, newVersion *semverlib.Version, mds []clusterv1alpha1.MachineDeployment) ([]apiv2.MachineDeploymentUpgrade, error) {
+	var requiredKubeletVersion string
+	if err := nodeupdate.EnsureVersionCompatible(newVersion, currentVersion); err == nil {
+		requiredKubeletVersion = currentVersion.String()
+	}
+
+	var incompatible []apiv2.MachineDeploymentUpgrade
+	for _, md := range mds {
+		kubeletVersion, err := semverlib.NewVersion(md.Spec.Template.Spec.Versions.Kubelet)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = nodeupdate.EnsureVersionCompatible(newVersion, kubeletVersion); err != nil {
+			incompatible = append(incompatible, apiv2.MachineDeploymentUpgrade{
+				Name:                   md.Name,
+				KubeletVersion:         kubeletVersion.String(),
+				RequiredKubeletVersion: requiredKubeletVersion,
+			})
+		}
+	}
+
+	sort.Slice(incompatible, func(i, j int) bool {
+		return incompatible[i].Name < incompatible[j].Name
+	})
+
+	return incompatible, nil
+}
+
 func GetKubeOneUpgradesEndpoint(ctx context.Context, masterClient ctrlruntimeclient.Client, externalCluster *kubermaticv1.ExternalCluster, clusterProvider provider.ExternalClusterProvider, configGetter provider.KubermaticConfigurationGetter) (interface{}, error) {
 	providerName := externalCluster.Spec.CloudSpec.KubeOne.ProviderName
 	providerType := kubermaticv1.ProviderType(providerName)
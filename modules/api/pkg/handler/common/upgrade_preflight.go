@@ -0,0 +1,440 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	semverlib "github.com/Masterminds/semver/v3"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	ksemver "k8c.io/kubermatic/sdk/v2/semver"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// upgradePreflightCheckTimeout is the time a single preflight check may take, before it is reported as a warning.
+	upgradePreflightCheckTimeout = 20 * time.Second
+
+	upgradePreflightMachineDeployments = "machineDeployments"
+	upgradePreflightVersionSkew        = "versionSkew"
+	upgradePreflightPDBs               = "podDisruptionBudgets"
+	upgradePreflightEtcd               = "etcd"
+	upgradePreflightDeprecatedAPIs     = "deprecatedAPIs"
+
+	// deprecatedAPIsMetric is the metric of the API server which counts the requests of deprecated APIs.
+	deprecatedAPIsMetric = "apiserver_requested_deprecated_apis"
+)
+
+var metricLabelRegexp = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// DeprecatedAPI is a deprecated API which was requested from the API server of a cluster.
+type DeprecatedAPI struct {
+	Group          string
+	Version        string
+	Resource       string
+	RemovedRelease string
+}
+
+// DeprecatedAPIsGetter returns the deprecated APIs which were requested from the API server of the cluster.
+type DeprecatedAPIsGetter func(ctx context.Context, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster) ([]DeprecatedAPI, error)
+
+// GetRequestedDeprecatedAPIs reads the deprecated APIs from the metrics of the API server of the cluster, it is
+// replaced in tests.
+var GetRequestedDeprecatedAPIs DeprecatedAPIsGetter = getRequestedDeprecatedAPIsFromMetrics
+
+// upgradePreflightCheck is a check which is run before an upgrade. The name of the result is set by the runner.
+type upgradePreflightCheck struct {
+	name string
+	run  func(ctx context.Context) apiv2.UpgradePreflightCheck
+}
+
+// UpgradePreflightEndpoint runs the checks which tell if the control plane of the cluster can be upgraded to the
+// given version. The checks run in parallel, each with its own timeout, and never change the cluster.
+func UpgradePreflightEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, to *semverlib.Version, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	cluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	if !to.GreaterThan(cluster.Spec.Version.Semver()) {
+		return nil, utilerrors.NewBadRequest("the target version %s must be newer than the current version %s", to, cluster.Spec.Version.String())
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	targetCluster := cluster.DeepCopy()
+	targetCluster.Spec.Version = *ksemver.NewSemverOrDie(to.String())
+
+	checks := []upgradePreflightCheck{
+		{
+			name: upgradePreflightMachineDeployments,
+			run: func(ctx context.Context) apiv2.UpgradePreflightCheck {
+				return checkMachineDeploymentsHealthy(ctx, client)
+			},
+		},
+		{
+			name: upgradePreflightVersionSkew,
+			run: func(ctx context.Context) apiv2.UpgradePreflightCheck {
+				return checkUpgradeVersionSkew(ctx, userInfoGetter, clusterProvider, targetCluster, projectID)
+			},
+		},
+		{
+			name: upgradePreflightPDBs,
+			run: func(ctx context.Context) apiv2.UpgradePreflightCheck {
+				return checkPodDisruptionBudgets(ctx, client)
+			},
+		},
+		{
+			name: upgradePreflightEtcd,
+			run: func(ctx context.Context) apiv2.UpgradePreflightCheck {
+				return checkEtcdHealthy(ctx, clusterProvider, privilegedClusterProvider.GetSeedClusterAdminClient(), cluster)
+			},
+		},
+		{
+			name: upgradePreflightDeprecatedAPIs,
+			run: func(ctx context.Context) apiv2.UpgradePreflightCheck {
+				return checkDeprecatedAPIs(ctx, clusterProvider, cluster, to)
+			},
+		},
+	}
+
+	report := apiv2.UpgradePreflightReport{
+		Version: targetCluster.Spec.Version,
+		Status:  apiv2.UpgradePreflightPass,
+		Checks:  runUpgradePreflightChecks(ctx, checks, upgradePreflightCheckTimeout),
+	}
+	for _, check := range report.Checks {
+		if upgradePreflightSeverity(check.Status) > upgradePreflightSeverity(report.Status) {
+			report.Status = check.Status
+		}
+	}
+
+	return report, nil
+}
+
+// runUpgradePreflightChecks runs the checks in parallel. A check which doesn't finish in time is reported as a
+// warning, so the request doesn't take longer than the timeout. The result has the same order as the checks.
+func runUpgradePreflightChecks(ctx context.Context, checks []upgradePreflightCheck, timeout time.Duration) []apiv2.UpgradePreflightCheck {
+	var wg sync.WaitGroup
+
+	result := make([]apiv2.UpgradePreflightCheck, len(checks))
+	for i, check := range checks {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			done := make(chan apiv2.UpgradePreflightCheck, 1)
+			go func() {
+				done <- check.run(checkCtx)
+			}()
+
+			select {
+			case result[i] = <-done:
+			case <-checkCtx.Done():
+				result[i] = apiv2.UpgradePreflightCheck{
+					Status:  apiv2.UpgradePreflightWarn,
+					Message: fmt.Sprintf("the check did not finish within %s", timeout),
+				}
+			}
+			result[i].Name = check.name
+		}()
+	}
+	wg.Wait()
+
+	return result
+}
+
+func upgradePreflightSeverity(status string) int {
+	switch status {
+	case apiv2.UpgradePreflightFail:
+		return 2
+	case apiv2.UpgradePreflightWarn:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// upgradePreflightError is the result of a check which could not be run, the upgrade is not blocked by it.
+func upgradePreflightError(message string, err error) apiv2.UpgradePreflightCheck {
+	return apiv2.UpgradePreflightCheck{
+		Status:  apiv2.UpgradePreflightWarn,
+		Message: fmt.Sprintf("%s: %v", message, err),
+	}
+}
+
+// checkMachineDeploymentsHealthy fails if not all replicas of a machine deployment are available and up to date.
+func checkMachineDeploymentsHealthy(ctx context.Context, client ctrlruntimeclient.Client) apiv2.UpgradePreflightCheck {
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return apiv2.UpgradePreflightCheck{Status: apiv2.UpgradePreflightPass, Message: "the cluster has no machine deployments"}
+		}
+		return upgradePreflightError("failed to list the machine deployments", err)
+	}
+
+	var unhealthy []string
+	for _, md := range machineDeployments.Items {
+		replicas := int32(1)
+		if md.Spec.Replicas != nil {
+			replicas = *md.Spec.Replicas
+		}
+		if md.Status.AvailableReplicas < replicas || md.Status.UpdatedReplicas < replicas {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s has %d of %d replicas available and %d updated", md.Name, md.Status.AvailableReplicas, replicas, md.Status.UpdatedReplicas))
+		}
+	}
+	sort.Strings(unhealthy)
+
+	if len(unhealthy) > 0 {
+		return apiv2.UpgradePreflightCheck{
+			Status:  apiv2.UpgradePreflightFail,
+			Message: fmt.Sprintf("%d of %d machine deployments are not healthy", len(unhealthy), len(machineDeployments.Items)),
+			Details: unhealthy,
+		}
+	}
+
+	return apiv2.UpgradePreflightCheck{
+		Status:  apiv2.UpgradePreflightPass,
+		Message: fmt.Sprintf("all %d machine deployments are healthy", len(machineDeployments.Items)),
+	}
+}
+
+// checkUpgradeVersionSkew fails if a kubelet of the cluster is not compatible with the version of the target cluster.
+func checkUpgradeVersionSkew(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, targetCluster *kubermaticv1.Cluster, projectID string) apiv2.UpgradePreflightCheck {
+	incompatible, err := common.CheckClusterVersionSkew(ctx, userInfoGetter, clusterProvider, targetCluster, projectID)
+	if err != nil {
+		return upgradePreflightError("failed to check the version skew", err)
+	}
+
+	if len(incompatible) > 0 {
+		sort.Strings(incompatible)
+		details := make([]string, 0, len(incompatible))
+		for _, version := range incompatible {
+			details = append(details, fmt.Sprintf("kubelet %s is not compatible with the control plane %s", version, targetCluster.Spec.Version.String()))
+		}
+		return apiv2.UpgradePreflightCheck{
+			Status:  apiv2.UpgradePreflightFail,
+			Message: "the kubelets have to be upgraded before the control plane",
+			Details: details,
+		}
+	}
+
+	return apiv2.UpgradePreflightCheck{
+		Status:  apiv2.UpgradePreflightPass,
+		Message: fmt.Sprintf("all kubelets are compatible with the control plane %s", targetCluster.Spec.Version.String()),
+	}
+}
+
+// checkPodDisruptionBudgets warns about the pod disruption budgets which allow no disruption, they block the drain
+// of the nodes when the machine deployments are rolled out with the new kubelet.
+func checkPodDisruptionBudgets(ctx context.Context, client ctrlruntimeclient.Client) apiv2.UpgradePreflightCheck {
+	pdbs := &policyv1.PodDisruptionBudgetList{}
+	if err := client.List(ctx, pdbs); err != nil {
+		return upgradePreflightError("failed to list the pod disruption budgets", err)
+	}
+
+	var blocking []string
+	for _, pdb := range pdbs.Items {
+		if pdb.Status.ExpectedPods > 0 && pdb.Status.DisruptionsAllowed == 0 {
+			blocking = append(blocking, fmt.Sprintf("%s/%s allows no disruption, %d of %d pods are healthy and %d are required", pdb.Namespace, pdb.Name, pdb.Status.CurrentHealthy, pdb.Status.ExpectedPods, pdb.Status.DesiredHealthy))
+		}
+	}
+	sort.Strings(blocking)
+
+	if len(blocking) > 0 {
+		return apiv2.UpgradePreflightCheck{
+			Status:  apiv2.UpgradePreflightWarn,
+			Message: "pod disruption budgets would block the drain of nodes",
+			Details: blocking,
+		}
+	}
+
+	return apiv2.UpgradePreflightCheck{
+		Status:  apiv2.UpgradePreflightPass,
+		Message: "no pod disruption budget blocks the drain of nodes",
+	}
+}
+
+// checkEtcdHealthy fails if etcd is down, has lost its quorum or has active alarms.
+func checkEtcdHealthy(ctx context.Context, clusterProvider provider.ClusterProvider, seedClient kubernetes.Interface, cluster *kubermaticv1.Cluster) apiv2.UpgradePreflightCheck {
+	if health := cluster.Status.ExtendedHealth.Etcd; health != kubermaticv1.HealthStatusUp {
+		return apiv2.UpgradePreflightCheck{
+			Status:  apiv2.UpgradePreflightFail,
+			Message: fmt.Sprintf("etcd is not healthy, its health is %s", health),
+		}
+	}
+
+	status, err := GetEtcdStatus(ctx, clusterProvider, seedClient, cluster)
+	if err != nil {
+		return upgradePreflightError("failed to query etcd", err)
+	}
+
+	if status.HealthyMembers <= status.MemberCount/2 {
+		return apiv2.UpgradePreflightCheck{
+			Status:  apiv2.UpgradePreflightFail,
+			Message: fmt.Sprintf("etcd has lost its quorum, %d of %d members are healthy", status.HealthyMembers, status.MemberCount),
+		}
+	}
+
+	if len(status.Alarms) > 0 {
+		details := make([]string, 0, len(status.Alarms))
+		for _, alarm := range status.Alarms {
+			details = append(details, fmt.Sprintf("member %s has the alarm %s", alarm.MemberID, alarm.Alarm))
+		}
+		return apiv2.UpgradePreflightCheck{
+			Status:  apiv2.UpgradePreflightFail,
+			Message: "etcd has active alarms",
+			Details: details,
+		}
+	}
+
+	if status.HealthyMembers < status.MemberCount {
+		return apiv2.UpgradePreflightCheck{
+			Status:  apiv2.UpgradePreflightWarn,
+			Message: fmt.Sprintf("%d of %d etcd members are healthy", status.HealthyMembers, status.MemberCount),
+		}
+	}
+
+	return apiv2.UpgradePreflightCheck{
+		Status:  apiv2.UpgradePreflightPass,
+		Message: fmt.Sprintf("all %d etcd members are healthy", status.MemberCount),
+	}
+}
+
+// checkDeprecatedAPIs fails if APIs are in use which are removed in the target version and warns about the other
+// deprecated APIs in use. The API server only counts the requests since it was started, so the result is incomplete
+// for APIs which are rarely used.
+func checkDeprecatedAPIs(ctx context.Context, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, to *semverlib.Version) apiv2.UpgradePreflightCheck {
+	apis, err := GetRequestedDeprecatedAPIs(ctx, clusterProvider, cluster)
+	if err != nil {
+		return upgradePreflightError("the deprecated APIs in use can not be determined", err)
+	}
+
+	status := apiv2.UpgradePreflightPass
+	var removed, deprecated []string
+	for _, api := range apis {
+		groupVersion := api.Version
+		if api.Group != "" {
+			groupVersion = fmt.Sprintf("%s/%s", api.Group, api.Version)
+		}
+
+		removedRelease, err := semverlib.NewVersion(api.RemovedRelease)
+		if err == nil && removedRelease.Major() == to.Major() && removedRelease.Minor() <= to.Minor() {
+			removed = append(removed, fmt.Sprintf("%s %s is removed in %s", groupVersion, api.Resource, api.RemovedRelease))
+			continue
+		}
+		deprecated = append(deprecated, fmt.Sprintf("%s %s is deprecated", groupVersion, api.Resource))
+	}
+	sort.Strings(removed)
+	sort.Strings(deprecated)
+
+	switch {
+	case len(removed) > 0:
+		status = apiv2.UpgradePreflightFail
+	case len(deprecated) > 0:
+		status = apiv2.UpgradePreflightWarn
+	default:
+		return apiv2.UpgradePreflightCheck{Status: status, Message: "no deprecated APIs are in use"}
+	}
+
+	return apiv2.UpgradePreflightCheck{
+		Status:  status,
+		Message: fmt.Sprintf("%d deprecated APIs are in use and %d are removed in %s", len(removed)+len(deprecated), len(removed), to),
+		Details: append(removed, deprecated...),
+	}
+}
+
+func getRequestedDeprecatedAPIsFromMetrics(ctx context.Context, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster) ([]DeprecatedAPI, error) {
+	client, err := clusterProvider.GetAdminK8sClientForUserCluster(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := client.CoreV1().RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the metrics of the API server: %w", err)
+	}
+
+	return parseDeprecatedAPIsMetric(metrics), nil
+}
+
+// parseDeprecatedAPIsMetric returns the deprecated APIs from the metrics of the API server in the text format. The
+// metric has one series per requested deprecated API.
+func parseDeprecatedAPIsMetric(metrics []byte) []DeprecatedAPI {
+	apis := []DeprecatedAPI{}
+	seen := map[DeprecatedAPI]bool{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(metrics))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, deprecatedAPIsMetric+"{") {
+			continue
+		}
+
+		labels := map[string]string{}
+		for _, match := range metricLabelRegexp.FindAllStringSubmatch(line, -1) {
+			labels[match[1]] = match[2]
+		}
+
+		api := DeprecatedAPI{
+			Group:          labels["group"],
+			Version:        labels["version"],
+			Resource:       labels["resource"],
+			RemovedRelease: labels["removed_release"],
+		}
+		if !seen[api] {
+			seen[api] = true
+			apis = append(apis, api)
+		}
+	}
+
+	return apis
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+)
+
+func TestParseDeprecatedAPIsMetric(t *testing.T) {
+	t.Parallel()
+
+	metrics := []byte(`# HELP apiserver_requested_deprecated_apis [STABLE] Gauge of deprecated APIs that have been requested, broken out by API group, version, resource, subresource, and removed_release.
+# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.32",resource="flowschemas",subresource="",version="v1beta3"} 1
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.32",resource="flowschemas",subresource="status",version="v1beta3"} 1
+apiserver_requested_deprecated_apis{group="",removed_release="",resource="componentstatuses",subresource="",version="v1"} 1
+apiserver_request_total{code="200",resource="pods",verb="LIST",version="v1"} 42
+`)
+
+	expected := []DeprecatedAPI{
+		{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3", Resource: "flowschemas", RemovedRelease: "1.32"},
+		{Version: "v1", Resource: "componentstatuses"},
+	}
+	if apis := parseDeprecatedAPIsMetric(metrics); !reflect.DeepEqual(apis, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, apis)
+	}
+}
+
+func TestRunUpgradePreflightChecksTimeout(t *testing.T) {
+	t.Parallel()
+
+	checks := []upgradePreflightCheck{
+		{
+			name: "fast",
+			run: func(_ context.Context) apiv2.UpgradePreflightCheck {
+				return apiv2.UpgradePreflightCheck{Status: apiv2.UpgradePreflightPass, Message: "done"}
+			},
+		},
+		{
+			name: "slow",
+			run: func(_ context.Context) apiv2.UpgradePreflightCheck {
+				time.Sleep(time.Second)
+				return apiv2.UpgradePreflightCheck{Status: apiv2.UpgradePreflightFail}
+			},
+		},
+	}
+
+	expected := []apiv2.UpgradePreflightCheck{
+		{Name: "fast", Status: apiv2.UpgradePreflightPass, Message: "done"},
+		{Name: "slow", Status: apiv2.UpgradePreflightWarn, Message: "the check did not finish within 10ms"},
+	}
+	if result := runUpgradePreflightChecks(context.Background(), checks, 10*time.Millisecond); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, result)
+	}
+}
@@ -20,15 +20,25 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"reflect"
+	"strconv"
 
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/kubermatic/v2/pkg/log"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 )
 
 const (
 	headerContentType = "Content-Type"
+	headerWarning     = "Warning"
+
+	// headerRolloutTriggered tells whether a change of a machine deployment rolls out new machines.
+	headerRolloutTriggered = "X-Rollout-Triggered"
+
+	// warnCodeMiscellaneous is the RFC 7234 warn-code for persistent warnings which don't fit another code.
+	warnCodeMiscellaneous = 299
 
 	contentTypeJSON = "application/json"
 )
@@ -55,6 +65,10 @@ type ErrorDetails struct {
 	//
 	// Required: false
 	Additional []string `json:"details,omitempty"`
+	// The machine-readable reason of the error, e.g. CLUSTER_NOT_READY
+	//
+	// Required: false
+	Reason string `json:"reason,omitempty"`
 }
 
 // EmptyResponse is a empty response
@@ -87,6 +101,7 @@ func ErrorEncoder(ctx context.Context, err error, w http.ResponseWriter) {
 			Code:       errorCode,
 			Message:    msg,
 			Additional: additional,
+			Reason:     common.ErrorReason(err),
 		},
 	}
 
@@ -129,6 +144,47 @@ func statusOK(res http.ResponseWriter, _ *http.Request) {
 	res.WriteHeader(http.StatusOK)
 }
 
+// ResponseWithWarnings wraps a response to return warnings for the request, e.g. about deprecated fields,
+// without changing the response body.
+type ResponseWithWarnings struct {
+	Response interface{}
+	Warnings []string
+}
+
+// SetWarningHeaders adds a Warning header for each warning of a ResponseWithWarnings and passes the wrapped
+// response to f. The headers follow RFC 7234, e.g. `299 - "spec.dynamicConfig is deprecated"`. Other
+// responses are passed to f unchanged.
+func SetWarningHeaders(f func(context.Context, http.ResponseWriter, interface{}) error) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+		if rsp, ok := response.(*ResponseWithWarnings); ok {
+			for _, warning := range rsp.Warnings {
+				w.Header().Add(headerWarning, fmt.Sprintf("%d - %s", warnCodeMiscellaneous, strconv.Quote(warning)))
+			}
+			response = rsp.Response
+		}
+		return f(ctx, w, response)
+	}
+}
+
+// ResponseWithRollout wraps a response to tell whether the request rolled out new machines, without changing the
+// response body.
+type ResponseWithRollout struct {
+	Response         interface{}
+	RolloutTriggered bool
+}
+
+// SetRolloutHeader sets the X-Rollout-Triggered header to true or false for a ResponseWithRollout and passes the
+// wrapped response to f. Other responses are passed to f unchanged.
+func SetRolloutHeader(f func(context.Context, http.ResponseWriter, interface{}) error) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+		if rsp, ok := response.(*ResponseWithRollout); ok {
+			w.Header().Set(headerRolloutTriggered, strconv.FormatBool(rsp.RolloutTriggered))
+			response = rsp.Response
+		}
+		return f(ctx, w, response)
+	}
+}
+
 func SetStatusCreatedHeader(f func(context.Context, http.ResponseWriter, interface{}) error) func(context.Context, http.ResponseWriter, interface{}) error {
 	return func(ctx context.Context, r http.ResponseWriter, i interface{}) error {
 		r.Header().Set(headerContentType, contentTypeJSON)
@@ -19,7 +19,9 @@ package handler
 import (
 	"context"
 	"io"
+	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -60,3 +62,47 @@ func TestEncodeJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestSetWarningHeaders(t *testing.T) {
+	testcases := []struct {
+		name             string
+		response         interface{}
+		expectedWarnings []string
+		expectedBody     string
+	}{
+		{
+			name: "add a header per warning before the status is written",
+			response: &ResponseWithWarnings{
+				Response: map[string]string{"name": "mars"},
+				Warnings: []string{"spec.dynamicConfig is deprecated", `field "a" is ignored`},
+			},
+			expectedWarnings: []string{`299 - "spec.dynamicConfig is deprecated"`, `299 - "field \"a\" is ignored"`},
+			expectedBody:     `{"name":"mars"}`,
+		},
+		{
+			name:         "pass other responses unchanged",
+			response:     map[string]string{"name": "mars"},
+			expectedBody: `{"name":"mars"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			writer := httptest.NewRecorder()
+
+			if err := SetWarningHeaders(SetStatusCreatedHeader(EncodeJSON))(context.Background(), writer, tc.response); err != nil {
+				t.Fatalf("failed to encode response: %v", err)
+			}
+
+			if writer.Code != http.StatusCreated {
+				t.Errorf("expected status code %d, got %d", http.StatusCreated, writer.Code)
+			}
+			if warnings := writer.Result().Header.Values("Warning"); !reflect.DeepEqual(warnings, tc.expectedWarnings) {
+				t.Errorf("expected warnings %q, got %q", tc.expectedWarnings, warnings)
+			}
+			if body := strings.TrimSpace(writer.Body.String()); body != tc.expectedBody {
+				t.Errorf("expected body '%s', got '%s'", tc.expectedBody, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics contains the Prometheus metrics of the API handlers. The metric names are stable, dashboards and
+// alerts rely on them, so they must not be renamed or have their labels changed.
+package metrics
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+const (
+	// EndpointRequestsTotalName counts the requests of the instrumented endpoints, labeled with the operation ID of
+	// the endpoint, e.g. createMachineDeployment, and the HTTP status code.
+	EndpointRequestsTotalName = "kubermatic_api_endpoint_requests_total"
+	// EndpointRequestDurationName is a histogram of the latency of the instrumented endpoints by operation ID.
+	EndpointRequestDurationName = "kubermatic_api_endpoint_request_duration_seconds"
+	// MachineDeploymentValidationFailuresTotalName counts the machine deployment requests rejected with 400, labeled
+	// with the operation ID and the reason of the error, e.g. SIZE_LIMIT. Errors without a reason are counted as
+	// INVALID.
+	MachineDeploymentValidationFailuresTotalName = "kubermatic_api_machine_deployment_validation_failures_total"
+	// SeedClusterListDurationName is a histogram of the time it takes to list the clusters of a project in a seed.
+	SeedClusterListDurationName = "kubermatic_api_seed_cluster_list_duration_seconds"
+	// ProjectClustersName is the number of clusters returned by the last cluster listing of a project. Only the
+	// projects whose clusters were listed since the previous scrape are exported.
+	ProjectClustersName = "kubermatic_api_project_clusters"
+)
+
+// ValidationFailureReasonInvalid is the reason of validation failures whose error has no reason.
+const ValidationFailureReasonInvalid = "INVALID"
+
+var (
+	EndpointRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: EndpointRequestsTotalName,
+		Help: "Count of the requests of the instrumented API endpoints by endpoint and status code",
+	}, []string{"endpoint", "code"})
+
+	EndpointRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    EndpointRequestDurationName,
+		Help:    "A histogram of the latencies of the instrumented API endpoints",
+		Buckets: []float64{.005, .01, .025, .05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{"endpoint"})
+
+	MachineDeploymentValidationFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: MachineDeploymentValidationFailuresTotalName,
+		Help: "Count of the machine deployment requests which failed validation by endpoint and reason",
+	}, []string{"endpoint", "reason"})
+
+	SeedClusterListDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    SeedClusterListDurationName,
+		Help:    "A histogram of the time it takes to list the clusters of a project in a seed",
+		Buckets: []float64{.01, .025, .05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{"seed"})
+
+	ProjectClusters = &projectClustersCollector{
+		desc:     prometheus.NewDesc(ProjectClustersName, "The number of clusters returned by the last cluster listing of a project", []string{"project"}, nil),
+		clusters: map[string]int{},
+	}
+)
+
+func init() {
+	prometheus.MustRegister(EndpointRequestsTotal)
+	prometheus.MustRegister(EndpointRequestDuration)
+	prometheus.MustRegister(MachineDeploymentValidationFailuresTotal)
+	prometheus.MustRegister(SeedClusterListDuration)
+	prometheus.MustRegister(ProjectClusters)
+}
+
+// InstrumentEndpoint wraps the handler of an endpoint with request counting and latency tracking. The endpoint is
+// identified by its operation ID.
+func InstrumentEndpoint(endpoint string, next http.Handler) http.Handler {
+	counter := EndpointRequestsTotal.MustCurryWith(prometheus.Labels{"endpoint": endpoint})
+	duration := EndpointRequestDuration.With(prometheus.Labels{"endpoint": endpoint})
+	instrumented := promhttp.InstrumentHandlerCounter(counter, next)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		instrumented.ServeHTTP(w, r)
+		duration.Observe(time.Since(start).Seconds())
+	})
+}
+
+// RecordMachineDeploymentValidationFailure counts the error if it rejects the machine deployment as a bad request.
+// Other errors are ignored.
+func RecordMachineDeploymentValidationFailure(endpoint string, err error) {
+	var httpErr utilerrors.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode() != http.StatusBadRequest {
+		return
+	}
+
+	reason := common.ErrorReason(err)
+	if reason == "" {
+		reason = ValidationFailureReasonInvalid
+	}
+	MachineDeploymentValidationFailuresTotal.With(prometheus.Labels{"endpoint": endpoint, "reason": reason}).Inc()
+}
+
+// ObserveSeedClusterList records the time it took to list the clusters in the seed since start.
+func ObserveSeedClusterList(seed string, start time.Time) {
+	SeedClusterListDuration.With(prometheus.Labels{"seed": seed}).Observe(time.Since(start).Seconds())
+}
+
+// projectClustersCollector exports the number of clusters of the projects listed since the previous scrape. Its
+// series are reset on every scrape, so that the number of series is bounded by the projects listed in between and
+// not by all projects ever listed by the replica.
+type projectClustersCollector struct {
+	desc *prometheus.Desc
+
+	lock     sync.Mutex
+	clusters map[string]int
+}
+
+// Set records the number of clusters listed for the project.
+func (c *projectClustersCollector) Set(project string, clusters int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.clusters[project] = clusters
+}
+
+func (c *projectClustersCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *projectClustersCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.Lock()
+	clusters := c.clusters
+	c.clusters = map[string]int{}
+	c.lock.Unlock()
+
+	for project, count := range clusters {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count), project)
+	}
+}
+
+// Handler serves the metrics of the default registry in the Prometheus format. Requests need to authenticate with
+// the bearer token, as the handler is exposed on the public address of the API. Cluster-internal scrapes use the
+// internal address instead, which doesn't require a token.
+func Handler(bearerToken string) http.Handler {
+	next := promhttp.Handler()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(bearerToken)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
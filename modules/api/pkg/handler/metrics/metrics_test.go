@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"k8c.io/dashboard/v2/pkg/handler/metrics"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func TestHandler(t *testing.T) {
+	metrics.ProjectClusters.Set("testhandler", 1)
+
+	testcases := []struct {
+		Name          string
+		Authorization string
+		HTTPStatus    int
+	}{
+		{
+			Name:       "scenario 1: requests without a token are rejected",
+			HTTPStatus: http.StatusUnauthorized,
+		},
+		{
+			Name:          "scenario 2: requests with a wrong token are rejected",
+			Authorization: "Bearer wrong-token",
+			HTTPStatus:    http.StatusUnauthorized,
+		},
+		{
+			Name:          "scenario 3: the token has to be sent as bearer token",
+			Authorization: "Basic secret-token",
+			HTTPStatus:    http.StatusUnauthorized,
+		},
+		{
+			Name:          "scenario 4: requests with the token get the metrics",
+			Authorization: "Bearer secret-token",
+			HTTPStatus:    http.StatusOK,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			if tc.Authorization != "" {
+				req.Header.Set("Authorization", tc.Authorization)
+			}
+			res := httptest.NewRecorder()
+
+			metrics.Handler("secret-token").ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.HTTPStatus == http.StatusOK && !strings.Contains(res.Body.String(), `kubermatic_api_project_clusters{project="testhandler"} 1`) {
+				t.Fatalf("Expected the metrics to contain the clusters of the project, got:\n%s", res.Body.String())
+			}
+		})
+	}
+}
+
+func TestInstrumentEndpoint(t *testing.T) {
+	handler := metrics.InstrumentEndpoint("testInstrumentEndpoint", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+	}))
+
+	before := test.ScrapeMetric(t, metrics.EndpointRequestsTotalName, `code="409",endpoint="testInstrumentEndpoint"`)
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	after := test.ScrapeMetric(t, metrics.EndpointRequestsTotalName, `code="409",endpoint="testInstrumentEndpoint"`)
+
+	if after-before != 1 {
+		t.Fatalf("Expected the request counter to increase by 1, got %v -> %v", before, after)
+	}
+}
+
+func TestRecordMachineDeploymentValidationFailure(t *testing.T) {
+	testcases := []struct {
+		Name           string
+		Err            error
+		ExpectedReason string
+	}{
+		{
+			Name:           "scenario 1: bad requests are counted by reason",
+			Err:            common.WithReason(common.ReasonSizeLimit, utilerrors.NewBadRequest("too many replicas")),
+			ExpectedReason: common.ReasonSizeLimit,
+		},
+		{
+			Name:           "scenario 2: bad requests without a reason are counted as invalid",
+			Err:            utilerrors.NewBadRequest("invalid spec"),
+			ExpectedReason: metrics.ValidationFailureReasonInvalid,
+		},
+		{
+			Name: "scenario 3: other errors are not counted",
+			Err:  utilerrors.NewNotFound("MachineDeployment", "venus"),
+		},
+		{
+			Name: "scenario 4: errors without a status code are not counted",
+			Err:  errors.New("connection refused"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			endpoint := "test" + strings.ReplaceAll(tc.Name, " ", "")
+			reasons := []string{common.ReasonSizeLimit, metrics.ValidationFailureReasonInvalid}
+			before := map[string]float64{}
+			for _, reason := range reasons {
+				before[reason] = test.ScrapeMetric(t, metrics.MachineDeploymentValidationFailuresTotalName, `endpoint="`+endpoint+`",reason="`+reason+`"`)
+			}
+
+			metrics.RecordMachineDeploymentValidationFailure(endpoint, tc.Err)
+
+			for _, reason := range reasons {
+				var expected float64
+				if reason == tc.ExpectedReason {
+					expected = 1
+				}
+				after := test.ScrapeMetric(t, metrics.MachineDeploymentValidationFailuresTotalName, `endpoint="`+endpoint+`",reason="`+reason+`"`)
+				if after-before[reason] != expected {
+					t.Fatalf("Expected the %s failures to increase by %v, got %v -> %v", reason, expected, before[reason], after)
+				}
+			}
+		})
+	}
+}
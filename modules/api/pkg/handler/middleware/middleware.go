@@ -303,6 +303,10 @@ func TokenVerifier(tokenVerifier authtypes.TokenVerifier, userProvider provider.
 				return nil, err
 			}
 
+			if err := enforceRateLimit(ctx, claims.Email); err != nil {
+				return nil, err
+			}
+
 			ctx = context.WithValue(ctx, TokenExpiryContextKey, claims.Expiry)
 			return next(context.WithValue(ctx, AuthenticatedUserContextKey, user), request)
 		}
@@ -0,0 +1,314 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticcontext "k8c.io/kubermatic/v2/pkg/util/context"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+const (
+	// APIRateLimitsAnnotation holds the rate limits of the API as JSON on the KubermaticSetting.
+	APIRateLimitsAnnotation = "kubermatic.io/api-rate-limits"
+
+	// RateLimitLimitHeader is the number of requests per minute of the user.
+	RateLimitLimitHeader = "X-RateLimit-Limit"
+	// RateLimitRemainingHeader is the number of requests the user can still make in the current window.
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+	// RateLimitResetHeader is the time at which the current window ends as unix timestamp.
+	RateLimitResetHeader = "X-RateLimit-Reset"
+	// RetryAfterHeader is the number of seconds a user who exceeded the limit has to wait.
+	RetryAfterHeader = "Retry-After"
+
+	// rateLimitWindow is the window the limits are given for.
+	rateLimitWindow = time.Minute
+	// unlimitedRateLimit is reported in the X-RateLimit headers of users without a limit.
+	unlimitedRateLimit = math.MaxInt32
+
+	// rateLimitContextKey key under which the state of the rate limiting of the request is kept in the ctx.
+	rateLimitContextKey kubermaticcontext.Key = "rate-limit"
+)
+
+// RateLimitStatus is the state of the bucket of a user.
+type RateLimitStatus struct {
+	// Limit is the number of requests per window.
+	Limit int
+	// Remaining is the number of requests which can still be made in the current window.
+	Remaining int
+	// Reset is the end of the current window, it is zero if the user has no bucket.
+	Reset time.Time
+	// Allowed is whether the request which took from the bucket is allowed.
+	Allowed bool
+}
+
+// RateLimiter keeps the buckets of the users of the API. The identity is the email of a user or service account.
+type RateLimiter interface {
+	// Take takes a request from the bucket of the identity.
+	Take(ctx context.Context, identity string, limit int) (RateLimitStatus, error)
+	// Get returns the bucket of the identity without taking a request.
+	Get(ctx context.Context, identity string, limit int) (RateLimitStatus, error)
+	// Reset removes the bucket of the identity.
+	Reset(ctx context.Context, identity string) error
+}
+
+type rateLimitBucket struct {
+	count int
+	reset time.Time
+}
+
+// memoryRateLimiter is a fixed window rate limiter which keeps the buckets in memory. The buckets are not shared
+// between the replicas of the API, so the effective limit of a user is the configured limit times the number of
+// replicas the requests of the user are balanced to.
+type memoryRateLimiter struct {
+	lock      sync.Mutex
+	buckets   map[string]*rateLimitBucket
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+// NewInMemoryRateLimiter returns a rate limiter which keeps the buckets in the memory of the API replica.
+func NewInMemoryRateLimiter() RateLimiter {
+	return &memoryRateLimiter{
+		buckets: map[string]*rateLimitBucket{},
+		now:     time.Now,
+	}
+}
+
+func (l *memoryRateLimiter) Take(_ context.Context, identity string, limit int) (RateLimitStatus, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	bucket, ok := l.buckets[identity]
+	if !ok || !now.Before(bucket.reset) {
+		bucket = &rateLimitBucket{reset: now.Add(rateLimitWindow)}
+		l.buckets[identity] = bucket
+	}
+
+	allowed := bucket.count < limit
+	if allowed {
+		bucket.count++
+	}
+
+	status := bucketStatus(bucket, limit)
+	status.Allowed = allowed
+
+	return status, nil
+}
+
+func (l *memoryRateLimiter) Get(_ context.Context, identity string, limit int) (RateLimitStatus, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	bucket, ok := l.buckets[identity]
+	if !ok || !l.now().Before(bucket.reset) {
+		return RateLimitStatus{Limit: limit, Remaining: limit, Allowed: true}, nil
+	}
+
+	status := bucketStatus(bucket, limit)
+	status.Allowed = status.Remaining > 0
+
+	return status, nil
+}
+
+func (l *memoryRateLimiter) Reset(_ context.Context, identity string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	delete(l.buckets, identity)
+
+	return nil
+}
+
+// prune removes the expired buckets, at most once per window.
+func (l *memoryRateLimiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < rateLimitWindow {
+		return
+	}
+	l.lastPrune = now
+
+	for identity, bucket := range l.buckets {
+		if !now.Before(bucket.reset) {
+			delete(l.buckets, identity)
+		}
+	}
+}
+
+func bucketStatus(bucket *rateLimitBucket, limit int) RateLimitStatus {
+	remaining := limit - bucket.count
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return RateLimitStatus{Limit: limit, Remaining: remaining, Reset: bucket.reset}
+}
+
+// rateLimitState is kept in the ctx of a request, so that the rate limit is enforced once the user is known.
+type rateLimitState struct {
+	limiter          RateLimiter
+	settingsProvider provider.SettingsProvider
+	header           http.Header
+}
+
+// RateLimit is a HTTP middleware which enables the rate limiting of the authenticated users. The limit is enforced
+// by the TokenVerifier, which sets the X-RateLimit headers on the response and rejects requests over the limit with
+// 429 Too Many Requests. Users without a limit get the headers with the largest possible limit.
+func RateLimit(limiter RateLimiter, settingsProvider provider.SettingsProvider) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			state := &rateLimitState{
+				limiter:          limiter,
+				settingsProvider: settingsProvider,
+				header:           w.Header(),
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), rateLimitContextKey, state)))
+		})
+	}
+}
+
+// enforceRateLimit takes a request from the bucket of the identity. It does nothing if the request was not passed
+// through the RateLimit middleware.
+func enforceRateLimit(ctx context.Context, identity string) error {
+	state, ok := ctx.Value(rateLimitContextKey).(*rateLimitState)
+	if !ok {
+		return nil
+	}
+
+	limit, err := GetUserRateLimit(ctx, state.settingsProvider, identity)
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	if limit <= 0 {
+		setRateLimitHeaders(state.header, RateLimitStatus{
+			Limit:     unlimitedRateLimit,
+			Remaining: unlimitedRateLimit,
+			Reset:     time.Now().Add(rateLimitWindow),
+		})
+		return nil
+	}
+
+	status, err := state.limiter.Take(ctx, identity, limit)
+	if err != nil {
+		return err
+	}
+
+	setRateLimitHeaders(state.header, status)
+
+	if !status.Allowed {
+		retryAfter := int(math.Ceil(time.Until(status.Reset).Seconds()))
+		if retryAfter < 1 {
+			retryAfter = 1
+		}
+		state.header.Set(RetryAfterHeader, strconv.Itoa(retryAfter))
+
+		return utilerrors.New(http.StatusTooManyRequests, fmt.Sprintf("rate limit of %d requests per minute exceeded, retry in %d seconds", status.Limit, retryAfter))
+	}
+
+	return nil
+}
+
+func setRateLimitHeaders(header http.Header, status RateLimitStatus) {
+	header.Set(RateLimitLimitHeader, strconv.Itoa(status.Limit))
+	header.Set(RateLimitRemainingHeader, strconv.Itoa(status.Remaining))
+	header.Set(RateLimitResetHeader, strconv.FormatInt(status.Reset.Unix(), 10))
+}
+
+// GetUserRateLimit returns the number of requests per minute of the identity, zero means no limit.
+func GetUserRateLimit(ctx context.Context, settingsProvider provider.SettingsProvider, identity string) (int, error) {
+	settings, err := settingsProvider.GetGlobalSettings(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	limits, err := GetAPIRateLimits(settings)
+	if err != nil {
+		return 0, err
+	}
+
+	if limit, ok := limits.UserOverrides[identity]; ok {
+		return limit, nil
+	}
+
+	return limits.RequestsPerMinute, nil
+}
+
+// GetAPIRateLimits returns the rate limits of the API. Without the annotation there are no limits.
+func GetAPIRateLimits(settings *kubermaticv1.KubermaticSetting) (apiv2.APIRateLimits, error) {
+	limits := apiv2.APIRateLimits{}
+
+	value, ok := settings.Annotations[APIRateLimitsAnnotation]
+	if !ok || value == "" {
+		return limits, nil
+	}
+	if err := json.Unmarshal([]byte(value), &limits); err != nil {
+		return limits, fmt.Errorf("failed to parse API rate limits: %w", err)
+	}
+
+	return limits, nil
+}
+
+// SetAPIRateLimits sets the rate limits of the API on the settings. Empty limits remove the annotation.
+func SetAPIRateLimits(settings *kubermaticv1.KubermaticSetting, limits *apiv2.APIRateLimits) error {
+	if limits == nil || (limits.RequestsPerMinute == 0 && len(limits.UserOverrides) == 0) {
+		delete(settings.Annotations, APIRateLimitsAnnotation)
+		return nil
+	}
+
+	value, err := json.Marshal(limits)
+	if err != nil {
+		return err
+	}
+	if settings.Annotations == nil {
+		settings.Annotations = map[string]string{}
+	}
+	settings.Annotations[APIRateLimitsAnnotation] = string(value)
+
+	return nil
+}
+
+// ValidateAPIRateLimits checks that no limit is negative.
+func ValidateAPIRateLimits(limits *apiv2.APIRateLimits) error {
+	if limits == nil {
+		return nil
+	}
+	if limits.RequestsPerMinute < 0 {
+		return errors.New("API rate limit must not be negative")
+	}
+	for user, limit := range limits.UserOverrides {
+		if limit < 0 {
+			return fmt.Errorf("API rate limit of user %q must not be negative", user)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeSettingsProvider struct {
+	settings *kubermaticv1.KubermaticSetting
+}
+
+func (p *fakeSettingsProvider) GetGlobalSettings(_ context.Context) (*kubermaticv1.KubermaticSetting, error) {
+	return p.settings, nil
+}
+
+func (p *fakeSettingsProvider) UpdateGlobalSettings(_ context.Context, _ *provider.UserInfo, settings *kubermaticv1.KubermaticSetting) (*kubermaticv1.KubermaticSetting, error) {
+	p.settings = settings
+	return settings, nil
+}
+
+func genRateLimitSettings(limits string) *kubermaticv1.KubermaticSetting {
+	settings := &kubermaticv1.KubermaticSetting{ObjectMeta: metav1.ObjectMeta{Name: kubermaticv1.GlobalSettingsName}}
+	if limits != "" {
+		settings.Annotations = map[string]string{APIRateLimitsAnnotation: limits}
+	}
+	return settings
+}
+
+// newRateLimitTestHandler returns a handler which enforces the rate limit of the user given in the X-User header,
+// like the TokenVerifier does for the authenticated user.
+func newRateLimitTestHandler(limiter RateLimiter, settings *kubermaticv1.KubermaticSetting) http.Handler {
+	return RateLimit(limiter, &fakeSettingsProvider{settings: settings})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := enforceRateLimit(r.Context(), r.Header.Get("X-User")); err != nil {
+			common.WriteHTTPError(zap.NewNop().Sugar(), w, err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func doRateLimitTestRequest(handler http.Handler, user string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/v2/projects", nil)
+	req.Header.Set("X-User", user)
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+	return res
+}
+
+func TestRateLimit(t *testing.T) {
+	testcases := []struct {
+		name               string
+		limits             string
+		user               string
+		requests           int
+		expectedStatus     int
+		expectedLimit      string
+		expectedRemaining  string
+		expectedRetryAfter bool
+	}{
+		{
+			name:              "scenario 1: requests within the limit are allowed",
+			limits:            `{"requestsPerMinute":3}`,
+			user:              "bob@acme.com",
+			requests:          3,
+			expectedStatus:    http.StatusOK,
+			expectedLimit:     "3",
+			expectedRemaining: "0",
+		},
+		{
+			name:               "scenario 2: requests over the limit are rejected",
+			limits:             `{"requestsPerMinute":3}`,
+			user:               "bob@acme.com",
+			requests:           4,
+			expectedStatus:     http.StatusTooManyRequests,
+			expectedLimit:      "3",
+			expectedRemaining:  "0",
+			expectedRetryAfter: true,
+		},
+		{
+			name:              "scenario 3: the override of the user takes precedence over the default limit",
+			limits:            `{"requestsPerMinute":3,"userOverrides":{"bob@acme.com":10}}`,
+			user:              "bob@acme.com",
+			requests:          4,
+			expectedStatus:    http.StatusOK,
+			expectedLimit:     "10",
+			expectedRemaining: "6",
+		},
+		{
+			name:               "scenario 4: the override only applies to the user",
+			limits:             `{"requestsPerMinute":3,"userOverrides":{"bob@acme.com":10}}`,
+			user:               "john@acme.com",
+			requests:           4,
+			expectedStatus:     http.StatusTooManyRequests,
+			expectedLimit:      "3",
+			expectedRemaining:  "0",
+			expectedRetryAfter: true,
+		},
+		{
+			name:              "scenario 5: an override of zero exempts the user",
+			limits:            `{"requestsPerMinute":3,"userOverrides":{"serviceaccount-abcd@acme.com":0}}`,
+			user:              "serviceaccount-abcd@acme.com",
+			requests:          4,
+			expectedStatus:    http.StatusOK,
+			expectedLimit:     "2147483647",
+			expectedRemaining: "2147483647",
+		},
+		{
+			name:              "scenario 6: requests are not limited without limits",
+			user:              "bob@acme.com",
+			requests:          4,
+			expectedStatus:    http.StatusOK,
+			expectedLimit:     "2147483647",
+			expectedRemaining: "2147483647",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newRateLimitTestHandler(NewInMemoryRateLimiter(), genRateLimitSettings(tc.limits))
+
+			var res *httptest.ResponseRecorder
+			for i := 0; i < tc.requests; i++ {
+				res = doRateLimitTestRequest(handler, tc.user)
+			}
+
+			if res.Code != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.expectedStatus, res.Code, res.Body.String())
+			}
+			if limit := res.Header().Get(RateLimitLimitHeader); limit != tc.expectedLimit {
+				t.Errorf("expected %s header %q, got %q", RateLimitLimitHeader, tc.expectedLimit, limit)
+			}
+			if remaining := res.Header().Get(RateLimitRemainingHeader); remaining != tc.expectedRemaining {
+				t.Errorf("expected %s header %q, got %q", RateLimitRemainingHeader, tc.expectedRemaining, remaining)
+			}
+			if reset := res.Header().Get(RateLimitResetHeader); (reset != "") != (tc.expectedLimit != "") {
+				t.Errorf("unexpected %s header %q", RateLimitResetHeader, reset)
+			}
+			if retryAfter := res.Header().Get(RetryAfterHeader); (retryAfter != "") != tc.expectedRetryAfter {
+				t.Errorf("unexpected %s header %q", RetryAfterHeader, retryAfter)
+			}
+		})
+	}
+}
+
+func TestRateLimitReset(t *testing.T) {
+	limiter := NewInMemoryRateLimiter()
+	handler := newRateLimitTestHandler(limiter, genRateLimitSettings(`{"requestsPerMinute":1}`))
+
+	if res := doRateLimitTestRequest(handler, "bob@acme.com"); res.Code != http.StatusOK {
+		t.Fatalf("expected the first request to be allowed, got %d", res.Code)
+	}
+	if res := doRateLimitTestRequest(handler, "bob@acme.com"); res.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected the second request to be rejected, got %d", res.Code)
+	}
+
+	status, err := limiter.Get(context.Background(), "bob@acme.com", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Remaining != 0 || status.Reset.IsZero() {
+		t.Fatalf("expected an exhausted bucket, got %+v", status)
+	}
+
+	if err := limiter.Reset(context.Background(), "bob@acme.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	status, err = limiter.Get(context.Background(), "bob@acme.com", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Remaining != 1 || !status.Reset.IsZero() {
+		t.Fatalf("expected a full bucket after the reset, got %+v", status)
+	}
+	if res := doRateLimitTestRequest(handler, "bob@acme.com"); res.Code != http.StatusOK {
+		t.Fatalf("expected the request after the reset to be allowed, got %d", res.Code)
+	}
+}
+
+func TestInMemoryRateLimiterWindow(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := &memoryRateLimiter{
+		buckets: map[string]*rateLimitBucket{},
+		now:     func() time.Time { return now },
+	}
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if status, _ := limiter.Take(ctx, "bob@acme.com", 2); !status.Allowed {
+			t.Fatalf("expected request %d to be allowed", i+1)
+		}
+	}
+	status, _ := limiter.Take(ctx, "bob@acme.com", 2)
+	if status.Allowed {
+		t.Fatal("expected the request over the limit to be rejected")
+	}
+	if !status.Reset.Equal(now.Add(time.Minute)) {
+		t.Fatalf("expected the window to end at %v, got %v", now.Add(time.Minute), status.Reset)
+	}
+
+	now = now.Add(time.Minute)
+	status, _ = limiter.Take(ctx, "bob@acme.com", 2)
+	if !status.Allowed || status.Remaining != 1 {
+		t.Fatalf("expected a new window after the reset time, got %+v", status)
+	}
+
+	now = now.Add(2 * time.Minute)
+	_, _ = limiter.Take(ctx, "john@acme.com", 2)
+	if _, ok := limiter.buckets["bob@acme.com"]; ok {
+		t.Fatal("expected the expired bucket to be pruned")
+	}
+}
@@ -455,7 +455,8 @@ func (r Routing) RegisterV1(mux *mux.Router, metrics common.ServerMetrics) {
 // swagger:route GET /api/v1/projects/{project_id}/sshkeys project listSSHKeys
 //
 //	Lists SSH Keys that belong to the given project.
-//	The returned collection is sorted by creation timestamp.
+//	The returned collection is sorted by creation timestamp. If query parameter `show_usage` is set to `true`
+//	then the endpoint will also return the clusters each key is assigned to.
 //
 //	Produces:
 //	- application/json
@@ -470,7 +471,7 @@ func (r Routing) listSSHKeys() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(ssh.ListEndpoint(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.features)),
+		)(ssh.ListEndpoint(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.seedsGetter, r.clusterProviderGetter, r.features)),
 		ssh.DecodeListReq,
 		EncodeJSON,
 		r.defaultServerOptions()...,
@@ -506,7 +507,8 @@ func (r Routing) createSSHKey() http.Handler {
 
 // swagger:route DELETE /api/v1/projects/{project_id}/sshkeys/{key_id} project deleteSSHKey
 //
-//	Removes the given SSH Key from the system.
+//	Removes the given SSH Key from the system. Keys which are still assigned to clusters can only be
+//	removed if query parameter `force` is set to `true`.
 //
 //	Produces:
 //	- application/json
@@ -516,12 +518,13 @@ func (r Routing) createSSHKey() http.Handler {
 //	  200: empty
 //	  401: empty
 //	  403: empty
+//	  409: errorResponse
 func (r Routing) deleteSSHKey() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(ssh.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.features)),
+		)(ssh.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.seedsGetter, r.clusterProviderGetter, r.features)),
 		ssh.DecodeDeleteReq,
 		EncodeJSON,
 		r.defaultServerOptions()...,
@@ -985,8 +988,8 @@ func (r Routing) createCluster() http.Handler {
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.CreateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.presetProvider,
-			r.exposeStrategy, r.userInfoGetter, r.settingsProvider, r.caBundle, r.kubermaticConfigGetter, r.features)),
+		)(cluster.CreateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.clusterProviderGetter, r.presetProvider,
+			r.exposeStrategy, r.userInfoGetter, r.settingsProvider, r.caBundle, r.kubermaticConfigGetter, r.features, r.webhookNotifier)),
 		cluster.DecodeCreateReq,
 		SetStatusCreatedHeader(EncodeJSON),
 		r.defaultServerOptions()...,
@@ -1195,7 +1198,7 @@ func (r Routing) deleteCluster() http.Handler {
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(cluster.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.webhookNotifier)),
 		cluster.DecodeDeleteReq,
 		EncodeJSON,
 		r.defaultServerOptions()...,
@@ -1527,7 +1530,7 @@ func (r Routing) editUserInProject() http.Handler {
 
 // swagger:route DELETE /api/v1/projects/{project_id}/users/{user_id} users deleteUserFromProject
 //
-//	Removes the given member from the project
+//	Removes the given member from the project and revokes the access of the member to the clusters of the project
 //
 //	Consumes:
 //	- application/json
@@ -1537,7 +1540,7 @@ func (r Routing) editUserInProject() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: User
+//	  200: ProjectMemberRemoval
 //	  401: empty
 //	  403: empty
 func (r Routing) deleteUserFromProject() http.Handler {
@@ -1545,7 +1548,7 @@ func (r Routing) deleteUserFromProject() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(user.DeleteEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userProvider, r.projectMemberProvider, r.privilegedProjectMemberProvider, r.userInfoGetter)),
+		)(user.DeleteEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userProvider, r.projectMemberProvider, r.privilegedProjectMemberProvider, r.userInfoGetter, r.seedsGetter, r.clusterProviderGetter)),
 		user.DecodeDeleteReq,
 		EncodeJSON,
 		r.defaultServerOptions()...,
@@ -1928,7 +1931,7 @@ func (r Routing) createNodeDeployment() http.Handler {
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(node.CreateNodeDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider)),
+		)(node.CreateNodeDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.clusterProviderGetter, r.caBundle)),
 		node.DecodeCreateNodeDeployment,
 		SetStatusCreatedHeader(EncodeJSON),
 		r.defaultServerOptions()...,
@@ -1954,7 +1957,7 @@ func (r Routing) listNodeDeployments() http.Handler {
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(node.ListNodeDeployments(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(node.ListNodeDeployments(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.seedsGetter)),
 		node.DecodeListNodeDeployments,
 		EncodeJSON,
 		r.defaultServerOptions()...,
@@ -1980,7 +1983,7 @@ func (r Routing) getNodeDeployment() http.Handler {
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(node.GetNodeDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(node.GetNodeDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.seedsGetter)),
 		node.DecodeGetNodeDeployment,
 		EncodeJSON,
 		r.defaultServerOptions()...,
@@ -2089,7 +2092,7 @@ func (r Routing) patchNodeDeployment() http.Handler {
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(node.PatchNodeDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider)),
+		)(node.PatchNodeDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.clusterProviderGetter, r.caBundle)),
 		node.DecodePatchNodeDeployment,
 		EncodeJSON,
 		r.defaultServerOptions()...,
@@ -2201,7 +2204,7 @@ func (r Routing) createAddon() http.Handler {
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
 			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
-		)(addon.CreateAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(addon.CreateAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.addonConfigProvider)),
 		addon.DecodeCreateAddon,
 		SetStatusCreatedHeader(EncodeJSON),
 		r.defaultServerOptions()...,
@@ -2288,7 +2291,7 @@ func (r Routing) patchAddon() http.Handler {
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
 			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
-		)(addon.PatchAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(addon.PatchAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.addonConfigProvider)),
 		addon.DecodePatchAddon,
 		EncodeJSON,
 		r.defaultServerOptions()...,
@@ -52,6 +52,15 @@ func (r Routing) RegisterV1Admin(mux *mux.Router) {
 		Path("/admin/settings").
 		Handler(r.patchKubermaticSettings())
 
+	// Defines a set of HTTP endpoints for the rate limits of the users
+	mux.Methods(http.MethodGet).
+		Path("/admin/ratelimits/{user_email}").
+		Handler(r.getUserRateLimit())
+
+	mux.Methods(http.MethodDelete).
+		Path("/admin/ratelimits/{user_email}").
+		Handler(r.resetUserRateLimit())
+
 	// Defines a set of HTTP endpoints for the admission plugins
 	mux.Methods(http.MethodGet).
 		Path("/admin/admission/plugins").
@@ -205,6 +214,58 @@ func (r Routing) patchKubermaticSettings() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v1/admin/ratelimits/{user_email} admin getUserRateLimit
+//
+//	Returns the current rate limit bucket of the user.
+//
+//	The buckets are kept per API replica, so the bucket of the replica which serves the request is returned.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: APIRateLimitBucket
+//	  401: empty
+//	  403: empty
+func (r Routing) getUserRateLimit() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.GetUserRateLimitEndpoint(r.userInfoGetter, r.settingsProvider, r.rateLimiter)),
+		admin.DecodeUserRateLimitReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v1/admin/ratelimits/{user_email} admin resetUserRateLimit
+//
+//	Resets the current rate limit bucket of the user.
+//
+//	The buckets are kept per API replica, so only the bucket of the replica which serves the request is reset.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) resetUserRateLimit() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.ResetUserRateLimitEndpoint(r.userInfoGetter, r.rateLimiter)),
+		admin.DecodeUserRateLimitReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v1/admin admin getAdmins
 //
 //	Returns list of admin users.
@@ -662,7 +723,7 @@ func (r Routing) UpdateMeteringReportConfiguration() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: empty
+//	  200: MeteringReportConfigurationDeletion
 //	  401: empty
 //	  403: empty
 func (r Routing) DeleteMeteringReportConfiguration() http.Handler {
@@ -670,7 +731,7 @@ func (r Routing) DeleteMeteringReportConfiguration() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(admin.DeleteMeteringReportConfigurationEndpoint(r.userInfoGetter, r.seedsGetter, r.masterClient)),
+		)(admin.DeleteMeteringReportConfigurationEndpoint(r.userInfoGetter, r.seedsGetter, r.seedsClientGetter, r.masterClient)),
 		admin.DecodeDeleteMeteringReportConfigurationReq,
 		EncodeJSON,
 		r.defaultServerOptions()...,
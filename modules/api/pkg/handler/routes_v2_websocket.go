@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	wsh "k8c.io/dashboard/v2/pkg/handler/websocket"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/watcher"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/log"
+	kubermaticcontext "k8c.io/kubermatic/v2/pkg/util/context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type WebsocketMachineDeploymentsWriter func(ctx context.Context, ws *websocket.Conn, client ctrlruntimeclient.WithWatch, userInfo *provider.UserInfo)
+
+// clusterDeletionCheckInterval is how often websocket connections of a cluster check whether it is being deleted.
+const clusterDeletionCheckInterval = 10 * time.Second
+
+func (r Routing) RegisterV2Websocket(mux *mux.Router) {
+	providers := getProviders(r)
+
+	mux.HandleFunc("/ws/projects/{project_id}/clusters/{cluster_id}/machinedeployments", getMachineDeploymentsWatchHandler(wsh.WriteMachineDeployments, providers, r))
+}
+
+// getMachineDeploymentsWatchHandler streams the machine deployments of the cluster to project members, including
+// viewers. The user cluster is accessed with the permissions of the user, like the REST endpoints do.
+func getMachineDeploymentsWatchHandler(writer WebsocketMachineDeploymentsWriter, providers watcher.Providers, routing Routing) func(w http.ResponseWriter, req *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+
+		authenticatedUser, err := verifyAuthorizationToken(req, routing.tokenVerifiers, routing.tokenExtractors)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+
+		clusterID, err := common.DecodeClusterID(ctx, req)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+
+		projectReq, err := common.DecodeProjectRequest(ctx, req)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+		projectID := projectReq.(common.ProjectReq).ProjectID
+
+		clusterProvider, ctx, err := middleware.GetClusterProvider(ctx, terminalReq{ClusterID: clusterID}, providers.SeedsGetter, providers.ClusterProviderGetter)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+		privilegedClusterProvider := clusterProvider.(provider.PrivilegedClusterProvider)
+
+		user, err := providers.UserProvider.UserByEmail(ctx, authenticatedUser.Email)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+		ctx = context.WithValue(ctx, middleware.ClusterProviderContextKey, clusterProvider)
+		ctx = context.WithValue(ctx, middleware.PrivilegedClusterProviderContextKey, privilegedClusterProvider)
+		ctx = context.WithValue(ctx, kubermaticcontext.UserCRContextKey, user)
+
+		cluster, err := handlercommon.GetCluster(ctx, providers.ProjectProvider, providers.PrivilegedProjectProvider, providers.UserInfoGetter, projectID, clusterID, nil)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+
+		userInfo, err := providers.UserInfoGetter(ctx, "")
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+		if !userInfo.IsAdmin {
+			if userInfo, err = providers.UserInfoGetter(ctx, projectID); err != nil {
+				log.Logger.Debug(err)
+				return
+			}
+		}
+
+		client, err := clusterProvider.GetWatchClientForUserCluster(ctx, userInfo, cluster)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+
+		ws, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+		defer ws.Close()
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go cancelOnClusterDeletion(ctx, cancel, privilegedClusterProvider.GetSeedClusterAdminRuntimeClient(), cluster.Name)
+
+		writer(ctx, ws, client, userInfo)
+	}
+}
+
+// cancelOnClusterDeletion cancels the context once the cluster is being deleted, so that the websocket connections
+// of the cluster are closed.
+func cancelOnClusterDeletion(ctx context.Context, cancel context.CancelFunc, client ctrlruntimeclient.Client, clusterName string) {
+	_ = wait.PollUntilContextCancel(ctx, clusterDeletionCheckInterval, false, func(ctx context.Context) (bool, error) {
+		cluster := &kubermaticv1.Cluster{}
+		if err := client.Get(ctx, ctrlruntimeclient.ObjectKey{Name: clusterName}, cluster); err != nil {
+			return apierrors.IsNotFound(err), nil
+		}
+		return cluster.DeletionTimestamp != nil, nil
+	})
+	cancel()
+}
@@ -34,6 +34,7 @@ import (
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
 	"k8c.io/dashboard/v2/pkg/serviceaccount"
 	"k8c.io/dashboard/v2/pkg/watcher"
+	"k8c.io/dashboard/v2/pkg/webhook"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/features"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
@@ -85,6 +86,8 @@ type Routing struct {
 	seedProvider                          provider.SeedProvider
 	resourceQuotaProvider                 provider.ResourceQuotaProvider
 	oidcIssuerVerifierGetter              provider.OIDCIssuerVerifierGetter
+	webhookNotifier                       *webhook.Notifier
+	rateLimiter                           middleware.RateLimiter
 }
 
 // NewRouting creates a new Routing.
@@ -132,6 +135,8 @@ func NewRouting(routingParams RoutingParams, masterClient ctrlruntimeclient.Clie
 		seedProvider:                          routingParams.SeedProvider,
 		resourceQuotaProvider:                 routingParams.ResourceQuotaProvider,
 		oidcIssuerVerifierGetter:              routingParams.OIDCIssuerVerifierProviderGetter,
+		webhookNotifier:                       routingParams.WebhookNotifier,
+		rateLimiter:                           routingParams.RateLimiter,
 	}
 }
 
@@ -234,7 +239,11 @@ type RoutingParams struct {
 	ApplicationDefinitionProvider                  provider.ApplicationDefinitionProvider
 	PrivilegedOperatingSystemProfileProviderGetter provider.PrivilegedOperatingSystemProfileProviderGetter
 	OIDCIssuerVerifierProviderGetter               provider.OIDCIssuerVerifierGetter
+	PrivilegedWebhookProvider                      provider.PrivilegedWebhookProvider
+	WebhookNotifier                                *webhook.Notifier
+	PriceCatalog                                   provider.PriceCatalog
 	Versions                                       kubermatic.Versions
 	CABundle                                       *x509.CertPool
 	Features                                       features.FeatureGate
+	RateLimiter                                    middleware.RateLimiter
 }
@@ -31,10 +31,18 @@ import (
 	"k8c.io/kubermatic/v2/pkg/defaulting"
 	"k8c.io/machine-controller/sdk/providerconfig"
 
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakerestclient "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -139,6 +147,8 @@ func createError(status int32, message string) error {
 type FakeExternalClusterProvider struct {
 	Provider   *kubernetes.ExternalClusterProvider
 	FakeClient ctrlruntimeclient.Client
+	// ClusterClient is used for all requests to the external cluster itself.
+	ClusterClient ctrlruntimeclient.Client
 }
 
 var _ provider.ExternalClusterProvider = &FakeExternalClusterProvider{}
@@ -148,12 +158,18 @@ func (p *FakeExternalClusterProvider) CreateOrUpdateCredentialSecretForCluster(c
 }
 
 func (p *FakeExternalClusterProvider) IsMetricServerAvailable(ctx context.Context, masterClient ctrlruntimeclient.Client, cluster *kubermaticv1.ExternalCluster) (bool, error) {
+	if err := p.ClusterClient.List(ctx, &v1beta1.NodeMetricsList{}); err != nil {
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, err
+	}
 	return true, nil
 }
 
 func (p *FakeExternalClusterProvider) GetNode(ctx context.Context, masterClient ctrlruntimeclient.Client, cluster *kubermaticv1.ExternalCluster, nodeName string) (*corev1.Node, error) {
 	node := &corev1.Node{}
-	if err := p.FakeClient.Get(ctx, ctrlruntimeclient.ObjectKey{Name: nodeName}, node); err != nil {
+	if err := p.ClusterClient.Get(ctx, ctrlruntimeclient.ObjectKey{Name: nodeName}, node); err != nil {
 		return nil, err
 	}
 
@@ -162,7 +178,7 @@ func (p *FakeExternalClusterProvider) GetNode(ctx context.Context, masterClient
 
 func (p *FakeExternalClusterProvider) ListNodes(ctx context.Context, masterClient ctrlruntimeclient.Client, cluster *kubermaticv1.ExternalCluster) (*corev1.NodeList, error) {
 	nodes := &corev1.NodeList{}
-	if err := p.FakeClient.List(ctx, nodes); err != nil {
+	if err := p.ClusterClient.List(ctx, nodes); err != nil {
 		return nil, err
 	}
 
@@ -186,7 +202,7 @@ func (p *FakeExternalClusterProvider) GetUserBasedMasterClient(ctx context.Conte
 }
 
 func (p *FakeExternalClusterProvider) GetClient(ctx context.Context, masterClient ctrlruntimeclient.Client, cluster *kubermaticv1.ExternalCluster) (ctrlruntimeclient.Client, error) {
-	return p.FakeClient, nil
+	return p.ClusterClient, nil
 }
 
 func (p *FakeExternalClusterProvider) List(ctx context.Context, project *kubermaticv1.Project) (*kubermaticv1.ExternalClusterList, error) {
@@ -209,6 +225,24 @@ func (p *FakeExternalClusterProvider) ValidateKubeconfig(_ context.Context, _ []
 	return nil
 }
 
+// CheckKubeconfig runs the checks against a fake cluster, in which the credentials of every usable kubeconfig
+// have all permissions.
+func (p *FakeExternalClusterProvider) CheckKubeconfig(ctx context.Context, kubeconfig []byte) *apiv2.ExternalClusterCheckReport {
+	if _, err := kubernetes.LoadKubeconfigForCheck(kubeconfig); err != nil {
+		return kubernetes.KubeconfigCheckFailed(err)
+	}
+
+	client := fakerestclient.NewSimpleClientset()
+	client.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: "v1.31.1"}
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		review := action.(clienttesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = true
+		return true, review, nil
+	})
+
+	return kubernetes.CheckClusterAccess(ctx, client)
+}
+
 func (p *FakeExternalClusterProvider) CreateOrUpdateKubeconfigSecretForCluster(ctx context.Context, cluster *kubermaticv1.ExternalCluster, kubeconfig []byte) error {
 	return p.Provider.CreateOrUpdateKubeconfigSecretForCluster(ctx, cluster, kubeconfig)
 }
@@ -230,7 +264,19 @@ func (p *FakeExternalClusterProvider) New(ctx context.Context, userInfo *provide
 }
 
 func (p *FakeExternalClusterProvider) GetProviderPoolNodes(ctx context.Context, masterClient ctrlruntimeclient.Client, cluster *kubermaticv1.ExternalCluster, providerNodeLabel, providerNodePoolName string) ([]corev1.Node, error) {
-	return p.Provider.GetProviderPoolNodes(ctx, masterClient, cluster, providerNodeLabel, providerNodePoolName)
+	nodes, err := p.ListNodes(ctx, masterClient, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	var poolNodes []corev1.Node
+	for _, node := range nodes.Items {
+		if node.Labels[providerNodeLabel] == providerNodePoolName {
+			poolNodes = append(poolNodes, node)
+		}
+	}
+
+	return poolNodes, nil
 }
 
 type FakeConstraintTemplateProvider struct {
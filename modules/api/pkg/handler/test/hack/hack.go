@@ -24,13 +24,16 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"k8c.io/dashboard/v2/pkg/handler"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	v2 "k8c.io/dashboard/v2/pkg/handler/v2"
 	"k8c.io/dashboard/v2/pkg/provider"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
 	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/provider/pricing"
 	"k8c.io/dashboard/v2/pkg/serviceaccount"
 	"k8c.io/dashboard/v2/pkg/watcher"
+	"k8c.io/dashboard/v2/pkg/webhook"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/features"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
@@ -100,6 +103,7 @@ func NewTestRouting(
 	privilegedIPAMPoolProviderGetter provider.PrivilegedIPAMPoolProviderGetter,
 	privilegedOperatingSystemProfileProviderGetter provider.PrivilegedOperatingSystemProfileProviderGetter,
 	fakeOIDCVerifierIssuerGetter provider.OIDCIssuerVerifierGetter,
+	webhookProvider provider.PrivilegedWebhookProvider,
 	features features.FeatureGate) http.Handler {
 	routingParams := handler.RoutingParams{
 		Log:                                            kubermaticlog.Logger,
@@ -162,6 +166,10 @@ func NewTestRouting(
 		PrivilegedIPAMPoolProviderGetter:               privilegedIPAMPoolProviderGetter,
 		PrivilegedOperatingSystemProfileProviderGetter: privilegedOperatingSystemProfileProviderGetter,
 		OIDCIssuerVerifierProviderGetter:               fakeOIDCVerifierIssuerGetter,
+		PrivilegedWebhookProvider:                      webhookProvider,
+		WebhookNotifier:                                webhook.NewNotifier(webhookProvider, kubermaticlog.Logger),
+		PriceCatalog:                                   pricing.NewStaticCatalog(),
+		RateLimiter:                                    middleware.NewInMemoryRateLimiter(),
 	}
 
 	r := handler.NewRouting(routingParams, masterClient)
@@ -174,6 +182,7 @@ func NewTestRouting(
 	r.RegisterV1Optional(v1Router, true)
 	r.RegisterV1Admin(v1Router)
 	r.RegisterV1Websocket(v1Router)
+	r.RegisterV2Websocket(v2Router)
 	rv2.RegisterV2(v2Router, true)
 	return mainRouter
 }
@@ -26,6 +26,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"sort"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -39,6 +40,7 @@ import (
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	"k8c.io/dashboard/v2/pkg/handler/auth"
 	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/metrics"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	handlerv1common "k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/handler/v2/etcdbackupconfig"
@@ -81,6 +83,7 @@ import (
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	"k8s.io/utils/ptr"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 var testScheme = fake.NewScheme()
@@ -218,6 +221,7 @@ type newRoutingFunc func(
 	privilegedIPAMPoolProviderGetter provider.PrivilegedIPAMPoolProviderGetter,
 	privilegedOperatingSystemProfileProviderGetter provider.PrivilegedOperatingSystemProfileProviderGetter,
 	oidcIssuerVerifierGetter provider.OIDCIssuerVerifierGetter,
+	webhookProvider provider.PrivilegedWebhookProvider,
 	features features.FeatureGate,
 ) http.Handler
 
@@ -230,7 +234,7 @@ func getRuntimeObjects(objs ...ctrlruntimeclient.Object) []runtime.Object {
 	return runtimeObjects
 }
 
-func initTestEndpoint(user apiv1.User, seedsGetter provider.SeedsGetter, kubeObjects, machineObjects, kubermaticObjects []ctrlruntimeclient.Object, kubermaticConfiguration *kubermaticv1.KubermaticConfiguration, routingFunc newRoutingFunc) (http.Handler, *ClientsSets, error) {
+func initTestEndpoint(user apiv1.User, seedsGetter provider.SeedsGetter, kubeObjects, machineObjects, kubermaticObjects []ctrlruntimeclient.Object, kubermaticConfiguration *kubermaticv1.KubermaticConfiguration, routingFunc newRoutingFunc, userClusterInterceptor *interceptor.Funcs, userClusterObjects map[string][]ctrlruntimeclient.Object) (http.Handler, *ClientsSets, error) {
 	ctx := context.Background()
 
 	allObjects := kubeObjects
@@ -282,6 +286,7 @@ func initTestEndpoint(user apiv1.User, seedsGetter provider.SeedsGetter, kubeObj
 		WithScheme(testScheme).
 		WithObjects(allObjects...).
 		WithIndex(&corev1.Event{}, handlerv1common.EventFieldIndexerKey, handlerv1common.EventIndexer()).
+		WithIndex(&corev1.Pod{}, handlerv1common.PodNodeNameFieldIndexerKey, handlerv1common.PodNodeNameIndexer()).
 		Build()
 	kubernetesClient := fakerestclient.NewSimpleClientset(getRuntimeObjects(kubeObjects...)...)
 	fakeImpersonationClient := func(impCfg restclient.ImpersonationConfig) (ctrlruntimeclient.Client, error) {
@@ -354,7 +359,15 @@ func initTestEndpoint(user apiv1.User, seedsGetter provider.SeedsGetter, kubeObj
 	}
 
 	kubermaticVersions := kubermatic.GetFakeVersions()
-	fUserClusterConnection := &fakeUserClusterConnection{fakeClient}
+	var userClusterClient ctrlruntimeclient.Client = fakeClient
+	if userClusterInterceptor != nil {
+		userClusterClient = interceptor.NewClient(fakeClient, *userClusterInterceptor)
+	}
+	clusterClients := make(map[string]ctrlruntimeclient.Client, len(userClusterObjects))
+	for clusterID, objects := range userClusterObjects {
+		clusterClients[clusterID] = fake.NewClientBuilder().WithScheme(testScheme).WithObjects(objects...).Build()
+	}
+	fUserClusterConnection := &fakeUserClusterConnection{userClusterClient, clusterClients}
 	clusterProvider := kubernetes.NewClusterProvider(
 		&restclient.Config{},
 		fakeImpersonationClient,
@@ -414,8 +427,9 @@ func initTestEndpoint(user apiv1.User, seedsGetter provider.SeedsGetter, kubeObj
 		return nil, nil, err
 	}
 	fakeExternalClusterProvider := &FakeExternalClusterProvider{
-		Provider:   externalClusterProvider,
-		FakeClient: fakeClient,
+		Provider:      externalClusterProvider,
+		FakeClient:    fakeClient,
+		ClusterClient: userClusterClient,
 	}
 
 	constraintTemplateProvider, err := kubernetes.NewConstraintTemplateProvider(fakeImpersonationClient, fakeClient)
@@ -642,6 +656,7 @@ func initTestEndpoint(user apiv1.User, seedsGetter provider.SeedsGetter, kubeObj
 		privilegedIPAMPoolProviderGetter,
 		privilegedOperatingSystemProfileProviderGetter,
 		fakeOIDCVerifierIssuerGetter,
+		kubernetes.NewPrivilegedWebhookProvider(fakeClient),
 		featureGates,
 	)
 
@@ -650,7 +665,25 @@ func initTestEndpoint(user apiv1.User, seedsGetter provider.SeedsGetter, kubeObj
 
 // CreateTestEndpointAndGetClients is a convenience function that instantiates fake providers and sets up routes for the tests.
 func CreateTestEndpointAndGetClients(user apiv1.User, seedsGetter provider.SeedsGetter, kubeObjects, machineObjects, kubermaticObjects []ctrlruntimeclient.Object, config *kubermaticv1.KubermaticConfiguration, routingFunc newRoutingFunc) (http.Handler, *ClientsSets, error) {
-	return initTestEndpoint(user, seedsGetter, kubeObjects, machineObjects, kubermaticObjects, config, routingFunc)
+	return initTestEndpoint(user, seedsGetter, kubeObjects, machineObjects, kubermaticObjects, config, routingFunc, nil, nil)
+}
+
+// CreateTestEndpointWithUserClusterInterceptor does exactly the same as CreateTestEndpoint except all requests to the
+// user cluster or an external cluster go through the given interceptor functions. It allows to simulate failing user clusters.
+func CreateTestEndpointWithUserClusterInterceptor(
+	user apiv1.User, kubeObjects, kubermaticObjects []ctrlruntimeclient.Object, config *kubermaticv1.KubermaticConfiguration, routingFunc newRoutingFunc, funcs interceptor.Funcs,
+) (http.Handler, error) {
+	router, _, err := initTestEndpoint(user, nil, kubeObjects, nil, kubermaticObjects, config, routingFunc, &funcs, nil)
+	return router, err
+}
+
+// CreateTestEndpointWithUserClusterObjects does exactly the same as CreateTestEndpoint except the clusters with the
+// given IDs get their own user cluster client with the given objects, instead of sharing the client of the seed.
+func CreateTestEndpointWithUserClusterObjects(
+	user apiv1.User, kubeObjects, kubermaticObjects []ctrlruntimeclient.Object, userClusterObjects map[string][]ctrlruntimeclient.Object, config *kubermaticv1.KubermaticConfiguration, routingFunc newRoutingFunc,
+) (http.Handler, error) {
+	router, _, err := initTestEndpoint(user, nil, kubeObjects, nil, kubermaticObjects, config, routingFunc, nil, userClusterObjects)
+	return router, err
 }
 
 // CreateTestEndpoint does exactly the same as CreateTestEndpointAndGetClients except it omits ClientsSets when returning.
@@ -786,6 +819,8 @@ func CreateTestSeedsGetter(ctx context.Context, client ctrlruntimeclient.Client)
 
 type fakeUserClusterConnection struct {
 	fakeDynamicClient ctrlruntimeclient.Client
+	// clusterClients are the clients of clusters with their own objects by cluster ID.
+	clusterClients map[string]ctrlruntimeclient.Client
 }
 
 func (f *fakeUserClusterConnection) GetK8sClient(_ context.Context, _ *kubermaticv1.Cluster, _ ...k8cuserclusterclient.ConfigOption) (kubernetesclientset.Interface, error) {
@@ -796,7 +831,12 @@ func (f *fakeUserClusterConnection) GetClientConfig(_ context.Context, _ *kuberm
 	return nil, nil
 }
 
-func (f *fakeUserClusterConnection) GetClient(_ context.Context, _ *kubermaticv1.Cluster, _ ...k8cuserclusterclient.ConfigOption) (ctrlruntimeclient.Client, error) {
+func (f *fakeUserClusterConnection) GetClient(_ context.Context, cluster *kubermaticv1.Cluster, _ ...k8cuserclusterclient.ConfigOption) (ctrlruntimeclient.Client, error) {
+	if cluster != nil {
+		if client, ok := f.clusterClients[cluster.Name]; ok {
+			return client, nil
+		}
+	}
 	return f.fakeDynamicClient, nil
 }
 
@@ -868,6 +908,35 @@ func CompareWithResult(t *testing.T, res *httptest.ResponseRecorder, response st
 	}
 }
 
+// ScrapeMetric scrapes the metrics handler of the API and returns the value of the sample with the given name and
+// labels, e.g. `code="400",endpoint="createMachineDeployment"`. The labels have to be sorted by name, samples which
+// were not recorded yet are 0.
+func ScrapeMetric(t *testing.T, name, labels string) float64 {
+	t.Helper()
+
+	const token = "test-token"
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	res := httptest.NewRecorder()
+	metrics.Handler(token).ServeHTTP(res, req)
+	if res.Code != http.StatusOK {
+		t.Fatalf("failed to scrape metrics, got HTTP status %d: %s", res.Code, res.Body.String())
+	}
+
+	sample := name + "{" + labels + "} "
+	for _, line := range strings.Split(res.Body.String(), "\n") {
+		if value, ok := strings.CutPrefix(line, sample); ok {
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				t.Fatalf("failed to parse the value of %s: %v", sample, err)
+			}
+			return v
+		}
+	}
+
+	return 0
+}
+
 // GenUser generates a User resource
 // note if the id is empty then it will be auto generated.
 func GenUser(id, name, email string) *kubermaticv1.User {
@@ -146,7 +146,7 @@ func UpdateMeteringReportConfigurationEndpoint(userInfoGetter provider.UserInfoG
 }
 
 // DeleteMeteringReportConfigurationEndpoint deletes report configuration entry for kkp metering tool.
-func DeleteMeteringReportConfigurationEndpoint(userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, masterClient ctrlruntimeclient.Client) endpoint.Endpoint {
+func DeleteMeteringReportConfigurationEndpoint(userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, seedClientGetter provider.SeedClientGetter, masterClient ctrlruntimeclient.Client) endpoint.Endpoint {
 	return func(ctx context.Context, req interface{}) (interface{}, error) {
 		userInfo, err := userInfoGetter(ctx, "")
 		if err != nil {
@@ -156,11 +156,12 @@ func DeleteMeteringReportConfigurationEndpoint(userInfoGetter provider.UserInfoG
 			return nil, apierrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%q doesn't have admin rights", userInfo.Email))
 		}
 
-		if err := deleteMeteringReportConfiguration(ctx, req, seedsGetter, masterClient); err != nil {
+		deletion, err := deleteMeteringReportConfiguration(ctx, req, seedsGetter, seedClientGetter, masterClient)
+		if err != nil {
 			return nil, fmt.Errorf("failed to delete metering report configuration: %w", err)
 		}
 
-		return nil, nil
+		return deletion, nil
 	}
 }
 
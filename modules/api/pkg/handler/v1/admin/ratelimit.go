@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// userRateLimitReq defines HTTP request for getUserRateLimit and resetUserRateLimit
+// swagger:parameters getUserRateLimit resetUserRateLimit
+type userRateLimitReq struct {
+	// The email of the user or service account.
+	// in: path
+	// required: true
+	User string `json:"user_email"`
+}
+
+func DecodeUserRateLimitReq(c context.Context, r *http.Request) (interface{}, error) {
+	user := mux.Vars(r)["user_email"]
+	if user == "" {
+		return nil, utilerrors.NewBadRequest("'user_email' parameter is required but was not provided")
+	}
+
+	return userRateLimitReq{User: user}, nil
+}
+
+// GetUserRateLimitEndpoint returns the current rate limit bucket of a user.
+func GetUserRateLimitEndpoint(userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, rateLimiter middleware.RateLimiter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(userRateLimitReq)
+		if err := checkAdmin(ctx, userInfoGetter); err != nil {
+			return nil, err
+		}
+
+		limit, err := middleware.GetUserRateLimit(ctx, settingsProvider, req.User)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		status, err := rateLimiter.Get(ctx, req.User, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		return convertRateLimitStatus(req.User, status), nil
+	}
+}
+
+// ResetUserRateLimitEndpoint resets the current rate limit bucket of a user, so that the user can make the full
+// number of requests again.
+func ResetUserRateLimitEndpoint(userInfoGetter provider.UserInfoGetter, rateLimiter middleware.RateLimiter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(userRateLimitReq)
+		if err := checkAdmin(ctx, userInfoGetter); err != nil {
+			return nil, err
+		}
+
+		return nil, rateLimiter.Reset(ctx, req.User)
+	}
+}
+
+func checkAdmin(ctx context.Context, userInfoGetter provider.UserInfoGetter) error {
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	if !userInfo.IsAdmin {
+		return utilerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+	}
+
+	return nil
+}
+
+func convertRateLimitStatus(user string, status middleware.RateLimitStatus) apiv2.APIRateLimitBucket {
+	bucket := apiv2.APIRateLimitBucket{
+		User:      user,
+		Limit:     status.Limit,
+		Remaining: status.Remaining,
+	}
+	if !status.Reset.IsZero() {
+		reset := apiv1.NewTime(status.Reset)
+		bucket.Reset = &reset
+	}
+
+	return bucket
+}
@@ -30,8 +30,10 @@ import (
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 
@@ -48,7 +50,7 @@ func KubermaticSettingsEndpoint(settingsProvider provider.SettingsProvider) endp
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
 
-		return ConvertCRDSettingsToAPISettingsSpec(&globalSettings.Spec), nil
+		return ConvertCRDSettingsToAPISettings(globalSettings)
 	}
 }
 
@@ -78,7 +80,11 @@ func UpdateKubermaticSettingsEndpoint(userInfoGetter provider.UserInfoGetter, se
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
 
-		existingGlobalSettingsSpecJSON, err := json.Marshal(ConvertCRDSettingsToAPISettingsSpec(&existingGlobalSettings.Spec))
+		existingAPISettings, err := ConvertCRDSettingsToAPISettings(existingGlobalSettings)
+		if err != nil {
+			return nil, err
+		}
+		existingGlobalSettingsSpecJSON, err := json.Marshal(existingAPISettings)
 		if err != nil {
 			return nil, utilerrors.NewBadRequest("cannot decode existing settings: %v", err)
 		}
@@ -105,12 +111,25 @@ func UpdateKubermaticSettingsEndpoint(userInfoGetter provider.UserInfoGetter, se
 		if err != nil {
 			return nil, utilerrors.NewBadRequest("cannot convert API settings to CRD settings: %v", err)
 		}
+		sizeLimits := patchedGlobalSettingsSpec.MachineDeploymentOptions.MachineDeploymentSizeLimits
+		if err := machine.ValidateMachineDeploymentSizeLimits(sizeLimits); err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+		if err := machine.SetMachineDeploymentSizeLimits(existingGlobalSettings, sizeLimits); err != nil {
+			return nil, err
+		}
+		if err := middleware.ValidateAPIRateLimits(patchedGlobalSettingsSpec.APIRateLimits); err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+		if err := middleware.SetAPIRateLimits(existingGlobalSettings, patchedGlobalSettingsSpec.APIRateLimits); err != nil {
+			return nil, err
+		}
 		globalSettings, err := settingsProvider.UpdateGlobalSettings(ctx, userInfo, existingGlobalSettings)
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
 
-		return ConvertCRDSettingsToAPISettingsSpec(&globalSettings.Spec), nil
+		return ConvertCRDSettingsToAPISettings(globalSettings)
 	}
 }
 
@@ -158,7 +177,7 @@ func convertAPISettingsToSettingsSpec(settings *apiv2.GlobalSettings) (kubermati
 		Notifications:                    settings.Notifications,
 		ProviderConfiguration:            settings.ProviderConfiguration,
 		MachineDeploymentVMResourceQuota: settings.MachineDeploymentVMResourceQuota,
-		MachineDeploymentOptions:         settings.MachineDeploymentOptions,
+		MachineDeploymentOptions:         settings.MachineDeploymentOptions.MachineDeploymentOptions,
 		AllowedOperatingSystems:          settings.AllowedOperatingSystems,
 		DisableChangelogPopup:            settings.DisableChangelogPopup,
 		WebTerminalOptions:               settings.WebTerminalOptions,
@@ -181,6 +200,28 @@ func convertAPISettingsToSettingsSpec(settings *apiv2.GlobalSettings) (kubermati
 	return s, nil
 }
 
+// ConvertCRDSettingsToAPISettings converts the global settings, including the settings which are stored in
+// annotations of the KubermaticSetting.
+func ConvertCRDSettingsToAPISettings(settings *kubermaticv1.KubermaticSetting) (apiv2.GlobalSettings, error) {
+	s := ConvertCRDSettingsToAPISettingsSpec(&settings.Spec)
+
+	sizeLimits, err := machine.GetMachineDeploymentSizeLimits(settings)
+	if err != nil {
+		return s, err
+	}
+	s.MachineDeploymentOptions.MachineDeploymentSizeLimits = sizeLimits
+
+	rateLimits, err := middleware.GetAPIRateLimits(settings)
+	if err != nil {
+		return s, err
+	}
+	if rateLimits.RequestsPerMinute != 0 || len(rateLimits.UserOverrides) > 0 {
+		s.APIRateLimits = &rateLimits
+	}
+
+	return s, nil
+}
+
 func ConvertCRDSettingsToAPISettingsSpec(settings *kubermaticv1.SettingSpec) apiv2.GlobalSettings {
 	enableShareCluster := true
 	if settings.EnableShareCluster != nil {
@@ -211,7 +252,7 @@ func ConvertCRDSettingsToAPISettingsSpec(settings *kubermaticv1.SettingSpec) api
 		Notifications:                    settings.Notifications,
 		ProviderConfiguration:            settings.ProviderConfiguration,
 		MachineDeploymentVMResourceQuota: settings.MachineDeploymentVMResourceQuota,
-		MachineDeploymentOptions:         settings.MachineDeploymentOptions,
+		MachineDeploymentOptions:         apiv2.MachineDeploymentOptions{MachineDeploymentOptions: settings.MachineDeploymentOptions},
 		AllowedOperatingSystems:          settings.AllowedOperatingSystems,
 		DisableChangelogPopup:            settings.DisableChangelogPopup,
 		WebTerminalOptions:               settings.WebTerminalOptions,
@@ -245,6 +286,7 @@ func addDefaultAnnotations(annotations *kubermaticv1.AnnotationSettings) {
 		annotations.HiddenAnnotations = append(annotations.HiddenAnnotations, corev1.LastAppliedConfigAnnotation)
 		annotations.HiddenAnnotations = append(annotations.HiddenAnnotations, kubermaticv1.InitialApplicationInstallationsRequestAnnotation)
 		annotations.HiddenAnnotations = append(annotations.HiddenAnnotations, kubermaticv1.InitialMachineDeploymentRequestAnnotation)
+		annotations.HiddenAnnotations = append(annotations.HiddenAnnotations, machine.PendingInitialMachineDeploymentsAnnotation)
 		annotations.HiddenAnnotations = append(annotations.HiddenAnnotations, kubermaticv1.InitialCNIValuesRequestAnnotation)
 	}
 }
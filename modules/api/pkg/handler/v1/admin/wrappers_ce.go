@@ -53,8 +53,8 @@ func updateMeteringReportConfiguration(_ context.Context, _ interface{}, _ provi
 	return nil, nil
 }
 
-func deleteMeteringReportConfiguration(_ context.Context, _ interface{}, _ provider.SeedsGetter, _ ctrlruntimeclient.Client) error {
-	return nil
+func deleteMeteringReportConfiguration(_ context.Context, _ interface{}, _ provider.SeedsGetter, _ provider.SeedClientGetter, _ ctrlruntimeclient.Client) (*apiv1.MeteringReportConfigurationDeletion, error) {
+	return nil, nil
 }
 
 func listMeteringReports(_ context.Context, _ interface{}, _ provider.SeedsGetter, _ provider.SeedClientGetter) ([]apiv1.MeteringReport, error) {
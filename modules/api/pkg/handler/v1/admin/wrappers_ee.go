@@ -53,8 +53,8 @@ func updateMeteringReportConfiguration(ctx context.Context, request interface{},
 	return metering.UpdateMeteringReportConfiguration(ctx, request, seedsGetter, masterClient)
 }
 
-func deleteMeteringReportConfiguration(ctx context.Context, request interface{}, seedsGetter provider.SeedsGetter, masterClient ctrlruntimeclient.Client) error {
-	return metering.DeleteMeteringReportConfiguration(ctx, request, seedsGetter, masterClient)
+func deleteMeteringReportConfiguration(ctx context.Context, request interface{}, seedsGetter provider.SeedsGetter, seedClientGetter provider.SeedClientGetter, masterClient ctrlruntimeclient.Client) (*apiv1.MeteringReportConfigurationDeletion, error) {
+	return metering.DeleteMeteringReportConfiguration(ctx, request, seedsGetter, seedClientGetter, masterClient)
 }
 
 func listMeteringReports(ctx context.Context, request interface{}, seedsGetter provider.SeedsGetter, seedClientGetter provider.SeedClientGetter) ([]apiv1.MeteringReport, error) {
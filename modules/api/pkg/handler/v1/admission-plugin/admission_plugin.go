@@ -21,10 +21,10 @@ import (
 	"fmt"
 	"net/http"
 
-	semverlib "github.com/Masterminds/semver/v3"
 	"github.com/go-kit/kit/endpoint"
 	"github.com/gorilla/mux"
 
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
 
@@ -34,29 +34,11 @@ import (
 func GetAdmissionPluginEndpoint(admissionPluginProvider provider.AdmissionPluginsProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(admissionPluginReq)
-		pluginResponse, err := admissionPluginProvider.ListPluginNamesFromVersion(ctx, req.Version)
+		plugins, err := handlercommon.GetAdmissionPlugins(ctx, admissionPluginProvider, req.Version)
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
 
-		// for the backward compatibility we have to keep those plugins as a default
-		plugins := sets.New(
-			"PodNodeSelector",
-			"EventRateLimit",
-		)
-
-		v, err := semverlib.NewVersion(req.Version)
-		if err != nil {
-			return nil, err
-		}
-
-		// Pod Security Policy was removed in k8s v1.25
-		gteKube125Condition, _ := semverlib.NewConstraint(">= 1.25")
-		if !gteKube125Condition.Check(v) {
-			plugins.Insert("PodSecurityPolicy")
-		}
-		plugins.Insert(pluginResponse...)
-
 		return sets.List(plugins), nil
 	}
 }
@@ -301,10 +301,10 @@ func TestDetachSSHKeyFromClusterEndpoint(t *testing.T) {
 			Name:                             "scenario 3: the user John can not detach any key from the Bob cluster",
 			Body:                             ``,
 			KeyToDelete:                      "key-c08aa5c7abf34504f18552846485267d-yafn",
-			ExpectedDeleteResponse:           `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedDeleteResponse:           `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			ExpectedDeleteHTTPStatus:         http.StatusForbidden,
 			ExpectedGetHTTPStatus:            http.StatusForbidden,
-			ExpectedResponseOnGetAfterDelete: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponseOnGetAfterDelete: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			ProjectToSync:                    test.GenDefaultProject().Name,
 			ExistingAPIUser:                  test.GenAPIUser("John", "john@acme.com"),
 			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -560,7 +560,7 @@ func TestAssignSSHKeyToClusterEndpoint(t *testing.T) {
 		{
 			Name:             "scenario 4: the user John can not assign ssh key to the Bob's cluster",
 			SSHKeyID:         "key-c08aa5c7abf34504f18552846485267d-yafn",
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			HTTPStatus:       http.StatusForbidden,
 			ProjectToSync:    test.GenDefaultProject().Name,
 			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
@@ -661,7 +661,7 @@ func TestCreateClusterEndpoint(t *testing.T) {
 		{
 			Name:             "scenario 3: unable to create a cluster when the user doesn't belong to the project",
 			Body:             fmt.Sprintf(`{"cluster":{"name":"keen-snyder","pause":false,"spec":{"version":"%s","cloud":{"version":"%s","fake":{"token":"dummy_token"},"dc":"fake-dc"}}},"sshKeys":["key-c08aa5c7abf34504f18552846485267d-yafn"]}`, version, version),
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			HTTPStatus:       http.StatusForbidden,
 			ProjectToSync:    test.GenDefaultProject().Name,
 			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -940,7 +940,7 @@ func TestGetClusterHealth(t *testing.T) {
 		{
 			Name:             "scenario 3: the user Bob can not get John's cluster health status",
 			Body:             ``,
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			HTTPStatus:       http.StatusForbidden,
 			ClusterToGet:     "keen-snyder",
 			ProjectToSync:    test.GenDefaultProject().Name,
@@ -1265,7 +1265,7 @@ func TestPatchCluster(t *testing.T) {
 		{
 			Name:             "scenario 7: the regular user John can not update Bob's cluster version",
 			Body:             `{"spec":{"version":"1.2.3"}}`,
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			cluster:          "keen-snyder",
 			HTTPStatus:       http.StatusForbidden,
 			project:          test.GenDefaultProject().Name,
@@ -1384,7 +1384,7 @@ func TestGetCluster(t *testing.T) {
 		{
 			Name:             "scenario 4: the regular user John can not get Bob's cluster",
 			Body:             ``,
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			ClusterToGet:     test.GenDefaultCluster().Name,
 			HTTPStatus:       http.StatusForbidden,
 			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -2026,7 +2026,7 @@ func TestRevokeClusterAdminTokenEndpoint(t *testing.T) {
 		// scenario 3
 		{
 			name:             "scenario 3: the user John can not revoke Bob's cluster token",
-			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			clusterToGet:     test.GenDefaultCluster(),
 			httpStatus:       http.StatusForbidden,
 			existingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -2173,7 +2173,7 @@ func TestGetClusterEventsEndpoint(t *testing.T) {
 				test.GenTestEvent("event-1", corev1.EventTypeNormal, "Started", "message started", "Cluster", "venus-1-machine", test.GenDefaultCluster().Name),
 				test.GenTestEvent("event-2", corev1.EventTypeWarning, "Killed", "message killed", "Cluster", "venus-1-machine", test.GenDefaultCluster().Name),
 			},
-			ExpectedResult: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResult: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 		},
 	}
 
@@ -2319,7 +2319,7 @@ func TestGetClusterMetrics(t *testing.T) {
 		{
 			Name:             "scenario 2: the user John can not get Bob's cluster metrics",
 			Body:             ``,
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			ClusterToGet:     test.GenDefaultCluster().Name,
 			HTTPStatus:       http.StatusForbidden,
 			ExistingNodes: []*corev1.Node{
@@ -2451,7 +2451,7 @@ func TestListNamespace(t *testing.T) {
 		// scenario 3
 		{
 			name:             "scenario 3: the user John can not get Bob's cluster namespaces",
-			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			clusterToGet:     test.GenDefaultCluster().Name,
 			httpStatus:       http.StatusForbidden,
 			existingKubermaticObjs: test.GenDefaultKubermaticObjects(
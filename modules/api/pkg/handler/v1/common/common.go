@@ -303,8 +303,14 @@ func ValidateUserCanModifyProject(ctx context.Context, userInfoGetter provider.U
 	}
 
 	// Only KKP admins and project owners/editors are allowed to perform this operation.
-	if !userInfo.IsAdmin && !userInfo.Roles.HasAny("editors", "owners") {
+	if !UserCanModifyProject(userInfo) {
 		return utilerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have privileges to perform this action. Please contact your administrator.", userInfo.Email))
 	}
 	return nil
 }
+
+// UserCanModifyProject returns true if the user is a global admin or an owner/editor of the project
+// the user info was retrieved for.
+func UserCanModifyProject(userInfo *provider.UserInfo) bool {
+	return userInfo.IsAdmin || userInfo.Roles.HasAny("editors", "owners")
+}
@@ -17,16 +17,81 @@ limitations under the License.
 package common
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
 
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	clustercommon "k8c.io/machine-controller/sdk/apis/cluster/common"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
+// Reasons of API errors. The reason is returned next to the code and message of an error, so that clients can
+// handle the error without parsing the message.
+const (
+	ReasonClusterNotReady  = "CLUSTER_NOT_READY"
+	ReasonNotProjectMember = "NOT_PROJECT_MEMBER"
+	ReasonVersionSkew      = "VERSION_SKEW"
+	ReasonAutoscalerBounds = "AUTOSCALER_BOUNDS"
+	ReasonSizeLimit        = "SIZE_LIMIT"
+	ReasonNodeQuota        = "NODE_QUOTA_EXCEEDED"
+
+	ReasonMachineProvisioning = "MACHINE_PROVISIONING_FAILED"
+	ReasonUpstreamTimeout     = "UPSTREAM_TIMEOUT"
+	ReasonUpstreamUnavailable = "UPSTREAM_UNAVAILABLE"
+)
+
+// ReasonError adds a machine-readable reason to an error. The status code and message of the error response are
+// the ones of the wrapped error.
+type ReasonError struct {
+	Reason string
+	Err    error
+}
+
+// WithReason adds the reason to the error, the error is returned unmodified if it is nil or the reason is empty.
+func WithReason(reason string, err error) error {
+	if err == nil || reason == "" {
+		return err
+	}
+	return &ReasonError{Reason: reason, Err: err}
+}
+
+func (e *ReasonError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ReasonError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorReason returns the reason of the error or an empty string if the error doesn't have one.
+func ErrorReason(err error) string {
+	var reasonErr *ReasonError
+	if errors.As(err, &reasonErr) {
+		return reasonErr.Reason
+	}
+	return ""
+}
+
 // kubernetesErrorToHTTPError constructs HTTPError only if the given err is of type *StatusError.
-// Otherwise unmodified err will be returned to the caller.
+// Otherwise unmodified err will be returned to the caller. The reason of the error is kept.
 func KubernetesErrorToHTTPError(err error) error {
+	if reason := ErrorReason(err); reason != "" {
+		if httpErr := kubernetesErrorToHTTPError(err); httpErr != err {
+			return WithReason(reason, httpErr)
+		}
+		return err
+	}
+
+	return kubernetesErrorToHTTPError(err)
+}
+
+func kubernetesErrorToHTTPError(err error) error {
 	var errStatus *apierrors.StatusError
 
 	if errors.As(err, &errStatus) {
@@ -36,5 +101,92 @@ func KubernetesErrorToHTTPError(err error) error {
 		return utilerrors.New(int(httpCode), httpMessage)
 	}
 
+	var machineErr *MachineProvisioningError
+	if errors.As(err, &machineErr) {
+		return WithReason(ReasonMachineProvisioning, utilerrors.New(machineErr.StatusCode(), machineErr.Error()))
+	}
+
+	return err
+}
+
+// machineErrorStatusCodes maps the error reasons reported by the machine-controller to HTTP status codes. Terminal
+// errors, which can only be resolved by changing the machine spec or the cloud account, are mapped to 422, while
+// errors which are expected to resolve over time are mapped to 503.
+var machineErrorStatusCodes = map[clustercommon.MachineStatusError]int{
+	clustercommon.InvalidConfigurationMachineError:  http.StatusUnprocessableEntity,
+	clustercommon.UnsupportedChangeMachineError:     http.StatusUnprocessableEntity,
+	clustercommon.InsufficientResourcesMachineError: http.StatusUnprocessableEntity,
+	clustercommon.CreateMachineError:                http.StatusServiceUnavailable,
+	clustercommon.UpdateMachineError:                http.StatusServiceUnavailable,
+	clustercommon.DeleteMachineError:                http.StatusServiceUnavailable,
+	clustercommon.JoinClusterTimeoutMachineError:    http.StatusServiceUnavailable,
+}
+
+// MachineProvisioningError is the error the machine-controller reported in the status of a machine.
+type MachineProvisioningError struct {
+	Machine string
+	Reason  clustercommon.MachineStatusError
+	Message string
+}
+
+// NewMachineProvisioningError returns the error reported in the status of the given machine or nil if the
+// machine-controller didn't report any error.
+func NewMachineProvisioningError(machine *clusterv1alpha1.Machine) *MachineProvisioningError {
+	if machine.Status.ErrorReason == nil {
+		return nil
+	}
+
+	err := &MachineProvisioningError{
+		Machine: machine.Name,
+		Reason:  *machine.Status.ErrorReason,
+	}
+	if machine.Status.ErrorMessage != nil {
+		err.Message = *machine.Status.ErrorMessage
+	}
+
 	return err
 }
+
+func (e *MachineProvisioningError) Error() string {
+	return fmt.Sprintf("machine %s failed with %s: %s", e.Machine, e.Reason, e.Message)
+}
+
+// Terminal returns true if the error will not be resolved by the machine-controller without user interaction.
+func (e *MachineProvisioningError) Terminal() bool {
+	return e.StatusCode() == http.StatusUnprocessableEntity
+}
+
+// StatusCode returns the HTTP status code the error is mapped to.
+func (e *MachineProvisioningError) StatusCode() int {
+	if code, ok := machineErrorStatusCodes[e.Reason]; ok {
+		return code
+	}
+	return http.StatusInternalServerError
+}
+
+const (
+	// UpstreamSeed marks errors returned by the seed cluster.
+	UpstreamSeed = "seed"
+	// UpstreamUserCluster marks errors returned by the user cluster.
+	UpstreamUserCluster = "user cluster"
+)
+
+// UpstreamErrorToHTTPError maps timeouts and refused connections to the given upstream (seed or user cluster)
+// to 504 and 503 errors, so they can not be confused with authorization failures. All other errors are
+// handled by KubernetesErrorToHTTPError.
+func UpstreamErrorToHTTPError(err error, upstream string) error {
+	if err == nil {
+		return nil
+	}
+
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), apierrors.IsTimeout(err), apierrors.IsServerTimeout(err),
+		errors.As(err, &netErr) && netErr.Timeout():
+		return WithReason(ReasonUpstreamTimeout, utilerrors.New(http.StatusGatewayTimeout, fmt.Sprintf("request to %s timed out: %v", upstream, err)))
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return WithReason(ReasonUpstreamUnavailable, utilerrors.New(http.StatusServiceUnavailable, fmt.Sprintf("%s is unavailable: %v", upstream, err)))
+	}
+
+	return KubernetesErrorToHTTPError(err)
+}
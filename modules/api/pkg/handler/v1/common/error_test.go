@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	clustercommon "k8c.io/machine-controller/sdk/apis/cluster/common"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestMachineProvisioningErrorToHTTPError(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name             string
+		Reason           clustercommon.MachineStatusError
+		ExpectedCode     int
+		ExpectedTerminal bool
+	}{
+		{
+			Name:             "invalid configuration, e.g. invalid credentials, is terminal",
+			Reason:           clustercommon.InvalidConfigurationMachineError,
+			ExpectedCode:     http.StatusUnprocessableEntity,
+			ExpectedTerminal: true,
+		},
+		{
+			Name:             "unsupported changes are terminal",
+			Reason:           clustercommon.UnsupportedChangeMachineError,
+			ExpectedCode:     http.StatusUnprocessableEntity,
+			ExpectedTerminal: true,
+		},
+		{
+			Name:             "insufficient resources, e.g. an exceeded quota, are terminal",
+			Reason:           clustercommon.InsufficientResourcesMachineError,
+			ExpectedCode:     http.StatusUnprocessableEntity,
+			ExpectedTerminal: true,
+		},
+		{
+			Name:         "create errors are transient",
+			Reason:       clustercommon.CreateMachineError,
+			ExpectedCode: http.StatusServiceUnavailable,
+		},
+		{
+			Name:         "update errors are transient",
+			Reason:       clustercommon.UpdateMachineError,
+			ExpectedCode: http.StatusServiceUnavailable,
+		},
+		{
+			Name:         "delete errors are transient",
+			Reason:       clustercommon.DeleteMachineError,
+			ExpectedCode: http.StatusServiceUnavailable,
+		},
+		{
+			Name:         "join cluster timeouts are transient",
+			Reason:       clustercommon.JoinClusterTimeoutMachineError,
+			ExpectedCode: http.StatusServiceUnavailable,
+		},
+		{
+			Name:         "unknown reasons are internal errors",
+			Reason:       "SomethingElse",
+			ExpectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			machine := &clusterv1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: "venus-1"},
+				Status: clusterv1alpha1.MachineStatus{
+					ErrorReason:  ptr.To(tc.Reason),
+					ErrorMessage: ptr.To("something went wrong"),
+				},
+			}
+
+			machineErr := common.NewMachineProvisioningError(machine)
+			if machineErr == nil {
+				t.Fatal("expected a machine provisioning error")
+			}
+			if machineErr.Terminal() != tc.ExpectedTerminal {
+				t.Errorf("expected terminal to be %t", tc.ExpectedTerminal)
+			}
+
+			err := common.KubernetesErrorToHTTPError(fmt.Errorf("failed to delete machine: %w", machineErr))
+			var httpErr utilerrors.HTTPError
+			if !errors.As(err, &httpErr) {
+				t.Fatal("expected the error to be converted to an HTTP error")
+			}
+			if httpErr.StatusCode() != tc.ExpectedCode {
+				t.Errorf("expected status code %d, got %d", tc.ExpectedCode, httpErr.StatusCode())
+			}
+
+			expectedMessage := fmt.Sprintf("machine venus-1 failed with %s: something went wrong", tc.Reason)
+			if httpErr.Error() != expectedMessage {
+				t.Errorf("expected message %q, got %q", expectedMessage, httpErr.Error())
+			}
+
+			if reason := common.ErrorReason(err); reason != common.ReasonMachineProvisioning {
+				t.Errorf("expected reason %q, got %q", common.ReasonMachineProvisioning, reason)
+			}
+			if len(httpErr.Details()) != 0 {
+				t.Errorf("expected no details, got %v", httpErr.Details())
+			}
+		})
+	}
+}
+
+func TestNewMachineProvisioningErrorWithoutError(t *testing.T) {
+	t.Parallel()
+
+	if err := common.NewMachineProvisioningError(&clusterv1alpha1.Machine{}); err != nil {
+		t.Fatalf("expected no error for a healthy machine, got %v", err)
+	}
+}
+
+func TestKubernetesErrorToHTTPErrorKeepsReason(t *testing.T) {
+	t.Parallel()
+
+	err := common.WithReason(common.ReasonClusterNotReady, apierrors.NewServiceUnavailable("Cluster components are not ready yet"))
+	converted := common.KubernetesErrorToHTTPError(fmt.Errorf("failed to get cluster: %w", err))
+
+	var httpErr utilerrors.HTTPError
+	if !errors.As(converted, &httpErr) {
+		t.Fatal("expected the error to be converted to an HTTP error")
+	}
+	if httpErr.StatusCode() != http.StatusServiceUnavailable {
+		t.Errorf("expected status code %d, got %d", http.StatusServiceUnavailable, httpErr.StatusCode())
+	}
+	if reason := common.ErrorReason(converted); reason != common.ReasonClusterNotReady {
+		t.Errorf("expected reason %q, got %q", common.ReasonClusterNotReady, reason)
+	}
+}
+
+func TestWithEmptyReason(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("something went wrong")
+	if common.WithReason("", err) != err {
+		t.Fatal("expected the error to be returned unmodified")
+	}
+}
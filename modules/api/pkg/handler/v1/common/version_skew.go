@@ -35,13 +35,23 @@ import (
 // CheckClusterVersionSkew returns a list of machines and/or machine deployments
 // that are running kubelet at a version incompatible with the cluster's control plane.
 func CheckClusterVersionSkew(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, projectID string) ([]string, error) {
+	return checkVersionSkew(ctx, userInfoGetter, clusterProvider, cluster, projectID, true)
+}
+
+// CheckMachineVersionSkew is like CheckClusterVersionSkew, but leaves out the machine deployments. It is used when
+// the machine deployments are upgraded together with the control plane.
+func CheckMachineVersionSkew(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, projectID string) ([]string, error) {
+	return checkVersionSkew(ctx, userInfoGetter, clusterProvider, cluster, projectID, false)
+}
+
+func checkVersionSkew(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, projectID string, includeMachineDeployments bool) ([]string, error) {
 	client, err := GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create a machine client: %w", err)
 	}
 
 	// get deduplicated list of all used kubelet versions
-	kubeletVersions, err := getKubeletVersions(ctx, client)
+	kubeletVersions, err := getKubeletVersions(ctx, client, includeMachineDeployments)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get the list of kubelet versions used in the cluster: %w", err)
 	}
@@ -77,16 +87,19 @@ func CheckClusterVersionSkew(ctx context.Context, userInfoGetter provider.UserIn
 	return incompatibleVersionsList, nil
 }
 
-// getKubeletVersions returns the list of all kubelet versions used by a given cluster's Machines and MachineDeployments.
-func getKubeletVersions(ctx context.Context, client ctrlruntimeclient.Client) ([]string, error) {
+// getKubeletVersions returns the list of all kubelet versions used by a given cluster's Machines and, if
+// includeMachineDeployments is set, MachineDeployments.
+func getKubeletVersions(ctx context.Context, client ctrlruntimeclient.Client, includeMachineDeployments bool) ([]string, error) {
 	machineList := &clusterv1alpha1.MachineList{}
 	if err := client.List(ctx, machineList); err != nil {
 		return nil, fmt.Errorf("failed to load machines from cluster: %w", err)
 	}
 
 	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
-	if err := client.List(ctx, machineDeployments); err != nil {
-		return nil, KubernetesErrorToHTTPError(err)
+	if includeMachineDeployments {
+		if err := client.List(ctx, machineDeployments); err != nil {
+			return nil, KubernetesErrorToHTTPError(err)
+		}
 	}
 
 	kubeletVersionsSet := map[string]bool{}
@@ -32,6 +32,7 @@ import (
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
 	"k8c.io/kubermatic/v2/pkg/log"
@@ -243,6 +244,11 @@ func getAPIDCsFromSeed(seed *kubermaticv1.Seed) []apiv1.Datacenter {
 			log.Logger.Errorf("api spec error in dc %q: %v", datacenterName, err)
 			continue
 		}
+		// Malformed node spec defaults must not hide the datacenter, it is returned without them.
+		spec.NodeSpecDefaults, err = machine.GetNodeSpecDefaults(seed, datacenterName)
+		if err != nil {
+			log.Logger.Errorf("node spec defaults error in dc %q: %v", datacenterName, err)
+		}
 		foundDCs = append(foundDCs, apiv1.Datacenter{
 			Metadata: apiv1.DatacenterMeta{
 				Name: datacenterName,
@@ -292,6 +298,9 @@ func CreateEndpoint(seedsGetter provider.SeedsGetter, userInfoGetter provider.Us
 			seed.Spec.Datacenters = map[string]kubermaticv1.Datacenter{}
 		}
 		seed.Spec.Datacenters[req.Body.Name] = convertExternalDCToInternal(&req.Body.Spec)
+		if err := machine.SetNodeSpecDefaults(seed, req.Body.Name, req.Body.Spec.NodeSpecDefaults); err != nil {
+			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to set node spec defaults: %v", err))
+		}
 
 		if err = masterClient.Update(ctx, seed); err != nil {
 			return nil, utilerrors.New(http.StatusInternalServerError,
@@ -350,8 +359,14 @@ func UpdateEndpoint(seedsGetter provider.SeedsGetter, userInfoGetter provider.Us
 						req.DCToUpdate, req.Body.Name))
 			}
 			delete(seed.Spec.Datacenters, req.DCToUpdate)
+			if err := machine.SetNodeSpecDefaults(seed, req.DCToUpdate, nil); err != nil {
+				return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to set node spec defaults: %v", err))
+			}
 		}
 		seed.Spec.Datacenters[req.Body.Name] = convertExternalDCToInternal(&req.Body.Spec)
+		if err := machine.SetNodeSpecDefaults(seed, req.Body.Name, req.Body.Spec.NodeSpecDefaults); err != nil {
+			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to set node spec defaults: %v", err))
+		}
 
 		if err = masterClient.Update(ctx, seed); err != nil {
 			return nil, utilerrors.New(http.StatusInternalServerError,
@@ -407,6 +422,10 @@ func PatchEndpoint(seedsGetter provider.SeedsGetter, userInfoGetter provider.Use
 		if err != nil {
 			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to convert current dc: %v", err))
 		}
+		currentAPIDC.Spec.NodeSpecDefaults, err = machine.GetNodeSpecDefaults(seed, req.DCToPatch)
+		if err != nil {
+			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to get node spec defaults: %v", err))
+		}
 
 		currentDCJSON, err := json.Marshal(currentAPIDC)
 		if err != nil {
@@ -428,6 +447,9 @@ func PatchEndpoint(seedsGetter provider.SeedsGetter, userInfoGetter provider.Use
 		if err := validateProvider(&patched.Spec); err != nil {
 			return nil, utilerrors.New(http.StatusBadRequest, fmt.Sprintf("patched dc validation failed: %v", err))
 		}
+		if err := machine.ValidateNodeSpecDefaults(patched.Spec.NodeSpecDefaults); err != nil {
+			return nil, utilerrors.New(http.StatusBadRequest, fmt.Sprintf("patched dc validation failed: %v", err))
+		}
 		kubermaticPatched := convertExternalDCToInternal(&patched.Spec)
 
 		// As provider field is extracted from providers, we need to make sure its set properly
@@ -446,10 +468,16 @@ func PatchEndpoint(seedsGetter provider.SeedsGetter, userInfoGetter provider.Use
 						req.DCToPatch, patched.Metadata.Name))
 			}
 			delete(seed.Spec.Datacenters, req.DCToPatch)
+			if err := machine.SetNodeSpecDefaults(seed, req.DCToPatch, nil); err != nil {
+				return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to set node spec defaults: %v", err))
+			}
 			dcName = patched.Metadata.Name
 		}
 
 		seed.Spec.Datacenters[dcName] = kubermaticPatched
+		if err := machine.SetNodeSpecDefaults(seed, dcName, patched.Spec.NodeSpecDefaults); err != nil {
+			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to set node spec defaults: %v", err))
+		}
 
 		if err = masterClient.Update(ctx, seed); err != nil {
 			return nil, utilerrors.New(http.StatusInternalServerError,
@@ -490,6 +518,9 @@ func DeleteEndpoint(seedsGetter provider.SeedsGetter, userInfoGetter provider.Us
 				fmt.Sprintf("Bad request: datacenter %q does not exists", req.DC))
 		}
 		delete(seed.Spec.Datacenters, req.DC)
+		if err := machine.SetNodeSpecDefaults(seed, req.DC, nil); err != nil {
+			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to set node spec defaults: %v", err))
+		}
 
 		if err = masterClient.Update(ctx, seed); err != nil {
 			return nil, utilerrors.New(http.StatusInternalServerError,
@@ -814,6 +845,10 @@ func (req createDCReq) validate() error {
 		return err
 	}
 
+	if err := machine.ValidateNodeSpecDefaults(req.Body.Spec.NodeSpecDefaults); err != nil {
+		return err
+	}
+
 	if !strings.EqualFold(req.Seed, req.Body.Spec.Seed) {
 		return fmt.Errorf("path seed %q and request seed %q not equal", req.Seed, req.Body.Spec.Seed)
 	}
@@ -28,6 +28,7 @@ import (
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -88,6 +89,7 @@ func TestDatacenterGetEndpoint(t *testing.T) {
 		expectedResponse string
 		httpStatus       int
 		existingAPIUser  *apiv1.User
+		seedAnnotations  map[string]string
 	}{
 		{
 			name:             "admin should be able to get email restricted dc",
@@ -124,13 +126,23 @@ func TestDatacenterGetEndpoint(t *testing.T) {
 			httpStatus:       http.StatusOK,
 			existingAPIUser:  test.GenDefaultAPIUser(),
 		},
+		{
+			name:             "should find dc without its node spec defaults if they are malformed",
+			dc:               "regular-do1",
+			expectedResponse: `{"metadata":{"name":"regular-do1"},"spec":{"seed":"us-central1","country":"NL","location":"Amsterdam","provider":"digitalocean","digitalocean":{"region":"ams2"},"node":{},"enforceAuditLogging":false,"enforcePodSecurityPolicy":false,"ipv6Enabled":true}}`,
+			httpStatus:       http.StatusOK,
+			existingAPIUser:  test.GenDefaultAPIUser(),
+			seedAnnotations:  map[string]string{machine.NodeSpecDefaultsAnnotation: "{not json"},
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
 			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/dc/%s", tc.dc), nil)
 			res := httptest.NewRecorder()
+			seed := test.GenTestSeed()
+			seed.Annotations = tc.seedAnnotations
 			ep, err := test.CreateTestEndpoint(*tc.existingAPIUser, []ctrlruntimeclient.Object{},
-				[]ctrlruntimeclient.Object{test.APIUserToKubermaticUser(*tc.existingAPIUser), test.GenTestSeed()}, nil, hack.NewTestRouting)
+				[]ctrlruntimeclient.Object{test.APIUserToKubermaticUser(*tc.existingAPIUser), seed}, nil, hack.NewTestRouting)
 			if err != nil {
 				t.Fatalf("failed to create test endpoint: %v", err)
 			}
@@ -846,6 +858,24 @@ func TestDatacenterPatchEndpoint(t *testing.T) {
 			httpStatus:       http.StatusBadRequest,
 			existingAPIUser:  test.GenDefaultAdminAPIUser(),
 		},
+		{
+			name:             "admin should be able to set the node spec defaults of a dc",
+			patch:            `{"spec":{"nodeSpecDefaults":{"instanceType":"s-4vcpu-8gb","diskSize":50}}}`,
+			dcPathName:       "private-do1",
+			seedName:         "us-central1",
+			expectedResponse: `{"metadata":{"name":"private-do1"},"spec":{"seed":"us-central1","country":"NL","location":"US ","provider":"digitalocean","digitalocean":{"region":"ams2"},"node":{"pauseImage":"image-pause"},"enforceAuditLogging":false,"enforcePodSecurityPolicy":true,"ipv6Enabled":true,"nodeSpecDefaults":{"instanceType":"s-4vcpu-8gb","diskSize":50}}}`,
+			httpStatus:       http.StatusOK,
+			existingAPIUser:  test.GenDefaultAdminAPIUser(),
+		},
+		{
+			name:             "should not be able to set a negative disk size as node spec default",
+			patch:            `{"spec":{"nodeSpecDefaults":{"diskSize":-1}}}`,
+			dcPathName:       "private-do1",
+			seedName:         "us-central1",
+			expectedResponse: `{"error":{"code":400,"message":"patched dc validation failed: the disk size of the node spec defaults must not be negative, got -1"}}`,
+			httpStatus:       http.StatusBadRequest,
+			existingAPIUser:  test.GenDefaultAdminAPIUser(),
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -79,7 +79,7 @@ func TestCreateNodeDeployment(t *testing.T) {
 		{
 			Name:             "scenario 2: cluster components are not ready",
 			Body:             `{"spec":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}`,
-			ExpectedResponse: `{"error":{"code":503,"message":"Cluster components are not ready yet"}}`,
+			ExpectedResponse: `{"error":{"code":503,"message":"Cluster components are not ready yet","reason":"CLUSTER_NOT_READY"}}`,
 			HTTPStatus:       http.StatusServiceUnavailable,
 			ProjectID:        test.GenDefaultProject().Name,
 			ClusterID:        test.GenDefaultCluster().Name,
@@ -94,7 +94,7 @@ func TestCreateNodeDeployment(t *testing.T) {
 		{
 			Name:             "scenario 3: kubelet version is too old",
 			Body:             `{"spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"versions":{"kubelet":"9.6.0"}}}}`,
-			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: kubelet version 9.6.0 is not compatible with control plane version 9.9.9"}}`,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: kubelet version 9.6.0 is not compatible with control plane version 9.9.9","reason":"VERSION_SKEW"}}`,
 			HTTPStatus:       http.StatusBadRequest,
 			ProjectID:        test.GenDefaultProject().Name,
 			ClusterID:        test.GenDefaultCluster().Name,
@@ -109,7 +109,7 @@ func TestCreateNodeDeployment(t *testing.T) {
 		{
 			Name:             "scenario 4: kubelet version is too new",
 			Body:             `{"spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"versions":{"kubelet":"9.10.0"}}}}`,
-			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: kubelet version 9.10.0 is not compatible with control plane version 9.9.9"}}`,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: kubelet version 9.10.0 is not compatible with control plane version 9.9.9","reason":"VERSION_SKEW"}}`,
 			HTTPStatus:       http.StatusBadRequest,
 			ProjectID:        test.GenDefaultProject().Name,
 			ClusterID:        test.GenDefaultCluster().Name,
@@ -664,9 +664,10 @@ func TestListNodeDeploymentNodes(t *testing.T) {
 						},
 					},
 					Status: apiv1.NodeStatus{
-						MachineName: "venus-1",
-						Capacity:    apiv1.NodeResources{},
-						Allocatable: apiv1.NodeResources{},
+						MachineName:    "venus-1",
+						Capacity:       apiv1.NodeResources{},
+						Allocatable:    apiv1.NodeResources{},
+						OSUpdateStatus: &apiv1.NodeOSUpdateStatus{State: apiv1.NodeOSUpdateUnknown},
 					},
 				},
 				{
@@ -691,9 +692,10 @@ func TestListNodeDeploymentNodes(t *testing.T) {
 						},
 					},
 					Status: apiv1.NodeStatus{
-						MachineName: "venus-2",
-						Capacity:    apiv1.NodeResources{},
-						Allocatable: apiv1.NodeResources{},
+						MachineName:    "venus-2",
+						Capacity:       apiv1.NodeResources{},
+						Allocatable:    apiv1.NodeResources{},
+						OSUpdateStatus: &apiv1.NodeOSUpdateStatus{State: apiv1.NodeOSUpdateUnknown},
 					},
 				},
 			},
@@ -744,9 +746,10 @@ func TestListNodeDeploymentNodes(t *testing.T) {
 						},
 					},
 					Status: apiv1.NodeStatus{
-						MachineName: "venus-1",
-						Capacity:    apiv1.NodeResources{},
-						Allocatable: apiv1.NodeResources{},
+						MachineName:    "venus-1",
+						Capacity:       apiv1.NodeResources{},
+						Allocatable:    apiv1.NodeResources{},
+						OSUpdateStatus: &apiv1.NodeOSUpdateStatus{State: apiv1.NodeOSUpdateUnknown},
 					},
 				},
 				{
@@ -771,9 +774,10 @@ func TestListNodeDeploymentNodes(t *testing.T) {
 						},
 					},
 					Status: apiv1.NodeStatus{
-						MachineName: "venus-2",
-						Capacity:    apiv1.NodeResources{},
-						Allocatable: apiv1.NodeResources{},
+						MachineName:    "venus-2",
+						Capacity:       apiv1.NodeResources{},
+						Allocatable:    apiv1.NodeResources{},
+						OSUpdateStatus: &apiv1.NodeOSUpdateStatus{State: apiv1.NodeOSUpdateUnknown},
 					},
 				},
 			},
@@ -961,7 +965,7 @@ func TestListNodeDeploymentNodesEvents(t *testing.T) {
 				test.GenTestEvent("event-1", corev1.EventTypeNormal, "Started", "message started", "Machine", "venus-1-machine", "venus-1"),
 				test.GenTestEvent("event-2", corev1.EventTypeWarning, "Killed", "message killed", "Machine", "venus-1-machine", "venus-1"),
 			},
-			ExpectedResult: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResult: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 		},
 	}
 
@@ -1073,7 +1077,7 @@ func TestPatchNodeDeployment(t *testing.T) {
 		{
 			Name:                       "Scenario 4: Downgrade kubelet to too old",
 			Body:                       `{"spec":{"template":{"versions":{"kubelet":"9.6.0"}}}}`,
-			ExpectedResponse:           `{"error":{"code":400,"message":"kubelet version 9.6.0 is not compatible with control plane version 9.9.9"}}`,
+			ExpectedResponse:           `{"error":{"code":400,"message":"kubelet version 9.6.0 is not compatible with control plane version 9.9.9","reason":"VERSION_SKEW"}}`,
 			cluster:                    "keen-snyder",
 			HTTPStatus:                 http.StatusBadRequest,
 			project:                    test.GenDefaultProject().Name,
@@ -1089,7 +1093,7 @@ func TestPatchNodeDeployment(t *testing.T) {
 		{
 			Name:                       "Scenario 5: Upgrade kubelet to too new",
 			Body:                       `{"spec":{"template":{"versions":{"kubelet":"9.10.0"}}}}`,
-			ExpectedResponse:           `{"error":{"code":400,"message":"kubelet version 9.10.0 is not compatible with control plane version 9.9.9"}}`,
+			ExpectedResponse:           `{"error":{"code":400,"message":"kubelet version 9.10.0 is not compatible with control plane version 9.9.9","reason":"VERSION_SKEW"}}`,
 			cluster:                    "keen-snyder",
 			HTTPStatus:                 http.StatusBadRequest,
 			project:                    test.GenDefaultProject().Name,
@@ -1122,7 +1126,7 @@ func TestPatchNodeDeployment(t *testing.T) {
 		{
 			Name:                       "Scenario 7: The user John can not update Bob's node deployment",
 			Body:                       fmt.Sprintf(`{"spec":{"replicas":%v}}`, replicasUpdated),
-			ExpectedResponse:           `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse:           `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			cluster:                    "keen-snyder",
 			HTTPStatus:                 http.StatusForbidden,
 			project:                    test.GenDefaultProject().Name,
@@ -1419,7 +1423,7 @@ func TestNodeDeploymentMetrics(t *testing.T) {
 					Usage:      map[corev1.ResourceName]resource.Quantity{"cpu": cpuQuantity, "memory": memoryQuantity},
 				},
 			},
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 		},
 	}
 
@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"time"
 	"unicode/utf8"
 
 	"github.com/go-kit/kit/endpoint"
@@ -40,6 +42,13 @@ import (
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
+const (
+	// lastRotatedAtAnnotation records on the token secret when the token was regenerated the last time.
+	lastRotatedAtAnnotation = "kubermatic.io/last-rotated-at"
+	// rotationCountAnnotation records on the token secret how many times the token was regenerated.
+	rotationCountAnnotation = "kubermatic.io/rotation-count"
+)
+
 // CreateTokenEndpoint creates a token for the given service account.
 func CreateTokenEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, serviceAccountProvider provider.ServiceAccountProvider, privilegedServiceAccount provider.PrivilegedServiceAccountProvider, serviceAccountTokenProvider provider.ServiceAccountTokenProvider, privilegedServiceAccountTokenProvider provider.PrivilegedServiceAccountTokenProvider, tokenAuthenticator serviceaccount.TokenAuthenticator, tokenGenerator serviceaccount.TokenGenerator, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
@@ -187,7 +196,7 @@ func UpdateTokenEndpoint(projectProvider provider.ProjectProvider, privilegedPro
 			return nil, utilerrors.NewBadRequest("%v", err)
 		}
 
-		secret, err := updateEndpoint(ctx, projectProvider, privilegedProjectProvider, serviceAccountProvider, privilegedServiceAccount, serviceAccountTokenProvider, privilegedServiceAccountTokenProvider, userInfoGetter, tokenGenerator, req.ProjectID, req.ServiceAccountID, req.TokenID, req.Body.Name, true)
+		secret, err := updateEndpoint(ctx, projectProvider, privilegedProjectProvider, serviceAccountProvider, privilegedServiceAccount, serviceAccountTokenProvider, privilegedServiceAccountTokenProvider, userInfoGetter, tokenGenerator, req.ProjectID, req.ServiceAccountID, req.TokenID, req.Body.Name, true, req.Body.Expiry.Time)
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
@@ -218,7 +227,7 @@ func PatchTokenEndpoint(projectProvider provider.ProjectProvider, privilegedProj
 			return nil, utilerrors.NewBadRequest("new name can not be empty")
 		}
 
-		secret, err := updateEndpoint(ctx, projectProvider, privilegedProjectProvider, serviceAccountProvider, privilegedServiceAccount, serviceAccountTokenProvider, privilegedServiceAccountTokenProvider, userInfoGetter, tokenGenerator, req.ProjectID, req.ServiceAccountID, req.TokenID, tokenReq.Name, false)
+		secret, err := updateEndpoint(ctx, projectProvider, privilegedProjectProvider, serviceAccountProvider, privilegedServiceAccount, serviceAccountTokenProvider, privilegedServiceAccountTokenProvider, userInfoGetter, tokenGenerator, req.ProjectID, req.ServiceAccountID, req.TokenID, tokenReq.Name, false, time.Time{})
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
@@ -290,9 +299,11 @@ func getSAToken(ctx context.Context, userInfoGetter provider.UserInfoGetter, ser
 	return serviceAccountTokenProvider.Get(ctx, userInfo, tokenID)
 }
 
+// updateEndpoint renames the token and regenerates it, if requested. The regenerated token expires at the given
+// expiry or, if it is zero, at the default expiry.
 func updateEndpoint(ctx context.Context, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, serviceAccountProvider provider.ServiceAccountProvider,
 	privilegedServiceAccount provider.PrivilegedServiceAccountProvider, serviceAccountTokenProvider provider.ServiceAccountTokenProvider, privilegedServiceAccountTokenProvider provider.PrivilegedServiceAccountTokenProvider, userInfoGetter provider.UserInfoGetter, tokenGenerator serviceaccount.TokenGenerator,
-	projectID, saID, tokenID, newName string, regenerateToken bool,
+	projectID, saID, tokenID, newName string, regenerateToken bool, expiry time.Time,
 ) (*corev1.Secret, error) {
 	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
 	if err != nil {
@@ -330,12 +341,16 @@ func updateEndpoint(ctx context.Context, projectProvider provider.ProjectProvide
 	}
 
 	if regenerateToken {
-		token, err := tokenGenerator.Generate(serviceaccount.Claims(sa.Spec.Email, project.Name, existingSecret.Name))
+		if expiry.IsZero() {
+			expiry = serviceaccount.DefaultExpiry()
+		}
+		token, err := tokenGenerator.Generate(serviceaccount.ClaimsWithExpiry(sa.Spec.Email, project.Name, existingSecret.Name, expiry))
 		if err != nil {
 			return nil, fmt.Errorf("can not generate token data")
 		}
 
 		existingSecret.Data["token"] = []byte(token)
+		recordTokenRotation(existingSecret)
 	}
 
 	secret, err := updateSAToken(ctx, userInfoGetter, serviceAccountTokenProvider, privilegedServiceAccountTokenProvider, existingSecret, projectID)
@@ -346,6 +361,26 @@ func updateEndpoint(ctx context.Context, projectProvider provider.ProjectProvide
 	return secret, nil
 }
 
+// recordTokenRotation updates the rotation history of the token secret.
+func recordTokenRotation(secret *corev1.Secret) {
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+
+	secret.Annotations[rotationCountAnnotation] = strconv.Itoa(tokenRotationCount(secret) + 1)
+	secret.Annotations[lastRotatedAtAnnotation] = serviceaccount.Now().UTC().Format(time.RFC3339)
+}
+
+// tokenRotationCount returns how many times the token was regenerated. A missing or malformed count is treated as
+// zero, so that it starts over with the next rotation.
+func tokenRotationCount(secret *corev1.Secret) int {
+	count, err := strconv.Atoi(secret.Annotations[rotationCountAnnotation])
+	if err != nil || count < 0 {
+		return 0
+	}
+	return count
+}
+
 func updateSAToken(ctx context.Context, userInfoGetter provider.UserInfoGetter, serviceAccountTokenProvider provider.ServiceAccountTokenProvider, privilegedServiceAccountTokenProvider provider.PrivilegedServiceAccountTokenProvider, token *corev1.Secret, projectID string) (*corev1.Secret, error) {
 	adminUserInfo, err := userInfoGetter(ctx, "")
 	if err != nil {
@@ -443,6 +478,14 @@ func (r updateTokenReq) Validate() error {
 	if r.TokenID != r.Body.ID {
 		return fmt.Errorf("token ID mismatch, you requested to update token %q but body contains token %q", r.TokenID, r.Body.ID)
 	}
+	if !r.Body.Expiry.IsZero() {
+		if !r.Body.Expiry.After(serviceaccount.Now()) {
+			return fmt.Errorf("the expiry must be in the future")
+		}
+		if r.Body.Expiry.After(serviceaccount.DefaultExpiry()) {
+			return fmt.Errorf("the expiry can not be later than %s", serviceaccount.DefaultExpiry().UTC().Format(time.RFC3339))
+		}
+	}
 
 	return nil
 }
@@ -630,6 +673,13 @@ func convertInternalTokenToPublicExternal(internal *corev1.Secret, authenticator
 
 	externalToken.CreationTimestamp = apiv1.NewTime(internal.CreationTimestamp.Time)
 
+	// the rotation history is informational only, malformed values are left out
+	if lastRotatedAt, err := time.Parse(time.RFC3339, internal.Annotations[lastRotatedAtAnnotation]); err == nil {
+		rotatedAt := apiv1.NewTime(lastRotatedAt)
+		externalToken.LastRotatedAt = &rotatedAt
+	}
+	externalToken.RotationCount = tokenRotationCount(internal)
+
 	publicClaim, _, err := authenticator.Authenticate(string(token))
 	// set invalidated flag to true if you can't authenticate token
 	// It will force the user to regenerate token
@@ -22,8 +22,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	"k8c.io/dashboard/v2/pkg/handler/test"
@@ -246,6 +248,29 @@ func TestListTokens(t *testing.T) {
 				genPublicServiceAccountToken("3", "test-3", expiry),
 			},
 		},
+		{
+			name:       "scenario 3: a malformed rotation history is left out",
+			httpStatus: http.StatusOK,
+			existingKubermaticObjs: []ctrlruntimeclient.Object{
+				/*add projects*/
+				test.GenProject("plan9", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				/*add bindings*/
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				test.GenBinding("plan9-ID", "serviceaccount-1@sa.kubermatic.io", "editors"),
+				/*add users*/
+				test.GenUser("", "john", "john@acme.com"),
+				test.GenProjectServiceAccount("1", "test-1", "editors", "plan9-ID"),
+			},
+			existingKubernetesObjs: []ctrlruntimeclient.Object{
+				genSaTokenWithRotationHistory("plan9-ID", "serviceaccount-1", "test-1", "1", "yesterday", "twice"),
+			},
+			existingAPIUser: *test.GenAPIUser("john", "john@acme.com"),
+			projectToSync:   "plan9-ID",
+			saToSync:        "1",
+			expectedTokens: []apiv1.PublicServiceAccountToken{
+				genPublicServiceAccountToken("1", "test-1", expiry),
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -359,6 +384,7 @@ func TestPatchToken(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	lastRotatedAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
 	testcases := []struct {
 		name                   string
 		body                   string
@@ -466,6 +492,29 @@ func TestPatchToken(t *testing.T) {
 			tokenToSync:     "1",
 			expectedToken:   genPublicServiceAccountToken("1", "test-new-name", expiry),
 		},
+		{
+			name:       "scenario 5: changing the name doesn't change the rotation history",
+			httpStatus: http.StatusOK,
+			body:       `{"name":"test-new-name"}`,
+			existingKubermaticObjs: []ctrlruntimeclient.Object{
+				/*add projects*/
+				test.GenProject("plan9", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				/*add bindings*/
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				test.GenBinding("plan9-ID", "serviceaccount-1@sa.kubermatic.io", "editors"),
+				/*add users*/
+				test.GenUser("", "john", "john@acme.com"),
+				test.GenProjectServiceAccount("1", "test-1", "editors", "plan9-ID"),
+			},
+			existingKubernetesObjs: []ctrlruntimeclient.Object{
+				genRotatedSaToken("plan9-ID", "serviceaccount-1", "test-1", "1", lastRotatedAt, 2),
+			},
+			existingAPIUser: *test.GenAPIUser("john", "john@acme.com"),
+			projectToSync:   "plan9-ID",
+			saToSync:        "1",
+			tokenToSync:     "1",
+			expectedToken:   genRotatedPublicServiceAccountToken("1", "test-new-name", expiry, lastRotatedAt, 2),
+		},
 	}
 
 	for _, tc := range testcases {
@@ -500,6 +549,9 @@ func TestPatchToken(t *testing.T) {
 				if token.Expiry != tc.expectedToken.Expiry {
 					t.Fatalf("expected expiry %v got %v", tc.expectedToken.Expiry, token.Expiry)
 				}
+				if token.RotationCount != tc.expectedToken.RotationCount || !token.LastRotatedAt.Equal(tc.expectedToken.LastRotatedAt) {
+					t.Fatalf("expected rotation count %d and last rotation %v, got %d and %v", tc.expectedToken.RotationCount, tc.expectedToken.LastRotatedAt, token.RotationCount, token.LastRotatedAt)
+				}
 			} else {
 				test.CompareWithResult(t, res, tc.expectedErrorMsg)
 			}
@@ -513,12 +565,16 @@ func TestUpdateToken(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	lastRotatedAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	requestedExpiry := time.Now().AddDate(0, 1, 0).UTC().Truncate(time.Second)
 	testcases := []struct {
 		name                   string
 		body                   string
 		existingKubermaticObjs []ctrlruntimeclient.Object
 		existingKubernetesObjs []ctrlruntimeclient.Object
 		expectedToken          apiv1.PublicServiceAccountToken
+		expectedExpiry         time.Time
+		expectedRotationCount  int
 		expectedErrorMsg       string
 		projectToSync          string
 		saToSync               string
@@ -543,11 +599,12 @@ func TestUpdateToken(t *testing.T) {
 			existingKubernetesObjs: []ctrlruntimeclient.Object{
 				test.GenDefaultSaToken("plan9-ID", "serviceaccount-1", "test-1", "1"),
 			},
-			existingAPIUser: *test.GenAPIUser("john", "john@acme.com"),
-			projectToSync:   "plan9-ID",
-			saToSync:        "1",
-			tokenToSync:     "1",
-			expectedToken:   genPublicServiceAccountToken("1", "test-new-name", expiry),
+			existingAPIUser:       *test.GenAPIUser("john", "john@acme.com"),
+			projectToSync:         "plan9-ID",
+			saToSync:              "1",
+			tokenToSync:           "1",
+			expectedToken:         genPublicServiceAccountToken("1", "test-new-name", expiry),
+			expectedRotationCount: 1,
 		},
 		{
 			name:       "scenario 2: changed name is empty",
@@ -614,11 +671,12 @@ func TestUpdateToken(t *testing.T) {
 			existingKubernetesObjs: []ctrlruntimeclient.Object{
 				test.GenDefaultSaToken("plan9-ID", "serviceaccount-1", "test-1", "1"),
 			},
-			existingAPIUser: *test.GenAPIUser("bob", "bob@acme.com"),
-			projectToSync:   "plan9-ID",
-			saToSync:        "1",
-			tokenToSync:     "1",
-			expectedToken:   genPublicServiceAccountToken("1", "test-new-name", expiry),
+			existingAPIUser:       *test.GenAPIUser("bob", "bob@acme.com"),
+			projectToSync:         "plan9-ID",
+			saToSync:              "1",
+			tokenToSync:           "1",
+			expectedToken:         genPublicServiceAccountToken("1", "test-new-name", expiry),
+			expectedRotationCount: 1,
 		},
 		{
 			name:       "scenario 5: the user Bob can change John's token name and regenerate token",
@@ -642,7 +700,79 @@ func TestUpdateToken(t *testing.T) {
 			projectToSync:    "plan9-ID",
 			saToSync:         "1",
 			tokenToSync:      "1",
-			expectedErrorMsg: `{"error":{"code":403,"message":"forbidden: \"bob@acme.com\" doesn't belong to project plan9-ID"}}`,
+			expectedErrorMsg: `{"error":{"code":403,"message":"forbidden: \"bob@acme.com\" doesn't belong to project plan9-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+		},
+		{
+			name:       "scenario 6: regenerate a rotated token with the requested expiry",
+			httpStatus: http.StatusOK,
+			body:       fmt.Sprintf(`{"name":"test-1", "id":"1", "expiry":"%s"}`, requestedExpiry.Format(time.RFC3339)),
+			existingKubermaticObjs: []ctrlruntimeclient.Object{
+				/*add projects*/
+				test.GenProject("plan9", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				/*add bindings*/
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				test.GenBinding("plan9-ID", "serviceaccount-1@sa.kubermatic.io", "editors"),
+				/*add users*/
+				test.GenUser("", "john", "john@acme.com"),
+				test.GenProjectServiceAccount("1", "test-1", "editors", "plan9-ID"),
+			},
+			existingKubernetesObjs: []ctrlruntimeclient.Object{
+				genRotatedSaToken("plan9-ID", "serviceaccount-1", "test-1", "1", lastRotatedAt, 2),
+			},
+			existingAPIUser:       *test.GenAPIUser("john", "john@acme.com"),
+			projectToSync:         "plan9-ID",
+			saToSync:              "1",
+			tokenToSync:           "1",
+			expectedToken:         genPublicServiceAccountToken("1", "test-1", expiry),
+			expectedExpiry:        requestedExpiry,
+			expectedRotationCount: 3,
+		},
+		{
+			name:       "scenario 7: the requested expiry is in the past",
+			httpStatus: http.StatusBadRequest,
+			body:       `{"name":"test-1", "id":"1", "expiry":"2020-01-01T00:00:00Z"}`,
+			existingKubermaticObjs: []ctrlruntimeclient.Object{
+				/*add projects*/
+				test.GenProject("plan9", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				/*add bindings*/
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				test.GenBinding("plan9-ID", "serviceaccount-1@sa.kubermatic.io", "editors"),
+				/*add users*/
+				test.GenUser("", "john", "john@acme.com"),
+				test.GenProjectServiceAccount("1", "test-1", "editors", "plan9-ID"),
+			},
+			existingKubernetesObjs: []ctrlruntimeclient.Object{
+				test.GenDefaultSaToken("plan9-ID", "serviceaccount-1", "test-1", "1"),
+			},
+			existingAPIUser:  *test.GenAPIUser("john", "john@acme.com"),
+			projectToSync:    "plan9-ID",
+			saToSync:         "1",
+			tokenToSync:      "1",
+			expectedErrorMsg: `{"error":{"code":400,"message":"the expiry must be in the future"}}`,
+		},
+		{
+			name:       "scenario 8: a malformed rotation count starts over",
+			httpStatus: http.StatusOK,
+			body:       `{"name":"test-1", "id":"1"}`,
+			existingKubermaticObjs: []ctrlruntimeclient.Object{
+				/*add projects*/
+				test.GenProject("plan9", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				/*add bindings*/
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				test.GenBinding("plan9-ID", "serviceaccount-1@sa.kubermatic.io", "editors"),
+				/*add users*/
+				test.GenUser("", "john", "john@acme.com"),
+				test.GenProjectServiceAccount("1", "test-1", "editors", "plan9-ID"),
+			},
+			existingKubernetesObjs: []ctrlruntimeclient.Object{
+				genSaTokenWithRotationHistory("plan9-ID", "serviceaccount-1", "test-1", "1", "yesterday", "-3"),
+			},
+			existingAPIUser:       *test.GenAPIUser("john", "john@acme.com"),
+			projectToSync:         "plan9-ID",
+			saToSync:              "1",
+			tokenToSync:           "1",
+			expectedToken:         genPublicServiceAccountToken("1", "test-1", expiry),
+			expectedRotationCount: 1,
 		},
 	}
 
@@ -681,6 +811,15 @@ func TestUpdateToken(t *testing.T) {
 				if token.Token == test.TestFakeToken {
 					t.Fatalf("token should be regenerated")
 				}
+				if !tc.expectedExpiry.IsZero() && !token.Expiry.Time.Equal(tc.expectedExpiry) {
+					t.Fatalf("expected expiry %v got %v", tc.expectedExpiry, token.Expiry)
+				}
+				if token.RotationCount != tc.expectedRotationCount {
+					t.Fatalf("expected rotation count %d got %d", tc.expectedRotationCount, token.RotationCount)
+				}
+				if token.LastRotatedAt == nil || !token.LastRotatedAt.After(lastRotatedAt) {
+					t.Fatalf("expected the last rotation time to be updated, got %v", token.LastRotatedAt)
+				}
 			} else {
 				test.CompareWithResult(t, res, tc.expectedErrorMsg)
 			}
@@ -796,3 +935,24 @@ func genPublicServiceAccountToken(id, name string, expiry apiv1.Time) apiv1.Publ
 	token.Expiry = expiry
 	return token
 }
+
+func genRotatedPublicServiceAccountToken(id, name string, expiry apiv1.Time, lastRotatedAt time.Time, rotationCount int) apiv1.PublicServiceAccountToken {
+	token := genPublicServiceAccountToken(id, name, expiry)
+	rotatedAt := apiv1.NewTime(lastRotatedAt)
+	token.LastRotatedAt = &rotatedAt
+	token.RotationCount = rotationCount
+	return token
+}
+
+func genRotatedSaToken(projectID, saID, name, id string, lastRotatedAt time.Time, rotationCount int) *corev1.Secret {
+	return genSaTokenWithRotationHistory(projectID, saID, name, id, lastRotatedAt.Format(time.RFC3339), strconv.Itoa(rotationCount))
+}
+
+func genSaTokenWithRotationHistory(projectID, saID, name, id, lastRotatedAt, rotationCount string) *corev1.Secret {
+	secret := test.GenDefaultSaToken(projectID, saID, name, id)
+	secret.Annotations = map[string]string{
+		"kubermatic.io/last-rotated-at": lastRotatedAt,
+		"kubermatic.io/rotation-count":  rotationCount,
+	}
+	return secret
+}
@@ -21,6 +21,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/go-kit/kit/endpoint"
 	"github.com/gorilla/mux"
@@ -30,6 +33,7 @@ import (
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/features"
+	"k8c.io/kubermatic/v2/pkg/log"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 )
 
@@ -93,7 +97,7 @@ func createUserSSHKey(ctx context.Context, userInfoGetter provider.UserInfoGette
 	return keyProvider.Create(ctx, userInfo, project, keyName, pubKey)
 }
 
-func DeleteEndpoint(keyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, features features.FeatureGate) endpoint.Endpoint {
+func DeleteEndpoint(keyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, features features.FeatureGate) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		if features.Enabled(DisableUserSSHKey) {
 			return nil, fmt.Errorf("SSH keys feature is disabled")
@@ -106,6 +110,11 @@ func DeleteEndpoint(keyProvider provider.SSHKeyProvider, privilegedSSHKeyProvide
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
+		if !req.Force {
+			if err := ensureSSHKeyNotUsed(ctx, keyProvider, seedsGetter, clusterProviderGetter, project, req.SSHKeyID); err != nil {
+				return nil, err
+			}
+		}
 		if err := deleteUserSSHKey(ctx, userInfoGetter, keyProvider, privilegedSSHKeyProvider, project, req.SSHKeyID); err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
@@ -128,7 +137,85 @@ func deleteUserSSHKey(ctx context.Context, userInfoGetter provider.UserInfoGette
 	return keyProvider.Delete(ctx, userInfo, keyName)
 }
 
-func ListEndpoint(keyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, features features.FeatureGate) endpoint.Endpoint {
+// ensureSSHKeyNotUsed returns a conflict error if the key is still assigned to an existing cluster of the project.
+func ensureSSHKeyNotUsed(ctx context.Context, keyProvider provider.SSHKeyProvider, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, project *kubermaticv1.Project, keyID string) error {
+	keys, err := keyProvider.List(ctx, project, nil)
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+
+	for _, key := range keys {
+		if key.Name != keyID || len(key.Spec.Clusters) == 0 {
+			continue
+		}
+
+		clusters, err := getProjectClusters(ctx, seedsGetter, clusterProviderGetter, project)
+		if err != nil {
+			return err
+		}
+
+		if usedBy := usedByClusters(key, clusters); len(usedBy) > 0 {
+			names := make([]string, 0, len(usedBy))
+			for _, cluster := range usedBy {
+				names = append(names, cluster.Name)
+			}
+			return utilerrors.New(http.StatusConflict, fmt.Sprintf("ssh key %s is still used by the clusters %s, set force=true to delete it anyway", key.Spec.Name, strings.Join(names, ", ")))
+		}
+	}
+
+	return nil
+}
+
+// getProjectClusters returns the clusters of the project from all seeds, indexed by their ID.
+func getProjectClusters(ctx context.Context, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, project *kubermaticv1.Project) (map[string]kubermaticv1.Cluster, error) {
+	seeds, err := seedsGetter()
+	if err != nil {
+		return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to list seeds: %v", err))
+	}
+
+	projectClusters := map[string]kubermaticv1.Cluster{}
+	for seedName, seed := range seeds {
+		if seed.Status.Phase == kubermaticv1.SeedInvalidPhase {
+			log.Logger.Warnf("skipping seed %s as it is in an invalid phase", seedName)
+			continue
+		}
+
+		clusterProvider, err := clusterProviderGetter(seed)
+		if err != nil {
+			// if one or more Seeds are bad, continue with the request, log that a Seed is in error
+			log.Logger.Warnw("error getting cluster provider", "seed", seedName, "error", err)
+			continue
+		}
+		clusters, err := clusterProvider.List(ctx, project, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		for _, cluster := range clusters.Items {
+			projectClusters[cluster.Name] = cluster
+		}
+	}
+
+	return projectClusters, nil
+}
+
+// usedByClusters returns the clusters the key is assigned to, sorted by their ID. Clusters which don't
+// exist anymore are ignored.
+func usedByClusters(key *kubermaticv1.UserSSHKey, clusters map[string]kubermaticv1.Cluster) []apiv1.SSHKeyCluster {
+	var result []apiv1.SSHKeyCluster
+	for _, clusterID := range key.Spec.Clusters {
+		if cluster, ok := clusters[clusterID]; ok {
+			result = append(result, apiv1.SSHKeyCluster{ID: cluster.Name, Name: cluster.Spec.HumanReadableName})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+func ListEndpoint(keyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, features features.FeatureGate) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		if features.Enabled(DisableUserSSHKey) {
 			return nil, fmt.Errorf("SSH keys feature is disabled")
@@ -152,6 +239,17 @@ func ListEndpoint(keyProvider provider.SSHKeyProvider, projectProvider provider.
 		}
 
 		apiKeys := common.ConvertInternalSSHKeysToExternal(keys)
+
+		if req.ShowUsage {
+			clusters, err := getProjectClusters(ctx, seedsGetter, clusterProviderGetter, project)
+			if err != nil {
+				return nil, err
+			}
+			for i, key := range keys {
+				apiKeys[i].UsedByClusters = usedByClusters(key, clusters)
+			}
+		}
+
 		return apiKeys, nil
 	}
 }
@@ -160,6 +258,9 @@ func ListEndpoint(keyProvider provider.SSHKeyProvider, projectProvider provider.
 // swagger:parameters listSSHKeys
 type ListReq struct {
 	common.ProjectReq
+
+	// in: query
+	ShowUsage bool `json:"show_usage"`
 }
 
 func DecodeListReq(c context.Context, r *http.Request) (interface{}, error) {
@@ -167,7 +268,10 @@ func DecodeListReq(c context.Context, r *http.Request) (interface{}, error) {
 	if err != nil {
 		return nil, nil
 	}
-	return ListReq{ProjectReq: req.(common.ProjectReq)}, err
+	return ListReq{
+		ProjectReq: req.(common.ProjectReq),
+		ShowUsage:  strings.EqualFold(r.URL.Query().Get("show_usage"), "true"),
+	}, err
 }
 
 // DeleteReq defines HTTP request for deleteSSHKey endpoint
@@ -176,6 +280,10 @@ type DeleteReq struct {
 	common.ProjectReq
 	// in: path
 	SSHKeyID string `json:"key_id"`
+
+	// Force deletes the key even if it is still assigned to clusters.
+	// in: query
+	Force bool `json:"force"`
 }
 
 func DecodeDeleteReq(c context.Context, r *http.Request) (interface{}, error) {
@@ -193,6 +301,14 @@ func DecodeDeleteReq(c context.Context, r *http.Request) (interface{}, error) {
 	}
 
 	req.SSHKeyID = SSHKeyID
+
+	if force := r.URL.Query().Get("force"); force != "" {
+		req.Force, err = strconv.ParseBool(force)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("wrong query parameter `force`: %v", err)
+		}
+	}
+
 	return req, nil
 }
 
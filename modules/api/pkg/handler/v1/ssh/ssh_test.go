@@ -46,6 +46,7 @@ func TestDeleteSSHKey(t *testing.T) {
 		Name                   string
 		HTTPStatus             int
 		SSHKeyToDelete         string
+		Query                  string
 		ExistingKubermaticObjs []ctrlruntimeclient.Object
 		ExistingAPIUser        *apiv1.User
 		ExistingSSHKeys        []*kubermaticv1.UserSSHKey
@@ -112,12 +113,53 @@ func TestDeleteSSHKey(t *testing.T) {
 			},
 			ExistingAPIUser: test.GenAPIUser("user", "user@acme.com"),
 		},
+		// scenario 4
+		{
+			Name:           "scenario 4: an SSH key which is still assigned to a cluster can not be deleted",
+			HTTPStatus:     http.StatusConflict,
+			SSHKeyToDelete: "key-c08aa5c7abf34504f18552846485267d-first-key",
+			ExistingKubermaticObjs: []ctrlruntimeclient.Object{
+				/*add projects*/
+				test.GenProject("my-first-project", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				/*add bindings*/
+				test.GenBinding("my-first-project-ID", "john@acme.com", "owners"),
+				/*add users*/
+				test.GenUser("", "john", "john@acme.com"),
+				/*add seed and cluster*/
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				/*add ssh keys*/
+				genSSHKey(test.DefaultCreationTimestamp(), "c08aa5c7abf34504f18552846485267d", "first-key", "my-first-project-ID", test.GenDefaultCluster().Name),
+			},
+			ExistingAPIUser: test.GenAPIUser("john", "john@acme.com"),
+		},
+		// scenario 5
+		{
+			Name:           "scenario 5: an SSH key which is still assigned to a cluster can be deleted with force",
+			HTTPStatus:     http.StatusOK,
+			SSHKeyToDelete: "key-c08aa5c7abf34504f18552846485267d-first-key",
+			Query:          "?force=true",
+			ExistingKubermaticObjs: []ctrlruntimeclient.Object{
+				/*add projects*/
+				test.GenProject("my-first-project", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				/*add bindings*/
+				test.GenBinding("my-first-project-ID", "john@acme.com", "owners"),
+				/*add users*/
+				test.GenUser("", "john", "john@acme.com"),
+				/*add seed and cluster*/
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				/*add ssh keys*/
+				genSSHKey(test.DefaultCreationTimestamp(), "c08aa5c7abf34504f18552846485267d", "first-key", "my-first-project-ID", test.GenDefaultCluster().Name),
+			},
+			ExistingAPIUser: test.GenAPIUser("john", "john@acme.com"),
+		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.Name, func(t *testing.T) {
 			sshKeyID := tc.SSHKeyToDelete
-			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/projects/%s/sshkeys/%s", "my-first-project-ID", sshKeyID), nil)
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/projects/%s/sshkeys/%s%s", "my-first-project-ID", sshKeyID, tc.Query), nil)
 			res := httptest.NewRecorder()
 			kubermaticObj := []ctrlruntimeclient.Object{}
 			kubermaticObj = append(kubermaticObj, tc.ExistingKubermaticObjs...)
@@ -142,6 +184,7 @@ func TestListSSHKeys(t *testing.T) {
 	testcases := []struct {
 		Name                   string
 		Body                   string
+		Query                  string
 		ExpectedKeys           []apiv1.SSHKey
 		HTTPStatus             int
 		ExistingProject        *kubermaticv1.Project
@@ -224,11 +267,52 @@ func TestListSSHKeys(t *testing.T) {
 			},
 			ExistingAPIUser: test.GenAPIUser("admin", "admin@acme.com"),
 		},
+		// scenario 3
+		{
+			Name:  "scenario 3: gets a list of ssh keys together with the clusters which use them",
+			Body:  ``,
+			Query: "?show_usage=true",
+			ExpectedKeys: []apiv1.SSHKey{
+				{
+					ObjectMeta: apiv1.ObjectMeta{
+						ID:                "key-c08aa5c7abf34504f18552846485267d-first-key",
+						Name:              "first-key",
+						CreationTimestamp: apiv1.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC),
+					},
+					UsedByClusters: []apiv1.SSHKeyCluster{
+						{ID: test.GenDefaultCluster().Name, Name: test.GenDefaultCluster().Spec.HumanReadableName},
+					},
+				},
+				{
+					ObjectMeta: apiv1.ObjectMeta{
+						ID:                "key-abc-second-key",
+						Name:              "second-key",
+						CreationTimestamp: apiv1.Date(2013, 02, 03, 19, 55, 0, 0, time.UTC),
+					},
+				},
+			},
+			HTTPStatus: http.StatusOK,
+			ExistingKubermaticObjs: []ctrlruntimeclient.Object{
+				/*add projects*/
+				test.GenProject("my-first-project", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				/*add bindings*/
+				test.GenBinding("my-first-project-ID", "john@acme.com", "owners"),
+				/*add users*/
+				test.GenUser("", "john", "john@acme.com"),
+				/*add seed and cluster*/
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				/*add ssh keys, the second key refers to a cluster which doesn't exist anymore*/
+				genSSHKey(creationTime, "c08aa5c7abf34504f18552846485267d", "first-key", "my-first-project-ID", test.GenDefaultCluster().Name),
+				genSSHKey(creationTime.Add(time.Minute), "abc", "second-key", "my-first-project-ID", "abcd-ID"),
+			},
+			ExistingAPIUser: test.GenAPIUser("john", "john@acme.com"),
+		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.Name, func(t *testing.T) {
-			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/projects/%s/sshkeys", "my-first-project-ID"), strings.NewReader(tc.Body))
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/projects/%s/sshkeys%s", "my-first-project-ID", tc.Query), strings.NewReader(tc.Body))
 			res := httptest.NewRecorder()
 			kubermaticObj := []ctrlruntimeclient.Object{}
 			kubermaticObj = append(kubermaticObj, tc.ExistingKubermaticObjs...)
@@ -24,6 +24,8 @@ import (
 	"io"
 	"net/http"
 	"net/mail"
+	"sort"
+	"strconv"
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -31,6 +33,7 @@ import (
 	"github.com/gorilla/mux"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
@@ -42,8 +45,9 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
-// DeleteEndpoint deletes the given user/member from the given project.
-func DeleteEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userProvider provider.UserProvider, memberProvider provider.ProjectMemberProvider, privilegedMemberProvider provider.PrivilegedProjectMemberProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+// DeleteEndpoint deletes the given user/member from the given project and revokes the access of the member to the
+// clusters of the project.
+func DeleteEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userProvider provider.UserProvider, memberProvider provider.ProjectMemberProvider, privilegedMemberProvider provider.PrivilegedProjectMemberProvider, userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(DeleteReq)
 		if !ok {
@@ -82,12 +86,97 @@ func DeleteEndpoint(projectProvider provider.ProjectProvider, privilegedProjectP
 			return nil, utilerrors.New(http.StatusForbidden, "you cannot delete yourself from the project")
 		}
 
+		seeds, err := seedsGetter()
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
 		if err = deleteBinding(ctx, userInfoGetter, memberProvider, privilegedMemberProvider, req.ProjectID, bindingForRequestedMember.Name); err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
 
-		return nil, nil
+		return revokeClusterAccess(ctx, seeds, clusterProviderGetter, project, bindingForRequestedMember.Spec.UserEmail, req.RevokeTokens), nil
+	}
+}
+
+// revokeClusterAccess removes the member from the role bindings in all clusters of the project, so that kubeconfigs
+// downloaded by the member stop working right away. Failures are reported per cluster and don't stop the revocation
+// for the remaining clusters.
+func revokeClusterAccess(ctx context.Context, seeds map[string]*kubermaticv1.Seed, clusterProviderGetter provider.ClusterProviderGetter, project *kubermaticv1.Project, userEmail string, revokeTokens bool) *apiv1.ProjectMemberRemoval {
+	result := &apiv1.ProjectMemberRemoval{Clusters: []apiv1.ClusterAccessRevocation{}}
+
+	for _, seed := range seeds {
+		if seed.Status.Phase == kubermaticv1.SeedInvalidPhase {
+			log.Logger.Warnf("skipping seed %s as it is in an invalid phase", seed.Name)
+			result.FailedSeeds = append(result.FailedSeeds, seed.Name)
+			continue
+		}
+
+		clusterProvider, err := clusterProviderGetter(seed)
+		if err != nil {
+			log.Logger.Errorw("failed to create cluster provider", "seed", seed.Name, "error", err)
+			result.FailedSeeds = append(result.FailedSeeds, seed.Name)
+			continue
+		}
+
+		clusters, err := clusterProvider.List(ctx, project, nil)
+		if err != nil {
+			log.Logger.Errorw("failed to get clusters from seed", "seed", seed.Name, "error", err)
+			result.FailedSeeds = append(result.FailedSeeds, seed.Name)
+			continue
+		}
+
+		for i := range clusters.Items {
+			result.Clusters = append(result.Clusters, revokeUserClusterAccess(ctx, clusterProvider, &clusters.Items[i], userEmail, revokeTokens))
+		}
+	}
+
+	sort.Strings(result.FailedSeeds)
+	sort.Slice(result.Clusters, func(i, j int) bool {
+		return result.Clusters[i].ClusterID < result.Clusters[j].ClusterID
+	})
+
+	return result
+}
+
+func revokeUserClusterAccess(ctx context.Context, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, userEmail string, revokeTokens bool) apiv1.ClusterAccessRevocation {
+	status := apiv1.ClusterAccessRevocation{
+		ClusterID:   cluster.Name,
+		ClusterName: cluster.Spec.HumanReadableName,
+	}
+
+	var errs []error
+	if err := unbindUserFromCluster(ctx, clusterProvider, cluster, userEmail); err != nil {
+		errs = append(errs, err)
+	} else {
+		status.BindingsRevoked = true
+	}
+
+	if revokeTokens {
+		if err := clusterProvider.RevokeViewerKubeconfig(ctx, cluster); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to revoke viewer token: %w", err))
+		} else {
+			status.ViewerTokenRevoked = true
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		log.Logger.Warnw("failed to revoke cluster access of removed project member", "cluster", cluster.Name, "user", userEmail, "error", err)
+		status.Error = err.Error()
+	}
+
+	return status
+}
+
+func unbindUserFromCluster(ctx context.Context, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, userEmail string) error {
+	client, err := clusterProvider.GetAdminClientForUserCluster(ctx, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to get client for user cluster: %w", err)
+	}
+	if err := handlercommon.UnbindUserFromRoles(ctx, client, userEmail); err != nil {
+		return err
 	}
+	return handlercommon.UnbindUserFromClusterRoles(ctx, client, userEmail)
 }
 
 func deleteBinding(ctx context.Context, userInfoGetter provider.UserInfoGetter, memberProvider provider.ProjectMemberProvider, privilegedMemberProvider provider.PrivilegedProjectMemberProvider, projectID, bindingID string) error {
@@ -548,6 +637,9 @@ func DecodeEditReq(c context.Context, r *http.Request) (interface{}, error) {
 type DeleteReq struct {
 	common.ProjectReq
 	IDReq
+	// in: header
+	// RevokeTokens if true the viewer tokens of the project clusters are rotated as well
+	RevokeTokens bool
 }
 
 // DecodeDeleteReq  decodes an HTTP request into DeleteReq.
@@ -566,6 +658,15 @@ func DecodeDeleteReq(c context.Context, r *http.Request) (interface{}, error) {
 	}
 	req.UserID = userIDReq.UserID
 
+	headerValue := r.Header.Get("RevokeTokens")
+	if len(headerValue) > 0 {
+		revokeTokens, err := strconv.ParseBool(headerValue)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid value for RevokeTokens header: %v", err)
+		}
+		req.RevokeTokens = revokeTokens
+	}
+
 	return req, nil
 }
 
@@ -17,14 +17,18 @@ limitations under the License.
 package user_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -32,10 +36,13 @@ import (
 	"k8c.io/machine-controller/sdk/providerconfig"
 
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 func init() {
@@ -156,7 +163,7 @@ func TestGetUsersForProject(t *testing.T) {
 				genDefaultUser(), /*bob*/
 			},
 			ExistingAPIUser:        *genAPIUser("alice2", "alice2@acme.com"),
-			ExpectedResponseString: `{"error":{"code":403,"message":"forbidden: \"alice2@acme.com\" doesn't belong to project foo2-ID"}}`,
+			ExpectedResponseString: `{"error":{"code":403,"message":"forbidden: \"alice2@acme.com\" doesn't belong to project foo2-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 		},
 		{
 			Name:         "scenario 3: the admin can get a list of user for any project",
@@ -312,7 +319,7 @@ func TestDeleteUserFromProject(t *testing.T) {
 			},
 			UserIDToDelete:   genDefaultUser().Name,
 			ExistingAPIUser:  *genAPIUser("john", "john@acme.com"),
-			ExpectedResponse: `{}`,
+			ExpectedResponse: `{"clusters":[]}`,
 		},
 
 		// scenario 2
@@ -380,7 +387,7 @@ func TestDeleteUserFromProject(t *testing.T) {
 			},
 			UserIDToDelete:   genDefaultUser().Name,
 			ExistingAPIUser:  *genAPIUser("john", "john@acme.com"),
-			ExpectedResponse: `{}`,
+			ExpectedResponse: `{"clusters":[]}`,
 		},
 
 		// scenario 5
@@ -405,7 +412,7 @@ func TestDeleteUserFromProject(t *testing.T) {
 			},
 			UserIDToDelete:   genDefaultUser().Name,
 			ExistingAPIUser:  *genAPIUser("admin", "admin@acme.com"),
-			ExpectedResponse: `{}`,
+			ExpectedResponse: `{"clusters":[]}`,
 		},
 	}
 	for _, tc := range testcases {
@@ -429,6 +436,141 @@ func TestDeleteUserFromProject(t *testing.T) {
 	}
 }
 
+func TestDeleteUserFromProjectRevokesClusterAccess(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name                       string
+		RevokeTokens               string
+		UserClusterErr             error
+		HTTPStatus                 int
+		ExpectedResponse           string
+		ExpectedBindingSubjects    []rbacv1.Subject
+		ExpectedViewerTokenDeleted bool
+	}{
+		{
+			Name:             "scenario 1: the removed member is unbound from the roles in the project clusters",
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"clusters":[{"clusterID":"abcd","clusterName":"payments","bindingsRevoked":true}]}`,
+			ExpectedBindingSubjects: []rbacv1.Subject{
+				{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "alice@acme.com"},
+				{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "bob@acme.com"},
+			},
+		},
+		{
+			Name:             "scenario 2: the viewer tokens of the project clusters are rotated on request",
+			RevokeTokens:     "true",
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"clusters":[{"clusterID":"abcd","clusterName":"payments","bindingsRevoked":true,"viewerTokenRevoked":true}]}`,
+			ExpectedBindingSubjects: []rbacv1.Subject{
+				{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "alice@acme.com"},
+				{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "bob@acme.com"},
+			},
+			ExpectedViewerTokenDeleted: true,
+		},
+		{
+			Name:             "scenario 3: an invalid RevokeTokens header is rejected",
+			RevokeTokens:     "maybe",
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"invalid value for RevokeTokens header: strconv.ParseBool: parsing \"maybe\": invalid syntax"}}`,
+		},
+		{
+			Name:             "scenario 4: an unreachable cluster doesn't block the removal of the member",
+			RevokeTokens:     "true",
+			UserClusterErr:   errors.New("connection refused"),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"clusters":[{"clusterID":"abcd","clusterName":"payments","bindingsRevoked":false,"viewerTokenRevoked":true,"error":"failed to list rolebinding: connection refused"}]}`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			cluster := test.GenCluster("abcd", "payments", "plan9-ID", test.DefaultCreationTimestamp())
+			labels := map[string]string{handlercommon.UserClusterComponentKey: handlercommon.UserClusterBindingComponentValue}
+			subjects := []rbacv1.Subject{
+				{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "Bob@acme.com"},
+				{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "alice@acme.com"},
+				{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "bob@acme.com"},
+			}
+			kubeObjs := []ctrlruntimeclient.Object{
+				&rbacv1.ClusterRoleBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "view", Labels: labels},
+					RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "view"},
+					Subjects:   subjects,
+				},
+				&rbacv1.RoleBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "edit", Namespace: "default", Labels: labels},
+					RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: "edit"},
+					Subjects:   subjects,
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: resources.ViewerTokenSecretName, Namespace: cluster.Status.NamespaceName},
+				},
+			}
+			kubermaticObjs := []ctrlruntimeclient.Object{
+				test.GenTestSeed(),
+				test.GenProject("plan9", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				test.GenBinding("plan9-ID", "bob@acme.com", "viewers"),
+				genUser("", "john", "john@acme.com"),
+				genDefaultUser(), /*bob*/
+				cluster,
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/projects/plan9-ID/users/%s", genDefaultUser().Name), nil)
+			if tc.RevokeTokens != "" {
+				req.Header.Set("RevokeTokens", tc.RevokeTokens)
+			}
+			res := httptest.NewRecorder()
+
+			if tc.UserClusterErr != nil {
+				funcs := interceptor.Funcs{
+					List: func(_ context.Context, _ ctrlruntimeclient.WithWatch, _ ctrlruntimeclient.ObjectList, _ ...ctrlruntimeclient.ListOption) error {
+						return tc.UserClusterErr
+					},
+				}
+				ep, err := test.CreateTestEndpointWithUserClusterInterceptor(*genAPIUser("john", "john@acme.com"), kubeObjs, kubermaticObjs, nil, hack.NewTestRouting, funcs)
+				if err != nil {
+					t.Fatalf("failed to create test endpoint: %v", err)
+				}
+				ep.ServeHTTP(res, req)
+			} else {
+				ep, clients, err := test.CreateTestEndpointAndGetClients(*genAPIUser("john", "john@acme.com"), nil, kubeObjs, nil, kubermaticObjs, nil, hack.NewTestRouting)
+				if err != nil {
+					t.Fatalf("failed to create test endpoint: %v", err)
+				}
+				ep.ServeHTTP(res, req)
+
+				if tc.ExpectedBindingSubjects != nil {
+					clusterRoleBinding := &rbacv1.ClusterRoleBinding{}
+					if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: "view"}, clusterRoleBinding); err != nil {
+						t.Fatalf("failed to get cluster role binding: %v", err)
+					}
+					if !reflect.DeepEqual(clusterRoleBinding.Subjects, tc.ExpectedBindingSubjects) {
+						t.Fatalf("Expected cluster role binding subjects %v, got %v", tc.ExpectedBindingSubjects, clusterRoleBinding.Subjects)
+					}
+
+					roleBinding := &rbacv1.RoleBinding{}
+					if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: "edit", Namespace: "default"}, roleBinding); err != nil {
+						t.Fatalf("failed to get role binding: %v", err)
+					}
+					if !reflect.DeepEqual(roleBinding.Subjects, tc.ExpectedBindingSubjects) {
+						t.Fatalf("Expected role binding subjects %v, got %v", tc.ExpectedBindingSubjects, roleBinding.Subjects)
+					}
+				}
+
+				err = clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: resources.ViewerTokenSecretName, Namespace: cluster.Status.NamespaceName}, &corev1.Secret{})
+				if deleted := apierrors.IsNotFound(err); deleted != tc.ExpectedViewerTokenDeleted {
+					t.Fatalf("Expected viewer token secret to be deleted: %v, got error %v", tc.ExpectedViewerTokenDeleted, err)
+				}
+			}
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
 func TestEditUserInProject(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
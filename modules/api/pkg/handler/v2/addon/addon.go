@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/go-kit/kit/endpoint"
 	"github.com/gorilla/mux"
@@ -31,8 +32,8 @@ import (
 	"k8c.io/dashboard/v2/pkg/provider"
 )
 
-// addonReq defines HTTP request for getAddonV2 and deleteAddonV2
-// swagger:parameters getAddonV2 deleteAddonV2
+// addonReq defines HTTP request for deleteAddonV2
+// swagger:parameters deleteAddonV2
 type addonReq struct {
 	common.ProjectReq
 	// in: path
@@ -42,8 +43,16 @@ type addonReq struct {
 	AddonID string `json:"addon_id"`
 }
 
-// listReq defines HTTP request for listAddonsV2 and listInstallableAddonsV2 endpoints
-// swagger:parameters listAddonsV2 listInstallableAddonsV2
+// getReq defines HTTP request for getAddonV2
+// swagger:parameters getAddonV2
+type getReq struct {
+	addonReq
+	// in: query
+	ShowStatus bool `json:"show_status"`
+}
+
+// listReq defines HTTP request for listInstallableAddonsV2 endpoint
+// swagger:parameters listInstallableAddonsV2
 type listReq struct {
 	common.ProjectReq
 	// in: path
@@ -51,6 +60,14 @@ type listReq struct {
 	ClusterID string `json:"cluster_id"`
 }
 
+// listAddonsReq defines HTTP request for listAddonsV2 endpoint
+// swagger:parameters listAddonsV2
+type listAddonsReq struct {
+	listReq
+	// in: query
+	ShowStatus bool `json:"show_status"`
+}
+
 // createReq defines HTTP request for createAddon endpoint
 // swagger:parameters createAddonV2
 type createReq struct {
@@ -142,6 +159,32 @@ func DecodeListAddons(c context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+func DecodeGetAddonWithStatus(c context.Context, r *http.Request) (interface{}, error) {
+	var req getReq
+
+	ar, err := DecodeGetAddon(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.addonReq = ar.(addonReq)
+	req.ShowStatus, _ = strconv.ParseBool(r.URL.Query().Get("show_status"))
+
+	return req, nil
+}
+
+func DecodeListAddonsWithStatus(c context.Context, r *http.Request) (interface{}, error) {
+	var req listAddonsReq
+
+	lr, err := DecodeListAddons(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.listReq = lr.(listReq)
+	req.ShowStatus, _ = strconv.ParseBool(r.URL.Query().Get("show_status"))
+
+	return req, nil
+}
+
 func DecodeCreateAddon(c context.Context, r *http.Request) (interface{}, error) {
 	var req createReq
 
@@ -181,6 +224,23 @@ func DecodePatchAddon(c context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+// schemaReq defines HTTP request for getAddonSchema endpoint
+// swagger:parameters getAddonSchema
+type schemaReq struct {
+	// in: path
+	// required: true
+	AddonName string `json:"addon_name"`
+}
+
+func DecodeGetAddonSchema(c context.Context, r *http.Request) (interface{}, error) {
+	addonName := mux.Vars(r)["addon_name"]
+	if addonName == "" {
+		return nil, fmt.Errorf("'addon_name' parameter is required but was not provided")
+	}
+
+	return schemaReq{AddonName: addonName}, nil
+}
+
 func decodeAddonID(c context.Context, r *http.Request) (string, error) {
 	addonID := mux.Vars(r)["addon_id"]
 	if addonID == "" {
@@ -199,29 +259,29 @@ func ListInstallableAddonEndpoint(projectProvider provider.ProjectProvider, priv
 
 func GetAddonEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(addonReq)
-		return handlercommon.GetAddonEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.AddonID)
+		req := request.(getReq)
+		return handlercommon.GetAddonEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.AddonID, req.ShowStatus)
 	}
 }
 
 func ListAddonEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(listReq)
-		return handlercommon.ListAddonEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID)
+		req := request.(listAddonsReq)
+		return handlercommon.ListAddonEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.ShowStatus)
 	}
 }
 
-func CreateAddonEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func CreateAddonEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, addonConfigProvider provider.AddonConfigProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(createReq)
-		return handlercommon.CreateAddonEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.Body, req.ProjectID, req.ClusterID)
+		return handlercommon.CreateAddonEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, addonConfigProvider, req.Body, req.ProjectID, req.ClusterID)
 	}
 }
 
-func PatchAddonEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func PatchAddonEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, addonConfigProvider provider.AddonConfigProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(patchReq)
-		return handlercommon.PatchAddonEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.Body, req.ProjectID, req.ClusterID, req.AddonID)
+		return handlercommon.PatchAddonEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, addonConfigProvider, req.Body, req.ProjectID, req.ClusterID, req.AddonID)
 	}
 }
 
@@ -231,3 +291,10 @@ func DeleteAddonEndpoint(projectProvider provider.ProjectProvider, privilegedPro
 		return handlercommon.DeleteAddonEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.AddonID)
 	}
 }
+
+func GetAddonSchemaEndpoint(addonConfigProvider provider.AddonConfigProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(schemaReq)
+		return handlercommon.GetAddonSchemaEndpoint(ctx, addonConfigProvider, req.AddonName)
+	}
+}
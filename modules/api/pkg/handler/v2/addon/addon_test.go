@@ -17,6 +17,7 @@ limitations under the License.
 package addon_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -28,8 +29,13 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
 
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 func TestGetAddon(t *testing.T) {
@@ -338,6 +344,124 @@ func TestListAddons(t *testing.T) {
 	}
 }
 
+func genAddonDeployment(addonName string, replicas, ready int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      addonName,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    map[string]string{"kubermatic-addon": addonName},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+		},
+		Status: appsv1.DeploymentStatus{
+			ReadyReplicas:     ready,
+			AvailableReplicas: ready,
+		},
+	}
+}
+
+func genAddonDaemonSet(addonName string, desired, ready int32) *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      addonName,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    map[string]string{"kubermatic-addon": addonName},
+		},
+		Status: appsv1.DaemonSetStatus{
+			DesiredNumberScheduled: desired,
+			NumberReady:            ready,
+			NumberAvailable:        ready,
+		},
+	}
+}
+
+func TestListAddonsWithStatus(t *testing.T) {
+	t.Parallel()
+	creationTime := test.DefaultCreationTimestamp()
+	cluster := test.GenDefaultCluster()
+
+	testcases := []struct {
+		Name             string
+		Query            string
+		UserClusterErr   error
+		ExpectedStatuses map[string]*apiv1.AddonStatus
+	}{
+		{
+			Name:  "scenario 1: the status of a healthy and a degraded addon is returned",
+			Query: "?show_status=true",
+			ExpectedStatuses: map[string]*apiv1.AddonStatus{
+				"addon1": {State: apiv1.AddonHealthy, Healthy: true, Desired: 5, Ready: 5, Available: 5},
+				"addon2": {State: apiv1.AddonDegraded, Healthy: false, Desired: 2, Ready: 1, Available: 1},
+			},
+		},
+		{
+			Name:           "scenario 2: the status is unknown if the user cluster is not reachable",
+			Query:          "?show_status=true",
+			UserClusterErr: apierrors.NewServiceUnavailable("connection refused"),
+			ExpectedStatuses: map[string]*apiv1.AddonStatus{
+				"addon1": {State: apiv1.AddonHealthUnknown},
+				"addon2": {State: apiv1.AddonHealthUnknown},
+			},
+		},
+		{
+			Name: "scenario 3: the status is not returned unless requested",
+			ExpectedStatuses: map[string]*apiv1.AddonStatus{
+				"addon1": nil,
+				"addon2": nil,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/addons%s", test.GenDefaultProject().Name, cluster.Name, tc.Query), nil)
+			res := httptest.NewRecorder()
+
+			kubermaticObjs := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				cluster,
+				test.GenTestAddon("addon1", nil, cluster, creationTime),
+				test.GenTestAddon("addon2", nil, cluster, creationTime),
+				genAddonDeployment("addon1", 2, 2),
+				genAddonDaemonSet("addon1", 3, 3),
+				genAddonDeployment("addon2", 2, 1),
+			)
+
+			funcs := interceptor.Funcs{}
+			if tc.UserClusterErr != nil {
+				funcs.List = func(_ context.Context, _ ctrlruntimeclient.WithWatch, _ ctrlruntimeclient.ObjectList, _ ...ctrlruntimeclient.ListOption) error {
+					return tc.UserClusterErr
+				}
+			}
+
+			ep, err := test.CreateTestEndpointWithUserClusterInterceptor(*test.GenDefaultAPIUser(), nil, kubermaticObjs, nil, hack.NewTestRouting, funcs)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != http.StatusOK {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+			}
+
+			addons := []apiv1.Addon{}
+			if err := json.Unmarshal(res.Body.Bytes(), &addons); err != nil {
+				t.Fatal(err)
+			}
+
+			statuses := map[string]*apiv1.AddonStatus{}
+			for _, addon := range addons {
+				statuses[addon.Name] = addon.Status
+			}
+			if !diff.SemanticallyEqual(tc.ExpectedStatuses, statuses) {
+				t.Fatalf("Got unexpected addon statuses:\n%v", diff.ObjectDiff(tc.ExpectedStatuses, statuses))
+			}
+		})
+	}
+}
+
 func TestCreateAddon(t *testing.T) {
 	t.Parallel()
 	cluster := test.GenDefaultCluster()
@@ -701,3 +825,135 @@ func TestCreatePatchGetAddon(t *testing.T) {
 		})
 	}
 }
+
+func genAddonConfigWithFormSpec(name string) *kubermaticv1.AddonConfig {
+	return &kubermaticv1.AddonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: kubermaticv1.AddonConfigSpec{
+			Controls: []kubermaticv1.AddonFormControl{
+				{DisplayName: "Replicas", InternalName: "replicas", HelpText: "Number of replicas", Required: true, Type: "number"},
+				{DisplayName: "Domain", InternalName: "domain", Type: "text"},
+				{DisplayName: "Debug", InternalName: "debug", Type: "boolean"},
+			},
+		},
+	}
+}
+
+func TestAddonVariablesSchemaValidation(t *testing.T) {
+	t.Parallel()
+	cluster := test.GenDefaultCluster()
+	cluster.Status.NamespaceName = fmt.Sprintf("cluster-%s", cluster.Name)
+
+	testcases := []struct {
+		Name               string
+		Method             string
+		Path               string
+		Body               string
+		ExpectedHTTPStatus int
+		ExpectedResponse   string
+	}{
+		{
+			Name:               "scenario 1: create an addon with valid variables",
+			Method:             http.MethodPost,
+			Path:               fmt.Sprintf("/api/v2/projects/my-first-project-ID/clusters/%s/addons", cluster.Name),
+			Body:               `{"name":"addon1","spec":{"variables":{"replicas":"3","domain":"example.com","debug":true}}}`,
+			ExpectedHTTPStatus: http.StatusCreated,
+			ExpectedResponse:   `{"id":"addon1","name":"addon1","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"variables":{"debug":true,"domain":"example.com","replicas":"3"}}}`,
+		},
+		{
+			Name:               "scenario 2: empty values of optional variables are accepted",
+			Method:             http.MethodPost,
+			Path:               fmt.Sprintf("/api/v2/projects/my-first-project-ID/clusters/%s/addons", cluster.Name),
+			Body:               `{"name":"addon1","spec":{"variables":{"replicas":3,"domain":"","debug":null}}}`,
+			ExpectedHTTPStatus: http.StatusCreated,
+			ExpectedResponse:   `{"id":"addon1","name":"addon1","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"variables":{"debug":null,"domain":"","replicas":3}}}`,
+		},
+		{
+			Name:               "scenario 3: unknown variables and missing required variables are rejected",
+			Method:             http.MethodPost,
+			Path:               fmt.Sprintf("/api/v2/projects/my-first-project-ID/clusters/%s/addons", cluster.Name),
+			Body:               `{"name":"addon1","spec":{"variables":{"replica":3}}}`,
+			ExpectedHTTPStatus: http.StatusBadRequest,
+			ExpectedResponse:   `{"error":{"code":400,"message":"invalid variables for addon \"addon1\": missing required variable \"replicas\", unknown variable \"replica\""}}`,
+		},
+		{
+			Name:               "scenario 4: variables of the wrong type are rejected",
+			Method:             http.MethodPost,
+			Path:               fmt.Sprintf("/api/v2/projects/my-first-project-ID/clusters/%s/addons", cluster.Name),
+			Body:               `{"name":"addon1","spec":{"variables":{"replicas":"three","domain":5,"debug":"yes"}}}`,
+			ExpectedHTTPStatus: http.StatusBadRequest,
+			ExpectedResponse:   `{"error":{"code":400,"message":"invalid variables for addon \"addon1\": variable \"debug\" must be a boolean, variable \"domain\" must be a string, variable \"replicas\" must be a number"}}`,
+		},
+		{
+			Name:               "scenario 5: addons without a schema accept any variables",
+			Method:             http.MethodPost,
+			Path:               fmt.Sprintf("/api/v2/projects/my-first-project-ID/clusters/%s/addons", cluster.Name),
+			Body:               `{"name":"addon2","spec":{"variables":{"foo":"bar"}}}`,
+			ExpectedHTTPStatus: http.StatusCreated,
+			ExpectedResponse:   `{"id":"addon2","name":"addon2","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"variables":{"foo":"bar"}}}`,
+		},
+		{
+			Name:               "scenario 6: patching an addon validates the variables",
+			Method:             http.MethodPatch,
+			Path:               fmt.Sprintf("/api/v2/projects/my-first-project-ID/clusters/%s/addons/addon1", cluster.Name),
+			Body:               `{"name":"addon1","spec":{"variables":{"replicas":2,"debug":"true"}}}`,
+			ExpectedHTTPStatus: http.StatusBadRequest,
+			ExpectedResponse:   `{"error":{"code":400,"message":"invalid variables for addon \"addon1\": variable \"debug\" must be a boolean"}}`,
+		},
+		{
+			Name:               "scenario 7: patch an addon with valid variables",
+			Method:             http.MethodPatch,
+			Path:               fmt.Sprintf("/api/v2/projects/my-first-project-ID/clusters/%s/addons/addon1", cluster.Name),
+			Body:               `{"name":"addon1","spec":{"variables":{"replicas":2,"debug":false}}}`,
+			ExpectedHTTPStatus: http.StatusOK,
+			ExpectedResponse:   `{"id":"addon1","name":"addon1","creationTimestamp":"2013-02-03T19:54:00Z","spec":{"variables":{"debug":false,"replicas":2}}}`,
+		},
+		{
+			Name:               "scenario 8: get the schema of an addon",
+			Method:             http.MethodGet,
+			Path:               "/api/v2/addons/addon1/schema",
+			ExpectedHTTPStatus: http.StatusOK,
+			ExpectedResponse: `{"name":"addon1","type":"object","properties":{` +
+				`"debug":{"type":"boolean","title":"Debug","formControl":"boolean"},` +
+				`"domain":{"type":"string","title":"Domain","formControl":"text"},` +
+				`"replicas":{"type":"number","title":"Replicas","description":"Number of replicas","formControl":"number"}},` +
+				`"required":["replicas"],"additionalProperties":false}`,
+		},
+		{
+			Name:               "scenario 9: get the schema of an addon without an addon config",
+			Method:             http.MethodGet,
+			Path:               "/api/v2/addons/addon2/schema",
+			ExpectedHTTPStatus: http.StatusOK,
+			ExpectedResponse:   `{"name":"addon2","type":"object","additionalProperties":true}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			existingAddon := test.GenTestAddon("addon1", nil, cluster, test.DefaultCreationTimestamp())
+			kubermaticObj := []ctrlruntimeclient.Object{
+				test.GenTestSeed(),
+				test.GenProject("my-first-project", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("my-first-project-ID", "john@acme.com", "owners"),
+				test.GenUser("", "john", "john@acme.com"),
+				cluster,
+				genAddonConfigWithFormSpec("addon1"),
+			}
+			if tc.Method == http.MethodPatch {
+				kubermaticObj = append(kubermaticObj, existingAddon)
+			}
+			ep, err := test.CreateTestEndpoint(*test.GenAPIUser("john", "john@acme.com"), []ctrlruntimeclient.Object{}, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(tc.Method, tc.Path, strings.NewReader(tc.Body)))
+
+			if res.Code != tc.ExpectedHTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.ExpectedHTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/features"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func GetAdmissionPluginsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetClusterReq)
+
+		cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return convertAdmissionPlugins(cluster.Spec.AdmissionPlugins), nil
+	}
+}
+
+// UpdateAdmissionPluginsEndpoint replaces the admission plugins of the cluster. The plugins are validated against the
+// plugins supported for the Kubernetes version of the cluster and the change is applied like a cluster patch.
+func UpdateAdmissionPluginsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, admissionPluginProvider provider.AdmissionPluginsProvider,
+	caBundle *x509.CertPool, configGetter provider.KubermaticConfigurationGetter, features features.FeatureGate) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateAdmissionPluginsReq)
+
+		cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		version := cluster.Spec.Version.String()
+		supportedPlugins, err := handlercommon.GetAdmissionPlugins(ctx, admissionPluginProvider, version)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		plugins := sets.New(req.Body...)
+		if unsupported := plugins.Difference(supportedPlugins); unsupported.Len() > 0 {
+			return nil, utilerrors.NewBadRequest("admission plugins %v are not supported for Kubernetes version %s, supported admission plugins are %v",
+				sets.List(unsupported), version, sets.List(supportedPlugins))
+		}
+
+		patch, err := json.Marshal(map[string]interface{}{
+			"spec": map[string]interface{}{
+				"admissionPlugins": sets.List(plugins),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		patchedCluster, _, err := handlercommon.PatchEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, patch, seedsGetter,
+			projectProvider, privilegedProjectProvider, caBundle, configGetter, features, false, false, "", false)
+		if err != nil {
+			return nil, err
+		}
+
+		return convertAdmissionPlugins(patchedCluster.Spec.AdmissionPlugins), nil
+	}
+}
+
+func convertAdmissionPlugins(plugins []string) apiv1.AdmissionPluginList {
+	if plugins == nil {
+		return apiv1.AdmissionPluginList{}
+	}
+	return plugins
+}
+
+// updateAdmissionPluginsReq defines HTTP request for updateClusterAdmissionPlugins endpoint.
+// swagger:parameters updateClusterAdmissionPlugins
+type updateAdmissionPluginsReq struct {
+	GetClusterReq
+	// in: body
+	// required: true
+	Body apiv1.AdmissionPluginList
+}
+
+func DecodeUpdateAdmissionPluginsReq(c context.Context, r *http.Request) (interface{}, error) {
+	clusterReq, err := DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := updateAdmissionPluginsReq{GetClusterReq: clusterReq.(GetClusterReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse body: %v", err)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func genAdmissionPlugin(name string, fromVersion *semver.Semver) *kubermaticv1.AdmissionPlugin {
+	return &kubermaticv1.AdmissionPlugin{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: strings.ToLower(name),
+		},
+		Spec: kubermaticv1.AdmissionPluginSpec{
+			PluginName:  name,
+			FromVersion: fromVersion,
+		},
+	}
+}
+
+func TestUpdateClusterAdmissionPlugins(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		Name             string
+		Body             string
+		HTTPStatus       int
+		ExpectedResponse string
+		ExpectedPlugins  []string
+	}{
+		{
+			Name:             "scenario 1: the admission plugins of the cluster are replaced",
+			Body:             `["NodeRestriction","EventRateLimit"]`,
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `["EventRateLimit","NodeRestriction"]`,
+			ExpectedPlugins:  []string{"EventRateLimit", "NodeRestriction"},
+		},
+		{
+			Name:             "scenario 2: plugins which are not supported for the cluster version are rejected",
+			Body:             `["NodeRestriction","FuturePlugin","Typo"]`,
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"admission plugins [FuturePlugin Typo] are not supported for Kubernetes version 9.9.9, supported admission plugins are [EventRateLimit NodeRestriction PodNodeSelector]"}}`,
+			ExpectedPlugins:  []string{"PodNodeSelector"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+			cluster.Spec.Cloud.DatacenterName = "fake-dc"
+			cluster.Spec.AdmissionPlugins = []string{"PodNodeSelector"}
+
+			futureVersion := semver.NewSemverOrDie("10.0.0")
+			kubermaticObjects := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				cluster,
+				genAdmissionPlugin("NodeRestriction", nil),
+				genAdmissionPlugin("FuturePlugin", futureVersion),
+			)
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, nil, kubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/admissionplugins", test.GenDefaultProject().Name, cluster.Name)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPut, path, strings.NewReader(tc.Body)))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+
+			storedCluster := &kubermaticv1.Cluster{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(cluster), storedCluster); err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			if !reflect.DeepEqual(storedCluster.Spec.AdmissionPlugins, tc.ExpectedPlugins) {
+				t.Fatalf("Expected admission plugins %v, got %v", tc.ExpectedPlugins, storedCluster.Spec.AdmissionPlugins)
+			}
+
+			res = httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodGet, path, nil))
+			if res.Code != http.StatusOK {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, fmt.Sprintf(`["%s"]`, strings.Join(tc.ExpectedPlugins, `","`)))
+		})
+	}
+}
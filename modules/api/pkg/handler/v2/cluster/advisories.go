@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// ListAdvisoriesEndpoint returns the advisories of the cluster.
+func ListAdvisoriesEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listAdvisoriesReq)
+		return handlercommon.ListClusterAdvisoriesEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.includeAcked)
+	}
+}
+
+// AcknowledgeAdvisoryEndpoint acknowledges an advisory of the cluster.
+func AcknowledgeAdvisoryEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(acknowledgeAdvisoryReq)
+		return handlercommon.AcknowledgeClusterAdvisoryEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.AdvisoryID)
+	}
+}
+
+// listAdvisoriesReq defines HTTP request for listClusterAdvisories endpoint.
+// swagger:parameters listClusterAdvisories
+type listAdvisoriesReq struct {
+	GetClusterReq
+	// Set to false to leave out the acknowledged advisories, they are included by default.
+	// in: query
+	IncludeAcked string `json:"include_acked"`
+
+	includeAcked bool
+}
+
+func DecodeListAdvisoriesReq(c context.Context, r *http.Request) (interface{}, error) {
+	clusterReq, err := DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := listAdvisoriesReq{GetClusterReq: clusterReq.(GetClusterReq), includeAcked: true}
+	req.IncludeAcked = r.URL.Query().Get("include_acked")
+	if req.IncludeAcked != "" {
+		req.includeAcked, err = strconv.ParseBool(req.IncludeAcked)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid value %q for include_acked: %v", req.IncludeAcked, err)
+		}
+	}
+
+	return req, nil
+}
+
+// acknowledgeAdvisoryReq defines HTTP request for acknowledgeClusterAdvisory endpoint.
+// swagger:parameters acknowledgeClusterAdvisory
+type acknowledgeAdvisoryReq struct {
+	GetClusterReq
+	// in: path
+	// required: true
+	AdvisoryID string `json:"advisory_id"`
+}
+
+func DecodeAcknowledgeAdvisoryReq(c context.Context, r *http.Request) (interface{}, error) {
+	clusterReq, err := DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := acknowledgeAdvisoryReq{GetClusterReq: clusterReq.(GetClusterReq)}
+	req.AdvisoryID = mux.Vars(r)["advisory_id"]
+	if req.AdvisoryID == "" {
+		return nil, utilerrors.NewBadRequest("'advisory_id' parameter is required but was not provided")
+	}
+
+	return req, nil
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	clusterresources "k8c.io/dashboard/v2/pkg/resources/cluster"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestListClusterAdvisories(t *testing.T) {
+	ca, err := triple.NewCA("test-ca")
+	if err != nil {
+		t.Fatalf("failed to generate CA: %v", err)
+	}
+	caExpiry := ca.Cert.NotAfter.UTC().Format(time.DateOnly)
+
+	defer func() { handlercommon.AdvisoryNow = time.Now }()
+
+	outdatedCluster := func(cluster *kubermaticv1.Cluster) {
+		cluster.Spec.Version = *semver.NewSemverOrDie("1.33.1")
+		cluster.Spec.CNIPlugin.Version = "v3.26"
+		cluster.Status.Conditions = map[kubermaticv1.ClusterConditionType]kubermaticv1.ClusterCondition{
+			kubermaticv1.ClusterConditionCloudControllerReconcilingSuccess: {
+				Status:  corev1.ConditionFalse,
+				Message: "failed to reconcile the security group",
+			},
+			kubermaticv1.ClusterConditionAddonControllerReconcilingSuccess: {
+				Status: corev1.ConditionTrue,
+			},
+		}
+	}
+
+	testcases := []struct {
+		Name             string
+		Query            string
+		Now              time.Time
+		ModifyCluster    func(*kubermaticv1.Cluster)
+		CASecret         bool
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: a healthy cluster has no advisories",
+			Now:              time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `[]`,
+		},
+		{
+			Name:          "scenario 2: failed conditions, a Kubernetes version close to its end of life and a deprecated CNI version are warnings",
+			Now:           time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC),
+			ModifyCluster: outdatedCluster,
+			HTTPStatus:    http.StatusOK,
+			ExpectedResponse: `[` +
+				`{"id":"cni-deprecated-canal-v3.26","severity":"warning","message":"The canal CNI plugin version v3.26 is deprecated","remediation":"Upgrade the CNI plugin to one of the supported versions: v3.27, v3.28, v3.29."},` +
+				`{"id":"condition-CloudControllerReconciledSuccessfully","severity":"warning","message":"The condition CloudControllerReconciledSuccessfully of the cluster is false: failed to reconcile the security group","remediation":"Check the events of the cluster and the logs of the controller for the cause of the failure."},` +
+				`{"id":"kubernetes-eol-soon-1.33","severity":"warning","message":"Kubernetes 1.33 reaches its end of life on 2026-06-28","remediation":"Upgrade the cluster to a newer Kubernetes version."}` +
+				`]`,
+		},
+		{
+			Name: "scenario 3: a Kubernetes version past its end of life is critical",
+			Now:  time.Date(2026, 7, 1, 10, 0, 0, 0, time.UTC),
+			ModifyCluster: func(cluster *kubermaticv1.Cluster) {
+				cluster.Spec.Version = *semver.NewSemverOrDie("1.33.1")
+			},
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `[{"id":"kubernetes-eol-1.33","severity":"critical","message":"Kubernetes 1.33 reached its end of life on 2026-06-28 and no longer receives security fixes","remediation":"Upgrade the cluster to a newer Kubernetes version."}]`,
+		},
+		{
+			Name:       "scenario 4: a CA certificate which expires soon is a warning",
+			Now:        ca.Cert.NotAfter.Add(-30 * 24 * time.Hour),
+			CASecret:   true,
+			HTTPStatus: http.StatusOK,
+			ExpectedResponse: fmt.Sprintf(`[{"id":"ca-certificate-expiry-%s","severity":"warning","message":"The cluster CA certificate expires on %s","remediation":"Rotate the cluster CA certificate before it expires, the nodes have to be recreated afterwards."}]`,
+				caExpiry, caExpiry),
+		},
+		{
+			Name:       "scenario 5: an expired CA certificate is critical",
+			Now:        ca.Cert.NotAfter.Add(time.Hour),
+			CASecret:   true,
+			HTTPStatus: http.StatusOK,
+			ExpectedResponse: fmt.Sprintf(`[{"id":"ca-certificate-expiry-%s","severity":"critical","message":"The cluster CA certificate expired on %s","remediation":"Rotate the cluster CA certificate before it expires, the nodes have to be recreated afterwards."}]`,
+				caExpiry, caExpiry),
+		},
+		{
+			Name:  "scenario 6: acknowledged advisories are left out on request",
+			Query: "?include_acked=false",
+			Now:   time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC),
+			ModifyCluster: func(cluster *kubermaticv1.Cluster) {
+				outdatedCluster(cluster)
+				cluster.Annotations = map[string]string{
+					clusterresources.AdvisoryAcknowledgmentsAnnotation: `{"kubernetes-eol-soon-1.33":{"by":"bob@acme.com","at":"2026-04-01T08:00:00Z"},"cni-deprecated-canal-v3.26":{"by":"bob@acme.com","at":"2026-04-01T08:00:00Z"}}`,
+				}
+			},
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `[{"id":"condition-CloudControllerReconciledSuccessfully","severity":"warning","message":"The condition CloudControllerReconciledSuccessfully of the cluster is false: failed to reconcile the security group","remediation":"Check the events of the cluster and the logs of the controller for the cause of the failure."}]`,
+		},
+		{
+			Name:  "scenario 7: acknowledging the end of life warning doesn't hide the critical advisory",
+			Query: "?include_acked=false",
+			Now:   time.Date(2026, 7, 1, 10, 0, 0, 0, time.UTC),
+			ModifyCluster: func(cluster *kubermaticv1.Cluster) {
+				cluster.Spec.Version = *semver.NewSemverOrDie("1.33.1")
+				cluster.Annotations = map[string]string{
+					clusterresources.AdvisoryAcknowledgmentsAnnotation: `{"kubernetes-eol-soon-1.33":{"by":"bob@acme.com","at":"2026-04-01T08:00:00Z"}}`,
+				}
+			},
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `[{"id":"kubernetes-eol-1.33","severity":"critical","message":"Kubernetes 1.33 reached its end of life on 2026-06-28 and no longer receives security fixes","remediation":"Upgrade the cluster to a newer Kubernetes version."}]`,
+		},
+		{
+			Name:             "scenario 8: an invalid include_acked parameter is rejected",
+			Query:            "?include_acked=maybe",
+			Now:              time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"invalid value \"maybe\" for include_acked: strconv.ParseBool: parsing \"maybe\": invalid syntax"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			handlercommon.AdvisoryNow = func() time.Time { return tc.Now }
+
+			cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+			if tc.ModifyCluster != nil {
+				tc.ModifyCluster(cluster)
+			}
+			kubermaticObjects := test.GenDefaultKubermaticObjects(test.GenTestSeed(), cluster)
+			if tc.CASecret {
+				kubermaticObjects = append(kubermaticObjects, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resources.CASecretName,
+						Namespace: cluster.Status.NamespaceName,
+					},
+					Data: map[string][]byte{
+						resources.CACertSecretKey: triple.EncodeCertPEM(ca.Cert),
+					},
+				})
+			}
+
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), nil, kubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/advisories%s", test.GenDefaultProject().Name, cluster.Name, tc.Query)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodGet, path, nil))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func TestAcknowledgeClusterAdvisory(t *testing.T) {
+	defer func() { handlercommon.AdvisoryNow = time.Now }()
+	handlercommon.AdvisoryNow = func() time.Time { return time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC) }
+
+	cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+	cluster.Spec.Version = *semver.NewSemverOrDie("1.33.1")
+	cluster.Annotations = map[string]string{
+		clusterresources.AdvisoryAcknowledgmentsAnnotation: `{"condition-CloudControllerReconciledSuccessfully":{"by":"bob@acme.com","at":"2026-04-01T08:00:00Z"}}`,
+	}
+	ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, nil, test.GenDefaultKubermaticObjects(test.GenTestSeed(), cluster), nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	advisoriesPath := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/advisories", test.GenDefaultProject().Name, cluster.Name)
+	advisory := `{"id":"kubernetes-eol-soon-1.33","severity":"warning","message":"Kubernetes 1.33 reaches its end of life on 2026-06-28","remediation":"Upgrade the cluster to a newer Kubernetes version."`
+	acknowledgedAdvisory := advisory + `,"acknowledged":{"by":"bob@acme.com","at":"2026-05-01T10:00:00Z"}}`
+
+	steps := []struct {
+		Name             string
+		Method           string
+		Path             string
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "an unknown advisory can't be acknowledged",
+			Method:           http.MethodPost,
+			Path:             advisoriesPath + "/kubernetes-eol-1.20/ack",
+			HTTPStatus:       http.StatusNotFound,
+			ExpectedResponse: `{"error":{"code":404,"message":"advisory \"kubernetes-eol-1.20\" not found"}}`,
+		},
+		{
+			Name:             "the advisory is not acknowledged yet",
+			Method:           http.MethodGet,
+			Path:             advisoriesPath + "?include_acked=false",
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `[` + advisory + `}]`,
+		},
+		{
+			Name:             "the advisory is acknowledged",
+			Method:           http.MethodPost,
+			Path:             advisoriesPath + "/kubernetes-eol-soon-1.33/ack",
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: acknowledgedAdvisory,
+		},
+		{
+			Name:             "the acknowledged advisory is left out",
+			Method:           http.MethodGet,
+			Path:             advisoriesPath + "?include_acked=false",
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `[]`,
+		},
+		{
+			Name:             "the acknowledged advisory is included by default",
+			Method:           http.MethodGet,
+			Path:             advisoriesPath,
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `[` + acknowledgedAdvisory + `]`,
+		},
+	}
+
+	for _, step := range steps {
+		res := httptest.NewRecorder()
+		ep.ServeHTTP(res, httptest.NewRequest(step.Method, step.Path, nil))
+
+		if res.Code != step.HTTPStatus {
+			t.Fatalf("%s: expected HTTP status code %d, got %d: %s", step.Name, step.HTTPStatus, res.Code, res.Body.String())
+		}
+		test.CompareWithResult(t, res, step.ExpectedResponse)
+	}
+
+	// the acknowledgment of the advisory which no longer applies is dropped
+	storedCluster := &kubermaticv1.Cluster{}
+	if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(cluster), storedCluster); err != nil {
+		t.Fatalf("failed to get cluster: %v", err)
+	}
+	expectedAnnotation := `{"kubernetes-eol-soon-1.33":{"by":"bob@acme.com","at":"2026-05-01T10:00:00Z"}}`
+	if annotation := storedCluster.Annotations[clusterresources.AdvisoryAcknowledgmentsAnnotation]; annotation != expectedAnnotation {
+		t.Fatalf("Expected acknowledged advisories %q, got %q", expectedAnnotation, annotation)
+	}
+}
@@ -331,7 +331,7 @@ func TestBindUserToRole(t *testing.T) {
 			roleName:         "role-1",
 			namespace:        "default",
 			body:             `{"userEmail":"test@example.com"}`,
-			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			clusterToGet:     test.GenDefaultCluster().Name,
 			httpStatus:       http.StatusForbidden,
 			existingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -485,7 +485,7 @@ func TestUnbindUserFromRoleBinding(t *testing.T) {
 			roleName:         "role-1",
 			namespace:        "default",
 			body:             `{"userEmail":"bob@acme.com"}`,
-			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			clusterToGet:     test.GenDefaultCluster().Name,
 			httpStatus:       http.StatusForbidden,
 			existingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -830,7 +830,7 @@ func TestBindUserToClusterRole(t *testing.T) {
 			name:             "scenario 17: user John can not update existing binding for the new user for Bob's cluster",
 			roleName:         "role-1",
 			body:             `{"userEmail":"test@example.com"}`,
-			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			clusterToGet:     test.GenDefaultCluster().Name,
 			httpStatus:       http.StatusForbidden,
 			existingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -983,7 +983,7 @@ func TestUnbindUserFromClusterRoleBinding(t *testing.T) {
 			name:             "scenario 6: the user can not remove user from existing cluster role binding for Bob's cluster",
 			roleName:         "role-1",
 			body:             `{"userEmail":"bob@acme.com"}`,
-			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			clusterToGet:     test.GenDefaultCluster().Name,
 			httpStatus:       http.StatusForbidden,
 			existingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -1084,7 +1084,7 @@ func TestListRoleBinding(t *testing.T) {
 		},
 		{
 			name:             "scenario 3: the user John can not list Bob's bindings",
-			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			clusterToGet:     test.GenDefaultCluster().Name,
 			httpStatus:       http.StatusForbidden,
 			existingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -1189,7 +1189,7 @@ func TestListClusterRoleBinding(t *testing.T) {
 		// scenario 3
 		{
 			name:             "scenario 3: the user John can not list Bob's cluster role bindings",
-			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			clusterToGet:     test.GenDefaultCluster().Name,
 			httpStatus:       http.StatusForbidden,
 			existingKubermaticObjs: test.GenDefaultKubermaticObjects(
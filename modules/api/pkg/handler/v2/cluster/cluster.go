@@ -23,8 +23,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-kit/kit/endpoint"
 	"go.uber.org/zap"
@@ -32,21 +34,27 @@ import (
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/metrics"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/webhook"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
 	"k8c.io/kubermatic/v2/pkg/features"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 	"k8c.io/kubermatic/v2/pkg/version"
+
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 func CreateEndpoint(
 	projectProvider provider.ProjectProvider,
 	privilegedProjectProvider provider.PrivilegedProjectProvider,
 	seedsGetter provider.SeedsGetter,
+	clusterProviderGetter provider.ClusterProviderGetter,
 	credentialManager provider.PresetProvider,
 	exposeStrategy kubermaticv1.ExposeStrategy,
 	userInfoGetter provider.UserInfoGetter,
@@ -54,6 +62,7 @@ func CreateEndpoint(
 	caBundle *x509.CertPool,
 	configGetter provider.KubermaticConfigurationGetter,
 	features features.FeatureGate,
+	notifier *webhook.Notifier,
 ) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(CreateClusterReq)
@@ -69,7 +78,7 @@ func CreateEndpoint(
 		}
 
 		return handlercommon.CreateEndpoint(ctx, req.ProjectID, req.Body, projectProvider, privilegedProjectProvider,
-			seedsGetter, credentialManager, exposeStrategy, userInfoGetter, caBundle, configGetter, features, settingsProvider)
+			seedsGetter, clusterProviderGetter, credentialManager, exposeStrategy, userInfoGetter, caBundle, configGetter, features, settingsProvider, notifier)
 	}
 }
 
@@ -81,31 +90,26 @@ func ListEndpoint(
 	clusterProviderGetter provider.ClusterProviderGetter,
 	userInfoGetter provider.UserInfoGetter,
 	configGetter provider.KubermaticConfigurationGetter,
+	seedCircuitBreaker *handlercommon.SeedCircuitBreaker,
 ) endpoint.Endpoint {
+	lastKnownClusters := handlercommon.NewLastKnownClusters()
+
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(ListClustersReq)
-		allClusters := make([]*apiv1.Cluster, 0)
 
 		seeds, err := seedsGetter()
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
 
-		brokenSeeds := []string{}
-		for _, seed := range seeds {
-			if seed.Status.Phase == kubermaticv1.SeedInvalidPhase {
-				kubermaticlog.Logger.Warnf("skipping seed %s as it is in an invalid phase", seed.Name)
-				brokenSeeds = append(brokenSeeds, seed.Name)
-				continue
-			}
+		user, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, err
+		}
 
-			// if a Seed is bad, log error and put seed's name on the list of broken seeds.
-			seedClusterProvider, err := clusterProviderGetter(seed)
-			if err != nil {
-				kubermaticlog.Logger.Errorw("failed to create cluster provider", "seed", seed.Name, zap.Error(err))
-				continue
-			}
-			seedClusters, err := handlercommon.GetClusters(
+		listSeedClusters := func(ctx context.Context, seed *kubermaticv1.Seed, seedClusterProvider provider.ClusterProvider) ([]*apiv1.Cluster, error) {
+			defer metrics.ObserveSeedClusterList(seed.Name, time.Now())
+			return handlercommon.GetClusters(
 				ctx,
 				userInfoGetter,
 				seedClusterProvider,
@@ -116,26 +120,17 @@ func ListEndpoint(
 				configGetter,
 				req.ShowDeploymentMachineCount,
 			)
-			if err != nil {
-				kubermaticlog.Logger.Errorw("failed to get clusters from seed ", "seed", seed.Name, zap.Error(err))
-				brokenSeeds = append(brokenSeeds, seed.Name)
-			} else {
-				allClusters = append(allClusters, seedClusters...)
-			}
 		}
+		allClusters, brokenSeeds := handlercommon.ListProjectClusters(ctx, seeds, clusterProviderGetter, user, seedsGetter, req.ProjectID, lastKnownClusters, seedCircuitBreaker, listSeedClusters)
 
 		clusterList := make(apiv1.ClusterList, len(allClusters))
 		for idx, cluster := range allClusters {
 			clusterList[idx] = *cluster
 		}
+		metrics.ProjectClusters.Set(req.ProjectID, len(clusterList))
 
 		if len(brokenSeeds) > 0 {
 			errMsg := "Failed to fetch data for one or more seeds. Please contact an administrator."
-
-			user, err := userInfoGetter(ctx, "")
-			if err != nil {
-				return nil, err
-			}
 			if user.IsAdmin {
 				brokenSeedsAsStr := strings.Join(brokenSeeds, `, `)
 				errMsg = fmt.Sprintf("Failed to fetch data for following seeds: %s.", brokenSeedsAsStr)
@@ -153,17 +148,133 @@ func ListEndpoint(
 	}
 }
 
+// ListSeedCircuitBreakersEndpoint returns the state of the circuit breakers of all seeds, sorted by seed name. Only
+// available for admins.
+func ListSeedCircuitBreakersEndpoint(seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, seedCircuitBreaker *handlercommon.SeedCircuitBreaker) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if !userInfo.IsAdmin {
+			return nil, utilerrors.New(http.StatusForbidden,
+				fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+		}
+
+		seeds, err := seedsGetter()
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		seedNames := make([]string, 0, len(seeds))
+		for name := range seeds {
+			seedNames = append(seedNames, name)
+		}
+		sort.Strings(seedNames)
+
+		result := make([]apiv2.SeedCircuitBreaker, 0, len(seedNames))
+		for _, name := range seedNames {
+			result = append(result, seedCircuitBreaker.State(name))
+		}
+
+		return result, nil
+	}
+}
+
+// ListAdminEndpoint lists the clusters of all projects. Only available for admins.
+func ListAdminEndpoint(seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, userInfoGetter provider.UserInfoGetter, configGetter provider.KubermaticConfigurationGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListAdminClustersReq)
+
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if !userInfo.IsAdmin {
+			return nil, utilerrors.New(http.StatusForbidden,
+				fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+		}
+
+		config, err := configGetter(ctx)
+		if err != nil {
+			return nil, err
+		}
+		incompatibilities := version.NewFromConfiguration(config).GetIncompatibilities()
+
+		seeds, err := seedsGetter()
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		clusterList := make([]apiv2.AdminCluster, 0)
+		brokenSeeds := []string{}
+		for _, seed := range seeds {
+			if seed.Status.Phase == kubermaticv1.SeedInvalidPhase {
+				kubermaticlog.Logger.Warnf("skipping seed %s as it is in an invalid phase", seed.Name)
+				brokenSeeds = append(brokenSeeds, seed.Name)
+				continue
+			}
+
+			// if a Seed is bad, log error and put seed's name on the list of broken seeds.
+			seedClusterProvider, err := clusterProviderGetter(seed)
+			if err != nil {
+				kubermaticlog.Logger.Errorw("failed to create cluster provider", "seed", seed.Name, zap.Error(err))
+				brokenSeeds = append(brokenSeeds, seed.Name)
+				continue
+			}
+
+			seedClusters, err := seedClusterProvider.ListAll(ctx, req.labelSelector)
+			if err != nil {
+				kubermaticlog.Logger.Errorw("failed to get clusters from seed ", "seed", seed.Name, zap.Error(err))
+				brokenSeeds = append(brokenSeeds, seed.Name)
+				continue
+			}
+
+			for _, internalCluster := range seedClusters.Items {
+				if !req.matches(&internalCluster) {
+					continue
+				}
+
+				dc := seed.Spec.Datacenters[internalCluster.Spec.Cloud.DatacenterName]
+				clusterList = append(clusterList, apiv2.AdminCluster{
+					Cluster:   *handlercommon.ConvertInternalClusterToExternal(internalCluster.DeepCopy(), &dc, true, incompatibilities...),
+					ProjectID: internalCluster.Labels[kubermaticv1.ProjectIDLabelKey],
+				})
+			}
+		}
+
+		if len(brokenSeeds) > 0 {
+			errMsg := fmt.Sprintf("Failed to fetch data for following seeds: %s.", strings.Join(brokenSeeds, `, `))
+			return apiv2.AdminClusterList{
+				Clusters:     clusterList,
+				ErrorMessage: &errMsg,
+			}, nil
+		}
+
+		return apiv2.AdminClusterList{
+			Clusters: clusterList,
+		}, nil
+	}
+}
+
 func GetEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, configGetter provider.KubermaticConfigurationGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetClusterReq)
-		return handlercommon.GetEndpoint(ctx, projectProvider, privilegedProjectProvider, seedsGetter, userInfoGetter, req.ProjectID, req.ClusterID, configGetter)
+		cluster, resourceVersion, err := handlercommon.GetEndpoint(ctx, projectProvider, privilegedProjectProvider, seedsGetter, userInfoGetter, req.ProjectID, req.ClusterID, configGetter)
+		if err != nil {
+			return nil, err
+		}
+		return &clusterWithETagResponse{cluster: cluster, resourceVersion: resourceVersion}, nil
 	}
 }
 
-func DeleteEndpoint(sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func DeleteEndpoint(sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, notifier *webhook.Notifier) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(DeleteReq)
-		return handlercommon.DeleteEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.DeleteVolumes, req.DeleteLoadBalancers, sshKeyProvider, privilegedSSHKeyProvider, projectProvider, privilegedProjectProvider)
+		if req.Preview {
+			return handlercommon.DeletePreviewEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.DeleteVolumes, req.DeleteLoadBalancers, projectProvider, privilegedProjectProvider)
+		}
+		return handlercommon.DeleteEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.DeleteVolumes, req.DeleteLoadBalancers, sshKeyProvider, privilegedSSHKeyProvider, projectProvider, privilegedProjectProvider, notifier)
 	}
 }
 
@@ -171,15 +282,62 @@ func PatchEndpoint(projectProvider provider.ProjectProvider, privilegedProjectPr
 	seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool, configGetter provider.KubermaticConfigurationGetter, features features.FeatureGate) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(PatchReq)
-		return handlercommon.PatchEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Patch, seedsGetter,
-			projectProvider, privilegedProjectProvider, caBundle, configGetter, features, req.SkipKubeletVersionValidation)
+		cluster, resourceVersion, err := handlercommon.PatchEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Patch, seedsGetter,
+			projectProvider, privilegedProjectProvider, caBundle, configGetter, features, req.SkipKubeletVersionValidation, req.Force, req.resourceVersion(),
+			req.UpgradeMachineDeployments)
+		if err != nil {
+			return nil, err
+		}
+		response := &clusterWithETagResponse{cluster: cluster, resourceVersion: resourceVersion}
+
+		if req.UpgradeMachineDeployments {
+			response.machineDeploymentUpgrades, err = handlercommon.UpgradeIncompatibleMachineDeployments(ctx, userInfoGetter, req.ProjectID, req.ClusterID, projectProvider, privilegedProjectProvider)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return response, nil
 	}
 }
 
+func PatchPreviewEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool, configGetter provider.KubermaticConfigurationGetter, features features.FeatureGate) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(PatchReq)
+		return handlercommon.PatchPreviewEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Patch, seedsGetter,
+			projectProvider, privilegedProjectProvider, caBundle, configGetter, features, req.SkipKubeletVersionValidation, req.Force, req.resourceVersion(),
+			req.UpgradeMachineDeployments)
+	}
+}
+
+type clusterWithETagResponse struct {
+	cluster         *apiv1.Cluster
+	resourceVersion string
+	// machineDeploymentUpgrades is only set if the machine deployments were upgraded together with the cluster.
+	machineDeploymentUpgrades []apiv2.MachineDeploymentKubeletUpgrade
+}
+
+// EncodeClusterWithETag writes the cluster as JSON and exposes its resource version in the ETag header, so
+// that clients can send it back in the If-Match header of a patch request.
+func EncodeClusterWithETag(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	rsp := response.(*clusterWithETagResponse)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", strconv.Quote(rsp.resourceVersion))
+
+	if rsp.machineDeploymentUpgrades != nil {
+		return json.NewEncoder(w).Encode(&apiv2.PatchedCluster{
+			Cluster:                   *rsp.cluster,
+			MachineDeploymentUpgrades: rsp.machineDeploymentUpgrades,
+		})
+	}
+	return json.NewEncoder(w).Encode(rsp.cluster)
+}
+
 func GetClusterEventsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(EventsReq)
-		return handlercommon.GetClusterEventsEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Type, projectProvider, privilegedProjectProvider)
+		return handlercommon.GetClusterEventsEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Type, req.Scope, projectProvider, privilegedProjectProvider)
 	}
 }
 
@@ -190,6 +348,13 @@ func HealthEndpoint(projectProvider provider.ProjectProvider, privilegedProjectP
 	}
 }
 
+func EtcdHealthEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetClusterReq)
+		return handlercommon.EtcdHealthEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, projectProvider, privilegedProjectProvider)
+	}
+}
+
 func MigrateEndpointToExternalCCM(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, configGetter provider.KubermaticConfigurationGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetClusterReq)
@@ -197,6 +362,13 @@ func MigrateEndpointToExternalCCM(projectProvider provider.ProjectProvider, priv
 	}
 }
 
+func GetClusterPermissionsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetClusterReq)
+		return handlercommon.GetClusterPermissionsEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, projectProvider, privilegedProjectProvider)
+	}
+}
+
 func GetMetricsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetClusterReq)
@@ -232,6 +404,13 @@ func ListSSHKeysEndpoint(sshKeyProvider provider.SSHKeyProvider, projectProvider
 	}
 }
 
+func SetSSHKeysEndpoint(sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(SetSSHKeysReq)
+		return handlercommon.SetSSHKeysEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Body, projectProvider, privilegedProjectProvider, sshKeyProvider, privilegedSSHKeyProvider)
+	}
+}
+
 func RevokeAdminTokenEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(adminTokenReq)
@@ -362,6 +541,49 @@ func DecodeAssignSSHKeyReq(c context.Context, r *http.Request) (interface{}, err
 	return req, nil
 }
 
+// SetSSHKeysReq defines HTTP request data for setSSHKeysAssignedToClusterV2 endpoint
+// swagger:parameters setSSHKeysAssignedToClusterV2
+type SetSSHKeysReq struct {
+	common.ProjectReq
+	// in: path
+	ClusterID string `json:"cluster_id"`
+	// The IDs of all SSH keys which should be assigned to the cluster
+	// in: body
+	// required: true
+	Body []string
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (req SetSSHKeysReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeSetSSHKeysReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req SetSSHKeysReq
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse the list of SSH key IDs: %v", err)
+	}
+	if req.Body == nil {
+		return nil, utilerrors.NewBadRequest("the list of SSH key IDs is required")
+	}
+
+	return req, nil
+}
+
 // EventsReq defines HTTP request for getClusterEventsV2 endpoint
 // swagger:parameters getClusterEventsV2
 type EventsReq struct {
@@ -372,6 +594,11 @@ type EventsReq struct {
 
 	// in: query
 	Type string `json:"type,omitempty"`
+
+	// Scope selects the events of the cluster object (user), the events from the namespace of the cluster on
+	// the seed (seed) or both (all). Defaults to user.
+	// in: query
+	Scope string `json:"scope,omitempty"`
 }
 
 // GetSeedCluster returns the SeedCluster object.
@@ -395,6 +622,15 @@ func DecodeGetClusterEvents(c context.Context, r *http.Request) (interface{}, er
 	}
 	req.ClusterID = clusterID
 
+	req.Scope = r.URL.Query().Get("scope")
+	switch req.Scope {
+	case "":
+		req.Scope = handlercommon.ClusterEventsScopeUser
+	case handlercommon.ClusterEventsScopeUser, handlercommon.ClusterEventsScopeSeed, handlercommon.ClusterEventsScopeAll:
+	default:
+		return nil, utilerrors.NewBadRequest("wrong query parameter, unsupported scope: %s", req.Scope)
+	}
+
 	req.Type = r.URL.Query().Get("type")
 	if len(req.Type) > 0 {
 		if req.Type == "warning" || req.Type == "normal" {
@@ -406,8 +642,8 @@ func DecodeGetClusterEvents(c context.Context, r *http.Request) (interface{}, er
 	return req, nil
 }
 
-// PatchReq defines HTTP request for patchCluster endpoint
-// swagger:parameters patchClusterV2
+// PatchReq defines HTTP request for patchCluster and previewClusterPatch endpoints
+// swagger:parameters patchClusterV2 previewClusterPatch
 type PatchReq struct {
 	common.ProjectReq
 	// in: path
@@ -420,6 +656,35 @@ type PatchReq struct {
 	// in: query
 	// required: false
 	SkipKubeletVersionValidation bool `json:"skip_kubelet_version_validation,omitempty"`
+
+	// Force allows project owners and admins to change the version outside of the maintenance window of the cluster.
+	// in: query
+	// required: false
+	Force bool `json:"force,omitempty"`
+
+	// The resource version of the cluster as returned in the ETag header. The patch is rejected with 409
+	// if the cluster has been modified in the meantime. "*" matches any version of the cluster.
+	// in: header
+	// name: If-Match
+	// required: false
+	IfMatch string `json:"If-Match,omitempty"`
+
+	// UpgradeMachineDeployments upgrades the kubelet of every machine deployment which is not compatible with the new
+	// control plane version to that version. Paused machine deployments are skipped.
+	// in: query
+	// required: false
+	UpgradeMachineDeployments bool `json:"upgrade_machine_deployments,omitempty"`
+}
+
+// resourceVersion returns the resource version from the If-Match header. Both quoted entity tags, as
+// returned in the ETag header, and plain resource versions are accepted. "*" matches any current version
+// of the cluster (RFC 9110), so no resource version is returned for it.
+func (req PatchReq) resourceVersion() string {
+	ifMatch := strings.TrimSpace(req.IfMatch)
+	if ifMatch == "*" {
+		return ""
+	}
+	return strings.Trim(ifMatch, `"`)
 }
 
 func DecodePatchReq(c context.Context, r *http.Request) (interface{}, error) {
@@ -449,6 +714,15 @@ func DecodePatchReq(c context.Context, r *http.Request) (interface{}, error) {
 		}
 	}
 	req.SkipKubeletVersionValidation = skipKubeletVersionValidation
+	req.Force = strings.EqualFold(r.URL.Query().Get("force"), "true")
+	req.IfMatch = r.Header.Get("If-Match")
+
+	if queryParam := r.URL.Query().Get("upgrade_machine_deployments"); queryParam != "" {
+		req.UpgradeMachineDeployments, err = strconv.ParseBool(queryParam)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("wrong query parameter `upgrade_machine_deployments`: %v", err)
+		}
+	}
 
 	return req, nil
 }
@@ -473,6 +747,9 @@ type DeleteReq struct {
 	// in: header
 	// DeleteLoadBalancers if true all load balancers will be deleted from cluster
 	DeleteLoadBalancers bool
+	// in: query
+	// Preview if true the cluster is not deleted, instead the resources which would be cleaned up are returned
+	Preview bool `json:"preview"`
 }
 
 // GetSeedCluster returns the SeedCluster object.
@@ -515,6 +792,15 @@ func DecodeDeleteReq(c context.Context, r *http.Request) (interface{}, error) {
 		req.DeleteLoadBalancers = deleteLB
 	}
 
+	queryValue := r.URL.Query().Get("preview")
+	if len(queryValue) > 0 {
+		preview, err := strconv.ParseBool(queryValue)
+		if err != nil {
+			return nil, err
+		}
+		req.Preview = preview
+	}
+
 	return req, nil
 }
 
@@ -544,8 +830,69 @@ func DecodeListClustersReq(c context.Context, r *http.Request) (interface{}, err
 	return req, nil
 }
 
+// ListAdminClustersReq defines HTTP request for listAdminClusters endpoint.
+// swagger:parameters listAdminClusters
+type ListAdminClustersReq struct {
+	// in: query
+	Datacenter string `json:"datacenter,omitempty"`
+	// in: query
+	Version string `json:"version,omitempty"`
+	// in: query
+	Phase string `json:"phase,omitempty"`
+	// in: query
+	LabelSelector string `json:"label_selector,omitempty"`
+
+	version       *semver.Semver
+	labelSelector labels.Selector
+}
+
+func DecodeListAdminClustersReq(c context.Context, r *http.Request) (interface{}, error) {
+	query := r.URL.Query()
+	req := ListAdminClustersReq{
+		Datacenter:    query.Get("datacenter"),
+		Version:       query.Get("version"),
+		Phase:         query.Get("phase"),
+		LabelSelector: query.Get("label_selector"),
+		labelSelector: labels.Everything(),
+	}
+
+	if req.Version != "" {
+		v, err := semver.NewSemver(req.Version)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid value for `version`: %v", err)
+		}
+		req.version = v
+	}
+
+	if req.LabelSelector != "" {
+		selector, err := labels.Parse(req.LabelSelector)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid value for `label_selector`: %v", err)
+		}
+		req.labelSelector = selector
+	}
+
+	return req, nil
+}
+
+// matches checks whether the cluster matches the filters of the request. The label selector is
+// applied when listing the clusters.
+func (req ListAdminClustersReq) matches(cluster *kubermaticv1.Cluster) bool {
+	if req.Datacenter != "" && cluster.Spec.Cloud.DatacenterName != req.Datacenter {
+		return false
+	}
+	if req.version != nil && !cluster.Spec.Version.Equal(req.version) {
+		return false
+	}
+	if req.Phase != "" && !strings.EqualFold(string(cluster.Status.Phase), req.Phase) {
+		return false
+	}
+
+	return true
+}
+
 // GetClusterReq defines HTTP request for getCluster endpoint.
-// swagger:parameters getClusterV2 getClusterHealthV2 getOidcClusterKubeconfigV2 getClusterKubeconfigV2 getClusterMetricsV2 listNamespaceV2 getClusterUpgradesV2 listAWSSizesNoCredentialsV2 listAWSSubnetsNoCredentialsV2 listGCPNetworksNoCredentialsV2 listGCPZonesNoCredentialsV2 listHetznerSizesNoCredentialsV2 listDigitaloceanSizesNoCredentialsV2 migrateClusterToExternalCCM getClusterOidc listKubeVirtInstancetypesNoCredentials listKubevirtStorageClassesNoCredentials getKubevirtStorageClassesNoCredentials listKubeVirtVPCsNoCredentials listKubeVirtSubnetsNoCredentials
+// swagger:parameters getClusterV2 getClusterHealthV2 getClusterEtcdHealth getClusterSupportBundle getClusterAdmissionPlugins getOidcClusterKubeconfigV2 getServiceAccountClusterKubeconfigV2 getClusterKubeconfigV2 getClusterMetricsV2 listNamespaceV2 getClusterUpgradesV2 getClusterUpgradePlan listAWSSizesNoCredentialsV2 listAWSSubnetsNoCredentialsV2 listGCPNetworksNoCredentialsV2 listGCPZonesNoCredentialsV2 listHetznerSizesNoCredentialsV2 listHetznerNetworksNoCredentials listHetznerFirewallsNoCredentials migrateClusterToExternalCCM getClusterOidc listKubeVirtInstancetypesNoCredentials listKubevirtStorageClassesNoCredentials getKubevirtStorageClassesNoCredentials listKubeVirtVPCsNoCredentials listKubeVirtSubnetsNoCredentials
 type GetClusterReq struct {
 	common.ProjectReq
 	// in: path
@@ -19,18 +19,24 @@ package cluster_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/metrics"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/sdk/v2/semver"
 	"k8c.io/kubermatic/v2/pkg/cni"
@@ -40,9 +46,11 @@ import (
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	"k8s.io/utils/ptr"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -114,7 +122,7 @@ func TestCreateClusterEndpoint(t *testing.T) {
 		{
 			Name:             "scenario 3: unable to create a cluster when the user doesn't belong to the project",
 			Body:             fmt.Sprintf(`{"cluster":{"name":"keen-snyder","pause":false,"spec":{"version":"%s","cloud":{"version":"%s","fake":{"token":"dummy_token"},"dc":"fake-dc","enableUserSSHKeyAgent":true,"containerRuntime":"containerd"}}},"sshKeys":["key-c08aa5c7abf34504f18552846485267d-yafn"]}`, version, version),
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			HTTPStatus:       http.StatusForbidden,
 			ProjectToSync:    test.GenDefaultProject().Name,
 			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -342,6 +350,316 @@ func TestCreateClusterEndpoint(t *testing.T) {
 	}
 }
 
+func TestCreateClusterWithMachineDeployments(t *testing.T) {
+	version := defaulting.DefaultKubernetesVersioning.Default.String()
+	machineDeployment := func(name string, replicas int, annotations string) string {
+		return fmt.Sprintf(`{"name":"%s","annotations":{%s},"spec":{"replicas":%d,"template":{"cloud":{"edge":{}},"operatingSystem":{"ubuntu":{}},"versions":{"kubelet":"%s"}}}}`, name, annotations, replicas, version)
+	}
+	clusterBody := fmt.Sprintf(`"cluster":{"name":"keen-snyder","spec":{"version":"%s","cloud":{"edge":{},"dc":"edge-dc"}}}`, version)
+
+	seed := test.GenTestSeed()
+	seed.Spec.Datacenters["edge-dc"] = kubermaticv1.Datacenter{
+		Spec: kubermaticv1.DatacenterSpec{Edge: &kubermaticv1.DatacenterSpecEdge{}},
+	}
+
+	t.Parallel()
+	testcases := []struct {
+		Name                       string
+		Body                       string
+		HTTPStatus                 int
+		ExpectedResponse           string
+		ExpectedInitialMD          string
+		ExpectedPendingMDs         []string
+		ExpectedClusterIsPersisted bool
+	}{
+		{
+			Name:                       "scenario 1: two machine deployments are requested one after another",
+			Body:                       fmt.Sprintf(`{%s,"machineDeployments":[%s,%s]}`, clusterBody, machineDeployment("workers", 2, ""), machineDeployment("gpu-workers", 1, "")),
+			HTTPStatus:                 http.StatusCreated,
+			ExpectedInitialMD:          "workers",
+			ExpectedPendingMDs:         []string{"gpu-workers"},
+			ExpectedClusterIsPersisted: true,
+		},
+		{
+			Name:                       "scenario 2: the legacy node deployment is requested first",
+			Body:                       fmt.Sprintf(`{%s,"nodeDeployment":%s,"machineDeployments":[%s]}`, clusterBody, machineDeployment("legacy", 1, ""), machineDeployment("workers", 2, "")),
+			HTTPStatus:                 http.StatusCreated,
+			ExpectedInitialMD:          "legacy",
+			ExpectedPendingMDs:         []string{"workers"},
+			ExpectedClusterIsPersisted: true,
+		},
+		{
+			Name:             "scenario 3: the cluster is rejected if any machine deployment is invalid",
+			Body:             fmt.Sprintf(`{%s,"machineDeployments":[%s,%s,%s]}`, clusterBody, machineDeployment("workers", 2, ""), machineDeployment("autoscaled", 1, `"cluster.k8s.io/cluster-api-autoscaler-node-group-min-size":"1"`), machineDeployment("workers", 1, "")),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"machine deployment validation failed, please examine details field for more info","details":["machineDeployments[1]: annotation cluster.k8s.io/cluster-api-autoscaler-node-group-min-size cannot be set directly, use minReplicas and maxReplicas instead","machineDeployments[2]: machine deployment name \"workers\" is used more than once"]}}`,
+		},
+	}
+
+	dummyKubermaticConfiguration := &kubermaticv1.KubermaticConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kubermatic",
+			Namespace: resources.KubermaticNamespace,
+		},
+		Spec: kubermaticv1.KubermaticConfigurationSpec{
+			Versions: kubermaticv1.KubermaticVersioningConfiguration{
+				Versions: defaulting.DefaultKubernetesVersioning.Versions,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters", test.GenDefaultProject().Name), strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, nil, test.GenDefaultKubermaticObjects(seed), dummyKubermaticConfiguration, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+
+			clusters := &kubermaticv1.ClusterList{}
+			if err := clients.FakeClient.List(context.Background(), clusters); err != nil {
+				t.Fatalf("failed to list clusters: %v", err)
+			}
+			if !tc.ExpectedClusterIsPersisted {
+				if len(clusters.Items) != 0 {
+					t.Fatalf("expected no cluster to be created, got %d", len(clusters.Items))
+				}
+				return
+			}
+			if len(clusters.Items) != 1 {
+				t.Fatalf("expected exactly one cluster, got %d", len(clusters.Items))
+			}
+			annotations := clusters.Items[0].Annotations
+
+			initialMD := &clusterv1alpha1.MachineDeployment{}
+			if err := json.Unmarshal([]byte(annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation]), initialMD); err != nil {
+				t.Fatalf("failed to unmarshal initial machine deployment: %v", err)
+			}
+			if initialMD.Name != tc.ExpectedInitialMD {
+				t.Fatalf("expected initial machine deployment %q, got %q", tc.ExpectedInitialMD, initialMD.Name)
+			}
+
+			pendingMDs := []clusterv1alpha1.MachineDeployment{}
+			if err := json.Unmarshal([]byte(annotations[machine.PendingInitialMachineDeploymentsAnnotation]), &pendingMDs); err != nil {
+				t.Fatalf("failed to unmarshal pending machine deployments: %v", err)
+			}
+			pendingNames := []string{}
+			for _, md := range pendingMDs {
+				pendingNames = append(pendingNames, md.Name)
+			}
+			if !equality.Semantic.DeepEqual(pendingNames, tc.ExpectedPendingMDs) {
+				t.Fatalf("expected pending machine deployments %v, got %v", tc.ExpectedPendingMDs, pendingNames)
+			}
+		})
+	}
+}
+
+func TestCreateClusterNodeQuota(t *testing.T) {
+	t.Parallel()
+
+	version := defaulting.DefaultKubernetesVersioning.Default.String()
+	machineDeployment := func(name string, replicas int) string {
+		return fmt.Sprintf(`{"name":"%s","spec":{"replicas":%d,"template":{"cloud":{"edge":{}},"operatingSystem":{"ubuntu":{}},"versions":{"kubelet":"%s"}}}}`, name, replicas, version)
+	}
+	body := fmt.Sprintf(`{"cluster":{"name":"keen-snyder","spec":{"version":"%s","cloud":{"edge":{},"dc":"edge-dc"}}},"machineDeployments":[%s,%s]}`,
+		version, machineDeployment("workers", 2), machineDeployment("gpu-workers", 1))
+
+	seed := test.GenTestSeed()
+	seed.Spec.Datacenters["edge-dc"] = kubermaticv1.Datacenter{
+		Spec: kubermaticv1.DatacenterSpec{Edge: &kubermaticv1.DatacenterSpecEdge{}},
+	}
+
+	testcases := []struct {
+		Name             string
+		Quota            apiv2.ProjectNodeQuota
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:       "scenario 1: a cluster whose initial machine deployments fit into the node quota is created",
+			Quota:      apiv2.ProjectNodeQuota{MaxNodes: 5, MaxMachineDeployments: 3},
+			HTTPStatus: http.StatusCreated,
+		},
+		{
+			Name:             "scenario 2: the initial machine deployments of clusters which are still being created count against the node quota",
+			Quota:            apiv2.ProjectNodeQuota{MaxNodes: 4},
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"node quota exceeded: the project has 2 of 4 nodes, 3 more would exceed the quota","details":["nodes: 2, limit: 4","machine deployments: 1, limit: 0"],"reason":"NODE_QUOTA_EXCEEDED"}}`,
+		},
+		{
+			Name:             "scenario 3: a cluster with more initial machine deployments than the quota allows is forbidden",
+			Quota:            apiv2.ProjectNodeQuota{MaxMachineDeployments: 2},
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"node quota exceeded: the project has 1 of 2 machine deployments, 2 more would exceed the quota","details":["nodes: 2, limit: 0","machine deployments: 1, limit: 2"],"reason":"NODE_QUOTA_EXCEEDED"}}`,
+		},
+	}
+
+	dummyKubermaticConfiguration := &kubermaticv1.KubermaticConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kubermatic",
+			Namespace: resources.KubermaticNamespace,
+		},
+		Spec: kubermaticv1.KubermaticConfigurationSpec{
+			Versions: kubermaticv1.KubermaticVersioningConfiguration{
+				Versions: defaulting.DefaultKubernetesVersioning.Versions,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			project := test.GenDefaultProject()
+			if err := machine.SetProjectNodeQuota(project, tc.Quota); err != nil {
+				t.Fatalf("failed to set node quota: %v", err)
+			}
+
+			// the machine deployment of the cluster is not created yet, as its API server is not up
+			creatingCluster := test.GenCluster("creatingClusterID", "creating", project.Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+			creatingCluster.Status.ExtendedHealth.Apiserver = kubermaticv1.HealthStatusDown
+			initialMD := &clusterv1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "existing"},
+				Spec:       clusterv1alpha1.MachineDeploymentSpec{Replicas: ptr.To[int32](2)},
+			}
+			if err := machine.SetInitialMachineDeployments(creatingCluster, []*clusterv1alpha1.MachineDeployment{initialMD}); err != nil {
+				t.Fatalf("failed to set initial machine deployments: %v", err)
+			}
+
+			kubermaticObjs := []ctrlruntimeclient.Object{project, test.GenDefaultUser(), test.GenDefaultOwnerBinding(), seed, creatingCluster}
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), nil, kubermaticObjs, dummyKubermaticConfiguration, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters", project.Name), strings.NewReader(body)))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+		})
+	}
+}
+
+// TestCreateClusterWithInvalidInitialNodeDeployment mirrors the validation scenarios of TestCreateMachineDeployment
+// for the initial node deployment of the create cluster request.
+func TestCreateClusterWithInvalidInitialNodeDeployment(t *testing.T) {
+	version := defaulting.DefaultKubernetesVersioning.Default.String()
+	nodeDeployment := func(spec, template string) string {
+		return fmt.Sprintf(`{"name":"workers","spec":{"replicas":1,%s"template":{%s"cloud":{"edge":{}},"operatingSystem":{"ubuntu":{}},"versions":{"kubelet":"%s"}}}}`, spec, template, version)
+	}
+	clusterBody := fmt.Sprintf(`"cluster":{"name":"keen-snyder","spec":{"version":"%s","cloud":{"edge":{},"dc":"edge-dc"}}}`, version)
+
+	seed := test.GenTestSeed()
+	seed.Spec.Datacenters["edge-dc"] = kubermaticv1.Datacenter{
+		Spec: kubermaticv1.DatacenterSpec{Edge: &kubermaticv1.DatacenterSpecEdge{}},
+	}
+
+	t.Parallel()
+	testcases := []struct {
+		Name             string
+		NodeDeployment   string
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:           "scenario 1: set taints",
+			NodeDeployment: nodeDeployment("", `"taints":[{"key":"foo","value":"bar","effect":"NoExecute"}],`),
+			HTTPStatus:     http.StatusCreated,
+		},
+		{
+			Name:             "scenario 2: invalid taint",
+			NodeDeployment:   nodeDeployment("", `"taints":[{"key":"foo","value":"bar","effect":"BAD_EFFECT"}],`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: taint effect 'BAD_EFFECT' not allowed. Allowed: NoExecute, NoSchedule, PreferNoSchedule"}}`,
+		},
+		{
+			Name:             "scenario 3: dynamic config is rejected for the requested cluster version",
+			NodeDeployment:   nodeDeployment(`"dynamicConfig":true,`, ""),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: dynamic config cannot be configured for Kubernetes 1.24 or higher"}}`,
+		},
+		{
+			Name:             "scenario 4: invalid taint key",
+			NodeDeployment:   nodeDeployment("", `"taints":[{"key":"foo bar","value":"bar","effect":"NoExecute"}],`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: taint key 'foo bar' is invalid: name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')"}}`,
+		},
+		{
+			Name:             "scenario 5: labels in a namespace reserved for Kubernetes are rejected",
+			NodeDeployment:   nodeDeployment("", `"labels":{"node-role.kubernetes.io/worker":""},`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: label 'node-role.kubernetes.io/worker' is in a namespace reserved for Kubernetes and cannot be set on nodes"}}`,
+		},
+		{
+			Name:             "scenario 6: replicas outside of the autoscaler bounds are rejected",
+			NodeDeployment:   nodeDeployment(`"minReplicas":2,"maxReplicas":5,`, ""),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"replica count (1) cannot be lower then autoscaler minreplicas (2).","reason":"AUTOSCALER_BOUNDS"}}`,
+		},
+	}
+
+	dummyKubermaticConfiguration := &kubermaticv1.KubermaticConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kubermatic",
+			Namespace: resources.KubermaticNamespace,
+		},
+		Spec: kubermaticv1.KubermaticConfigurationSpec{
+			Versions: kubermaticv1.KubermaticVersioningConfiguration{
+				Versions: defaulting.DefaultKubernetesVersioning.Versions,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			body := fmt.Sprintf(`{%s,"nodeDeployment":%s}`, clusterBody, tc.NodeDeployment)
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters", test.GenDefaultProject().Name), strings.NewReader(body))
+			res := httptest.NewRecorder()
+
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, nil, test.GenDefaultKubermaticObjects(seed), dummyKubermaticConfiguration, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			clusters := &kubermaticv1.ClusterList{}
+			if err := clients.FakeClient.List(context.Background(), clusters); err != nil {
+				t.Fatalf("failed to list clusters: %v", err)
+			}
+			if tc.ExpectedResponse == "" {
+				if len(clusters.Items) != 1 {
+					t.Fatalf("expected exactly one cluster, got %d", len(clusters.Items))
+				}
+				return
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+			if len(clusters.Items) != 0 {
+				t.Fatalf("expected no cluster to be created, got %d", len(clusters.Items))
+			}
+		})
+	}
+}
+
 func TestListClusters(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
@@ -644,11 +962,219 @@ func TestListClusters(t *testing.T) {
 
 	for _, tc := range testcases {
 		t.Run(tc.Name, func(t *testing.T) {
-			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters", test.ProjectName), strings.NewReader(""))
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters", test.ProjectName), strings.NewReader(""))
+			res := httptest.NewRecorder()
+			var kubermaticObj []ctrlruntimeclient.Object
+			kubermaticObj = append(kubermaticObj, tc.ExistingKubermaticObjs...)
+			ep, err := test.CreateTestEndpoint(*tc.ExistingAPIUser, []ctrlruntimeclient.Object{}, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			actualClusters := decodeProjectClusterListOrDie(res.Body, t)
+
+			wrappedExpectedClusters := test.NewClusterV1SliceWrapper(tc.ExpectedClusters.Clusters)
+			wrappedExpectedClusters.Sort()
+
+			actualClusters.EqualOrDie(wrappedExpectedClusters, t)
+		})
+	}
+}
+
+// TestListClustersMetrics is not run in parallel, other tests would change the metrics.
+func TestListClustersMetrics(t *testing.T) {
+	seed := test.GenTestSeed()
+	seedLabels := fmt.Sprintf(`seed="%s"`, seed.Name)
+	listsBefore := test.ScrapeMetric(t, metrics.SeedClusterListDurationName+"_count", seedLabels)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters", test.ProjectName), strings.NewReader(""))
+	res := httptest.NewRecorder()
+	kubermaticObj := test.GenDefaultKubermaticObjects(
+		seed,
+		genUser("John", "john@acme.com", true),
+		test.GenCluster("clusterAbcID", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC)),
+		test.GenCluster("clusterDefID", "clusterDef", test.GenDefaultProject().Name, time.Date(2013, 02, 04, 01, 54, 0, 0, time.UTC)),
+	)
+	ep, err := test.CreateTestEndpoint(*test.GenAPIUser("John", "john@acme.com"), []ctrlruntimeclient.Object{}, kubermaticObj, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+	if clusters := test.ScrapeMetric(t, metrics.ProjectClustersName, fmt.Sprintf(`project="%s"`, test.ProjectName)); clusters != 2 {
+		t.Fatalf("Expected 2 clusters to be listed for the project, got %v", clusters)
+	}
+	if clusters := test.ScrapeMetric(t, metrics.ProjectClustersName, fmt.Sprintf(`project="%s"`, test.ProjectName)); clusters != 0 {
+		t.Fatalf("Expected the clusters of the project to be reset by the previous scrape, got %v", clusters)
+	}
+	if requests := test.ScrapeMetric(t, metrics.EndpointRequestsTotalName, `code="200",endpoint="listClusters"`); requests < 1 {
+		t.Fatalf("Expected the cluster listing to be counted, got %v", requests)
+	}
+	if lists := test.ScrapeMetric(t, metrics.SeedClusterListDurationName+"_count", seedLabels); lists-listsBefore != 1 {
+		t.Fatalf("Expected the seed cluster listings to increase by 1, got %v -> %v", listsBefore, lists)
+	}
+}
+
+func TestListAdminClusters(t *testing.T) {
+	t.Parallel()
+
+	otherProject := test.GenProject("other-project", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp())
+	existingKubermaticObjs := test.GenDefaultKubermaticObjects(
+		test.GenTestSeed(func(seed *kubermaticv1.Seed) {
+			seed.Spec.Datacenters["OpenstackDatacenter"] = kubermaticv1.Datacenter{
+				Spec: kubermaticv1.DatacenterSpec{
+					Openstack: &kubermaticv1.DatacenterSpecOpenstack{},
+				},
+			}
+		}),
+		otherProject,
+		genUser("John", "john@acme.com", true),
+		test.GenCluster("clusterAbcID", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC), func(cluster *kubermaticv1.Cluster) {
+			cluster.Labels["env"] = "prod"
+			cluster.Status.Phase = kubermaticv1.ClusterRunning
+		}),
+		test.GenCluster("clusterDefID", "clusterDef", otherProject.Name, time.Date(2013, 02, 04, 01, 54, 0, 0, time.UTC), func(cluster *kubermaticv1.Cluster) {
+			cluster.Spec.Version = *semver.NewSemverOrDie("8.8.8")
+			cluster.Status.Phase = kubermaticv1.ClusterUpdating
+		}),
+		test.GenClusterWithOpenstack(test.GenCluster("clusterOpenstackID", "clusterOpenstack", otherProject.Name, time.Date(2013, 02, 04, 03, 54, 0, 0, time.UTC))),
+	)
+
+	testcases := []struct {
+		Name             string
+		Query            string
+		ExpectedClusters []string
+		HTTPStatus       int
+		ExistingAPIUser  *apiv1.User
+	}{
+		{
+			Name:  "scenario 1: admin lists the clusters of all projects",
+			Query: "",
+			ExpectedClusters: []string{
+				"my-first-project-ID/clusterAbcID",
+				"other-project-ID/clusterDefID",
+				"other-project-ID/clusterOpenstackID",
+			},
+			HTTPStatus:      http.StatusOK,
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+		},
+		{
+			Name:             "scenario 2: admin filters clusters by datacenter",
+			Query:            "datacenter=OpenstackDatacenter",
+			ExpectedClusters: []string{"other-project-ID/clusterOpenstackID"},
+			HTTPStatus:       http.StatusOK,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+		},
+		{
+			Name:             "scenario 3: admin filters clusters by version",
+			Query:            "version=8.8.8",
+			ExpectedClusters: []string{"other-project-ID/clusterDefID"},
+			HTTPStatus:       http.StatusOK,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+		},
+		{
+			Name:             "scenario 4: admin filters clusters by phase",
+			Query:            "phase=running",
+			ExpectedClusters: []string{"my-first-project-ID/clusterAbcID"},
+			HTTPStatus:       http.StatusOK,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+		},
+		{
+			Name:             "scenario 5: admin filters clusters by label selector",
+			Query:            "label_selector=env%3Dprod",
+			ExpectedClusters: []string{"my-first-project-ID/clusterAbcID"},
+			HTTPStatus:       http.StatusOK,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+		},
+		{
+			Name:            "scenario 6: invalid label selector",
+			Query:           "label_selector=env%3D%3D%3Dprod",
+			HTTPStatus:      http.StatusBadRequest,
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+		},
+		{
+			Name:            "scenario 7: regular user can't list the clusters of all projects",
+			Query:           "",
+			HTTPStatus:      http.StatusForbidden,
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/admin/clusters?"+tc.Query, strings.NewReader(""))
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*tc.ExistingAPIUser, []ctrlruntimeclient.Object{}, existingKubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if res.Code != http.StatusOK {
+				return
+			}
+
+			clusterList := &apiv2.AdminClusterList{}
+			if err := json.NewDecoder(res.Body).Decode(clusterList); err != nil {
+				t.Fatal(err)
+			}
+
+			actualClusters := []string{}
+			for _, cluster := range clusterList.Clusters {
+				actualClusters = append(actualClusters, cluster.ProjectID+"/"+cluster.ID)
+			}
+			sort.Strings(actualClusters)
+
+			if !equality.Semantic.DeepEqual(actualClusters, tc.ExpectedClusters) {
+				t.Fatalf("expected clusters %v, got %v", tc.ExpectedClusters, actualClusters)
+			}
+		})
+	}
+}
+
+func TestListSeedCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		Name             string
+		ExpectedResponse string
+		HTTPStatus       int
+		ExistingAPIUser  *apiv1.User
+	}{
+		{
+			Name:             "scenario 1: admin lists the circuit breakers of the seeds",
+			ExpectedResponse: `[{"seed":"us-central1","state":"closed","consecutiveFailures":0}]`,
+			HTTPStatus:       http.StatusOK,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+		},
+		{
+			Name:             "scenario 2: regular user can't list the circuit breakers",
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"bob@acme.com\" doesn't have admin rights"}}`,
+			HTTPStatus:       http.StatusForbidden,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/admin/seeds/circuitbreakers", nil)
 			res := httptest.NewRecorder()
-			var kubermaticObj []ctrlruntimeclient.Object
-			kubermaticObj = append(kubermaticObj, tc.ExistingKubermaticObjs...)
-			ep, err := test.CreateTestEndpoint(*tc.ExistingAPIUser, []ctrlruntimeclient.Object{}, kubermaticObj, nil, hack.NewTestRouting)
+			kubermaticObjs := test.GenDefaultKubermaticObjects(test.GenTestSeed(), genUser("John", "john@acme.com", true))
+			ep, err := test.CreateTestEndpoint(*tc.ExistingAPIUser, []ctrlruntimeclient.Object{}, kubermaticObjs, nil, hack.NewTestRouting)
 			if err != nil {
 				t.Fatalf("failed to create test endpoint: %v", err)
 			}
@@ -658,13 +1184,7 @@ func TestListClusters(t *testing.T) {
 			if res.Code != tc.HTTPStatus {
 				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
 			}
-
-			actualClusters := decodeProjectClusterListOrDie(res.Body, t)
-
-			wrappedExpectedClusters := test.NewClusterV1SliceWrapper(tc.ExpectedClusters.Clusters)
-			wrappedExpectedClusters.Sort()
-
-			actualClusters.EqualOrDie(wrappedExpectedClusters, t)
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
 		})
 	}
 }
@@ -759,7 +1279,7 @@ func TestGetCluster(t *testing.T) {
 		{
 			Name:             "scenario 5: the regular user John can not get Bob's cluster",
 			Body:             ``,
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			ClusterToGet:     test.GenDefaultCluster().Name,
 			HTTPStatus:       http.StatusForbidden,
 			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -794,6 +1314,93 @@ func TestGetCluster(t *testing.T) {
 	}
 }
 
+func TestGetClusterPermissions(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name                   string
+		ExpectedResponse       string
+		HTTPStatus             int
+		ExistingAPIUser        *apiv1.User
+		ExistingKubermaticObjs []ctrlruntimeclient.Object
+	}{
+		{
+			Name:             "scenario 1: the project owner has all permissions",
+			ExpectedResponse: `{"isAdmin":false,"canPatchCluster":true,"canDeleteCluster":true,"canManageMachineDeployments":true,"canManageSSHKeys":true,"canViewMetrics":true}`,
+			HTTPStatus:       http.StatusOK,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+		{
+			Name:             "scenario 2: the project editor has all permissions",
+			ExpectedResponse: `{"isAdmin":false,"canPatchCluster":true,"canDeleteCluster":true,"canManageMachineDeployments":true,"canManageSSHKeys":true,"canViewMetrics":true}`,
+			HTTPStatus:       http.StatusOK,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenUser(test.UserID2, test.UserName2, test.UserEmail2),
+				test.GenBinding(test.GenDefaultProject().Name, test.UserEmail2, "editors"),
+			),
+			ExistingAPIUser: test.GenAPIUser(test.UserName2, test.UserEmail2),
+		},
+		{
+			Name:             "scenario 3: the project viewer can only view metrics",
+			ExpectedResponse: `{"isAdmin":false,"canPatchCluster":false,"canDeleteCluster":false,"canManageMachineDeployments":false,"canManageSSHKeys":false,"canViewMetrics":true}`,
+			HTTPStatus:       http.StatusOK,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenUser(test.UserID2, test.UserName2, test.UserEmail2),
+				test.GenBinding(test.GenDefaultProject().Name, test.UserEmail2, "viewers"),
+			),
+			ExistingAPIUser: test.GenAPIUser(test.UserName2, test.UserEmail2),
+		},
+		{
+			Name:             "scenario 4: the admin John has all permissions for Bob's cluster",
+			ExpectedResponse: `{"isAdmin":true,"canPatchCluster":true,"canDeleteCluster":true,"canManageMachineDeployments":true,"canManageSSHKeys":true,"canViewMetrics":true}`,
+			HTTPStatus:       http.StatusOK,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				genUser("John", "john@acme.com", true),
+			),
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+		},
+		{
+			Name:             "scenario 5: the regular user John can not get the permissions for Bob's cluster",
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+			HTTPStatus:       http.StatusForbidden,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				genUser("John", "john@acme.com", false),
+			),
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/permissions", test.ProjectName, test.GenDefaultCluster().Name), nil)
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*tc.ExistingAPIUser, []ctrlruntimeclient.Object{}, tc.ExistingKubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
 func TestDeleteClusterEndpoint(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
@@ -908,6 +1515,97 @@ func TestDeleteClusterEndpoint(t *testing.T) {
 	}
 }
 
+func TestDeleteClusterPreviewEndpoint(t *testing.T) {
+	t.Parallel()
+
+	const providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+
+	genPV := func(name, size string) *corev1.PersistentVolume {
+		return &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: corev1.PersistentVolumeSpec{
+				Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+			},
+		}
+	}
+	genService := func(name string, serviceType corev1.ServiceType) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: serviceType},
+		}
+	}
+
+	upCluster := test.GenDefaultCluster()
+	kuberneteshelper.AddFinalizer(upCluster, kubermaticv1.NodeDeletionFinalizer)
+
+	userClusterObjects := []ctrlruntimeclient.Object{
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "mars"}},
+		genPV("pv-1", "10Gi"),
+		genPV("pv-2", "5G"),
+		genService("ingress", corev1.ServiceTypeLoadBalancer),
+		genService("backend", corev1.ServiceTypeClusterIP),
+	}
+
+	testcases := []struct {
+		Name             string
+		Headers          map[string]string
+		Cluster          *kubermaticv1.Cluster
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: volumes and load balancers are orphaned without the cleanup headers",
+			Cluster:          upCluster,
+			ExpectedResponse: `{"machineDeploymentCount":1,"nodeCount":2,"persistentVolumeCount":2,"persistentVolumeSize":15.74,"loadBalancers":["default/ingress"],"volumesOrphaned":true,"loadBalancersOrphaned":true}`,
+		},
+		{
+			Name:             "scenario 2: volumes and load balancers are cleaned up with the cleanup headers",
+			Headers:          map[string]string{"DeleteVolumes": "true", "DeleteLoadBalancers": "true"},
+			Cluster:          upCluster,
+			ExpectedResponse: `{"machineDeploymentCount":1,"nodeCount":2,"persistentVolumeCount":2,"persistentVolumeSize":15.74,"loadBalancers":["default/ingress"],"volumesOrphaned":false,"loadBalancersOrphaned":false}`,
+		},
+		{
+			Name:             "scenario 3: a cluster which was never up has nothing to clean up",
+			Headers:          map[string]string{"DeleteVolumes": "true", "DeleteLoadBalancers": "true"},
+			Cluster:          test.GenDefaultCluster(),
+			ExpectedResponse: `{"machineDeploymentCount":0,"nodeCount":0,"persistentVolumeCount":0,"persistentVolumeSize":0,"loadBalancers":[],"volumesOrphaned":false,"loadBalancersOrphaned":false}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v2/projects/%s/clusters/%s?preview=true", test.GenDefaultProject().Name, tc.Cluster.Name), nil)
+			for key, value := range tc.Headers {
+				req.Header.Add(key, value)
+			}
+			res := httptest.NewRecorder()
+
+			kubermaticObjects := test.GenDefaultKubermaticObjects(test.GenTestSeed(), tc.Cluster.DeepCopy())
+			machineObjects := []ctrlruntimeclient.Object{test.GenTestMachineDeployment("venus", providerSpec, nil, false)}
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, userClusterObjects, machineObjects, kubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != http.StatusOK {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+
+			// validate that the cluster was not deleted
+			cluster := &kubermaticv1.Cluster{}
+			if err := clients.FakeClient.Get(context.Background(), types.NamespacedName{Name: tc.Cluster.Name}, cluster); err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			if cluster.DeletionTimestamp != nil {
+				t.Fatal("expected the cluster not to be deleted")
+			}
+		})
+	}
+}
+
 func TestPatchCluster(t *testing.T) {
 	t.Parallel()
 
@@ -1030,85 +1728,249 @@ func TestPatchCluster(t *testing.T) {
 		},
 		// scenario 6
 		{
-			Name:             "scenario 6: the admin John can update Bob's cluster version",
-			Body:             `{"spec":{"version":"9.9.10"}}`,
-			ExpectedResponse: `{"id":"keen-snyder","name":"clusterAbc","creationTimestamp":"2013-02-03T19:54:00Z","type":"kubernetes","spec":{"cloud":{"dc":"fake-dc","fake":{}},"version":"9.9.10","oidc":{},"enableUserSSHKeyAgent":false,"kubernetesDashboard":{"enabled":true},"containerRuntime":"containerd","clusterNetwork":{"ipFamily":"IPv4","services":{"cidrBlocks":["5.6.7.8/8"]},"pods":{"cidrBlocks":["1.2.3.4/8"]},"nodeCidrMaskSizeIPv4":24,"dnsDomain":"cluster.local","proxyMode":"ipvs","ipvs":{"strictArp":true},"nodeLocalDNSCacheEnabled":true},"cniPlugin":{"type":"canal","version":"v3.29"},"exposeStrategy":"NodePort"},"status":{"version":"9.9.9","url":"https://w225mx4z66.asia-east1-a-1.cloud.kubermatic.io:31885","externalCCMMigration":"Unsupported"}}`,
-			cluster:          "keen-snyder",
-			HTTPStatus:       http.StatusOK,
-			project:          test.GenDefaultProject().Name,
-			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
-			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
-				test.GenTestSeed(),
-				func() *kubermaticv1.Cluster {
-					cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
-					cluster.Spec.Cloud.DatacenterName = fakeDC
-					return cluster
-				}(),
-				genUser("John", "john@acme.com", true),
-			),
+			Name:             "scenario 6: the admin John can update Bob's cluster version",
+			Body:             `{"spec":{"version":"9.9.10"}}`,
+			ExpectedResponse: `{"id":"keen-snyder","name":"clusterAbc","creationTimestamp":"2013-02-03T19:54:00Z","type":"kubernetes","spec":{"cloud":{"dc":"fake-dc","fake":{}},"version":"9.9.10","oidc":{},"enableUserSSHKeyAgent":false,"kubernetesDashboard":{"enabled":true},"containerRuntime":"containerd","clusterNetwork":{"ipFamily":"IPv4","services":{"cidrBlocks":["5.6.7.8/8"]},"pods":{"cidrBlocks":["1.2.3.4/8"]},"nodeCidrMaskSizeIPv4":24,"dnsDomain":"cluster.local","proxyMode":"ipvs","ipvs":{"strictArp":true},"nodeLocalDNSCacheEnabled":true},"cniPlugin":{"type":"canal","version":"v3.29"},"exposeStrategy":"NodePort"},"status":{"version":"9.9.9","url":"https://w225mx4z66.asia-east1-a-1.cloud.kubermatic.io:31885","externalCCMMigration":"Unsupported"}}`,
+			cluster:          "keen-snyder",
+			HTTPStatus:       http.StatusOK,
+			project:          test.GenDefaultProject().Name,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				func() *kubermaticv1.Cluster {
+					cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+					cluster.Spec.Cloud.DatacenterName = fakeDC
+					return cluster
+				}(),
+				genUser("John", "john@acme.com", true),
+			),
+		},
+		// scenario 7
+		{
+			Name:             "scenario 7: the regular user John can not update Bob's cluster version",
+			Body:             `{"spec":{"version":"9.9.9"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+			cluster:          "keen-snyder",
+			HTTPStatus:       http.StatusForbidden,
+			project:          test.GenDefaultProject().Name,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				func() *kubermaticv1.Cluster {
+					cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+					cluster.Spec.Cloud.DatacenterName = fakeDC
+					return cluster
+				}(),
+				genUser("John", "john@acme.com", false),
+			),
+		},
+		// scenario 8
+		{
+			Name:             "scenario 8: update the pod node selector admission plugin config",
+			Body:             `{"spec":{"version":"9.9.9","podNodeSelectorAdmissionPluginConfig":{"clusterDefaultNodeSelector":"env=development"}}}`,
+			ExpectedResponse: `{"id":"keen-snyder","name":"clusterAbc","creationTimestamp":"2013-02-03T19:54:00Z","type":"kubernetes","spec":{"cloud":{"dc":"fake-dc","fake":{}},"version":"9.9.9","oidc":{},"enableUserSSHKeyAgent":false,"kubernetesDashboard":{"enabled":true},"podNodeSelectorAdmissionPluginConfig":{"clusterDefaultNodeSelector":"env=development"},"containerRuntime":"containerd","clusterNetwork":{"ipFamily":"IPv4","services":{"cidrBlocks":["5.6.7.8/8"]},"pods":{"cidrBlocks":["1.2.3.4/8"]},"nodeCidrMaskSizeIPv4":24,"dnsDomain":"cluster.local","proxyMode":"ipvs","ipvs":{"strictArp":true},"nodeLocalDNSCacheEnabled":true},"cniPlugin":{"type":"canal","version":"v3.29"},"exposeStrategy":"NodePort"},"status":{"version":"9.9.9","url":"https://w225mx4z66.asia-east1-a-1.cloud.kubermatic.io:31885","externalCCMMigration":"Unsupported"}}`,
+			cluster:          "keen-snyder",
+			HTTPStatus:       http.StatusOK,
+			project:          test.GenDefaultProject().Name,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				func() *kubermaticv1.Cluster {
+					cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+					cluster.Spec.Cloud.DatacenterName = fakeDC
+					return cluster
+				}(),
+			),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var machineObj []ctrlruntimeclient.Object
+			for _, existingMachine := range tc.ExistingMachines {
+				machineObj = append(machineObj, existingMachine)
+			}
+			// test data
+			req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/api/v2/projects/%s/clusters/%s", tc.project, tc.cluster), strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, []ctrlruntimeclient.Object{}, machineObj, tc.ExistingKubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			// act
+			ep.ServeHTTP(res, req)
+
+			// validate
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func TestPatchClusterWithResourceVersion(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		Name       string
+		IfMatch    func(etag string) string
+		HTTPStatus int
+	}{
+		{
+			Name:       "scenario 1: the patch is applied if the resource version matches",
+			IfMatch:    func(etag string) string { return etag },
+			HTTPStatus: http.StatusOK,
+		},
+		{
+			Name:       "scenario 2: the patch is rejected if the cluster has been modified in the meantime",
+			IfMatch:    func(string) string { return `"1"` },
+			HTTPStatus: http.StatusConflict,
+		},
+		{
+			Name:       "scenario 3: the patch is applied without conflict detection if the header is absent",
+			IfMatch:    func(string) string { return "" },
+			HTTPStatus: http.StatusOK,
+		},
+		{
+			Name:       "scenario 4: the patch is applied if the header matches any version",
+			IfMatch:    func(string) string { return "*" },
+			HTTPStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+			cluster.Spec.Cloud.DatacenterName = "fake-dc"
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, nil, test.GenDefaultKubermaticObjects(test.GenTestSeed(), cluster), nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s", test.GenDefaultProject().Name, cluster.Name)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodGet, path, nil))
+			if res.Code != http.StatusOK {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+			}
+
+			storedCluster := &kubermaticv1.Cluster{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(cluster), storedCluster); err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			etag := res.Header().Get("ETag")
+			if expected := fmt.Sprintf("%q", storedCluster.ResourceVersion); etag != expected {
+				t.Fatalf("Expected ETag %s, got %s", expected, etag)
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, path, strings.NewReader(`{"spec":{"version":"9.9.10"}}`))
+			if ifMatch := tc.IfMatch(etag); ifMatch != "" {
+				req.Header.Set("If-Match", ifMatch)
+			}
+			res = httptest.NewRecorder()
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(cluster), storedCluster); err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+
+			if tc.HTTPStatus == http.StatusConflict {
+				test.CompareWithResult(t, res, fmt.Sprintf(`{"error":{"code":409,"message":"cluster keen-snyder has been modified in the meantime, its current resource version is %s"}}`, storedCluster.ResourceVersion))
+				if storedCluster.Spec.Version.String() != "9.9.9" {
+					t.Fatalf("Expected the cluster to be unchanged, but its version is %s", storedCluster.Spec.Version)
+				}
+				return
+			}
+
+			if storedCluster.Spec.Version.String() != "9.9.10" {
+				t.Fatalf("Expected the cluster to be patched, but its version is %s", storedCluster.Spec.Version)
+			}
+			if expected := fmt.Sprintf("%q", storedCluster.ResourceVersion); res.Header().Get("ETag") != expected || expected == etag {
+				t.Fatalf("Expected the new ETag %s, got %s", expected, res.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+func TestPatchClusterUpgradeMachineDeployments(t *testing.T) {
+	t.Parallel()
+
+	const providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"},"operatingSystem":"ubuntu","operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+	genMachineDeployment := func(name, kubelet string, paused bool) *clusterv1alpha1.MachineDeployment {
+		md := test.GenTestMachineDeployment(name, providerSpec, map[string]string{"md": name}, false)
+		md.Spec.Template.Spec.Versions.Kubelet = kubelet
+		md.Spec.Paused = paused
+		return md
+	}
+
+	testcases := []struct {
+		Name             string
+		Query            string
+		HTTPStatus       int
+		ExpectedResponse string
+		ExpectedKubelets map[string]string
+	}{
+		{
+			Name:             "scenario 1: the patch is rejected without upgrading the machine deployments",
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"Cluster contains nodes running the following incompatible kubelet versions: [9.8.0]. Upgrade your nodes before you upgrade the cluster."}}`,
+			ExpectedKubelets: map[string]string{"compliant": "v9.9.9", "outdated": "v9.8.0", "paused": "v9.8.0"},
 		},
-		// scenario 7
 		{
-			Name:             "scenario 7: the regular user John can not update Bob's cluster version",
-			Body:             `{"spec":{"version":"9.9.9"}}`,
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
-			cluster:          "keen-snyder",
-			HTTPStatus:       http.StatusForbidden,
-			project:          test.GenDefaultProject().Name,
-			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
-			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
-				test.GenTestSeed(),
-				func() *kubermaticv1.Cluster {
-					cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
-					cluster.Spec.Cloud.DatacenterName = fakeDC
-					return cluster
-				}(),
-				genUser("John", "john@acme.com", false),
-			),
+			Name:             "scenario 2: the outdated machine deployment is upgraded together with the control plane",
+			Query:            "?upgrade_machine_deployments=true",
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"id":"keen-snyder","name":"clusterAbc","creationTimestamp":"2013-02-03T19:54:00Z","type":"kubernetes","spec":{"cloud":{"dc":"fake-dc","fake":{}},"version":"9.11.3","oidc":{},"enableUserSSHKeyAgent":false,"kubernetesDashboard":{"enabled":true},"containerRuntime":"containerd","clusterNetwork":{"ipFamily":"IPv4","services":{"cidrBlocks":["5.6.7.8/8"]},"pods":{"cidrBlocks":["1.2.3.4/8"]},"nodeCidrMaskSizeIPv4":24,"dnsDomain":"cluster.local","proxyMode":"ipvs","ipvs":{"strictArp":true},"nodeLocalDNSCacheEnabled":true},"cniPlugin":{"type":"canal","version":"v3.29"},"exposeStrategy":"NodePort"},"status":{"version":"9.9.9","url":"https://w225mx4z66.asia-east1-a-1.cloud.kubermatic.io:31885","externalCCMMigration":"Unsupported"},"machineDeploymentUpgrades":[{"name":"compliant","kubeletVersion":"9.9.9","status":"compatible"},{"name":"outdated","kubeletVersion":"9.8.0","status":"upgraded"},{"name":"paused","kubeletVersion":"9.8.0","status":"skipped","message":"the machine deployment is paused"}]}`,
+			ExpectedKubelets: map[string]string{"compliant": "v9.9.9", "outdated": "9.11.3", "paused": "v9.8.0"},
 		},
-		// scenario 8
 		{
-			Name:             "scenario 8: update the pod node selector admission plugin config",
-			Body:             `{"spec":{"version":"9.9.9","podNodeSelectorAdmissionPluginConfig":{"clusterDefaultNodeSelector":"env=development"}}}`,
-			ExpectedResponse: `{"id":"keen-snyder","name":"clusterAbc","creationTimestamp":"2013-02-03T19:54:00Z","type":"kubernetes","spec":{"cloud":{"dc":"fake-dc","fake":{}},"version":"9.9.9","oidc":{},"enableUserSSHKeyAgent":false,"kubernetesDashboard":{"enabled":true},"podNodeSelectorAdmissionPluginConfig":{"clusterDefaultNodeSelector":"env=development"},"containerRuntime":"containerd","clusterNetwork":{"ipFamily":"IPv4","services":{"cidrBlocks":["5.6.7.8/8"]},"pods":{"cidrBlocks":["1.2.3.4/8"]},"nodeCidrMaskSizeIPv4":24,"dnsDomain":"cluster.local","proxyMode":"ipvs","ipvs":{"strictArp":true},"nodeLocalDNSCacheEnabled":true},"cniPlugin":{"type":"canal","version":"v3.29"},"exposeStrategy":"NodePort"},"status":{"version":"9.9.9","url":"https://w225mx4z66.asia-east1-a-1.cloud.kubermatic.io:31885","externalCCMMigration":"Unsupported"}}`,
-			cluster:          "keen-snyder",
-			HTTPStatus:       http.StatusOK,
-			project:          test.GenDefaultProject().Name,
-			ExistingAPIUser:  test.GenDefaultAPIUser(),
-			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
-				test.GenTestSeed(),
-				func() *kubermaticv1.Cluster {
-					cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
-					cluster.Spec.Cloud.DatacenterName = fakeDC
-					return cluster
-				}(),
-			),
+			Name:             "scenario 3: an invalid value is rejected",
+			Query:            "?upgrade_machine_deployments=maybe",
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"wrong query parameter ` + "`upgrade_machine_deployments`" + `: strconv.ParseBool: parsing \"maybe\": invalid syntax"}}`,
+			ExpectedKubelets: map[string]string{"compliant": "v9.9.9", "outdated": "v9.8.0", "paused": "v9.8.0"},
 		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.Name, func(t *testing.T) {
-			var machineObj []ctrlruntimeclient.Object
-			for _, existingMachine := range tc.ExistingMachines {
-				machineObj = append(machineObj, existingMachine)
+			cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+			cluster.Spec.Cloud.DatacenterName = "fake-dc"
+			machineObjects := []ctrlruntimeclient.Object{
+				genMachineDeployment("compliant", "v9.9.9", false),
+				genMachineDeployment("outdated", "v9.8.0", false),
+				genMachineDeployment("paused", "v9.8.0", true),
 			}
-			// test data
-			req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/api/v2/projects/%s/clusters/%s", tc.project, tc.cluster), strings.NewReader(tc.Body))
-			res := httptest.NewRecorder()
-			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, []ctrlruntimeclient.Object{}, machineObj, tc.ExistingKubermaticObjects, nil, hack.NewTestRouting)
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, machineObjects, test.GenDefaultKubermaticObjects(test.GenTestSeed(), cluster), nil, hack.NewTestRouting)
 			if err != nil {
 				t.Fatalf("failed to create test endpoint: %v", err)
 			}
 
-			// act
-			ep.ServeHTTP(res, req)
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s%s", test.GenDefaultProject().Name, cluster.Name, tc.Query)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPatch, path, strings.NewReader(`{"spec":{"version":"9.11.3"}}`)))
 
-			// validate
 			if res.Code != tc.HTTPStatus {
 				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
 			}
-
 			test.CompareWithResult(t, res, tc.ExpectedResponse)
+
+			for name, expected := range tc.ExpectedKubelets {
+				md := &clusterv1alpha1.MachineDeployment{}
+				if err := clients.FakeClient.Get(context.Background(), types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: name}, md); err != nil {
+					t.Fatalf("failed to get machine deployment %s: %v", name, err)
+				}
+				if kubelet := md.Spec.Template.Spec.Versions.Kubelet; kubelet != expected {
+					t.Errorf("Expected kubelet %s of machine deployment %s, got %s", expected, name, kubelet)
+				}
+			}
 		})
 	}
 }
@@ -1216,7 +2078,78 @@ func TestGetClusterEventsEndpoint(t *testing.T) {
 				test.GenTestEvent("event-1", corev1.EventTypeNormal, "Started", "message started", "Cluster", "venus-1-machine", test.GenDefaultCluster().Name),
 				test.GenTestEvent("event-2", corev1.EventTypeWarning, "Killed", "message killed", "Cluster", "venus-1-machine", test.GenDefaultCluster().Name),
 			},
-			ExpectedResult: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResult: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+		},
+		// scenario 6
+		{
+			Name:            "scenario 6: list seed events with redacted node names",
+			QueryParams:     "?scope=seed",
+			HTTPStatus:      http.StatusOK,
+			ClusterIDToSync: test.GenDefaultCluster().Name,
+			ProjectIDToSync: test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "seed-node-1"}},
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExistingEvents: []*corev1.Event{
+				test.GenTestEvent("event-1", corev1.EventTypeNormal, "Started", "message started", "Cluster", "venus-1-machine", test.GenDefaultCluster().Name),
+				genSeedNamespaceEvent("event-2", corev1.EventTypeNormal, "Scheduled", "Successfully assigned apiserver-7d9f8-abcde to seed-node-1", "Pod", "apiserver-7d9f8-abcde"),
+			},
+			ExpectedResult: `[{"name":"event-2","creationTimestamp":"0001-01-01T00:00:00Z","message":"Successfully assigned apiserver-7d9f8-abcde to \u003credacted\u003e","type":"Normal","involvedObject":{"type":"Pod","namespace":"cluster-defClusterID","name":"apiserver-7d9f8-abcde"},"lastTimestamp":"0001-01-01T00:00:00Z","count":1,"component":"apiserver"}]`,
+		},
+		// scenario 7
+		{
+			Name:            "scenario 7: the admin John can list seed events with node names",
+			QueryParams:     "?scope=seed",
+			HTTPStatus:      http.StatusOK,
+			ClusterIDToSync: test.GenDefaultCluster().Name,
+			ProjectIDToSync: test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				genUser("John", "john@acme.com", true),
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "seed-node-1"}},
+			),
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+			ExistingEvents: []*corev1.Event{
+				genSeedNamespaceEvent("event-2", corev1.EventTypeNormal, "Scheduled", "Successfully assigned apiserver-7d9f8-abcde to seed-node-1", "Pod", "apiserver-7d9f8-abcde"),
+			},
+			ExpectedResult: `[{"name":"event-2","creationTimestamp":"0001-01-01T00:00:00Z","message":"Successfully assigned apiserver-7d9f8-abcde to seed-node-1","type":"Normal","involvedObject":{"type":"Pod","namespace":"cluster-defClusterID","name":"apiserver-7d9f8-abcde"},"lastTimestamp":"0001-01-01T00:00:00Z","count":1,"component":"apiserver"}]`,
+		},
+		// scenario 8
+		{
+			Name:            "scenario 8: list warning events of all scopes",
+			QueryParams:     "?scope=all&type=warning",
+			HTTPStatus:      http.StatusOK,
+			ClusterIDToSync: test.GenDefaultCluster().Name,
+			ProjectIDToSync: test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExistingEvents: []*corev1.Event{
+				test.GenTestEvent("event-1", corev1.EventTypeWarning, "Killed", "message killed", "Cluster", "venus-1-machine", test.GenDefaultCluster().Name),
+				genSeedNamespaceEvent("event-2", corev1.EventTypeWarning, "BackOff", "Back-off restarting failed container", "Pod", "etcd-0"),
+				genSeedNamespaceEvent("event-3", corev1.EventTypeNormal, "Pulled", "Container image pulled", "Pod", "etcd-0"),
+			},
+			ExpectedResult: `[{"name":"event-1","creationTimestamp":"0001-01-01T00:00:00Z","message":"message killed","type":"Warning","involvedObject":{"type":"Cluster","namespace":"kube-system","name":"defClusterID"},"lastTimestamp":"0001-01-01T00:00:00Z","count":1},{"name":"event-2","creationTimestamp":"0001-01-01T00:00:00Z","message":"Back-off restarting failed container","type":"Warning","involvedObject":{"type":"Pod","namespace":"cluster-defClusterID","name":"etcd-0"},"lastTimestamp":"0001-01-01T00:00:00Z","count":1,"component":"etcd"}]`,
+		},
+		// scenario 9
+		{
+			Name:            "scenario 9: unsupported scope",
+			QueryParams:     "?scope=node",
+			HTTPStatus:      http.StatusBadRequest,
+			ClusterIDToSync: test.GenDefaultCluster().Name,
+			ProjectIDToSync: test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExpectedResult:  `{"error":{"code":400,"message":"wrong query parameter, unsupported scope: node"}}`,
 		},
 	}
 
@@ -1248,6 +2181,14 @@ func TestGetClusterEventsEndpoint(t *testing.T) {
 	}
 }
 
+func genSeedNamespaceEvent(eventName, eventType, eventReason, eventMessage, kind, involvedObjectName string) *corev1.Event {
+	event := test.GenTestEvent(eventName, eventType, eventReason, eventMessage, kind, "", involvedObjectName)
+	event.Namespace = test.GenDefaultCluster().Status.NamespaceName
+	event.InvolvedObject.Namespace = event.Namespace
+
+	return event
+}
+
 func TestGetClusterHealth(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
@@ -1326,7 +2267,7 @@ func TestGetClusterHealth(t *testing.T) {
 		{
 			Name:             "scenario 3: the user Bob can not get John's cluster health status",
 			Body:             ``,
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			HTTPStatus:       http.StatusForbidden,
 			ClusterToGet:     "keen-snyder",
 			ProjectToSync:    test.GenDefaultProject().Name,
@@ -1507,6 +2448,96 @@ func TestGetClusterHealth(t *testing.T) {
 	}
 }
 
+func TestGetClusterEtcdHealth(t *testing.T) {
+	genEtcdCluster := func() *kubermaticv1.Cluster {
+		cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+		cluster.Status.ExtendedHealth.Etcd = kubermaticv1.HealthStatusUp
+		return cluster
+	}
+
+	testcases := []struct {
+		Name             string
+		ExpectedResponse string
+		HTTPStatus       int
+		EtcdStatus       *handlercommon.EtcdStatus
+		EtcdError        error
+		ExistingAPIUser  *apiv1.User
+	}{
+		{
+			Name:             "scenario 1: get the etcd health with the details from etcd",
+			ExpectedResponse: `{"health":"HealthStatusUp","state":"available","dbSize":2147483648,"dbSizeInUse":1073741824,"memberCount":3,"hasQuorum":true,"alarms":[{"memberID":"8e9e05c52164694d","alarm":"NOSPACE"}]}`,
+			HTTPStatus:       http.StatusOK,
+			EtcdStatus: &handlercommon.EtcdStatus{
+				DBSize:         2147483648,
+				DBSizeInUse:    1073741824,
+				MemberCount:    3,
+				HealthyMembers: 2,
+				Alarms:         []apiv1.EtcdAlarm{{MemberID: "8e9e05c52164694d", Alarm: "NOSPACE"}},
+			},
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+		{
+			Name:             "scenario 2: the quorum is lost if the majority of the members is unhealthy",
+			ExpectedResponse: `{"health":"HealthStatusUp","state":"available","dbSize":20480,"dbSizeInUse":16384,"memberCount":3,"hasQuorum":false}`,
+			HTTPStatus:       http.StatusOK,
+			EtcdStatus: &handlercommon.EtcdStatus{
+				DBSize:         20480,
+				DBSizeInUse:    16384,
+				MemberCount:    3,
+				HealthyMembers: 1,
+			},
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+		{
+			Name:             "scenario 3: the details are unknown if etcd can not be reached",
+			ExpectedResponse: `{"health":"HealthStatusUp","state":"unknown","message":"failed to query etcd: connection refused"}`,
+			HTTPStatus:       http.StatusOK,
+			EtcdError:        errors.New("connection refused"),
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+		},
+		{
+			Name:             "scenario 4: the user John can not get Bob's etcd health",
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+			HTTPStatus:       http.StatusForbidden,
+			EtcdStatus:       &handlercommon.EtcdStatus{MemberCount: 3, HealthyMembers: 3},
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			getEtcdStatus := handlercommon.GetEtcdStatus
+			defer func() { handlercommon.GetEtcdStatus = getEtcdStatus }()
+			handlercommon.GetEtcdStatus = func(_ context.Context, _ provider.ClusterProvider, _ kubernetes.Interface, cluster *kubermaticv1.Cluster) (*handlercommon.EtcdStatus, error) {
+				if cluster.Name != "keen-snyder" {
+					return nil, fmt.Errorf("unexpected cluster %q", cluster.Name)
+				}
+				return tc.EtcdStatus, tc.EtcdError
+			}
+
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/keen-snyder/health/etcd", test.GenDefaultProject().Name), nil)
+			res := httptest.NewRecorder()
+			kubermaticObj := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genUser("John", "john@acme.com", false),
+				genEtcdCluster(),
+			)
+			ep, err := test.CreateTestEndpoint(*tc.ExistingAPIUser, []ctrlruntimeclient.Object{}, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
 func TestGetClusterMetrics(t *testing.T) {
 	t.Parallel()
 	cpuQuantity, err := resource.ParseQuantity("290")
@@ -1617,7 +2648,7 @@ func TestGetClusterMetrics(t *testing.T) {
 		// scenario 3
 		{
 			Name:             "scenario 3: the user John can not get Bob's cluster metrics",
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			ClusterToGet:     test.GenDefaultCluster().Name,
 			HTTPStatus:       http.StatusForbidden,
 			ExistingNodes: []*corev1.Node{
@@ -1657,6 +2688,33 @@ func TestGetClusterMetrics(t *testing.T) {
 				},
 			},
 		},
+		// scenario 4
+		{
+			Name:             "scenario 4: gets cluster metrics grouped by control plane component",
+			ExpectedResponse: `{"name":"defClusterID","controlPlane":{"memoryTotalBytes":2620,"cpuTotalMillicores":1160000,"components":[{"name":"apiserver","podCount":2,"memoryTotalBytes":1310,"cpuTotalMillicores":580000},{"name":"etcd","podCount":1,"memoryTotalBytes":655,"cpuTotalMillicores":290000}]},"nodes":{"memoryTotalBytes":655,"memoryAvailableBytes":655,"memoryUsedPercentage":100,"cpuTotalMillicores":290000,"cpuAvailableMillicores":290000,"cpuUsedPercentage":100}}`,
+			ClusterToGet:     test.GenDefaultCluster().Name,
+			HTTPStatus:       http.StatusOK,
+			ExistingNodes: []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}, Status: corev1.NodeStatus{Allocatable: map[corev1.ResourceName]resource.Quantity{"cpu": cpuQuantity, "memory": memoryQuantity}}},
+			},
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExistingPodMetrics: []*v1beta1.PodMetrics{
+				genControlPlanePodMetrics("apiserver-5d8f7-abcde", "apiserver", cpuQuantity, memoryQuantity),
+				genControlPlanePodMetrics("apiserver-5d8f7-fghij", "apiserver", cpuQuantity, memoryQuantity),
+				genControlPlanePodMetrics("etcd-0", "etcd", cpuQuantity, memoryQuantity),
+				genControlPlanePodMetrics("unlabeled", "", cpuQuantity, memoryQuantity),
+			},
+			ExistingNodeMetrics: []*v1beta1.NodeMetrics{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "venus"},
+					Usage:      map[corev1.ResourceName]resource.Quantity{"cpu": cpuQuantity, "memory": memoryQuantity},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -1693,6 +2751,23 @@ func TestGetClusterMetrics(t *testing.T) {
 	}
 }
 
+func genControlPlanePodMetrics(name, component string, cpu, memory resource.Quantity) *v1beta1.PodMetrics {
+	podMetrics := &v1beta1.PodMetrics{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "cluster-defClusterID"},
+		Containers: []v1beta1.ContainerMetrics{
+			{
+				Name:  name,
+				Usage: map[corev1.ResourceName]resource.Quantity{"cpu": cpu, "memory": memory},
+			},
+		},
+	}
+	if component != "" {
+		podMetrics.Labels = map[string]string{"app": component}
+	}
+
+	return podMetrics
+}
+
 func TestListNamespace(t *testing.T) {
 	t.Parallel()
 
@@ -1749,7 +2824,7 @@ func TestListNamespace(t *testing.T) {
 		// scenario 3
 		{
 			name:             "scenario 3: the user John can not get Bob's cluster namespaces",
-			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			clusterToGet:     test.GenDefaultCluster().Name,
 			httpStatus:       http.StatusForbidden,
 			existingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -1892,10 +2967,10 @@ func TestDetachSSHKeyFromClusterEndpoint(t *testing.T) {
 			Name:                             "scenario 3: the user John can not detach any key from the Bob cluster",
 			Body:                             ``,
 			KeyToDelete:                      "key-c08aa5c7abf34504f18552846485267d-yafn",
-			ExpectedDeleteResponse:           `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedDeleteResponse:           `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			ExpectedDeleteHTTPStatus:         http.StatusForbidden,
 			ExpectedGetHTTPStatus:            http.StatusForbidden,
-			ExpectedResponseOnGetAfterDelete: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponseOnGetAfterDelete: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			ProjectToSync:                    test.GenDefaultProject().Name,
 			ExistingAPIUser:                  test.GenAPIUser("John", "john@acme.com"),
 			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -2046,7 +3121,7 @@ func TestAssignSSHKeyToClusterEndpoint(t *testing.T) {
 		{
 			Name:             "scenario 4: the user John can not assign ssh key to the Bob's cluster",
 			SSHKeyID:         "key-c08aa5c7abf34504f18552846485267d-yafn",
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			HTTPStatus:       http.StatusForbidden,
 			ProjectToSync:    test.GenDefaultProject().Name,
 			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
@@ -2197,6 +3272,109 @@ func TestListSSHKeysAssignedToClusterEndpoint(t *testing.T) {
 	}
 }
 
+func TestSetSSHKeysAssignedToClusterEndpoint(t *testing.T) {
+	t.Parallel()
+
+	clusterID := test.GenDefaultCluster().Name
+	genSSHKey := func(id, name, projectID string, minute int, clusters ...string) *kubermaticv1.UserSSHKey {
+		return &kubermaticv1.UserSSHKey{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              id,
+				CreationTimestamp: metav1.NewTime(time.Date(2013, 02, 03, 19, minute, 0, 0, time.UTC)),
+			},
+			Spec: kubermaticv1.SSHKeySpec{
+				Name:     name,
+				Project:  projectID,
+				Clusters: clusters,
+			},
+		}
+	}
+	otherProject := test.GenProject("other-project", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp())
+
+	testcases := []struct {
+		Name                 string
+		Body                 string
+		HTTPStatus           int
+		ExpectedKeys         []apiv1.SSHKey
+		ExpectedAssignedKeys []string
+	}{
+		{
+			Name:       "scenario 1: attach and detach keys in one call",
+			Body:       `["key-b","key-c"]`,
+			HTTPStatus: http.StatusOK,
+			ExpectedKeys: []apiv1.SSHKey{
+				{ObjectMeta: apiv1.ObjectMeta{ID: "key-b", Name: "b", CreationTimestamp: apiv1.Date(2013, 02, 03, 19, 2, 0, 0, time.UTC)}},
+				{ObjectMeta: apiv1.ObjectMeta{ID: "key-c", Name: "c", CreationTimestamp: apiv1.Date(2013, 02, 03, 19, 3, 0, 0, time.UTC)}},
+			},
+			ExpectedAssignedKeys: []string{"key-b", "key-c"},
+		},
+		{
+			Name:                 "scenario 2: nothing is changed if one of the keys belongs to another project",
+			Body:                 `["key-c","key-foreign"]`,
+			HTTPStatus:           http.StatusBadRequest,
+			ExpectedAssignedKeys: []string{"key-a", "key-b"},
+		},
+		{
+			Name:                 "scenario 3: the list of keys is required",
+			Body:                 `null`,
+			HTTPStatus:           http.StatusBadRequest,
+			ExpectedAssignedKeys: []string{"key-a", "key-b"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/sshkeys", test.GenDefaultProject().Name, clusterID), strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+
+			kubermaticObjs := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				otherProject,
+				genSSHKey("key-a", "a", test.GenDefaultProject().Name, 1, clusterID),
+				genSSHKey("key-b", "b", test.GenDefaultProject().Name, 2, clusterID),
+				genSSHKey("key-c", "c", test.GenDefaultProject().Name, 3),
+				genSSHKey("key-foreign", "foreign", otherProject.Name, 4),
+			)
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, nil, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			if res.Code == http.StatusOK {
+				actualKeys := test.NewSSHKeyV1SliceWrapper{}
+				actualKeys.DecodeOrDie(res.Body, t).Sort()
+
+				wrappedExpectedKeys := test.NewSSHKeyV1SliceWrapper(tc.ExpectedKeys)
+				wrappedExpectedKeys.Sort()
+
+				actualKeys.EqualOrDie(wrappedExpectedKeys, t)
+			}
+
+			keys := &kubermaticv1.UserSSHKeyList{}
+			if err := clients.FakeClient.List(context.Background(), keys); err != nil {
+				t.Fatalf("failed to list ssh keys: %v", err)
+			}
+			assignedKeys := []string{}
+			for _, key := range keys.Items {
+				if key.IsUsedByCluster(clusterID) {
+					assignedKeys = append(assignedKeys, key.Name)
+				}
+			}
+			sort.Strings(assignedKeys)
+			if !equality.Semantic.DeepEqual(assignedKeys, tc.ExpectedAssignedKeys) {
+				t.Fatalf("expected the keys %v to be assigned to the cluster, got %v", tc.ExpectedAssignedKeys, assignedKeys)
+			}
+		})
+	}
+}
+
 func TestRevokeClusterAdminTokenEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -2238,7 +3416,7 @@ func TestRevokeClusterAdminTokenEndpoint(t *testing.T) {
 		// scenario 3
 		{
 			name:             "scenario 3: the user John can not revoke Bob's cluster token",
-			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			clusterToGet:     test.GenDefaultCluster(),
 			httpStatus:       http.StatusForbidden,
 			existingKubermaticObjs: test.GenDefaultKubermaticObjects(
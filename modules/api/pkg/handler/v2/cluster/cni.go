@@ -0,0 +1,254 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	semverlib "github.com/Masterminds/semver/v3"
+	"github.com/go-kit/kit/endpoint"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/cni"
+	"k8c.io/kubermatic/v2/pkg/features"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	"k8c.io/kubermatic/v2/pkg/validation"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// CNIMigration allows to change the CNI plugin type of existing clusters.
+const CNIMigration = "CNIMigration"
+
+func GetCNIEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	userInfoGetter provider.UserInfoGetter, features features.FeatureGate) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetClusterReq)
+
+		cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return convertCNISettings(cluster.Spec.CNIPlugin, cluster.Spec.ClusterNetwork.ProxyMode, cluster.Spec.Version.Semver(), features.Enabled(CNIMigration)), nil
+	}
+}
+
+// UpdateCNIEndpoint changes the CNI plugin of the cluster. Version changes of the same CNI plugin are limited to
+// upgrades by at most one minor version, changing the CNI plugin type requires the CNIMigration feature gate.
+func UpdateCNIEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool,
+	configGetter provider.KubermaticConfigurationGetter, features features.FeatureGate) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateCNIReq)
+
+		cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		current := cluster.Spec.CNIPlugin
+		if current == nil {
+			return nil, utilerrors.NewBadRequest("cluster %s has no CNI plugin settings", req.ClusterID)
+		}
+
+		migrationEnabled := features.Enabled(CNIMigration)
+		if current.Type.String() == req.Body.Type && current.Version == req.Body.Version {
+			return convertCNISettings(current, cluster.Spec.ClusterNetwork.ProxyMode, cluster.Spec.Version.Semver(), migrationEnabled), nil
+		}
+
+		candidates := cniUpgradeCandidates(current, cluster.Spec.Version.Semver(), migrationEnabled)
+		if !containsCNISettings(candidates, req.Body) {
+			return nil, utilerrors.NewBadRequest("cannot change CNI plugin from %s %s to %s %s, allowed targets are %v",
+				current.Type, current.Version, req.Body.Type, req.Body.Version, formatCNISettings(candidates))
+		}
+
+		spec := map[string]interface{}{
+			"cniPlugin": map[string]interface{}{
+				"type":    req.Body.Type,
+				"version": req.Body.Version,
+			},
+		}
+		patch := map[string]interface{}{
+			"spec": spec,
+		}
+		if current.Type.String() != req.Body.Type {
+			// the validation only accepts a CNI type change if the cluster is marked for migration
+			patch["labels"] = map[string]string{
+				validation.UnsafeCNIMigrationLabel: "true",
+			}
+			// eBPF proxy mode is not supported by Canal
+			if req.Body.Type == kubermaticv1.CNIPluginTypeCanal.String() && cluster.Spec.ClusterNetwork.ProxyMode == resources.EBPFProxyMode {
+				spec["clusterNetwork"] = map[string]interface{}{
+					"proxyMode": resources.IPVSProxyMode,
+				}
+			}
+		}
+
+		rawPatch, err := json.Marshal(patch)
+		if err != nil {
+			return nil, err
+		}
+
+		patchedCluster, _, err := handlercommon.PatchEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, rawPatch, seedsGetter,
+			projectProvider, privilegedProjectProvider, caBundle, configGetter, features, false, false, "", false)
+		if err != nil {
+			return nil, err
+		}
+
+		var proxyMode string
+		if patchedCluster.Spec.ClusterNetwork != nil {
+			proxyMode = patchedCluster.Spec.ClusterNetwork.ProxyMode
+		}
+
+		return convertCNISettings(patchedCluster.Spec.CNIPlugin, proxyMode, patchedCluster.Spec.Version.Semver(), migrationEnabled), nil
+	}
+}
+
+func convertCNISettings(cniPlugin *kubermaticv1.CNIPluginSettings, proxyMode string, k8sVersion *semverlib.Version, migrationEnabled bool) *apiv2.ClusterCNISettings {
+	result := &apiv2.ClusterCNISettings{
+		ProxyMode:         proxyMode,
+		UpgradeCandidates: []apiv2.CNISettings{},
+	}
+	if cniPlugin == nil {
+		return result
+	}
+
+	result.Type = cniPlugin.Type.String()
+	result.Version = cniPlugin.Version
+	result.UpgradeCandidates = cniUpgradeCandidates(cniPlugin, k8sVersion, migrationEnabled)
+
+	return result
+}
+
+// cniUpgradeCandidates returns the supported CNI plugin settings the given CNI plugin can be changed to. These are the
+// newer versions of the same plugin which are at most one minor version ahead or explicitly allowed and, if the
+// migration is enabled, the versions of the other CNI plugins.
+func cniUpgradeCandidates(current *kubermaticv1.CNIPluginSettings, k8sVersion *semverlib.Version, migrationEnabled bool) []apiv2.CNISettings {
+	candidates := []apiv2.CNISettings{}
+
+	for _, pluginType := range sets.List(cni.GetSupportedCNIPlugins()) {
+		cniType := kubermaticv1.CNIPluginType(pluginType)
+		if cniType == kubermaticv1.CNIPluginTypeNone {
+			continue
+		}
+		if cniType != current.Type && !migrationEnabled {
+			continue
+		}
+
+		versions, err := cni.GetSupportedCNIPluginVersions(cniType)
+		if err != nil {
+			continue
+		}
+
+		for _, version := range sets.List(versions) {
+			if cniType == current.Type && !isAllowedCNIUpgrade(cniType, current.Version, version, k8sVersion) {
+				continue
+			}
+			candidates = append(candidates, apiv2.CNISettings{Type: pluginType, Version: version})
+		}
+	}
+
+	return candidates
+}
+
+func isAllowedCNIUpgrade(cniType kubermaticv1.CNIPluginType, oldVersion, newVersion string, k8sVersion *semverlib.Version) bool {
+	oldV, err := semverlib.NewVersion(oldVersion)
+	if err != nil {
+		return false
+	}
+	newV, err := semverlib.NewVersion(newVersion)
+	if err != nil {
+		return false
+	}
+
+	if !newV.GreaterThan(oldV) {
+		return false
+	}
+	if newV.Major() == oldV.Major() && newV.Minor()-oldV.Minor() <= 1 {
+		return true
+	}
+
+	for _, transition := range cni.GetAllowedCNIVersionTransitions(cniType) {
+		if checkVersionConstraint(k8sVersion, transition.K8sVersion) &&
+			checkVersionConstraint(oldV, transition.OldCNIVersion) &&
+			checkVersionConstraint(newV, transition.NewCNIVersion) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkVersionConstraint(version *semverlib.Version, constraint string) bool {
+	if constraint == "" {
+		return true
+	}
+	c, err := semverlib.NewConstraint(constraint)
+	if err != nil {
+		return false
+	}
+	return c.Check(version)
+}
+
+func containsCNISettings(candidates []apiv2.CNISettings, settings apiv2.CNISettings) bool {
+	for _, candidate := range candidates {
+		if candidate == settings {
+			return true
+		}
+	}
+	return false
+}
+
+func formatCNISettings(settings []apiv2.CNISettings) []string {
+	result := make([]string, 0, len(settings))
+	for _, s := range settings {
+		result = append(result, fmt.Sprintf("%s %s", s.Type, s.Version))
+	}
+	return result
+}
+
+// updateCNIReq defines HTTP request for updateClusterCNI endpoint.
+// swagger:parameters updateClusterCNI
+type updateCNIReq struct {
+	GetClusterReq
+	// in: body
+	// required: true
+	Body apiv2.CNISettings
+}
+
+func DecodeUpdateCNIReq(c context.Context, r *http.Request) (interface{}, error) {
+	clusterReq, err := DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := updateCNIReq{GetClusterReq: clusterReq.(GetClusterReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse body: %v", err)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/handler/v2/cluster"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/validation"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestClusterCNI(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		Name               string
+		Body               string
+		CNIMigration       bool
+		HTTPStatus         int
+		ExpectedResponse   string
+		ExpectedCNI        kubermaticv1.CNIPluginSettings
+		ExpectedMigration  bool
+		ExpectedCandidates string
+	}{
+		{
+			Name:             "scenario 1: the CNI plugin can be upgraded by one minor version",
+			Body:             `{"type":"canal","version":"v3.28"}`,
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"type":"canal","version":"v3.28","proxyMode":"ipvs","upgradeCandidates":[{"type":"canal","version":"v3.29"}]}`,
+			ExpectedCNI: kubermaticv1.CNIPluginSettings{
+				Type:    kubermaticv1.CNIPluginTypeCanal,
+				Version: "v3.28",
+			},
+			ExpectedCandidates: `{"type":"canal","version":"v3.28","proxyMode":"ipvs","upgradeCandidates":[{"type":"canal","version":"v3.29"}]}`,
+		},
+		{
+			Name:             "scenario 2: the CNI plugin can not skip a minor version",
+			Body:             `{"type":"canal","version":"v3.29"}`,
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"cannot change CNI plugin from canal v3.27 to canal v3.29, allowed targets are [canal v3.28]"}}`,
+			ExpectedCNI: kubermaticv1.CNIPluginSettings{
+				Type:    kubermaticv1.CNIPluginTypeCanal,
+				Version: "v3.27",
+			},
+			ExpectedCandidates: `{"type":"canal","version":"v3.27","proxyMode":"ipvs","upgradeCandidates":[{"type":"canal","version":"v3.28"}]}`,
+		},
+		{
+			Name:             "scenario 3: the CNI plugin type can not be changed without the migration feature gate",
+			Body:             `{"type":"cilium","version":"1.16.9"}`,
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"cannot change CNI plugin from canal v3.27 to cilium 1.16.9, allowed targets are [canal v3.28]"}}`,
+			ExpectedCNI: kubermaticv1.CNIPluginSettings{
+				Type:    kubermaticv1.CNIPluginTypeCanal,
+				Version: "v3.27",
+			},
+			ExpectedCandidates: `{"type":"canal","version":"v3.27","proxyMode":"ipvs","upgradeCandidates":[{"type":"canal","version":"v3.28"}]}`,
+		},
+		{
+			Name:             "scenario 4: the CNI plugin type can be changed with the migration feature gate",
+			Body:             `{"type":"cilium","version":"1.16.9"}`,
+			CNIMigration:     true,
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"type":"cilium","version":"1.16.9","proxyMode":"ipvs","upgradeCandidates":[{"type":"canal","version":"v3.27"},{"type":"canal","version":"v3.28"},{"type":"canal","version":"v3.29"}]}`,
+			ExpectedCNI: kubermaticv1.CNIPluginSettings{
+				Type:    kubermaticv1.CNIPluginTypeCilium,
+				Version: "1.16.9",
+			},
+			ExpectedMigration:  true,
+			ExpectedCandidates: `{"type":"cilium","version":"1.16.9","proxyMode":"ipvs","upgradeCandidates":[{"type":"canal","version":"v3.27"},{"type":"canal","version":"v3.28"},{"type":"canal","version":"v3.29"}]}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			c := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+			c.Spec.Cloud.DatacenterName = "fake-dc"
+			c.Spec.ClusterNetwork.ProxyMode = resources.IPVSProxyMode
+			c.Spec.CNIPlugin = &kubermaticv1.CNIPluginSettings{
+				Type:    kubermaticv1.CNIPluginTypeCanal,
+				Version: "v3.27",
+			}
+
+			config := &kubermaticv1.KubermaticConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "kubermatic",
+					Namespace: resources.KubermaticNamespace,
+				},
+				Spec: kubermaticv1.KubermaticConfigurationSpec{
+					Versions: kubermaticv1.KubermaticVersioningConfiguration{
+						Versions: []semver.Semver{*semver.NewSemverOrDie("9.9.9")},
+					},
+					FeatureGates: map[string]bool{
+						cluster.CNIMigration: tc.CNIMigration,
+					},
+				},
+			}
+
+			kubermaticObjects := test.GenDefaultKubermaticObjects(test.GenTestSeed(), c)
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, nil, kubermaticObjects, config, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/cni", test.GenDefaultProject().Name, c.Name)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPut, path, strings.NewReader(tc.Body)))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+
+			storedCluster := &kubermaticv1.Cluster{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(c), storedCluster); err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			if *storedCluster.Spec.CNIPlugin != tc.ExpectedCNI {
+				t.Fatalf("Expected CNI plugin %v, got %v", tc.ExpectedCNI, *storedCluster.Spec.CNIPlugin)
+			}
+			if _, ok := storedCluster.Labels[validation.UnsafeCNIMigrationLabel]; ok != tc.ExpectedMigration {
+				t.Fatalf("Expected CNI migration label to be present: %v, got labels %v", tc.ExpectedMigration, storedCluster.Labels)
+			}
+
+			res = httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodGet, path, nil))
+			if res.Code != http.StatusOK {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedCandidates)
+		})
+	}
+}
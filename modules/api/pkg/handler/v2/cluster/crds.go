@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-kit/kit/endpoint"
+
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// ListCRDsEndpoint returns the custom resource definitions of the cluster.
+func ListCRDsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listCRDsReq)
+		return handlercommon.ListClusterCRDsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.Group, req.countInstances)
+	}
+}
+
+// listCRDsReq defines HTTP request for listClusterCRDs endpoint.
+// swagger:parameters listClusterCRDs
+type listCRDsReq struct {
+	GetClusterReq
+	// Only returns the definitions of the given API group.
+	// in: query
+	Group string `json:"group,omitempty"`
+	// Set to true to count the custom resources of every definition.
+	// in: query
+	Count string `json:"count,omitempty"`
+
+	countInstances bool
+}
+
+func DecodeListCRDsReq(c context.Context, r *http.Request) (interface{}, error) {
+	clusterReq, err := DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := listCRDsReq{GetClusterReq: clusterReq.(GetClusterReq)}
+	req.Group = r.URL.Query().Get("group")
+	req.Count = r.URL.Query().Get("count")
+	if req.Count != "" {
+		req.countInstances, err = strconv.ParseBool(req.Count)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid value %q for count: %v", req.Count, err)
+		}
+	}
+
+	return req, nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestListClusterCRDs(t *testing.T) {
+	genCRD := func(group, plural, kind string, scope apiextensionsv1.ResourceScope, established bool, versions ...apiextensionsv1.CustomResourceDefinitionVersion) *apiextensionsv1.CustomResourceDefinition {
+		status := apiextensionsv1.ConditionFalse
+		if established {
+			status = apiextensionsv1.ConditionTrue
+		}
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: plural + "." + group},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group:    group,
+				Names:    apiextensionsv1.CustomResourceDefinitionNames{Plural: plural, Kind: kind, ListKind: kind + "List"},
+				Scope:    scope,
+				Versions: versions,
+			},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{
+				Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+					{Type: apiextensionsv1.Established, Status: status},
+				},
+			},
+		}
+	}
+	genCR := func(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+		cr := &unstructured.Unstructured{}
+		cr.SetAPIVersion(apiVersion)
+		cr.SetKind(kind)
+		cr.SetNamespace(namespace)
+		cr.SetName(name)
+		return cr
+	}
+
+	served := apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1", Served: true, Storage: true}
+	genUserClusterObjects := func() []ctrlruntimeclient.Object {
+		return []ctrlruntimeclient.Object{
+			genCRD("cert-manager.io", "certificates", "Certificate", apiextensionsv1.NamespaceScoped, true,
+				apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1alpha2", Served: false},
+				served,
+			),
+			genCRD("cert-manager.io", "clusterissuers", "ClusterIssuer", apiextensionsv1.ClusterScoped, true, served),
+			genCRD("example.com", "widgets", "Widget", apiextensionsv1.NamespaceScoped, false,
+				apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1beta1", Served: true},
+				apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1", Served: true, Storage: true},
+			),
+			genCR("cert-manager.io/v1", "Certificate", "default", "frontend"),
+			genCR("cert-manager.io/v1", "Certificate", "kube-system", "webhook"),
+			genCR("cert-manager.io/v1", "ClusterIssuer", "", "letsencrypt"),
+		}
+	}
+
+	certificates := `{"name":"certificates.cert-manager.io","group":"cert-manager.io","kind":"Certificate","versions":["v1"],"scope":"Namespaced","established":true%s}`
+	clusterIssuers := `{"name":"clusterissuers.cert-manager.io","group":"cert-manager.io","kind":"ClusterIssuer","versions":["v1"],"scope":"Cluster","established":true%s}`
+	widgets := `{"name":"widgets.example.com","group":"example.com","kind":"Widget","versions":["v1beta1","v1"],"scope":"Namespaced","established":false%s}`
+
+	testcases := []struct {
+		Name             string
+		Query            string
+		ExistingAPIUser  *apiv1.User
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: all custom resource definitions are listed",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: "[" + fmt.Sprintf(certificates, "") + "," + fmt.Sprintf(clusterIssuers, "") + "," + fmt.Sprintf(widgets, "") + "]",
+		},
+		{
+			Name:             "scenario 2: the custom resource definitions are filtered by group",
+			Query:            "?group=cert-manager.io",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: "[" + fmt.Sprintf(certificates, "") + "," + fmt.Sprintf(clusterIssuers, "") + "]",
+		},
+		{
+			Name:             "scenario 3: the custom resources are counted",
+			Query:            "?count=true",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: "[" + fmt.Sprintf(certificates, `,"instances":2`) + "," + fmt.Sprintf(clusterIssuers, `,"instances":1`) + "," + fmt.Sprintf(widgets, `,"instances":0`) + "]",
+		},
+		{
+			Name:             "scenario 4: a project viewer can list the custom resource definitions",
+			Query:            "?group=example.com",
+			ExistingAPIUser:  test.GenAPIUser(test.UserName2, test.UserEmail2),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: "[" + fmt.Sprintf(widgets, "") + "]",
+		},
+		{
+			Name:             "scenario 5: an invalid count is rejected",
+			Query:            "?count=maybe",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"invalid value \"maybe\" for count: strconv.ParseBool: parsing \"maybe\": invalid syntax"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			kubermaticObjects := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenUser(test.UserID2, test.UserName2, test.UserEmail2),
+				test.GenBinding(test.GenDefaultProject().Name, test.UserEmail2, "viewers"),
+			)
+			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, genUserClusterObjects(), nil, kubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/crds%s", test.GenDefaultProject().Name, test.GenDefaultCluster().Name, tc.Query)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodGet, path, nil))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
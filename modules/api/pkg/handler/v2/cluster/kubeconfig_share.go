@@ -0,0 +1,337 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/log"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// KubeconfigShareComponentValue is the ServiceAccountComponentKey label value of the service accounts and
+	// cluster role bindings of kubeconfig shares.
+	KubeconfigShareComponentValue = "kubeconfigShare"
+
+	// KubeconfigShareClusterRole is the cluster role bound to the service accounts of kubeconfig shares.
+	KubeconfigShareClusterRole = "view"
+
+	kubeconfigShareExpiryAnnotation  = "kubermatic.io/kubeconfig-share-expiry"
+	kubeconfigShareCreatorAnnotation = "kubermatic.io/kubeconfig-share-creator"
+
+	// minKubeconfigShareValidity is the shortest expiration the API server accepts for service account tokens, it
+	// has to be kept in sync with the error message of CreateKubeconfigShareEndpoint.
+	minKubeconfigShareValidity = 10 * time.Minute
+	maxKubeconfigShareValidity = 30 * 24 * time.Hour
+)
+
+// CreateKubeconfigShareEndpoint creates a service account bound to the view cluster role in the user cluster and
+// returns a kubeconfig with a token of the service account, which expires at the requested time.
+func CreateKubeconfigShareEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createKubeconfigShareReq)
+
+		validity := time.Until(req.Body.ExpiresAt.Time)
+		if validity < minKubeconfigShareValidity || validity > maxKubeconfigShareValidity {
+			return nil, utilerrors.NewBadRequest("the kubeconfig share must expire between 10 minutes and 30 days from now")
+		}
+
+		clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+		cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		userInfo, err := userInfoGetter(ctx, req.ProjectID)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, req.ProjectID)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		serviceAccount := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "kubeconfig-share-",
+				Namespace:    metav1.NamespaceSystem,
+				Labels:       map[string]string{ServiceAccountComponentKey: KubeconfigShareComponentValue},
+				Annotations: map[string]string{
+					kubeconfigShareExpiryAnnotation:  req.Body.ExpiresAt.UTC().Format(time.RFC3339),
+					kubeconfigShareCreatorAnnotation: userInfo.Email,
+				},
+			},
+		}
+		if err := client.Create(ctx, serviceAccount); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		kubeconfig, err := createKubeconfigShare(ctx, client, clusterProvider, cluster, serviceAccount, validity)
+		if err != nil {
+			// don't leave a service account behind, which nobody got a kubeconfig for
+			if deleteErr := deleteKubeconfigShare(ctx, client, serviceAccount); deleteErr != nil {
+				log.Logger.Errorf("failed to clean up the service account %s of the kubeconfig share: %v", serviceAccount.Name, deleteErr)
+			}
+			return nil, err
+		}
+
+		share := convertInternalKubeconfigShareToExternal(serviceAccount)
+		share.Kubeconfig = string(kubeconfig)
+		return share, nil
+	}
+}
+
+func createKubeconfigShare(ctx context.Context, client ctrlruntimeclient.Client, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, serviceAccount *corev1.ServiceAccount, validity time.Duration) ([]byte, error) {
+	binding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   serviceAccount.Name,
+			Labels: map[string]string{ServiceAccountComponentKey: KubeconfigShareComponentValue},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     KubeconfigShareClusterRole,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      serviceAccount.Name,
+				Namespace: serviceAccount.Namespace,
+			},
+		},
+	}
+	if err := client.Create(ctx, binding); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: ptr.To(int64(validity.Seconds())),
+		},
+	}
+	if err := client.SubResource("token").Create(ctx, serviceAccount, tokenRequest); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	kubeconfig, err := handlercommon.NewServiceAccountKubeconfig(ctx, clusterProvider, cluster, serviceAccount.Name, tokenRequest.Status.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientcmd.Write(*kubeconfig)
+}
+
+// ListKubeconfigShareEndpoint lists the kubeconfig shares of the cluster, including the expired ones.
+func ListKubeconfigShareEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(kubeconfigShareClusterReq)
+
+		clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+		cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, req.ProjectID)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		serviceAccounts := &corev1.ServiceAccountList{}
+		if err := client.List(ctx, serviceAccounts, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem), ctrlruntimeclient.MatchingLabels{ServiceAccountComponentKey: KubeconfigShareComponentValue}); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		shares := make([]*apiv2.KubeconfigShare, len(serviceAccounts.Items))
+		for i := range serviceAccounts.Items {
+			shares[i] = convertInternalKubeconfigShareToExternal(&serviceAccounts.Items[i])
+		}
+		sort.Slice(shares, func(i, j int) bool {
+			if !shares[i].CreationTimestamp.Equal(shares[j].CreationTimestamp.Time) {
+				return shares[i].CreationTimestamp.Before(shares[j].CreationTimestamp.Time)
+			}
+			return shares[i].ID < shares[j].ID
+		})
+		return shares, nil
+	}
+}
+
+// DeleteKubeconfigShareEndpoint revokes the kubeconfig share by deleting its service account, which invalidates
+// the token of the kubeconfig.
+func DeleteKubeconfigShareEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(kubeconfigShareReq)
+
+		clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+		cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, req.ProjectID)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		serviceAccount := &corev1.ServiceAccount{}
+		if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: req.ShareID}, serviceAccount); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if serviceAccount.Labels[ServiceAccountComponentKey] != KubeconfigShareComponentValue {
+			return nil, utilerrors.NewNotFound("KubeconfigShare", req.ShareID)
+		}
+
+		return nil, deleteKubeconfigShare(ctx, client, serviceAccount)
+	}
+}
+
+func deleteKubeconfigShare(ctx context.Context, client ctrlruntimeclient.Client, serviceAccount *corev1.ServiceAccount) error {
+	binding := &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: serviceAccount.Name}}
+	if err := client.Delete(ctx, binding); err != nil && !apierrors.IsNotFound(err) {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	if err := client.Delete(ctx, serviceAccount); err != nil && !apierrors.IsNotFound(err) {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+
+	return nil
+}
+
+func convertInternalKubeconfigShareToExternal(serviceAccount *corev1.ServiceAccount) *apiv2.KubeconfigShare {
+	share := &apiv2.KubeconfigShare{
+		ID:                serviceAccount.Name,
+		CreatedBy:         serviceAccount.Annotations[kubeconfigShareCreatorAnnotation],
+		CreationTimestamp: apiv1.NewTime(serviceAccount.CreationTimestamp.Time),
+	}
+
+	// shares with an unparsable expiry are reported as expired, their token can't be trusted to expire in time
+	expiresAt, err := time.Parse(time.RFC3339, serviceAccount.Annotations[kubeconfigShareExpiryAnnotation])
+	if err != nil {
+		share.Expired = true
+		return share
+	}
+	share.ExpiresAt = apiv1.NewTime(expiresAt)
+	share.Expired = !time.Now().Before(expiresAt)
+
+	return share
+}
+
+// kubeconfigShareClusterReq defines HTTP request for listKubeconfigShares
+// swagger:parameters listKubeconfigShares
+type kubeconfigShareClusterReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	ClusterID string `json:"cluster_id"`
+}
+
+func (req kubeconfigShareClusterReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeKubeconfigShareClusterReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req kubeconfigShareClusterReq
+
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	return req, nil
+}
+
+// createKubeconfigShareReq defines HTTP request for createKubeconfigShare
+// swagger:parameters createKubeconfigShare
+type createKubeconfigShareReq struct {
+	kubeconfigShareClusterReq
+	// in: body
+	// required: true
+	Body apiv2.CreateKubeconfigShareBody
+}
+
+func DecodeCreateKubeconfigShareReq(c context.Context, r *http.Request) (interface{}, error) {
+	clusterReq, err := DecodeKubeconfigShareClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := createKubeconfigShareReq{kubeconfigShareClusterReq: clusterReq.(kubeconfigShareClusterReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse body: %v", err)
+	}
+
+	return req, nil
+}
+
+// kubeconfigShareReq defines HTTP request for deleteKubeconfigShare
+// swagger:parameters deleteKubeconfigShare
+type kubeconfigShareReq struct {
+	kubeconfigShareClusterReq
+	// in: path
+	// required: true
+	ShareID string `json:"share_id"`
+}
+
+func DecodeKubeconfigShareReq(c context.Context, r *http.Request) (interface{}, error) {
+	clusterReq, err := DecodeKubeconfigShareClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := kubeconfigShareReq{kubeconfigShareClusterReq: clusterReq.(kubeconfigShareClusterReq)}
+	req.ShareID = mux.Vars(r)["share_id"]
+	if req.ShareID == "" {
+		return nil, utilerrors.NewBadRequest("'share_id' parameter is required but was not provided")
+	}
+
+	return req, nil
+}
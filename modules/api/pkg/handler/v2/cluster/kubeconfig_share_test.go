@@ -0,0 +1,248 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/handler/v2/cluster"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func genKubeconfigShareKubermaticObjects() []ctrlruntimeclient.Object {
+	return []ctrlruntimeclient.Object{
+		test.GenTestSeed(),
+		test.GenProject("foo", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+		test.GenBinding("foo-ID", "john@acme.com", "owners"),
+		test.GenUser("", "john", "john@acme.com"),
+		test.GenCluster("cluster-foo", "cluster-foo", "foo-ID", test.DefaultCreationTimestamp()),
+	}
+}
+
+func genKubeconfigShareSA(name, expiresAt string) *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceSystem,
+			Name:      name,
+			Labels:    map[string]string{cluster.ServiceAccountComponentKey: cluster.KubeconfigShareComponentValue},
+			Annotations: map[string]string{
+				"kubermatic.io/kubeconfig-share-expiry":  expiresAt,
+				"kubermatic.io/kubeconfig-share-creator": "john@acme.com",
+			},
+		},
+	}
+}
+
+func TestCreateKubeconfigShareEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		Name             string
+		ExpiresAt        time.Time
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:       "scenario 1: a kubeconfig share is created",
+			ExpiresAt:  time.Now().Add(24 * time.Hour),
+			HTTPStatus: http.StatusCreated,
+		},
+		{
+			Name:             "scenario 2: kubeconfig shares can not be valid for more than 30 days",
+			ExpiresAt:        time.Now().Add(31 * 24 * time.Hour),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"the kubeconfig share must expire between 10 minutes and 30 days from now"}}`,
+		},
+		{
+			Name:             "scenario 3: kubeconfig shares can not expire in the past",
+			ExpiresAt:        time.Now().Add(-time.Hour),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"the kubeconfig share must expire between 10 minutes and 30 days from now"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			existingObjects := []ctrlruntimeclient.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "cluster-cluster-foo", Name: "admin-kubeconfig"},
+					Data:       map[string][]byte{"kubeconfig": []byte(test.GenerateTestKubeconfig("cluster-foo", test.IDToken))},
+				},
+			}
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenAPIUser("john", "john@acme.com"), nil, existingObjects, nil, genKubeconfigShareKubermaticObjects(), nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			body := fmt.Sprintf(`{"expiresAt":%q}`, tc.ExpiresAt.UTC().Format(time.RFC3339))
+			req := httptest.NewRequest(http.MethodPost, "/api/v2/projects/foo-ID/clusters/cluster-foo/kubeconfig/share", strings.NewReader(body))
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.HTTPStatus != http.StatusCreated {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			share := &apiv2.KubeconfigShare{}
+			if err := json.Unmarshal(res.Body.Bytes(), share); err != nil {
+				t.Fatalf("failed to unmarshal the kubeconfig share: %v", err)
+			}
+			if !strings.HasPrefix(share.ID, "kubeconfig-share-") || share.CreatedBy != "john@acme.com" || share.Expired {
+				t.Fatalf("unexpected kubeconfig share %+v", share)
+			}
+			if !share.ExpiresAt.Equal(tc.ExpiresAt.Truncate(time.Second)) {
+				t.Fatalf("Expected the share to expire at %v, got %v", tc.ExpiresAt, share.ExpiresAt)
+			}
+
+			kubeconfig, err := clientcmd.Load([]byte(share.Kubeconfig))
+			if err != nil {
+				t.Fatalf("failed to load the kubeconfig: %v", err)
+			}
+			if authInfo, ok := kubeconfig.AuthInfos["sa-"+share.ID]; !ok || authInfo.Token != "fake-token" {
+				t.Fatalf("Expected the kubeconfig to use the token of the service account, got %+v", kubeconfig.AuthInfos)
+			}
+
+			ctx := context.Background()
+			serviceAccount := &corev1.ServiceAccount{}
+			if err := clients.FakeClient.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: share.ID}, serviceAccount); err != nil {
+				t.Fatalf("failed to get the service account: %v", err)
+			}
+			if serviceAccount.Labels[cluster.ServiceAccountComponentKey] != cluster.KubeconfigShareComponentValue {
+				t.Fatalf("Expected the service account to be labeled as kubeconfig share, got labels %v", serviceAccount.Labels)
+			}
+
+			binding := &rbacv1.ClusterRoleBinding{}
+			if err := clients.FakeClient.Get(ctx, types.NamespacedName{Name: share.ID}, binding); err != nil {
+				t.Fatalf("failed to get the cluster role binding: %v", err)
+			}
+			if binding.RoleRef.Kind != "ClusterRole" || binding.RoleRef.Name != cluster.KubeconfigShareClusterRole {
+				t.Fatalf("Expected the service account to be bound to the view cluster role, got %+v", binding.RoleRef)
+			}
+			if len(binding.Subjects) != 1 || binding.Subjects[0].Name != share.ID || binding.Subjects[0].Namespace != metav1.NamespaceSystem {
+				t.Fatalf("Expected the service account to be the only subject, got %+v", binding.Subjects)
+			}
+		})
+	}
+}
+
+func TestListKubeconfigSharesEndpoint(t *testing.T) {
+	t.Parallel()
+
+	existingObjects := []ctrlruntimeclient.Object{
+		genKubeconfigShareSA("kubeconfig-share-b", "2099-01-01T00:00:00Z"),
+		genKubeconfigShareSA("kubeconfig-share-a", "2020-01-01T00:00:00Z"),
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "other"}},
+	}
+	ep, err := test.CreateTestEndpoint(*test.GenAPIUser("john", "john@acme.com"), existingObjects, genKubeconfigShareKubermaticObjects(), nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v2/projects/foo-ID/clusters/cluster-foo/kubeconfig/share", nil)
+	res := httptest.NewRecorder()
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+	test.CompareWithResult(t, res, `[`+
+		`{"id":"kubeconfig-share-a","createdBy":"john@acme.com","creationTimestamp":"0001-01-01T00:00:00Z","expiresAt":"2020-01-01T00:00:00Z","expired":true},`+
+		`{"id":"kubeconfig-share-b","createdBy":"john@acme.com","creationTimestamp":"0001-01-01T00:00:00Z","expiresAt":"2099-01-01T00:00:00Z","expired":false}`+
+		`]`)
+}
+
+func TestDeleteKubeconfigShareEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		Name             string
+		ShareID          string
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: a kubeconfig share is revoked",
+			ShareID:          "kubeconfig-share-a",
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{}`,
+		},
+		{
+			Name:             "scenario 2: other service accounts can not be deleted",
+			ShareID:          "other",
+			HTTPStatus:       http.StatusNotFound,
+			ExpectedResponse: `{"error":{"code":404,"message":"KubeconfigShare \"other\" not found"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			existingObjects := []ctrlruntimeclient.Object{
+				genKubeconfigShareSA("kubeconfig-share-a", "2099-01-01T00:00:00Z"),
+				&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "kubeconfig-share-a"}},
+				&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "other"}},
+			}
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenAPIUser("john", "john@acme.com"), nil, existingObjects, nil, genKubeconfigShareKubermaticObjects(), nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/v2/projects/foo-ID/clusters/cluster-foo/kubeconfig/share/"+tc.ShareID, nil)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+
+			ctx := context.Background()
+			serviceAccount := &corev1.ServiceAccount{}
+			err = clients.FakeClient.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: tc.ShareID}, serviceAccount)
+			if deleted := apierrors.IsNotFound(err); deleted != (tc.HTTPStatus == http.StatusOK) {
+				t.Fatalf("Expected the service account to be deleted: %v, got error %v", tc.HTTPStatus == http.StatusOK, err)
+			}
+			if tc.HTTPStatus == http.StatusOK {
+				err := clients.FakeClient.Get(ctx, types.NamespacedName{Name: tc.ShareID}, &rbacv1.ClusterRoleBinding{})
+				if !apierrors.IsNotFound(err) {
+					t.Fatalf("Expected the cluster role binding to be deleted, got error %v", err)
+				}
+			}
+		})
+	}
+}
@@ -20,15 +20,18 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/serviceaccount"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -165,7 +168,7 @@ func TestGetMasterKubeconfig(t *testing.T) {
 				},
 			},
 			ExistingAPIUser:        *test.GenAPIUser("bob", "bob@acme.com"),
-			ExpectedResponseString: `{"error":{"code":403,"message":"forbidden: \"bob@acme.com\" doesn't belong to project foo-ID"}}`,
+			ExpectedResponseString: `{"error":{"code":403,"message":"forbidden: \"bob@acme.com\" doesn't belong to project foo-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 		},
 	}
 
@@ -372,6 +375,141 @@ func TestGetClusterSAKubeconfig(t *testing.T) {
 	}
 }
 
+func TestGetServiceAccountKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	const saEmail = "serviceaccount-1@sa.kubermatic.io"
+
+	genSAToken := func(t *testing.T, projectID string) (string, *corev1.Secret) {
+		generator, err := serviceaccount.JWTTokenGenerator([]byte(test.TestServiceAccountHashKey))
+		if err != nil {
+			t.Fatalf("failed to create token generator: %v", err)
+		}
+		token, err := generator.Generate(serviceaccount.Claims(saEmail, projectID, "tokenID"))
+		if err != nil {
+			t.Fatalf("failed to generate token: %v", err)
+		}
+
+		secret := test.GenDefaultSaToken(projectID, "serviceaccount-1", "test", "tokenID")
+		secret.Data["token"] = []byte(token)
+		return token, secret
+	}
+
+	adminKubeconfig := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "cluster-cluster-foo",
+			Name:      "admin-kubeconfig",
+		},
+		Data: map[string][]byte{
+			"kubeconfig": []byte(test.GenerateTestKubeconfig("cluster-foo", test.IDToken)),
+		},
+	}
+
+	testcases := []struct {
+		Name                   string
+		TokenProjectID         string
+		ProjectToGet           string
+		ClusterProjectID       string
+		HTTPStatus             int
+		ExistingAPIUser        apiv1.User
+		ExpectedResponseString string
+	}{
+		{
+			Name:             "scenario 1: service account gets kubeconfig for a cluster of its project",
+			TokenProjectID:   "foo-ID",
+			ProjectToGet:     "foo-ID",
+			ClusterProjectID: "foo-ID",
+			HTTPStatus:       http.StatusOK,
+			ExistingAPIUser:  *test.GenAPIUser("test", saEmail),
+		},
+		{
+			Name:                   "scenario 2: service account can't get kubeconfig for a cluster of another project",
+			TokenProjectID:         "foo-ID",
+			ProjectToGet:           "foo-ID",
+			ClusterProjectID:       "bar-ID",
+			HTTPStatus:             http.StatusForbidden,
+			ExistingAPIUser:        *test.GenAPIUser("test", saEmail),
+			ExpectedResponseString: `{"error":{"code":403,"message":"cluster cluster-foo doesn't belong to project foo-ID"}}`,
+		},
+		{
+			Name:                   "scenario 3: service account token of another project is rejected",
+			TokenProjectID:         "bar-ID",
+			ProjectToGet:           "foo-ID",
+			ClusterProjectID:       "foo-ID",
+			HTTPStatus:             http.StatusForbidden,
+			ExistingAPIUser:        *test.GenAPIUser("test", saEmail),
+			ExpectedResponseString: `{"error":{"code":403,"message":"the token doesn't belong to a service account of project foo-ID"}}`,
+		},
+		{
+			Name:                   "scenario 4: regular user can't get a service account kubeconfig",
+			ProjectToGet:           "foo-ID",
+			ClusterProjectID:       "foo-ID",
+			HTTPStatus:             http.StatusForbidden,
+			ExistingAPIUser:        *test.GenAPIUser("john", "john@acme.com"),
+			ExpectedResponseString: `{"error":{"code":403,"message":"invalid service account token: go-jose/go-jose: compact JWS format must have three parts"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			existingObjects := []ctrlruntimeclient.Object{adminKubeconfig}
+			var token string
+			if tc.TokenProjectID != "" {
+				var tokenSecret *corev1.Secret
+				token, tokenSecret = genSAToken(t, tc.TokenProjectID)
+				existingObjects = append(existingObjects, tokenSecret)
+			}
+
+			kubermaticObjs := []ctrlruntimeclient.Object{
+				test.GenTestSeed(),
+				test.GenProject("foo", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenProject("bar", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("foo-ID", "john@acme.com", "owners"),
+				test.GenBinding("foo-ID", saEmail, "editors"),
+				test.GenUser("", "john", "john@acme.com"),
+				test.GenProjectServiceAccount("1", "test", "editors", "foo-ID"),
+				test.GenCluster("cluster-foo", "cluster-foo", tc.ClusterProjectID, test.DefaultCreationTimestamp()),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/cluster-foo/serviceaccountkubeconfig", tc.ProjectToGet), nil)
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			res := httptest.NewRecorder()
+			ep, _, err := test.CreateTestEndpointAndGetClients(tc.ExistingAPIUser, nil, existingObjects, []ctrlruntimeclient.Object{}, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			if tc.HTTPStatus != http.StatusOK {
+				test.CompareWithResult(t, res, tc.ExpectedResponseString)
+				return
+			}
+
+			kubeconfig, err := clientcmd.Load(res.Body.Bytes())
+			if err != nil {
+				t.Fatalf("failed to parse kubeconfig: %v", err)
+			}
+			authInfo, ok := kubeconfig.AuthInfos["default"]
+			if !ok {
+				t.Fatalf("expected kubeconfig to contain the default user, got %v", kubeconfig.AuthInfos)
+			}
+			if authInfo.Token != token {
+				t.Fatalf("expected kubeconfig to use the service account token, got %q", authInfo.Token)
+			}
+			if authInfo.Impersonate != saEmail || !reflect.DeepEqual(authInfo.ImpersonateGroups, []string{"editors-foo-ID"}) {
+				t.Fatalf("expected kubeconfig to map to the project group, got user %q and groups %v", authInfo.Impersonate, authInfo.ImpersonateGroups)
+			}
+		})
+	}
+}
+
 func genToken(clusterID string, userName string, tokenID string) string {
 	return fmt.Sprintf(`apiVersion: v1
 clusters:
@@ -0,0 +1,258 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	clusterresources "k8c.io/dashboard/v2/pkg/resources/cluster"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testMaintenanceWindow = `{"weekdays":["Sunday"],"startTime":"02:00","duration":"2h"}`
+
+func TestUpdateClusterMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		Name               string
+		Body               string
+		ExistingAPIUser    *apiv1.User
+		HTTPStatus         int
+		ExpectedResponse   string
+		ExpectedAnnotation string
+	}{
+		{
+			Name:               "scenario 1: a project owner sets the maintenance window",
+			Body:               `{"weekdays":["saturday","Sunday"],"startTime":"22:00","duration":"4h","timezone":"Europe/Berlin"}`,
+			HTTPStatus:         http.StatusOK,
+			ExpectedResponse:   `{"weekdays":["saturday","Sunday"],"startTime":"22:00","duration":"4h","timezone":"Europe/Berlin"}`,
+			ExpectedAnnotation: `{"weekdays":["saturday","Sunday"],"startTime":"22:00","duration":"4h","timezone":"Europe/Berlin"}`,
+		},
+		{
+			Name:             "scenario 2: an empty window removes the maintenance window",
+			Body:             `{}`,
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"startTime":"","duration":""}`,
+		},
+		{
+			Name:               "scenario 3: an invalid window is rejected",
+			Body:               `{"weekdays":["Funday"],"startTime":"02:00","duration":"2h"}`,
+			HTTPStatus:         http.StatusBadRequest,
+			ExpectedResponse:   `{"error":{"code":400,"message":"invalid maintenance window: weekday \"Funday\" is invalid, it must be one of Monday, Tuesday, Wednesday, Thursday, Friday, Saturday and Sunday"}}`,
+			ExpectedAnnotation: testMaintenanceWindow,
+		},
+		{
+			Name:               "scenario 4: a project editor can't change the maintenance window",
+			Body:               `{"startTime":"02:00","duration":"2h"}`,
+			ExistingAPIUser:    test.GenAPIUser(test.UserName2, test.UserEmail2),
+			HTTPStatus:         http.StatusForbidden,
+			ExpectedResponse:   `{"error":{"code":403,"message":"forbidden: only project owners and admins can change the maintenance window"}}`,
+			ExpectedAnnotation: testMaintenanceWindow,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+			cluster.Annotations = map[string]string{clusterresources.MaintenanceWindowAnnotation: testMaintenanceWindow}
+			kubermaticObjects := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				cluster,
+				test.GenUser(test.UserID2, test.UserName2, test.UserEmail2),
+				test.GenBinding(test.GenDefaultProject().Name, test.UserEmail2, "editors"),
+			)
+			apiUser := test.GenDefaultAPIUser()
+			if tc.ExistingAPIUser != nil {
+				apiUser = tc.ExistingAPIUser
+			}
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*apiUser, nil, nil, nil, kubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/maintenance-window", test.GenDefaultProject().Name, cluster.Name)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPut, path, strings.NewReader(tc.Body)))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+
+			storedCluster := &kubermaticv1.Cluster{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(cluster), storedCluster); err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			if annotation := storedCluster.Annotations[clusterresources.MaintenanceWindowAnnotation]; annotation != tc.ExpectedAnnotation {
+				t.Fatalf("Expected maintenance window %q, got %q", tc.ExpectedAnnotation, annotation)
+			}
+		})
+	}
+}
+
+func TestPatchClusterVersionInMaintenanceWindow(t *testing.T) {
+	// the window starts on Sundays at 02:00 UTC and lasts two hours, 2025-01-05 is a Sunday
+	insideWindow := time.Date(2025, 1, 5, 3, 0, 0, 0, time.UTC)
+	outsideWindow := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+
+	defer func() { handlercommon.MaintenanceWindowNow = time.Now }()
+
+	testcases := []struct {
+		Name               string
+		Body               string
+		Query              string
+		Now                time.Time
+		MaintenanceWindow  string
+		ExistingAPIUser    *apiv1.User
+		HTTPStatus         int
+		ExpectedResponse   string
+		ExpectedVersion    string
+		ExpectedAnnotation string
+	}{
+		{
+			Name:            "scenario 1: the version can be changed inside of the maintenance window",
+			Body:            `{"spec":{"version":"9.9.10"}}`,
+			Now:             insideWindow,
+			HTTPStatus:      http.StatusOK,
+			ExpectedVersion: "9.9.10",
+		},
+		{
+			Name:             "scenario 2: a version change outside of the maintenance window is rejected with the next window",
+			Body:             `{"spec":{"version":"9.9.10"}}`,
+			Now:              outsideWindow,
+			HTTPStatus:       http.StatusConflict,
+			ExpectedResponse: `{"error":{"code":409,"message":"cluster keen-snyder is outside of its maintenance window, the next window starts at 2025-01-12T02:00:00Z"}}`,
+			ExpectedVersion:  "9.9.9",
+		},
+		{
+			Name:            "scenario 3: a project owner can force a version change outside of the maintenance window",
+			Body:            `{"spec":{"version":"9.9.10"}}`,
+			Query:           "?force=true",
+			Now:             outsideWindow,
+			HTTPStatus:      http.StatusOK,
+			ExpectedVersion: "9.9.10",
+		},
+		{
+			Name:             "scenario 4: a project editor can't force a version change outside of the maintenance window",
+			Body:             `{"spec":{"version":"9.9.10"}}`,
+			Query:            "?force=true",
+			Now:              outsideWindow,
+			ExistingAPIUser:  test.GenAPIUser(test.UserName2, test.UserEmail2),
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: only project owners and admins can bypass the maintenance window"}}`,
+			ExpectedVersion:  "9.9.9",
+		},
+		{
+			Name:            "scenario 5: other changes are not restricted by the maintenance window",
+			Body:            `{"spec":{"auditLogging":{"enabled":true}}}`,
+			Now:             outsideWindow,
+			HTTPStatus:      http.StatusOK,
+			ExpectedVersion: "9.9.9",
+		},
+		{
+			Name:               "scenario 6: the maintenance window can't be changed by a cluster patch",
+			Body:               fmt.Sprintf(`{"annotations":{%q:""}}`, clusterresources.MaintenanceWindowAnnotation),
+			Now:                outsideWindow,
+			ExistingAPIUser:    test.GenAPIUser(test.UserName2, test.UserEmail2),
+			HTTPStatus:         http.StatusOK,
+			ExpectedVersion:    "9.9.9",
+			ExpectedAnnotation: testMaintenanceWindow,
+		},
+		{
+			Name:              "scenario 7: a version change with an invalid stored maintenance window is rejected",
+			Body:              `{"spec":{"version":"9.9.10"}}`,
+			Now:               insideWindow,
+			MaintenanceWindow: `{"weekdays":["Sunday"],"startTime":"02:00","duration":"2h","timezone":"Mars/Olympus"}`,
+			HTTPStatus:        http.StatusConflict,
+			ExpectedResponse:  `{"error":{"code":409,"message":"the maintenance window stored for cluster keen-snyder is invalid, set a new maintenance window: timezone \"Mars/Olympus\" is unknown"}}`,
+			ExpectedVersion:   "9.9.9",
+		},
+		{
+			Name:              "scenario 8: a project owner can force a version change with an invalid stored maintenance window",
+			Body:              `{"spec":{"version":"9.9.10"}}`,
+			Query:             "?force=true",
+			Now:               insideWindow,
+			MaintenanceWindow: `{"weekdays":`,
+			HTTPStatus:        http.StatusOK,
+			ExpectedVersion:   "9.9.10",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			handlercommon.MaintenanceWindowNow = func() time.Time { return tc.Now }
+
+			maintenanceWindow := testMaintenanceWindow
+			if tc.MaintenanceWindow != "" {
+				maintenanceWindow = tc.MaintenanceWindow
+			}
+
+			cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+			cluster.Spec.Cloud.DatacenterName = "fake-dc"
+			cluster.Annotations = map[string]string{clusterresources.MaintenanceWindowAnnotation: maintenanceWindow}
+			kubermaticObjects := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				cluster,
+				test.GenUser(test.UserID2, test.UserName2, test.UserEmail2),
+				test.GenBinding(test.GenDefaultProject().Name, test.UserEmail2, "editors"),
+			)
+			apiUser := test.GenDefaultAPIUser()
+			if tc.ExistingAPIUser != nil {
+				apiUser = tc.ExistingAPIUser
+			}
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*apiUser, nil, nil, nil, kubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s%s", test.GenDefaultProject().Name, cluster.Name, tc.Query)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPatch, path, strings.NewReader(tc.Body)))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+
+			storedCluster := &kubermaticv1.Cluster{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(cluster), storedCluster); err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			if version := storedCluster.Spec.Version.String(); version != tc.ExpectedVersion {
+				t.Fatalf("Expected version %s, got %s", tc.ExpectedVersion, version)
+			}
+			if tc.ExpectedAnnotation != "" && storedCluster.Annotations[clusterresources.MaintenanceWindowAnnotation] != tc.ExpectedAnnotation {
+				t.Fatalf("Expected maintenance window %q, got %q", tc.ExpectedAnnotation, storedCluster.Annotations[clusterresources.MaintenanceWindowAnnotation])
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestPreviewClusterPatch(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		Name             string
+		Body             string
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			// the fields defaulted by the patch are part of the preview as well
+			Name:             "scenario 1: a version bump is previewed",
+			Body:             `{"spec":{"version":"9.9.10"}}`,
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"changes":[{"path":"/spec/clusterNetwork/nodeLocalDNSCacheEnabled","new":true},{"path":"/spec/kubernetesDashboard/enabled","new":true},{"path":"/spec/version","old":"9.9.9","new":"9.9.10"}]}`,
+		},
+		{
+			Name:             "scenario 2: a label change is previewed",
+			Body:             `{"labels":{"team":"platform","k8c.io/owner":"bob"}}`,
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"changes":[{"path":"/labels/k8c.io~1owner","new":"bob"},{"path":"/labels/team","new":"platform"},{"path":"/spec/clusterNetwork/nodeLocalDNSCacheEnabled","new":true},{"path":"/spec/kubernetesDashboard/enabled","new":true}]}`,
+		},
+		{
+			Name:             "scenario 3: cloud credentials are redacted",
+			Body:             `{"spec":{"cloud":{"fake":{"token":"secret-token"}}}}`,
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"changes":[{"path":"/spec/cloud/fake/token","old":"\u003credacted\u003e","new":"\u003credacted\u003e"},{"path":"/spec/clusterNetwork/nodeLocalDNSCacheEnabled","new":true},{"path":"/spec/kubernetesDashboard/enabled","new":true}]}`,
+		},
+		{
+			Name:       "scenario 4: an invalid patch fails like the patch itself",
+			Body:       `{"spec":{"version":"9.12.3"}}`,
+			HTTPStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+			cluster.Spec.Cloud.DatacenterName = "fake-dc"
+			kubermaticObjects := test.GenDefaultKubermaticObjects(test.GenTestSeed(), cluster)
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, nil, kubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			existingCluster := &kubermaticv1.Cluster{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(cluster), existingCluster); err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s", test.GenDefaultProject().Name, cluster.Name)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPost, path+"/patch-preview", strings.NewReader(tc.Body)))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			previewResponse := res.Body.String()
+
+			storedCluster := &kubermaticv1.Cluster{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(cluster), storedCluster); err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			if !reflect.DeepEqual(storedCluster, existingCluster) {
+				t.Fatalf("Expected the cluster to be unchanged by the preview, got %+v", storedCluster)
+			}
+
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			// the patch itself fails with the same error as its preview
+			res = httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPatch, path, strings.NewReader(tc.Body)))
+			if res.Code != tc.HTTPStatus || res.Body.String() != previewResponse {
+				t.Fatalf("Expected the patch to fail with %d %s, got %d %s", tc.HTTPStatus, previewResponse, res.Code, res.Body.String())
+			}
+		})
+	}
+}
@@ -124,7 +124,7 @@ func TestCreateClusterSAEndpoint(t *testing.T) {
 			saName:           "test",
 			saNamespace:      "default",
 			body:             `{"name":"test"}`,
-			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			httpStatus:       http.StatusForbidden,
 			clusterToGet:     test.GenDefaultCluster().Name,
 			existingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -633,7 +633,7 @@ func TestDeleteClusterSAEndpoint(t *testing.T) {
 			saName:           "test",
 			saNamespace:      "default",
 			body:             `{"namespace":"default", "name":"test"}`,
-			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			httpStatus:       http.StatusForbidden,
 			clusterToGet:     test.GenDefaultCluster().Name,
 			existingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -780,7 +780,7 @@ func TestListClusterSAEndpoint(t *testing.T) {
 		},
 		{
 			name:             "scenario 3: user can non list accounts for Bob's cluster",
-			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			httpStatus:       http.StatusForbidden,
 			clusterToGet:     test.GenDefaultCluster().Name,
 			existingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -946,7 +946,7 @@ func TestGetClusterSAPermissionsEndpoint(t *testing.T) {
 		},
 		{
 			name:             "scenario 6: user can get service account's permissions for Bob's cluster",
-			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			httpStatus:       http.StatusForbidden,
 			clusterToGet:     test.GenDefaultCluster().Name,
 			existingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -0,0 +1,408 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// supportBundleMaxSize is the maximum number of uncompressed bytes written to a support bundle. Files which would
+	// exceed it are skipped and listed in the truncation notice of the bundle.
+	supportBundleMaxSize = 64 << 20
+	// supportBundleIndent is the indentation of the JSON files of a support bundle.
+	supportBundleIndent = "  "
+
+	// supportBundleMaxNodeEvents is the number of most recent node events included in a support bundle.
+	supportBundleMaxNodeEvents = 1000
+
+	supportBundleRedacted = "<redacted>"
+)
+
+// errSupportBundleFull is returned by the supportBundleWriter once the maximum size of the bundle is reached.
+var errSupportBundleFull = errors.New("the support bundle reached its maximum size")
+
+// supportBundleSensitiveKeys are the (lowercase) substrings of JSON keys whose values are redacted in support bundles.
+var supportBundleSensitiveKeys = []string{
+	"password",
+	"secret",
+	"token",
+	"accesskey",
+	"apikey",
+	"privatekey",
+	"credential",
+	"kubeconfig",
+}
+
+type supportBundleFile struct {
+	name  string
+	fetch func() (interface{}, error)
+}
+
+type supportBundleResponse struct {
+	clusterID string
+	files     []supportBundleFile
+}
+
+// SupportBundleEndpoint collects the cluster object, its health, events, machine deployments, machines, nodes and
+// the recent node events into a support bundle. The data is fetched while the bundle is streamed to the client.
+func SupportBundleEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetClusterReq)
+		clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+		cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, req.ProjectID)
+		if err != nil {
+			return nil, common.UpstreamErrorToHTTPError(err, common.UpstreamUserCluster)
+		}
+
+		return &supportBundleResponse{
+			clusterID: cluster.Name,
+			files: []supportBundleFile{
+				{name: "cluster.json", fetch: func() (interface{}, error) {
+					return cluster, nil
+				}},
+				{name: "health.json", fetch: func() (interface{}, error) {
+					return handlercommon.HealthEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, projectProvider, privilegedProjectProvider)
+				}},
+				{name: "events.json", fetch: func() (interface{}, error) {
+					return handlercommon.GetClusterEventsEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, "", "", projectProvider, privilegedProjectProvider)
+				}},
+				{name: "machinedeployments.json", fetch: func() (interface{}, error) {
+					return handlercommon.ListMachineDeployments(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, nil, req.ProjectID, req.ClusterID, true)
+				}},
+				{name: "machines.json", fetch: func() (interface{}, error) {
+					machines := &clusterv1alpha1.MachineList{}
+					if err := client.List(ctx, machines, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+						return nil, err
+					}
+					return machines.Items, nil
+				}},
+				{name: "nodes.json", fetch: func() (interface{}, error) {
+					nodes := &corev1.NodeList{}
+					if err := client.List(ctx, nodes); err != nil {
+						return nil, err
+					}
+					return nodes.Items, nil
+				}},
+				{name: "node-events.json", fetch: func() (interface{}, error) {
+					return getRecentNodeEvents(ctx, client)
+				}},
+			},
+		}, nil
+	}
+}
+
+func getRecentNodeEvents(ctx context.Context, client ctrlruntimeclient.Client) ([]apiv1.Event, error) {
+	nodes := &corev1.NodeList{}
+	if err := client.List(ctx, nodes); err != nil {
+		return nil, err
+	}
+
+	events := make([]apiv1.Event, 0)
+	for i := range nodes.Items {
+		nodeEvents, err := common.GetEvents(ctx, client, &nodes.Items[i], metav1.NamespaceAll)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, nodeEvents...)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[j].LastTimestamp.Before(events[i].LastTimestamp)
+	})
+	if len(events) > supportBundleMaxNodeEvents {
+		events = events[:supportBundleMaxNodeEvents]
+	}
+
+	return events, nil
+}
+
+// EncodeSupportBundle streams the support bundle as a gzipped tarball. Every file is fetched, redacted and written
+// on its own, so that the bundle is never held in memory as a whole. Files which cannot be fetched are replaced by
+// an error file, as the response status has already been sent at that point.
+func EncodeSupportBundle(_ context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	rsp := response.(*supportBundleResponse)
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-disposition", fmt.Sprintf("attachment; filename=supportbundle-%s.tar.gz", rsp.clusterID))
+	w.Header().Add("Cache-Control", "no-cache")
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	defer func() {
+		if closeErr := tarWriter.Close(); err == nil {
+			err = closeErr
+		}
+		if closeErr := gzipWriter.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	var written int
+	var skipped []string
+	for _, file := range rsp.files {
+		name := file.name
+		data, err := collectSupportBundleFile(file)
+		write := func(w io.Writer) error {
+			return encodeSupportBundleJSON(w, data)
+		}
+		if err != nil {
+			name = strings.TrimSuffix(name, ".json") + ".error"
+			write = supportBundleText(err.Error())
+		}
+
+		// the size of a tar entry precedes its content, so the file is encoded once to count its bytes, which stops as
+		// soon as the remaining size of the bundle is exceeded, and once more into the bundle
+		counter := &supportBundleWriter{w: io.Discard, limit: supportBundleMaxSize - written}
+		if err := write(counter); err != nil {
+			if errors.Is(err, errSupportBundleFull) {
+				skipped = append(skipped, file.name)
+				continue
+			}
+			return err
+		}
+		if err := writeSupportBundleFile(tarWriter, name, counter.written, write); err != nil {
+			return err
+		}
+		written += counter.written
+	}
+
+	if len(skipped) > 0 {
+		notice := fmt.Sprintf("The support bundle exceeded the maximum size of %d bytes, the following files were skipped: %s\n", supportBundleMaxSize, strings.Join(skipped, ", "))
+		return writeSupportBundleFile(tarWriter, "TRUNCATED.txt", len(notice), supportBundleText(notice))
+	}
+
+	return nil
+}
+
+// collectSupportBundleFile fetches the content of the file and redacts it.
+func collectSupportBundleFile(file supportBundleFile) (interface{}, error) {
+	obj, err := file.fetch()
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect %s: %w", file.name, err)
+	}
+
+	// round-trip the object through its JSON representation to redact it independently of its type
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", file.name, err)
+	}
+	var data interface{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", file.name, err)
+	}
+
+	return redactSupportBundleData(data), nil
+}
+
+// encodeSupportBundleJSON writes the decoded JSON document indented like json.Encoder does. Other than json.Encoder,
+// it writes the document piece by piece, so that a writer which fails stops the encoding right away.
+func encodeSupportBundleJSON(w io.Writer, data interface{}) error {
+	if err := encodeSupportBundleJSONValue(w, data, ""); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func encodeSupportBundleJSONValue(w io.Writer, data interface{}, indent string) error {
+	write := func(parts ...string) error {
+		for _, part := range parts {
+			if _, err := io.WriteString(w, part); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	nestedIndent := indent + supportBundleIndent
+
+	switch value := data.(type) {
+	case map[string]interface{}:
+		if len(value) == 0 {
+			return write("{}")
+		}
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		if err := write("{"); err != nil {
+			return err
+		}
+		for i, key := range keys {
+			separator := ","
+			if i == 0 {
+				separator = ""
+			}
+			encodedKey, err := encodeSupportBundleScalar(key)
+			if err != nil {
+				return err
+			}
+			if err := write(separator, "\n", nestedIndent, encodedKey, ": "); err != nil {
+				return err
+			}
+			if err := encodeSupportBundleJSONValue(w, value[key], nestedIndent); err != nil {
+				return err
+			}
+		}
+		return write("\n", indent, "}")
+	case []interface{}:
+		if len(value) == 0 {
+			return write("[]")
+		}
+
+		if err := write("["); err != nil {
+			return err
+		}
+		for i, item := range value {
+			separator := ","
+			if i == 0 {
+				separator = ""
+			}
+			if err := write(separator, "\n", nestedIndent); err != nil {
+				return err
+			}
+			if err := encodeSupportBundleJSONValue(w, item, nestedIndent); err != nil {
+				return err
+			}
+		}
+		return write("\n", indent, "]")
+	default:
+		encoded, err := encodeSupportBundleScalar(value)
+		if err != nil {
+			return err
+		}
+		return write(encoded)
+	}
+}
+
+func encodeSupportBundleScalar(value interface{}) (string, error) {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// redactSupportBundleData replaces the values of all keys which might hold provider credentials or tokens.
+// Embedded raw objects, e.g. the provider spec of machines, are part of the decoded document and redacted too.
+func redactSupportBundleData(data interface{}) interface{} {
+	switch value := data.(type) {
+	case map[string]interface{}:
+		for key, nested := range value {
+			if isSupportBundleSensitiveKey(key) && nested != nil {
+				value[key] = supportBundleRedacted
+				continue
+			}
+			value[key] = redactSupportBundleData(nested)
+		}
+	case []interface{}:
+		for i := range value {
+			value[i] = redactSupportBundleData(value[i])
+		}
+	}
+
+	return data
+}
+
+func isSupportBundleSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitiveKey := range supportBundleSensitiveKeys {
+		if strings.Contains(key, sensitiveKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeSupportBundleFile(tarWriter *tar.Writer, name string, size int, write func(io.Writer) error) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(size),
+		ModTime: time.Now(),
+	}
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return err
+	}
+
+	buf := bufio.NewWriter(tarWriter)
+	if err := write(buf); err != nil {
+		return err
+	}
+	return buf.Flush()
+}
+
+func supportBundleText(text string) func(io.Writer) error {
+	return func(w io.Writer) error {
+		_, err := io.WriteString(w, text)
+		return err
+	}
+}
+
+// supportBundleWriter counts the bytes written through it and fails once more than limit bytes would be written.
+type supportBundleWriter struct {
+	w       io.Writer
+	written int
+	limit   int
+}
+
+func (w *supportBundleWriter) Write(p []byte) (int, error) {
+	if w.written+len(p) > w.limit {
+		return 0, errSupportBundleFull
+	}
+
+	n, err := w.w.Write(p)
+	w.written += n
+	return n, err
+}
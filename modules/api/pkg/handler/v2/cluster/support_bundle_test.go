@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestGetClusterSupportBundle(t *testing.T) {
+	t.Parallel()
+
+	const providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+
+	testcases := []struct {
+		Name             string
+		ExistingAPIUser  *apiv1.User
+		HTTPStatus       int
+		ExpectedResponse string
+		ExpectedFiles    []string
+	}{
+		{
+			Name:            "scenario 1: the project member gets the support bundle of the cluster",
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			HTTPStatus:      http.StatusOK,
+			ExpectedFiles:   []string{"cluster.json", "events.json", "health.json", "machinedeployments.json", "machines.json", "node-events.json", "nodes.json"},
+		},
+		{
+			Name:             "scenario 2: users outside of the project can not get the support bundle",
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			md := test.GenTestMachineDeployment("venus", providerSpec, map[string]string{"md": "venus"}, false)
+			machine := test.GenTestMachine("venus-1", providerSpec, map[string]string{"md": "venus"}, nil)
+			kubeObjects := []ctrlruntimeclient.Object{
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "venus-1"}},
+			}
+			kubermaticObjects := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster(), genUser("John", "john@acme.com", false))
+
+			ep, err := test.CreateTestEndpoint(*tc.ExistingAPIUser, kubeObjects, append(kubermaticObjects, md, machine), nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/supportbundle", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodGet, path, nil))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.HTTPStatus != http.StatusOK {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			files := readSupportBundle(t, res.Body)
+			fileNames := make([]string, 0, len(files))
+			for name, content := range files {
+				fileNames = append(fileNames, name)
+				if strings.Contains(content, "dummy-token") {
+					t.Errorf("Expected credentials to be redacted in %s, got %s", name, content)
+				}
+			}
+			sort.Strings(fileNames)
+			if !reflect.DeepEqual(fileNames, tc.ExpectedFiles) {
+				t.Fatalf("Expected files %v, got %v", tc.ExpectedFiles, fileNames)
+			}
+
+			if !strings.Contains(files["machines.json"], `"token": "<redacted>"`) {
+				t.Errorf("Expected the machine token to be redacted, got %s", files["machines.json"])
+			}
+			if !strings.Contains(files["nodes.json"], `"name": "venus-1"`) {
+				t.Errorf("Expected the node to be part of the bundle, got %s", files["nodes.json"])
+			}
+		})
+	}
+}
+
+func readSupportBundle(t *testing.T, r io.Reader) map[string]string {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		t.Fatalf("failed to read gzip stream: %v", err)
+	}
+
+	files := map[string]string{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return files
+		}
+		if err != nil {
+			t.Fatalf("failed to read tar stream: %v", err)
+		}
+
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", header.Name, err)
+		}
+		files[header.Name] = string(content)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	semverlib "github.com/Masterminds/semver/v3"
 	"github.com/go-kit/kit/endpoint"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
@@ -40,6 +41,16 @@ func GetUpgradesEndpoint(configGetter provider.KubermaticConfigurationGetter, pr
 	}
 }
 
+func GetUpgradePlanEndpoint(configGetter provider.KubermaticConfigurationGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(GetClusterReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, common.GetClusterReq{})
+		}
+		return handlercommon.GetUpgradePlanEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, projectProvider, privilegedProjectProvider, configGetter)
+	}
+}
+
 func UpgradeNodeDeploymentsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(UpgradeNodeDeploymentsReq)
@@ -88,3 +99,57 @@ func DecodeUpgradeNodeDeploymentsReq(c context.Context, r *http.Request) (interf
 
 	return req, nil
 }
+
+// upgradePreflightReq defines HTTP request for getClusterUpgradePreflight endpoint
+// swagger:parameters getClusterUpgradePreflight
+type upgradePreflightReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	ClusterID string `json:"cluster_id"`
+	// The control plane version the cluster would be upgraded to, e.g. v1.30.0.
+	// in: query
+	// required: true
+	To string `json:"to"`
+
+	to *semverlib.Version
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (req upgradePreflightReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeUpgradePreflightReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req upgradePreflightReq
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	req.To = r.URL.Query().Get("to")
+	if req.To == "" {
+		return nil, utilerrors.NewBadRequest("the 'to' parameter is required")
+	}
+	req.to, err = semverlib.NewVersion(req.To)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("invalid version %q: %v", req.To, err)
+	}
+
+	return req, nil
+}
+
+func UpgradePreflightEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(upgradePreflightReq)
+		return handlercommon.UpgradePreflightEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.to, projectProvider, privilegedProjectProvider)
+	}
+}
@@ -19,6 +19,7 @@ package cluster_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -29,14 +30,18 @@ import (
 	semverlib "github.com/Masterminds/semver/v3"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/provider"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	k8csemver "k8c.io/kubermatic/sdk/v2/semver"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -287,6 +292,67 @@ func TestGetClusterUpgrades(t *testing.T) {
 	}
 }
 
+func TestGetClusterUpgradePlan(t *testing.T) {
+	t.Parallel()
+
+	cluster := test.GenCluster("foo", "foo", "project", time.Now())
+	cluster.Labels = map[string]string{"user": test.UserName}
+	cluster.Spec.Version = *k8csemver.NewSemverOrDie("1.29.0")
+
+	genMachineDeployment := func(name, kubeletVersion string) ctrlruntimeclient.Object {
+		md := test.GenTestMachineDeployment(name, `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false)
+		md.Spec.Template.Spec.Versions.Kubelet = kubeletVersion
+		return md
+	}
+
+	config := &kubermaticv1.KubermaticConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kubermatic",
+			Namespace: resources.KubermaticNamespace,
+		},
+		Spec: kubermaticv1.KubermaticConfigurationSpec{
+			Versions: kubermaticv1.KubermaticVersioningConfiguration{
+				Versions: []k8csemver.Semver{
+					*k8csemver.NewSemverOrDie("1.29.0"),
+					*k8csemver.NewSemverOrDie("1.29.1"),
+					*k8csemver.NewSemverOrDie("1.30.0"),
+					*k8csemver.NewSemverOrDie("1.32.0"),
+				},
+				Updates: []kubermaticv1.Update{
+					{From: "1.29.*", To: "1.29.*"},
+					{From: "1.29.*", To: "1.30.*"},
+					{From: "1.29.*", To: "1.32.*"},
+				},
+			},
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/foo/upgrades/plan", test.ProjectName), nil)
+	res := httptest.NewRecorder()
+	machineObjs := []ctrlruntimeclient.Object{
+		genMachineDeployment("old", "1.27.0"),
+		genMachineDeployment("current", "1.29.0"),
+	}
+	kubermaticObjs := test.GenDefaultKubermaticObjects(test.GenTestSeed(), cluster)
+
+	ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, machineObjs, kubermaticObjs, config, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+	ep.ServeHTTP(res, req)
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected status code to be 200, got %d\nResponse body: %q", res.Code, res.Body.String())
+	}
+
+	// 1.29.1 is compatible with all kubelets. For 1.30.0 the 1.27 kubelet has to be upgraded to the current
+	// control plane version first. 1.32.0 is too far ahead for any kubelet to be upgraded before it.
+	test.CompareWithResult(t, res, `[`+
+		`{"version":"1.29.1"},`+
+		`{"version":"1.30.0","incompatibleMachineDeployments":[{"name":"old","kubeletVersion":"1.27.0","requiredKubeletVersion":"1.29.0"}]},`+
+		`{"version":"1.32.0","incompatibleMachineDeployments":[{"name":"current","kubeletVersion":"1.29.0"},{"name":"old","kubeletVersion":"1.27.0"}]}`+
+		`]`)
+}
+
 func TestUpgradeClusterNodeDeployments(t *testing.T) {
 	t.Parallel()
 
@@ -404,3 +470,165 @@ func TestUpgradeClusterNodeDeployments(t *testing.T) {
 		})
 	}
 }
+
+func TestGetClusterUpgradePreflight(t *testing.T) {
+	genPreflightCluster := func() *kubermaticv1.Cluster {
+		cluster := test.GenCluster("keen-snyder", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+		cluster.Spec.Version = *k8csemver.NewSemverOrDie("1.29.0")
+		cluster.Status.ExtendedHealth.Etcd = kubermaticv1.HealthStatusUp
+		return cluster
+	}
+
+	genMachineDeployment := func(name, kubeletVersion string, availableReplicas int32) ctrlruntimeclient.Object {
+		md := test.GenTestMachineDeployment(name, `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false)
+		md.Spec.Template.Spec.Versions.Kubelet = kubeletVersion
+		md.Status.AvailableReplicas = availableReplicas
+		md.Status.UpdatedReplicas = 1
+		return md
+	}
+
+	healthyEtcd := &handlercommon.EtcdStatus{MemberCount: 3, HealthyMembers: 3}
+
+	testcases := []struct {
+		Name               string
+		To                 string
+		ExpectedResponse   string
+		HTTPStatus         int
+		EtcdStatus         *handlercommon.EtcdStatus
+		EtcdError          error
+		DeprecatedAPIs     []handlercommon.DeprecatedAPI
+		DeprecatedAPIsErr  error
+		ExistingAPIUser    *apiv1.User
+		ExistingUserObjs   []ctrlruntimeclient.Object
+		ExistingKubermatic []ctrlruntimeclient.Object
+	}{
+		{
+			Name: "scenario 1: all checks pass",
+			To:   "v1.30.0",
+			ExpectedResponse: `{"version":"1.30.0","status":"pass","checks":[` +
+				`{"name":"machineDeployments","status":"pass","message":"all 1 machine deployments are healthy"},` +
+				`{"name":"versionSkew","status":"pass","message":"all kubelets are compatible with the control plane 1.30.0"},` +
+				`{"name":"podDisruptionBudgets","status":"pass","message":"no pod disruption budget blocks the drain of nodes"},` +
+				`{"name":"etcd","status":"pass","message":"all 3 etcd members are healthy"},` +
+				`{"name":"deprecatedAPIs","status":"pass","message":"no deprecated APIs are in use"}]}`,
+			HTTPStatus:      http.StatusOK,
+			EtcdStatus:      healthyEtcd,
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExistingUserObjs: []ctrlruntimeclient.Object{
+				genMachineDeployment("venus", "1.29.0", 1),
+			},
+		},
+		{
+			Name: "scenario 2: the checks report what blocks the upgrade",
+			To:   "1.30.0",
+			ExpectedResponse: `{"version":"1.30.0","status":"fail","checks":[` +
+				`{"name":"machineDeployments","status":"fail","message":"1 of 2 machine deployments are not healthy","details":["mars has 0 of 1 replicas available and 1 updated"]},` +
+				`{"name":"versionSkew","status":"fail","message":"the kubelets have to be upgraded before the control plane","details":["kubelet 1.27.0 is not compatible with the control plane 1.30.0"]},` +
+				`{"name":"podDisruptionBudgets","status":"warn","message":"pod disruption budgets would block the drain of nodes","details":["default/web allows no disruption, 2 of 2 pods are healthy and 2 are required"]},` +
+				`{"name":"etcd","status":"fail","message":"etcd has active alarms","details":["member 8e9e05c52164694d has the alarm NOSPACE"]},` +
+				`{"name":"deprecatedAPIs","status":"fail","message":"2 deprecated APIs are in use and 1 are removed in 1.30.0","details":["autoscaling/v2beta2 horizontalpodautoscalers is removed in 1.26","flowcontrol.apiserver.k8s.io/v1beta3 flowschemas is deprecated"]}]}`,
+			HTTPStatus: http.StatusOK,
+			EtcdStatus: &handlercommon.EtcdStatus{
+				MemberCount:    3,
+				HealthyMembers: 3,
+				Alarms:         []apiv1.EtcdAlarm{{MemberID: "8e9e05c52164694d", Alarm: "NOSPACE"}},
+			},
+			DeprecatedAPIs: []handlercommon.DeprecatedAPI{
+				{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3", Resource: "flowschemas", RemovedRelease: "1.32"},
+				{Group: "autoscaling", Version: "v2beta2", Resource: "horizontalpodautoscalers", RemovedRelease: "1.26"},
+			},
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExistingUserObjs: []ctrlruntimeclient.Object{
+				genMachineDeployment("venus", "1.29.0", 1),
+				genMachineDeployment("mars", "1.27.0", 0),
+				&policyv1.PodDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: metav1.NamespaceDefault},
+					Status: policyv1.PodDisruptionBudgetStatus{
+						DisruptionsAllowed: 0,
+						CurrentHealthy:     2,
+						DesiredHealthy:     2,
+						ExpectedPods:       2,
+					},
+				},
+			},
+		},
+		{
+			Name: "scenario 3: checks which can not be run are reported as warnings",
+			To:   "1.30.0",
+			ExpectedResponse: `{"version":"1.30.0","status":"warn","checks":[` +
+				`{"name":"machineDeployments","status":"pass","message":"all 1 machine deployments are healthy"},` +
+				`{"name":"versionSkew","status":"pass","message":"all kubelets are compatible with the control plane 1.30.0"},` +
+				`{"name":"podDisruptionBudgets","status":"pass","message":"no pod disruption budget blocks the drain of nodes"},` +
+				`{"name":"etcd","status":"warn","message":"failed to query etcd: connection refused"},` +
+				`{"name":"deprecatedAPIs","status":"warn","message":"the deprecated APIs in use can not be determined: the server could not find the requested resource"}]}`,
+			HTTPStatus:        http.StatusOK,
+			EtcdError:         errors.New("connection refused"),
+			DeprecatedAPIsErr: errors.New("the server could not find the requested resource"),
+			ExistingAPIUser:   test.GenDefaultAPIUser(),
+			ExistingUserObjs: []ctrlruntimeclient.Object{
+				genMachineDeployment("venus", "1.29.0", 1),
+			},
+		},
+		{
+			Name:             "scenario 4: the target version must be newer than the current version",
+			To:               "1.29.0",
+			ExpectedResponse: `{"error":{"code":400,"message":"the target version 1.29.0 must be newer than the current version 1.29.0"}}`,
+			HTTPStatus:       http.StatusBadRequest,
+			EtcdStatus:       healthyEtcd,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+		},
+		{
+			Name:             "scenario 5: the target version must be a valid version",
+			To:               "latest",
+			ExpectedResponse: `{"error":{"code":400,"message":"invalid version \"latest\": Invalid Semantic Version"}}`,
+			HTTPStatus:       http.StatusBadRequest,
+			EtcdStatus:       healthyEtcd,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+		},
+		{
+			Name:             "scenario 6: the user John can not run the preflight checks of Bob's cluster",
+			To:               "1.30.0",
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+			HTTPStatus:       http.StatusForbidden,
+			EtcdStatus:       healthyEtcd,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			getEtcdStatus := handlercommon.GetEtcdStatus
+			getDeprecatedAPIs := handlercommon.GetRequestedDeprecatedAPIs
+			defer func() {
+				handlercommon.GetEtcdStatus = getEtcdStatus
+				handlercommon.GetRequestedDeprecatedAPIs = getDeprecatedAPIs
+			}()
+			handlercommon.GetEtcdStatus = func(_ context.Context, _ provider.ClusterProvider, _ kubernetes.Interface, _ *kubermaticv1.Cluster) (*handlercommon.EtcdStatus, error) {
+				return tc.EtcdStatus, tc.EtcdError
+			}
+			handlercommon.GetRequestedDeprecatedAPIs = func(_ context.Context, _ provider.ClusterProvider, _ *kubermaticv1.Cluster) ([]handlercommon.DeprecatedAPI, error) {
+				return tc.DeprecatedAPIs, tc.DeprecatedAPIsErr
+			}
+
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/keen-snyder/upgrade-preflight?to=%s", test.GenDefaultProject().Name, tc.To), nil)
+			res := httptest.NewRecorder()
+			kubermaticObjs := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genUser("John", "john@acme.com", false),
+				genPreflightCluster(),
+			)
+			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, []ctrlruntimeclient.Object{}, tc.ExistingUserObjs, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"go.uber.org/zap"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// clusterUsageWorkers is the number of clusters of a seed whose usage is read at the same time.
+	clusterUsageWorkers = 10
+	// clusterUsageTimeout bounds reading the usage of all clusters of a seed.
+	clusterUsageTimeout = 10 * time.Second
+)
+
+// GetProjectUsageEndpoint aggregates the machine deployments and node capacity of all clusters of a project.
+// Clusters which are not reachable are reported with an unknown usage instead of failing the whole request.
+func GetProjectUsageEndpoint(
+	projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider,
+	seedsGetter provider.SeedsGetter,
+	clusterProviderGetter provider.ClusterProviderGetter,
+	userInfoGetter provider.UserInfoGetter,
+) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetProjectUsageReq)
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		adminUserInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		seeds, err := seedsGetter()
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		usage := apiv2.ProjectUsage{Clusters: []apiv2.ClusterUsage{}}
+		capacity := kubermaticv1.NewResourceDetails(resource.Quantity{}, resource.Quantity{}, resource.Quantity{})
+
+		brokenSeeds := []string{}
+		for _, seed := range seeds {
+			if seed.Status.Phase == kubermaticv1.SeedInvalidPhase {
+				kubermaticlog.Logger.Warnf("skipping seed %s as it is in an invalid phase", seed.Name)
+				brokenSeeds = append(brokenSeeds, seed.Name)
+				continue
+			}
+
+			seedClusterProvider, err := clusterProviderGetter(seed)
+			if err != nil {
+				kubermaticlog.Logger.Errorw("failed to create cluster provider", "seed", seed.Name, zap.Error(err))
+				continue
+			}
+
+			clusters, err := seedClusterProvider.List(ctx, project, nil)
+			if err != nil {
+				kubermaticlog.Logger.Errorw("failed to get clusters from seed ", "seed", seed.Name, zap.Error(err))
+				brokenSeeds = append(brokenSeeds, seed.Name)
+				continue
+			}
+
+			accessibleClusters := make([]kubermaticv1.Cluster, 0, len(clusters.Items))
+			for _, cluster := range clusters.Items {
+				if _, _, err := provider.DatacenterFromSeedMap(adminUserInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName); err != nil {
+					// Ignore 403 errors and omit clusters with not accessible datacenters in the result.
+					var errHttp *utilerrors.HTTPError
+					if errors.As(err, &errHttp) && errHttp.StatusCode() == http.StatusForbidden {
+						continue
+					}
+					return nil, common.KubernetesErrorToHTTPError(err)
+				}
+				accessibleClusters = append(accessibleClusters, cluster)
+			}
+
+			for i, clusterUsage := range getClustersUsage(ctx, userInfoGetter, seedClusterProvider, accessibleClusters, project.Name) {
+				usage.Clusters = append(usage.Clusters, clusterUsage.ClusterUsage)
+				if clusterUsage.Status != apiv2.ClusterUsageAvailable {
+					kubermaticlog.Logger.Debugw("failed to get cluster usage", "cluster", accessibleClusters[i].Name, zap.Error(clusterUsage.err))
+					continue
+				}
+
+				usage.MachineDeploymentCount += clusterUsage.MachineDeploymentCount
+				usage.Replicas += clusterUsage.Replicas
+				usage.NodeCount += clusterUsage.NodeCount
+				capacity.CPU.Add(*clusterUsage.capacity.CPU)
+				capacity.Memory.Add(*clusterUsage.capacity.Memory)
+			}
+		}
+
+		sort.Slice(usage.Clusters, func(i, j int) bool {
+			return usage.Clusters[i].ID < usage.Clusters[j].ID
+		})
+		usage.ClusterCount = len(usage.Clusters)
+		usage.Capacity = apiv2.ConvertToAPIQuota(*capacity)
+
+		if len(brokenSeeds) > 0 {
+			errMsg := "Failed to fetch data for one or more seeds. Please contact an administrator."
+			if adminUserInfo.IsAdmin {
+				errMsg = fmt.Sprintf("Failed to fetch data for following seeds: %s.", strings.Join(brokenSeeds, `, `))
+			}
+			usage.ErrorMessage = &errMsg
+		}
+
+		return usage, nil
+	}
+}
+
+type clusterUsage struct {
+	apiv2.ClusterUsage
+
+	capacity *kubermaticv1.ResourceDetails
+	err      error
+}
+
+// getClustersUsage reads the usage of the clusters with a bounded number of workers. Clusters whose usage can't be
+// read in time are reported with an unknown usage. The result has the same order as the clusters.
+func getClustersUsage(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, clusters []kubermaticv1.Cluster, projectID string) []clusterUsage {
+	ctx, cancel := context.WithTimeout(ctx, clusterUsageTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	result := make([]clusterUsage, len(clusters))
+	positions := make(chan int)
+	for range min(clusterUsageWorkers, len(clusters)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			for pos := range positions {
+				result[pos] = getClusterUsage(ctx, userInfoGetter, clusterProvider, &clusters[pos], projectID)
+			}
+		}()
+	}
+
+	for i := range clusters {
+		positions <- i
+	}
+	close(positions)
+	wg.Wait()
+
+	return result
+}
+
+func getClusterUsage(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, projectID string) clusterUsage {
+	usage := clusterUsage{
+		ClusterUsage: apiv2.ClusterUsage{
+			ID:     cluster.Name,
+			Name:   cluster.Spec.HumanReadableName,
+			Status: apiv2.ClusterUsageUnknown,
+		},
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		usage.err = err
+		return usage
+	}
+
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		usage.err = err
+		return usage
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := client.List(ctx, nodes); err != nil {
+		usage.err = err
+		return usage
+	}
+
+	usage.MachineDeploymentCount = len(machineDeployments.Items)
+	for _, md := range machineDeployments.Items {
+		if md.Spec.Replicas != nil {
+			usage.Replicas += *md.Spec.Replicas
+		}
+	}
+
+	cpu, memory := resource.Quantity{}, resource.Quantity{}
+	for _, node := range nodes.Items {
+		cpu.Add(*node.Status.Capacity.Cpu())
+		memory.Add(*node.Status.Capacity.Memory())
+	}
+	usage.NodeCount = len(nodes.Items)
+	usage.capacity = kubermaticv1.NewResourceDetails(cpu, memory, resource.Quantity{})
+	usage.Capacity = apiv2.ConvertToAPIQuota(*usage.capacity)
+	usage.Status = apiv2.ClusterUsageAvailable
+
+	return usage
+}
+
+// GetProjectUsageReq defines HTTP request for getProjectUsage endpoint.
+// swagger:parameters getProjectUsage
+type GetProjectUsageReq struct {
+	common.ProjectReq
+}
+
+func DecodeGetProjectUsageReq(c context.Context, r *http.Request) (interface{}, error) {
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetProjectUsageReq{ProjectReq: pr.(common.ProjectReq)}, nil
+}
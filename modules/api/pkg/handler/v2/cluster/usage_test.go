@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func genUsageTestNode(name, cpu, memory string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+		},
+	}
+}
+
+func TestGetProjectUsage(t *testing.T) {
+	t.Parallel()
+
+	const providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+
+	testcases := []struct {
+		Name             string
+		UserClusterErr   error
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: the machine deployments and node capacity of the clusters are summed up",
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"clusterCount":1,"machineDeploymentCount":2,"replicas":3,"nodeCount":2,"capacity":{"cpu":6,"memory":12},"clusters":[{"id":"defClusterID","name":"defClusterName","status":"available","machineDeploymentCount":2,"replicas":3,"nodeCount":2,"capacity":{"cpu":6,"memory":12}}]}`,
+		},
+		{
+			Name:             "scenario 2: unreachable clusters are reported with an unknown usage",
+			UserClusterErr:   apierrors.NewServiceUnavailable("connection refused"),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"clusterCount":1,"machineDeploymentCount":0,"replicas":0,"nodeCount":0,"capacity":{"cpu":0},"clusters":[{"id":"defClusterID","name":"defClusterName","status":"unknown","machineDeploymentCount":0,"replicas":0,"nodeCount":0,"capacity":{}}]}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/usage", test.GenDefaultProject().Name), nil)
+			res := httptest.NewRecorder()
+
+			md := test.GenTestMachineDeployment("venus", providerSpec, nil, false)
+			replicas := int32(2)
+			otherMD := test.GenTestMachineDeployment("mars", providerSpec, nil, false)
+			otherMD.Spec.Replicas = &replicas
+
+			kubeObjects := []ctrlruntimeclient.Object{
+				genUsageTestNode("node-1", "2", "4G"),
+				genUsageTestNode("node-2", "4", "8G"),
+			}
+			kubermaticObjects := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster(), md, otherMD)
+
+			funcs := interceptor.Funcs{}
+			if tc.UserClusterErr != nil {
+				funcs.List = func(_ context.Context, _ ctrlruntimeclient.WithWatch, _ ctrlruntimeclient.ObjectList, _ ...ctrlruntimeclient.ListOption) error {
+					return tc.UserClusterErr
+				}
+			}
+
+			ep, err := test.CreateTestEndpointWithUserClusterInterceptor(*test.GenDefaultAPIUser(), kubeObjects, kubermaticObjects, nil, hack.NewTestRouting, funcs)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
@@ -36,6 +36,7 @@ import (
 	clusterv2 "k8c.io/dashboard/v2/pkg/handler/v2/cluster"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/features"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
@@ -421,8 +422,9 @@ func ImportEndpoint(
 				Credential:      req.Body.Cluster.Credential,
 				Spec:            req.Body.Cluster.Spec,
 			},
-			NodeDeployment: nd,
-			Applications:   apps,
+			NodeDeployment:     nd,
+			MachineDeployments: req.Body.MachineDeployments,
+			Applications:       apps,
 		}
 
 		return createOrUpdateClusterTemplate(ctx, userInfoGetter, seedsGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, credentialManager, exposeStrategy, caBundle, configGetter, features, clusterTemplateProvider, createCluster, req.ProjectID, req.Body.Name, req.Body.Scope, req.Body.UserSSHKeys, "", settingsProvider)
@@ -482,6 +484,10 @@ func createOrUpdateClusterTemplate(ctx context.Context, userInfoGetter provider.
 	}
 
 	newClusterTemplate.Annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation] = partialCluster.Annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation]
+	// The remaining initial machine deployments are requested one after another once the cluster was created.
+	if pending := partialCluster.Annotations[machine.PendingInitialMachineDeploymentsAnnotation]; pending != "" {
+		newClusterTemplate.Annotations[machine.PendingInitialMachineDeploymentsAnnotation] = pending
+	}
 
 	newClusterTemplate.Annotations[kubermaticv1.ClusterTemplateUserAnnotationKey] = adminUserInfo.Email
 	newClusterTemplate.Labels[kubermaticv1.ClusterTemplateProjectLabelKey] = project.Name
@@ -578,24 +584,7 @@ func CreateInstanceEndpoint(projectProvider provider.ProjectProvider, privileged
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
 
-		if adminUserInfo.IsAdmin {
-			privilegedclusterTemplateInstanceProvider := clusterTemplateInstanceProvider.(provider.PrivilegedClusterTemplateInstanceProvider)
-			instance, err := privilegedclusterTemplateInstanceProvider.CreateUnsecured(ctx, adminUserInfo, ct, project, req.Body.Replicas)
-			if err != nil {
-				return nil, common.KubernetesErrorToHTTPError(err)
-			}
-			return apiv2.ClusterTemplateInstance{
-				Name: instance.Name,
-				Spec: instance.Spec,
-			}, nil
-		}
-
-		userInfo, err := userInfoGetter(ctx, project.Name)
-		if err != nil {
-			return nil, common.KubernetesErrorToHTTPError(err)
-		}
-
-		instance, err := clusterTemplateInstanceProvider.Create(ctx, userInfo, ct, project, req.Body.Replicas)
+		instance, err := createInstance(ctx, userInfoGetter, adminUserInfo, clusterTemplateInstanceProvider, ct, project, req.Body.Replicas)
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
@@ -607,6 +596,22 @@ func CreateInstanceEndpoint(projectProvider provider.ProjectProvider, privileged
 	}
 }
 
+// createInstance creates a cluster template instance with the given replicas. Admins bypass the RBAC checks.
+func createInstance(ctx context.Context, userInfoGetter provider.UserInfoGetter, adminUserInfo *provider.UserInfo, clusterTemplateInstanceProvider provider.ClusterTemplateInstanceProvider,
+	ct *kubermaticv1.ClusterTemplate, project *kubermaticv1.Project, replicas int64) (*kubermaticv1.ClusterTemplateInstance, error) {
+	if adminUserInfo.IsAdmin {
+		privilegedclusterTemplateInstanceProvider := clusterTemplateInstanceProvider.(provider.PrivilegedClusterTemplateInstanceProvider)
+		return privilegedclusterTemplateInstanceProvider.CreateUnsecured(ctx, adminUserInfo, ct, project, replicas)
+	}
+
+	userInfo, err := userInfoGetter(ctx, project.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return clusterTemplateInstanceProvider.Create(ctx, userInfo, ct, project, replicas)
+}
+
 type encodeClusterTemplateResponse struct {
 	clusterTemplate *apiv2.ClusterTemplate
 	fileSuffix      string
@@ -747,6 +752,7 @@ func convertInternalClusterTemplatetoExternal(template *kubermaticv1.ClusterTemp
 		if err != nil {
 			return nil, err
 		}
+		initialNodeDeployment.Spec.Template.OperatingSystem.RedactSecrets()
 	}
 
 	var apps []apiv1.Application
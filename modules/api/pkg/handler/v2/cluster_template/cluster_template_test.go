@@ -17,6 +17,7 @@ limitations under the License.
 package clustertemplate_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -28,9 +29,11 @@ import (
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/defaulting"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -197,6 +200,70 @@ func TestCreateClusterTemplateEndpoint(t *testing.T) {
 	}
 }
 
+func TestCreateClusterTemplateWithMachineDeployments(t *testing.T) {
+	t.Parallel()
+	version := defaulting.DefaultKubernetesVersioning.Default.String()
+	machineDeployment := func(name string) string {
+		return fmt.Sprintf(`{"name":"%s","spec":{"replicas":1,"template":{"cloud":{"edge":{}},"operatingSystem":{"ubuntu":{}},"versions":{"kubelet":"%s"}}}}`, name, version)
+	}
+	body := fmt.Sprintf(`{"name":"test","scope":"project","cluster":{"name":"keen-snyder","spec":{"version":"%s","cloud":{"edge":{},"dc":"edge-dc"}}},"nodeDeployment":%s,"machineDeployments":[%s,%s]}`,
+		version, machineDeployment("legacy"), machineDeployment("workers"), machineDeployment("gpu-workers"))
+
+	seed := test.GenTestSeed()
+	seed.Spec.Datacenters["edge-dc"] = kubermaticv1.Datacenter{
+		Spec: kubermaticv1.DatacenterSpec{Edge: &kubermaticv1.DatacenterSpecEdge{}},
+	}
+	dummyKubermaticConfiguration := &kubermaticv1.KubermaticConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kubermatic",
+			Namespace: resources.KubermaticNamespace,
+		},
+		Spec: kubermaticv1.KubermaticConfigurationSpec{
+			Versions: kubermaticv1.KubermaticVersioningConfiguration{
+				Versions: defaulting.DefaultKubernetesVersioning.Versions,
+			},
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clustertemplates", test.GenDefaultProject().Name), strings.NewReader(body))
+	res := httptest.NewRecorder()
+	ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, nil, test.GenDefaultKubermaticObjects(seed), dummyKubermaticConfiguration, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusCreated {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusCreated, res.Code, res.Body.String())
+	}
+
+	templates := &kubermaticv1.ClusterTemplateList{}
+	if err := clients.FakeClient.List(context.Background(), templates); err != nil {
+		t.Fatalf("failed to list cluster templates: %v", err)
+	}
+	if len(templates.Items) != 1 {
+		t.Fatalf("expected exactly one cluster template, got %d", len(templates.Items))
+	}
+	annotations := templates.Items[0].Annotations
+
+	initialMD := &clusterv1alpha1.MachineDeployment{}
+	if err := json.Unmarshal([]byte(annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation]), initialMD); err != nil {
+		t.Fatalf("failed to unmarshal initial machine deployment: %v", err)
+	}
+	if initialMD.Name != "legacy" {
+		t.Fatalf("expected initial machine deployment %q, got %q", "legacy", initialMD.Name)
+	}
+
+	pendingMDs := []clusterv1alpha1.MachineDeployment{}
+	if err := json.Unmarshal([]byte(annotations[machine.PendingInitialMachineDeploymentsAnnotation]), &pendingMDs); err != nil {
+		t.Fatalf("failed to unmarshal pending machine deployments: %v", err)
+	}
+	if len(pendingMDs) != 2 || pendingMDs[0].Name != "workers" || pendingMDs[1].Name != "gpu-workers" {
+		t.Fatalf("expected pending machine deployments [workers gpu-workers], got %+v", pendingMDs)
+	}
+}
+
 func TestListClusterTemplates(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
@@ -0,0 +1,333 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertemplate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// clusterTemplateInstanceErrorReason is the reason of the events the cluster template controller records when it
+// fails to create the clusters of an instance.
+const clusterTemplateInstanceErrorReason = "ReconcilingError"
+
+// instanceReq defines HTTP request for getClusterTemplateInstanceStatus and retryClusterTemplateInstance
+// swagger:parameters getClusterTemplateInstanceStatus retryClusterTemplateInstance
+type instanceReq struct {
+	getClusterTemplatesReq
+	// in: path
+	// required: true
+	InstanceID string `json:"instance_id"`
+}
+
+// Validate validates instanceReq request.
+func (req instanceReq) Validate() error {
+	if err := req.getClusterTemplatesReq.Validate(); err != nil {
+		return err
+	}
+	if len(req.InstanceID) == 0 {
+		return fmt.Errorf("cluster template instance ID cannot be empty")
+	}
+	return nil
+}
+
+func DecodeInstanceReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req instanceReq
+
+	pr, err := DecodeGetReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.getClusterTemplatesReq = pr.(getClusterTemplatesReq)
+	req.InstanceID = mux.Vars(r)["instance_id"]
+
+	return req, nil
+}
+
+func GetInstanceStatusEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	userInfoGetter provider.UserInfoGetter, clusterTemplateProvider provider.ClusterTemplateProvider, seedsGetter provider.SeedsGetter,
+	clusterTemplateProviderGetter provider.ClusterTemplateInstanceProviderGetter, clusterProviderGetter provider.ClusterProviderGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(instanceReq)
+		if err := req.Validate(); err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+
+		state, err := getInstanceState(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, clusterTemplateProvider, seedsGetter, clusterTemplateProviderGetter, clusterProviderGetter, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return instanceStatus(state.instance, state.clusters, state.failure), nil
+	}
+}
+
+func RetryInstanceEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	userInfoGetter provider.UserInfoGetter, clusterTemplateProvider provider.ClusterTemplateProvider, seedsGetter provider.SeedsGetter,
+	clusterTemplateProviderGetter provider.ClusterTemplateInstanceProviderGetter, clusterProviderGetter provider.ClusterProviderGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(instanceReq)
+		if err := req.Validate(); err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+
+		state, err := getInstanceState(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, clusterTemplateProvider, seedsGetter, clusterTemplateProviderGetter, clusterProviderGetter, req)
+		if err != nil {
+			return nil, err
+		}
+
+		status := instanceStatus(state.instance, state.clusters, state.failure)
+		var failed int64
+		for _, replica := range status.Replicas {
+			if replica.Phase == apiv2.ClusterTemplateInstanceReplicaFailed {
+				failed++
+			}
+		}
+		if failed == 0 {
+			return nil, utilerrors.NewBadRequest("cluster template instance %s has no failed replicas", state.instance.Name)
+		}
+
+		retryInstance, err := createInstance(ctx, userInfoGetter, state.adminUserInfo, state.instanceProvider, state.template, state.project, failed)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		// The failed replicas are now owned by the new instance, so they are not reported as failed again. The
+		// controller only retries the replicas which are left and deletes the instance once there are none.
+		instance := state.instance.DeepCopy()
+		instance.Spec.Replicas -= failed
+		if err := patchInstance(ctx, userInfoGetter, state.adminUserInfo, state.instanceProvider, state.project, instance); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return apiv2.ClusterTemplateInstance{
+			Name: retryInstance.Name,
+			Spec: retryInstance.Spec,
+		}, nil
+	}
+}
+
+// instanceState holds the objects needed to derive the status of a cluster template instance.
+type instanceState struct {
+	adminUserInfo    *provider.UserInfo
+	project          *kubermaticv1.Project
+	template         *kubermaticv1.ClusterTemplate
+	instanceProvider provider.ClusterTemplateInstanceProvider
+	instance         *kubermaticv1.ClusterTemplateInstance
+	clusters         []kubermaticv1.Cluster
+	// failure is the latest error the controller reported for the instance, if any.
+	failure *corev1.Event
+}
+
+func getInstanceState(ctx context.Context, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	userInfoGetter provider.UserInfoGetter, clusterTemplateProvider provider.ClusterTemplateProvider, seedsGetter provider.SeedsGetter,
+	clusterTemplateProviderGetter provider.ClusterTemplateInstanceProviderGetter, clusterProviderGetter provider.ClusterProviderGetter, req instanceReq) (*instanceState, error) {
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, &provider.ProjectGetOptions{IncludeUninitialized: false})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	adminUserInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	ct, err := clusterTemplateProvider.Get(ctx, adminUserInfo, project.Name, req.ClusterTemplateID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	seed, _, err := provider.DatacenterFromSeedMap(adminUserInfo, seedsGetter, ct.Spec.Cloud.DatacenterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting seed: %w", err)
+	}
+
+	instanceProvider, err := clusterTemplateProviderGetter(seed)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	var instance *kubermaticv1.ClusterTemplateInstance
+	if adminUserInfo.IsAdmin {
+		instance, err = instanceProvider.(provider.PrivilegedClusterTemplateInstanceProvider).GetUnsecured(ctx, req.InstanceID)
+	} else {
+		var userInfo *provider.UserInfo
+		userInfo, err = userInfoGetter(ctx, project.Name)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		instance, err = instanceProvider.Get(ctx, userInfo, req.InstanceID)
+	}
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if instance.Spec.ProjectID != project.Name || instance.Spec.ClusterTemplateID != ct.Name {
+		return nil, utilerrors.NewNotFound("ClusterTemplateInstance", req.InstanceID)
+	}
+
+	clusterProvider, err := clusterProviderGetter(seed)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	clusterList, err := clusterProvider.ListAll(ctx, labels.SelectorFromSet(map[string]string{kubernetesprovider.ClusterTemplateInstanceLabelKey: instance.Name}))
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	var clusters []kubermaticv1.Cluster
+	for _, cluster := range clusterList.Items {
+		if cluster.DeletionTimestamp == nil {
+			clusters = append(clusters, cluster)
+		}
+	}
+
+	failure, err := getInstanceFailure(ctx, clusterProvider.(provider.PrivilegedClusterProvider).GetSeedClusterAdminRuntimeClient(), instance)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return &instanceState{
+		adminUserInfo:    adminUserInfo,
+		project:          project,
+		template:         ct,
+		instanceProvider: instanceProvider,
+		instance:         instance,
+		clusters:         clusters,
+		failure:          failure,
+	}, nil
+}
+
+// getInstanceFailure returns the latest error event the cluster template controller recorded for the instance.
+// Events of cluster-scoped objects are stored in the default namespace.
+func getInstanceFailure(ctx context.Context, client ctrlruntimeclient.Client, instance *kubermaticv1.ClusterTemplateInstance) (*corev1.Event, error) {
+	events := &corev1.EventList{}
+	if err := client.List(ctx, events, ctrlruntimeclient.InNamespace(metav1.NamespaceDefault)); err != nil {
+		return nil, err
+	}
+
+	var failure *corev1.Event
+	for i, event := range events.Items {
+		involved := event.InvolvedObject
+		if involved.Kind != kubermaticv1.ClusterTemplateInstanceKindName || involved.Name != instance.Name ||
+			(involved.UID != "" && involved.UID != instance.UID) {
+			continue
+		}
+		if event.Type != corev1.EventTypeWarning || event.Reason != clusterTemplateInstanceErrorReason {
+			continue
+		}
+		if failure == nil || eventTime(failure).Before(eventTime(&event)) {
+			failure = &events.Items[i]
+		}
+	}
+
+	return failure, nil
+}
+
+func eventTime(event *corev1.Event) metav1.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp
+	}
+	if !event.EventTime.IsZero() {
+		return metav1.NewTime(event.EventTime.Time)
+	}
+	return event.CreationTimestamp
+}
+
+// instanceStatus reports the existing clusters of the instance in creation order, followed by the replicas the
+// controller has not created yet. When the controller fails to create a cluster, it lowers the replicas of the
+// instance to the number of clusters which are left and records an error event. These replicas are failed unless
+// the controller has created clusters since, then they are pending like the replicas of an instance without errors.
+func instanceStatus(instance *kubermaticv1.ClusterTemplateInstance, clusters []kubermaticv1.Cluster, failure *corev1.Event) *apiv2.ClusterTemplateInstanceStatus {
+	sort.SliceStable(clusters, func(i, j int) bool {
+		if !clusters[i].CreationTimestamp.Equal(&clusters[j].CreationTimestamp) {
+			return clusters[i].CreationTimestamp.Before(&clusters[j].CreationTimestamp)
+		}
+		return clusters[i].Name < clusters[j].Name
+	})
+
+	status := &apiv2.ClusterTemplateInstanceStatus{
+		Name:     instance.Name,
+		Replicas: []apiv2.ClusterTemplateInstanceReplicaStatus{},
+	}
+	for i, cluster := range clusters {
+		status.Replicas = append(status.Replicas, apiv2.ClusterTemplateInstanceReplicaStatus{
+			Index:     i,
+			Phase:     apiv2.ClusterTemplateInstanceReplicaCreated,
+			ClusterID: cluster.Name,
+		})
+	}
+
+	// The replicas of the instance count the clusters which are left after the latest failure.
+	left := instance.Spec.Replicas
+	if failure != nil {
+		failureTime := eventTime(failure)
+		for _, cluster := range clusters {
+			if failureTime.Before(&cluster.CreationTimestamp) {
+				left--
+			}
+		}
+	} else {
+		left -= int64(len(clusters))
+	}
+	failed := failure != nil && left == instance.Spec.Replicas
+
+	for i := int64(0); i < left; i++ {
+		replica := apiv2.ClusterTemplateInstanceReplicaStatus{
+			Index: len(status.Replicas),
+			Phase: apiv2.ClusterTemplateInstanceReplicaPending,
+		}
+		if failed {
+			replica.Phase = apiv2.ClusterTemplateInstanceReplicaFailed
+			replica.Error = failure.Message
+		}
+		status.Replicas = append(status.Replicas, replica)
+	}
+
+	return status
+}
+
+func patchInstance(ctx context.Context, userInfoGetter provider.UserInfoGetter, adminUserInfo *provider.UserInfo, clusterTemplateInstanceProvider provider.ClusterTemplateInstanceProvider,
+	project *kubermaticv1.Project, instance *kubermaticv1.ClusterTemplateInstance) error {
+	if adminUserInfo.IsAdmin {
+		_, err := clusterTemplateInstanceProvider.(provider.PrivilegedClusterTemplateInstanceProvider).PatchUnsecured(ctx, instance)
+		return err
+	}
+
+	userInfo, err := userInfoGetter(ctx, project.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = clusterTemplateInstanceProvider.Patch(ctx, userInfo, instance)
+	return err
+}
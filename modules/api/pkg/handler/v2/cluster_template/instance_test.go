@@ -0,0 +1,237 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertemplate_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testInstanceName = "my-first-project-ID-ctID2"
+
+func genInstance(replicas int64, created time.Time) *kubermaticv1.ClusterTemplateInstance {
+	instance := test.GenClusterTemplateInstance(test.GenDefaultProject().Name, "ctID2", "john@acme.com", replicas)
+	instance.CreationTimestamp = metav1.NewTime(created)
+	return instance
+}
+
+func genInstanceCluster(id string, created time.Time) *kubermaticv1.Cluster {
+	return test.GenCluster(id, id, test.GenDefaultProject().Name, created, func(c *kubermaticv1.Cluster) {
+		c.Labels[kubernetes.ClusterTemplateInstanceLabelKey] = testInstanceName
+	})
+}
+
+// genInstanceFailure returns the event the controller records when it fails to create the clusters of the instance.
+func genInstanceFailure(name string, created time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind: kubermaticv1.ClusterTemplateInstanceKindName,
+			Name: testInstanceName,
+		},
+		Type:          corev1.EventTypeWarning,
+		Reason:        "ReconcilingError",
+		Message:       "failed to create desired number of clusters: quota exceeded",
+		LastTimestamp: metav1.NewTime(created),
+	}
+}
+
+func genInstanceKubermaticObjects(objs ...ctrlruntimeclient.Object) []ctrlruntimeclient.Object {
+	objs = append(objs,
+		test.GenTestSeed(),
+		test.GenAdminUser("admin", "john@acme.com", true),
+		test.GenClusterTemplate("ct1", "ctID1", test.GenDefaultProject().Name, kubermaticv1.UserClusterTemplateScope, test.GenDefaultAPIUser().Email),
+		test.GenClusterTemplate("ct2", "ctID2", "", kubermaticv1.GlobalClusterTemplateScope, "john@acme.com"),
+	)
+	return test.GenDefaultKubermaticObjects(objs...)
+}
+
+func TestGetClusterTemplateInstanceStatus(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	testcases := []struct {
+		Name                   string
+		TemplateID             string
+		InstanceID             string
+		ExpectedResponse       string
+		HTTPStatus             int
+		ExistingAPIUser        *apiv1.User
+		ExistingKubermaticObjs []ctrlruntimeclient.Object
+	}{
+		{
+			Name:             "scenario 1: partially failed instance reports the created cluster and the failed replicas",
+			TemplateID:       "ctID2",
+			InstanceID:       testInstanceName,
+			ExpectedResponse: `{"name":"my-first-project-ID-ctID2","replicas":[{"index":0,"phase":"Created","clusterID":"cluster-a"},{"index":1,"phase":"Failed","error":"failed to create desired number of clusters: quota exceeded"},{"index":2,"phase":"Failed","error":"failed to create desired number of clusters: quota exceeded"}]}`,
+			HTTPStatus:       http.StatusOK,
+			ExistingKubermaticObjs: genInstanceKubermaticObjects(
+				genInstance(2, now.Add(-time.Hour)),
+				genInstanceCluster("cluster-a", now.Add(-50*time.Minute)),
+				genInstanceFailure("failure", now.Add(-49*time.Minute)),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+		{
+			Name:             "scenario 2: replicas of a recent instance are pending",
+			TemplateID:       "ctID2",
+			InstanceID:       testInstanceName,
+			ExpectedResponse: `{"name":"my-first-project-ID-ctID2","replicas":[{"index":0,"phase":"Created","clusterID":"cluster-a"},{"index":1,"phase":"Pending"}]}`,
+			HTTPStatus:       http.StatusOK,
+			ExistingKubermaticObjs: genInstanceKubermaticObjects(
+				genInstance(2, now.Add(-time.Minute)),
+				genInstanceCluster("cluster-a", now),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+		{
+			Name:             "scenario 3: replicas are pending again while the controller creates clusters after a failure",
+			TemplateID:       "ctID2",
+			InstanceID:       testInstanceName,
+			ExpectedResponse: `{"name":"my-first-project-ID-ctID2","replicas":[{"index":0,"phase":"Created","clusterID":"cluster-a"},{"index":1,"phase":"Created","clusterID":"cluster-b"},{"index":2,"phase":"Pending"}]}`,
+			HTTPStatus:       http.StatusOK,
+			ExistingKubermaticObjs: genInstanceKubermaticObjects(
+				genInstance(2, now.Add(-time.Hour)),
+				genInstanceCluster("cluster-a", now.Add(-50*time.Minute)),
+				genInstanceFailure("failure", now.Add(-49*time.Minute)),
+				genInstanceCluster("cluster-b", now.Add(-time.Minute)),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+		{
+			Name:             "scenario 4: instance of another template is not found",
+			TemplateID:       "ctID1",
+			InstanceID:       testInstanceName,
+			ExpectedResponse: `{"error":{"code":404,"message":"ClusterTemplateInstance \"my-first-project-ID-ctID2\" not found"}}`,
+			HTTPStatus:       http.StatusNotFound,
+			ExistingKubermaticObjs: genInstanceKubermaticObjects(
+				genInstance(1, now.Add(-time.Hour)),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clustertemplates/%s/instances/%s/status", test.ProjectName, tc.TemplateID, tc.InstanceID), nil)
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*tc.ExistingAPIUser, []ctrlruntimeclient.Object{}, tc.ExistingKubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func TestRetryClusterTemplateInstance(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	testcases := []struct {
+		Name                     string
+		ExpectedResponse         string
+		HTTPStatus               int
+		ExpectedOriginalReplicas int64
+		ExistingAPIUser          *apiv1.User
+		ExistingKubermaticObjs   []ctrlruntimeclient.Object
+	}{
+		{
+			Name:                     "scenario 1: retry creates a new instance for the failed replicas only",
+			ExpectedResponse:         `{"name":"%s","spec":{"projectID":"my-first-project-ID","clusterTemplateID":"ctID2","clusterTemplateName":"ct2","replicas":2}}`,
+			HTTPStatus:               http.StatusCreated,
+			ExpectedOriginalReplicas: 0,
+			ExistingKubermaticObjs: genInstanceKubermaticObjects(
+				genInstance(2, now.Add(-time.Hour)),
+				genInstanceCluster("cluster-a", now.Add(-50*time.Minute)),
+				genInstanceFailure("failure", now.Add(-49*time.Minute)),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+		{
+			Name:                     "scenario 2: retry of an instance without failed replicas is rejected",
+			ExpectedResponse:         `{"error":{"code":400,"message":"cluster template instance my-first-project-ID-ctID2 has no failed replicas"}}`,
+			HTTPStatus:               http.StatusBadRequest,
+			ExpectedOriginalReplicas: 2,
+			ExistingKubermaticObjs: genInstanceKubermaticObjects(
+				genInstance(2, now.Add(-time.Minute)),
+				genInstanceCluster("cluster-a", now),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clustertemplates/ctID2/instances/%s/retry", test.ProjectName, testInstanceName), nil)
+			res := httptest.NewRecorder()
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, []ctrlruntimeclient.Object{}, []ctrlruntimeclient.Object{}, tc.ExistingKubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			expectedResponse := tc.ExpectedResponse
+			if res.Code == http.StatusCreated {
+				retryInstance := &apiv2.ClusterTemplateInstance{}
+				if err := json.Unmarshal(res.Body.Bytes(), retryInstance); err != nil {
+					t.Fatal(err)
+				}
+				expectedResponse = fmt.Sprintf(tc.ExpectedResponse, retryInstance.Name)
+			}
+			test.CompareWithResult(t, res, expectedResponse)
+
+			original := &kubermaticv1.ClusterTemplateInstance{}
+			if err := clients.FakeClient.Get(context.Background(), types.NamespacedName{Name: testInstanceName}, original); err != nil {
+				t.Fatalf("failed to get the original instance: %v", err)
+			}
+			if original.Spec.Replicas != tc.ExpectedOriginalReplicas {
+				t.Fatalf("expected the original instance to have %d replicas, got %d", tc.ExpectedOriginalReplicas, original.Spec.Replicas)
+			}
+		})
+	}
+}
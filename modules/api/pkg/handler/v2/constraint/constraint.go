@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -40,6 +41,7 @@ import (
 
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -123,6 +125,147 @@ func ListEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provid
 	}
 }
 
+// maxViolationsPerConstraint is the number of violations which are listed at most for a single constraint.
+const maxViolationsPerConstraint = 100
+
+// ListViolationsEndpoint summarizes the violations found by the gatekeeper audit for the constraints of the cluster,
+// grouped by constraint kind. Constraints which are not synced to the user cluster yet are left out.
+func ListViolationsEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listViolationsReq)
+		clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+		clus, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		clusterCli, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, clus, req.ProjectID)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		constraintProvider := ctx.Value(middleware.ConstraintProviderContextKey).(provider.ConstraintProvider)
+
+		var constraints []kubermaticv1.Constraint
+		if req.Constraint != "" {
+			constraint, err := constraintProvider.Get(ctx, clus, req.Constraint)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			constraints = append(constraints, *constraint)
+		} else {
+			constraintList, err := constraintProvider.List(ctx, clus)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			constraints = constraintList.Items
+		}
+
+		constraintNames := map[string]sets.Set[string]{}
+		for _, ct := range constraints {
+			if constraintNames[ct.Spec.ConstraintType] == nil {
+				constraintNames[ct.Spec.ConstraintType] = sets.New[string]()
+			}
+			constraintNames[ct.Spec.ConstraintType].Insert(ct.Name)
+		}
+
+		result := &apiv2.ClusterViolations{Kinds: []apiv2.ConstraintKindViolations{}}
+		for _, kind := range sets.List(sets.KeySet(constraintNames)) {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(schema.GroupVersionKind{
+				Group:   ConstraintsGroup,
+				Version: ConstraintsVersion,
+				Kind:    kind + "List",
+			})
+			if err := clusterCli.List(ctx, list); err != nil {
+				// the constraint template is not synced to the user cluster yet
+				if meta.IsNoMatchError(err) {
+					continue
+				}
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+
+			kindViolations := apiv2.ConstraintKindViolations{Kind: kind, Constraints: []apiv2.ConstraintViolations{}}
+			for _, uc := range list.Items {
+				if !constraintNames[kind].Has(uc.GetName()) {
+					continue
+				}
+
+				constraintViolations, err := getConstraintViolations(&uc)
+				if err != nil {
+					return nil, err
+				}
+				kindViolations.TotalViolations += constraintViolations.TotalViolations
+				kindViolations.Constraints = append(kindViolations.Constraints, *constraintViolations)
+			}
+			if len(kindViolations.Constraints) == 0 {
+				continue
+			}
+
+			sort.Slice(kindViolations.Constraints, func(i, j int) bool {
+				return kindViolations.Constraints[i].Name < kindViolations.Constraints[j].Name
+			})
+			result.TotalViolations += kindViolations.TotalViolations
+			result.Kinds = append(result.Kinds, kindViolations)
+		}
+
+		return result, nil
+	}
+}
+
+// getConstraintViolations reads the violations from the audit results in the status of the gatekeeper constraint.
+// At most maxViolationsPerConstraint violations are listed.
+func getConstraintViolations(uc *unstructured.Unstructured) (*apiv2.ConstraintViolations, error) {
+	constraintStatus, err := getConstraintStatus(uc)
+	if err != nil {
+		return nil, err
+	}
+	totalViolations, _, err := unstructured.NestedInt64(uc.Object, constraintStatusField, "totalViolations")
+	if err != nil {
+		return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("error getting total violations: %v", err))
+	}
+
+	violations := constraintStatus.Violations
+	// the audit results can be limited by gatekeeper as well, the total is counted in any case
+	totalViolations = max(totalViolations, int64(len(violations)))
+	if len(violations) > maxViolationsPerConstraint {
+		violations = violations[:maxViolationsPerConstraint]
+	}
+
+	return &apiv2.ConstraintViolations{
+		Name:            uc.GetName(),
+		AuditTimestamp:  constraintStatus.AuditTimestamp,
+		TotalViolations: totalViolations,
+		Violations:      violations,
+		Truncated:       int64(len(violations)) < totalViolations,
+	}, nil
+}
+
+// listViolationsReq defines HTTP request for list cluster violations endpoint
+// swagger:parameters listClusterViolations
+type listViolationsReq struct {
+	cluster.GetClusterReq
+	// Only the violations of the constraint with this name are listed
+	// in: query
+	Constraint string `json:"constraint,omitempty"`
+}
+
+func DecodeListViolationsReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req listViolationsReq
+
+	cr, err := cluster.DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req.GetClusterReq = cr.(cluster.GetClusterReq)
+	req.Constraint = r.URL.Query().Get("constraint")
+
+	return req, nil
+}
+
 func genConstraintKey(constraintType, name string) string {
 	return fmt.Sprintf("%s-%s", constraintType, name)
 }
@@ -205,7 +205,7 @@ func TestGetConstraints(t *testing.T) {
 			ConstraintName:   "ct1",
 			ProjectID:        test.GenDefaultProject().Name,
 			ClusterID:        test.GenDefaultCluster().Name,
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			HTTPStatus:       http.StatusForbidden,
 			ExistingObjects: test.GenDefaultKubermaticObjects(
 				test.GenTestSeed(),
@@ -351,6 +351,219 @@ func genGatekeeperConstraint(name, kind string, t *testing.T) *unstructured.Unst
 	return ct
 }
 
+func TestListClusterViolations(t *testing.T) {
+	t.Parallel()
+
+	type expectedConstraint struct {
+		name            string
+		totalViolations int64
+		violations      int
+		truncated       bool
+	}
+	type expectedKind struct {
+		kind            string
+		totalViolations int64
+		constraints     []expectedConstraint
+	}
+
+	existingObjects := func(objs ...ctrlruntimeclient.Object) []ctrlruntimeclient.Object {
+		return test.GenDefaultKubermaticObjects(append([]ctrlruntimeclient.Object{
+			test.GenTestSeed(),
+			test.GenDefaultCluster(),
+			test.GenConstraint("ct1", test.GenDefaultCluster().Status.NamespaceName, "RequiredLabel"),
+			test.GenConstraint("ct2", test.GenDefaultCluster().Status.NamespaceName, "RequiredLabel"),
+			test.GenConstraint("ct3", test.GenDefaultCluster().Status.NamespaceName, "UniqueLabel"),
+			test.GenConstraint("ct4", test.GenDefaultCluster().Status.NamespaceName, "UniqueLabel"),
+		}, objs...)...)
+	}
+	existingGatekeeperObjects := []ctrlruntimeclient.Object{
+		genGatekeeperConstraintWithViolations("ct1", "RequiredLabel", 2, t),
+		genGatekeeperConstraintWithViolations("ct2", "RequiredLabel", 150, t),
+		genGatekeeperConstraintWithViolations("ct3", "UniqueLabel", 3, t),
+	}
+
+	testcases := []struct {
+		Name                      string
+		Query                     string
+		HTTPStatus                int
+		ExistingAPIUser           *apiv1.User
+		ExistingObjects           []ctrlruntimeclient.Object
+		ExistingGatekeeperObjects []ctrlruntimeclient.Object
+		ExpectedTotalViolations   int64
+		ExpectedKinds             []expectedKind
+	}{
+		{
+			Name:                      "scenario 1: user can list the violations of all synced constraints",
+			HTTPStatus:                http.StatusOK,
+			ExistingAPIUser:           test.GenDefaultAPIUser(),
+			ExistingObjects:           existingObjects(),
+			ExistingGatekeeperObjects: existingGatekeeperObjects,
+			ExpectedTotalViolations:   155,
+			ExpectedKinds: []expectedKind{
+				{
+					kind:            "RequiredLabel",
+					totalViolations: 152,
+					constraints: []expectedConstraint{
+						{name: "ct1", totalViolations: 2, violations: 2},
+						{name: "ct2", totalViolations: 150, violations: 100, truncated: true},
+					},
+				},
+				{
+					kind:            "UniqueLabel",
+					totalViolations: 3,
+					constraints: []expectedConstraint{
+						{name: "ct3", totalViolations: 3, violations: 3},
+					},
+				},
+			},
+		},
+		{
+			Name:                      "scenario 2: user can list the violations of a single constraint",
+			Query:                     "?constraint=ct3",
+			HTTPStatus:                http.StatusOK,
+			ExistingAPIUser:           test.GenDefaultAPIUser(),
+			ExistingObjects:           existingObjects(),
+			ExistingGatekeeperObjects: existingGatekeeperObjects,
+			ExpectedTotalViolations:   3,
+			ExpectedKinds: []expectedKind{
+				{
+					kind:            "UniqueLabel",
+					totalViolations: 3,
+					constraints: []expectedConstraint{
+						{name: "ct3", totalViolations: 3, violations: 3},
+					},
+				},
+			},
+		},
+		{
+			Name:                      "scenario 3: the violations of a constraint which is not synced yet are empty",
+			Query:                     "?constraint=ct4",
+			HTTPStatus:                http.StatusOK,
+			ExistingAPIUser:           test.GenDefaultAPIUser(),
+			ExistingObjects:           existingObjects(),
+			ExistingGatekeeperObjects: existingGatekeeperObjects,
+			ExpectedKinds:             []expectedKind{},
+		},
+		{
+			Name:                      "scenario 4: user can not list the violations of a missing constraint",
+			Query:                     "?constraint=ct5",
+			HTTPStatus:                http.StatusNotFound,
+			ExistingAPIUser:           test.GenDefaultAPIUser(),
+			ExistingObjects:           existingObjects(),
+			ExistingGatekeeperObjects: existingGatekeeperObjects,
+		},
+		{
+			Name:                      "scenario 5: unauthorized user can not list violations",
+			HTTPStatus:                http.StatusForbidden,
+			ExistingAPIUser:           test.GenAPIUser("John", "john@acme.com"),
+			ExistingObjects:           existingObjects(),
+			ExistingGatekeeperObjects: existingGatekeeperObjects,
+		},
+		{
+			Name:                      "scenario 6: admin user can list the violations of any cluster",
+			Query:                     "?constraint=ct1",
+			HTTPStatus:                http.StatusOK,
+			ExistingAPIUser:           test.GenAPIUser("John", "john@acme.com"),
+			ExistingObjects:           existingObjects(genKubermaticUser("John", "john@acme.com", true)),
+			ExistingGatekeeperObjects: existingGatekeeperObjects,
+			ExpectedTotalViolations:   2,
+			ExpectedKinds: []expectedKind{
+				{
+					kind:            "RequiredLabel",
+					totalViolations: 2,
+					constraints: []expectedConstraint{
+						{name: "ct1", totalViolations: 2, violations: 2},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/violations%s",
+				test.GenDefaultProject().Name, test.GenDefaultCluster().Name, tc.Query), strings.NewReader(""))
+			res := httptest.NewRecorder()
+			ctx := context.Background()
+
+			ep, clientsSets, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, nil, nil, tc.ExistingObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			for _, gkObject := range tc.ExistingGatekeeperObjects {
+				err = clientsSets.FakeClient.Create(ctx, gkObject.DeepCopyObject().(ctrlruntimeclient.Object))
+				if err != nil {
+					t.Fatalf("failed to create gk object %v: %v", gkObject, err)
+				}
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			if res.Code != http.StatusOK {
+				return
+			}
+
+			result := &apiv2.ClusterViolations{}
+			if err := json.Unmarshal(res.Body.Bytes(), result); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if result.TotalViolations != tc.ExpectedTotalViolations {
+				t.Errorf("Expected %d violations in total, got %d", tc.ExpectedTotalViolations, result.TotalViolations)
+			}
+			if len(result.Kinds) != len(tc.ExpectedKinds) {
+				t.Fatalf("Expected %d constraint kinds, got %d: %s", len(tc.ExpectedKinds), len(result.Kinds), res.Body.String())
+			}
+			for i, expectedKind := range tc.ExpectedKinds {
+				kind := result.Kinds[i]
+				if kind.Kind != expectedKind.kind || kind.TotalViolations != expectedKind.totalViolations {
+					t.Errorf("Expected kind %s with %d violations, got kind %s with %d violations", expectedKind.kind, expectedKind.totalViolations, kind.Kind, kind.TotalViolations)
+				}
+				if len(kind.Constraints) != len(expectedKind.constraints) {
+					t.Fatalf("Expected %d constraints of kind %s, got %d", len(expectedKind.constraints), expectedKind.kind, len(kind.Constraints))
+				}
+				for j, expected := range expectedKind.constraints {
+					actual := kind.Constraints[j]
+					if actual.Name != expected.name || actual.TotalViolations != expected.totalViolations || len(actual.Violations) != expected.violations || actual.Truncated != expected.truncated {
+						t.Errorf("Expected constraint %s with %d violations (%d listed, truncated %v), got constraint %s with %d violations (%d listed, truncated %v)",
+							expected.name, expected.totalViolations, expected.violations, expected.truncated,
+							actual.Name, actual.TotalViolations, len(actual.Violations), actual.Truncated)
+					}
+				}
+			}
+		})
+	}
+}
+
+// genGatekeeperConstraintWithViolations generates a gatekeeper constraint whose audit found the given number of
+// namespaces without the required labels.
+func genGatekeeperConstraintWithViolations(name, kind string, violations int, t *testing.T) *unstructured.Unstructured {
+	ct := genGatekeeperConstraint(name, kind, t)
+
+	auditResults := make([]interface{}, 0, violations)
+	for i := range violations {
+		auditResults = append(auditResults, map[string]interface{}{
+			"enforcementAction": "deny",
+			"kind":              "Namespace",
+			"message":           "'you must provide labels: {\"gatekeeper\"}'",
+			"name":              fmt.Sprintf("namespace-%d", i),
+		})
+	}
+	if err := unstructured.SetNestedSlice(ct.Object, auditResults, "status", "violations"); err != nil {
+		t.Fatalf("error setting constraint violations field: %v", err)
+	}
+	if err := unstructured.SetNestedField(ct.Object, int64(violations), "status", "totalViolations"); err != nil {
+		t.Fatalf("error setting constraint total violations field: %v", err)
+	}
+
+	return ct
+}
+
 func unmarshallToJSONMap(object interface{}) (map[string]interface{}, error) {
 	raw, err := json.Marshal(object)
 	if err != nil {
@@ -396,7 +609,7 @@ func TestDeleteConstraints(t *testing.T) {
 			ConstraintName:   "ct1",
 			ProjectID:        test.GenDefaultProject().Name,
 			ClusterID:        test.GenDefaultCluster().Name,
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			HTTPStatus:       http.StatusForbidden,
 			ExistingObjects: test.GenDefaultKubermaticObjects(
 				test.GenTestSeed(),
@@ -480,7 +693,7 @@ func TestCreateConstraints(t *testing.T) {
 			},
 			ProjectID:        test.GenDefaultProject().Name,
 			ClusterID:        test.GenDefaultCluster().Name,
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			HTTPStatus:       http.StatusForbidden,
 			ExistingObjects: test.GenDefaultKubermaticObjects(
 				test.GenTestSeed(),
@@ -638,7 +851,7 @@ func TestPatchConstraints(t *testing.T) {
 			ProjectID:        test.GenDefaultProject().Name,
 			ClusterID:        test.GenDefaultCluster().Name,
 			Patch:            `{"spec":{"constraintType":"RequiredLabel","parameters":{"labels":["test"]},"match":{"kinds":[{"kinds":["pods"], "apiGroups":["v1"]}, {"kinds":["namespaces"]}]}}}`,
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			HTTPStatus:       http.StatusForbidden,
 			ExistingObjects: test.GenDefaultKubermaticObjects(
 				test.GenTestSeed(),
@@ -0,0 +1,430 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacenterhealth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/handler/v1/dc"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/util/email"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CapacitiesAnnotation holds the capacity hints of the datacenters of a seed as JSON, keyed by the datacenter name.
+const CapacitiesAnnotation = "kubermatic.io/datacenter-capacities"
+
+// seedCheckTimeout limits the health check of a seed, so that an unreachable seed doesn't block the wizard.
+const seedCheckTimeout = 5 * time.Second
+
+// clusterPageSize is the number of clusters fetched per request when the clusters of a seed are counted.
+const clusterPageSize = 500
+
+// seedHealth is the result of the health check of a seed, which is shared by all its datacenters.
+type seedHealth struct {
+	connectivity string
+	clusters     map[string]int
+	err          error
+}
+
+// visibleDatacenter is a datacenter the user is allowed to see.
+type visibleDatacenter struct {
+	seed     *kubermaticv1.Seed
+	name     string
+	provider string
+}
+
+// GetEndpoint returns the health of the datacenter.
+func GetEndpoint(userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, breaker *handlercommon.SeedCircuitBreaker) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(datacenterHealthReq)
+
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		datacenters, err := getVisibleDatacenters(userInfo, seedsGetter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, datacenter := range datacenters {
+			if datacenter.name != req.DC || datacenter.provider != req.Provider {
+				continue
+			}
+
+			health := checkSeed(ctx, datacenter.seed, clusterProviderGetter, breaker)
+			return convertToAPI(datacenter, health, userInfo.IsAdmin)
+		}
+
+		return nil, utilerrors.NewNotFound("Datacenter", req.DC)
+	}
+}
+
+// ListEndpoint returns the health of all datacenters which are visible to the user. Every seed is checked once,
+// concurrently, so that the response time is bound by the slowest seed.
+func ListEndpoint(userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, breaker *handlercommon.SeedCircuitBreaker) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		datacenters, err := getVisibleDatacenters(userInfo, seedsGetter)
+		if err != nil {
+			return nil, err
+		}
+
+		seeds := map[string]*kubermaticv1.Seed{}
+		for _, datacenter := range datacenters {
+			seeds[datacenter.seed.Name] = datacenter.seed
+		}
+
+		var (
+			lock   sync.Mutex
+			wg     sync.WaitGroup
+			health = make(map[string]seedHealth, len(seeds))
+		)
+		for name, seed := range seeds {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				seedHealth := checkSeed(ctx, seed, clusterProviderGetter, breaker)
+
+				lock.Lock()
+				defer lock.Unlock()
+				health[name] = seedHealth
+			}()
+		}
+		wg.Wait()
+
+		result := make([]*apiv2.DatacenterHealth, 0, len(datacenters))
+		for _, datacenter := range datacenters {
+			datacenterHealth, err := convertToAPI(datacenter, health[datacenter.seed.Name], userInfo.IsAdmin)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, datacenterHealth)
+		}
+
+		return result, nil
+	}
+}
+
+// UpdateCapacityEndpoint replaces the capacity hints of the datacenter. Hints without a maximum number of clusters
+// remove the capacity hints of the datacenter.
+func UpdateCapacityEndpoint(userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, seedProvider provider.SeedProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateDatacenterCapacityReq)
+
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if !userInfo.IsAdmin {
+			return nil, utilerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+		}
+		if req.Body.MaxClusters != nil && *req.Body.MaxClusters < 1 {
+			return nil, utilerrors.NewBadRequest("maxClusters must be at least 1")
+		}
+
+		seeds, err := seedsGetter()
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		seed, ok := seeds[req.Seed]
+		if !ok {
+			return nil, utilerrors.NewNotFound("Seed", req.Seed)
+		}
+		if _, ok := seed.Spec.Datacenters[req.DC]; !ok {
+			return nil, utilerrors.NewNotFound("Datacenter", req.DC)
+		}
+
+		if err := setCapacity(seed, req.DC, &req.Body); err != nil {
+			return nil, err
+		}
+		if _, err := seedProvider.UpdateUnsecured(ctx, seed); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return &req.Body, nil
+	}
+}
+
+// getVisibleDatacenters returns the datacenters of all seeds, sorted by name. Regular users only see the
+// datacenters whose email requirements they match.
+func getVisibleDatacenters(userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter) ([]visibleDatacenter, error) {
+	seeds, err := seedsGetter()
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	var datacenters []visibleDatacenter
+	for _, seed := range seeds {
+		for name, datacenter := range seed.Spec.Datacenters {
+			if !userInfo.IsAdmin {
+				matches, err := email.MatchesRequirements(userInfo.Email, datacenter.Spec.RequiredEmails)
+				if err != nil {
+					return nil, err
+				}
+				if !matches {
+					continue
+				}
+			}
+
+			spec, err := dc.ConvertInternalDCToExternalSpec(datacenter.DeepCopy(), seed.Name)
+			if err != nil {
+				return nil, err
+			}
+			providerName, err := dc.GetProviderName(spec)
+			if err != nil {
+				return nil, err
+			}
+
+			datacenters = append(datacenters, visibleDatacenter{seed: seed, name: name, provider: string(providerName)})
+		}
+	}
+
+	sort.Slice(datacenters, func(i, j int) bool {
+		return datacenters[i].name < datacenters[j].name
+	})
+
+	return datacenters, nil
+}
+
+// checkSeed checks the connectivity of the seed with a cheap API call and counts its clusters per datacenter.
+func checkSeed(ctx context.Context, seed *kubermaticv1.Seed, clusterProviderGetter provider.ClusterProviderGetter, breaker *handlercommon.SeedCircuitBreaker) seedHealth {
+	if seed.Status.Phase == kubermaticv1.SeedInvalidPhase {
+		return seedHealth{connectivity: apiv2.DatacenterInvalid, err: errors.New("the seed is in the invalid phase")}
+	}
+	if allowed, cooldown := breaker.Allow(seed.Name); !allowed {
+		return seedHealth{
+			connectivity: apiv2.DatacenterUnreachable,
+			err:          fmt.Errorf("the seed is skipped after repeated connection failures, retrying in %s", cooldown.Round(time.Second)),
+		}
+	}
+
+	clusterProvider, err := clusterProviderGetter(seed)
+	if err != nil {
+		breaker.RecordFailure(seed.Name, err)
+		return seedHealth{connectivity: apiv2.DatacenterUnreachable, err: err}
+	}
+	privilegedClusterProvider, ok := clusterProvider.(provider.PrivilegedClusterProvider)
+	if !ok {
+		return seedHealth{connectivity: apiv2.DatacenterUnreachable, err: errors.New("failed to assert the cluster provider")}
+	}
+	client := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
+
+	ctx, cancel := context.WithTimeout(ctx, seedCheckTimeout)
+	defer cancel()
+
+	// fetching a single cluster is enough to know whether the seed answers, the clusters are only counted afterwards
+	if err := client.List(ctx, &kubermaticv1.ClusterList{}, ctrlruntimeclient.Limit(1)); err != nil {
+		breaker.RecordFailure(seed.Name, err)
+		return seedHealth{connectivity: apiv2.DatacenterUnreachable, err: err}
+	}
+	clusters, err := countClusters(ctx, client)
+	if err != nil {
+		breaker.RecordFailure(seed.Name, err)
+		return seedHealth{connectivity: apiv2.DatacenterUnreachable, err: err}
+	}
+	breaker.RecordSuccess(seed.Name)
+
+	return seedHealth{connectivity: apiv2.DatacenterConnected, clusters: clusters}
+}
+
+// countClusters returns the number of clusters per datacenter. The clusters are listed in pages, so that seeds
+// with many clusters don't have to return all of them in a single response.
+func countClusters(ctx context.Context, client ctrlruntimeclient.Client) (map[string]int, error) {
+	counts := map[string]int{}
+	listOpts := []ctrlruntimeclient.ListOption{ctrlruntimeclient.Limit(clusterPageSize)}
+	for {
+		clusters := &kubermaticv1.ClusterList{}
+		if err := client.List(ctx, clusters, listOpts...); err != nil {
+			return nil, fmt.Errorf("failed to list clusters: %w", err)
+		}
+		for _, cluster := range clusters.Items {
+			counts[cluster.Spec.Cloud.DatacenterName]++
+		}
+		if clusters.Continue == "" {
+			return counts, nil
+		}
+		listOpts = []ctrlruntimeclient.ListOption{ctrlruntimeclient.Limit(clusterPageSize), ctrlruntimeclient.Continue(clusters.Continue)}
+	}
+}
+
+func convertToAPI(datacenter visibleDatacenter, health seedHealth, isAdmin bool) (*apiv2.DatacenterHealth, error) {
+	capacity, err := getCapacity(datacenter.seed, datacenter.name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &apiv2.DatacenterHealth{
+		Datacenter:   datacenter.name,
+		Provider:     datacenter.provider,
+		Seed:         datacenter.seed.Name,
+		Connectivity: health.connectivity,
+	}
+	if capacity != nil {
+		result.MaxClusters = capacity.MaxClusters
+	}
+	if health.connectivity == apiv2.DatacenterConnected {
+		clusters := health.clusters[datacenter.name]
+		result.Clusters = &clusters
+		result.Full = result.MaxClusters != nil && clusters >= *result.MaxClusters
+	}
+	// the errors can contain internal addresses of the seeds, which must not be shown to regular users
+	if isAdmin && health.err != nil {
+		result.Error = health.err.Error()
+	}
+
+	return result, nil
+}
+
+func getCapacities(seed *kubermaticv1.Seed) (map[string]apiv2.DatacenterCapacity, error) {
+	capacities := map[string]apiv2.DatacenterCapacity{}
+
+	value, ok := seed.Annotations[CapacitiesAnnotation]
+	if !ok || value == "" {
+		return capacities, nil
+	}
+	if err := json.Unmarshal([]byte(value), &capacities); err != nil {
+		return nil, fmt.Errorf("failed to parse datacenter capacities of seed %s: %w", seed.Name, err)
+	}
+
+	return capacities, nil
+}
+
+// getCapacity returns the capacity hints of the datacenter or nil if the datacenter has none.
+func getCapacity(seed *kubermaticv1.Seed, datacenter string) (*apiv2.DatacenterCapacity, error) {
+	capacities, err := getCapacities(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity, ok := capacities[datacenter]
+	if !ok {
+		return nil, nil
+	}
+
+	return &capacity, nil
+}
+
+// setCapacity stores the capacity hints of the datacenter in the seed. Hints without a maximum number of
+// clusters remove the entry of the datacenter.
+func setCapacity(seed *kubermaticv1.Seed, datacenter string, capacity *apiv2.DatacenterCapacity) error {
+	capacities, err := getCapacities(seed)
+	if err != nil {
+		return err
+	}
+
+	if capacity == nil || capacity.MaxClusters == nil {
+		delete(capacities, datacenter)
+	} else {
+		capacities[datacenter] = *capacity
+	}
+
+	if len(capacities) == 0 {
+		delete(seed.Annotations, CapacitiesAnnotation)
+		return nil
+	}
+
+	value, err := json.Marshal(capacities)
+	if err != nil {
+		return fmt.Errorf("failed to marshal datacenter capacities: %w", err)
+	}
+	if seed.Annotations == nil {
+		seed.Annotations = map[string]string{}
+	}
+	seed.Annotations[CapacitiesAnnotation] = string(value)
+
+	return nil
+}
+
+// datacenterHealthReq defines HTTP request for getDatacenterHealth
+// swagger:parameters getDatacenterHealth
+type datacenterHealthReq struct {
+	// in: path
+	// required: true
+	Provider string `json:"provider_name"`
+	// in: path
+	// required: true
+	DC string `json:"dc"`
+}
+
+func DecodeDatacenterHealthReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req datacenterHealthReq
+
+	req.Provider = mux.Vars(r)["provider_name"]
+	if req.Provider == "" {
+		return nil, utilerrors.NewBadRequest("'provider_name' parameter is required but was not provided")
+	}
+	req.DC = mux.Vars(r)["dc"]
+	if req.DC == "" {
+		return nil, utilerrors.NewBadRequest("'dc' parameter is required but was not provided")
+	}
+
+	return req, nil
+}
+
+// updateDatacenterCapacityReq defines HTTP request for updateDatacenterCapacity
+// swagger:parameters updateDatacenterCapacity
+type updateDatacenterCapacityReq struct {
+	// in: path
+	// required: true
+	Seed string `json:"seed_name"`
+	// in: path
+	// required: true
+	DC string `json:"dc"`
+	// in: body
+	// required: true
+	Body apiv2.DatacenterCapacity
+}
+
+func DecodeUpdateDatacenterCapacityReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req updateDatacenterCapacityReq
+
+	req.Seed = mux.Vars(r)["seed_name"]
+	if req.Seed == "" {
+		return nil, utilerrors.NewBadRequest("'seed_name' parameter is required but was not provided")
+	}
+	req.DC = mux.Vars(r)["dc"]
+	if req.DC == "" {
+		return nil, utilerrors.NewBadRequest("'dc' parameter is required but was not provided")
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse body: %v", err)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacenterhealth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	datacenterhealth "k8c.io/dashboard/v2/pkg/handler/v2/datacenter_health"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// genUnreachableSeed returns a seed without a cluster provider in the test routing, so that it can't be reached.
+func genUnreachableSeed() *kubermaticv1.Seed {
+	return test.GenTestSeed(func(seed *kubermaticv1.Seed) {
+		seed.Name = "europe-west3"
+		seed.Spec.Datacenters = map[string]kubermaticv1.Datacenter{
+			"eu-fake-dc": {
+				Spec: kubermaticv1.DatacenterSpec{
+					Fake: &kubermaticv1.DatacenterSpecFake{},
+				},
+			},
+		}
+	})
+}
+
+func TestDatacenterHealthEndpoints(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		Name             string
+		Method           string
+		Path             string
+		Body             string
+		ExistingAPIUser  *apiv1.User
+		HTTPStatus       int
+		ExpectedResponse string
+		ExpectedCapacity string
+	}{
+		{
+			Name:             "scenario 1: a regular user gets the health of a full datacenter",
+			Method:           http.MethodGet,
+			Path:             "/api/v2/providers/digitalocean/dc/private-do1/health",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"datacenter":"private-do1","provider":"digitalocean","seed":"us-central1","connectivity":"connected","clusters":1,"maxClusters":1,"full":true}`,
+			ExpectedCapacity: `{"private-do1":{"maxClusters":1}}`,
+		},
+		{
+			Name:             "scenario 2: the provider has to match the datacenter",
+			Method:           http.MethodGet,
+			Path:             "/api/v2/providers/aws/dc/private-do1/health",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusNotFound,
+			ExpectedResponse: `{"error":{"code":404,"message":"Datacenter \"private-do1\" not found"}}`,
+			ExpectedCapacity: `{"private-do1":{"maxClusters":1}}`,
+		},
+		{
+			Name:             "scenario 3: regular users can not get the health of datacenters restricted to other emails",
+			Method:           http.MethodGet,
+			Path:             "/api/v2/providers/fake/dc/restricted-fake-dc/health",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusNotFound,
+			ExpectedResponse: `{"error":{"code":404,"message":"Datacenter \"restricted-fake-dc\" not found"}}`,
+			ExpectedCapacity: `{"private-do1":{"maxClusters":1}}`,
+		},
+		{
+			Name:             "scenario 4: the error of an unreachable seed is redacted for regular users",
+			Method:           http.MethodGet,
+			Path:             "/api/v2/providers/fake/dc/eu-fake-dc/health",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"datacenter":"eu-fake-dc","provider":"fake","seed":"europe-west3","connectivity":"unreachable","full":false}`,
+			ExpectedCapacity: `{"private-do1":{"maxClusters":1}}`,
+		},
+		{
+			Name:             "scenario 5: admins get the error of an unreachable seed",
+			Method:           http.MethodGet,
+			Path:             "/api/v2/providers/fake/dc/eu-fake-dc/health",
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"datacenter":"eu-fake-dc","provider":"fake","seed":"europe-west3","connectivity":"unreachable","full":false,"error":"can not find clusterprovider for cluster \"europe-west3\""}`,
+			ExpectedCapacity: `{"private-do1":{"maxClusters":1}}`,
+		},
+		{
+			Name:            "scenario 6: a regular user lists the health of all visible datacenters",
+			Method:          http.MethodGet,
+			Path:            "/api/v2/dc/health",
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			HTTPStatus:      http.StatusOK,
+			ExpectedResponse: `[` +
+				`{"datacenter":"KubevirtDC","provider":"kubevirt","seed":"us-central1","connectivity":"connected","clusters":0,"full":false},` +
+				`{"datacenter":"audited-dc","provider":"fake","seed":"us-central1","connectivity":"connected","clusters":0,"full":false},` +
+				`{"datacenter":"eu-fake-dc","provider":"fake","seed":"europe-west3","connectivity":"unreachable","full":false},` +
+				`{"datacenter":"fake-dc","provider":"fake","seed":"us-central1","connectivity":"connected","clusters":0,"full":false},` +
+				`{"datacenter":"node-dc","provider":"fake","seed":"us-central1","connectivity":"connected","clusters":0,"full":false},` +
+				`{"datacenter":"private-do1","provider":"digitalocean","seed":"us-central1","connectivity":"connected","clusters":1,"maxClusters":1,"full":true},` +
+				`{"datacenter":"psp-dc","provider":"fake","seed":"us-central1","connectivity":"connected","clusters":0,"full":false},` +
+				`{"datacenter":"regular-do1","provider":"digitalocean","seed":"us-central1","connectivity":"connected","clusters":0,"full":false}` +
+				`]`,
+			ExpectedCapacity: `{"private-do1":{"maxClusters":1}}`,
+		},
+		{
+			Name:             "scenario 7: the admin sets the capacity of a datacenter",
+			Method:           http.MethodPut,
+			Path:             "/api/v2/admin/seeds/us-central1/datacenters/regular-do1/capacity",
+			Body:             `{"maxClusters":10}`,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"maxClusters":10}`,
+			ExpectedCapacity: `{"private-do1":{"maxClusters":1},"regular-do1":{"maxClusters":10}}`,
+		},
+		{
+			Name:             "scenario 8: the admin removes the capacity of a datacenter",
+			Method:           http.MethodPut,
+			Path:             "/api/v2/admin/seeds/us-central1/datacenters/private-do1/capacity",
+			Body:             `{}`,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{}`,
+		},
+		{
+			Name:             "scenario 9: the maximum number of clusters must be positive",
+			Method:           http.MethodPut,
+			Path:             "/api/v2/admin/seeds/us-central1/datacenters/private-do1/capacity",
+			Body:             `{"maxClusters":0}`,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"maxClusters must be at least 1"}}`,
+			ExpectedCapacity: `{"private-do1":{"maxClusters":1}}`,
+		},
+		{
+			Name:             "scenario 10: regular users can not set the capacity of datacenters",
+			Method:           http.MethodPut,
+			Path:             "/api/v2/admin/seeds/us-central1/datacenters/private-do1/capacity",
+			Body:             `{"maxClusters":10}`,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"bob@acme.com\" doesn't have admin rights"}}`,
+			ExpectedCapacity: `{"private-do1":{"maxClusters":1}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			seed := test.GenTestSeed(func(seed *kubermaticv1.Seed) {
+				seed.Annotations = map[string]string{datacenterhealth.CapacitiesAnnotation: `{"private-do1":{"maxClusters":1}}`}
+			})
+			kubermaticObjs := test.GenDefaultKubermaticObjects(
+				seed,
+				genUnreachableSeed(),
+				test.GenDefaultCluster(),
+				test.GenAdminUser("John", "john@acme.com", true),
+			)
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, nil, nil, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(tc.Method, tc.Path, strings.NewReader(tc.Body)))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+
+			seed = &kubermaticv1.Seed{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(test.GenTestSeed()), seed); err != nil {
+				t.Fatalf("failed to get seed: %v", err)
+			}
+			if capacity := seed.Annotations[datacenterhealth.CapacitiesAnnotation]; capacity != tc.ExpectedCapacity {
+				t.Fatalf("Expected datacenter capacities %q, got %q", tc.ExpectedCapacity, capacity)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultnodespec
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// GetEndpoint returns the node spec which is suggested for the machine deployments of the datacenter. The node spec
+// defaults of the datacenter take precedence over the built-in defaults of its provider.
+func GetEndpoint(userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(defaultNodeSpecReq)
+
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		seed, _, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, req.DC)
+		if err != nil {
+			return nil, err
+		}
+
+		spec, err := machine.DefaultNodeSpec(seed, req.DC)
+		if err != nil {
+			if errors.Is(err, machine.ErrNoDefaultNodeSpec) {
+				return nil, utilerrors.NewBadRequest("%v", err)
+			}
+			return nil, err
+		}
+
+		return spec, nil
+	}
+}
+
+// defaultNodeSpecReq defines HTTP request for getDefaultNodeSpec
+// swagger:parameters getDefaultNodeSpec
+type defaultNodeSpecReq struct {
+	// in: path
+	// required: true
+	DC string `json:"dc"`
+}
+
+func DecodeDefaultNodeSpecReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req defaultNodeSpecReq
+
+	req.DC = mux.Vars(r)["dc"]
+	if req.DC == "" {
+		return nil, utilerrors.NewBadRequest("'dc' parameter is required but was not provided")
+	}
+
+	return req, nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultnodespec_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
+)
+
+func TestGetDefaultNodeSpecEndpoint(t *testing.T) {
+	t.Parallel()
+
+	const digitaloceanSpec = `{"cloud":{"digitalocean":{"size":"%s","backups":false,"ipv6":false,"monitoring":false,"tags":null}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"versions":{"kubelet":""}}`
+
+	testcases := []struct {
+		Name             string
+		DC               string
+		Defaults         *apiv1.NodeSpecDefaults
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: the built-in defaults of the provider are used",
+			DC:               "regular-do1",
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: fmt.Sprintf(digitaloceanSpec, "s-2vcpu-4gb"),
+		},
+		{
+			Name:             "scenario 2: the defaults configured by the admins take precedence",
+			DC:               "regular-do1",
+			Defaults:         &apiv1.NodeSpecDefaults{InstanceType: "s-4vcpu-8gb"},
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: fmt.Sprintf(digitaloceanSpec, "s-4vcpu-8gb"),
+		},
+		{
+			Name:             "scenario 3: providers without defaults are rejected",
+			DC:               "fake-dc",
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"no default node spec is available for provider \"fake\" of datacenter \"fake-dc\""}}`,
+		},
+		{
+			Name:             "scenario 4: datacenters restricted to other email domains are rejected",
+			DC:               "restricted-fake-dc",
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"cannot access restricted-fake-dc datacenter due to email requirements"}}`,
+		},
+		{
+			Name:             "scenario 5: unknown datacenters are rejected",
+			DC:               "unknown",
+			HTTPStatus:       http.StatusNotFound,
+			ExpectedResponse: `{"error":{"code":404,"message":"datacenter \"unknown\" not found"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			seed := test.GenTestSeed()
+			if err := machine.SetNodeSpecDefaults(seed, tc.DC, tc.Defaults); err != nil {
+				t.Fatalf("failed to set node spec defaults: %v", err)
+			}
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), nil, test.GenDefaultKubermaticObjects(seed), nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/dc/%s/default-node-spec", tc.DC), nil))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
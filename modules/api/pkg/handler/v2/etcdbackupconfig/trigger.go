@@ -0,0 +1,257 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdbackupconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/handler/v2/cluster"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+const (
+	manualBackupPrefix = "manual"
+	// manualBackupLabelKey marks the etcd backup configs created for manual backups.
+	manualBackupLabelKey = "kubermatic.io/manual-etcd-backup"
+	// manualBackupsKept is the number of completed manual backups kept per cluster. One-shot backups are only
+	// deleted together with their etcd backup config, so older configs are removed when a new backup is triggered.
+	manualBackupsKept = kubermaticv1.DefaultKeptBackupsCount
+)
+
+// TriggerEndpoint creates a one-shot etcd backup config, which takes a backup of the cluster right away.
+func TriggerEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider, settingsProvider provider.SettingsProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(triggerEtcdBackupReq)
+
+		if err := IsEtcdBackupEnabled(ctx, settingsProvider); err != nil {
+			return nil, err
+		}
+
+		c, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		expireManualBackups(ctx, userInfoGetter, c, req.ProjectID)
+
+		// without a schedule, the backup is taken exactly once
+		name := fmt.Sprintf("%s-%s-%s", manualBackupPrefix, time.Now().UTC().Format("20060102150405"), rand.String(5))
+		ebc, err := convertAPIToInternalEtcdBackupConfig(name, &apiv2.EtcdBackupConfigSpec{Destination: req.Body.Destination}, c)
+		if err != nil {
+			return nil, err
+		}
+
+		// set projectID label
+		ebc.Labels = map[string]string{
+			kubermaticv1.ProjectIDLabelKey: req.ProjectID,
+			manualBackupLabelKey:           "true",
+		}
+
+		ebc, err = createEtcdBackupConfig(ctx, userInfoGetter, req.ProjectID, ebc)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return convertInternalToAPIEtcdBackupStatus(ebc), nil
+	}
+}
+
+// expireManualBackups deletes the etcd backup configs of failed manual backups and of completed manual backups
+// beyond the newest manualBackupsKept ones. Failures are only logged, as they must not prevent a new backup.
+func expireManualBackups(ctx context.Context, userInfoGetter provider.UserInfoGetter, c *kubermaticv1.Cluster, projectID string) {
+	ebcList, err := listEtcdBackupConfig(ctx, userInfoGetter, c, projectID)
+	if err != nil {
+		kubermaticlog.Logger.Warnw("failed to list etcd backup configs", "cluster", c.Name, zap.Error(err))
+		return
+	}
+
+	var expired, completed []*kubermaticv1.EtcdBackupConfig
+	for i := range ebcList.Items {
+		ebc := &ebcList.Items[i]
+		if ebc.Labels[manualBackupLabelKey] != "true" || ebc.DeletionTimestamp != nil {
+			continue
+		}
+
+		latest := latestBackup(ebc)
+		if latest == nil {
+			continue
+		}
+		switch latest.BackupPhase {
+		case kubermaticv1.BackupStatusPhaseFailed:
+			expired = append(expired, ebc)
+		case kubermaticv1.BackupStatusPhaseCompleted:
+			completed = append(completed, ebc)
+		}
+	}
+
+	if len(completed) > manualBackupsKept {
+		sort.Slice(completed, func(i, j int) bool {
+			return latestBackup(completed[j]).ScheduledTime.Before(&latestBackup(completed[i]).ScheduledTime)
+		})
+		expired = append(expired, completed[manualBackupsKept:]...)
+	}
+
+	for _, ebc := range expired {
+		if err := deleteEtcdBackupConfig(ctx, userInfoGetter, c, projectID, ebc.Name); err != nil {
+			kubermaticlog.Logger.Warnw("failed to delete expired etcd backup config", "cluster", c.Name, "etcdBackupConfig", ebc.Name, zap.Error(err))
+		}
+	}
+}
+
+// triggerEtcdBackupReq represents a request for taking an etcd backup of a cluster right away
+// swagger:parameters triggerEtcdBackup
+type triggerEtcdBackupReq struct {
+	cluster.GetClusterReq
+	// in: body
+	// required: true
+	Body apiv2.EtcdBackupTrigger
+}
+
+func (r *triggerEtcdBackupReq) validate() error {
+	if r.Body.Destination == "" {
+		return utilerrors.NewBadRequest("the backup destination is required")
+	}
+	return nil
+}
+
+func DecodeTriggerEtcdBackupReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req triggerEtcdBackupReq
+	cr, err := cluster.DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.GetClusterReq = cr.(cluster.GetClusterReq)
+
+	if err = json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse the request body: %v", err)
+	}
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// GetStatusEndpoint returns the progress of the latest backup of an etcd backup config.
+func GetStatusEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider, settingsProvider provider.SettingsProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getEtcdBackupStatusReq)
+
+		if err := IsEtcdBackupEnabled(ctx, settingsProvider); err != nil {
+			return nil, err
+		}
+
+		c, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		ebc, err := getEtcdBackupConfig(ctx, userInfoGetter, c, req.ProjectID, decodeEtcdBackupConfigID(req.EtcdBackupConfigID, req.ClusterID))
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return convertInternalToAPIEtcdBackupStatus(ebc), nil
+	}
+}
+
+// getEtcdBackupStatusReq represents a request for getting the progress of an etcd backup
+// swagger:parameters getEtcdBackupStatus
+type getEtcdBackupStatusReq struct {
+	cluster.GetClusterReq
+	// in: path
+	// required: true
+	EtcdBackupConfigID string `json:"ebc_id"`
+}
+
+func DecodeGetEtcdBackupStatusReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req getEtcdBackupStatusReq
+	cr, err := cluster.DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.GetClusterReq = cr.(cluster.GetClusterReq)
+
+	req.EtcdBackupConfigID = mux.Vars(r)["ebc_id"]
+	if req.EtcdBackupConfigID == "" {
+		return "", fmt.Errorf("'ebc_id' parameter is required but was not provided")
+	}
+
+	return req, nil
+}
+
+// convertInternalToAPIEtcdBackupStatus converts the latest backup of the etcd backup config. The backup is pending
+// until the controller has scheduled its job.
+func convertInternalToAPIEtcdBackupStatus(ebc *kubermaticv1.EtcdBackupConfig) *apiv2.EtcdBackupStatus {
+	status := &apiv2.EtcdBackupStatus{
+		ID:    GenEtcdBackupConfigID(ebc.Name, ebc.Spec.Cluster.Name),
+		Phase: apiv2.EtcdBackupPendingPhase,
+	}
+
+	latest := latestBackup(ebc)
+	if latest == nil {
+		return status
+	}
+
+	if latest.BackupPhase != "" {
+		status.Phase = latest.BackupPhase
+	}
+	if !latest.BackupStartTime.IsZero() {
+		startTime := apiv1.NewTime(latest.BackupStartTime.Time)
+		status.StartTime = &startTime
+	}
+	if !latest.BackupFinishedTime.IsZero() {
+		completionTime := apiv1.NewTime(latest.BackupFinishedTime.Time)
+		status.CompletionTime = &completionTime
+	}
+	if latest.BackupPhase == kubermaticv1.BackupStatusPhaseFailed {
+		status.ErrorMessage = latest.BackupMessage
+	}
+
+	return status
+}
+
+// latestBackup returns the most recently scheduled backup of the etcd backup config, if any.
+func latestBackup(ebc *kubermaticv1.EtcdBackupConfig) *kubermaticv1.BackupStatus {
+	var latest *kubermaticv1.BackupStatus
+	for i, backup := range ebc.Status.CurrentBackups {
+		if latest == nil || latest.ScheduledTime.Before(&backup.ScheduledTime) {
+			latest = &ebc.Status.CurrentBackups[i]
+		}
+	}
+	return latest
+}
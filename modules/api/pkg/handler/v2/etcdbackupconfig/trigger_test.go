@@ -0,0 +1,299 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdbackupconfig_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/handler/v2/etcdbackupconfig"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestTriggerEndpoint(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name                      string
+		Body                      string
+		ExistingKubermaticObjects []ctrlruntimeclient.Object
+		ExistingAPIUser           *apiv1.User
+		ExpectedHTTPStatusCode    int
+	}{
+		{
+			Name: "trigger an etcd backup of the given cluster",
+			Body: `{"destination":"s3"}`,
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenDefaultSettings(),
+			),
+			ExistingAPIUser:        test.GenDefaultAPIUser(),
+			ExpectedHTTPStatusCode: http.StatusCreated,
+		},
+		{
+			Name: "the destination is required",
+			Body: `{}`,
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenDefaultSettings(),
+			),
+			ExistingAPIUser:        test.GenDefaultAPIUser(),
+			ExpectedHTTPStatusCode: http.StatusBadRequest,
+		},
+		{
+			Name: "user john cannot trigger an etcd backup of bob's cluster",
+			Body: `{"destination":"s3"}`,
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAdminUser("John", "john@acme.com", false),
+				test.GenDefaultSettings(),
+			),
+			ExistingAPIUser:        test.GenAPIUser("John", "john@acme.com"),
+			ExpectedHTTPStatusCode: http.StatusForbidden,
+		},
+		{
+			Name: "admin user john can trigger an etcd backup of bob's cluster",
+			Body: `{"destination":"s3"}`,
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAdminUser("John", "john@acme.com", true),
+				test.GenDefaultSettings(),
+			),
+			ExistingAPIUser:        test.GenAPIUser("John", "john@acme.com"),
+			ExpectedHTTPStatusCode: http.StatusCreated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			requestURL := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/etcdbackups/trigger", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+			req := httptest.NewRequest(http.MethodPost, requestURL, strings.NewReader(tc.Body))
+			resp := httptest.NewRecorder()
+
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, nil, nil, tc.ExistingKubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+			ep.ServeHTTP(resp, req)
+
+			if resp.Code != tc.ExpectedHTTPStatusCode {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.ExpectedHTTPStatusCode, resp.Code, resp.Body.String())
+			}
+			if resp.Code != http.StatusCreated {
+				return
+			}
+
+			status := &apiv2.EtcdBackupStatus{}
+			if err := json.Unmarshal(resp.Body.Bytes(), status); err != nil {
+				t.Fatalf("failed unmarshalling response %v", err)
+			}
+			if status.Phase != apiv2.EtcdBackupPendingPhase {
+				t.Fatalf("expected the backup to be pending, got %q", status.Phase)
+			}
+
+			ebcList := &kubermaticv1.EtcdBackupConfigList{}
+			if err := clients.FakeClient.List(context.Background(), ebcList); err != nil {
+				t.Fatalf("failed to list etcd backup configs: %v", err)
+			}
+			if len(ebcList.Items) != 1 {
+				t.Fatalf("expected exactly one etcd backup config, got %d", len(ebcList.Items))
+			}
+			ebc := ebcList.Items[0]
+			if status.ID != etcdbackupconfig.GenEtcdBackupConfigID(ebc.Name, test.GenDefaultCluster().Name) {
+				t.Fatalf("expected the ID of etcd backup config %q, got %q", ebc.Name, status.ID)
+			}
+			if ebc.Spec.Schedule != "" || ebc.Spec.Destination != "s3" {
+				t.Fatalf("expected a one-shot backup to destination s3, got schedule %q and destination %q", ebc.Spec.Schedule, ebc.Spec.Destination)
+			}
+		})
+	}
+}
+
+func TestTriggerEndpointExpiresManualBackups(t *testing.T) {
+	t.Parallel()
+
+	scheduledTime := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	genManualEBC := func(name string, phase kubermaticv1.BackupStatusPhase, scheduled time.Time) *kubermaticv1.EtcdBackupConfig {
+		ebc := test.GenEtcdBackupConfig(name, test.GenDefaultCluster(), test.GenDefaultProject().Name)
+		ebc.Labels["kubermatic.io/manual-etcd-backup"] = "true"
+		ebc.Spec.Schedule = ""
+		ebc.Status.CurrentBackups = []kubermaticv1.BackupStatus{{
+			ScheduledTime: metav1.NewTime(scheduled),
+			BackupPhase:   phase,
+		}}
+		return ebc
+	}
+
+	kubermaticObjects := test.GenDefaultKubermaticObjects(
+		test.GenTestSeed(),
+		test.GenDefaultCluster(),
+		test.GenDefaultSettings(),
+		// scheduled backups are never expired
+		test.GenEtcdBackupConfig("scheduled", test.GenDefaultCluster(), test.GenDefaultProject().Name),
+		genManualEBC("manual-failed", kubermaticv1.BackupStatusPhaseFailed, scheduledTime.Add(time.Hour)),
+		genManualEBC("manual-running", kubermaticv1.BackupStatusPhaseRunning, scheduledTime),
+	)
+	for i := 0; i <= kubermaticv1.DefaultKeptBackupsCount; i++ {
+		kubermaticObjects = append(kubermaticObjects, genManualEBC(fmt.Sprintf("manual-completed-%d", i), kubermaticv1.BackupStatusPhaseCompleted, scheduledTime.Add(time.Duration(i)*time.Minute)))
+	}
+
+	ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, nil, kubermaticObjects, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	requestURL := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/etcdbackups/trigger", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+	resp := httptest.NewRecorder()
+	ep.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, requestURL, strings.NewReader(`{"destination":"s3"}`)))
+	if resp.Code != http.StatusCreated {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusCreated, resp.Code, resp.Body.String())
+	}
+
+	ebcList := &kubermaticv1.EtcdBackupConfigList{}
+	if err := clients.FakeClient.List(context.Background(), ebcList); err != nil {
+		t.Fatalf("failed to list etcd backup configs: %v", err)
+	}
+	names := map[string]bool{}
+	for _, ebc := range ebcList.Items {
+		names[ebc.Name] = true
+	}
+
+	// the new backup, the scheduled and the running one and the newest completed backups are kept
+	if expected := kubermaticv1.DefaultKeptBackupsCount + 3; len(names) != expected {
+		t.Fatalf("expected %d etcd backup configs, got %d", expected, len(names))
+	}
+	for _, name := range []string{"manual-failed", "manual-completed-0"} {
+		if names[name] {
+			t.Fatalf("expected etcd backup config %s to be expired", name)
+		}
+	}
+	for _, name := range []string{"scheduled", "manual-running", fmt.Sprintf("manual-completed-%d", kubermaticv1.DefaultKeptBackupsCount)} {
+		if !names[name] {
+			t.Fatalf("expected etcd backup config %s to be kept", name)
+		}
+	}
+}
+
+func TestGetStatusEndpoint(t *testing.T) {
+	t.Parallel()
+
+	scheduledTime := metav1.NewTime(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+	startTime := metav1.NewTime(scheduledTime.Add(time.Minute))
+	finishedTime := metav1.NewTime(scheduledTime.Add(3 * time.Minute))
+
+	genEBC := func(backups ...kubermaticv1.BackupStatus) *kubermaticv1.EtcdBackupConfig {
+		ebc := test.GenEtcdBackupConfig("test-ebc", test.GenDefaultCluster(), test.GenDefaultProject().Name)
+		ebc.Spec.Schedule = ""
+		ebc.Status.CurrentBackups = backups
+		return ebc
+	}
+	ebcID := etcdbackupconfig.GenEtcdBackupConfigID("test-ebc", test.GenDefaultCluster().Name)
+	const (
+		expectedStartTime      = `"startTime":"2025-01-01T10:01:00Z"`
+		expectedCompletionTime = `"completionTime":"2025-01-01T10:03:00Z"`
+	)
+
+	testCases := []struct {
+		Name                   string
+		EtcdBackupConfig       *kubermaticv1.EtcdBackupConfig
+		ExpectedHTTPStatusCode int
+		ExpectedResponse       string
+	}{
+		{
+			Name:                   "a backup whose job has not been scheduled yet is pending",
+			EtcdBackupConfig:       genEBC(),
+			ExpectedHTTPStatusCode: http.StatusOK,
+			ExpectedResponse:       fmt.Sprintf(`{"id":%q,"phase":"Pending"}`, ebcID),
+		},
+		{
+			Name: "a running backup has a start time",
+			EtcdBackupConfig: genEBC(kubermaticv1.BackupStatus{
+				ScheduledTime:   scheduledTime,
+				BackupStartTime: startTime,
+				BackupPhase:     kubermaticv1.BackupStatusPhaseRunning,
+			}),
+			ExpectedHTTPStatusCode: http.StatusOK,
+			ExpectedResponse:       fmt.Sprintf(`{"id":%q,"phase":"Running",%s}`, ebcID, expectedStartTime),
+		},
+		{
+			Name: "a completed backup has a completion time",
+			EtcdBackupConfig: genEBC(kubermaticv1.BackupStatus{
+				ScheduledTime:      scheduledTime,
+				BackupStartTime:    startTime,
+				BackupFinishedTime: finishedTime,
+				BackupPhase:        kubermaticv1.BackupStatusPhaseCompleted,
+				BackupMessage:      "job completed",
+			}),
+			ExpectedHTTPStatusCode: http.StatusOK,
+			ExpectedResponse:       fmt.Sprintf(`{"id":%q,"phase":"Completed",%s,%s}`, ebcID, expectedStartTime, expectedCompletionTime),
+		},
+		{
+			Name: "a failed backup reports the error message of its job",
+			EtcdBackupConfig: genEBC(kubermaticv1.BackupStatus{
+				ScheduledTime:      scheduledTime,
+				BackupStartTime:    startTime,
+				BackupFinishedTime: finishedTime,
+				BackupPhase:        kubermaticv1.BackupStatusPhaseFailed,
+				BackupMessage:      "failed to upload the snapshot",
+			}),
+			ExpectedHTTPStatusCode: http.StatusOK,
+			ExpectedResponse:       fmt.Sprintf(`{"id":%q,"phase":"Failed",%s,%s,"errorMessage":"failed to upload the snapshot"}`, ebcID, expectedStartTime, expectedCompletionTime),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			requestURL := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/etcdbackups/%s/status", test.GenDefaultProject().Name, test.GenDefaultCluster().Name, ebcID)
+			req := httptest.NewRequest(http.MethodGet, requestURL, nil)
+			resp := httptest.NewRecorder()
+
+			kubermaticObjects := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenDefaultSettings(),
+				tc.EtcdBackupConfig,
+			)
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), nil, kubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+			ep.ServeHTTP(resp, req)
+
+			if resp.Code != tc.ExpectedHTTPStatusCode {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.ExpectedHTTPStatusCode, resp.Code, resp.Body.String())
+			}
+
+			test.CompareWithResult(t, resp, tc.ExpectedResponse)
+		})
+	}
+}
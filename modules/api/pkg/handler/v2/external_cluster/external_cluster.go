@@ -62,8 +62,8 @@ var (
 	rxBase64 = regexp.MustCompile(Base64)
 )
 
-// createClusterReq defines HTTP request for createExternalCluster
-// swagger:parameters createExternalCluster
+// createClusterReq defines HTTP request for createExternalCluster and validateExternalCluster
+// swagger:parameters createExternalCluster validateExternalCluster
 type createClusterReq struct {
 	common.ProjectReq
 	// The credential name used in the preset for the provider
@@ -293,6 +293,45 @@ func CreateEndpoint(
 	}
 }
 
+// ValidateEndpoint checks if a cluster can be connected with the kubeconfig of the request, without creating
+// anything. The report contains a passed or failed status for each check.
+func ValidateEndpoint(
+	userInfoGetter provider.UserInfoGetter,
+	projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider,
+	clusterProvider provider.ExternalClusterProvider,
+	settingsProvider provider.SettingsProvider,
+) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if !AreExternalClustersEnabled(ctx, settingsProvider) {
+			return nil, utilerrors.New(http.StatusForbidden, "external cluster functionality is disabled")
+		}
+
+		req := request.(createClusterReq)
+		if err := req.Validate(); err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+
+		if _, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, &provider.ProjectGetOptions{IncludeUninitialized: false}); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		if req.Body.Cloud != nil {
+			return nil, utilerrors.NewBadRequest("only clusters which are connected with a kubeconfig can be validated")
+		}
+		if req.Body.Kubeconfig == "" {
+			return nil, utilerrors.NewBadRequest("kubeconfig is required")
+		}
+
+		config, err := base64.StdEncoding.DecodeString(req.Body.Kubeconfig)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+
+		return clusterProvider.CheckKubeconfig(ctx, config), nil
+	}
+}
+
 func DeleteEndpoint(userInfoGetter provider.UserInfoGetter,
 	projectProvider provider.ProjectProvider,
 	privilegedProjectProvider provider.PrivilegedProjectProvider,
@@ -241,6 +241,7 @@ func getKubeOneAPIMachineDeployment(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	nd.Spec.Template.OperatingSystem.RedactSecrets()
 
 	return &apiv2.ExternalClusterMachineDeployment{NodeDeployment: *nd}, nil
 }
@@ -429,6 +430,7 @@ func getKubeOneAPIMachineDeployments(ctx context.Context,
 		if err != nil {
 			return nil, fmt.Errorf("failed to output machine deployment %s: %w", md.Name, err)
 		}
+		nd.Spec.Template.OperatingSystem.RedactSecrets()
 		nodeDeployments = append(nodeDeployments, apiv2.ExternalClusterMachineDeployment{NodeDeployment: *nd})
 	}
 
@@ -19,6 +19,7 @@ package externalcluster
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -37,6 +38,8 @@ import (
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
@@ -101,10 +104,18 @@ func ListNodesMetricsEndpoint(userInfoGetter provider.UserInfoGetter, projectPro
 		if err := req.Validate(); err != nil {
 			return nil, utilerrors.NewBadRequest("%v", err)
 		}
-		return getClusterNodesMetrics(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, clusterProvider, privilegedClusterProvider, req.ProjectID, req.ClusterID)
+		nodeMetrics, err := getClusterNodesMetrics(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, clusterProvider, privilegedClusterProvider, req.ProjectID, req.ClusterID)
+		if errors.Is(err, errMetricServerUnavailable) {
+			return []apiv1.NodeMetric{}, nil
+		}
+		return nodeMetrics, err
 	}
 }
 
+var errMetricServerUnavailable = errors.New("metrics-server is not available in the cluster")
+
+// getClusterNodesMetrics returns the metrics of all nodes of the external cluster. If the cluster has no
+// metrics-server or it cannot serve the node metrics, errMetricServerUnavailable is returned.
 func getClusterNodesMetrics(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, clusterProvider provider.ExternalClusterProvider, privilegedClusterProvider provider.PrivilegedExternalClusterProvider, projectID, clusterID string) ([]apiv1.NodeMetric, error) {
 	nodeMetrics := make([]apiv1.NodeMetric, 0)
 
@@ -132,36 +143,39 @@ func getClusterNodesMetrics(ctx context.Context, userInfoGetter provider.UserInf
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
+	if !isMetricServer {
+		return nil, errMetricServerUnavailable
+	}
 
-	if isMetricServer {
-		client, err := clusterProvider.GetClient(ctx, masterClient, cluster)
-		if err != nil {
-			return nil, common.KubernetesErrorToHTTPError(err)
-		}
-		nodes := &corev1.NodeList{}
-		if err := client.List(ctx, nodes); err != nil {
-			return nil, err
-		}
-		availableResources := make(map[string]corev1.ResourceList)
-		for _, n := range nodes.Items {
-			availableResources[n.Name] = n.Status.Allocatable
-		}
+	client, err := clusterProvider.GetClient(ctx, masterClient, cluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	nodes := &corev1.NodeList{}
+	if err := client.List(ctx, nodes); err != nil {
+		return nil, err
+	}
+	availableResources := make(map[string]corev1.ResourceList)
+	for _, n := range nodes.Items {
+		availableResources[n.Name] = n.Status.Allocatable
+	}
 
-		nodeDeploymentNodesMetrics := make([]v1beta1.NodeMetrics, 0)
-		allNodeMetricsList := &v1beta1.NodeMetricsList{}
-		if err := client.List(ctx, allNodeMetricsList); err != nil {
-			return nil, common.KubernetesErrorToHTTPError(err)
+	nodeDeploymentNodesMetrics := make([]v1beta1.NodeMetrics, 0)
+	allNodeMetricsList := &v1beta1.NodeMetricsList{}
+	if err := client.List(ctx, allNodeMetricsList); err != nil {
+		// the metrics API can be registered while the metrics-server itself is not running
+		if meta.IsNoMatchError(err) || apierrors.IsServiceUnavailable(err) {
+			return nil, errMetricServerUnavailable
 		}
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
 
-		for _, m := range allNodeMetricsList.Items {
-			if _, ok := availableResources[m.Name]; ok {
-				nodeDeploymentNodesMetrics = append(nodeDeploymentNodesMetrics, m)
-			}
+	for _, m := range allNodeMetricsList.Items {
+		if _, ok := availableResources[m.Name]; ok {
+			nodeDeploymentNodesMetrics = append(nodeDeploymentNodesMetrics, m)
 		}
-		return handlercommon.ConvertNodeMetrics(nodeDeploymentNodesMetrics, availableResources)
 	}
-
-	return nodeMetrics, nil
+	return handlercommon.ConvertNodeMetrics(nodeDeploymentNodesMetrics, availableResources)
 }
 
 // listNodesReq defines HTTP request for listExternalClusterNodes
@@ -447,6 +461,9 @@ func ListMachineDeploymentMetricsEndpoint(userInfoGetter provider.UserInfoGetter
 			return nil, err
 		}
 		allNodeMetrics, err := getClusterNodesMetrics(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, clusterProvider, privilegedClusterProvider, req.ProjectID, req.ClusterID)
+		if errors.Is(err, errMetricServerUnavailable) {
+			return &nodeMetricsResponse{metrics: nodeMetrics, warning: err.Error()}, nil
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -458,10 +475,28 @@ func ListMachineDeploymentMetricsEndpoint(userInfoGetter provider.UserInfoGetter
 			}
 		}
 
-		return nodeMetrics, nil
+		return &nodeMetricsResponse{metrics: nodeMetrics}, nil
 	}
 }
 
+type nodeMetricsResponse struct {
+	metrics []apiv1.NodeMetric
+	warning string
+}
+
+// EncodeNodeMetrics writes the node metrics as JSON. If the metrics could not be read from the cluster, the
+// reason is sent in the Warning header, so that clients can tell it apart from a node pool without nodes.
+func EncodeNodeMetrics(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	rsp := response.(*nodeMetricsResponse)
+
+	w.Header().Set("Content-Type", "application/json")
+	if rsp.warning != "" {
+		w.Header().Set("Warning", fmt.Sprintf("299 - %q", rsp.warning))
+	}
+
+	return json.NewEncoder(w).Encode(rsp.metrics)
+}
+
 func ListMachineDeploymentEventsEndpoint(userInfoGetter provider.UserInfoGetter,
 	projectProvider provider.ProjectProvider,
 	privilegedProjectProvider provider.PrivilegedProjectProvider,
@@ -764,6 +799,7 @@ func apiNodeStatus(status apiv1.NodeStatus, inputNode corev1.Node) apiv1.NodeSta
 	status.NodeInfo.Architecture = inputNode.Status.NodeInfo.Architecture
 	status.NodeInfo.ContainerRuntimeVersion = inputNode.Status.NodeInfo.ContainerRuntimeVersion
 	status.NodeInfo.KernelVersion = inputNode.Status.NodeInfo.KernelVersion
+	status.Unschedulable = inputNode.Spec.Unschedulable
 	return status
 }
 
@@ -17,6 +17,7 @@ limitations under the License.
 package externalcluster_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -26,12 +27,16 @@ import (
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	externalcluster "k8c.io/dashboard/v2/pkg/handler/v2/external_cluster"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 func TestListNodesEndpoint(t *testing.T) {
@@ -78,7 +83,7 @@ func TestListNodesEndpoint(t *testing.T) {
 		},
 		{
 			Name:             "scenario 3: the user John can not get Bob's cluster nodes",
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			HTTPStatus:       http.StatusForbidden,
 			ProjectToSync:    test.GenDefaultProject().Name,
 			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -161,7 +166,7 @@ func TestGetNodeEndpoint(t *testing.T) {
 		},
 		{
 			Name:             "scenario 3: the user John can not get Bob's cluster nodes",
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			HTTPStatus:       http.StatusForbidden,
 			ProjectToSync:    test.GenDefaultProject().Name,
 			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
@@ -274,7 +279,7 @@ func TestGetClusterNodesMetrics(t *testing.T) {
 		// scenario 3
 		{
 			Name:             "scenario 3: the user John can not get Bob's cluster nodes metrics",
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			ClusterToGet:     "clusterAbcID",
 			HTTPStatus:       http.StatusForbidden,
 			ExistingNodes: []*corev1.Node{
@@ -329,3 +334,107 @@ func TestGetClusterNodesMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestListMachineDeploymentMetrics(t *testing.T) {
+	t.Parallel()
+	cpuQuantity, err := resource.ParseQuantity("290")
+	if err != nil {
+		t.Fatal(err)
+	}
+	memoryQuantity, err := resource.ParseQuantity("687202304")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	genKubeOneCluster := func() *kubermaticv1.ExternalCluster {
+		cluster := test.GenExternalCluster(test.GenDefaultProject().Name, "clusterAbcID")
+		cluster.Spec.CloudSpec = kubermaticv1.ExternalClusterCloudSpec{
+			ProviderName: kubermaticv1.ExternalClusterKubeOneProvider,
+			KubeOne:      &kubermaticv1.ExternalClusterKubeOneCloudSpec{ProviderName: "digitalocean"},
+		}
+		cluster.Status.Condition.Phase = kubermaticv1.ExternalClusterPhaseRunning
+		return cluster
+	}
+	genNode := func(name, pool string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{externalcluster.NodeWorkerLabel: pool}},
+			Status:     corev1.NodeStatus{Allocatable: map[corev1.ResourceName]resource.Quantity{"cpu": cpuQuantity, "memory": memoryQuantity}},
+		}
+	}
+	genNodeMetrics := func(name string) *v1beta1.NodeMetrics {
+		return &v1beta1.NodeMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Usage:      map[corev1.ResourceName]resource.Quantity{"cpu": cpuQuantity, "memory": memoryQuantity},
+		}
+	}
+	noMetricServer := interceptor.Funcs{
+		List: func(ctx context.Context, client ctrlruntimeclient.WithWatch, list ctrlruntimeclient.ObjectList, opts ...ctrlruntimeclient.ListOption) error {
+			if _, ok := list.(*v1beta1.NodeMetricsList); ok {
+				return &meta.NoKindMatchError{GroupKind: v1beta1.SchemeGroupVersion.WithKind("NodeMetrics").GroupKind()}
+			}
+			return client.List(ctx, list, opts...)
+		},
+	}
+
+	testcases := []struct {
+		Name                   string
+		ExpectedResponse       string
+		ExpectedWarning        string
+		HTTPStatus             int
+		MachineDeploymentToGet string
+		ExistingKubermaticObjs []ctrlruntimeclient.Object
+		ExistingClusterObjs    []ctrlruntimeclient.Object
+		Interceptor            interceptor.Funcs
+	}{
+		{
+			Name:                   "scenario 1: lists the metrics of the nodes in the node pool",
+			ExpectedResponse:       `[{"name":"venus","memoryTotalBytes":655,"memoryAvailableBytes":655,"memoryUsedPercentage":100,"cpuTotalMillicores":290000,"cpuAvailableMillicores":290000,"cpuUsedPercentage":100}]`,
+			HTTPStatus:             http.StatusOK,
+			MachineDeploymentToGet: "pool1",
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(genKubeOneCluster()),
+			ExistingClusterObjs: []ctrlruntimeclient.Object{
+				genNode("venus", "pool1"),
+				genNode("mars", "pool2"),
+				genNodeMetrics("venus"),
+				genNodeMetrics("mars"),
+			},
+		},
+		{
+			Name:                   "scenario 2: returns an empty list with a warning if the cluster has no metrics-server",
+			ExpectedResponse:       `[]`,
+			ExpectedWarning:        `299 - "metrics-server is not available in the cluster"`,
+			HTTPStatus:             http.StatusOK,
+			MachineDeploymentToGet: "pool1",
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(genKubeOneCluster()),
+			ExistingClusterObjs: []ctrlruntimeclient.Object{
+				genNode("venus", "pool1"),
+			},
+			Interceptor: noMetricServer,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/kubernetes/clusters/%s/machinedeployments/%s/nodes/metrics", test.ProjectName, "clusterAbcID", tc.MachineDeploymentToGet), strings.NewReader(""))
+			res := httptest.NewRecorder()
+
+			// the external cluster is backed by the same fake client as the kubermatic objects
+			kubermaticObj := append(tc.ExistingKubermaticObjs, tc.ExistingClusterObjs...)
+			ep, err := test.CreateTestEndpointWithUserClusterInterceptor(*test.GenDefaultAPIUser(), nil, kubermaticObj, nil, hack.NewTestRouting, tc.Interceptor)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if warning := res.Header().Get("Warning"); warning != tc.ExpectedWarning {
+				t.Fatalf("Expected warning header %q, got %q", tc.ExpectedWarning, warning)
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
@@ -78,7 +78,7 @@ func TestGetConfigEndpoint(t *testing.T) {
 		},
 		{
 			Name:             "scenario 3: user john can not get bobs gatekeeper config",
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			ProjectID:        test.GenDefaultProject().Name,
 			ClusterID:        test.GenDefaultCluster().Name,
 			HTTPStatus:       http.StatusForbidden,
@@ -173,7 +173,7 @@ func TestDeleteConfigEndpoint(t *testing.T) {
 		},
 		{
 			Name:             "scenario 3: user john can not delete bobs gatekeeper config",
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			ProjectID:        test.GenDefaultProject().Name,
 			ClusterID:        test.GenDefaultCluster().Name,
 			HTTPStatus:       http.StatusForbidden,
@@ -270,7 +270,7 @@ func TestCreateConfigEndpoint(t *testing.T) {
 		},
 		{
 			Name:             "scenario 3: user john can not create bob cluster gatekeeper config",
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			ProjectID:        test.GenDefaultProject().Name,
 			ClusterID:        test.GenDefaultCluster().Name,
 			ToCreateConfig:   genAPIGatekeeperConfig(),
@@ -373,7 +373,7 @@ func TestPatchConfigEndpoint(t *testing.T) {
 		},
 		{
 			Name:             "scenario 3: user john can not patch bobs gatekeeper config",
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			ProjectID:        test.GenDefaultProject().Name,
 			ClusterID:        test.GenDefaultCluster().Name,
 			HTTPStatus:       http.StatusForbidden,
@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetypefilter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// GetEndpoint returns the instance type filter of the datacenter. Datacenters without a filter return an empty one.
+func GetEndpoint(userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(instanceTypeFilterReq)
+
+		seed, err := getSeedForDatacenter(ctx, userInfoGetter, seedsGetter, req)
+		if err != nil {
+			return nil, err
+		}
+
+		filter, err := machine.GetInstanceTypeFilter(seed, req.DC)
+		if err != nil {
+			return nil, err
+		}
+		if filter == nil {
+			return &apiv2.InstanceTypeFilter{}, nil
+		}
+
+		return filter, nil
+	}
+}
+
+// UpdateEndpoint replaces the instance type filter of the datacenter.
+func UpdateEndpoint(userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, seedProvider provider.SeedProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateInstanceTypeFilterReq)
+
+		seed, err := getSeedForDatacenter(ctx, userInfoGetter, seedsGetter, req.instanceTypeFilterReq)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := machine.SetInstanceTypeFilter(seed, req.DC, &req.Body); err != nil {
+			return nil, err
+		}
+		if _, err := seedProvider.UpdateUnsecured(ctx, seed); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return &req.Body, nil
+	}
+}
+
+// DeleteEndpoint removes the instance type filter of the datacenter.
+func DeleteEndpoint(userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, seedProvider provider.SeedProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(instanceTypeFilterReq)
+
+		seed, err := getSeedForDatacenter(ctx, userInfoGetter, seedsGetter, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := machine.SetInstanceTypeFilter(seed, req.DC, nil); err != nil {
+			return nil, err
+		}
+		if _, err := seedProvider.UpdateUnsecured(ctx, seed); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return nil, nil
+	}
+}
+
+func getSeedForDatacenter(ctx context.Context, userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, req instanceTypeFilterReq) (*kubermaticv1.Seed, error) {
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if !userInfo.IsAdmin {
+		return nil, utilerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+	}
+
+	seeds, err := seedsGetter()
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	seed, ok := seeds[req.Seed]
+	if !ok {
+		return nil, utilerrors.NewNotFound("Seed", req.Seed)
+	}
+	if _, ok := seed.Spec.Datacenters[req.DC]; !ok {
+		return nil, utilerrors.NewNotFound("Datacenter", req.DC)
+	}
+
+	return seed, nil
+}
+
+// instanceTypeFilterReq defines HTTP request for getInstanceTypeFilter and deleteInstanceTypeFilter
+// swagger:parameters getInstanceTypeFilter deleteInstanceTypeFilter
+type instanceTypeFilterReq struct {
+	// in: path
+	// required: true
+	Seed string `json:"seed_name"`
+	// in: path
+	// required: true
+	DC string `json:"dc"`
+}
+
+func DecodeInstanceTypeFilterReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req instanceTypeFilterReq
+
+	req.Seed = mux.Vars(r)["seed_name"]
+	if req.Seed == "" {
+		return nil, utilerrors.NewBadRequest("'seed_name' parameter is required but was not provided")
+	}
+	req.DC = mux.Vars(r)["dc"]
+	if req.DC == "" {
+		return nil, utilerrors.NewBadRequest("'dc' parameter is required but was not provided")
+	}
+
+	return req, nil
+}
+
+// updateInstanceTypeFilterReq defines HTTP request for updateInstanceTypeFilter
+// swagger:parameters updateInstanceTypeFilter
+type updateInstanceTypeFilterReq struct {
+	instanceTypeFilterReq
+	// in: body
+	// required: true
+	Body apiv2.InstanceTypeFilter
+}
+
+func DecodeUpdateInstanceTypeFilterReq(c context.Context, r *http.Request) (interface{}, error) {
+	filterReq, err := DecodeInstanceTypeFilterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := updateInstanceTypeFilterReq{instanceTypeFilterReq: filterReq.(instanceTypeFilterReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse body: %v", err)
+	}
+
+	return req, nil
+}
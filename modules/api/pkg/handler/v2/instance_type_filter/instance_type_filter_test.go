@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetypefilter_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const filterPath = "/api/v2/admin/seeds/us-central1/datacenters/regular-do1/machine-flavor-filter"
+
+func TestInstanceTypeFilterEndpoints(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		Name             string
+		Method           string
+		Path             string
+		Body             string
+		ExistingFilter   *apiv2.InstanceTypeFilter
+		ExistingAPIUser  *apiv1.User
+		HTTPStatus       int
+		ExpectedResponse string
+		ExpectedFilter   string
+	}{
+		{
+			Name:             "scenario 1: the admin sets the instance type filter of the datacenter",
+			Method:           http.MethodPut,
+			Path:             filterPath,
+			Body:             `{"allowed":["s-1vcpu-1gb"],"denied":["c-32"]}`,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"allowed":["s-1vcpu-1gb"],"denied":["c-32"]}`,
+			ExpectedFilter:   `{"regular-do1":{"allowed":["s-1vcpu-1gb"],"denied":["c-32"]}}`,
+		},
+		{
+			Name:             "scenario 2: the admin gets the empty instance type filter of the datacenter",
+			Method:           http.MethodGet,
+			Path:             filterPath,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{}`,
+		},
+		{
+			Name:            "scenario 3: the admin deletes the instance type filter of the datacenter",
+			Method:          http.MethodDelete,
+			Path:            filterPath,
+			ExistingFilter:  &apiv2.InstanceTypeFilter{Denied: []string{"c-32"}},
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:      http.StatusOK,
+		},
+		{
+			Name:             "scenario 4: unknown datacenters are rejected",
+			Method:           http.MethodPut,
+			Path:             "/api/v2/admin/seeds/us-central1/datacenters/unknown/machine-flavor-filter",
+			Body:             `{"denied":["c-32"]}`,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusNotFound,
+			ExpectedResponse: `{"error":{"code":404,"message":"Datacenter \"unknown\" not found"}}`,
+		},
+		{
+			Name:             "scenario 5: regular users can not manage instance type filters",
+			Method:           http.MethodPut,
+			Path:             filterPath,
+			Body:             `{"denied":["c-32"]}`,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"bob@acme.com\" doesn't have admin rights"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			seed := test.GenTestSeed()
+			if tc.ExistingFilter != nil {
+				if err := machine.SetInstanceTypeFilter(seed, "regular-do1", tc.ExistingFilter); err != nil {
+					t.Fatalf("failed to set instance type filter: %v", err)
+				}
+			}
+			kubermaticObjs := test.GenDefaultKubermaticObjects(
+				seed,
+				test.GenAdminUser("John", "john@acme.com", true),
+			)
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, nil, nil, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(tc.Method, tc.Path, strings.NewReader(tc.Body)))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+
+			seed = &kubermaticv1.Seed{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(test.GenTestSeed()), seed); err != nil {
+				t.Fatalf("failed to get seed: %v", err)
+			}
+			if filter := seed.Annotations[machine.InstanceTypeFiltersAnnotation]; filter != tc.ExpectedFilter {
+				t.Fatalf("Expected instance type filters %q, got %q", tc.ExpectedFilter, filter)
+			}
+		})
+	}
+}
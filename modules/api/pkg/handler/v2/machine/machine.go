@@ -17,44 +17,164 @@ limitations under the License.
 package machine
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"crypto/x509"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
+	semverlib "github.com/Masterminds/semver/v3"
 	"github.com/go-kit/kit/endpoint"
 	"github.com/gorilla/mux"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler"
 	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	providercommon "k8c.io/dashboard/v2/pkg/handler/common/provider"
+	"k8c.io/dashboard/v2/pkg/handler/metrics"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
+	resourcesmachine "k8c.io/dashboard/v2/pkg/resources/machine"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	"k8s.io/apimachinery/pkg/labels"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
 )
 
-func CreateMachineDeployment(sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider) endpoint.Endpoint {
+func CreateMachineDeployment(sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, clusterProviderGetter provider.ClusterProviderGetter, caBundle *x509.CertPool) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(createMachineDeploymentReq)
 		if err := req.ValidateCreateNodeDeploymentReq(); err != nil {
-			return nil, utilerrors.NewBadRequest("%v", err)
+			err = common.WithReason(common.ReasonAutoscalerBounds, utilerrors.NewBadRequest("%v", err))
+			metrics.RecordMachineDeploymentValidationFailure("createMachineDeployment", err)
+			return nil, err
+		}
+		nd, err := handlercommon.CreateMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, clusterProviderGetter, req.Body, req.ProjectID, req.ClusterID, settingsProvider, caBundle, req.options())
+		if err != nil {
+			metrics.RecordMachineDeploymentValidationFailure("createMachineDeployment", err)
+			return nil, err
 		}
-		return handlercommon.CreateMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, req.Body, req.ProjectID, req.ClusterID, settingsProvider)
+		return withWarnings(nd, &req.Body), nil
+	}
+}
+
+// withWarnings adds warnings about the deprecated fields used by the requested node deployment to the response.
+// The kubelet version of the resulting node deployment decides which deprecations apply. The resulting node
+// deployment is also checked for a GPU instance type without GPU driver settings.
+func withWarnings(response interface{}, requested *apiv1.NodeDeployment) interface{} {
+	nd, ok := response.(*apiv1.NodeDeployment)
+	if !ok {
+		return response
+	}
+
+	warnings := resourcesmachine.DeprecationWarnings(requested, nd.Spec.Template.Versions.Kubelet)
+	if warning := resourcesmachine.GPUWarning(nd.Spec.Template); warning != "" {
+		warnings = append(warnings, warning)
+	}
+	if len(warnings) == 0 {
+		return response
+	}
+
+	return &handler.ResponseWithWarnings{Response: response, Warnings: warnings}
+}
+
+// ValidateMachineDeployment validates and defaults the machine deployment the same way as CreateMachineDeployment
+// does, without creating it.
+func ValidateMachineDeployment(sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, caBundle *x509.CertPool) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createMachineDeploymentReq)
+		nd, err := handlercommon.ValidateMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, req.Body, req.ProjectID, req.ClusterID, settingsProvider, caBundle, req.options())
+		metrics.RecordMachineDeploymentValidationFailure("validateMachineDeployment", err)
+		return nd, err
+	}
+}
+
+func EstimateMachineDeploymentCost(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, priceCatalog provider.PriceCatalog) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(estimateMachineDeploymentCostReq)
+		return handlercommon.EstimateMachineDeploymentCost(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, priceCatalog, req.Body, req.ProjectID, req.ClusterID)
 	}
 }
 
-// createMachineDeploymentReq defines HTTP request for createMachineDeployment
-// swagger:parameters createMachineDeployment
+// estimateMachineDeploymentCostReq defines HTTP request for estimateMachineDeploymentCost
+// swagger:parameters estimateMachineDeploymentCost
+type estimateMachineDeploymentCostReq struct {
+	common.ProjectReq
+	// in: path
+	ClusterID string `json:"cluster_id"`
+	// in: body
+	Body apiv1.NodeDeployment
+}
+
+func DecodeEstimateMachineDeploymentCost(c context.Context, r *http.Request) (interface{}, error) {
+	var req estimateMachineDeploymentCostReq
+
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+
+	if err = json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (r estimateMachineDeploymentCostReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: r.ClusterID,
+	}
+}
+
+// createMachineDeploymentReq defines HTTP request for createMachineDeployment and validateMachineDeployment
+// swagger:parameters createMachineDeployment validateMachineDeployment
 type createMachineDeploymentReq struct {
 	common.ProjectReq
 	// in: path
 	ClusterID string `json:"cluster_id"`
+	// OverrideInstanceTypeFilter allows admins to use instance types which are not allowed by the instance type
+	// filter of the datacenter.
+	// in: query
+	OverrideInstanceTypeFilter bool `json:"override_instance_type_filter,omitempty"`
+	// OverrideSizeLimits allows admins to exceed the global size limits of machine deployments.
+	// in: query
+	OverrideSizeLimits bool `json:"override_size_limits,omitempty"`
+	// SkipCloudValidation skips checking the node spec against the API of the cloud provider, e.g. in air-gapped
+	// environments where the API can't reach it.
+	// in: query
+	SkipCloudValidation bool `json:"skip_cloud_validation,omitempty"`
 	// in: body
 	Body apiv1.NodeDeployment
 }
 
+func (r createMachineDeploymentReq) options() handlercommon.MachineDeploymentOptions {
+	return handlercommon.MachineDeploymentOptions{
+		OverrideInstanceTypeFilter: r.OverrideInstanceTypeFilter,
+		OverrideSizeLimits:         r.OverrideSizeLimits,
+		SkipCloudValidation:        r.SkipCloudValidation,
+	}
+}
+
 func DecodeCreateMachineDeployment(c context.Context, r *http.Request) (interface{}, error) {
 	var req createMachineDeploymentReq
 
@@ -69,6 +189,9 @@ func DecodeCreateMachineDeployment(c context.Context, r *http.Request) (interfac
 		return nil, err
 	}
 	req.ProjectReq = projectReq.(common.ProjectReq)
+	req.OverrideInstanceTypeFilter = strings.EqualFold(r.URL.Query().Get("override_instance_type_filter"), "true")
+	req.OverrideSizeLimits = strings.EqualFold(r.URL.Query().Get("override_size_limits"), "true")
+	req.SkipCloudValidation = strings.EqualFold(r.URL.Query().Get("skip_cloud_validation"), "true")
 
 	if err = json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
 		return nil, err
@@ -99,8 +222,91 @@ func DeleteMachineDeploymentNode(projectProvider provider.ProjectProvider, privi
 	}
 }
 
-// deleteMachineDeploymentNodeReq defines HTTP request for deleteMachineDeploymentNode
-// swagger:parameters deleteMachineDeploymentNode
+func CordonNode(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, unschedulable bool) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteMachineDeploymentNodeReq)
+		return handlercommon.SetNodeUnschedulable(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.NodeID, unschedulable)
+	}
+}
+
+func PatchNodeLabels(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(patchNodeLabelsReq)
+		return handlercommon.PatchNodeLabels(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.NodeID, req.Body)
+	}
+}
+
+func ListNodePods(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteMachineDeploymentNodeReq)
+		return handlercommon.ListNodePods(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.NodeID)
+	}
+}
+
+func EvictNodePod(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(evictNodePodReq)
+		return nil, handlercommon.EvictNodePod(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.NodeID, req.Namespace, req.PodName)
+	}
+}
+
+// evictNodePodReq defines HTTP request for evictNodePod
+// swagger:parameters evictNodePod
+type evictNodePodReq struct {
+	deleteMachineDeploymentNodeReq
+	// in: path
+	// required: true
+	Namespace string `json:"namespace"`
+	// in: path
+	// required: true
+	PodName string `json:"pod_name"`
+}
+
+func DecodeEvictNodePod(c context.Context, r *http.Request) (interface{}, error) {
+	nodeReq, err := DecodeDeleteMachineDeploymentNode(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := evictNodePodReq{deleteMachineDeploymentNodeReq: nodeReq.(deleteMachineDeploymentNodeReq)}
+	req.Namespace = mux.Vars(r)["namespace"]
+	if req.Namespace == "" {
+		return nil, utilerrors.NewBadRequest("'namespace' parameter is required but was not provided")
+	}
+	req.PodName = mux.Vars(r)["pod_name"]
+	if req.PodName == "" {
+		return nil, utilerrors.NewBadRequest("'pod_name' parameter is required but was not provided")
+	}
+
+	return req, nil
+}
+
+// patchNodeLabelsReq defines HTTP request for patchNodeLabels
+// swagger:parameters patchNodeLabels
+type patchNodeLabelsReq struct {
+	deleteMachineDeploymentNodeReq
+	// The labels to set on the node, a null value removes the label.
+	// in: body
+	// required: true
+	Body map[string]*string
+}
+
+func DecodePatchNodeLabels(c context.Context, r *http.Request) (interface{}, error) {
+	nodeReq, err := DecodeDeleteMachineDeploymentNode(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := patchNodeLabelsReq{deleteMachineDeploymentNodeReq: nodeReq.(deleteMachineDeploymentNodeReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse body: %v", err)
+	}
+
+	return req, nil
+}
+
+// deleteMachineDeploymentNodeReq defines HTTP request for deleteMachineDeploymentNode, cordonNode, uncordonNode and listNodePods
+// swagger:parameters deleteMachineDeploymentNode cordonNode uncordonNode listNodePods
 type deleteMachineDeploymentNodeReq struct {
 	common.ProjectReq
 	// in: path
@@ -146,6 +352,8 @@ type listMachineDeploymentsReq struct {
 	common.ProjectReq
 	// in: path
 	ClusterID string `json:"cluster_id"`
+	// in: query
+	ShowNodeStatus bool `json:"show_node_status"`
 }
 
 func DecodeListMachineDeployments(c context.Context, r *http.Request) (interface{}, error) {
@@ -162,6 +370,7 @@ func DecodeListMachineDeployments(c context.Context, r *http.Request) (interface
 		return nil, err
 	}
 	req.ProjectReq = projectReq.(common.ProjectReq)
+	req.ShowNodeStatus, _ = strconv.ParseBool(r.URL.Query().Get("show_node_status"))
 
 	return req, nil
 }
@@ -173,27 +382,118 @@ func (req listMachineDeploymentsReq) GetSeedCluster() apiv1.SeedCluster {
 	}
 }
 
-func ListMachineDeployments(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func ListMachineDeployments(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(listMachineDeploymentsReq)
-		return handlercommon.ListMachineDeployments(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID)
+		return handlercommon.ListMachineDeployments(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, req.ProjectID, req.ClusterID, req.ShowNodeStatus)
 	}
 }
 
-func GetMachineDeployment(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+// listProjectMachineDeploymentsReq defines HTTP request for listProjectMachineDeployments
+// swagger:parameters listProjectMachineDeployments
+type listProjectMachineDeploymentsReq struct {
+	common.ProjectReq
+	// Only list the machine deployments with a kubelet version older than the given version, e.g. v1.28.0.
+	// in: query
+	KubeletOlderThan string `json:"kubelet_older_than"`
+
+	kubeletOlderThan *semverlib.Version
+}
+
+func DecodeListProjectMachineDeployments(c context.Context, r *http.Request) (interface{}, error) {
+	var req listProjectMachineDeploymentsReq
+
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+
+	req.KubeletOlderThan = r.URL.Query().Get("kubelet_older_than")
+	if req.KubeletOlderThan != "" {
+		req.kubeletOlderThan, err = semverlib.NewVersion(req.KubeletOlderThan)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid kubelet_older_than version %q: %v", req.KubeletOlderThan, err)
+		}
+	}
+
+	return req, nil
+}
+
+func ListProjectMachineDeployments(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, userInfoGetter provider.UserInfoGetter, seedCircuitBreaker *handlercommon.SeedCircuitBreaker) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(machineDeploymentReq)
-		return handlercommon.GetMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID)
+		req := request.(listProjectMachineDeploymentsReq)
+		return handlercommon.ListProjectMachineDeployments(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, clusterProviderGetter, seedCircuitBreaker, req.ProjectID, req.kubeletOlderThan)
+	}
+}
+
+func GetMachineDeployment(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getMachineDeploymentReq)
+		return handlercommon.GetMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, req.ProjectID, req.ClusterID, req.MachineDeploymentID, req.ShowMachineErrors)
+	}
+}
+
+// getMachineDeploymentReq defines HTTP request for getMachineDeployment
+// swagger:parameters getMachineDeployment
+type getMachineDeploymentReq struct {
+	machineDeploymentReq
+	// Include the errors the machine-controller reported for the machines of the machine deployment.
+	// in: query
+	ShowMachineErrors bool `json:"show_machine_errors"`
+}
+
+func DecodeGetMachineDeploymentReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req getMachineDeploymentReq
+
+	mdReq, err := DecodeGetMachineDeployment(c, r)
+	if err != nil {
+		return nil, err
 	}
+	req.machineDeploymentReq = mdReq.(machineDeploymentReq)
+	req.ShowMachineErrors, _ = strconv.ParseBool(r.URL.Query().Get("show_machine_errors"))
+
+	return req, nil
 }
 
 func GetMachineDeploymentJoiningScript(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(machineDeploymentReq)
-		return handlercommon.GetMachineDeploymentJoiningScript(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID)
+		req := request.(machineDeploymentJoiningScriptReq)
+		return handlercommon.GetMachineDeploymentJoiningScript(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID, req.Format)
 	}
 }
 
+// machineDeploymentJoiningScriptReq defines HTTP request for getMachineDeploymentJoinScript
+// swagger:parameters getMachineDeploymentJoinScript
+type machineDeploymentJoiningScriptReq struct {
+	machineDeploymentReq
+	// Format of the joining script, one of shell, cloud-init or json. Defaults to shell.
+	// in: query
+	Format string `json:"format,omitempty"`
+}
+
+func DecodeGetMachineDeploymentJoiningScript(c context.Context, r *http.Request) (interface{}, error) {
+	var req machineDeploymentJoiningScriptReq
+
+	mdReq, err := DecodeGetMachineDeployment(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.machineDeploymentReq = mdReq.(machineDeploymentReq)
+
+	req.Format = r.URL.Query().Get("format")
+	switch req.Format {
+	case "":
+		req.Format = handlercommon.JoiningScriptFormatShell
+	case handlercommon.JoiningScriptFormatShell, handlercommon.JoiningScriptFormatCloudInit, handlercommon.JoiningScriptFormatJSON:
+	default:
+		return nil, utilerrors.NewBadRequest("unsupported joining script format %q, must be one of: %s, %s, %s", req.Format,
+			handlercommon.JoiningScriptFormatShell, handlercommon.JoiningScriptFormatCloudInit, handlercommon.JoiningScriptFormatJSON)
+	}
+
+	return req, nil
+}
+
 // GetSeedCluster returns the SeedCluster object.
 func (req machineDeploymentReq) GetSeedCluster() apiv1.SeedCluster {
 	return apiv1.SeedCluster{
@@ -201,8 +501,8 @@ func (req machineDeploymentReq) GetSeedCluster() apiv1.SeedCluster {
 	}
 }
 
-// machineDeploymentReq defines HTTP request for getMachineDeployment
-// swagger:parameters getMachineDeployment restartMachineDeployment getMachineDeploymentJoinScript
+// machineDeploymentReq defines HTTP request for a single machine deployment
+// swagger:parameters pauseMachineDeployment resumeMachineDeployment listMachineDeploymentRevisions
 type machineDeploymentReq struct {
 	common.ProjectReq
 	// in: path
@@ -299,6 +599,82 @@ func ListMachineDeploymentNodes(projectProvider provider.ProjectProvider, privil
 	}
 }
 
+// machineConsoleLogReq defines HTTP request for getMachineConsoleLog
+// swagger:parameters getMachineConsoleLog
+type machineConsoleLogReq struct {
+	common.ProjectReq
+	// in: path
+	ClusterID string `json:"cluster_id"`
+	// in: path
+	MachineDeploymentID string `json:"machinedeployment_id"`
+	// in: path
+	NodeID string `json:"node_id"`
+	// Number of bytes returned from the end of the console log. Defaults to 65536.
+	// in: query
+	TailBytes int `json:"tail_bytes,omitempty"`
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (req machineConsoleLogReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeGetMachineConsoleLog(c context.Context, r *http.Request) (interface{}, error) {
+	var req machineConsoleLogReq
+
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+
+	machineDeploymentID, err := decodeMachineDeploymentID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.MachineDeploymentID = machineDeploymentID
+
+	req.NodeID = mux.Vars(r)["node_id"]
+	if req.NodeID == "" {
+		return nil, fmt.Errorf("'node_id' parameter is required but was not provided")
+	}
+
+	req.TailBytes = providercommon.DefaultConsoleLogTailBytes
+	if tailBytes := r.URL.Query().Get("tail_bytes"); tailBytes != "" {
+		req.TailBytes, err = strconv.Atoi(tailBytes)
+		if err != nil || req.TailBytes <= 0 {
+			return nil, utilerrors.NewBadRequest("invalid value for tail_bytes: %s", tailBytes)
+		}
+	}
+
+	return req, nil
+}
+
+func GetMachineConsoleLog(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter,
+	presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(machineConsoleLogReq)
+		return providercommon.MachineConsoleLogEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, presetProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID, req.NodeID, req.TailBytes, caBundle)
+	}
+}
+
+// EncodeConsoleLog writes the console log as plain text to the response.
+func EncodeConsoleLog(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Add("Cache-Control", "no-cache")
+
+	_, err := w.Write(response.([]byte))
+	return err
+}
+
 // listNodesForClusterReq defines HTTP request for listNodesForCluster
 // swagger:parameters listNodesForCluster
 type listNodesForClusterReq struct {
@@ -307,6 +683,10 @@ type listNodesForClusterReq struct {
 	ClusterID string `json:"cluster_id"`
 	// in: query
 	HideInitialConditions bool `json:"hideInitialConditions"`
+	// in: query
+	LabelSelector string `json:"label_selector,omitempty"`
+
+	labelSelector labels.Selector
 }
 
 // GetSeedCluster returns the SeedCluster object.
@@ -333,13 +713,347 @@ func DecodeListNodesForCluster(c context.Context, r *http.Request) (interface{},
 
 	req.HideInitialConditions, _ = strconv.ParseBool(r.URL.Query().Get("hideInitialConditions"))
 
+	req.LabelSelector = r.URL.Query().Get("label_selector")
+	req.labelSelector, err = labels.Parse(req.LabelSelector)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("invalid label selector: %v", err)
+	}
+
 	return req, nil
 }
 
 func ListNodesForCluster(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(listNodesForClusterReq)
-		return handlercommon.ListNodesForCluster(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.HideInitialConditions)
+		return handlercommon.ListNodesForCluster(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.HideInitialConditions, req.labelSelector)
+	}
+}
+
+const (
+	nodesExportFormatCSV  = "csv"
+	nodesExportFormatJSON = "json"
+)
+
+var nodesExportCSVHeader = []string{
+	"name",
+	"machine_deployment",
+	"cloud_provider",
+	"instance_size",
+	"kubelet_version",
+	"creation_timestamp",
+	"cpu_capacity_millicores",
+	"memory_capacity_bytes",
+	"cpu_usage_millicores",
+	"memory_usage_bytes",
+}
+
+// exportNodesForClusterReq defines HTTP request for exportNodesForCluster
+// swagger:parameters exportNodesForCluster
+type exportNodesForClusterReq struct {
+	common.ProjectReq
+	// in: path
+	ClusterID string `json:"cluster_id"`
+	// Format of the export, one of: csv, json. Defaults to csv.
+	// in: query
+	Format string `json:"format,omitempty"`
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (req exportNodesForClusterReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeExportNodesForCluster(c context.Context, r *http.Request) (interface{}, error) {
+	var req exportNodesForClusterReq
+
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+
+	req.Format = r.URL.Query().Get("format")
+	switch req.Format {
+	case "":
+		req.Format = nodesExportFormatCSV
+	case nodesExportFormatCSV, nodesExportFormatJSON:
+	default:
+		return nil, utilerrors.NewBadRequest("not supported export format: %s", req.Format)
+	}
+
+	return req, nil
+}
+
+func ExportNodesForCluster(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(exportNodesForClusterReq)
+		export, err := handlercommon.GetNodeExport(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &encodeNodesExportResponse{
+			export:    export,
+			clusterID: req.ClusterID,
+			format:    req.Format,
+		}, nil
+	}
+}
+
+type encodeNodesExportResponse struct {
+	export    *handlercommon.NodeExport
+	clusterID string
+	format    string
+}
+
+// EncodeNodesExport streams the nodes export row by row to the response.
+func EncodeNodesExport(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	rsp := response.(*encodeNodesExportResponse)
+	filename := fmt.Sprintf("nodes-%s.%s", rsp.clusterID, rsp.format)
+
+	if rsp.format == nodesExportFormatJSON {
+		w.Header().Set("Content-Type", "application/json")
+	} else {
+		w.Header().Set("Content-Type", "text/csv")
+	}
+	w.Header().Set("Content-disposition", fmt.Sprintf("attachment; filename=%s", filename))
+	w.Header().Add("Cache-Control", "no-cache")
+
+	if rsp.format == nodesExportFormatJSON {
+		return writeNodesExportJSON(w, rsp.export)
+	}
+	return writeNodesExportCSV(w, rsp.export)
+}
+
+func writeNodesExportCSV(w io.Writer, export *handlercommon.NodeExport) error {
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(nodesExportCSVHeader); err != nil {
+		return err
+	}
+
+	err := export.WriteRows(func(row apiv2.NodeExportRow) error {
+		record := []string{
+			row.Name,
+			row.MachineDeployment,
+			row.CloudProvider,
+			row.InstanceSize,
+			row.KubeletVersion,
+			row.CreationTimestamp.UTC().Format(time.RFC3339),
+			strconv.FormatInt(row.CPUCapacityMillicores, 10),
+			strconv.FormatInt(row.MemoryCapacityBytes, 10),
+			"",
+			"",
+		}
+		if row.CPUUsageMillicores != nil {
+			record[8] = strconv.FormatInt(*row.CPUUsageMillicores, 10)
+		}
+		if row.MemoryUsageBytes != nil {
+			record[9] = strconv.FormatInt(*row.MemoryUsageBytes, 10)
+		}
+
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+		csvWriter.Flush()
+		return csvWriter.Error()
+	})
+	if err != nil {
+		return err
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+func writeNodesExportJSON(w io.Writer, export *handlercommon.NodeExport) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	first := true
+	err := export.WriteRows(func(row apiv2.NodeExportRow) error {
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+
+		b, err := json.Marshal(row)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// exportMachineDeploymentsReq defines HTTP request for exportMachineDeployments
+// swagger:parameters exportMachineDeployments
+type exportMachineDeploymentsReq struct {
+	common.ProjectReq
+	// in: path
+	ClusterID string `json:"cluster_id"`
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (req exportMachineDeploymentsReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeExportMachineDeployments(c context.Context, r *http.Request) (interface{}, error) {
+	var req exportMachineDeploymentsReq
+
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+
+	return req, nil
+}
+
+func ExportMachineDeployments(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(exportMachineDeploymentsReq)
+		manifests, err := handlercommon.ExportMachineDeployments(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &encodeMachineDeploymentsExportResponse{
+			manifests: manifests,
+			clusterID: req.ClusterID,
+		}, nil
+	}
+}
+
+type encodeMachineDeploymentsExportResponse struct {
+	manifests []apiv2.MachineDeploymentManifest
+	clusterID string
+}
+
+// EncodeMachineDeploymentsExport writes the machine deployment manifests as a multi-document YAML file.
+func EncodeMachineDeploymentsExport(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	rsp := response.(*encodeMachineDeploymentsExportResponse)
+
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Header().Set("Content-disposition", fmt.Sprintf("attachment; filename=machinedeployments-%s.yaml", rsp.clusterID))
+	w.Header().Add("Cache-Control", "no-cache")
+
+	for i, manifest := range rsp.manifests {
+		if i > 0 {
+			if _, err := io.WriteString(w, yamlDocumentSeparator); err != nil {
+				return err
+			}
+		}
+
+		b, err := yaml.Marshal(manifest)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const yamlDocumentSeparator = "---\n"
+
+// importMachineDeploymentsReq defines HTTP request for importMachineDeployments
+// swagger:parameters importMachineDeployments
+type importMachineDeploymentsReq struct {
+	common.ProjectReq
+	// in: path
+	ClusterID string `json:"cluster_id"`
+	// Overwrite existing machine deployments with the manifests, they are skipped otherwise.
+	// in: query
+	Overwrite bool `json:"overwrite,omitempty"`
+	// The machine deployment manifests as multi-document YAML, as returned by the export.
+	// in: body
+	// required: true
+	Body []apiv2.MachineDeploymentManifest
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (req importMachineDeploymentsReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeImportMachineDeployments(c context.Context, r *http.Request) (interface{}, error) {
+	var req importMachineDeploymentsReq
+
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+	req.Overwrite = strings.EqualFold(r.URL.Query().Get("overwrite"), "true")
+
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r.Body))
+	for {
+		document, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("unable to read manifests: %v", err)
+		}
+		if len(bytes.TrimSpace(document)) == 0 {
+			continue
+		}
+
+		var manifest apiv2.MachineDeploymentManifest
+		if err := yaml.UnmarshalStrict(document, &manifest); err != nil {
+			return nil, utilerrors.NewBadRequest("unable to parse manifest %d: %v", len(req.Body)+1, err)
+		}
+		req.Body = append(req.Body, manifest)
+	}
+	if len(req.Body) == 0 {
+		return nil, utilerrors.NewBadRequest("no machine deployment manifests were provided")
+	}
+
+	return req, nil
+}
+
+// ImportMachineDeployments creates the machine deployments of an export in the cluster and reports the result
+// of every manifest.
+func ImportMachineDeployments(sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, clusterProviderGetter provider.ClusterProviderGetter, caBundle *x509.CertPool) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(importMachineDeploymentsReq)
+		return handlercommon.ImportMachineDeployments(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, clusterProviderGetter, req.ProjectID, req.ClusterID, req.Body, req.Overwrite, settingsProvider, caBundle)
 	}
 }
 
@@ -396,10 +1110,35 @@ func ListMachineDeploymentMetrics(projectProvider provider.ProjectProvider, priv
 type patchMachineDeploymentReq struct {
 	machineDeploymentReq
 
+	// OverrideInstanceTypeFilter allows admins to use instance types which are not allowed by the instance type
+	// filter of the datacenter.
+	// in: query
+	OverrideInstanceTypeFilter bool `json:"override_instance_type_filter,omitempty"`
+	// OverrideSizeLimits allows admins to exceed the global size limits of machine deployments.
+	// in: query
+	OverrideSizeLimits bool `json:"override_size_limits,omitempty"`
+	// SkipCloudValidation skips checking the node spec against the API of the cloud provider, e.g. in air-gapped
+	// environments where the API can't reach it.
+	// in: query
+	SkipCloudValidation bool `json:"skip_cloud_validation,omitempty"`
+	// Force allows project owners and admins to change the kubelet version outside of the maintenance window of
+	// the cluster.
+	// in: query
+	Force bool `json:"force,omitempty"`
+
 	// in: body
 	Patch json.RawMessage
 }
 
+func (r patchMachineDeploymentReq) options() handlercommon.MachineDeploymentOptions {
+	return handlercommon.MachineDeploymentOptions{
+		OverrideInstanceTypeFilter: r.OverrideInstanceTypeFilter,
+		OverrideSizeLimits:         r.OverrideSizeLimits,
+		SkipCloudValidation:        r.SkipCloudValidation,
+		Force:                      r.Force,
+	}
+}
+
 func DecodePatchMachineDeployment(c context.Context, r *http.Request) (interface{}, error) {
 	var req patchMachineDeploymentReq
 
@@ -414,21 +1153,192 @@ func DecodePatchMachineDeployment(c context.Context, r *http.Request) (interface
 	req.MachineDeploymentID = md.MachineDeploymentID
 	req.ClusterID = md.ClusterID
 	req.ProjectID = md.ProjectID
+	req.OverrideInstanceTypeFilter = strings.EqualFold(r.URL.Query().Get("override_instance_type_filter"), "true")
+	req.OverrideSizeLimits = strings.EqualFold(r.URL.Query().Get("override_size_limits"), "true")
+	req.SkipCloudValidation = strings.EqualFold(r.URL.Query().Get("skip_cloud_validation"), "true")
+	req.Force = strings.EqualFold(r.URL.Query().Get("force"), "true")
 
 	return req, nil
 }
 
-func PatchMachineDeployment(sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider) endpoint.Endpoint {
+func PatchMachineDeployment(sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, clusterProviderGetter provider.ClusterProviderGetter, caBundle *x509.CertPool) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(patchMachineDeploymentReq)
-		return handlercommon.PatchMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, req.ProjectID, req.ClusterID, req.MachineDeploymentID, req.Patch, settingsProvider)
+		nd, rolloutTriggered, err := handlercommon.PatchMachineDeploymentWithRollout(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, clusterProviderGetter, req.ProjectID, req.ClusterID, req.MachineDeploymentID, req.Patch, settingsProvider, caBundle, req.options())
+		if err != nil {
+			metrics.RecordMachineDeploymentValidationFailure("patchMachineDeployment", err)
+			return nil, err
+		}
+
+		// Only the fields sent in the patch are checked, so that unrelated patches don't warn about
+		// deprecated fields of the existing machine deployment.
+		patched := &apiv1.NodeDeployment{}
+		if err := json.Unmarshal(req.Patch, patched); err != nil {
+			patched = &apiv1.NodeDeployment{}
+		}
+		return &handler.ResponseWithRollout{Response: withWarnings(nd, patched), RolloutTriggered: rolloutTriggered}, nil
 	}
 }
 
-func RestartMachineDeployment(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+// PauseMachineDeployment pauses the machine deployment. Only the paused flag is changed, pausing an already
+// paused machine deployment doesn't change anything.
+func PauseMachineDeployment(sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, clusterProviderGetter provider.ClusterProviderGetter, caBundle *x509.CertPool) endpoint.Endpoint {
+	return setMachineDeploymentPaused(sshKeyProvider, projectProvider, privilegedProjectProvider, seedsGetter, userInfoGetter, settingsProvider, clusterProviderGetter, caBundle, true)
+}
+
+// ResumeMachineDeployment resumes the paused machine deployment. Only the paused flag is changed, resuming a
+// machine deployment which is not paused doesn't change anything.
+func ResumeMachineDeployment(sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, clusterProviderGetter provider.ClusterProviderGetter, caBundle *x509.CertPool) endpoint.Endpoint {
+	return setMachineDeploymentPaused(sshKeyProvider, projectProvider, privilegedProjectProvider, seedsGetter, userInfoGetter, settingsProvider, clusterProviderGetter, caBundle, false)
+}
+
+func setMachineDeploymentPaused(sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, clusterProviderGetter provider.ClusterProviderGetter, caBundle *x509.CertPool, paused bool) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(machineDeploymentReq)
-		return handlercommon.RestartMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID)
+		patch := json.RawMessage(fmt.Sprintf(`{"spec":{"paused":%t}}`, paused))
+		return handlercommon.PatchMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, clusterProviderGetter, req.ProjectID, req.ClusterID, req.MachineDeploymentID, patch, settingsProvider, caBundle, handlercommon.MachineDeploymentOptions{})
+	}
+}
+
+// CopyMachineDeployment creates a copy of the machine deployment in another cluster of the project. The target
+// cluster might belong to a different seed than the source cluster, so its cluster provider is looked up here.
+func CopyMachineDeployment(sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, clusterProviderGetter provider.ClusterProviderGetter, caBundle *x509.CertPool) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(copyMachineDeploymentReq)
+
+		targetClusterProvider, targetCtx, err := middleware.GetClusterProvider(ctx, targetClusterReq{clusterID: req.Body.TargetClusterID}, seedsGetter, clusterProviderGetter)
+		if err != nil {
+			return nil, err
+		}
+		targetCtx = context.WithValue(targetCtx, middleware.ClusterProviderContextKey, targetClusterProvider)
+		targetCtx = context.WithValue(targetCtx, middleware.PrivilegedClusterProviderContextKey, targetClusterProvider.(provider.PrivilegedClusterProvider))
+
+		return handlercommon.CopyMachineDeployment(ctx, targetCtx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, clusterProviderGetter, req.ProjectID, req.ClusterID, req.MachineDeploymentID, req.Body.TargetClusterID, settingsProvider, caBundle)
+	}
+}
+
+// copyMachineDeploymentReq defines HTTP request for copyMachineDeployment
+// swagger:parameters copyMachineDeployment
+type copyMachineDeploymentReq struct {
+	machineDeploymentReq
+
+	// in: body
+	// required: true
+	Body copyMachineDeploymentBody
+}
+
+type copyMachineDeploymentBody struct {
+	// TargetClusterID is the ID of the cluster of the same project to copy the machine deployment to.
+	TargetClusterID string `json:"targetClusterID"`
+}
+
+// targetClusterReq identifies the target cluster of a copied machine deployment, so that the cluster provider of
+// its seed can be looked up.
+type targetClusterReq struct {
+	clusterID string
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (req targetClusterReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.clusterID,
+	}
+}
+
+func DecodeCopyMachineDeployment(c context.Context, r *http.Request) (interface{}, error) {
+	var req copyMachineDeploymentReq
+
+	rawMachineDeployment, err := DecodeGetMachineDeployment(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.machineDeploymentReq = rawMachineDeployment.(machineDeploymentReq)
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse body: %v", err)
+	}
+	if req.Body.TargetClusterID == "" {
+		return nil, utilerrors.NewBadRequest("'targetClusterID' is required but was not provided")
+	}
+
+	return req, nil
+}
+
+// ListMachineDeploymentRevisions lists the revisions of the machine deployment, i.e. the machine sets it owns.
+func ListMachineDeploymentRevisions(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(machineDeploymentReq)
+		return handlercommon.ListMachineDeploymentRevisions(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID)
+	}
+}
+
+// RollbackMachineDeployment rolls the machine deployment back to the template of the given revision.
+func RollbackMachineDeployment(sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, clusterProviderGetter provider.ClusterProviderGetter, caBundle *x509.CertPool) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(rollbackMachineDeploymentReq)
+		return handlercommon.RollbackMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, clusterProviderGetter, req.ProjectID, req.ClusterID, req.MachineDeploymentID, *req.Body.Revision, settingsProvider, caBundle)
+	}
+}
+
+// swagger:parameters rollbackMachineDeployment
+type rollbackMachineDeploymentReq struct {
+	machineDeploymentReq
+
+	// in: body
+	// required: true
+	Body rollbackMachineDeploymentBody
+}
+
+type rollbackMachineDeploymentBody struct {
+	// Revision is the revision of the machine deployment to roll back to.
+	Revision *int64 `json:"revision"`
+}
+
+func DecodeRollbackMachineDeployment(c context.Context, r *http.Request) (interface{}, error) {
+	var req rollbackMachineDeploymentReq
+
+	rawMachineDeployment, err := DecodeGetMachineDeployment(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.machineDeploymentReq = rawMachineDeployment.(machineDeploymentReq)
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse body: %v", err)
+	}
+	if req.Body.Revision == nil {
+		return nil, utilerrors.NewBadRequest("'revision' is required but was not provided")
+	}
+
+	return req, nil
+}
+
+// restartMachineDeploymentReq defines HTTP request for restartMachineDeployment endpoint
+// swagger:parameters restartMachineDeployment
+type restartMachineDeploymentReq struct {
+	machineDeploymentReq
+	// Force allows project owners and admins to restart the machine deployment outside of the maintenance window
+	// of the cluster.
+	// in: query
+	Force bool `json:"force,omitempty"`
+}
+
+func DecodeRestartMachineDeployment(c context.Context, r *http.Request) (interface{}, error) {
+	rawMachineDeployment, err := DecodeGetMachineDeployment(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return restartMachineDeploymentReq{
+		machineDeploymentReq: rawMachineDeployment.(machineDeploymentReq),
+		Force:                strings.EqualFold(r.URL.Query().Get("force"), "true"),
+	}, nil
+}
+
+func RestartMachineDeployment(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(restartMachineDeploymentReq)
+		return handlercommon.RestartMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID, req.Force)
 	}
 }
 
@@ -471,10 +1381,23 @@ func ListMachineDeploymentNodesEvents(projectProvider provider.ProjectProvider,
 	}
 }
 
+// defaultGracefulDeletionTimeout is the time a graceful deletion waits for the machines to be removed.
+const defaultGracefulDeletionTimeout = 10 * time.Minute
+
 // deleteMachineDeploymentReq defines HTTP request for deleteMachineDeployment
 // swagger:parameters deleteMachineDeployment
 type deleteMachineDeploymentReq struct {
 	machineDeploymentReq
+	// Scale the machine deployment to zero first and delete it once all machines are removed, instead of deleting
+	// all machines at once.
+	// in: query
+	Graceful bool `json:"graceful,omitempty"`
+	// The time to wait for the machines to be removed in a graceful deletion, e.g. 30m. The machine deployment is
+	// deleted anyway after the timeout. Defaults to 10m.
+	// in: query
+	Timeout string `json:"timeout,omitempty"`
+
+	timeout time.Duration
 }
 
 func DecodeDeleteMachineDeployment(c context.Context, r *http.Request) (interface{}, error) {
@@ -489,6 +1412,26 @@ func DecodeDeleteMachineDeployment(c context.Context, r *http.Request) (interfac
 	req.ClusterID = md.ClusterID
 	req.ProjectID = md.ProjectID
 
+	query := r.URL.Query()
+	if value := query.Get("graceful"); value != "" {
+		req.Graceful, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid value for `graceful`: %v", err)
+		}
+	}
+
+	req.Timeout = query.Get("timeout")
+	req.timeout = defaultGracefulDeletionTimeout
+	if req.Timeout != "" {
+		if !req.Graceful {
+			return nil, utilerrors.NewBadRequest("`timeout` can only be set for a graceful deletion")
+		}
+		req.timeout, err = time.ParseDuration(req.Timeout)
+		if err != nil || req.timeout <= 0 {
+			return nil, utilerrors.NewBadRequest("invalid value for `timeout`: %q, must be a positive duration", req.Timeout)
+		}
+	}
+
 	return req, nil
 }
 
@@ -502,6 +1445,9 @@ func (req deleteMachineDeploymentReq) GetSeedCluster() apiv1.SeedCluster {
 func DeleteMachineDeployment(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(deleteMachineDeploymentReq)
+		if req.Graceful {
+			return handlercommon.GracefulDeleteMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID, req.timeout)
+		}
 		return handlercommon.DeleteMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID)
 	}
 }
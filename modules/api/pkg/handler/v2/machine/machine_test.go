@@ -18,26 +18,62 @@ package machine_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
 	"strings"
+	"syscall"
 	"testing"
+	"time"
+
+	osavailabilityzones "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	osflavors "github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	kvinstancetypev1alpha1 "kubevirt.io/api/instancetype/v1alpha1"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/metrics"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/hetzner"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/kubevirt"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/vsphere"
+	clusterresources "k8c.io/dashboard/v2/pkg/resources/cluster"
 	"k8c.io/dashboard/v2/pkg/resources/machine"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	clustercommon "k8c.io/machine-controller/sdk/apis/cluster/common"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+	"k8c.io/machine-controller/sdk/providerconfig"
+	osmv1alpha1 "k8c.io/operating-system-manager/pkg/crd/osm/v1alpha1"
 
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	"k8s.io/utils/ptr"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/yaml"
 )
 
 func TestCreateMachineDeployment(t *testing.T) {
@@ -74,7 +110,7 @@ func TestCreateMachineDeployment(t *testing.T) {
 		{
 			Name:             "scenario 2: cluster components are not ready",
 			Body:             `{"spec":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}`,
-			ExpectedResponse: `{"error":{"code":503,"message":"Cluster components are not ready yet"}}`,
+			ExpectedResponse: `{"error":{"code":503,"message":"Cluster components are not ready yet","reason":"CLUSTER_NOT_READY"}}`,
 			HTTPStatus:       http.StatusServiceUnavailable,
 			ProjectID:        test.GenDefaultProject().Name,
 			ClusterID:        test.GenDefaultCluster().Name,
@@ -89,7 +125,7 @@ func TestCreateMachineDeployment(t *testing.T) {
 		{
 			Name:             "scenario 3: kubelet version is too old",
 			Body:             `{"spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"versions":{"kubelet":"9.6.0"}}}}`,
-			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: kubelet version 9.6.0 is not compatible with control plane version 9.9.9"}}`,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: kubelet version 9.6.0 is not compatible with control plane version 9.9.9","reason":"VERSION_SKEW"}}`,
 			HTTPStatus:       http.StatusBadRequest,
 			ProjectID:        test.GenDefaultProject().Name,
 			ClusterID:        test.GenDefaultCluster().Name,
@@ -104,7 +140,7 @@ func TestCreateMachineDeployment(t *testing.T) {
 		{
 			Name:             "scenario 4: kubelet version is too new",
 			Body:             `{"spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"versions":{"kubelet":"9.10.0"}}}}`,
-			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: kubelet version 9.10.0 is not compatible with control plane version 9.9.9"}}`,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: kubelet version 9.10.0 is not compatible with control plane version 9.9.9","reason":"VERSION_SKEW"}}`,
 			HTTPStatus:       http.StatusBadRequest,
 			ProjectID:        test.GenDefaultProject().Name,
 			ClusterID:        test.GenDefaultCluster().Name,
@@ -174,6 +210,96 @@ func TestCreateMachineDeployment(t *testing.T) {
 			),
 			ExistingAPIUser: test.GenDefaultAPIUser(),
 		},
+
+		// scenario 9
+		{
+			Name:             "scenario 9: machine deployment cloud provider does not match the cluster provider",
+			Body:             `{"spec":{"replicas":1,"template":{"cloud":{"aws":{"instanceType":"t3.small","diskSize":25,"volumeType":"standard","ami":"","tags":{},"availabilityZone":"eu-central-1a","subnetID":"","assignPublicIP":false,"isSpotInstance":false}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`,
+			ExpectedResponse: `{"error":{"code":400,"message":"machine deployment cloud provider aws does not match cluster provider digitalocean"}}`,
+			HTTPStatus:       http.StatusBadRequest,
+			ProjectID:        test.GenDefaultProject().Name,
+			ClusterID:        test.GenDefaultCluster().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestClusterWithCloud(kubermaticv1.CloudSpec{DatacenterName: "regular-do1", Digitalocean: &kubermaticv1.DigitaloceanCloudSpec{Token: "dummy-token"}}, nil),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+
+		// scenario 10
+		{
+			Name:             "scenario 10: machine deployment cloud provider matches the cluster provider",
+			Body:             `{"spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`,
+			ExpectedResponse: `{"id":"%s","name":"%s","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":["kubernetes","kubernetes-cluster-defClusterID","system-cluster-defClusterID","system-project-my-first-project-ID"]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"versions":{"kubelet":"9.9.9"},"labels":{"system/cluster":"defClusterID","system/project":"my-first-project-ID"}},"paused":false,"dynamicConfig":false},"status":{}}`,
+			HTTPStatus:       http.StatusCreated,
+			ProjectID:        test.GenDefaultProject().Name,
+			ClusterID:        test.GenDefaultCluster().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestClusterWithCloud(kubermaticv1.CloudSpec{DatacenterName: "regular-do1", Digitalocean: &kubermaticv1.DigitaloceanCloudSpec{Token: "dummy-token"}}, nil),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+
+		// scenario 11
+		{
+			Name:             "scenario 11: mixed cloud providers are allowed for annotated clusters",
+			Body:             `{"spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`,
+			ExpectedResponse: `{"id":"%s","name":"%s","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":["kubernetes","kubernetes-cluster-defClusterID","system-cluster-defClusterID","system-project-my-first-project-ID"]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"versions":{"kubelet":"9.9.9"},"labels":{"system/cluster":"defClusterID","system/project":"my-first-project-ID"}},"paused":false,"dynamicConfig":false},"status":{}}`,
+			HTTPStatus:       http.StatusCreated,
+			ProjectID:        test.GenDefaultProject().Name,
+			ClusterID:        test.GenDefaultCluster().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestClusterWithCloud(kubermaticv1.CloudSpec{DatacenterName: "regular-do1", AWS: &kubermaticv1.AWSCloudSpec{}}, map[string]string{machine.AllowMixedCloudProvidersAnnotation: "true"}),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+
+		// scenario 12
+		{
+			Name:             "scenario 12: cluster-autoscaler annotations cannot be set directly",
+			Body:             fmt.Sprintf(`{"annotations":{"%s":"5"},"spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`, machine.AutoscalerMaxSizeAnnotation),
+			ExpectedResponse: fmt.Sprintf(`{"error":{"code":400,"message":"annotation %s cannot be set directly, use minReplicas and maxReplicas instead"}}`, machine.AutoscalerMaxSizeAnnotation),
+			HTTPStatus:       http.StatusBadRequest,
+			ProjectID:        test.GenDefaultProject().Name,
+			ClusterID:        test.GenDefaultCluster().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+		// scenario 13
+		{
+			Name:             "scenario 13: create a machine deployment with an operating system profile",
+			Body:             fmt.Sprintf(`{"spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"osProfile":"%s"}}}`, "osp-custom"),
+			ExpectedResponse: `{"id":"%s","name":"%s","annotations":{"k8c.io/operating-system-profile":"osp-custom"},"creationTimestamp":"0001-01-01T00:00:00Z","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":["kubernetes","kubernetes-cluster-defClusterID","system-cluster-defClusterID","system-project-my-first-project-ID"]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"versions":{"kubelet":"9.9.9"},"labels":{"system/cluster":"defClusterID","system/project":"my-first-project-ID"},"osProfile":"osp-custom"},"paused":false,"dynamicConfig":false},"status":{}}`,
+			HTTPStatus:       http.StatusCreated,
+			ProjectID:        test.GenDefaultProject().Name,
+			ClusterID:        test.GenDefaultCluster().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+				genOperatingSystemProfile("osp-custom"),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+		// scenario 14
+		{
+			Name:             "scenario 14: unknown operating system profiles are rejected",
+			Body:             fmt.Sprintf(`{"spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"osProfile":"%s"}}}`, "osp-unknown"),
+			ExpectedResponse: `{"error":{"code":400,"message":"operating system profile does not exist in the cluster: \"osp-unknown\""}}`,
+			HTTPStatus:       http.StatusBadRequest,
+			ProjectID:        test.GenDefaultProject().Name,
+			ClusterID:        test.GenDefaultCluster().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+				genOperatingSystemProfile("osp-custom"),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
 	}
 
 	for _, tc := range testcases {
@@ -211,119 +337,63 @@ func TestCreateMachineDeployment(t *testing.T) {
 	}
 }
 
-func TestDeleteMachineDeploymentNode(t *testing.T) {
+func TestValidateMachineDeployment(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
-		Name                    string
-		HTTPStatus              int
-		NodeIDToDelete          string
-		ClusterIDToSync         string
-		ProjectIDToSync         string
-		ExistingAPIUser         *apiv1.User
-		ExistingNodes           []*corev1.Node
-		ExistingMachines        []*clusterv1alpha1.Machine
-		ExistingKubermaticObjs  []ctrlruntimeclient.Object
-		ExpectedHTTPStatusOnGet int
-		ExpectedResponseOnGet   string
-		ExpectedNodeCount       int
+		Name                   string
+		Body                   string
+		ExpectedResponse       string
+		HTTPStatus             int
+		ExistingKubermaticObjs []ctrlruntimeclient.Object
 	}{
-		// scenario 1
 		{
-			Name:            "scenario 1: delete the machine node that belong to the given cluster",
-			HTTPStatus:      http.StatusOK,
-			NodeIDToDelete:  "venus",
-			ClusterIDToSync: test.GenDefaultCluster().Name,
-			ProjectIDToSync: test.GenDefaultProject().Name,
+			Name:             "scenario 1: a valid machine deployment is returned with the defaults of the create path",
+			Body:             `{"name":"workers","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`,
+			ExpectedResponse: `{"id":"workers","name":"workers","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":["kubernetes","kubernetes-cluster-defClusterID","system-cluster-defClusterID","system-project-my-first-project-ID"]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"versions":{"kubelet":"9.9.9"},"labels":{"system/cluster":"defClusterID","system/project":"my-first-project-ID"}},"paused":false,"dynamicConfig":false},"status":{}}`,
+			HTTPStatus:       http.StatusOK,
 			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
 				test.GenTestSeed(),
-				test.GenDefaultCluster(),
+				genTestCluster(true),
 			),
-			ExistingAPIUser: test.GenDefaultAPIUser(),
-			ExistingNodes: []*corev1.Node{
-				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "mars"}},
-			},
-			ExistingMachines: []*clusterv1alpha1.Machine{
-				genTestMachine("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
-				genTestMachine("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
-			},
-			//
-			// even though the machine object was deleted the associated node object was not. When the client GETs the previously deleted "node" it will get a valid response.
-			// That is only true for testing, but in a real cluster, the node object will get deleted by the garbage-collector as it has a ownerRef set.
-			ExpectedHTTPStatusOnGet: http.StatusOK,
-			ExpectedResponseOnGet:   `{"id":"venus","name":"venus","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"cloud":{},"operatingSystem":{},"versions":{"kubelet":""}},"status":{"machineName":"","capacity":{"cpu":"0","memory":"0"},"allocatable":{"cpu":"0","memory":"0"},"nodeInfo":{"kernelVersion":"","containerRuntime":"","containerRuntimeVersion":"","kubeletVersion":"","operatingSystem":"","architecture":""}}}`,
-			ExpectedNodeCount:       1,
 		},
-		// scenario 2
 		{
-			Name:            "scenario 2: the admin John can delete any cluster machine node",
-			HTTPStatus:      http.StatusOK,
-			NodeIDToDelete:  "venus",
-			ClusterIDToSync: test.GenDefaultCluster().Name,
-			ProjectIDToSync: test.GenDefaultProject().Name,
+			Name:             "scenario 2: all validation errors are returned at once",
+			Body:             fmt.Sprintf(`{"annotations":{"%s":"5"},"spec":{"replicas":3,"maxReplicas":2,"template":{"taints":[{"key":"foo","value":"bar","effect":"BAD_EFFECT"}],"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`, machine.AutoscalerMaxSizeAnnotation),
+			ExpectedResponse: fmt.Sprintf(`{"error":{"code":400,"message":"node deployment validation failed, please examine details field for more info","details":["replica count (3) cannot be higher then autoscaler maxreplicas (2).","annotation %s cannot be set directly, use minReplicas and maxReplicas instead","node deployment validation failed: taint effect 'BAD_EFFECT' not allowed. Allowed: NoExecute, NoSchedule, PreferNoSchedule"]}}`, machine.AutoscalerMaxSizeAnnotation),
+			HTTPStatus:       http.StatusBadRequest,
 			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
 				test.GenTestSeed(),
-				test.GenDefaultCluster(),
-				test.GenAdminUser("John", "john@acme.com", true),
+				genTestCluster(true),
 			),
-			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
-			ExistingNodes: []*corev1.Node{
-				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "mars"}},
-			},
-			ExistingMachines: []*clusterv1alpha1.Machine{
-				genTestMachine("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
-				genTestMachine("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
-			},
-			//
-			// even though the machine object was deleted the associated node object was not. When the client GETs the previously deleted "node" it will get a valid response.
-			// That is only true for testing, but in a real cluster, the node object will get deleted by the garbage-collector as it has a ownerRef set.
-			ExpectedHTTPStatusOnGet: http.StatusOK,
-			ExpectedResponseOnGet:   `{"id":"venus","name":"venus","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"cloud":{},"operatingSystem":{},"versions":{"kubelet":""}},"status":{"machineName":"","capacity":{"cpu":"0","memory":"0"},"allocatable":{"cpu":"0","memory":"0"},"nodeInfo":{"kernelVersion":"","containerRuntime":"","containerRuntimeVersion":"","kubeletVersion":"","operatingSystem":"","architecture":""}}}`,
-			ExpectedNodeCount:       1,
 		},
-		// scenario 3
 		{
-			Name:            "scenario 3: the user John can not delete Bob's cluster machine node",
-			HTTPStatus:      http.StatusForbidden,
-			NodeIDToDelete:  "venus",
-			ClusterIDToSync: test.GenDefaultCluster().Name,
-			ProjectIDToSync: test.GenDefaultProject().Name,
+			Name:             "scenario 3: machine deployment cloud provider does not match the cluster provider",
+			Body:             `{"spec":{"replicas":1,"template":{"cloud":{"aws":{"instanceType":"t3.small","diskSize":25,"volumeType":"standard","ami":"","tags":{},"availabilityZone":"eu-central-1a","subnetID":"","assignPublicIP":false,"isSpotInstance":false}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed, please examine details field for more info","details":["machine deployment cloud provider aws does not match cluster provider digitalocean"]}}`,
+			HTTPStatus:       http.StatusBadRequest,
 			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
 				test.GenTestSeed(),
-				test.GenDefaultCluster(),
-				test.GenAdminUser("John", "john@acme.com", false),
+				genTestClusterWithCloud(kubermaticv1.CloudSpec{DatacenterName: "regular-do1", Digitalocean: &kubermaticv1.DigitaloceanCloudSpec{Token: "dummy-token"}}, nil),
+			),
+		},
+		{
+			Name:             "scenario 4: unknown operating system profiles are reported",
+			Body:             fmt.Sprintf(`{"spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"osProfile":"%s"}}}`, "osp-unknown"),
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed, please examine details field for more info","details":["operating system profile does not exist in the cluster: \"osp-unknown\""]}}`,
+			HTTPStatus:       http.StatusBadRequest,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+				genOperatingSystemProfile("osp-custom"),
 			),
-			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
-			ExistingNodes: []*corev1.Node{
-				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "mars"}},
-			},
-			ExistingMachines: []*clusterv1alpha1.Machine{
-				genTestMachine("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
-				genTestMachine("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
-			},
-			ExpectedHTTPStatusOnGet: http.StatusForbidden,
-			ExpectedResponseOnGet:   `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
-			ExpectedNodeCount:       2,
 		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.Name, func(t *testing.T) {
-			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/nodes/%s", tc.ProjectIDToSync, tc.ClusterIDToSync, tc.NodeIDToDelete), strings.NewReader(""))
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/validate", test.GenDefaultProject().Name, test.GenDefaultCluster().Name), strings.NewReader(tc.Body))
 			res := httptest.NewRecorder()
-			kubermaticObj := []ctrlruntimeclient.Object{}
-			machineObj := []ctrlruntimeclient.Object{}
-			kubernetesObj := []ctrlruntimeclient.Object{}
-			kubermaticObj = append(kubermaticObj, tc.ExistingKubermaticObjs...)
-			for _, existingNode := range tc.ExistingNodes {
-				kubernetesObj = append(kubernetesObj, existingNode)
-			}
-			for _, existingMachine := range tc.ExistingMachines {
-				machineObj = append(machineObj, existingMachine)
-			}
-			ep, clientsSets, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, kubernetesObj, machineObj, kubermaticObj, nil, hack.NewTestRouting)
+			ep, clientsSets, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, nil, tc.ExistingKubermaticObjs, nil, hack.NewTestRouting)
 			if err != nil {
 				t.Fatalf("failed to create test endpoint: %v", err)
 			}
@@ -334,89 +404,3018 @@ func TestDeleteMachineDeploymentNode(t *testing.T) {
 				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
 			}
 
-			machines := &clusterv1alpha1.MachineList{}
-			if err := clientsSets.FakeClient.List(context.Background(), machines); err != nil {
-				t.Fatalf("failed to list machines from fake client: %v", err)
-			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
 
-			if machineCount := len(machines.Items); machineCount != tc.ExpectedNodeCount {
-				t.Errorf("Expected %d machines to be gone but got %d", tc.ExpectedNodeCount, machineCount)
+			machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+			if err := clientsSets.FakeClient.List(context.Background(), machineDeployments); err != nil {
+				t.Fatalf("failed to list MachineDeployments: %v", err)
+			}
+			if len(machineDeployments.Items) != 0 {
+				t.Errorf("Expected no machine deployments to be created, but got %d", len(machineDeployments.Items))
 			}
 		})
 	}
 }
 
-func TestListMachineDeployments(t *testing.T) {
+func TestEstimateMachineDeploymentCost(t *testing.T) {
 	t.Parallel()
-	var replicas int32 = 1
-	var paused = false
+	const assumptions = `"a month has 730 hours","the prices are taken from the price catalog shipped with the dashboard, on-demand prices without taxes, discounts, storage and traffic"`
+	awsSeed := test.GenTestSeed(func(seed *kubermaticv1.Seed) {
+		seed.Spec.Datacenters["aws-eu-central-1"] = kubermaticv1.Datacenter{
+			Spec: kubermaticv1.DatacenterSpec{
+				AWS: &kubermaticv1.DatacenterSpecAWS{Region: "eu-central-1"},
+			},
+		}
+	})
+
 	testcases := []struct {
-		Name                       string
-		ExpectedResponse           []apiv1.NodeDeployment
-		HTTPStatus                 int
-		ProjectIDToSync            string
-		ClusterIDToSync            string
-		ExistingProject            *kubermaticv1.Project
-		ExistingKubermaticUser     *kubermaticv1.User
-		ExistingAPIUser            *apiv1.User
-		ExistingCluster            *kubermaticv1.Cluster
-		ExistingMachineDeployments []*clusterv1alpha1.MachineDeployment
-		ExistingKubermaticObjs     []ctrlruntimeclient.Object
+		Name                   string
+		Body                   string
+		ExpectedResponse       string
+		HTTPStatus             int
+		ExistingKubermaticObjs []ctrlruntimeclient.Object
 	}{
-		// scenario 1
 		{
-			Name:            "scenario 1: list machine deployments that belong to the given cluster",
-			HTTPStatus:      http.StatusOK,
-			ClusterIDToSync: test.GenDefaultCluster().Name,
-			ProjectIDToSync: test.GenDefaultProject().Name,
+			Name:             "scenario 1: the cost of a DigitalOcean machine deployment",
+			Body:             `{"spec":{"replicas":3,"template":{"cloud":{"digitalocean":{"size":"s-2vcpu-4gb"}},"operatingSystem":{"ubuntu":{}}}}}`,
+			ExpectedResponse: `{"provider":"digitalocean","region":"ams2","size":"s-2vcpu-4gb","currency":"USD","hourlyPrice":0.03571,"minReplicas":3,"maxReplicas":3,"minMonthlyCost":78.2,"maxMonthlyCost":78.2,"assumptions":["the machine deployment runs 3 nodes",` + assumptions + `]}`,
+			HTTPStatus:       http.StatusOK,
 			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
 				test.GenTestSeed(),
-				test.GenDefaultCluster(),
+				genTestCluster(true),
 			),
-			ExistingAPIUser: test.GenDefaultAPIUser(),
-			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
-				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
-				genTestMachineDeployment("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50,"diskType":"standard"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, nil, false),
-			},
-			ExpectedResponse: []apiv1.NodeDeployment{
-				{
-					ObjectMeta: apiv1.ObjectMeta{
-						ID:   "venus",
-						Name: "venus",
-					},
-					Spec: apiv1.NodeDeploymentSpec{
-						Template: apiv1.NodeSpec{
-							Cloud: apiv1.NodeCloudSpec{
-								Digitalocean: &apiv1.DigitaloceanNodeSpec{
-									Size: "2GB",
-								},
-							},
-							OperatingSystem: apiv1.OperatingSystemSpec{
-								Ubuntu: &apiv1.UbuntuSpec{
-									DistUpgradeOnBoot: true,
-								},
-							},
-							Versions: apiv1.NodeVersionInfo{
-								Kubelet: "v9.9.9",
-							},
-						},
-						Replicas:      replicas,
-						Paused:        &paused,
-						DynamicConfig: ptr.To(false),
-					},
-					Status: clusterv1alpha1.MachineDeploymentStatus{},
-				},
-				{
-					ObjectMeta: apiv1.ObjectMeta{
-						ID:   "mars",
-						Name: "mars",
-					},
-					Spec: apiv1.NodeDeploymentSpec{
-						Template: apiv1.NodeSpec{
-							Cloud: apiv1.NodeCloudSpec{
-								AWS: &apiv1.AWSNodeSpec{
-									InstanceType:     "t2.micro",
-									VolumeSize:       50,
+		},
+		{
+			Name:             "scenario 2: autoscaled machine deployments report the cost of the minimum and maximum replicas",
+			Body:             `{"spec":{"replicas":2,"minReplicas":1,"maxReplicas":5,"template":{"cloud":{"digitalocean":{"size":"s-2vcpu-4gb"}},"operatingSystem":{"ubuntu":{}}}}}`,
+			ExpectedResponse: `{"provider":"digitalocean","region":"ams2","size":"s-2vcpu-4gb","currency":"USD","hourlyPrice":0.03571,"minReplicas":1,"maxReplicas":5,"minMonthlyCost":26.07,"maxMonthlyCost":130.34,"assumptions":["the machine deployment is autoscaled between 1 and 5 nodes",` + assumptions + `]}`,
+			HTTPStatus:       http.StatusOK,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+			),
+		},
+		{
+			Name:             "scenario 3: the cost of an AWS machine deployment",
+			Body:             `{"spec":{"replicas":2,"template":{"cloud":{"aws":{"instanceType":"t3.medium","diskSize":25,"volumeType":"standard"}},"operatingSystem":{"ubuntu":{}}}}}`,
+			ExpectedResponse: `{"provider":"aws","region":"eu-central-1","size":"t3.medium","currency":"USD","hourlyPrice":0.048,"minReplicas":2,"maxReplicas":2,"minMonthlyCost":70.08,"maxMonthlyCost":70.08,"assumptions":["the machine deployment runs 2 nodes",` + assumptions + `]}`,
+			HTTPStatus:       http.StatusOK,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				awsSeed,
+				genTestClusterWithCloud(kubermaticv1.CloudSpec{DatacenterName: "aws-eu-central-1", AWS: &kubermaticv1.AWSCloudSpec{}}, nil),
+			),
+		},
+		{
+			Name:             "scenario 4: unknown sizes return an estimate without costs",
+			Body:             `{"spec":{"replicas":3,"template":{"cloud":{"digitalocean":{"size":"s-64vcpu-512gb"}},"operatingSystem":{"ubuntu":{}}}}}`,
+			ExpectedResponse: `{"provider":"digitalocean","region":"ams2","size":"s-64vcpu-512gb","minReplicas":3,"maxReplicas":3,"assumptions":["the machine deployment runs 3 nodes"],"warnings":["the price of size s-64vcpu-512gb in region ams2 is unknown, the costs are not estimated"]}`,
+			HTTPStatus:       http.StatusOK,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+			),
+		},
+		{
+			Name:             "scenario 5: replicas outside of the autoscaler bounds are rejected",
+			Body:             `{"spec":{"replicas":3,"maxReplicas":2,"template":{"cloud":{"digitalocean":{"size":"s-2vcpu-4gb"}},"operatingSystem":{"ubuntu":{}}}}}`,
+			ExpectedResponse: `{"error":{"code":400,"message":"replica count (3) cannot be higher then autoscaler maxreplicas (2).","reason":"AUTOSCALER_BOUNDS"}}`,
+			HTTPStatus:       http.StatusBadRequest,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+			),
+		},
+		{
+			Name:             "scenario 6: machine deployment cloud provider does not match the cluster provider",
+			Body:             `{"spec":{"replicas":1,"template":{"cloud":{"aws":{"instanceType":"t3.small","diskSize":25,"volumeType":"standard"}},"operatingSystem":{"ubuntu":{}}}}}`,
+			ExpectedResponse: `{"error":{"code":400,"message":"machine deployment cloud provider aws does not match cluster provider digitalocean"}}`,
+			HTTPStatus:       http.StatusBadRequest,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestClusterWithCloud(kubermaticv1.CloudSpec{DatacenterName: "regular-do1", Digitalocean: &kubermaticv1.DigitaloceanCloudSpec{Token: "dummy-token"}}, nil),
+			),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/estimate", test.GenDefaultProject().Name, test.GenDefaultCluster().Name), strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), nil, tc.ExistingKubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func genTestSeedWithInstanceTypeFilter(t *testing.T) *kubermaticv1.Seed {
+	seed := test.GenTestSeed()
+	filter := &apiv2.InstanceTypeFilter{
+		Allowed: []string{"s-1vcpu-1gb", "s-2vcpu-2gb"},
+		Denied:  []string{"s-2vcpu-2gb"},
+	}
+	if err := machine.SetInstanceTypeFilter(seed, "regular-do1", filter); err != nil {
+		t.Fatalf("failed to set instance type filter: %v", err)
+	}
+
+	return seed
+}
+
+func TestCreateMachineDeploymentWithInstanceTypeFilter(t *testing.T) {
+	t.Parallel()
+
+	const body = `{"spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"%s","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`
+
+	testcases := []struct {
+		Name             string
+		Size             string
+		Query            string
+		ExistingAPIUser  *apiv1.User
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:            "scenario 1: an allowed instance type can be used",
+			Size:            "s-1vcpu-1gb",
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			HTTPStatus:      http.StatusCreated,
+		},
+		{
+			Name:             "scenario 2: a denied instance type is rejected",
+			Size:             "s-2vcpu-2gb",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"instance type \"s-2vcpu-2gb\" is denied by the instance type filter of datacenter \"regular-do1\""}}`,
+		},
+		{
+			Name:             "scenario 3: an instance type which is not allowed is rejected",
+			Size:             "c-32",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"instance type \"c-32\" is not allowed by the instance type filter of datacenter \"regular-do1\", allowed instance types are [s-1vcpu-1gb s-2vcpu-2gb]"}}`,
+		},
+		{
+			Name:             "scenario 4: regular users can not override the instance type filter",
+			Size:             "c-32",
+			Query:            "?override_instance_type_filter=true",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"instance type \"c-32\" is not allowed by the instance type filter of datacenter \"regular-do1\", allowed instance types are [s-1vcpu-1gb s-2vcpu-2gb]"}}`,
+		},
+		{
+			Name:            "scenario 5: the admin John can override the instance type filter",
+			Size:            "c-32",
+			Query:           "?override_instance_type_filter=true",
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:      http.StatusCreated,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments%s", test.GenDefaultProject().Name, test.GenDefaultCluster().Name, tc.Query)
+			req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(fmt.Sprintf(body, tc.Size)))
+			res := httptest.NewRecorder()
+
+			kubermaticObjs := test.GenDefaultKubermaticObjects(
+				genTestSeedWithInstanceTypeFilter(t),
+				genTestCluster(true),
+				test.GenAdminUser("John", "john@acme.com", true),
+			)
+			ep, err := test.CreateTestEndpoint(*tc.ExistingAPIUser, []ctrlruntimeclient.Object{}, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+		})
+	}
+}
+
+func genTestSettingsWithSizeLimits(t *testing.T) *kubermaticv1.KubermaticSetting {
+	settings := test.GenDefaultSettings()
+	limits := apiv2.MachineDeploymentSizeLimits{MaxReplicas: 5, DefaultReplicas: 2, MaxAutoscalerMax: 8}
+	if err := machine.SetMachineDeploymentSizeLimits(settings, limits); err != nil {
+		t.Fatalf("failed to set machine deployment size limits: %v", err)
+	}
+
+	return settings
+}
+
+func TestCreateMachineDeploymentWithSizeLimits(t *testing.T) {
+	t.Parallel()
+
+	const body = `{"spec":{%s"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`
+
+	testcases := []struct {
+		Name             string
+		Replicas         string
+		Query            string
+		ExistingAPIUser  *apiv1.User
+		HTTPStatus       int
+		ExpectedReplicas int32
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: replicas within the global limit can be used",
+			Replicas:         `"replicas":3,`,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusCreated,
+			ExpectedReplicas: 3,
+		},
+		{
+			Name:             "scenario 2: the default replicas are used if none are specified",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusCreated,
+			ExpectedReplicas: 2,
+		},
+		{
+			Name:             "scenario 3: replicas above the global limit are rejected",
+			Replicas:         `"replicas":6,`,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"replica count (6) exceeds the global limit of 5 replicas per machine deployment","reason":"SIZE_LIMIT"}}`,
+		},
+		{
+			Name:             "scenario 4: an autoscaler maximum above the global limit is rejected",
+			Replicas:         `"replicas":3,"minReplicas":1,"maxReplicas":10,`,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"autoscaler maxreplicas (10) exceeds the global limit of 8","reason":"SIZE_LIMIT"}}`,
+		},
+		{
+			Name:             "scenario 5: regular users can not override the global limit",
+			Replicas:         `"replicas":6,`,
+			Query:            "?override_size_limits=true",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"replica count (6) exceeds the global limit of 5 replicas per machine deployment","reason":"SIZE_LIMIT"}}`,
+		},
+		{
+			Name:             "scenario 6: the admin John can override the global limit",
+			Replicas:         `"replicas":6,`,
+			Query:            "?override_size_limits=true",
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusCreated,
+			ExpectedReplicas: 6,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments%s", test.GenDefaultProject().Name, test.GenDefaultCluster().Name, tc.Query)
+			req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(fmt.Sprintf(body, tc.Replicas)))
+			res := httptest.NewRecorder()
+
+			kubermaticObjs := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+				genTestSettingsWithSizeLimits(t),
+				test.GenAdminUser("John", "john@acme.com", true),
+			)
+			ep, err := test.CreateTestEndpoint(*tc.ExistingAPIUser, []ctrlruntimeclient.Object{}, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			nd := &apiv1.NodeDeployment{}
+			if err := json.Unmarshal(res.Body.Bytes(), nd); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if nd.Spec.Replicas != tc.ExpectedReplicas {
+				t.Fatalf("Expected %d replicas, got %d", tc.ExpectedReplicas, nd.Spec.Replicas)
+			}
+		})
+	}
+}
+
+func TestMachineDeploymentNodeQuota(t *testing.T) {
+	t.Parallel()
+
+	const (
+		createBody   = `{"spec":{"replicas":%d,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`
+		providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+	)
+
+	basePath := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+
+	testcases := []struct {
+		Name             string
+		Method           string
+		Path             string
+		Body             string
+		Quota            apiv2.ProjectNodeQuota
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: creating a machine deployment which would exceed the node quota is forbidden",
+			Method:           http.MethodPost,
+			Path:             basePath,
+			Body:             fmt.Sprintf(createBody, 1),
+			Quota:            apiv2.ProjectNodeQuota{MaxNodes: 4},
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"node quota exceeded: the project has 4 of 4 nodes, 1 more would exceed the quota","details":["nodes: 4, limit: 4","machine deployments: 2, limit: 0"],"reason":"NODE_QUOTA_EXCEEDED"}}`,
+		},
+		{
+			Name:             "scenario 2: creating a machine deployment which would exceed the machine deployment quota is forbidden",
+			Method:           http.MethodPost,
+			Path:             basePath,
+			Body:             fmt.Sprintf(createBody, 1),
+			Quota:            apiv2.ProjectNodeQuota{MaxNodes: 10, MaxMachineDeployments: 2},
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"node quota exceeded: the project has 2 of 2 machine deployments, 1 more would exceed the quota","details":["nodes: 4, limit: 10","machine deployments: 2, limit: 2"],"reason":"NODE_QUOTA_EXCEEDED"}}`,
+		},
+		{
+			Name:       "scenario 3: creating a machine deployment within the quota is allowed",
+			Method:     http.MethodPost,
+			Path:       basePath,
+			Body:       fmt.Sprintf(createBody, 6),
+			Quota:      apiv2.ProjectNodeQuota{MaxNodes: 10, MaxMachineDeployments: 3},
+			HTTPStatus: http.StatusCreated,
+		},
+		{
+			Name:       "scenario 4: scaling a machine deployment within the node quota is allowed",
+			Method:     http.MethodPatch,
+			Path:       basePath + "/venus",
+			Body:       `{"spec":{"replicas":2}}`,
+			Quota:      apiv2.ProjectNodeQuota{MaxNodes: 5},
+			HTTPStatus: http.StatusOK,
+		},
+		{
+			Name:             "scenario 5: scaling a machine deployment beyond the node quota is forbidden",
+			Method:           http.MethodPatch,
+			Path:             basePath + "/venus",
+			Body:             `{"spec":{"replicas":3}}`,
+			Quota:            apiv2.ProjectNodeQuota{MaxNodes: 5},
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"node quota exceeded: the project has 4 of 5 nodes, 2 more would exceed the quota","details":["nodes: 4, limit: 5","machine deployments: 2, limit: 0"],"reason":"NODE_QUOTA_EXCEEDED"}}`,
+		},
+		{
+			Name:             "scenario 6: the autoscaler maximum is counted against the node quota",
+			Method:           http.MethodPatch,
+			Path:             basePath + "/venus",
+			Body:             `{"spec":{"minReplicas":1,"maxReplicas":4}}`,
+			Quota:            apiv2.ProjectNodeQuota{MaxNodes: 5},
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"node quota exceeded: the project has 4 of 5 nodes, 3 more would exceed the quota","details":["nodes: 4, limit: 5","machine deployments: 2, limit: 0"],"reason":"NODE_QUOTA_EXCEEDED"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			project := test.GenDefaultProject()
+			if err := machine.SetProjectNodeQuota(project, tc.Quota); err != nil {
+				t.Fatalf("failed to set node quota: %v", err)
+			}
+
+			// venus counts with its single replica, mars with its autoscaler maximum
+			mars := genTestMachineDeployment("mars", providerSpec, nil, false)
+			mars.Annotations = map[string]string{machine.AutoscalerMinSizeAnnotation: "1", machine.AutoscalerMaxSizeAnnotation: "3"}
+			kubermaticObjs := []ctrlruntimeclient.Object{
+				project,
+				test.GenDefaultUser(),
+				test.GenDefaultOwnerBinding(),
+				test.GenTestSeed(),
+				genTestCluster(true),
+				genTestMachineDeployment("venus", providerSpec, nil, false),
+				mars,
+			}
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), []ctrlruntimeclient.Object{}, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(tc.Method, tc.Path, strings.NewReader(tc.Body)))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+		})
+	}
+}
+
+// TestCreateMachineDeploymentMetrics is not run in parallel, other tests would change the counters.
+func TestCreateMachineDeploymentMetrics(t *testing.T) {
+	const (
+		body            = `{"spec":{"replicas":6,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`
+		requestLabels   = `code="400",endpoint="createMachineDeployment"`
+		validationLabel = `endpoint="createMachineDeployment",reason="SIZE_LIMIT"`
+	)
+
+	requestsBefore := test.ScrapeMetric(t, metrics.EndpointRequestsTotalName, requestLabels)
+	failuresBefore := test.ScrapeMetric(t, metrics.MachineDeploymentValidationFailuresTotalName, validationLabel)
+
+	path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	res := httptest.NewRecorder()
+
+	kubermaticObjs := test.GenDefaultKubermaticObjects(test.GenTestSeed(), genTestCluster(true), genTestSettingsWithSizeLimits(t))
+	ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), []ctrlruntimeclient.Object{}, kubermaticObjs, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusBadRequest {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusBadRequest, res.Code, res.Body.String())
+	}
+	if requests := test.ScrapeMetric(t, metrics.EndpointRequestsTotalName, requestLabels); requests-requestsBefore != 1 {
+		t.Fatalf("Expected the failed requests to increase by 1, got %v -> %v", requestsBefore, requests)
+	}
+	if failures := test.ScrapeMetric(t, metrics.MachineDeploymentValidationFailuresTotalName, validationLabel); failures-failuresBefore != 1 {
+		t.Fatalf("Expected the validation failures to increase by 1, got %v -> %v", failuresBefore, failures)
+	}
+}
+
+func TestCreateMachineDeploymentWithGenerateName(t *testing.T) {
+	t.Parallel()
+
+	const spec = `"spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}`
+
+	testcases := []struct {
+		Name             string
+		Body             string
+		Collisions       int
+		ExpectedResponse string
+		HTTPStatus       int
+	}{
+		{
+			Name:       "scenario 1: the name is generated from generateName",
+			Body:       fmt.Sprintf(`{"generateName":"workers-eu-central-1a-",%s}`, spec),
+			HTTPStatus: http.StatusCreated,
+		},
+		{
+			Name:       "scenario 2: a new name is generated when the generated name is taken",
+			Body:       fmt.Sprintf(`{"generateName":"workers-eu-central-1a-",%s}`, spec),
+			Collisions: 2,
+			HTTPStatus: http.StatusCreated,
+		},
+		{
+			Name:             "scenario 3: name and generateName are mutually exclusive",
+			Body:             fmt.Sprintf(`{"name":"workers","generateName":"workers-",%s}`, spec),
+			ExpectedResponse: `{"error":{"code":400,"message":"name and generateName are mutually exclusive"}}`,
+			HTTPStatus:       http.StatusBadRequest,
+		},
+		{
+			Name:             "scenario 4: the generated name must not exceed 63 characters",
+			Body:             fmt.Sprintf(`{"generateName":"%s",%s}`, strings.Repeat("w", 59), spec),
+			ExpectedResponse: fmt.Sprintf(`{"error":{"code":400,"message":"invalid generateName \"%[1]s\", it must be a valid DNS label of at most 63 characters including the 5 character suffix: must be no more than 63 characters"}}`, strings.Repeat("w", 59)),
+			HTTPStatus:       http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var createAttempts int
+			funcs := interceptor.Funcs{
+				Create: func(ctx context.Context, client ctrlruntimeclient.WithWatch, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.CreateOption) error {
+					if _, ok := obj.(*clusterv1alpha1.MachineDeployment); ok {
+						createAttempts++
+						if createAttempts <= tc.Collisions {
+							return apierrors.NewAlreadyExists(schema.GroupResource{Group: clusterv1alpha1.SchemeGroupVersion.Group, Resource: "machinedeployments"}, obj.GetName())
+						}
+					}
+					return client.Create(ctx, obj, opts...)
+				},
+			}
+
+			kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), genTestCluster(true))
+			ep, err := test.CreateTestEndpointWithUserClusterInterceptor(*test.GenDefaultAPIUser(), nil, kubermaticObj, nil, hack.NewTestRouting, funcs)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, test.GenDefaultCluster().Name), strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			nd := &apiv1.NodeDeployment{}
+			if err := json.Unmarshal(res.Body.Bytes(), nd); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			if !strings.HasPrefix(nd.Name, "workers-eu-central-1a-") || len(nd.Name) != len("workers-eu-central-1a-")+5 {
+				t.Fatalf("expected a name generated from workers-eu-central-1a-, got %q", nd.Name)
+			}
+			if createAttempts != tc.Collisions+1 {
+				t.Fatalf("expected %d create attempts, got %d", tc.Collisions+1, createAttempts)
+			}
+		})
+	}
+}
+
+func TestCreateMachineDeploymentWithDuplicateName(t *testing.T) {
+	t.Parallel()
+
+	const body = `{"name":"venus","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`
+	const expectedResponse = `{"error":{"code":409,"message":"machine deployment \"venus\" already exists in cluster defClusterID"}}`
+
+	t.Run("scenario 1: the second machine deployment with the same name is rejected", func(t *testing.T) {
+		kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), genTestCluster(true))
+		ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), []ctrlruntimeclient.Object{}, kubermaticObj, nil, hack.NewTestRouting)
+		if err != nil {
+			t.Fatalf("failed to create test endpoint: %v", err)
+		}
+
+		path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+		for i, expectedStatus := range []int{http.StatusCreated, http.StatusConflict} {
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+
+			if res.Code != expectedStatus {
+				t.Fatalf("Expected HTTP status code %d for request %d, got %d: %s", expectedStatus, i+1, res.Code, res.Body.String())
+			}
+			if expectedStatus == http.StatusConflict {
+				test.CompareWithResult(t, res, expectedResponse)
+			}
+		}
+
+		res := httptest.NewRecorder()
+		ep.ServeHTTP(res, httptest.NewRequest(http.MethodPost, path+"/validate", strings.NewReader(body)))
+		if res.Code != http.StatusConflict {
+			t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusConflict, res.Code, res.Body.String())
+		}
+		test.CompareWithResult(t, res, expectedResponse)
+	})
+
+	t.Run("scenario 2: a machine deployment created concurrently with the same name is reported", func(t *testing.T) {
+		funcs := interceptor.Funcs{
+			Create: func(ctx context.Context, client ctrlruntimeclient.WithWatch, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.CreateOption) error {
+				if _, ok := obj.(*clusterv1alpha1.MachineDeployment); ok {
+					return apierrors.NewAlreadyExists(schema.GroupResource{Group: clusterv1alpha1.SchemeGroupVersion.Group, Resource: "machinedeployments"}, obj.GetName())
+				}
+				return client.Create(ctx, obj, opts...)
+			},
+		}
+
+		kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), genTestCluster(true))
+		ep, err := test.CreateTestEndpointWithUserClusterInterceptor(*test.GenDefaultAPIUser(), nil, kubermaticObj, nil, hack.NewTestRouting, funcs)
+		if err != nil {
+			t.Fatalf("failed to create test endpoint: %v", err)
+		}
+
+		path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+		res := httptest.NewRecorder()
+		ep.ServeHTTP(res, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+
+		if res.Code != http.StatusConflict {
+			t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusConflict, res.Code, res.Body.String())
+		}
+		test.CompareWithResult(t, res, expectedResponse)
+	})
+}
+
+func TestCreateMachineDeploymentWithDefaults(t *testing.T) {
+	t.Parallel()
+
+	const network = `"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}`
+	const expectedResponse = `{"id":"venus","name":"venus","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"%s","backups":false,"ipv6":false,"monitoring":false,"tags":["kubernetes","kubernetes-cluster-defClusterID","system-cluster-defClusterID","system-project-my-first-project-ID"]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"versions":{"kubelet":"9.9.9"},"labels":{"system/cluster":"defClusterID","system/project":"my-first-project-ID"}},"paused":false,"dynamicConfig":false},"status":{}}`
+
+	testcases := []struct {
+		Name             string
+		Body             string
+		Defaults         *apiv1.NodeSpecDefaults
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: the built-in defaults of the provider are merged into the sparse spec",
+			Body:             fmt.Sprintf(`{"name":"venus","useDefaults":true,"spec":{"replicas":1,"template":{%s}}}`, network),
+			ExpectedResponse: fmt.Sprintf(expectedResponse, "s-2vcpu-4gb"),
+		},
+		{
+			Name:             "scenario 2: the defaults configured by the admins are merged into the sparse spec",
+			Body:             fmt.Sprintf(`{"name":"venus","useDefaults":true,"spec":{"replicas":1,"template":{%s}}}`, network),
+			Defaults:         &apiv1.NodeSpecDefaults{InstanceType: "s-4vcpu-8gb"},
+			ExpectedResponse: fmt.Sprintf(expectedResponse, "s-4vcpu-8gb"),
+		},
+		{
+			Name:             "scenario 3: fields which are set are kept",
+			Body:             fmt.Sprintf(`{"name":"venus","useDefaults":true,"spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb"}},%s}}}`, network),
+			Defaults:         &apiv1.NodeSpecDefaults{InstanceType: "s-4vcpu-8gb"},
+			ExpectedResponse: fmt.Sprintf(expectedResponse, "s-1vcpu-1gb"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			seed := test.GenTestSeed()
+			if err := machine.SetNodeSpecDefaults(seed, test.GenDefaultCluster().Spec.Cloud.DatacenterName, tc.Defaults); err != nil {
+				t.Fatalf("failed to set node spec defaults: %v", err)
+			}
+			kubermaticObj := test.GenDefaultKubermaticObjects(seed, genTestCluster(true))
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), []ctrlruntimeclient.Object{}, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPost, path, strings.NewReader(tc.Body)))
+
+			if res.Code != http.StatusCreated {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusCreated, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func TestPatchMachineDeploymentRetriesOnConflict(t *testing.T) {
+	t.Parallel()
+
+	const scaleDownDisabledAnnotation = "cluster-autoscaler.kubernetes.io/scale-down-disabled"
+
+	existingMachineDeployment := genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false)
+	// the user cluster client is backed by the same fake client as the kubermatic objects
+	kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), genTestCluster(true), existingMachineDeployment)
+
+	var (
+		userClusterClient ctrlruntimeclient.Client
+		updateAttempts    int
+	)
+	funcs := interceptor.Funcs{
+		Update: func(ctx context.Context, client ctrlruntimeclient.WithWatch, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.UpdateOption) error {
+			userClusterClient = client
+			updateAttempts++
+			if updateAttempts > 1 {
+				return client.Update(ctx, obj, opts...)
+			}
+
+			// simulate the cluster-autoscaler scaling the machine deployment between the read and the update
+			md := &clusterv1alpha1.MachineDeployment{}
+			if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(obj), md); err != nil {
+				return err
+			}
+			md.Spec.Replicas = ptr.To[int32](4)
+			md.Annotations = map[string]string{scaleDownDisabledAnnotation: "true"}
+			if err := client.Update(ctx, md); err != nil {
+				return err
+			}
+
+			return apierrors.NewConflict(schema.GroupResource{Group: clusterv1alpha1.SchemeGroupVersion.Group, Resource: "machinedeployments"}, obj.GetName(), errors.New("the object has been modified"))
+		},
+	}
+
+	ep, err := test.CreateTestEndpointWithUserClusterInterceptor(*test.GenDefaultAPIUser(), nil, kubermaticObj, nil, hack.NewTestRouting, funcs)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/venus",
+		test.GenDefaultProject().Name, test.GenDefaultCluster().Name), strings.NewReader(`{"spec":{"minReplicas":1,"maxReplicas":8}}`))
+	res := httptest.NewRecorder()
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+	if updateAttempts != 2 {
+		t.Fatalf("expected the update to be retried once, got %d attempts", updateAttempts)
+	}
+
+	md := &clusterv1alpha1.MachineDeployment{}
+	if err := userClusterClient.Get(context.Background(), types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "venus"}, md); err != nil {
+		t.Fatalf("failed to get machine deployment: %v", err)
+	}
+	if replicas := ptr.Deref(md.Spec.Replicas, 0); replicas != 4 {
+		t.Errorf("expected the replicas set by the autoscaler to be kept, got %d", replicas)
+	}
+	if md.Annotations[scaleDownDisabledAnnotation] != "true" {
+		t.Errorf("expected the annotation set by the autoscaler to be kept, got %v", md.Annotations)
+	}
+	if md.Annotations[machine.AutoscalerMinSizeAnnotation] != "1" || md.Annotations[machine.AutoscalerMaxSizeAnnotation] != "8" {
+		t.Errorf("expected the patched autoscaler annotations to be set, got %v", md.Annotations)
+	}
+}
+
+func TestPatchMachineDeploymentRollout(t *testing.T) {
+	t.Parallel()
+
+	ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, nil, test.GenDefaultKubermaticObjects(test.GenTestSeed(), genTestCluster(true)), nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	mdURL := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+	req := httptest.NewRequest(http.MethodPost, mdURL, strings.NewReader(`{"name":"venus","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`))
+	res := httptest.NewRecorder()
+	ep.ServeHTTP(res, req)
+	if res.Code != http.StatusCreated {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusCreated, res.Code, res.Body.String())
+	}
+
+	getMachineDeployment := func() *clusterv1alpha1.MachineDeployment {
+		md := &clusterv1alpha1.MachineDeployment{}
+		if err := clients.FakeClient.Get(context.Background(), types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "venus"}, md); err != nil {
+			t.Fatalf("failed to get machine deployment: %v", err)
+		}
+		return md
+	}
+	initialTemplate := getMachineDeployment().Spec.Template
+
+	// the scenarios run in order, each one patches the machine deployment of the previous one
+	testcases := []struct {
+		Name                     string
+		Body                     string
+		ExpectedRolloutTriggered string
+		ExpectedReplicas         int32
+	}{
+		{
+			Name:                     "scenario 1: changing the replicas doesn't roll out the machines",
+			Body:                     `{"spec":{"replicas":3}}`,
+			ExpectedRolloutTriggered: "false",
+			ExpectedReplicas:         3,
+		},
+		{
+			Name:                     "scenario 2: changing the annotations doesn't roll out the machines",
+			Body:                     `{"annotations":{"example.com/owner":"team-a"}}`,
+			ExpectedRolloutTriggered: "false",
+			ExpectedReplicas:         3,
+		},
+		{
+			Name:                     "scenario 3: changing the machine template rolls out the machines",
+			Body:                     `{"spec":{"template":{"cloud":{"digitalocean":{"size":"s-2vcpu-2gb"}}}}}`,
+			ExpectedRolloutTriggered: "true",
+			ExpectedReplicas:         3,
+		},
+	}
+
+	for _, tc := range testcases {
+		req := httptest.NewRequest(http.MethodPatch, mdURL+"/venus", strings.NewReader(tc.Body))
+		res := httptest.NewRecorder()
+		ep.ServeHTTP(res, req)
+
+		if res.Code != http.StatusOK {
+			t.Fatalf("%s: expected HTTP status code %d, got %d: %s", tc.Name, http.StatusOK, res.Code, res.Body.String())
+		}
+		if rolloutTriggered := res.Header().Get("X-Rollout-Triggered"); rolloutTriggered != tc.ExpectedRolloutTriggered {
+			t.Errorf("%s: expected the X-Rollout-Triggered header to be %q, got %q", tc.Name, tc.ExpectedRolloutTriggered, rolloutTriggered)
+		}
+
+		md := getMachineDeployment()
+		if replicas := ptr.Deref(md.Spec.Replicas, 0); replicas != tc.ExpectedReplicas {
+			t.Errorf("%s: expected %d replicas, got %d", tc.Name, tc.ExpectedReplicas, replicas)
+		}
+		if templateChanged := !reflect.DeepEqual(md.Spec.Template, initialTemplate); templateChanged != (tc.ExpectedRolloutTriggered == "true") {
+			t.Errorf("%s: expected the machine template to be changed: %s, got %v", tc.Name, tc.ExpectedRolloutTriggered, templateChanged)
+		}
+	}
+}
+
+func TestPatchMachineDeploymentWithInstanceTypeFilter(t *testing.T) {
+	t.Parallel()
+
+	// the existing machine deployment uses the "2GB" size, which is not allowed by the filter
+	const providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+
+	testcases := []struct {
+		Name            string
+		Patch           string
+		Query           string
+		ExistingAPIUser *apiv1.User
+		HTTPStatus      int
+	}{
+		{
+			Name:            "scenario 1: machine deployments can be changed without changing the instance type",
+			Patch:           `{"spec":{"replicas":2}}`,
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			HTTPStatus:      http.StatusOK,
+		},
+		{
+			Name:            "scenario 2: the instance type can be changed to an allowed one",
+			Patch:           `{"spec":{"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb"}}}}}`,
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			HTTPStatus:      http.StatusOK,
+		},
+		{
+			Name:            "scenario 3: the instance type can not be changed to a denied one",
+			Patch:           `{"spec":{"template":{"cloud":{"digitalocean":{"size":"s-2vcpu-2gb"}}}}}`,
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			HTTPStatus:      http.StatusForbidden,
+		},
+		{
+			Name:            "scenario 4: the admin John can override the instance type filter",
+			Patch:           `{"spec":{"template":{"cloud":{"digitalocean":{"size":"s-2vcpu-2gb"}}}}}`,
+			Query:           "?override_instance_type_filter=true",
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:      http.StatusOK,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/venus%s", test.GenDefaultProject().Name, test.GenDefaultCluster().Name, tc.Query)
+			req := httptest.NewRequest(http.MethodPatch, path, strings.NewReader(tc.Patch))
+			res := httptest.NewRecorder()
+
+			kubermaticObjs := test.GenDefaultKubermaticObjects(
+				genTestSeedWithInstanceTypeFilter(t),
+				genTestCluster(true),
+				test.GenAdminUser("John", "john@acme.com", true),
+			)
+			machineObjs := []ctrlruntimeclient.Object{test.GenTestMachineDeployment("venus", providerSpec, nil, false)}
+			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, []ctrlruntimeclient.Object{}, machineObjs, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+		})
+	}
+}
+
+func TestMachineDeploymentOperatingSystemSecrets(t *testing.T) {
+	t.Parallel()
+
+	const rhelProviderSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"rhel", "operatingSystemSpec":{"distUpgradeOnBoot":true,"rhelSubscriptionManagerUser":"admin","rhelSubscriptionManagerPassword":"secret-password","rhsmOfflineToken":"offline-token"}}`
+	const ubuntuProviderSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+
+	testcases := []struct {
+		Name                        string
+		ProviderSpec                string
+		Patch                       string
+		ExpectedOperatingSystem     string
+		ExpectedOperatingSystemSpec map[string]interface{}
+	}{
+		{
+			Name:                    "scenario 1: the subscription secrets are returned as true and kept if sent back",
+			ProviderSpec:            rhelProviderSpec,
+			Patch:                   `{"spec":{"template":{"operatingSystem":{"rhel":{"distUpgradeOnBoot":false,"rhelSubscriptionManagerUser":true,"rhelSubscriptionManagerPassword":true,"rhsmOfflineToken":true}}}}}`,
+			ExpectedOperatingSystem: `{"rhel":{"distUpgradeOnBoot":false,"rhelSubscriptionManagerUser":true,"rhelSubscriptionManagerPassword":true,"rhsmOfflineToken":true}}`,
+			ExpectedOperatingSystemSpec: map[string]interface{}{
+				"rhelSubscriptionManagerUser":     "admin",
+				"rhelSubscriptionManagerPassword": "secret-password",
+				"rhsmOfflineToken":                "offline-token",
+			},
+		},
+		{
+			Name:                    "scenario 2: the subscription secrets are kept if left out and cleared with null",
+			ProviderSpec:            rhelProviderSpec,
+			Patch:                   `{"spec":{"template":{"operatingSystem":{"rhel":{"distUpgradeOnBoot":true,"rhelSubscriptionManagerPassword":"new-password","rhsmOfflineToken":null}}}}}`,
+			ExpectedOperatingSystem: `{"rhel":{"distUpgradeOnBoot":true,"rhelSubscriptionManagerUser":true,"rhelSubscriptionManagerPassword":true}}`,
+			ExpectedOperatingSystemSpec: map[string]interface{}{
+				"rhelSubscriptionManagerUser":     "admin",
+				"rhelSubscriptionManagerPassword": "new-password",
+				"rhsmOfflineToken":                nil,
+			},
+		},
+		{
+			Name:                    "scenario 3: the Ubuntu Pro token is stored in the operating system spec",
+			ProviderSpec:            ubuntuProviderSpec,
+			Patch:                   `{"spec":{"template":{"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":true,"ubuntuProToken":"pro-token"}}}}}`,
+			ExpectedOperatingSystem: `{"ubuntu":{"distUpgradeOnBoot":true,"ubuntuProToken":true}}`,
+			ExpectedOperatingSystemSpec: map[string]interface{}{
+				"ubuntuProToken": "pro-token",
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), genTestCluster(true), genTestMachineDeployment("venus", tc.ProviderSpec, nil, false))
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, nil, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/venus", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPatch, path, strings.NewReader(tc.Patch)))
+			if res.Code != http.StatusOK {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+			}
+
+			res = httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodGet, path, nil))
+			if res.Code != http.StatusOK {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+			}
+			nd := struct {
+				Spec struct {
+					Template struct {
+						OperatingSystem json.RawMessage `json:"operatingSystem"`
+					} `json:"template"`
+				} `json:"spec"`
+			}{}
+			if err := json.Unmarshal(res.Body.Bytes(), &nd); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if string(nd.Spec.Template.OperatingSystem) != tc.ExpectedOperatingSystem {
+				t.Errorf("Expected operating system %s, got %s", tc.ExpectedOperatingSystem, nd.Spec.Template.OperatingSystem)
+			}
+
+			md := &clusterv1alpha1.MachineDeployment{}
+			if err := clients.FakeClient.Get(context.Background(), types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "venus"}, md); err != nil {
+				t.Fatalf("failed to get machine deployment: %v", err)
+			}
+			config, err := providerconfig.GetConfig(md.Spec.Template.Spec.ProviderSpec)
+			if err != nil {
+				t.Fatalf("failed to decode provider spec: %v", err)
+			}
+			osSpec := map[string]interface{}{}
+			if err := json.Unmarshal(config.OperatingSystemSpec.Raw, &osSpec); err != nil {
+				t.Fatalf("failed to decode operating system spec: %v", err)
+			}
+			for key, expected := range tc.ExpectedOperatingSystemSpec {
+				if osSpec[key] != expected {
+					t.Errorf("Expected %s to be stored as %v, got %v", key, expected, osSpec[key])
+				}
+			}
+		})
+	}
+}
+
+func TestMachineDeploymentRollingUpdateStrategy(t *testing.T) {
+	t.Parallel()
+
+	const (
+		providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+		createBody   = `{"name":"mars","spec":{"replicas":1,%s"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`
+	)
+
+	testcases := []struct {
+		Name                   string
+		Method                 string
+		MachineDeploymentID    string
+		Body                   string
+		HTTPStatus             int
+		ExpectedResponse       string
+		ExpectedMaxSurge       string
+		ExpectedMaxUnavailable string
+	}{
+		{
+			Name:                   "scenario 1: create a machine deployment with a rolling update strategy",
+			Method:                 http.MethodPost,
+			MachineDeploymentID:    "mars",
+			Body:                   fmt.Sprintf(createBody, `"maxSurge":"25%","maxUnavailable":"1",`),
+			HTTPStatus:             http.StatusCreated,
+			ExpectedMaxSurge:       "25%",
+			ExpectedMaxUnavailable: "1",
+		},
+		{
+			Name:                "scenario 2: create a machine deployment without a rolling update strategy",
+			Method:              http.MethodPost,
+			MachineDeploymentID: "mars",
+			Body:                fmt.Sprintf(createBody, ""),
+			HTTPStatus:          http.StatusCreated,
+		},
+		{
+			Name:             "scenario 3: maxSurge and maxUnavailable can not be both zero",
+			Method:           http.MethodPost,
+			Body:             fmt.Sprintf(createBody, `"maxSurge":"0","maxUnavailable":"0%",`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: maxSurge and maxUnavailable cannot be both zero"}}`,
+		},
+		{
+			Name:             "scenario 4: maxSurge has to be a number or percentage",
+			Method:           http.MethodPost,
+			Body:             fmt.Sprintf(createBody, `"maxSurge":"many",`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: maxSurge 'many' must be a non-negative number or percentage, e.g. 1 or 25%"}}`,
+		},
+		{
+			Name:                "scenario 5: an existing machine deployment without a rolling update strategy returns empty fields",
+			Method:              http.MethodGet,
+			MachineDeploymentID: "venus",
+			HTTPStatus:          http.StatusOK,
+		},
+		{
+			Name:                   "scenario 6: patch the rolling update strategy of an existing machine deployment",
+			Method:                 http.MethodPatch,
+			MachineDeploymentID:    "venus",
+			Body:                   `{"spec":{"maxUnavailable":"2"}}`,
+			HTTPStatus:             http.StatusOK,
+			ExpectedMaxUnavailable: "2",
+		},
+		{
+			Name:                "scenario 7: patching an invalid rolling update strategy is rejected",
+			Method:              http.MethodPatch,
+			MachineDeploymentID: "venus",
+			Body:                `{"spec":{"maxSurge":"0"}}`,
+			HTTPStatus:          http.StatusBadRequest,
+			ExpectedResponse:    `{"error":{"code":400,"message":"node deployment validation failed: maxSurge and maxUnavailable cannot be both zero"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			basePath := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+			path := basePath
+			if tc.Method != http.MethodPost {
+				path = fmt.Sprintf("%s/%s", basePath, tc.MachineDeploymentID)
+			}
+			req := httptest.NewRequest(tc.Method, path, strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+
+			kubermaticObjs := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+			)
+			machineObjs := []ctrlruntimeclient.Object{test.GenTestMachineDeployment("venus", providerSpec, nil, false)}
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, machineObjs, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			// the strategy has to be returned by the request itself and by a subsequent get
+			for _, body := range []string{res.Body.String(), getMachineDeployment(t, ep, fmt.Sprintf("%s/%s", basePath, tc.MachineDeploymentID))} {
+				nd := &apiv1.NodeDeployment{}
+				if err := json.Unmarshal([]byte(body), nd); err != nil {
+					t.Fatalf("failed to unmarshal node deployment: %v", err)
+				}
+				if nd.Spec.MaxSurge != tc.ExpectedMaxSurge || nd.Spec.MaxUnavailable != tc.ExpectedMaxUnavailable {
+					t.Fatalf("expected maxSurge %q and maxUnavailable %q, got %q and %q", tc.ExpectedMaxSurge, tc.ExpectedMaxUnavailable, nd.Spec.MaxSurge, nd.Spec.MaxUnavailable)
+				}
+			}
+		})
+	}
+}
+
+func TestMachineDeploymentAutoRepair(t *testing.T) {
+	t.Parallel()
+
+	const (
+		providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+		createBody   = `{"name":"mars","spec":{"replicas":1,%s"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`
+	)
+
+	testcases := []struct {
+		Name                string
+		Method              string
+		MachineDeploymentID string
+		Body                string
+		HTTPStatus          int
+		ExpectedResponse    string
+		ExpectedAutoRepair  *apiv1.NodeDeploymentAutoRepair
+	}{
+		{
+			Name:                "scenario 1: create a machine deployment with auto-repair",
+			Method:              http.MethodPost,
+			MachineDeploymentID: "mars",
+			Body:                fmt.Sprintf(createBody, `"autoRepair":{"enabled":true,"unhealthyTimeout":"10m"},`),
+			HTTPStatus:          http.StatusCreated,
+			ExpectedAutoRepair:  &apiv1.NodeDeploymentAutoRepair{Enabled: true, UnhealthyTimeout: "10m"},
+		},
+		{
+			Name:             "scenario 2: the unhealthy timeout has to be at least 5 minutes",
+			Method:           http.MethodPost,
+			Body:             fmt.Sprintf(createBody, `"autoRepair":{"enabled":true,"unhealthyTimeout":"90s"},`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: auto-repair unhealthyTimeout '90s' must be at least 5m0s"}}`,
+		},
+		{
+			Name:                "scenario 3: an existing machine deployment without auto-repair returns no settings",
+			Method:              http.MethodGet,
+			MachineDeploymentID: "venus",
+			HTTPStatus:          http.StatusOK,
+		},
+		{
+			Name:                "scenario 4: patch the auto-repair settings of an existing machine deployment",
+			Method:              http.MethodPatch,
+			MachineDeploymentID: "venus",
+			Body:                `{"spec":{"autoRepair":{"enabled":false,"unhealthyTimeout":"1h"}}}`,
+			HTTPStatus:          http.StatusOK,
+			ExpectedAutoRepair:  &apiv1.NodeDeploymentAutoRepair{Enabled: false, UnhealthyTimeout: "1h"},
+		},
+		{
+			Name:                "scenario 5: patching auto-repair to null clears the settings",
+			Method:              http.MethodPatch,
+			MachineDeploymentID: "earth",
+			Body:                `{"spec":{"autoRepair":null}}`,
+			HTTPStatus:          http.StatusOK,
+		},
+		{
+			Name:                "scenario 6: patching an invalid unhealthy timeout is rejected",
+			Method:              http.MethodPatch,
+			MachineDeploymentID: "earth",
+			Body:                `{"spec":{"autoRepair":{"unhealthyTimeout":"ten minutes"}}}`,
+			HTTPStatus:          http.StatusBadRequest,
+			ExpectedResponse:    `{"error":{"code":400,"message":"node deployment validation failed: auto-repair unhealthyTimeout 'ten minutes' must be a duration, e.g. 10m"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			basePath := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+			path := basePath
+			if tc.Method != http.MethodPost {
+				path = fmt.Sprintf("%s/%s", basePath, tc.MachineDeploymentID)
+			}
+			req := httptest.NewRequest(tc.Method, path, strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+
+			kubermaticObjs := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+			)
+			autoRepairMachineDeployment := test.GenTestMachineDeployment("earth", providerSpec, nil, false)
+			autoRepairMachineDeployment.Annotations = map[string]string{
+				machine.AutoRepairEnabledAnnotation:          "true",
+				machine.AutoRepairUnhealthyTimeoutAnnotation: "15m",
+			}
+			machineObjs := []ctrlruntimeclient.Object{test.GenTestMachineDeployment("venus", providerSpec, nil, false), autoRepairMachineDeployment}
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, machineObjs, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			// the settings have to be returned by the request itself and by a subsequent get
+			for _, body := range []string{res.Body.String(), getMachineDeployment(t, ep, fmt.Sprintf("%s/%s", basePath, tc.MachineDeploymentID))} {
+				nd := &apiv1.NodeDeployment{}
+				if err := json.Unmarshal([]byte(body), nd); err != nil {
+					t.Fatalf("failed to unmarshal node deployment: %v", err)
+				}
+				if !reflect.DeepEqual(tc.ExpectedAutoRepair, nd.Spec.AutoRepair) {
+					t.Fatalf("expected auto-repair settings %+v, got %+v", tc.ExpectedAutoRepair, nd.Spec.AutoRepair)
+				}
+				if tc.ExpectedAutoRepair == nil {
+					if _, ok := nd.Annotations[machine.AutoRepairEnabledAnnotation]; ok {
+						t.Fatalf("expected the auto-repair annotations to be removed, got %v", nd.Annotations)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMachineDeploymentAdditionalUserData(t *testing.T) {
+	t.Parallel()
+
+	const (
+		providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+		createBody   = `{"name":"mars","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"additionalUserData":%s}}}`
+		patchBody    = `{"spec":{"template":{"additionalUserData":%s}}}`
+
+		packages     = "#cloud-config\npackages:\n- htop\n"
+		rootUser     = "#cloud-config\nusers:\n- default\n- name: root\n  ssh_authorized_keys:\n  - ssh-ed25519 AAAA\n"
+		otherUser    = "#cloud-config\nusers:\n- default\n- name: backup\n"
+		invalidYAML  = "#cloud-config\npackages: [htop\n"
+		shellScript  = "#!/bin/bash\necho hello\n"
+		sshUserError = `{"error":{"code":400,"message":"node deployment validation failed: additional user data must not redefine the SSH login user 'root'"}}`
+	)
+
+	quote := func(s string) string {
+		b, err := json.Marshal(s)
+		if err != nil {
+			t.Fatalf("failed to marshal user data: %v", err)
+		}
+		return string(b)
+	}
+
+	testcases := []struct {
+		Name                       string
+		Method                     string
+		MachineDeploymentID        string
+		Body                       string
+		HTTPStatus                 int
+		ExpectedResponse           string
+		ExpectedAdditionalUserData string
+	}{
+		{
+			Name:                       "scenario 1: create a machine deployment with a cloud-config snippet",
+			Method:                     http.MethodPost,
+			MachineDeploymentID:        "mars",
+			Body:                       fmt.Sprintf(createBody, quote(packages)),
+			HTTPStatus:                 http.StatusCreated,
+			ExpectedAdditionalUserData: packages,
+		},
+		{
+			Name:                       "scenario 2: user data which is not a cloud-config document is not parsed",
+			Method:                     http.MethodPost,
+			MachineDeploymentID:        "mars",
+			Body:                       fmt.Sprintf(createBody, quote(shellScript)),
+			HTTPStatus:                 http.StatusCreated,
+			ExpectedAdditionalUserData: shellScript,
+		},
+		{
+			Name:       "scenario 3: a cloud-config snippet has to be valid YAML",
+			Method:     http.MethodPost,
+			Body:       fmt.Sprintf(createBody, quote(invalidYAML)),
+			HTTPStatus: http.StatusBadRequest,
+		},
+		{
+			Name:             "scenario 4: the user data is limited to 16KB",
+			Method:           http.MethodPost,
+			Body:             fmt.Sprintf(createBody, quote(strings.Repeat("a", 16*1024+1))),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: additional user data must not be larger than 16384 bytes, got 16385 bytes"}}`,
+		},
+		{
+			Name:             "scenario 5: a cloud-config snippet can not redefine the SSH login user on create",
+			Method:           http.MethodPost,
+			Body:             fmt.Sprintf(createBody, quote(rootUser)),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: sshUserError,
+		},
+		{
+			Name:                "scenario 6: an existing machine deployment without user data returns none",
+			Method:              http.MethodGet,
+			MachineDeploymentID: "venus",
+			HTTPStatus:          http.StatusOK,
+		},
+		{
+			Name:                       "scenario 7: patch the user data of an existing machine deployment",
+			Method:                     http.MethodPatch,
+			MachineDeploymentID:        "venus",
+			Body:                       fmt.Sprintf(patchBody, quote(otherUser)),
+			HTTPStatus:                 http.StatusOK,
+			ExpectedAdditionalUserData: otherUser,
+		},
+		{
+			Name:                "scenario 8: a cloud-config snippet can not redefine the SSH login user on patch",
+			Method:              http.MethodPatch,
+			MachineDeploymentID: "venus",
+			Body:                fmt.Sprintf(patchBody, quote(rootUser)),
+			HTTPStatus:          http.StatusBadRequest,
+			ExpectedResponse:    sshUserError,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			basePath := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+			path := basePath
+			if tc.Method != http.MethodPost {
+				path = fmt.Sprintf("%s/%s", basePath, tc.MachineDeploymentID)
+			}
+			req := httptest.NewRequest(tc.Method, path, strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+
+			kubermaticObjs := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+			)
+			machineObjs := []ctrlruntimeclient.Object{test.GenTestMachineDeployment("venus", providerSpec, nil, false)}
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, machineObjs, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+			if tc.MachineDeploymentID == "" {
+				return
+			}
+
+			// the user data has to be returned by the request itself and by a subsequent get
+			for _, body := range []string{res.Body.String(), getMachineDeployment(t, ep, fmt.Sprintf("%s/%s", basePath, tc.MachineDeploymentID))} {
+				if tc.HTTPStatus != http.StatusOK && tc.HTTPStatus != http.StatusCreated {
+					break
+				}
+				nd := &apiv1.NodeDeployment{}
+				if err := json.Unmarshal([]byte(body), nd); err != nil {
+					t.Fatalf("failed to unmarshal node deployment: %v", err)
+				}
+				if nd.Spec.Template.AdditionalUserData != tc.ExpectedAdditionalUserData {
+					t.Fatalf("expected additional user data %q, got %q", tc.ExpectedAdditionalUserData, nd.Spec.Template.AdditionalUserData)
+				}
+			}
+		})
+	}
+}
+
+func TestMachineDeploymentKubeletConfig(t *testing.T) {
+	t.Parallel()
+
+	const (
+		providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+		createBody   = `{"name":"mars","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"kubeletConfig":%s}}}`
+		patchBody    = `{"spec":{"template":{"kubeletConfig":%s}}}`
+	)
+
+	systemReservedAnnotation := fmt.Sprintf("%s/%s", clustercommon.KubeletConfigAnnotationPrefixV1, clustercommon.SystemReservedKubeletConfig)
+	maxPodsAnnotation := fmt.Sprintf("%s/%s", clustercommon.KubeletConfigAnnotationPrefixV1, clustercommon.MaxPodsKubeletConfig)
+	logMaxFilesAnnotation := fmt.Sprintf("%s/%s", clustercommon.KubeletConfigAnnotationPrefixV1, clustercommon.ContainerLogMaxFilesKubeletConfig)
+
+	testcases := []struct {
+		Name                  string
+		Method                string
+		MachineDeploymentID   string
+		Body                  string
+		HTTPStatus            int
+		ExpectedResponse      string
+		ExpectedKubeletConfig map[string]string
+		ExpectedAnnotations   map[string]string
+	}{
+		{
+			Name:                "scenario 1: create a machine deployment with a kubelet config",
+			Method:              http.MethodPost,
+			MachineDeploymentID: "mars",
+			Body:                fmt.Sprintf(createBody, `{"systemReserved":"cpu=200m,memory=1Gi","evictionHard":"memory.available<500Mi,nodefs.available<10%","maxPods":"200","containerLogMaxSize":"50Mi"}`),
+			HTTPStatus:          http.StatusCreated,
+			ExpectedKubeletConfig: map[string]string{
+				"systemReserved":      "cpu=200m,memory=1Gi",
+				"evictionHard":        "memory.available<500Mi,nodefs.available<10%",
+				"maxPods":             "200",
+				"containerLogMaxSize": "50Mi",
+			},
+			ExpectedAnnotations: map[string]string{
+				systemReservedAnnotation: "cpu=200m,memory=1Gi",
+				maxPodsAnnotation:        "200",
+			},
+		},
+		{
+			Name:             "scenario 2: unknown kubelet configs are rejected",
+			Method:           http.MethodPost,
+			Body:             fmt.Sprintf(createBody, `{"cpuManagerPolicy":"static"}`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: kubelet config 'cpuManagerPolicy' not supported. Supported: containerLogMaxSize, evictionHard, kubeReserved, maxPods, systemReserved"}}`,
+		},
+		{
+			Name:             "scenario 3: the reserved resources have to be quantities",
+			Method:           http.MethodPost,
+			Body:             fmt.Sprintf(createBody, `{"kubeReserved":"memory=lots"}`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: invalid kubelet config kubeReserved 'memory=lots': quantity of memory: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'"}}`,
+		},
+		{
+			Name:                "scenario 4: the kubelet config of an existing machine deployment is returned",
+			Method:              http.MethodGet,
+			MachineDeploymentID: "earth",
+			HTTPStatus:          http.StatusOK,
+			ExpectedKubeletConfig: map[string]string{
+				"systemReserved": "cpu=100m",
+			},
+		},
+		{
+			Name:                "scenario 5: patch the kubelet config, configs which are not supported by the API are kept",
+			Method:              http.MethodPatch,
+			MachineDeploymentID: "earth",
+			Body:                fmt.Sprintf(patchBody, `{"systemReserved":null,"maxPods":"110"}`),
+			HTTPStatus:          http.StatusOK,
+			ExpectedKubeletConfig: map[string]string{
+				"maxPods": "110",
+			},
+			ExpectedAnnotations: map[string]string{
+				maxPodsAnnotation:     "110",
+				logMaxFilesAnnotation: "5",
+			},
+		},
+		{
+			Name:                "scenario 6: patching maxPods out of range is rejected",
+			Method:              http.MethodPatch,
+			MachineDeploymentID: "earth",
+			Body:                fmt.Sprintf(patchBody, `{"maxPods":"1000"}`),
+			HTTPStatus:          http.StatusBadRequest,
+			ExpectedResponse:    `{"error":{"code":400,"message":"node deployment validation failed: invalid kubelet config maxPods '1000': must be between 10 and 500"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			basePath := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+			path := basePath
+			if tc.Method != http.MethodPost {
+				path = fmt.Sprintf("%s/%s", basePath, tc.MachineDeploymentID)
+			}
+			req := httptest.NewRequest(tc.Method, path, strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+
+			kubermaticObjs := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+			)
+			kubeletConfigMachineDeployment := test.GenTestMachineDeployment("earth", providerSpec, nil, false)
+			kubeletConfigMachineDeployment.Annotations = map[string]string{
+				systemReservedAnnotation: "cpu=100m",
+				logMaxFilesAnnotation:    "5",
+			}
+			machineObjs := []ctrlruntimeclient.Object{kubeletConfigMachineDeployment}
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, machineObjs, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			// the kubelet config has to be returned by the request itself and by a subsequent get
+			for _, body := range []string{res.Body.String(), getMachineDeployment(t, ep, fmt.Sprintf("%s/%s", basePath, tc.MachineDeploymentID))} {
+				nd := &apiv1.NodeDeployment{}
+				if err := json.Unmarshal([]byte(body), nd); err != nil {
+					t.Fatalf("failed to unmarshal node deployment: %v", err)
+				}
+				if !reflect.DeepEqual(tc.ExpectedKubeletConfig, nd.Spec.Template.KubeletConfig) {
+					t.Fatalf("expected kubelet config %v, got %v", tc.ExpectedKubeletConfig, nd.Spec.Template.KubeletConfig)
+				}
+				for key, value := range tc.ExpectedAnnotations {
+					if nd.Annotations[key] != value {
+						t.Fatalf("expected annotation %s=%q, got %v", key, value, nd.Annotations)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMachineDeploymentDualStackNetwork(t *testing.T) {
+	t.Parallel()
+
+	const (
+		providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+		createBody   = `{"name":"mars","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":false,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":%s}}}`
+	)
+
+	testcases := []struct {
+		Name                string
+		Method              string
+		MachineDeploymentID string
+		Body                string
+		DualStackCluster    bool
+		HTTPStatus          int
+		ExpectedResponse    string
+		ExpectedNetwork     *apiv1.NetworkSpec
+	}{
+		{
+			Name:                "scenario 1: create a dual-stack machine deployment in a dual-stack cluster",
+			Method:              http.MethodPost,
+			MachineDeploymentID: "mars",
+			Body:                fmt.Sprintf(createBody, `{"cidr":"192.168.1.10/24","gateway":"192.168.1.1","dns":{"servers":["8.8.8.8"]},"ipFamily":"IPv4+IPv6","secondaryCIDR":"fd00::10/64","secondaryGateway":"fd00::1"}`),
+			DualStackCluster:    true,
+			HTTPStatus:          http.StatusCreated,
+			ExpectedNetwork: &apiv1.NetworkSpec{
+				CIDR:             "192.168.1.10/24",
+				Gateway:          "192.168.1.1",
+				DNS:              providerconfig.DNSConfig{Servers: []string{"8.8.8.8"}},
+				IPFamily:         "IPv4+IPv6",
+				SecondaryCIDR:    "fd00::10/64",
+				SecondaryGateway: "fd00::1",
+			},
+		},
+		{
+			Name:                "scenario 2: create a single-stack machine deployment in a dual-stack cluster",
+			Method:              http.MethodPost,
+			MachineDeploymentID: "mars",
+			Body:                fmt.Sprintf(createBody, `{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}`),
+			DualStackCluster:    true,
+			HTTPStatus:          http.StatusCreated,
+			ExpectedNetwork:     &apiv1.NetworkSpec{IPFamily: "IPv4"},
+		},
+		{
+			Name:                "scenario 3: the IP family defaults to the one of the cluster",
+			Method:              http.MethodPost,
+			MachineDeploymentID: "mars",
+			Body:                fmt.Sprintf(createBody, `{"cidr":"","gateway":"","dns":{"servers":null}}`),
+			DualStackCluster:    true,
+			HTTPStatus:          http.StatusCreated,
+			ExpectedNetwork:     &apiv1.NetworkSpec{IPFamily: "IPv4+IPv6"},
+		},
+		{
+			Name:             "scenario 4: a dual-stack machine deployment is rejected in a single-stack cluster",
+			Method:           http.MethodPost,
+			Body:             fmt.Sprintf(createBody, `{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4+IPv6"}`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: ipFamily IPv4+IPv6 is not enabled in the cluster network, the cluster only supports IPv4"}}`,
+		},
+		{
+			Name:             "scenario 5: an unsupported IP family is rejected",
+			Method:           http.MethodPost,
+			Body:             fmt.Sprintf(createBody, `{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv6+IPv4"}`),
+			DualStackCluster: true,
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: ipFamily \"IPv6+IPv4\" is not supported, supported values are IPv4, IPv6 and IPv4+IPv6"}}`,
+		},
+		{
+			Name:             "scenario 6: a secondary CIDR requires the dual-stack IP family",
+			Method:           http.MethodPost,
+			Body:             fmt.Sprintf(createBody, `{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4","secondaryCIDR":"fd00::10/64"}`),
+			DualStackCluster: true,
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: secondaryCIDR and secondaryGateway can only be set for the IPv4+IPv6 ipFamily"}}`,
+		},
+		{
+			Name:             "scenario 7: the secondary CIDR has to be an IPv6 CIDR",
+			Method:           http.MethodPost,
+			Body:             fmt.Sprintf(createBody, `{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4+IPv6","secondaryCIDR":"192.168.2.10/24"}`),
+			DualStackCluster: true,
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: secondaryCIDR \"192.168.2.10/24\" is not a valid IPv6 CIDR"}}`,
+		},
+		{
+			Name:                "scenario 8: patch an existing machine deployment to dual-stack",
+			Method:              http.MethodPatch,
+			MachineDeploymentID: "venus",
+			Body:                `{"spec":{"template":{"network":{"ipFamily":"IPv4+IPv6","secondaryCIDR":"fd00::10/64","secondaryGateway":"fd00::1"}}}}`,
+			DualStackCluster:    true,
+			HTTPStatus:          http.StatusOK,
+			ExpectedNetwork:     &apiv1.NetworkSpec{IPFamily: "IPv4+IPv6", SecondaryCIDR: "fd00::10/64", SecondaryGateway: "fd00::1"},
+		},
+		{
+			Name:                "scenario 9: patching to dual-stack is rejected in a single-stack cluster",
+			Method:              http.MethodPatch,
+			MachineDeploymentID: "venus",
+			Body:                `{"spec":{"template":{"network":{"ipFamily":"IPv4+IPv6"}}}}`,
+			HTTPStatus:          http.StatusBadRequest,
+			ExpectedResponse:    `{"error":{"code":400,"message":"node deployment validation failed: ipFamily IPv4+IPv6 is not enabled in the cluster network, the cluster only supports IPv4"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			basePath := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+			path := basePath
+			if tc.Method != http.MethodPost {
+				path = fmt.Sprintf("%s/%s", basePath, tc.MachineDeploymentID)
+			}
+			req := httptest.NewRequest(tc.Method, path, strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+
+			cluster := genTestCluster(true)
+			if tc.DualStackCluster {
+				cluster.Spec.ClusterNetwork.IPFamily = kubermaticv1.IPFamilyDualStack
+				cluster.Spec.ClusterNetwork.Pods.CIDRBlocks = []string{"172.25.0.0/16", "fd01::/48"}
+				cluster.Spec.ClusterNetwork.Services.CIDRBlocks = []string{"10.240.16.0/20", "fd02::/120"}
+			}
+			kubermaticObjs := test.GenDefaultKubermaticObjects(test.GenTestSeed(), cluster)
+			machineObjs := []ctrlruntimeclient.Object{test.GenTestMachineDeployment("venus", providerSpec, nil, false)}
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, machineObjs, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			// the network has to be returned by the request itself and by a subsequent get
+			for _, body := range []string{res.Body.String(), getMachineDeployment(t, ep, fmt.Sprintf("%s/%s", basePath, tc.MachineDeploymentID))} {
+				nd := &apiv1.NodeDeployment{}
+				if err := json.Unmarshal([]byte(body), nd); err != nil {
+					t.Fatalf("failed to unmarshal node deployment: %v", err)
+				}
+				if !reflect.DeepEqual(tc.ExpectedNetwork, nd.Spec.Template.Network) {
+					t.Fatalf("expected network %+v, got %+v", tc.ExpectedNetwork, nd.Spec.Template.Network)
+				}
+			}
+		})
+	}
+}
+
+func TestMachineDeploymentDeprecationWarnings(t *testing.T) {
+	t.Parallel()
+
+	const (
+		providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+		createBody   = `{"name":"mars","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"s-1vcpu-1gb","backups":false,"ipv6":%t,"monitoring":false,"tags":[]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`
+		ipv6Warning  = `299 - "spec.template.cloud.digitalocean.ipv6 is deprecated: IPv6 is enabled automatically based on the IP family of the cluster"`
+	)
+
+	testcases := []struct {
+		Name             string
+		Method           string
+		Body             string
+		HTTPStatus       int
+		ExpectedWarnings []string
+	}{
+		{
+			Name:             "scenario 1: creating a machine deployment with a deprecated field returns a warning",
+			Method:           http.MethodPost,
+			Body:             fmt.Sprintf(createBody, true),
+			HTTPStatus:       http.StatusCreated,
+			ExpectedWarnings: []string{ipv6Warning},
+		},
+		{
+			Name:       "scenario 2: creating a machine deployment without deprecated fields returns no warnings",
+			Method:     http.MethodPost,
+			Body:       fmt.Sprintf(createBody, false),
+			HTTPStatus: http.StatusCreated,
+		},
+		{
+			Name:             "scenario 3: patching a deprecated field returns a warning",
+			Method:           http.MethodPatch,
+			Body:             `{"spec":{"template":{"cloud":{"digitalocean":{"ipv6":true}}}}}`,
+			HTTPStatus:       http.StatusOK,
+			ExpectedWarnings: []string{ipv6Warning},
+		},
+		{
+			Name:       "scenario 4: patching other fields returns no warnings",
+			Method:     http.MethodPatch,
+			Body:       `{"spec":{"replicas":3}}`,
+			HTTPStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			path := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+			if tc.Method == http.MethodPatch {
+				path = fmt.Sprintf("%s/venus", path)
+			}
+			req := httptest.NewRequest(tc.Method, path, strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+
+			kubermaticObjs := test.GenDefaultKubermaticObjects(test.GenTestSeed(), genTestCluster(true))
+			machineObjs := []ctrlruntimeclient.Object{test.GenTestMachineDeployment("venus", providerSpec, nil, false)}
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, machineObjs, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if warnings := res.Result().Header.Values("Warning"); !reflect.DeepEqual(warnings, tc.ExpectedWarnings) {
+				t.Fatalf("expected warnings %q, got %q", tc.ExpectedWarnings, warnings)
+			}
+
+			// the warnings must not change the response body
+			nd := &apiv1.NodeDeployment{}
+			if err := json.Unmarshal(res.Body.Bytes(), nd); err != nil {
+				t.Fatalf("failed to unmarshal node deployment: %v", err)
+			}
+			if nd.Spec.Template.Cloud.Digitalocean == nil {
+				t.Fatalf("expected a digitalocean node deployment, got %s", res.Body.String())
+			}
+		})
+	}
+}
+
+func TestMachineDeploymentKubeVirtReferences(t *testing.T) {
+	const createBody = `{"name":"mars","spec":{"replicas":1,"template":{"cloud":{"kubevirt":{%s"primaryDiskOSImage":"http://images/ubuntu.img","primaryDiskStorageClassName":"standard","primaryDiskSize":"10Gi"}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`
+
+	scheme := fake.NewScheme()
+	utilruntime.Must(kvinstancetypev1alpha1.AddToScheme(scheme))
+
+	instancetype := &kvinstancetypev1alpha1.VirtualMachineClusterInstancetype{ObjectMeta: metav1.ObjectMeta{Name: "custom-2"}}
+	preference := &kvinstancetypev1alpha1.VirtualMachineClusterPreference{ObjectMeta: metav1.ObjectMeta{Name: "custom-pref"}}
+	priorityClass := &schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "vm-high"}, Value: 1000}
+	setFakeNewKubeVirtClient := func(objects ...ctrlruntimeclient.Object) {
+		handlercommon.NewKubeVirtClient = func(kubeconfig string, options kubevirt.ClientOptions) (*kubevirt.Client, error) {
+			return &kubevirt.Client{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+			}, nil
+		}
+	}
+
+	testcases := []struct {
+		Name                      string
+		CreateBody                string
+		PatchBody                 string
+		InfraObjectsOnPatch       []ctrlruntimeclient.Object
+		HTTPStatus                int
+		ExpectedResponse          string
+		ExpectedPriorityClassName string
+	}{
+		{
+			Name:                      "scenario 1: create a machine deployment with existing references",
+			CreateBody:                fmt.Sprintf(createBody, `"instancetype":{"kind":"VirtualMachineClusterInstancetype","name":"custom-2"},"preference":{"kind":"VirtualMachineClusterPreference","name":"custom-pref"},"priorityClassName":"vm-high",`),
+			HTTPStatus:                http.StatusCreated,
+			ExpectedPriorityClassName: "vm-high",
+		},
+		{
+			Name:             "scenario 2: an unknown instancetype is rejected",
+			CreateBody:       fmt.Sprintf(createBody, `"instancetype":{"kind":"VirtualMachineClusterInstancetype","name":"custom-16"},`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: instancetype \"custom-16\" does not exist in the infra cluster, available instancetypes are [custom-2 standard-2 standard-4 standard-8]"}}`,
+		},
+		{
+			Name:             "scenario 3: an unknown preference is rejected",
+			CreateBody:       fmt.Sprintf(createBody, `"cpus":"1","memory":"2Gi","preference":{"kind":"VirtualMachineClusterPreference","name":"windows"},`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: preference \"windows\" does not exist in the infra cluster, available preferences are [custom-pref sockets-advantage]"}}`,
+		},
+		{
+			Name:             "scenario 4: an unknown priority class is rejected",
+			CreateBody:       fmt.Sprintf(createBody, `"cpus":"1","memory":"2Gi","priorityClassName":"vm-low",`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: priority class \"vm-low\" does not exist in the infra cluster, available priority classes are [vm-high]"}}`,
+		},
+		{
+			Name:                      "scenario 5: unchanged references are not validated again on patch",
+			CreateBody:                fmt.Sprintf(createBody, `"cpus":"1","memory":"2Gi","priorityClassName":"vm-high",`),
+			PatchBody:                 `{"spec":{"replicas":2}}`,
+			HTTPStatus:                http.StatusOK,
+			ExpectedPriorityClassName: "vm-high",
+		},
+		{
+			Name:                "scenario 6: patching an unknown priority class is rejected",
+			CreateBody:          fmt.Sprintf(createBody, `"cpus":"1","memory":"2Gi","priorityClassName":"vm-high",`),
+			PatchBody:           `{"spec":{"template":{"cloud":{"kubevirt":{"priorityClassName":"vm-low"}}}}}`,
+			InfraObjectsOnPatch: []ctrlruntimeclient.Object{priorityClass},
+			HTTPStatus:          http.StatusBadRequest,
+			ExpectedResponse:    `{"error":{"code":400,"message":"node deployment validation failed: priority class \"vm-low\" does not exist in the infra cluster, available priority classes are [vm-high]"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			setFakeNewKubeVirtClient(instancetype, preference, priorityClass)
+
+			cluster := genTestClusterWithCloud(kubermaticv1.CloudSpec{
+				DatacenterName: "KubevirtDC",
+				Kubevirt:       &kubermaticv1.KubevirtCloudSpec{Kubeconfig: "fake-kubeconfig"},
+			}, nil)
+			// instancetypes define the CPUs of the VM, which requires the pod resources to be used for them
+			seed := test.GenTestSeed(func(seed *kubermaticv1.Seed) {
+				seed.Spec.Datacenters["KubevirtDC"].Spec.Kubevirt.UsePodResourcesCPU = true
+			})
+			kubermaticObjs := test.GenDefaultKubermaticObjects(seed, cluster)
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, nil, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			basePath := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, cluster.Name)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPost, basePath, strings.NewReader(tc.CreateBody)))
+
+			if tc.PatchBody != "" {
+				if res.Code != http.StatusCreated {
+					t.Fatalf("Expected HTTP status code %d on create, got %d: %s", http.StatusCreated, res.Code, res.Body.String())
+				}
+				// the removed objects must not fail patches which don't change the references
+				setFakeNewKubeVirtClient(tc.InfraObjectsOnPatch...)
+
+				res = httptest.NewRecorder()
+				ep.ServeHTTP(res, httptest.NewRequest(http.MethodPatch, fmt.Sprintf("%s/mars", basePath), strings.NewReader(tc.PatchBody)))
+			}
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			for _, body := range []string{res.Body.String(), getMachineDeployment(t, ep, fmt.Sprintf("%s/mars", basePath))} {
+				nd := &apiv1.NodeDeployment{}
+				if err := json.Unmarshal([]byte(body), nd); err != nil {
+					t.Fatalf("failed to unmarshal node deployment: %v", err)
+				}
+				if nd.Spec.Template.Cloud.Kubevirt == nil || nd.Spec.Template.Cloud.Kubevirt.PriorityClassName != tc.ExpectedPriorityClassName {
+					t.Fatalf("expected priority class %q, got %+v", tc.ExpectedPriorityClassName, nd.Spec.Template.Cloud.Kubevirt)
+				}
+			}
+		})
+	}
+}
+
+func TestMachineDeploymentVSphereAdditionalNetworks(t *testing.T) {
+	const createBody = `{"name":"mars","spec":{"replicas":1,"template":{"cloud":{"vsphere":{"cpus":2,"memory":2048,"diskSizeGB":10,"template":"ubuntu-template",%s"vmAntiAffinity":false}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`
+
+	setFakeGetVSphereNetworks := func(names ...string) {
+		handlercommon.GetVSphereNetworks = func(ctx context.Context, dc *kubermaticv1.DatacenterSpecVSphere, username, password string, caBundle *x509.CertPool) ([]vsphere.NetworkInfo, error) {
+			networks := []vsphere.NetworkInfo{}
+			for _, name := range names {
+				networks = append(networks, vsphere.NetworkInfo{Name: name, RelativePath: "network/" + name, AbsolutePath: "/dc-1/network/" + name})
+			}
+			return networks, nil
+		}
+	}
+
+	testcases := []struct {
+		Name                       string
+		CreateBody                 string
+		PatchBody                  string
+		NetworksOnPatch            []string
+		HTTPStatus                 int
+		ExpectedResponse           string
+		ExpectedAdditionalNetworks []string
+	}{
+		{
+			Name:                       "scenario 1: create a machine deployment with existing additional networks",
+			CreateBody:                 fmt.Sprintf(createBody, `"additionalNetworks":["storage-vlan","network/backup-vlan"],`),
+			HTTPStatus:                 http.StatusCreated,
+			ExpectedAdditionalNetworks: []string{"storage-vlan", "network/backup-vlan"},
+		},
+		{
+			Name:             "scenario 2: an unknown additional network is rejected",
+			CreateBody:       fmt.Sprintf(createBody, `"additionalNetworks":["storage-vlan","san-vlan"],`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: additional network \"san-vlan\" does not exist in the vSphere datacenter"}}`,
+		},
+		{
+			Name:             "scenario 3: an additional network which is set twice is rejected",
+			CreateBody:       fmt.Sprintf(createBody, `"additionalNetworks":["storage-vlan","storage-vlan"],`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: additional network \"storage-vlan\" is set more than once"}}`,
+		},
+		{
+			Name:             "scenario 4: the network of the cluster can't be added again",
+			CreateBody:       fmt.Sprintf(createBody, `"additionalNetworks":["VM Network"],`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: additional network \"VM Network\" is already attached to all machines of the cluster"}}`,
+		},
+		{
+			Name:                       "scenario 5: unchanged additional networks are not validated again on patch",
+			CreateBody:                 fmt.Sprintf(createBody, `"additionalNetworks":["storage-vlan"],`),
+			PatchBody:                  `{"spec":{"replicas":2}}`,
+			HTTPStatus:                 http.StatusOK,
+			ExpectedAdditionalNetworks: []string{"storage-vlan"},
+		},
+		{
+			Name:             "scenario 6: patching an unknown additional network is rejected",
+			CreateBody:       fmt.Sprintf(createBody, `"additionalNetworks":["storage-vlan"],`),
+			PatchBody:        `{"spec":{"template":{"cloud":{"vsphere":{"additionalNetworks":["storage-vlan","backup-vlan"]}}}}}`,
+			NetworksOnPatch:  []string{"storage-vlan"},
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: additional network \"backup-vlan\" does not exist in the vSphere datacenter"}}`,
+		},
+		{
+			Name:                       "scenario 7: additional networks can be added by a patch",
+			CreateBody:                 fmt.Sprintf(createBody, ""),
+			PatchBody:                  `{"spec":{"template":{"cloud":{"vsphere":{"additionalNetworks":["backup-vlan"]}}}}}`,
+			NetworksOnPatch:            []string{"backup-vlan"},
+			HTTPStatus:                 http.StatusOK,
+			ExpectedAdditionalNetworks: []string{"backup-vlan"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			setFakeGetVSphereNetworks("VM Network", "storage-vlan", "backup-vlan")
+
+			cluster := genTestClusterWithCloud(kubermaticv1.CloudSpec{
+				DatacenterName: "VSphereDC",
+				VSphere: &kubermaticv1.VSphereCloudSpec{
+					Username: "user",
+					Password: "password",
+					Networks: []string{"VM Network"},
+				},
+			}, nil)
+			seed := test.GenTestSeed(func(seed *kubermaticv1.Seed) {
+				seed.Spec.Datacenters["VSphereDC"] = kubermaticv1.Datacenter{
+					Spec: kubermaticv1.DatacenterSpec{
+						VSphere: &kubermaticv1.DatacenterSpecVSphere{Endpoint: "https://vcenter.example.com", Datacenter: "dc-1"},
+					},
+				}
+			})
+			kubermaticObjs := test.GenDefaultKubermaticObjects(seed, cluster)
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, nil, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			basePath := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, cluster.Name)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPost, basePath, strings.NewReader(tc.CreateBody)))
+
+			if tc.PatchBody != "" {
+				if res.Code != http.StatusCreated {
+					t.Fatalf("Expected HTTP status code %d on create, got %d: %s", http.StatusCreated, res.Code, res.Body.String())
+				}
+				// removed networks must not fail patches which don't change the additional networks
+				setFakeGetVSphereNetworks(tc.NetworksOnPatch...)
+
+				res = httptest.NewRecorder()
+				ep.ServeHTTP(res, httptest.NewRequest(http.MethodPatch, fmt.Sprintf("%s/mars", basePath), strings.NewReader(tc.PatchBody)))
+			}
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			for _, body := range []string{res.Body.String(), getMachineDeployment(t, ep, fmt.Sprintf("%s/mars", basePath))} {
+				nd := &apiv1.NodeDeployment{}
+				if err := json.Unmarshal([]byte(body), nd); err != nil {
+					t.Fatalf("failed to unmarshal node deployment: %v", err)
+				}
+				if nd.Spec.Template.Cloud.VSphere == nil || !reflect.DeepEqual(nd.Spec.Template.Cloud.VSphere.AdditionalNetworks, tc.ExpectedAdditionalNetworks) {
+					t.Fatalf("expected additional networks %v, got %+v", tc.ExpectedAdditionalNetworks, nd.Spec.Template.Cloud.VSphere)
+				}
+			}
+		})
+	}
+}
+
+type fakeHetznerClient struct {
+	networks  apiv1.HetznerNetworkList
+	firewalls apiv1.HetznerFirewallList
+}
+
+func (c *fakeHetznerClient) ListNetworks(_ context.Context) (apiv1.HetznerNetworkList, error) {
+	return c.networks, nil
+}
+
+func (c *fakeHetznerClient) ListFirewalls(_ context.Context) (apiv1.HetznerFirewallList, error) {
+	return c.firewalls, nil
+}
+
+func TestMachineDeploymentHetznerReferences(t *testing.T) {
+	const createBody = `{"name":"mars","spec":{"replicas":1,"template":{"cloud":{"hetzner":{"type":"cx21"%s}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`
+
+	setFakeNewHetznerClient := func(networks apiv1.HetznerNetworkList, firewalls apiv1.HetznerFirewallList) {
+		handlercommon.NewHetznerClient = func(token string) hetzner.Client {
+			return &fakeHetznerClient{networks: networks, firewalls: firewalls}
+		}
+	}
+	networks := apiv1.HetznerNetworkList{{ID: 1, Name: "kubernetes", IPRange: "10.0.0.0/16"}}
+	firewalls := apiv1.HetznerFirewallList{{ID: 10, Name: "nodes"}, {ID: 11, Name: "bastion"}}
+
+	testcases := []struct {
+		Name              string
+		CreateBody        string
+		PatchBody         string
+		ReferencesOnPatch bool
+		HTTPStatus        int
+		ExpectedResponse  string
+		ExpectedNetwork   string
+		ExpectedFirewall  string
+	}{
+		{
+			Name:             "scenario 1: create a machine deployment with an existing network and firewall",
+			CreateBody:       fmt.Sprintf(createBody, `,"network":"kubernetes","firewall":"nodes"`),
+			HTTPStatus:       http.StatusCreated,
+			ExpectedNetwork:  "kubernetes",
+			ExpectedFirewall: "nodes",
+		},
+		{
+			Name:             "scenario 2: the network and firewall can be referenced by their IDs",
+			CreateBody:       fmt.Sprintf(createBody, `,"network":"1","firewall":"11"`),
+			HTTPStatus:       http.StatusCreated,
+			ExpectedNetwork:  "1",
+			ExpectedFirewall: "11",
+		},
+		{
+			Name:             "scenario 3: an unknown network is rejected",
+			CreateBody:       fmt.Sprintf(createBody, `,"network":"databases"`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: network \"databases\" does not exist in the Hetzner project, available networks are [kubernetes]"}}`,
+		},
+		{
+			Name:             "scenario 4: an unknown firewall is rejected",
+			CreateBody:       fmt.Sprintf(createBody, `,"firewall":"web"`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: firewall \"web\" does not exist in the Hetzner project, available firewalls are [nodes bastion]"}}`,
+		},
+		{
+			Name:             "scenario 5: unchanged references are not validated again on patch",
+			CreateBody:       fmt.Sprintf(createBody, `,"network":"kubernetes","firewall":"nodes"`),
+			PatchBody:        `{"spec":{"replicas":2}}`,
+			HTTPStatus:       http.StatusOK,
+			ExpectedNetwork:  "kubernetes",
+			ExpectedFirewall: "nodes",
+		},
+		{
+			Name:              "scenario 6: patching an unknown firewall is rejected",
+			CreateBody:        fmt.Sprintf(createBody, ""),
+			PatchBody:         `{"spec":{"template":{"cloud":{"hetzner":{"firewall":"web"}}}}}`,
+			ReferencesOnPatch: true,
+			HTTPStatus:        http.StatusBadRequest,
+			ExpectedResponse:  `{"error":{"code":400,"message":"node deployment validation failed: firewall \"web\" does not exist in the Hetzner project, available firewalls are [nodes bastion]"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			setFakeNewHetznerClient(networks, firewalls)
+
+			cluster := genTestClusterWithCloud(kubermaticv1.CloudSpec{
+				DatacenterName: "HetznerDC",
+				Hetzner: &kubermaticv1.HetznerCloudSpec{
+					Token: "token",
+				},
+			}, nil)
+			seed := test.GenTestSeed(func(seed *kubermaticv1.Seed) {
+				seed.Spec.Datacenters["HetznerDC"] = kubermaticv1.Datacenter{
+					Spec: kubermaticv1.DatacenterSpec{
+						Hetzner: &kubermaticv1.DatacenterSpecHetzner{Datacenter: "fsn1-dc14", Location: "fsn1"},
+					},
+				}
+			})
+			kubermaticObjs := test.GenDefaultKubermaticObjects(seed, cluster)
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, nil, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			basePath := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, cluster.Name)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPost, basePath, strings.NewReader(tc.CreateBody)))
+
+			if tc.PatchBody != "" {
+				if res.Code != http.StatusCreated {
+					t.Fatalf("Expected HTTP status code %d on create, got %d: %s", http.StatusCreated, res.Code, res.Body.String())
+				}
+				// removed networks and firewalls must not fail patches which don't change the references
+				if tc.ReferencesOnPatch {
+					setFakeNewHetznerClient(networks, firewalls)
+				} else {
+					setFakeNewHetznerClient(nil, nil)
+				}
+
+				res = httptest.NewRecorder()
+				ep.ServeHTTP(res, httptest.NewRequest(http.MethodPatch, fmt.Sprintf("%s/mars", basePath), strings.NewReader(tc.PatchBody)))
+			}
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			nd := &apiv1.NodeDeployment{}
+			if err := json.Unmarshal([]byte(getMachineDeployment(t, ep, fmt.Sprintf("%s/mars", basePath))), nd); err != nil {
+				t.Fatalf("failed to unmarshal node deployment: %v", err)
+			}
+			if spec := nd.Spec.Template.Cloud.Hetzner; spec == nil || spec.Network != tc.ExpectedNetwork || spec.Firewall != tc.ExpectedFirewall {
+				t.Fatalf("expected network %q and firewall %q, got %+v", tc.ExpectedNetwork, tc.ExpectedFirewall, spec)
+			}
+		})
+	}
+}
+
+func TestMachineDeploymentOpenstackFlavorAndZone(t *testing.T) {
+	const createBody = `{"name":"mars","spec":{"replicas":1,"template":{"cloud":{"openstack":{"flavor":"%s","image":"ubuntu-22.04"%s}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":false}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"}}}}`
+
+	listFlavors, listZones := handlercommon.ListOpenstackFlavors, handlercommon.ListOpenstackAvailabilityZones
+	defer func() {
+		handlercommon.ListOpenstackFlavors = listFlavors
+		handlercommon.ListOpenstackAvailabilityZones = listZones
+	}()
+
+	setFakeOpenstack := func(flavors []osflavors.Flavor, zones []osavailabilityzones.AvailabilityZone) {
+		handlercommon.ListOpenstackFlavors = func(_, _ string, _ *resources.OpenstackCredentials, _ *x509.CertPool) ([]osflavors.Flavor, error) {
+			return flavors, nil
+		}
+		handlercommon.ListOpenstackAvailabilityZones = func(_ context.Context, _, _ string, _ *resources.OpenstackCredentials, _ *x509.CertPool) ([]osavailabilityzones.AvailabilityZone, error) {
+			return zones, nil
+		}
+	}
+	flavors := []osflavors.Flavor{{ID: "f-small", Name: "m1.small"}, {ID: "f-large", Name: "m1.large"}}
+	zones := []osavailabilityzones.AvailabilityZone{{ZoneName: "nova"}, {ZoneName: "az-2"}}
+
+	testcases := []struct {
+		Name             string
+		CreateQuery      string
+		CreateBody       string
+		PatchBody        string
+		ValuesOnPatch    bool
+		HTTPStatus       int
+		ExpectedResponse string
+		ExpectedFlavor   string
+		ExpectedZone     string
+	}{
+		{
+			Name:           "scenario 1: create a machine deployment with an existing flavor and availability zone",
+			CreateBody:     fmt.Sprintf(createBody, "m1.small", `,"availabilityZone":"az-2"`),
+			HTTPStatus:     http.StatusCreated,
+			ExpectedFlavor: "m1.small",
+			ExpectedZone:   "az-2",
+		},
+		{
+			Name:           "scenario 2: the flavor can be referenced by its ID",
+			CreateBody:     fmt.Sprintf(createBody, "f-large", ""),
+			HTTPStatus:     http.StatusCreated,
+			ExpectedFlavor: "f-large",
+			ExpectedZone:   "nova",
+		},
+		{
+			Name:             "scenario 3: an unknown flavor is rejected",
+			CreateBody:       fmt.Sprintf(createBody, "m1.xlarge", ""),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: flavor \"m1.xlarge\" does not exist in the OpenStack region \"RegionOne\", available flavors are [m1.large m1.small]"}}`,
+		},
+		{
+			Name:             "scenario 4: an unknown availability zone is rejected",
+			CreateBody:       fmt.Sprintf(createBody, "m1.small", `,"availabilityZone":"az-3"`),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: availability zone \"az-3\" does not exist in the OpenStack region \"RegionOne\", available availability zones are [az-2 nova]"}}`,
+		},
+		{
+			Name:           "scenario 5: the validation can be skipped if OpenStack can't be reached",
+			CreateQuery:    "?skip_cloud_validation=true",
+			CreateBody:     fmt.Sprintf(createBody, "m1.xlarge", `,"availabilityZone":"az-3"`),
+			HTTPStatus:     http.StatusCreated,
+			ExpectedFlavor: "m1.xlarge",
+			ExpectedZone:   "az-3",
+		},
+		{
+			Name:           "scenario 6: unchanged values are not validated again on patch",
+			CreateBody:     fmt.Sprintf(createBody, "m1.small", `,"availabilityZone":"az-2"`),
+			PatchBody:      `{"spec":{"replicas":2}}`,
+			HTTPStatus:     http.StatusOK,
+			ExpectedFlavor: "m1.small",
+			ExpectedZone:   "az-2",
+		},
+		{
+			Name:             "scenario 7: patching an unknown availability zone is rejected",
+			CreateBody:       fmt.Sprintf(createBody, "m1.small", ""),
+			PatchBody:        `{"spec":{"template":{"cloud":{"openstack":{"availabilityZone":"az-3"}}}}}`,
+			ValuesOnPatch:    true,
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: availability zone \"az-3\" does not exist in the OpenStack region \"RegionOne\", available availability zones are [az-2 nova]"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			setFakeOpenstack(flavors, zones)
+
+			cluster := genTestClusterWithCloud(kubermaticv1.CloudSpec{
+				DatacenterName: "OpenstackDC",
+				Openstack: &kubermaticv1.OpenstackCloudSpec{
+					Username: "username",
+					Password: "password",
+					Project:  "project",
+					Domain:   "domain",
+				},
+			}, nil)
+			seed := test.GenTestSeed(func(seed *kubermaticv1.Seed) {
+				seed.Spec.Datacenters["OpenstackDC"] = kubermaticv1.Datacenter{
+					Spec: kubermaticv1.DatacenterSpec{
+						Openstack: &kubermaticv1.DatacenterSpecOpenstack{AuthURL: "https://keystone.example.com:5000/v3", Region: "RegionOne", AvailabilityZone: "nova"},
+					},
+				}
+			})
+			kubermaticObjs := test.GenDefaultKubermaticObjects(seed, cluster)
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, nil, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			basePath := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, cluster.Name)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(http.MethodPost, basePath+tc.CreateQuery, strings.NewReader(tc.CreateBody)))
+
+			if tc.PatchBody != "" {
+				if res.Code != http.StatusCreated {
+					t.Fatalf("Expected HTTP status code %d on create, got %d: %s", http.StatusCreated, res.Code, res.Body.String())
+				}
+				// removed flavors and zones must not fail patches which don't change them
+				if tc.ValuesOnPatch {
+					setFakeOpenstack(flavors, zones)
+				} else {
+					setFakeOpenstack(nil, nil)
+				}
+
+				res = httptest.NewRecorder()
+				ep.ServeHTTP(res, httptest.NewRequest(http.MethodPatch, fmt.Sprintf("%s/mars", basePath), strings.NewReader(tc.PatchBody)))
+			}
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			nd := &apiv1.NodeDeployment{}
+			if err := json.Unmarshal([]byte(getMachineDeployment(t, ep, fmt.Sprintf("%s/mars", basePath))), nd); err != nil {
+				t.Fatalf("failed to unmarshal node deployment: %v", err)
+			}
+			if spec := nd.Spec.Template.Cloud.Openstack; spec == nil || spec.Flavor != tc.ExpectedFlavor || spec.AvailabilityZone != tc.ExpectedZone {
+				t.Fatalf("expected flavor %q and availability zone %q, got %+v", tc.ExpectedFlavor, tc.ExpectedZone, spec)
+			}
+		})
+	}
+}
+
+func TestMachineDeploymentMaintenanceWindow(t *testing.T) {
+	const mdPath = "/api/v2/projects/my-first-project-ID/clusters/defClusterID/machinedeployments/venus"
+	// the window starts on Sundays at 02:00 UTC and lasts two hours, 2025-01-05 is a Sunday
+	insideWindow := time.Date(2025, 1, 5, 3, 0, 0, 0, time.UTC)
+	outsideWindow := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+
+	defer func() { handlercommon.MaintenanceWindowNow = time.Now }()
+
+	testcases := []struct {
+		Name             string
+		Method           string
+		Path             string
+		Body             string
+		Now              time.Time
+		ExistingAPIUser  *apiv1.User
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:       "scenario 1: a machine deployment can be restarted inside of the maintenance window",
+			Method:     http.MethodPost,
+			Path:       mdPath + "/restart",
+			Now:        insideWindow,
+			HTTPStatus: http.StatusOK,
+		},
+		{
+			Name:             "scenario 2: a restart outside of the maintenance window is rejected with the next window",
+			Method:           http.MethodPost,
+			Path:             mdPath + "/restart",
+			Now:              outsideWindow,
+			HTTPStatus:       http.StatusConflict,
+			ExpectedResponse: `{"error":{"code":409,"message":"cluster defClusterID is outside of its maintenance window, the next window starts at 2025-01-12T02:00:00Z"}}`,
+		},
+		{
+			Name:       "scenario 3: a project owner can force a restart outside of the maintenance window",
+			Method:     http.MethodPost,
+			Path:       mdPath + "/restart?force=true",
+			Now:        outsideWindow,
+			HTTPStatus: http.StatusOK,
+		},
+		{
+			Name:             "scenario 4: a project editor can't force a restart outside of the maintenance window",
+			Method:           http.MethodPost,
+			Path:             mdPath + "/restart?force=true",
+			Now:              outsideWindow,
+			ExistingAPIUser:  test.GenAPIUser(test.UserName2, test.UserEmail2),
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: only project owners and admins can bypass the maintenance window"}}`,
+		},
+		{
+			Name:             "scenario 5: a kubelet upgrade outside of the maintenance window is rejected",
+			Method:           http.MethodPatch,
+			Path:             mdPath,
+			Body:             `{"spec":{"template":{"versions":{"kubelet":"v9.8.0"}}}}`,
+			Now:              outsideWindow,
+			HTTPStatus:       http.StatusConflict,
+			ExpectedResponse: `{"error":{"code":409,"message":"cluster defClusterID is outside of its maintenance window, the next window starts at 2025-01-12T02:00:00Z"}}`,
+		},
+		{
+			Name:       "scenario 6: a project owner can force a kubelet upgrade outside of the maintenance window",
+			Method:     http.MethodPatch,
+			Path:       mdPath + "?force=true",
+			Body:       `{"spec":{"template":{"versions":{"kubelet":"v9.8.0"}}}}`,
+			Now:        outsideWindow,
+			HTTPStatus: http.StatusOK,
+		},
+		{
+			Name:       "scenario 7: patches which don't change the kubelet version are not restricted",
+			Method:     http.MethodPatch,
+			Path:       mdPath,
+			Body:       `{"spec":{"replicas":3}}`,
+			Now:        outsideWindow,
+			HTTPStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			handlercommon.MaintenanceWindowNow = func() time.Time { return tc.Now }
+
+			cluster := genTestCluster(true)
+			cluster.Annotations = map[string]string{
+				clusterresources.MaintenanceWindowAnnotation: `{"weekdays":["Sunday"],"startTime":"02:00","duration":"2h"}`,
+			}
+			kubermaticObjs := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				cluster,
+				test.GenUser(test.UserID2, test.UserName2, test.UserEmail2),
+				test.GenBinding(test.GenDefaultProject().Name, test.UserEmail2, "editors"),
+			)
+			machineObjs := []ctrlruntimeclient.Object{
+				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
+			}
+			apiUser := test.GenDefaultAPIUser()
+			if tc.ExistingAPIUser != nil {
+				apiUser = tc.ExistingAPIUser
+			}
+			ep, _, err := test.CreateTestEndpointAndGetClients(*apiUser, nil, []ctrlruntimeclient.Object{}, machineObjs, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(tc.Method, tc.Path, strings.NewReader(tc.Body)))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+		})
+	}
+}
+
+func getMachineDeployment(t *testing.T, ep http.Handler, path string) string {
+	t.Helper()
+
+	res := httptest.NewRecorder()
+	ep.ServeHTTP(res, httptest.NewRequest(http.MethodGet, path, nil))
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d on get, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+
+	return res.Body.String()
+}
+
+func TestDeleteMachineDeploymentNode(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name                    string
+		HTTPStatus              int
+		NodeIDToDelete          string
+		ClusterIDToSync         string
+		ProjectIDToSync         string
+		ExistingAPIUser         *apiv1.User
+		ExistingNodes           []*corev1.Node
+		ExistingMachines        []*clusterv1alpha1.Machine
+		ExistingKubermaticObjs  []ctrlruntimeclient.Object
+		ExpectedHTTPStatusOnGet int
+		ExpectedResponseOnGet   string
+		ExpectedNodeCount       int
+	}{
+		// scenario 1
+		{
+			Name:            "scenario 1: delete the machine node that belong to the given cluster",
+			HTTPStatus:      http.StatusOK,
+			NodeIDToDelete:  "venus",
+			ClusterIDToSync: test.GenDefaultCluster().Name,
+			ProjectIDToSync: test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExistingNodes: []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "mars"}},
+			},
+			ExistingMachines: []*clusterv1alpha1.Machine{
+				genTestMachine("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
+				genTestMachine("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
+			},
+			//
+			// even though the machine object was deleted the associated node object was not. When the client GETs the previously deleted "node" it will get a valid response.
+			// That is only true for testing, but in a real cluster, the node object will get deleted by the garbage-collector as it has a ownerRef set.
+			ExpectedHTTPStatusOnGet: http.StatusOK,
+			ExpectedResponseOnGet:   `{"id":"venus","name":"venus","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"cloud":{},"operatingSystem":{},"versions":{"kubelet":""}},"status":{"machineName":"","capacity":{"cpu":"0","memory":"0"},"allocatable":{"cpu":"0","memory":"0"},"nodeInfo":{"kernelVersion":"","containerRuntime":"","containerRuntimeVersion":"","kubeletVersion":"","operatingSystem":"","architecture":""}}}`,
+			ExpectedNodeCount:       1,
+		},
+		// scenario 2
+		{
+			Name:            "scenario 2: the admin John can delete any cluster machine node",
+			HTTPStatus:      http.StatusOK,
+			NodeIDToDelete:  "venus",
+			ClusterIDToSync: test.GenDefaultCluster().Name,
+			ProjectIDToSync: test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAdminUser("John", "john@acme.com", true),
+			),
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+			ExistingNodes: []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "mars"}},
+			},
+			ExistingMachines: []*clusterv1alpha1.Machine{
+				genTestMachine("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
+				genTestMachine("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
+			},
+			//
+			// even though the machine object was deleted the associated node object was not. When the client GETs the previously deleted "node" it will get a valid response.
+			// That is only true for testing, but in a real cluster, the node object will get deleted by the garbage-collector as it has a ownerRef set.
+			ExpectedHTTPStatusOnGet: http.StatusOK,
+			ExpectedResponseOnGet:   `{"id":"venus","name":"venus","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"cloud":{},"operatingSystem":{},"versions":{"kubelet":""}},"status":{"machineName":"","capacity":{"cpu":"0","memory":"0"},"allocatable":{"cpu":"0","memory":"0"},"nodeInfo":{"kernelVersion":"","containerRuntime":"","containerRuntimeVersion":"","kubeletVersion":"","operatingSystem":"","architecture":""}}}`,
+			ExpectedNodeCount:       1,
+		},
+		// scenario 3
+		{
+			Name:            "scenario 3: the user John can not delete Bob's cluster machine node",
+			HTTPStatus:      http.StatusForbidden,
+			NodeIDToDelete:  "venus",
+			ClusterIDToSync: test.GenDefaultCluster().Name,
+			ProjectIDToSync: test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAdminUser("John", "john@acme.com", false),
+			),
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+			ExistingNodes: []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "mars"}},
+			},
+			ExistingMachines: []*clusterv1alpha1.Machine{
+				genTestMachine("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
+				genTestMachine("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
+			},
+			ExpectedHTTPStatusOnGet: http.StatusForbidden,
+			ExpectedResponseOnGet:   `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+			ExpectedNodeCount:       2,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/nodes/%s", tc.ProjectIDToSync, tc.ClusterIDToSync, tc.NodeIDToDelete), strings.NewReader(""))
+			res := httptest.NewRecorder()
+			kubermaticObj := []ctrlruntimeclient.Object{}
+			machineObj := []ctrlruntimeclient.Object{}
+			kubernetesObj := []ctrlruntimeclient.Object{}
+			kubermaticObj = append(kubermaticObj, tc.ExistingKubermaticObjs...)
+			for _, existingNode := range tc.ExistingNodes {
+				kubernetesObj = append(kubernetesObj, existingNode)
+			}
+			for _, existingMachine := range tc.ExistingMachines {
+				machineObj = append(machineObj, existingMachine)
+			}
+			ep, clientsSets, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, kubernetesObj, machineObj, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			machines := &clusterv1alpha1.MachineList{}
+			if err := clientsSets.FakeClient.List(context.Background(), machines); err != nil {
+				t.Fatalf("failed to list machines from fake client: %v", err)
+			}
+
+			if machineCount := len(machines.Items); machineCount != tc.ExpectedNodeCount {
+				t.Errorf("Expected %d machines to be gone but got %d", tc.ExpectedNodeCount, machineCount)
+			}
+		})
+	}
+}
+
+func TestCordonNode(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name                  string
+		Action                string
+		NodeID                string
+		ExistingAPIUser       *apiv1.User
+		ExistingKubermaticObj []ctrlruntimeclient.Object
+		ExistingNodes         []*corev1.Node
+		ExpectedHTTPStatus    int
+		ExpectedResponse      string
+		ExpectedUnschedulable bool
+	}{
+		{
+			Name:   "scenario 1: cordon the machine node",
+			Action: "cordon",
+			NodeID: "venus",
+			ExistingKubermaticObj: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExistingNodes: []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
+			},
+			ExpectedHTTPStatus:    http.StatusOK,
+			ExpectedUnschedulable: true,
+		},
+		{
+			Name:   "scenario 2: uncordon the node",
+			Action: "uncordon",
+			NodeID: "venus",
+			ExistingKubermaticObj: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExistingNodes: []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}, Spec: corev1.NodeSpec{Unschedulable: true}},
+			},
+			ExpectedHTTPStatus:    http.StatusOK,
+			ExpectedUnschedulable: false,
+		},
+		{
+			Name:   "scenario 3: the admin John can cordon any cluster node",
+			Action: "cordon",
+			NodeID: "venus",
+			ExistingKubermaticObj: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAdminUser("John", "john@acme.com", true),
+			),
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+			ExistingNodes: []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
+			},
+			ExpectedHTTPStatus:    http.StatusOK,
+			ExpectedUnschedulable: true,
+		},
+		{
+			Name:   "scenario 4: the user John can not cordon Bob's cluster node",
+			Action: "cordon",
+			NodeID: "venus",
+			ExistingKubermaticObj: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAdminUser("John", "john@acme.com", false),
+			),
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+			ExistingNodes: []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
+			},
+			ExpectedHTTPStatus:    http.StatusForbidden,
+			ExpectedResponse:      `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+			ExpectedUnschedulable: false,
+		},
+		{
+			Name:   "scenario 5: cordon a node which doesn't exist",
+			Action: "cordon",
+			NodeID: "mars",
+			ExistingKubermaticObj: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExistingNodes: []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
+			},
+			ExpectedHTTPStatus:    http.StatusNotFound,
+			ExpectedResponse:      `{"error":{"code":404,"message":"Node \"mars\" not found"}}`,
+			ExpectedUnschedulable: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/nodes/%s/%s", test.GenDefaultProject().Name, test.GenDefaultCluster().Name, tc.NodeID, tc.Action), strings.NewReader(""))
+			res := httptest.NewRecorder()
+			kubernetesObj := []ctrlruntimeclient.Object{}
+			for _, existingNode := range tc.ExistingNodes {
+				kubernetesObj = append(kubernetesObj, existingNode)
+			}
+			ep, clientsSets, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, kubernetesObj, []ctrlruntimeclient.Object{}, tc.ExistingKubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.ExpectedHTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.ExpectedHTTPStatus, res.Code, res.Body.String())
+			}
+
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			} else {
+				node := &apiv1.Node{}
+				if err := json.Unmarshal(res.Body.Bytes(), node); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if node.Status.Unschedulable != tc.ExpectedUnschedulable {
+					t.Errorf("Expected the returned node to have unschedulable=%v", tc.ExpectedUnschedulable)
+				}
+			}
+
+			node := &corev1.Node{}
+			if err := clientsSets.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: "venus"}, node); err != nil {
+				t.Fatalf("failed to get node from fake client: %v", err)
+			}
+			if node.Spec.Unschedulable != tc.ExpectedUnschedulable {
+				t.Errorf("Expected node unschedulable=%v, got %v", tc.ExpectedUnschedulable, node.Spec.Unschedulable)
+			}
+		})
+	}
+}
+
+func TestPatchNodeLabels(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name               string
+		NodeID             string
+		Body               string
+		ExistingLabels     map[string]string
+		ExpectedHTTPStatus int
+		ExpectedResponse   string
+		ExpectedLabels     map[string]string
+	}{
+		{
+			Name:               "scenario 1: set a node label",
+			NodeID:             "venus",
+			Body:               `{"team":"frontend"}`,
+			ExistingLabels:     map[string]string{"kubernetes.io/hostname": "venus"},
+			ExpectedHTTPStatus: http.StatusOK,
+			ExpectedLabels:     map[string]string{"kubernetes.io/hostname": "venus", "team": "frontend"},
+		},
+		{
+			Name:               "scenario 2: remove a node label",
+			NodeID:             "venus",
+			Body:               `{"team":null,"env":"prod"}`,
+			ExistingLabels:     map[string]string{"kubernetes.io/hostname": "venus", "team": "frontend"},
+			ExpectedHTTPStatus: http.StatusOK,
+			ExpectedLabels:     map[string]string{"kubernetes.io/hostname": "venus", "env": "prod"},
+		},
+		{
+			Name:               "scenario 3: labels with the kubernetes.io/ prefix can not be changed",
+			NodeID:             "venus",
+			Body:               `{"kubernetes.io/hostname":null}`,
+			ExistingLabels:     map[string]string{"kubernetes.io/hostname": "venus"},
+			ExpectedHTTPStatus: http.StatusBadRequest,
+			ExpectedResponse:   `{"error":{"code":400,"message":"label \"kubernetes.io/hostname\" can not be changed, the prefix \"kubernetes.io/\" is reserved"}}`,
+			ExpectedLabels:     map[string]string{"kubernetes.io/hostname": "venus"},
+		},
+		{
+			Name:               "scenario 4: labels with the node.kubernetes.io/ prefix can not be changed",
+			NodeID:             "venus",
+			Body:               `{"node.kubernetes.io/exclude-from-external-load-balancers":"true"}`,
+			ExistingLabels:     map[string]string{"kubernetes.io/hostname": "venus"},
+			ExpectedHTTPStatus: http.StatusBadRequest,
+			ExpectedResponse:   `{"error":{"code":400,"message":"label \"node.kubernetes.io/exclude-from-external-load-balancers\" can not be changed, the prefix \"node.kubernetes.io/\" is reserved"}}`,
+			ExpectedLabels:     map[string]string{"kubernetes.io/hostname": "venus"},
+		},
+		{
+			Name:               "scenario 5: set labels of a node which doesn't exist",
+			NodeID:             "mars",
+			Body:               `{"team":"frontend"}`,
+			ExistingLabels:     map[string]string{"kubernetes.io/hostname": "venus"},
+			ExpectedHTTPStatus: http.StatusNotFound,
+			ExpectedResponse:   `{"error":{"code":404,"message":"Node \"mars\" not found"}}`,
+			ExpectedLabels:     map[string]string{"kubernetes.io/hostname": "venus"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/nodes/%s/labels", test.GenDefaultProject().Name, test.GenDefaultCluster().Name, tc.NodeID), strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+			kubernetesObj := []ctrlruntimeclient.Object{
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "venus", Labels: tc.ExistingLabels}},
+			}
+			kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster())
+			ep, clientsSets, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, kubernetesObj, []ctrlruntimeclient.Object{}, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.ExpectedHTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.ExpectedHTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+
+			node := &corev1.Node{}
+			if err := clientsSets.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: "venus"}, node); err != nil {
+				t.Fatalf("failed to get node from fake client: %v", err)
+			}
+			if !reflect.DeepEqual(node.Labels, tc.ExpectedLabels) {
+				t.Errorf("Expected node labels %v, got %v", tc.ExpectedLabels, node.Labels)
+			}
+		})
+	}
+}
+
+func genNodePods() []ctrlruntimeclient.Object {
+	return []ctrlruntimeclient.Object{
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "mars"}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", Labels: map[string]string{"app": "web"}},
+			Spec: corev1.PodSpec{
+				NodeName: "venus",
+				Containers: []corev1.Container{
+					{Name: "web", Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m"), corev1.ResourceMemory: resource.MustParse("128Mi")}}},
+					{Name: "proxy", Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")}}},
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase:             corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "web", RestartCount: 2}, {Name: "proxy", RestartCount: 1}},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "coredns", Namespace: "kube-system"},
+			Spec:       corev1.PodSpec{NodeName: "venus", Containers: []corev1.Container{{Name: "coredns"}}},
+			Status:     corev1.PodStatus{Phase: corev1.PodPending},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default", Labels: map[string]string{"app": "db"}},
+			Spec:       corev1.PodSpec{NodeName: "mars", Containers: []corev1.Container{{Name: "db"}}},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+	}
+}
+
+func TestListNodePods(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name                  string
+		NodeID                string
+		ExistingAPIUser       *apiv1.User
+		ExistingKubermaticObj []ctrlruntimeclient.Object
+		ExpectedHTTPStatus    int
+		ExpectedResponse      string
+	}{
+		{
+			Name:                  "scenario 1: list the pods of a node",
+			NodeID:                "venus",
+			ExistingAPIUser:       test.GenDefaultAPIUser(),
+			ExistingKubermaticObj: test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster()),
+			ExpectedHTTPStatus:    http.StatusOK,
+			ExpectedResponse:      `[{"name":"web","namespace":"default","phase":"Running","restarts":3,"cpuRequestMillicores":300,"memoryRequestBytes":134217728},{"name":"coredns","namespace":"kube-system","phase":"Pending","restarts":0,"cpuRequestMillicores":0,"memoryRequestBytes":0}]`,
+		},
+		{
+			Name:                  "scenario 2: pods of other nodes are not listed",
+			NodeID:                "mars",
+			ExistingAPIUser:       test.GenDefaultAPIUser(),
+			ExistingKubermaticObj: test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster()),
+			ExpectedHTTPStatus:    http.StatusOK,
+			ExpectedResponse:      `[{"name":"db","namespace":"default","phase":"Running","restarts":0,"cpuRequestMillicores":0,"memoryRequestBytes":0}]`,
+		},
+		{
+			Name:                  "scenario 3: the user John can not list the pods of Bob's cluster node",
+			NodeID:                "venus",
+			ExistingAPIUser:       test.GenAPIUser("John", "john@acme.com"),
+			ExistingKubermaticObj: test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster(), test.GenAdminUser("John", "john@acme.com", false)),
+			ExpectedHTTPStatus:    http.StatusForbidden,
+			ExpectedResponse:      `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+		},
+		{
+			Name:                  "scenario 4: list the pods of a node which doesn't exist",
+			NodeID:                "pluto",
+			ExistingAPIUser:       test.GenDefaultAPIUser(),
+			ExistingKubermaticObj: test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster()),
+			ExpectedHTTPStatus:    http.StatusNotFound,
+			ExpectedResponse:      `{"error":{"code":404,"message":"Node \"pluto\" not found"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/nodes/%s/pods", test.GenDefaultProject().Name, test.GenDefaultCluster().Name, tc.NodeID), nil)
+			res := httptest.NewRecorder()
+			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, genNodePods(), []ctrlruntimeclient.Object{}, tc.ExistingKubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.ExpectedHTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.ExpectedHTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func TestEvictNodePod(t *testing.T) {
+	t.Parallel()
+
+	// The fake client doesn't know about pod disruption budgets, so the error of the API server is returned for
+	// blocked evictions.
+	blockedByPDB := interceptor.Funcs{
+		SubResourceCreate: func(_ context.Context, _ ctrlruntimeclient.Client, subResourceName string, _ ctrlruntimeclient.Object, _ ctrlruntimeclient.Object, _ ...ctrlruntimeclient.SubResourceCreateOption) error {
+			return &apierrors.StatusError{ErrStatus: metav1.Status{
+				Status:  metav1.StatusFailure,
+				Code:    http.StatusTooManyRequests,
+				Reason:  metav1.StatusReasonTooManyRequests,
+				Message: "Cannot evict pod as it would violate the pod's disruption budget.",
+			}}
+		},
+	}
+	pdbs := []ctrlruntimeclient.Object{
+		&policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-pdb", Namespace: "default"},
+			Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+		},
+		&policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-pdb", Namespace: "default"},
+			Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+		},
+	}
+
+	testcases := []struct {
+		Name                  string
+		NodeID                string
+		Pod                   string
+		ExistingAPIUser       *apiv1.User
+		ExistingKubermaticObj []ctrlruntimeclient.Object
+		Interceptor           *interceptor.Funcs
+		ExpectedHTTPStatus    int
+		ExpectedResponse      string
+		ExpectEvicted         bool
+	}{
+		{
+			Name:                  "scenario 1: evict a pod of the node",
+			NodeID:                "venus",
+			Pod:                   "default/web",
+			ExistingAPIUser:       test.GenDefaultAPIUser(),
+			ExistingKubermaticObj: test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster()),
+			ExpectedHTTPStatus:    http.StatusOK,
+			ExpectEvicted:         true,
+		},
+		{
+			Name:                  "scenario 2: pods of other nodes can not be evicted",
+			NodeID:                "venus",
+			Pod:                   "default/db",
+			ExistingAPIUser:       test.GenDefaultAPIUser(),
+			ExistingKubermaticObj: test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster()),
+			ExpectedHTTPStatus:    http.StatusNotFound,
+			ExpectedResponse:      `{"error":{"code":404,"message":"Pod \"default/db\" not found"}}`,
+		},
+		{
+			Name:                  "scenario 3: an eviction which is blocked by a pod disruption budget returns its name",
+			NodeID:                "venus",
+			Pod:                   "default/web",
+			ExistingAPIUser:       test.GenDefaultAPIUser(),
+			ExistingKubermaticObj: test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster()),
+			Interceptor:           &blockedByPDB,
+			ExpectedHTTPStatus:    http.StatusTooManyRequests,
+			ExpectedResponse:      `{"error":{"code":429,"message":"cannot evict pod default/web, it is blocked by the pod disruption budget web-pdb"}}`,
+		},
+		{
+			Name:                  "scenario 4: the user John can not evict pods of Bob's cluster node",
+			NodeID:                "venus",
+			Pod:                   "default/web",
+			ExistingAPIUser:       test.GenAPIUser("John", "john@acme.com"),
+			ExistingKubermaticObj: test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster(), test.GenAdminUser("John", "john@acme.com", false)),
+			ExpectedHTTPStatus:    http.StatusForbidden,
+			ExpectedResponse:      `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/nodes/%s/pods/%s/evict", test.GenDefaultProject().Name, test.GenDefaultCluster().Name, tc.NodeID, tc.Pod), nil)
+			res := httptest.NewRecorder()
+
+			kubernetesObj := append(genNodePods(), pdbs...)
+			funcs := interceptor.Funcs{}
+			if tc.Interceptor != nil {
+				funcs = *tc.Interceptor
+			}
+			ep, err := test.CreateTestEndpointWithUserClusterInterceptor(*tc.ExistingAPIUser, kubernetesObj, tc.ExistingKubermaticObj, nil, hack.NewTestRouting, funcs)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.ExpectedHTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.ExpectedHTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+
+			// The clients of the endpoint are not exposed, so the pods of the node are listed to check the eviction.
+			listReq := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/nodes/venus/pods", test.GenDefaultProject().Name, test.GenDefaultCluster().Name), nil)
+			listRes := httptest.NewRecorder()
+			ep.ServeHTTP(listRes, listReq)
+			if evicted := !strings.Contains(listRes.Body.String(), `"name":"web"`); listRes.Code == http.StatusOK && evicted != tc.ExpectEvicted {
+				t.Fatalf("expected the pod to be evicted: %v, got pods %s", tc.ExpectEvicted, listRes.Body.String())
+			}
+		})
+	}
+}
+
+func TestListMachineDeployments(t *testing.T) {
+	t.Parallel()
+	var replicas int32 = 1
+	var paused = false
+	testcases := []struct {
+		Name                       string
+		ExpectedResponse           []apiv1.NodeDeployment
+		HTTPStatus                 int
+		ProjectIDToSync            string
+		ClusterIDToSync            string
+		ExistingProject            *kubermaticv1.Project
+		ExistingKubermaticUser     *kubermaticv1.User
+		ExistingAPIUser            *apiv1.User
+		ExistingCluster            *kubermaticv1.Cluster
+		ExistingMachineDeployments []*clusterv1alpha1.MachineDeployment
+		ExistingKubermaticObjs     []ctrlruntimeclient.Object
+	}{
+		// scenario 1
+		{
+			Name:            "scenario 1: list machine deployments that belong to the given cluster",
+			HTTPStatus:      http.StatusOK,
+			ClusterIDToSync: test.GenDefaultCluster().Name,
+			ProjectIDToSync: test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
+				genTestMachineDeployment("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50,"diskType":"standard"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, nil, false),
+			},
+			ExpectedResponse: []apiv1.NodeDeployment{
+				{
+					ObjectMeta: apiv1.ObjectMeta{
+						ID:   "venus",
+						Name: "venus",
+					},
+					Spec: apiv1.NodeDeploymentSpec{
+						Template: apiv1.NodeSpec{
+							Cloud: apiv1.NodeCloudSpec{
+								Digitalocean: &apiv1.DigitaloceanNodeSpec{
+									Size: "2GB",
+								},
+							},
+							OperatingSystem: apiv1.OperatingSystemSpec{
+								Ubuntu: &apiv1.UbuntuSpec{
+									DistUpgradeOnBoot: true,
+								},
+							},
+							Versions: apiv1.NodeVersionInfo{
+								Kubelet: "v9.9.9",
+							},
+						},
+						Replicas:      replicas,
+						Paused:        &paused,
+						DynamicConfig: ptr.To(false),
+					},
+					Status: clusterv1alpha1.MachineDeploymentStatus{},
+				},
+				{
+					ObjectMeta: apiv1.ObjectMeta{
+						ID:   "mars",
+						Name: "mars",
+					},
+					Spec: apiv1.NodeDeploymentSpec{
+						Template: apiv1.NodeSpec{
+							Cloud: apiv1.NodeCloudSpec{
+								AWS: &apiv1.AWSNodeSpec{
+									InstanceType:     "t2.micro",
+									VolumeSize:       50,
 									VolumeType:       "standard",
 									AvailabilityZone: "eu-central-1a",
 									SubnetID:         "subnet-2bff4f43",
@@ -552,6 +3551,191 @@ func TestListMachineDeployments(t *testing.T) {
 	}
 }
 
+func TestListMachineDeploymentsWithNodeStatus(t *testing.T) {
+	t.Parallel()
+	const providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+
+	genNode := func(name string, ready corev1.ConditionStatus, annotations map[string]string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(name + "-node"), Annotations: annotations},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+			},
+		}
+	}
+
+	testcases := []struct {
+		Name               string
+		Query              string
+		ExpectedNodeStatus map[string]*apiv1.NodeDeploymentNodeStatus
+	}{
+		{
+			Name:  "scenario 1: the node status is included when requested",
+			Query: "?show_node_status=true",
+			ExpectedNodeStatus: map[string]*apiv1.NodeDeploymentNodeStatus{
+				"venus": {ReadyNodes: 1, TotalNodes: 2, UnavailableNodes: 1, RebootRequiredNodes: 1, OSUpdateUnknownNodes: 1},
+				"mars":  {ReadyNodes: 1, TotalNodes: 1, UnavailableNodes: 0, UpdatesPendingNodes: 1},
+			},
+		},
+		{
+			Name: "scenario 2: the node status is omitted by default",
+			ExpectedNodeStatus: map[string]*apiv1.NodeDeploymentNodeStatus{
+				"venus": nil,
+				"mars":  nil,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments%s",
+				test.GenDefaultProject().Name, test.GenDefaultCluster().Name, tc.Query), nil)
+			res := httptest.NewRecorder()
+			kubernetesObj := []ctrlruntimeclient.Object{
+				genNode("venus-1", corev1.ConditionTrue, map[string]string{machine.OSRebootRequiredAnnotation: "true"}),
+				genNode("venus-2", corev1.ConditionFalse, nil),
+				genNode("mars-1", corev1.ConditionTrue, map[string]string{machine.OSPendingSecurityUpdatesAnnotation: "4"}),
+			}
+			machineObj := []ctrlruntimeclient.Object{
+				genTestMachineDeployment("venus", providerSpec, map[string]string{"md": "venus"}, false),
+				genTestMachineDeployment("mars", providerSpec, map[string]string{"md": "mars"}, false),
+				genTestMachine("venus-1", providerSpec, map[string]string{"md": "venus"}, nil),
+				genTestMachine("venus-2", providerSpec, map[string]string{"md": "venus"}, nil),
+				genTestMachine("mars-1", providerSpec, map[string]string{"md": "mars"}, nil),
+			}
+			kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster())
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, kubernetesObj, machineObj, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != http.StatusOK {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+			}
+
+			nodeDeployments := test.NodeDeploymentSliceWrapper{}
+			nodeDeployments.DecodeOrDie(res.Body, t)
+			if len(nodeDeployments) != len(tc.ExpectedNodeStatus) {
+				t.Fatalf("expected %d machine deployments, got %d", len(tc.ExpectedNodeStatus), len(nodeDeployments))
+			}
+			for _, nd := range nodeDeployments {
+				if expected := tc.ExpectedNodeStatus[nd.Name]; !reflect.DeepEqual(nd.NodeStatus, expected) {
+					t.Errorf("expected node status %+v for machine deployment %s, got %+v", expected, nd.Name, nd.NodeStatus)
+				}
+			}
+		})
+	}
+}
+
+func TestGetMachineDeploymentWithMachineErrors(t *testing.T) {
+	t.Parallel()
+	const providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+
+	failedMachine := genTestMachine("venus-1", providerSpec, map[string]string{"md": "venus"}, nil)
+	failedMachine.Status.ErrorReason = ptr.To(clustercommon.InsufficientResourcesMachineError)
+	failedMachine.Status.ErrorMessage = ptr.To("quota exceeded")
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/venus?show_machine_errors=true",
+		test.GenDefaultProject().Name, test.GenDefaultCluster().Name), nil)
+	res := httptest.NewRecorder()
+	machineObj := []ctrlruntimeclient.Object{
+		genTestMachineDeployment("venus", providerSpec, map[string]string{"md": "venus"}, false),
+		failedMachine,
+		genTestMachine("venus-2", providerSpec, map[string]string{"md": "venus"}, nil),
+	}
+	kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster())
+	ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, machineObj, kubermaticObj, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+
+	nodeDeployment := apiv1.NodeDeployment{}
+	if err := json.Unmarshal(res.Body.Bytes(), &nodeDeployment); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	expected := []apiv1.MachineError{{Machine: "venus-1", Reason: "InsufficientResources", Message: "quota exceeded", Terminal: true}}
+	if !reflect.DeepEqual(nodeDeployment.MachineErrors, expected) {
+		t.Fatalf("expected machine errors %+v, got %+v", expected, nodeDeployment.MachineErrors)
+	}
+}
+
+func TestMachineDeploymentOperatingSystemWarnings(t *testing.T) {
+	t.Parallel()
+	const (
+		eolProviderSpec       = `{"cloudProvider":"openstack","cloudProviderSpec":{"flavor":"m1.small","image":"ubuntu-18.04"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+		supportedProviderSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+		eolWarning            = "operating system ubuntu 18.04 reached its end of life on 2023-05-31"
+	)
+
+	testcases := []struct {
+		Name             string
+		Path             string
+		ExpectedWarnings map[string][]string
+	}{
+		{
+			Name:             "scenario 1: getting a machine deployment with an end of life operating system returns a warning",
+			Path:             "/machinedeployments/venus",
+			ExpectedWarnings: map[string][]string{"venus": {eolWarning}},
+		},
+		{
+			Name:             "scenario 2: listing machine deployments only warns about the end of life operating system",
+			Path:             "/machinedeployments",
+			ExpectedWarnings: map[string][]string{"venus": {eolWarning}, "mars": nil},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s%s",
+				test.GenDefaultProject().Name, test.GenDefaultCluster().Name, tc.Path), nil)
+			res := httptest.NewRecorder()
+			machineObj := []ctrlruntimeclient.Object{
+				genTestMachineDeployment("venus", eolProviderSpec, nil, false),
+				genTestMachineDeployment("mars", supportedProviderSpec, nil, false),
+			}
+			kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster())
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, machineObj, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != http.StatusOK {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+			}
+
+			var nodeDeployments []apiv1.NodeDeployment
+			if strings.HasSuffix(tc.Path, "venus") {
+				nodeDeployments = make([]apiv1.NodeDeployment, 1)
+				err = json.Unmarshal(res.Body.Bytes(), &nodeDeployments[0])
+			} else {
+				err = json.Unmarshal(res.Body.Bytes(), &nodeDeployments)
+			}
+			if err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			warnings := map[string][]string{}
+			for _, nd := range nodeDeployments {
+				warnings[nd.Name] = nd.Warnings
+			}
+			if !reflect.DeepEqual(warnings, tc.ExpectedWarnings) {
+				t.Fatalf("expected warnings %v, got %v", tc.ExpectedWarnings, warnings)
+			}
+		})
+	}
+}
+
 func TestGetMachineDeployment(t *testing.T) {
 	t.Parallel()
 	var replicas int32 = 1
@@ -802,6 +3986,110 @@ func TestGetMachineDeployment(t *testing.T) {
 				Status: clusterv1alpha1.MachineDeploymentStatus{},
 			},
 		},
+		{
+			Name:            "scenario 6: internal annotations are not returned to users",
+			HTTPStatus:      http.StatusOK,
+			ClusterIDToSync: test.GenDefaultCluster().Name,
+			ProjectIDToSync: test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				func() *clusterv1alpha1.MachineDeployment {
+					md := genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false)
+					md.Annotations = map[string]string{
+						"kubermatic.k8c.io/internal": "true",
+						"test/annotation":            "true",
+					}
+					return md
+				}(),
+			},
+			ExpectedResponse: apiv1.NodeDeployment{
+				ObjectMeta: apiv1.ObjectMeta{
+					ID:   "venus",
+					Name: "venus",
+					Annotations: map[string]string{
+						"test/annotation": "true",
+					},
+				},
+				Spec: apiv1.NodeDeploymentSpec{
+					Template: apiv1.NodeSpec{
+						Cloud: apiv1.NodeCloudSpec{
+							Digitalocean: &apiv1.DigitaloceanNodeSpec{
+								Size: "2GB",
+							},
+						},
+						OperatingSystem: apiv1.OperatingSystemSpec{
+							Ubuntu: &apiv1.UbuntuSpec{
+								DistUpgradeOnBoot: true,
+							},
+						},
+						Versions: apiv1.NodeVersionInfo{
+							Kubelet: "v9.9.9",
+						},
+					},
+					Replicas:      replicas,
+					Paused:        &paused,
+					DynamicConfig: ptr.To(false),
+				},
+				Status: clusterv1alpha1.MachineDeploymentStatus{},
+			},
+		},
+		{
+			Name:            "scenario 7: internal annotations are returned to admins",
+			HTTPStatus:      http.StatusOK,
+			ClusterIDToSync: test.GenDefaultCluster().Name,
+			ProjectIDToSync: test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAdminUser("John", "john@acme.com", true),
+			),
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				func() *clusterv1alpha1.MachineDeployment {
+					md := genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false)
+					md.Annotations = map[string]string{
+						"kubermatic.k8c.io/internal": "true",
+						"test/annotation":            "true",
+					}
+					return md
+				}(),
+			},
+			ExpectedResponse: apiv1.NodeDeployment{
+				ObjectMeta: apiv1.ObjectMeta{
+					ID:   "venus",
+					Name: "venus",
+					Annotations: map[string]string{
+						"kubermatic.k8c.io/internal": "true",
+						"test/annotation":            "true",
+					},
+				},
+				Spec: apiv1.NodeDeploymentSpec{
+					Template: apiv1.NodeSpec{
+						Cloud: apiv1.NodeCloudSpec{
+							Digitalocean: &apiv1.DigitaloceanNodeSpec{
+								Size: "2GB",
+							},
+						},
+						OperatingSystem: apiv1.OperatingSystemSpec{
+							Ubuntu: &apiv1.UbuntuSpec{
+								DistUpgradeOnBoot: true,
+							},
+						},
+						Versions: apiv1.NodeVersionInfo{
+							Kubelet: "v9.9.9",
+						},
+					},
+					Replicas:      replicas,
+					Paused:        &paused,
+					DynamicConfig: ptr.To(false),
+				},
+				Status: clusterv1alpha1.MachineDeploymentStatus{},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -900,9 +4188,10 @@ func TestListMachineDeploymentNodes(t *testing.T) {
 						},
 					},
 					Status: apiv1.NodeStatus{
-						MachineName: "venus-1",
-						Capacity:    apiv1.NodeResources{},
-						Allocatable: apiv1.NodeResources{},
+						MachineName:    "venus-1",
+						Capacity:       apiv1.NodeResources{},
+						Allocatable:    apiv1.NodeResources{},
+						OSUpdateStatus: &apiv1.NodeOSUpdateStatus{State: apiv1.NodeOSUpdateUnknown},
 					},
 				},
 				{
@@ -927,9 +4216,10 @@ func TestListMachineDeploymentNodes(t *testing.T) {
 						},
 					},
 					Status: apiv1.NodeStatus{
-						MachineName: "venus-2",
-						Capacity:    apiv1.NodeResources{},
-						Allocatable: apiv1.NodeResources{},
+						MachineName:    "venus-2",
+						Capacity:       apiv1.NodeResources{},
+						Allocatable:    apiv1.NodeResources{},
+						OSUpdateStatus: &apiv1.NodeOSUpdateStatus{State: apiv1.NodeOSUpdateUnknown},
 					},
 				},
 			},
@@ -980,9 +4270,10 @@ func TestListMachineDeploymentNodes(t *testing.T) {
 						},
 					},
 					Status: apiv1.NodeStatus{
-						MachineName: "venus-1",
-						Capacity:    apiv1.NodeResources{},
-						Allocatable: apiv1.NodeResources{},
+						MachineName:    "venus-1",
+						Capacity:       apiv1.NodeResources{},
+						Allocatable:    apiv1.NodeResources{},
+						OSUpdateStatus: &apiv1.NodeOSUpdateStatus{State: apiv1.NodeOSUpdateUnknown},
 					},
 				},
 				{
@@ -1007,9 +4298,10 @@ func TestListMachineDeploymentNodes(t *testing.T) {
 						},
 					},
 					Status: apiv1.NodeStatus{
-						MachineName: "venus-2",
-						Capacity:    apiv1.NodeResources{},
-						Allocatable: apiv1.NodeResources{},
+						MachineName:    "venus-2",
+						Capacity:       apiv1.NodeResources{},
+						Allocatable:    apiv1.NodeResources{},
+						OSUpdateStatus: &apiv1.NodeOSUpdateStatus{State: apiv1.NodeOSUpdateUnknown},
 					},
 				},
 			},
@@ -1055,6 +4347,197 @@ func TestListMachineDeploymentNodes(t *testing.T) {
 	}
 }
 
+func TestListMachineDeploymentNodesOSUpdateStatus(t *testing.T) {
+	t.Parallel()
+	const providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+
+	genNode := func(name string, annotations map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(name + "-node"), Annotations: annotations}}
+	}
+	rebootRequired := true
+	noRebootRequired := false
+	pendingUpdates := 3
+	lastUpdate := apiv1.NewTime(time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC))
+
+	kubernetesObj := []ctrlruntimeclient.Object{
+		genNode("venus-1", map[string]string{
+			machine.OSRebootRequiredAnnotation:         "false",
+			machine.OSLastUpdateAnnotation:             "2025-03-01T12:00:00Z",
+			machine.OSPendingSecurityUpdatesAnnotation: "3",
+		}),
+		genNode("venus-2", map[string]string{
+			machine.OSRebootRequiredAnnotation: "true",
+			machine.OSLastUpdateAnnotation:     "2025-03-01T12:00:00Z",
+		}),
+		genNode("venus-3", nil),
+		genNode("venus-4", map[string]string{
+			machine.OSRebootRequiredAnnotation:         "maybe",
+			machine.OSLastUpdateAnnotation:             "yesterday",
+			machine.OSPendingSecurityUpdatesAnnotation: "-1",
+		}),
+	}
+	machineObj := []ctrlruntimeclient.Object{
+		genTestMachineDeployment("venus", providerSpec, map[string]string{"md": "venus"}, false),
+		genTestMachine("venus-1", providerSpec, map[string]string{"md": "venus"}, nil),
+		genTestMachine("venus-2", providerSpec, map[string]string{"md": "venus"}, nil),
+		genTestMachine("venus-3", providerSpec, map[string]string{"md": "venus"}, nil),
+		genTestMachine("venus-4", providerSpec, map[string]string{"md": "venus"}, nil),
+		genTestMachine("venus-5", providerSpec, map[string]string{"md": "venus"}, nil),
+	}
+	expectedStatus := map[string]*apiv1.NodeOSUpdateStatus{
+		"venus-1": {State: apiv1.NodeOSUpdatesPending, RebootRequired: &noRebootRequired, LastUpdate: &lastUpdate, PendingSecurityUpdates: &pendingUpdates},
+		"venus-2": {State: apiv1.NodeOSRebootRequired, RebootRequired: &rebootRequired, LastUpdate: &lastUpdate},
+		"venus-3": {State: apiv1.NodeOSUpdateUnknown},
+		"venus-4": {State: apiv1.NodeOSUpdateUnknown},
+		// the machine venus-5 has no node yet
+		"venus-5": {State: apiv1.NodeOSUpdateUnknown},
+	}
+
+	kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster())
+	ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, kubernetesObj, machineObj, kubermaticObj, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/venus/nodes",
+		test.GenDefaultProject().Name, test.GenDefaultCluster().Name), nil)
+	res := httptest.NewRecorder()
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+
+	nodes := test.NodeV1SliceWrapper{}
+	nodes.DecodeOrDie(res.Body, t)
+	if len(nodes) != len(expectedStatus) {
+		t.Fatalf("expected %d nodes, got %d", len(expectedStatus), len(nodes))
+	}
+	for _, node := range nodes {
+		expected := expectedStatus[node.ID]
+		actual := node.Status.OSUpdateStatus
+		if actual == nil {
+			t.Errorf("expected operating system update status %+v for node %s, got none", expected, node.ID)
+			continue
+		}
+		if actual.LastUpdate != nil && expected.LastUpdate != nil && actual.LastUpdate.Equal(expected.LastUpdate) {
+			actual.LastUpdate = expected.LastUpdate
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("expected operating system update status %+v for node %s, got %+v", expected, node.ID, actual)
+		}
+	}
+}
+
+func TestMachineDeploymentSpotInterruptions(t *testing.T) {
+	t.Parallel()
+	const spotProviderSpec = `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t3.medium","diskSize":50,"isSpotInstance":true}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`
+	const providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+
+	now := time.Now().UTC().Truncate(time.Second)
+	genNode := func(name string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(name + "-node")}}
+	}
+	genEvent := func(name, namespace, kind, object, reason, message string, lastTimestamp time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: namespace},
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: object},
+			Reason:         reason,
+			Message:        message,
+			LastTimestamp:  metav1.NewTime(lastTimestamp),
+		}
+	}
+
+	kubernetesObj := []ctrlruntimeclient.Object{
+		genNode("venus-1"),
+		genNode("venus-2"),
+		genNode("venus-3"),
+		genNode("mars-1"),
+		genEvent("venus-1.rebalance", metav1.NamespaceSystem, "Machine", "venus-1", "RebalanceRecommendation", "Rebalance recommendation received", now.Add(-2*time.Hour)),
+		genEvent("venus-1.interruption", metav1.NamespaceDefault, "Node", "venus-1", "SpotInterruption", "Spot interruption notice received, the instance is terminated at "+now.Format(time.RFC3339), now.Add(-time.Hour)),
+		genEvent("venus-2.interruption", metav1.NamespaceDefault, "Node", "venus-2", "SpotInterruption", "Spot interruption notice received", now.Add(-30*time.Hour)),
+		genEvent("venus-3.created", metav1.NamespaceSystem, "Machine", "venus-3", "Created", "Successfully created instance", now.Add(-time.Hour)),
+		genEvent("mars-1.interruption", metav1.NamespaceDefault, "Node", "mars-1", "SpotInterruption", "Spot interruption notice received", now.Add(-time.Hour)),
+	}
+	machineObj := []ctrlruntimeclient.Object{
+		genTestMachineDeployment("venus", spotProviderSpec, map[string]string{"md": "venus"}, false),
+		genTestMachineDeployment("mars", providerSpec, map[string]string{"md": "mars"}, false),
+		genTestMachine("venus-1", spotProviderSpec, map[string]string{"md": "venus"}, nil),
+		genTestMachine("venus-2", spotProviderSpec, map[string]string{"md": "venus"}, nil),
+		genTestMachine("venus-3", spotProviderSpec, map[string]string{"md": "venus"}, nil),
+		genTestMachine("mars-1", providerSpec, map[string]string{"md": "mars"}, nil),
+	}
+	kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster())
+	ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, kubernetesObj, machineObj, kubermaticObj, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	// the nodes show the latest notices of their machine and node
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/venus/nodes",
+		test.GenDefaultProject().Name, test.GenDefaultCluster().Name), nil)
+	res := httptest.NewRecorder()
+	ep.ServeHTTP(res, req)
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+
+	expectedInterruptions := map[string][]apiv1.NodeSpotInterruption{
+		"venus-1": {
+			{Type: apiv1.NodeSpotRebalanceRecommendation, Message: "Rebalance recommendation received", Time: apiv1.NewTime(now.Add(-2 * time.Hour))},
+			{Type: apiv1.NodeSpotTerminationNotice, Message: "Spot interruption notice received, the instance is terminated at " + now.Format(time.RFC3339), Time: apiv1.NewTime(now.Add(-time.Hour))},
+		},
+		"venus-2": {
+			{Type: apiv1.NodeSpotTerminationNotice, Message: "Spot interruption notice received", Time: apiv1.NewTime(now.Add(-30 * time.Hour))},
+		},
+		"venus-3": nil,
+	}
+	nodes := test.NodeV1SliceWrapper{}
+	nodes.DecodeOrDie(res.Body, t)
+	if len(nodes) != len(expectedInterruptions) {
+		t.Fatalf("expected %d nodes, got %d", len(expectedInterruptions), len(nodes))
+	}
+	for _, node := range nodes {
+		expected := expectedInterruptions[node.ID]
+		actual := node.Status.SpotInterruptions
+		if len(actual) != len(expected) {
+			t.Fatalf("expected spot interruptions %+v for node %s, got %+v", expected, node.ID, actual)
+		}
+		for i := range actual {
+			if actual[i].Type != expected[i].Type || actual[i].Message != expected[i].Message || !actual[i].Time.Equal(expected[i].Time.Time) {
+				t.Errorf("expected spot interruption %+v for node %s, got %+v", expected[i], node.ID, actual[i])
+			}
+		}
+	}
+
+	// the machine deployments count the machines with a termination notice in the last 24 hours, only for spot instances
+	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments?show_node_status=true",
+		test.GenDefaultProject().Name, test.GenDefaultCluster().Name), nil)
+	res = httptest.NewRecorder()
+	ep.ServeHTTP(res, req)
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+
+	expectedInterruptedNodes := map[string]*int{
+		"venus": ptr.To(1),
+		"mars":  nil,
+	}
+	nodeDeployments := test.NodeDeploymentSliceWrapper{}
+	nodeDeployments.DecodeOrDie(res.Body, t)
+	if len(nodeDeployments) != len(expectedInterruptedNodes) {
+		t.Fatalf("expected %d machine deployments, got %d", len(expectedInterruptedNodes), len(nodeDeployments))
+	}
+	for _, nd := range nodeDeployments {
+		if nd.NodeStatus == nil {
+			t.Fatalf("expected a node status for machine deployment %s", nd.Name)
+		}
+		if expected := expectedInterruptedNodes[nd.Name]; !reflect.DeepEqual(nd.NodeStatus.SpotInterruptedNodes, expected) {
+			t.Errorf("expected %v spot interrupted nodes for machine deployment %s, got %v", ptr.Deref(expected, -1), nd.Name, ptr.Deref(nd.NodeStatus.SpotInterruptedNodes, -1))
+		}
+	}
+}
+
 func TestListNodesForCluster(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
@@ -1289,7 +4772,88 @@ func TestListNodesForCluster(t *testing.T) {
 			wrappedExpectedNodes := test.NodeV1SliceWrapper(tc.ExpectedResponse)
 			wrappedExpectedNodes.Sort()
 
-			actualNodes.EqualOrDie(wrappedExpectedNodes, t)
+			actualNodes.EqualOrDie(wrappedExpectedNodes, t)
+		})
+	}
+}
+
+func TestListNodesForClusterWithLabelSelector(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name               string
+		LabelSelector      string
+		ExpectedHTTPStatus int
+		ExpectedResponse   string
+		ExpectedNodes      []string
+	}{
+		{
+			Name:               "scenario 1: list all nodes without a label selector",
+			ExpectedHTTPStatus: http.StatusOK,
+			ExpectedNodes:      []string{"mars", "mercury", "venus"},
+		},
+		{
+			Name:               "scenario 2: list nodes matching the label selector",
+			LabelSelector:      "team=frontend",
+			ExpectedHTTPStatus: http.StatusOK,
+			ExpectedNodes:      []string{"mercury", "venus"},
+		},
+		{
+			Name:               "scenario 3: list nodes matching a set based label selector",
+			LabelSelector:      "team notin (frontend)",
+			ExpectedHTTPStatus: http.StatusOK,
+			ExpectedNodes:      []string{"mars"},
+		},
+		{
+			Name:               "scenario 4: an invalid label selector is rejected",
+			LabelSelector:      "team in (frontend",
+			ExpectedHTTPStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			query := url.Values{}
+			if tc.LabelSelector != "" {
+				query.Set("label_selector", tc.LabelSelector)
+			}
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/nodes?%s", test.GenDefaultProject().Name, test.GenDefaultCluster().Name, query.Encode()), nil)
+			res := httptest.NewRecorder()
+			kubernetesObj := []ctrlruntimeclient.Object{
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "venus", UID: "venus", Labels: map[string]string{"team": "frontend"}}},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "mars", UID: "mars", Labels: map[string]string{"team": "backend"}}},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "mercury", UID: "mercury", Labels: map[string]string{"team": "frontend"}}},
+			}
+			machineObj := []ctrlruntimeclient.Object{
+				genTestMachine("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"},"operatingSystem":"ubuntu","operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123"}, nil),
+				genTestMachine("mars", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"},"operatingSystem":"ubuntu","operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123"}, nil),
+			}
+			kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster())
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, kubernetesObj, machineObj, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.ExpectedHTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.ExpectedHTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedHTTPStatus != http.StatusOK {
+				return
+			}
+
+			nodes := []apiv1.Node{}
+			if err := json.Unmarshal(res.Body.Bytes(), &nodes); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			names := []string{}
+			for _, node := range nodes {
+				names = append(names, node.Name)
+			}
+			sort.Strings(names)
+			if !reflect.DeepEqual(names, tc.ExpectedNodes) {
+				t.Errorf("Expected nodes %v, got %v", tc.ExpectedNodes, names)
+			}
 		})
 	}
 }
@@ -1354,93 +4918,553 @@ func TestMachineDeploymentMetrics(t *testing.T) {
 			},
 			ExpectedResponse: `[{"name":"venus-1","memoryTotalBytes":655,"memoryAvailableBytes":655,"memoryUsedPercentage":100,"cpuTotalMillicores":290104582000,"cpuAvailableMillicores":290104582000,"cpuUsedPercentage":100}]`,
 		},
-		// scenario 2
+		// scenario 2
+		{
+			Name:            "scenario 2: the admin John can get any metrics for the machine deployment nodes",
+			HTTPStatus:      http.StatusOK,
+			ClusterIDToSync: test.GenDefaultCluster().Name,
+			ProjectIDToSync: test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAdminUser("John", "john@acme.com", true),
+			),
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+			ExistingNodes: []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "venus-1"}, Status: corev1.NodeStatus{Allocatable: map[corev1.ResourceName]resource.Quantity{"cpu": cpuQuantity, "memory": memoryQuantity}}},
+			},
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123"}, false),
+				genTestMachineDeployment("mars", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
+			},
+			MachineDeploymentID: "venus",
+			ExistingMachines: []*clusterv1alpha1.Machine{
+				genTestMachine("venus-1", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"},"operatingSystem":"ubuntu","containerRuntimeInfo":{"name":"docker","version":"1.13"},"operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
+				genTestMachine("venus-2", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"},"operatingSystem":"ubuntu","containerRuntimeInfo":{"name":"docker","version":"1.13"},"operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123", "xyz": "abc"}, nil),
+			},
+			ExistingMetrics: []*v1beta1.NodeMetrics{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "venus-1"},
+					Usage:      map[corev1.ResourceName]resource.Quantity{"cpu": cpuQuantity, "memory": memoryQuantity},
+				},
+			},
+			ExpectedResponse: `[{"name":"venus-1","memoryTotalBytes":655,"memoryAvailableBytes":655,"memoryUsedPercentage":100,"cpuTotalMillicores":290104582000,"cpuAvailableMillicores":290104582000,"cpuUsedPercentage":100}]`,
+		},
+		// scenario 3
+		{
+			Name:            "scenario 3: the user John can not get Bob's metrics",
+			HTTPStatus:      http.StatusForbidden,
+			ClusterIDToSync: test.GenDefaultCluster().Name,
+			ProjectIDToSync: test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAdminUser("John", "john@acme.com", false),
+			),
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+			ExistingNodes: []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "venus-1"}, Status: corev1.NodeStatus{Allocatable: map[corev1.ResourceName]resource.Quantity{"cpu": cpuQuantity, "memory": memoryQuantity}}},
+			},
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123"}, false),
+				genTestMachineDeployment("mars", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
+			},
+			MachineDeploymentID: "venus",
+			ExistingMachines: []*clusterv1alpha1.Machine{
+				genTestMachine("venus-1", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"},"operatingSystem":"ubuntu","containerRuntimeInfo":{"name":"docker","version":"1.13"},"operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
+				genTestMachine("venus-2", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"},"operatingSystem":"ubuntu","containerRuntimeInfo":{"name":"docker","version":"1.13"},"operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123", "xyz": "abc"}, nil),
+			},
+			ExistingMetrics: []*v1beta1.NodeMetrics{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "venus-1"},
+					Usage:      map[corev1.ResourceName]resource.Quantity{"cpu": cpuQuantity, "memory": memoryQuantity},
+				},
+			},
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/%s/nodes/metrics", tc.ProjectIDToSync, tc.ClusterIDToSync, tc.MachineDeploymentID), strings.NewReader(""))
+			res := httptest.NewRecorder()
+			kubermaticObj := []ctrlruntimeclient.Object{}
+			machineObj := []ctrlruntimeclient.Object{}
+			kubernetesObj := []ctrlruntimeclient.Object{}
+			for _, existingNode := range tc.ExistingNodes {
+				kubernetesObj = append(kubernetesObj, existingNode)
+			}
+			for _, existingMetric := range tc.ExistingMetrics {
+				machineObj = append(machineObj, existingMetric)
+			}
+			for _, existingMachineDeployment := range tc.ExistingMachineDeployments {
+				machineObj = append(machineObj, existingMachineDeployment)
+			}
+			for _, existingMachine := range tc.ExistingMachines {
+				machineObj = append(machineObj, existingMachine)
+			}
+			kubermaticObj = append(kubermaticObj, tc.ExistingKubermaticObjs...)
+			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, kubernetesObj, machineObj, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func TestExportNodesForCluster(t *testing.T) {
+	t.Parallel()
+
+	existingNodes := []ctrlruntimeclient.Object{
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "venus", UID: "venus-node"},
+			Status: corev1.NodeStatus{
+				Capacity: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("4Gi")},
+			},
+		},
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "byo", UID: "byo-node", Labels: map[string]string{corev1.LabelInstanceTypeStable: "m5.large"}},
+			Status: corev1.NodeStatus{
+				Capacity: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("2Gi")},
+				NodeInfo: corev1.NodeSystemInfo{KubeletVersion: "v9.9.8"},
+			},
+		},
+	}
+	existingMachineObjs := []ctrlruntimeclient.Object{
+		genTestMachineDeployment("venus-md", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123"}, false),
+		genTestMachine("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"},"operatingSystem":"ubuntu","operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123"}, nil),
+		&v1beta1.NodeMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: "venus"},
+			Usage:      corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+		},
+	}
+
+	testcases := []struct {
+		Name                string
+		Format              string
+		HTTPStatus          int
+		ExpectedContentType string
+		ExpectedFilename    string
+		ExpectedResponse    string
+	}{
+		{
+			Name:                "scenario 1: export nodes as CSV by default",
+			HTTPStatus:          http.StatusOK,
+			ExpectedContentType: "text/csv",
+			ExpectedFilename:    "attachment; filename=nodes-defClusterID.csv",
+			ExpectedResponse: "name,machine_deployment,cloud_provider,instance_size,kubelet_version,creation_timestamp,cpu_capacity_millicores,memory_capacity_bytes,cpu_usage_millicores,memory_usage_bytes\n" +
+				"venus,venus-md,digitalocean,2GB,v9.9.9,0001-01-01T00:00:00Z,2000,4294967296,250,1073741824\n" +
+				"byo,,,m5.large,v9.9.8,0001-01-01T00:00:00Z,1000,2147483648,,\n",
+		},
+		{
+			Name:                "scenario 2: export nodes as JSON",
+			Format:              "json",
+			HTTPStatus:          http.StatusOK,
+			ExpectedContentType: "application/json",
+			ExpectedFilename:    "attachment; filename=nodes-defClusterID.json",
+			ExpectedResponse:    `[{"name":"venus","machineDeployment":"venus-md","cloudProvider":"digitalocean","instanceSize":"2GB","kubeletVersion":"v9.9.9","creationTimestamp":"0001-01-01T00:00:00Z","cpuCapacityMillicores":2000,"memoryCapacityBytes":4294967296,"cpuUsageMillicores":250,"memoryUsageBytes":1073741824},{"name":"byo","instanceSize":"m5.large","kubeletVersion":"v9.9.8","creationTimestamp":"0001-01-01T00:00:00Z","cpuCapacityMillicores":1000,"memoryCapacityBytes":2147483648}]`,
+		},
+		{
+			Name:                "scenario 3: unsupported export format",
+			Format:              "xml",
+			HTTPStatus:          http.StatusBadRequest,
+			ExpectedContentType: "application/json",
+			ExpectedResponse:    `{"error":{"code":400,"message":"not supported export format: xml"}}` + "\n",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			url := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/nodes/export", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+			if tc.Format != "" {
+				url = fmt.Sprintf("%s?format=%s", url, tc.Format)
+			}
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+			res := httptest.NewRecorder()
+			kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster())
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, existingNodes, existingMachineObjs, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if contentType := res.Header().Get("Content-Type"); contentType != tc.ExpectedContentType {
+				t.Fatalf("Expected content type %q, got %q", tc.ExpectedContentType, contentType)
+			}
+			if disposition := res.Header().Get("Content-Disposition"); disposition != tc.ExpectedFilename {
+				t.Fatalf("Expected content disposition %q, got %q", tc.ExpectedFilename, disposition)
+			}
+			if res.Body.String() != tc.ExpectedResponse {
+				t.Fatalf("Expected response %q, got %q", tc.ExpectedResponse, res.Body.String())
+			}
+		})
+	}
+}
+
+func TestExportMachineDeployments(t *testing.T) {
+	t.Parallel()
+
+	const providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+	venus := genTestMachineDeployment("venus", providerSpec, nil, false)
+	venus.UID = "venus-uid"
+	venus.Annotations = map[string]string{
+		"team": "ml",
+		"machinedeployment.clusters.k8s.io/revision":                "3",
+		"cluster.k8s.io/cluster-api-autoscaler-node-group-min-size": "1",
+		"cluster.k8s.io/cluster-api-autoscaler-node-group-max-size": "3",
+	}
+	venus.Spec.Template.Spec.Labels = map[string]string{
+		"system/cluster": test.GenDefaultCluster().Name,
+		"system/project": test.GenDefaultProject().Name,
+		"pool":           "gpu",
+	}
+	venus.Status.Replicas = 1
+	existingMachineObjs := []ctrlruntimeclient.Object{
+		venus,
+		genTestMachineDeployment("mars", providerSpec, nil, false),
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/export", test.GenDefaultProject().Name, test.GenDefaultCluster().Name), nil)
+	res := httptest.NewRecorder()
+	kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster())
+	ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, existingMachineObjs, kubermaticObj, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+	if contentType := res.Header().Get("Content-Type"); contentType != "application/yaml" {
+		t.Fatalf("Expected content type %q, got %q", "application/yaml", contentType)
+	}
+	if disposition := res.Header().Get("Content-Disposition"); disposition != "attachment; filename=machinedeployments-defClusterID.yaml" {
+		t.Fatalf("Expected content disposition %q, got %q", "attachment; filename=machinedeployments-defClusterID.yaml", disposition)
+	}
+
+	export := res.Body.String()
+	documents := strings.Split(export, "---\n")
+	if len(documents) != 2 {
+		t.Fatalf("expected 2 documents, got %d: %s", len(documents), export)
+	}
+	for _, field := range []string{"status:", "id:", "creationTimestamp:", "system/", "revision", "autoscaler"} {
+		if strings.Contains(export, field) {
+			t.Fatalf("expected %q to be dropped from the export, got %s", field, export)
+		}
+	}
+
+	manifests := make([]apiv2.MachineDeploymentManifest, 0, len(documents))
+	for _, document := range documents {
+		manifest := apiv2.MachineDeploymentManifest{}
+		if err := yaml.UnmarshalStrict([]byte(document), &manifest); err != nil {
+			t.Fatalf("failed to unmarshal manifest: %v", err)
+		}
+		manifests = append(manifests, manifest)
+	}
+	if manifests[0].Name != "mars" || manifests[1].Name != "venus" {
+		t.Fatalf("expected the manifests to be sorted by name, got %s and %s", manifests[0].Name, manifests[1].Name)
+	}
+	if !reflect.DeepEqual(manifests[1].Annotations, map[string]string{"team": "ml"}) {
+		t.Fatalf("expected only the user annotations, got %v", manifests[1].Annotations)
+	}
+	if !reflect.DeepEqual(manifests[1].Spec.Template.Labels, map[string]string{"pool": "gpu"}) {
+		t.Fatalf("expected only the user labels, got %v", manifests[1].Spec.Template.Labels)
+	}
+	if manifests[1].Spec.Template.Cloud.Digitalocean == nil || manifests[1].Spec.Template.Cloud.Digitalocean.Size != "2GB" {
+		t.Fatalf("expected the digitalocean spec to be exported, got %s", export)
+	}
+}
+
+func TestListProjectMachineDeployments(t *testing.T) {
+	t.Parallel()
+
+	const providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+	legacyCluster := test.GenCluster("legacyClusterID", "legacyClusterName", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+
+	oldMD := genTestMachineDeployment("mars", providerSpec, nil, false)
+	oldMD.Spec.Template.Spec.Versions.Kubelet = "v8.8.8"
+	userClusterObjects := map[string][]ctrlruntimeclient.Object{
+		test.GenDefaultCluster().Name: {genTestMachineDeployment("venus", providerSpec, nil, false)},
+		legacyCluster.Name:            {oldMD, genTestMachineDeployment("jupiter", providerSpec, nil, false)},
+	}
+
+	testcases := []struct {
+		Name                string
+		Query               string
+		User                *apiv1.User
+		UnreachableClusters bool
+		HTTPStatus          int
+		ExpectedEntries     []string
+		ExpectedResponse    string
+	}{
+		{
+			Name:            "scenario 1: the machine deployments of all clusters are listed",
+			User:            test.GenDefaultAPIUser(),
+			HTTPStatus:      http.StatusOK,
+			ExpectedEntries: []string{"us-central1/defClusterID/venus", "us-central1/legacyClusterID/jupiter", "us-central1/legacyClusterID/mars"},
+		},
+		{
+			Name:            "scenario 2: only the machine deployments with an older kubelet are listed",
+			Query:           "?kubelet_older_than=v9.0.0",
+			User:            test.GenDefaultAPIUser(),
+			HTTPStatus:      http.StatusOK,
+			ExpectedEntries: []string{"us-central1/legacyClusterID/mars"},
+		},
+		{
+			Name:             "scenario 3: an invalid kubelet version is rejected",
+			Query:            "?kubelet_older_than=latest",
+			User:             test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"invalid kubelet_older_than version \"latest\": Invalid Semantic Version"}}`,
+		},
+		{
+			Name:                "scenario 4: unreachable clusters are reported with an error entry",
+			User:                test.GenDefaultAPIUser(),
+			UnreachableClusters: true,
+			HTTPStatus:          http.StatusOK,
+			ExpectedEntries: []string{
+				"us-central1/defClusterID/failed to list the machine deployments of the cluster",
+				"us-central1/legacyClusterID/failed to list the machine deployments of the cluster",
+			},
+		},
+		{
+			Name:             "scenario 5: the user must be a member of the project",
+			User:             test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/machinedeployments%s", test.GenDefaultProject().Name, tc.Query), nil)
+			res := httptest.NewRecorder()
+			kubermaticObjs := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster(), legacyCluster.DeepCopy())
+
+			var ep http.Handler
+			var err error
+			if tc.UnreachableClusters {
+				ep, err = test.CreateTestEndpointWithUserClusterInterceptor(*tc.User, nil, kubermaticObjs, nil, hack.NewTestRouting, interceptor.Funcs{
+					List: func(_ context.Context, _ ctrlruntimeclient.WithWatch, _ ctrlruntimeclient.ObjectList, _ ...ctrlruntimeclient.ListOption) error {
+						return apierrors.NewServiceUnavailable("connection refused")
+					},
+				})
+			} else {
+				ep, err = test.CreateTestEndpointWithUserClusterObjects(*tc.User, nil, kubermaticObjs, userClusterObjects, nil, hack.NewTestRouting)
+			}
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			entries := []apiv2.ProjectMachineDeployment{}
+			if err := json.Unmarshal(res.Body.Bytes(), &entries); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			result := make([]string, 0, len(entries))
+			for _, entry := range entries {
+				if entry.MachineDeployment != nil {
+					result = append(result, fmt.Sprintf("%s/%s/%s", entry.Seed, entry.ClusterID, entry.MachineDeployment.Name))
+				} else {
+					result = append(result, fmt.Sprintf("%s/%s/%s", entry.Seed, entry.ClusterID, entry.Error))
+				}
+			}
+			if !reflect.DeepEqual(result, tc.ExpectedEntries) {
+				t.Fatalf("expected entries %v, got %v", tc.ExpectedEntries, result)
+			}
+		})
+	}
+}
+
+func TestImportMachineDeployments(t *testing.T) {
+	t.Parallel()
+
+	const (
+		providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+		marsManifest = `name: mars
+spec:
+  replicas: 2
+  template:
+    cloud:
+      digitalocean:
+        size: s-1vcpu-1gb
+    operatingSystem:
+      ubuntu:
+        distUpgradeOnBoot: false
+    versions:
+      kubelet: 9.9.9
+`
+		invalidManifest = `name: pluto
+spec:
+  replicas: 1
+  template:
+    cloud:
+      digitalocean:
+        size: s-1vcpu-1gb
+    operatingSystem:
+      ubuntu:
+        distUpgradeOnBoot: false
+    versions:
+      kubelet: 1.0.0
+`
+	)
+
+	kubermaticObjs := test.GenDefaultKubermaticObjects(test.GenTestSeed(), genTestCluster(true))
+	machineObjs := []ctrlruntimeclient.Object{genTestMachineDeployment("venus", providerSpec, nil, false)}
+	ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, machineObjs, kubermaticObjs, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+	basePath := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+
+	exportRes := httptest.NewRecorder()
+	ep.ServeHTTP(exportRes, httptest.NewRequest(http.MethodGet, basePath+"/export", nil))
+	if exportRes.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, exportRes.Code, exportRes.Body.String())
+	}
+	venusManifest := exportRes.Body.String()
+
+	// The steps share the endpoint, so that every import sees the machine deployments of the previous ones.
+	steps := []struct {
+		Name             string
+		Query            string
+		Body             string
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "step 1: new machine deployments are created, existing ones are skipped",
+			Body:             venusManifest + "---\n" + marsManifest,
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `[{"name":"venus","status":"skipped"},{"name":"mars","status":"created"}]`,
+		},
+		{
+			Name:             "step 2: importing the same manifests again changes nothing",
+			Body:             venusManifest + "---\n" + marsManifest,
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `[{"name":"venus","status":"skipped"},{"name":"mars","status":"skipped"}]`,
+		},
+		{
+			Name:             "step 3: existing machine deployments are updated with overwrite",
+			Query:            "?overwrite=true",
+			Body:             venusManifest + "---\n" + marsManifest,
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `[{"name":"venus","status":"updated"},{"name":"mars","status":"updated"}]`,
+		},
+		{
+			Name:             "step 4: invalid and duplicated manifests fail without affecting the others",
+			Body:             invalidManifest + "---\n" + "spec:\n  replicas: 1\n" + "---\n" + strings.Replace(marsManifest, "mars", "jupiter", 1) + "---\n" + strings.Replace(marsManifest, "mars", "jupiter", 1),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `[{"name":"pluto","status":"failed","error":"node deployment validation failed: kubelet version 1.0.0 is not compatible with control plane version 9.9.9"},{"name":"","status":"failed","error":"the name is required"},{"name":"jupiter","status":"created"},{"name":"jupiter","status":"failed","error":"the machine deployment is defined more than once"}]`,
+		},
+		{
+			Name:             "step 5: manifests with unknown fields are rejected",
+			Body:             "name: mars\nstatus:\n  replicas: 1\n",
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"unable to parse manifest 1: error unmarshaling JSON: while decoding JSON: json: unknown field \"status\""}}`,
+		},
+		{
+			Name:             "step 6: an empty document is rejected",
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"no machine deployment manifests were provided"}}`,
+		},
+	}
+
+	for _, step := range steps {
+		req := httptest.NewRequest(http.MethodPost, basePath+"/import"+step.Query, strings.NewReader(step.Body))
+		res := httptest.NewRecorder()
+
+		ep.ServeHTTP(res, req)
+
+		if res.Code != step.HTTPStatus {
+			t.Fatalf("%s: Expected HTTP status code %d, got %d: %s", step.Name, step.HTTPStatus, res.Code, res.Body.String())
+		}
+		test.CompareWithResult(t, res, step.ExpectedResponse)
+	}
+
+	mds := &clusterv1alpha1.MachineDeploymentList{}
+	if err := clients.FakeClient.List(context.Background(), mds); err != nil {
+		t.Fatalf("failed to list machine deployments: %v", err)
+	}
+	names := []string{}
+	for _, md := range mds.Items {
+		names = append(names, md.Name)
+	}
+	sort.Strings(names)
+	if !reflect.DeepEqual(names, []string{"jupiter", "mars", "venus"}) {
+		t.Fatalf("expected machine deployments jupiter, mars and venus, got %v", names)
+	}
+}
+
+func TestGetMachineConsoleLog(t *testing.T) {
+	t.Parallel()
+
+	existingMachineObjs := []ctrlruntimeclient.Object{
+		genTestMachineDeployment("venus-md", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123"}, false),
+		genTestMachine("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"},"operatingSystem":"ubuntu","operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123"}, nil),
+		genTestMachine("mars", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"},"operatingSystem":"ubuntu","operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "345"}, nil),
+	}
+
+	testcases := []struct {
+		Name             string
+		NodeID           string
+		TailBytes        string
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: the provider of the cluster has no console API",
+			NodeID:           "venus",
+			HTTPStatus:       http.StatusNotImplemented,
+			ExpectedResponse: `{"error":{"code":501,"message":"console logs are not supported for the fake provider"}}`,
+		},
 		{
-			Name:            "scenario 2: the admin John can get any metrics for the machine deployment nodes",
-			HTTPStatus:      http.StatusOK,
-			ClusterIDToSync: test.GenDefaultCluster().Name,
-			ProjectIDToSync: test.GenDefaultProject().Name,
-			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
-				test.GenTestSeed(),
-				test.GenDefaultCluster(),
-				test.GenAdminUser("John", "john@acme.com", true),
-			),
-			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
-			ExistingNodes: []*corev1.Node{
-				{ObjectMeta: metav1.ObjectMeta{Name: "venus-1"}, Status: corev1.NodeStatus{Allocatable: map[corev1.ResourceName]resource.Quantity{"cpu": cpuQuantity, "memory": memoryQuantity}}},
-			},
-			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
-				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123"}, false),
-				genTestMachineDeployment("mars", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
-			},
-			MachineDeploymentID: "venus",
-			ExistingMachines: []*clusterv1alpha1.Machine{
-				genTestMachine("venus-1", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"},"operatingSystem":"ubuntu","containerRuntimeInfo":{"name":"docker","version":"1.13"},"operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
-				genTestMachine("venus-2", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"},"operatingSystem":"ubuntu","containerRuntimeInfo":{"name":"docker","version":"1.13"},"operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123", "xyz": "abc"}, nil),
-			},
-			ExistingMetrics: []*v1beta1.NodeMetrics{
-				{
-					ObjectMeta: metav1.ObjectMeta{Name: "venus-1"},
-					Usage:      map[corev1.ResourceName]resource.Quantity{"cpu": cpuQuantity, "memory": memoryQuantity},
-				},
-			},
-			ExpectedResponse: `[{"name":"venus-1","memoryTotalBytes":655,"memoryAvailableBytes":655,"memoryUsedPercentage":100,"cpuTotalMillicores":290104582000,"cpuAvailableMillicores":290104582000,"cpuUsedPercentage":100}]`,
+			Name:             "scenario 2: the node does not belong to the machine deployment",
+			NodeID:           "mars",
+			HTTPStatus:       http.StatusNotFound,
+			ExpectedResponse: `{"error":{"code":404,"message":"Node \"mars\" not found"}}`,
 		},
-		// scenario 3
 		{
-			Name:            "scenario 3: the user John can not get Bob's metrics",
-			HTTPStatus:      http.StatusForbidden,
-			ClusterIDToSync: test.GenDefaultCluster().Name,
-			ProjectIDToSync: test.GenDefaultProject().Name,
-			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
-				test.GenTestSeed(),
-				test.GenDefaultCluster(),
-				test.GenAdminUser("John", "john@acme.com", false),
-			),
-			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
-			ExistingNodes: []*corev1.Node{
-				{ObjectMeta: metav1.ObjectMeta{Name: "venus-1"}, Status: corev1.NodeStatus{Allocatable: map[corev1.ResourceName]resource.Quantity{"cpu": cpuQuantity, "memory": memoryQuantity}}},
-			},
-			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
-				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123"}, false),
-				genTestMachineDeployment("mars", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
-			},
-			MachineDeploymentID: "venus",
-			ExistingMachines: []*clusterv1alpha1.Machine{
-				genTestMachine("venus-1", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"},"operatingSystem":"ubuntu","containerRuntimeInfo":{"name":"docker","version":"1.13"},"operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123", "some-other": "xyz"}, nil),
-				genTestMachine("venus-2", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"},"operatingSystem":"ubuntu","containerRuntimeInfo":{"name":"docker","version":"1.13"},"operatingSystemSpec":{"distUpgradeOnBoot":true}}`, map[string]string{"md-id": "123", "xyz": "abc"}, nil),
-			},
-			ExistingMetrics: []*v1beta1.NodeMetrics{
-				{
-					ObjectMeta: metav1.ObjectMeta{Name: "venus-1"},
-					Usage:      map[corev1.ResourceName]resource.Quantity{"cpu": cpuQuantity, "memory": memoryQuantity},
-				},
-			},
-			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			Name:             "scenario 3: invalid tail size",
+			NodeID:           "venus",
+			TailBytes:        "-1",
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"invalid value for tail_bytes: -1"}}`,
 		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.Name, func(t *testing.T) {
-			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/%s/nodes/metrics", tc.ProjectIDToSync, tc.ClusterIDToSync, tc.MachineDeploymentID), strings.NewReader(""))
-			res := httptest.NewRecorder()
-			kubermaticObj := []ctrlruntimeclient.Object{}
-			machineObj := []ctrlruntimeclient.Object{}
-			kubernetesObj := []ctrlruntimeclient.Object{}
-			for _, existingNode := range tc.ExistingNodes {
-				kubernetesObj = append(kubernetesObj, existingNode)
-			}
-			for _, existingMetric := range tc.ExistingMetrics {
-				machineObj = append(machineObj, existingMetric)
-			}
-			for _, existingMachineDeployment := range tc.ExistingMachineDeployments {
-				machineObj = append(machineObj, existingMachineDeployment)
-			}
-			for _, existingMachine := range tc.ExistingMachines {
-				machineObj = append(machineObj, existingMachine)
+			url := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/venus-md/nodes/%s/consolelog", test.GenDefaultProject().Name, test.GenDefaultCluster().Name, tc.NodeID)
+			if tc.TailBytes != "" {
+				url = fmt.Sprintf("%s?tail_bytes=%s", url, tc.TailBytes)
 			}
-			kubermaticObj = append(kubermaticObj, tc.ExistingKubermaticObjs...)
-			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, kubernetesObj, machineObj, kubermaticObj, nil, hack.NewTestRouting)
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+			res := httptest.NewRecorder()
+			kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster())
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, existingMachineObjs, kubermaticObj, nil, hack.NewTestRouting)
 			if err != nil {
 				t.Fatalf("failed to create test endpoint: %v", err)
 			}
@@ -1531,7 +5555,7 @@ func TestPatchMachineDeployment(t *testing.T) {
 		{
 			Name:                       "Scenario 4: Downgrade kubelet to too old",
 			Body:                       `{"spec":{"template":{"versions":{"kubelet":"9.6.0"}}}}`,
-			ExpectedResponse:           `{"error":{"code":400,"message":"kubelet version 9.6.0 is not compatible with control plane version 9.9.9"}}`,
+			ExpectedResponse:           `{"error":{"code":400,"message":"kubelet version 9.6.0 is not compatible with control plane version 9.9.9","reason":"VERSION_SKEW"}}`,
 			cluster:                    "keen-snyder",
 			HTTPStatus:                 http.StatusBadRequest,
 			project:                    test.GenDefaultProject().Name,
@@ -1547,7 +5571,7 @@ func TestPatchMachineDeployment(t *testing.T) {
 		{
 			Name:                       "Scenario 5: Upgrade kubelet to too new",
 			Body:                       `{"spec":{"template":{"versions":{"kubelet":"9.10.0"}}}}`,
-			ExpectedResponse:           `{"error":{"code":400,"message":"kubelet version 9.10.0 is not compatible with control plane version 9.9.9"}}`,
+			ExpectedResponse:           `{"error":{"code":400,"message":"kubelet version 9.10.0 is not compatible with control plane version 9.9.9","reason":"VERSION_SKEW"}}`,
 			cluster:                    "keen-snyder",
 			HTTPStatus:                 http.StatusBadRequest,
 			project:                    test.GenDefaultProject().Name,
@@ -1580,7 +5604,7 @@ func TestPatchMachineDeployment(t *testing.T) {
 		{
 			Name:                       "Scenario 7: The user John can not update Bob's machine deployment",
 			Body:                       fmt.Sprintf(`{"spec":{"replicas":%v}}`, replicasUpdated),
-			ExpectedResponse:           `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResponse:           `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 			cluster:                    "keen-snyder",
 			HTTPStatus:                 http.StatusForbidden,
 			project:                    test.GenDefaultProject().Name,
@@ -1625,7 +5649,7 @@ func TestPatchMachineDeployment(t *testing.T) {
 		{
 			Name:             "Scenario 9: Try to update replicas count over max",
 			Body:             fmt.Sprintf(`{"spec":{"replicas":%v}}`, replicasUpdated),
-			ExpectedResponse: `{"error":{"code":400,"message":"replica count (3) cannot be higher then autoscaler maxreplicas (2)"}}`,
+			ExpectedResponse: `{"error":{"code":400,"message":"replica count (3) cannot be higher then autoscaler maxreplicas (2)","reason":"AUTOSCALER_BOUNDS"}}`,
 			cluster:          "keen-snyder",
 			HTTPStatus:       http.StatusBadRequest,
 			project:          test.GenDefaultProject().Name,
@@ -1649,7 +5673,7 @@ func TestPatchMachineDeployment(t *testing.T) {
 		{
 			Name:             "Scenario 10: Try to update replicas count below min",
 			Body:             fmt.Sprintf(`{"spec":{"replicas":%v}}`, replicasUpdated),
-			ExpectedResponse: `{"error":{"code":400,"message":"replica count (3) cannot be lower then autoscaler minreplicas (5)"}}`,
+			ExpectedResponse: `{"error":{"code":400,"message":"replica count (3) cannot be lower then autoscaler minreplicas (5)","reason":"AUTOSCALER_BOUNDS"}}`,
 			cluster:          "keen-snyder",
 			HTTPStatus:       http.StatusBadRequest,
 			project:          test.GenDefaultProject().Name,
@@ -1763,6 +5787,150 @@ func TestPatchMachineDeployment(t *testing.T) {
 				genTestCluster(true),
 			),
 		},
+		// Scenario 15: Change the cloud provider to one not matching the cluster provider
+		{
+			Name:             "Scenario 15: Change the cloud provider to one not matching the cluster provider",
+			Body:             `{"spec":{"template":{"cloud":{"digitalocean":null,"aws":{"instanceType":"t3.small","diskSize":25,"volumeType":"standard","availabilityZone":"eu-central-1a"}}}}}`,
+			ExpectedResponse: `{"error":{"code":400,"message":"machine deployment cloud provider aws does not match cluster provider digitalocean"}}`,
+			cluster:          "keen-snyder",
+			HTTPStatus:       http.StatusBadRequest,
+			project:          test.GenDefaultProject().Name,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			NodeDeploymentID: "venus",
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
+			},
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestClusterWithCloud(kubermaticv1.CloudSpec{DatacenterName: "regular-do1", Digitalocean: &kubermaticv1.DigitaloceanCloudSpec{Token: "dummy-token"}}, nil),
+			),
+		},
+		// Scenario 16: Update a machine deployment matching the cluster provider
+		{
+			Name:             "Scenario 16: Update a machine deployment matching the cluster provider",
+			Body:             fmt.Sprintf(`{"spec":{"replicas":%v}}`, replicasUpdated),
+			ExpectedResponse: fmt.Sprintf(`{"id":"venus","name":"venus","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"replicas":%v,"template":{"cloud":{"digitalocean":{"size":"2GB","backups":false,"ipv6":false,"monitoring":false,"tags":["kubernetes","kubernetes-cluster-defClusterID","system-cluster-defClusterID","system-project-my-first-project-ID"]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":true}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"versions":{"kubelet":"v9.9.9"},"labels":{"system/cluster":"defClusterID","system/project":"my-first-project-ID"}},"paused":false,"dynamicConfig":false},"status":{}}`, replicasUpdated),
+			cluster:          "keen-snyder",
+			HTTPStatus:       http.StatusOK,
+			project:          test.GenDefaultProject().Name,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			NodeDeploymentID: "venus",
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
+			},
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestClusterWithCloud(kubermaticv1.CloudSpec{DatacenterName: "regular-do1", Digitalocean: &kubermaticv1.DigitaloceanCloudSpec{Token: "dummy-token"}}, nil),
+			),
+		},
+		// Scenario 17: Set a cluster-autoscaler annotation directly
+		{
+			Name:             "Scenario 17: Set a cluster-autoscaler annotation directly",
+			Body:             fmt.Sprintf(`{"annotations":{"%s":"5"}}`, machine.AutoscalerMaxSizeAnnotation),
+			ExpectedResponse: fmt.Sprintf(`{"error":{"code":400,"message":"annotation %s cannot be set directly, use minReplicas and maxReplicas instead"}}`, machine.AutoscalerMaxSizeAnnotation),
+			cluster:          "keen-snyder",
+			HTTPStatus:       http.StatusBadRequest,
+			project:          test.GenDefaultProject().Name,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			NodeDeploymentID: "venus",
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
+			},
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+			),
+		},
+		// Scenario 18: Unchanged cluster-autoscaler annotations are accepted and internal annotations are not returned
+		{
+			Name:             "Scenario 18: Unchanged cluster-autoscaler annotations are accepted and internal annotations are not returned",
+			Body:             fmt.Sprintf(`{"annotations":{"%s":"%v"},"spec":{"replicas":%v}}`, machine.AutoscalerMaxSizeAnnotation, maxReplicas, replicasUpdated),
+			ExpectedResponse: fmt.Sprintf(`{"id":"venus","name":"venus","annotations":{"%[1]s":"%[2]v","test/annotation":"true"},"creationTimestamp":"0001-01-01T00:00:00Z","spec":{"replicas":%[3]v,"template":{"cloud":{"digitalocean":{"size":"2GB","backups":false,"ipv6":false,"monitoring":false,"tags":["kubernetes","kubernetes-cluster-defClusterID","system-cluster-defClusterID","system-project-my-first-project-ID"]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":true}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"versions":{"kubelet":"v9.9.9"},"labels":{"system/cluster":"defClusterID","system/project":"my-first-project-ID"}},"paused":false,"dynamicConfig":false,"maxReplicas":%[2]v},"status":{}}`, machine.AutoscalerMaxSizeAnnotation, maxReplicas, replicasUpdated),
+			cluster:          "keen-snyder",
+			HTTPStatus:       http.StatusOK,
+			project:          test.GenDefaultProject().Name,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			NodeDeploymentID: "venus",
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				func() *clusterv1alpha1.MachineDeployment {
+					md := genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false)
+					md.Annotations = map[string]string{
+						machine.AutoscalerMaxSizeAnnotation: fmt.Sprint(maxReplicas),
+						"kubermatic.k8c.io/internal":        "true",
+						"test/annotation":                   "true",
+					}
+					return md
+				}(),
+			},
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+			),
+		},
+		// Scenario 19: Select an operating system profile
+		{
+			Name:             "Scenario 19: Select an operating system profile",
+			Body:             `{"spec":{"template":{"osProfile":"osp-custom"}}}`,
+			ExpectedResponse: `{"id":"venus","name":"venus","annotations":{"k8c.io/operating-system-profile":"osp-custom"},"creationTimestamp":"0001-01-01T00:00:00Z","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"2GB","backups":false,"ipv6":false,"monitoring":false,"tags":["kubernetes","kubernetes-cluster-defClusterID","system-cluster-defClusterID","system-project-my-first-project-ID"]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":true}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"versions":{"kubelet":"v9.9.9"},"labels":{"system/cluster":"defClusterID","system/project":"my-first-project-ID"},"osProfile":"osp-custom"},"paused":false,"dynamicConfig":false},"status":{}}`,
+			cluster:          "keen-snyder",
+			HTTPStatus:       http.StatusOK,
+			project:          test.GenDefaultProject().Name,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			NodeDeploymentID: "venus",
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
+			},
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+				genOperatingSystemProfile("osp-custom"),
+			),
+		},
+		// Scenario 20: Unknown operating system profiles are rejected
+		{
+			Name:             "Scenario 20: Unknown operating system profiles are rejected",
+			Body:             `{"spec":{"template":{"osProfile":"osp-unknown"}}}`,
+			ExpectedResponse: `{"error":{"code":400,"message":"operating system profile does not exist in the cluster: \"osp-unknown\""}}`,
+			cluster:          "keen-snyder",
+			HTTPStatus:       http.StatusBadRequest,
+			project:          test.GenDefaultProject().Name,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			NodeDeploymentID: "venus",
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
+			},
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+				genOperatingSystemProfile("osp-custom"),
+			),
+		},
+		// Scenario 21: Clearing the operating system profile removes its annotation
+		{
+			Name:             "Scenario 21: Clearing the operating system profile removes its annotation",
+			Body:             `{"spec":{"template":{"osProfile":""}}}`,
+			ExpectedResponse: `{"id":"venus","name":"venus","annotations":{"test/annotation":"true"},"creationTimestamp":"0001-01-01T00:00:00Z","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"2GB","backups":false,"ipv6":false,"monitoring":false,"tags":["kubernetes","kubernetes-cluster-defClusterID","system-cluster-defClusterID","system-project-my-first-project-ID"]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":true}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"versions":{"kubelet":"v9.9.9"},"labels":{"system/cluster":"defClusterID","system/project":"my-first-project-ID"}},"paused":false,"dynamicConfig":false},"status":{}}`,
+			cluster:          "keen-snyder",
+			HTTPStatus:       http.StatusOK,
+			project:          test.GenDefaultProject().Name,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			NodeDeploymentID: "venus",
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				func() *clusterv1alpha1.MachineDeployment {
+					md := genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false)
+					md.Annotations = map[string]string{
+						"k8c.io/operating-system-profile": "osp-custom",
+						"test/annotation":                 "true",
+					}
+					return md
+				}(),
+			},
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+				genOperatingSystemProfile("osp-custom"),
+			),
+		},
 	}
 
 	for _, tc := range testcases {
@@ -1793,6 +5961,110 @@ func TestPatchMachineDeployment(t *testing.T) {
 	}
 }
 
+func TestPauseAndResumeMachineDeployment(t *testing.T) {
+	t.Parallel()
+
+	const providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+	expectedResponse := func(paused bool) string {
+		return fmt.Sprintf(`{"id":"venus","name":"venus","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"replicas":1,"template":{"cloud":{"digitalocean":{"size":"2GB","backups":false,"ipv6":false,"monitoring":false,"tags":["kubernetes","kubernetes-cluster-defClusterID","system-cluster-defClusterID","system-project-my-first-project-ID"]}},"operatingSystem":{"ubuntu":{"distUpgradeOnBoot":true}},"network":{"cidr":"","gateway":"","dns":{"servers":null},"ipFamily":"IPv4"},"versions":{"kubelet":"v9.9.9"},"labels":{"system/cluster":"defClusterID","system/project":"my-first-project-ID"}},"paused":%t,"dynamicConfig":false},"status":{}}`, paused)
+	}
+
+	testcases := []struct {
+		Name                   string
+		Action                 string
+		Paused                 bool
+		ExpectedResponse       string
+		HTTPStatus             int
+		ExistingAPIUser        *apiv1.User
+		ExistingKubermaticObjs []ctrlruntimeclient.Object
+	}{
+		{
+			Name:             "scenario 1: pause a machine deployment",
+			Action:           "pause",
+			ExpectedResponse: expectedResponse(true),
+			HTTPStatus:       http.StatusOK,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+			),
+		},
+		{
+			Name:             "scenario 2: pausing an already paused machine deployment is a no-op",
+			Action:           "pause",
+			Paused:           true,
+			ExpectedResponse: expectedResponse(true),
+			HTTPStatus:       http.StatusOK,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+			),
+		},
+		{
+			Name:             "scenario 3: resume a paused machine deployment",
+			Action:           "resume",
+			Paused:           true,
+			ExpectedResponse: expectedResponse(false),
+			HTTPStatus:       http.StatusOK,
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+			),
+		},
+		{
+			Name:             "scenario 4: the admin John can pause any machine deployment",
+			Action:           "pause",
+			ExpectedResponse: expectedResponse(true),
+			HTTPStatus:       http.StatusOK,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+				test.GenAdminUser("John", "john@acme.com", true),
+			),
+		},
+		{
+			Name:             "scenario 5: the user John can not resume Bob's machine deployment",
+			Action:           "resume",
+			Paused:           true,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+			HTTPStatus:       http.StatusForbidden,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				genTestCluster(true),
+				test.GenAdminUser("John", "john@acme.com", false),
+			),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/%s/%s",
+				test.GenDefaultProject().Name, test.GenDefaultCluster().Name, "venus", tc.Action), nil)
+			res := httptest.NewRecorder()
+
+			md := genTestMachineDeployment("venus", providerSpec, nil, false)
+			md.Spec.Paused = tc.Paused
+
+			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, nil, []ctrlruntimeclient.Object{md}, tc.ExistingKubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
 func TestListNodeDeploymentNodesEvents(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
@@ -1935,32 +6207,474 @@ func TestListNodeDeploymentNodesEvents(t *testing.T) {
 				test.GenTestEvent("event-1", corev1.EventTypeNormal, "Started", "message started", "Machine", "venus-1-machine", "venus-1"),
 				test.GenTestEvent("event-2", corev1.EventTypeWarning, "Killed", "message killed", "Machine", "venus-1-machine", "venus-1"),
 			},
-			ExpectedResult: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID"}}`,
+			ExpectedResult: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/%s/nodes/events%s", tc.ProjectIDToSync, tc.ClusterIDToSync, tc.MachineDeploymentID, tc.QueryParams), strings.NewReader(""))
+			res := httptest.NewRecorder()
+			kubermaticObj := []ctrlruntimeclient.Object{}
+			machineObj := []ctrlruntimeclient.Object{}
+			kubernetesObj := []ctrlruntimeclient.Object{}
+			for _, existingNode := range tc.ExistingNodes {
+				kubernetesObj = append(kubernetesObj, existingNode)
+			}
+			for _, existingEvents := range tc.ExistingEvents {
+				kubernetesObj = append(kubernetesObj, existingEvents)
+			}
+			for _, existingMachineDeployment := range tc.ExistingMachineDeployments {
+				machineObj = append(machineObj, existingMachineDeployment)
+			}
+			for _, existingMachine := range tc.ExistingMachines {
+				machineObj = append(machineObj, existingMachine)
+			}
+			kubermaticObj = append(kubermaticObj, tc.ExistingKubermaticObjs...)
+
+			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, kubernetesObj, machineObj, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResult)
+		})
+	}
+}
+
+func TestDeleteMachineDeployment(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name                        string
+		HTTPStatus                  int
+		MachineIDToDelete           string
+		ClusterIDToSync             string
+		ProjectIDToSync             string
+		ExistingAPIUser             *apiv1.User
+		ExistingNodes               []*corev1.Node
+		ExistingMachineDeployments  []*clusterv1alpha1.MachineDeployment
+		ExistingKubermaticObjs      []ctrlruntimeclient.Object
+		ExpectedHTTPStatusOnGet     int
+		ExpectedNodeDeploymentCount int
+	}{
+		// scenario 1
+		{
+			Name:              "scenario 1: delete the machine deployments that belong to the given cluster",
+			HTTPStatus:        http.StatusOK,
+			MachineIDToDelete: "venus",
+			ClusterIDToSync:   test.GenDefaultCluster().Name,
+			ProjectIDToSync:   test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExistingNodes: []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "mars"}},
+			},
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
+				genTestMachineDeployment("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, nil, false),
+			},
+			// Even though the machine deployment object was deleted the associated node object was not.
+			// When the client GETs the previously deleted "node" it will get a valid response.
+			// That is only true for testing, but in a real cluster, the node object will get deleted by the garbage-collector as it has a ownerRef set.
+			ExpectedHTTPStatusOnGet:     http.StatusOK,
+			ExpectedNodeDeploymentCount: 1,
+		},
+		// scenario 2
+		{
+			Name:              "scenario 2: the admin John can delete any cluster machine deployment",
+			HTTPStatus:        http.StatusOK,
+			MachineIDToDelete: "venus",
+			ClusterIDToSync:   test.GenDefaultCluster().Name,
+			ProjectIDToSync:   test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAdminUser("John", "john@acme.com", true),
+			),
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+			ExistingNodes: []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "mars"}},
+			},
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
+				genTestMachineDeployment("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, nil, false),
+			},
+			// Even though the machine deployment object was deleted the associated node object was not.
+			// When the client GETs the previously deleted "node" it will get a valid response.
+			// That is only true for testing, but in a real cluster, the node object will get deleted by the garbage-collector as it has a ownerRef set.
+			ExpectedHTTPStatusOnGet:     http.StatusOK,
+			ExpectedNodeDeploymentCount: 1,
+		},
+		// scenario 3
+		{
+			Name:              "scenario 3: the user John can delete Bob's cluster machine deployment",
+			HTTPStatus:        http.StatusForbidden,
+			MachineIDToDelete: "venus",
+			ClusterIDToSync:   test.GenDefaultCluster().Name,
+			ProjectIDToSync:   test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAdminUser("John", "john@acme.com", false),
+			),
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+			ExistingNodes: []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "mars"}},
+			},
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
+				genTestMachineDeployment("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, nil, false),
+			},
+			ExpectedHTTPStatusOnGet:     http.StatusForbidden,
+			ExpectedNodeDeploymentCount: 2,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/%s",
+				tc.ProjectIDToSync, tc.ClusterIDToSync, tc.MachineIDToDelete), strings.NewReader(""))
+			res := httptest.NewRecorder()
+			kubermaticObj := []ctrlruntimeclient.Object{}
+			machineDeploymentObjects := []ctrlruntimeclient.Object{}
+			kubernetesObj := []ctrlruntimeclient.Object{}
+			kubermaticObj = append(kubermaticObj, tc.ExistingKubermaticObjs...)
+			for _, existingNode := range tc.ExistingNodes {
+				kubernetesObj = append(kubernetesObj, existingNode)
+			}
+			for _, existingMachineDeployment := range tc.ExistingMachineDeployments {
+				machineDeploymentObjects = append(machineDeploymentObjects, existingMachineDeployment)
+			}
+			ep, clientsSets, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, kubernetesObj, machineDeploymentObjects, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+			if err := clientsSets.FakeClient.List(context.Background(), machineDeployments); err != nil {
+				t.Fatalf("failed to list MachineDeployments: %v", err)
+			}
+
+			if machineDeploymentCount := len(machineDeployments.Items); machineDeploymentCount != tc.ExpectedNodeDeploymentCount {
+				t.Errorf("Expected to find %d  machineDeployments but got %d", tc.ExpectedNodeDeploymentCount, machineDeploymentCount)
+			}
+		})
+	}
+}
+
+func TestGracefulDeleteMachineDeployment(t *testing.T) {
+	handlercommon.GracefulDeletionPollInterval = time.Millisecond
+
+	testcases := []struct {
+		Name               string
+		Query              string
+		StuckReplicas      int32
+		HTTPStatus         int
+		ExpectedResponse   string
+		ExpectedScaledDown bool
+		ExpectedDeleted    bool
+	}{
+		{
+			Name:               "scenario 1: the machine deployment is deleted once all machines are removed",
+			Query:              "graceful=true",
+			HTTPStatus:         http.StatusOK,
+			ExpectedResponse:   `{"timeoutExceeded":false,"remainingReplicas":0}`,
+			ExpectedScaledDown: true,
+			ExpectedDeleted:    true,
+		},
+		{
+			Name:               "scenario 2: the machine deployment is deleted after the timeout if machines are left",
+			Query:              "graceful=true&timeout=50ms",
+			StuckReplicas:      1,
+			HTTPStatus:         http.StatusOK,
+			ExpectedResponse:   `{"timeoutExceeded":true,"remainingReplicas":1}`,
+			ExpectedScaledDown: true,
+			ExpectedDeleted:    true,
+		},
+		{
+			Name:            "scenario 3: without graceful the machine deployment is deleted right away",
+			Query:           "graceful=false",
+			HTTPStatus:      http.StatusOK,
+			ExpectedDeleted: true,
+		},
+		{
+			Name:             "scenario 4: a timeout requires a graceful deletion",
+			Query:            "timeout=5m",
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: "{\"error\":{\"code\":400,\"message\":\"`timeout` can only be set for a graceful deletion\"}}",
+		},
+		{
+			Name:             "scenario 5: an invalid timeout is rejected",
+			Query:            "graceful=true&timeout=-5m",
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: "{\"error\":{\"code\":400,\"message\":\"invalid value for `timeout`: \\\"-5m\\\", must be a positive duration\"}}",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			existingMachineDeployment := genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false)
+			existingMachineDeployment.Spec.Replicas = ptr.To[int32](3)
+			// the user cluster client is backed by the same fake client as the kubermatic objects
+			kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), genTestCluster(true), existingMachineDeployment)
+
+			var (
+				userClusterClient ctrlruntimeclient.Client
+				scaledDown        bool
+				replicas          int32 = 3
+			)
+			funcs := interceptor.Funcs{
+				Update: func(ctx context.Context, client ctrlruntimeclient.WithWatch, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.UpdateOption) error {
+					if md, ok := obj.(*clusterv1alpha1.MachineDeployment); ok && ptr.Deref(md.Spec.Replicas, 1) == 0 {
+						scaledDown = true
+					}
+					return client.Update(ctx, obj, opts...)
+				},
+				Get: func(ctx context.Context, client ctrlruntimeclient.WithWatch, key ctrlruntimeclient.ObjectKey, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.GetOption) error {
+					userClusterClient = client
+					if err := client.Get(ctx, key, obj, opts...); err != nil {
+						return err
+					}
+					// simulate the machine-controller removing one machine between the checks after the scale down
+					if md, ok := obj.(*clusterv1alpha1.MachineDeployment); ok {
+						if scaledDown && replicas > tc.StuckReplicas {
+							replicas--
+						}
+						md.Status.Replicas = replicas
+					}
+					return nil
+				},
+				Delete: func(ctx context.Context, client ctrlruntimeclient.WithWatch, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.DeleteOption) error {
+					userClusterClient = client
+					return client.Delete(ctx, obj, opts...)
+				},
+			}
+
+			ep, err := test.CreateTestEndpointWithUserClusterInterceptor(*test.GenDefaultAPIUser(), nil, kubermaticObj, nil, hack.NewTestRouting, funcs)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/venus?%s",
+				test.GenDefaultProject().Name, test.GenDefaultCluster().Name, tc.Query), strings.NewReader(""))
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+			if scaledDown != tc.ExpectedScaledDown {
+				t.Errorf("expected the machine deployment to be scaled down first: %v, got %v", tc.ExpectedScaledDown, scaledDown)
+			}
+			if userClusterClient == nil {
+				return
+			}
+
+			err = userClusterClient.Get(context.Background(), types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "venus"}, &clusterv1alpha1.MachineDeployment{})
+			if deleted := apierrors.IsNotFound(err); deleted != tc.ExpectedDeleted {
+				t.Errorf("expected the machine deployment to be deleted: %v, got %v (%v)", tc.ExpectedDeleted, deleted, err)
+			}
+		})
+	}
+}
+
+func TestGetMachineDeploymentJoiningScript(t *testing.T) {
+	t.Parallel()
+
+	ca, err := triple.NewCA("test-ca")
+	if err != nil {
+		t.Fatalf("failed to generate CA: %v", err)
+	}
+	caHash := sha256.Sum256(ca.Cert.RawSubjectPublicKeyInfo)
+
+	script := "apt-get update -y\ncurl -s -k -v --header 'Authorization: Bearer test-token' https://api.example.com:6443/api/v1/namespaces/cloud-init-settings/secrets/venus-kube-system-bootstrap-config | jq '.data[\"cloud-config\"]' -r| base64 -d > /etc/cloud/cloud.cfg.d/venus-kube-system-bootstrap-config.cfg\n"
+	scriptSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "edge-provider-script-venus-kube-system",
+			Namespace: "cloud-init-settings",
+		},
+		Data: map[string][]byte{
+			"fetch-bootstrap-script": []byte(script),
+		},
+	}
+	caConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kube-root-ca.crt",
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{
+			"ca.crt": string(triple.EncodeCertPEM(ca.Cert)),
+		},
+	}
+
+	testcases := []struct {
+		Name             string
+		Format           string
+		ExpectedResponse string
+		HTTPStatus       int
+	}{
+		{
+			Name:             "scenario 1: shell script is returned by default",
+			ExpectedResponse: fmt.Sprintf("%q", base64.StdEncoding.EncodeToString([]byte(script))),
+			HTTPStatus:       http.StatusOK,
+		},
+		{
+			Name:             "scenario 2: shell script is returned for the shell format",
+			Format:           "shell",
+			ExpectedResponse: fmt.Sprintf("%q", base64.StdEncoding.EncodeToString([]byte(script))),
+			HTTPStatus:       http.StatusOK,
+		},
+		{
+			Name:   "scenario 3: cloud-init document is returned for the cloud-init format",
+			Format: "cloud-init",
+			ExpectedResponse: fmt.Sprintf("%q", base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf(`#cloud-config
+write_files:
+- path: /opt/bin/fetch-bootstrap-script.sh
+  permissions: "0755"
+  encoding: b64
+  content: %s
+runcmd:
+- /opt/bin/fetch-bootstrap-script.sh
+`, base64.StdEncoding.EncodeToString([]byte(script)))))),
+			HTTPStatus: http.StatusOK,
+		},
+		{
+			Name:             "scenario 4: token data is returned for the json format",
+			Format:           "json",
+			ExpectedResponse: fmt.Sprintf(`{"token":"test-token","caCertHash":"sha256:%s","server":"https://api.example.com:6443"}`, hex.EncodeToString(caHash[:])),
+			HTTPStatus:       http.StatusOK,
+		},
+		{
+			Name:             "scenario 5: unknown format is rejected",
+			Format:           "ignition",
+			ExpectedResponse: `{"error":{"code":400,"message":"unsupported joining script format \"ignition\", must be one of: shell, cloud-init, json"}}`,
+			HTTPStatus:       http.StatusBadRequest,
 		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.Name, func(t *testing.T) {
-			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/%s/nodes/events%s", tc.ProjectIDToSync, tc.ClusterIDToSync, tc.MachineDeploymentID, tc.QueryParams), strings.NewReader(""))
+			url := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/venus/joiningscript", test.GenDefaultProject().Name, test.GenDefaultCluster().Name)
+			if tc.Format != "" {
+				url = fmt.Sprintf("%s?format=%s", url, tc.Format)
+			}
+			req := httptest.NewRequest(http.MethodGet, url, strings.NewReader(""))
 			res := httptest.NewRecorder()
-			kubermaticObj := []ctrlruntimeclient.Object{}
-			machineObj := []ctrlruntimeclient.Object{}
-			kubernetesObj := []ctrlruntimeclient.Object{}
-			for _, existingNode := range tc.ExistingNodes {
-				kubernetesObj = append(kubernetesObj, existingNode)
+
+			kubernetesObj := []ctrlruntimeclient.Object{scriptSecret, caConfigMap}
+			machineObj := []ctrlruntimeclient.Object{
+				genTestMachineDeployment("venus", `{"cloudProvider":"edge","cloudProviderSpec":{}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
 			}
-			for _, existingEvents := range tc.ExistingEvents {
-				kubernetesObj = append(kubernetesObj, existingEvents)
+			kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster())
+
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, kubernetesObj, machineObj, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
 			}
-			for _, existingMachineDeployment := range tc.ExistingMachineDeployments {
-				machineObj = append(machineObj, existingMachineDeployment)
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
 			}
-			for _, existingMachine := range tc.ExistingMachines {
-				machineObj = append(machineObj, existingMachine)
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func TestMachineEndpointsUpstreamErrors(t *testing.T) {
+	t.Parallel()
+
+	timeoutErr := fmt.Errorf("failed to list: %w", context.DeadlineExceeded)
+	connectionRefusedErr := fmt.Errorf("dial tcp 10.0.0.1:6443: %w", syscall.ECONNREFUSED)
+	forbiddenErr := apierrors.NewForbidden(schema.GroupResource{Resource: "machinedeployments"}, "venus", errors.New("access denied"))
+
+	timeoutResponse := func(msg string) string {
+		return fmt.Sprintf(`{"error":{"code":504,"message":"request to user cluster timed out: %s","reason":"UPSTREAM_TIMEOUT"}}`, msg)
+	}
+
+	testcases := []struct {
+		Name             string
+		Path             string
+		Err              error
+		ExpectedResponse string
+		HTTPStatus       int
+	}{
+		{
+			Name:             "scenario 1: user cluster timeout when listing machine deployments",
+			Path:             "machinedeployments",
+			Err:              timeoutErr,
+			ExpectedResponse: timeoutResponse("failed to list: context deadline exceeded"),
+			HTTPStatus:       http.StatusGatewayTimeout,
+		},
+		{
+			Name:             "scenario 2: user cluster timeout when listing nodes",
+			Path:             "nodes",
+			Err:              timeoutErr,
+			ExpectedResponse: timeoutResponse("failed to load machines from cluster: failed to list: context deadline exceeded"),
+			HTTPStatus:       http.StatusGatewayTimeout,
+		},
+		{
+			Name:             "scenario 3: user cluster timeout when listing machine deployment metrics",
+			Path:             "machinedeployments/venus/nodes/metrics",
+			Err:              timeoutErr,
+			ExpectedResponse: timeoutResponse("failed to list: context deadline exceeded"),
+			HTTPStatus:       http.StatusGatewayTimeout,
+		},
+		{
+			Name:             "scenario 4: unavailable user cluster when listing machine deployment events",
+			Path:             "machinedeployments/venus/nodes/events",
+			Err:              connectionRefusedErr,
+			ExpectedResponse: `{"error":{"code":503,"message":"user cluster is unavailable: dial tcp 10.0.0.1:6443: connection refused","reason":"UPSTREAM_UNAVAILABLE"}}`,
+			HTTPStatus:       http.StatusServiceUnavailable,
+		},
+		{
+			Name:             "scenario 5: authorization failures are still returned as forbidden",
+			Path:             "machinedeployments",
+			Err:              forbiddenErr,
+			ExpectedResponse: `{"error":{"code":403,"message":"machinedeployments \"venus\" is forbidden: access denied"}}`,
+			HTTPStatus:       http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/%s", test.GenDefaultProject().Name, test.GenDefaultCluster().Name, tc.Path), strings.NewReader(""))
+			res := httptest.NewRecorder()
+
+			kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster())
+			funcs := interceptor.Funcs{
+				Get: func(_ context.Context, _ ctrlruntimeclient.WithWatch, _ ctrlruntimeclient.ObjectKey, _ ctrlruntimeclient.Object, _ ...ctrlruntimeclient.GetOption) error {
+					return tc.Err
+				},
+				List: func(_ context.Context, _ ctrlruntimeclient.WithWatch, _ ctrlruntimeclient.ObjectList, _ ...ctrlruntimeclient.ListOption) error {
+					return tc.Err
+				},
 			}
-			kubermaticObj = append(kubermaticObj, tc.ExistingKubermaticObjs...)
 
-			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, kubernetesObj, machineObj, kubermaticObj, nil, hack.NewTestRouting)
+			ep, err := test.CreateTestEndpointWithUserClusterInterceptor(*test.GenDefaultAPIUser(), nil, kubermaticObj, nil, hack.NewTestRouting, funcs)
 			if err != nil {
 				t.Fatalf("failed to create test endpoint: %v", err)
 			}
@@ -1971,121 +6685,273 @@ func TestListNodeDeploymentNodesEvents(t *testing.T) {
 				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
 			}
 
-			test.CompareWithResult(t, res, tc.ExpectedResult)
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
 		})
 	}
 }
 
-func TestDeleteMachineDeployment(t *testing.T) {
+func TestCopyMachineDeployment(t *testing.T) {
 	t.Parallel()
+	doCloud := kubermaticv1.CloudSpec{
+		DatacenterName: "regular-do1",
+		ProviderName:   string(kubermaticv1.DigitaloceanCloudProvider),
+		Digitalocean:   &kubermaticv1.DigitaloceanCloudSpec{Token: "dummy-token"},
+	}
+	genTargetCluster := func(cloud kubermaticv1.CloudSpec, version string) *kubermaticv1.Cluster {
+		cluster := genTestClusterWithCloud(cloud, nil)
+		cluster.Name = "targetClusterID"
+		cluster.Spec.HumanReadableName = "target"
+		cluster.Spec.Version = *semver.NewSemverOrDie(version)
+		return cluster
+	}
+	genSourceMachineDeployment := func() *clusterv1alpha1.MachineDeployment {
+		md := genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false)
+		md.UID = "source-uid"
+		md.Annotations = map[string]string{
+			"team": "ml",
+			"machinedeployment.clusters.k8s.io/revision":                "3",
+			"cluster.k8s.io/cluster-api-autoscaler-node-group-min-size": "1",
+			"cluster.k8s.io/cluster-api-autoscaler-node-group-max-size": "3",
+		}
+		md.Spec.Template.Spec.Labels = map[string]string{
+			"system/cluster": test.GenDefaultCluster().Name,
+			"system/project": test.GenDefaultProject().Name,
+			"pool":           "gpu",
+		}
+		md.Status.Replicas = 1
+		return md
+	}
+
 	testcases := []struct {
-		Name                        string
-		HTTPStatus                  int
-		MachineIDToDelete           string
-		ClusterIDToSync             string
-		ProjectIDToSync             string
-		ExistingAPIUser             *apiv1.User
-		ExistingNodes               []*corev1.Node
-		ExistingMachineDeployments  []*clusterv1alpha1.MachineDeployment
-		ExistingKubermaticObjs      []ctrlruntimeclient.Object
-		ExpectedHTTPStatusOnGet     int
-		ExpectedNodeDeploymentCount int
+		Name             string
+		TargetClusterID  string
+		ProjectID        string
+		ExistingCluster  *kubermaticv1.Cluster
+		ExistingAPIUser  *apiv1.User
+		HTTPStatus       int
+		ExpectedResponse string
+		ExpectCreated    bool
 	}{
-		// scenario 1
 		{
-			Name:              "scenario 1: delete the machine deployments that belong to the given cluster",
-			HTTPStatus:        http.StatusOK,
-			MachineIDToDelete: "venus",
-			ClusterIDToSync:   test.GenDefaultCluster().Name,
-			ProjectIDToSync:   test.GenDefaultProject().Name,
-			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
-				test.GenTestSeed(),
-				test.GenDefaultCluster(),
-			),
+			Name:            "scenario 1: machine deployment is copied to a cluster with the same cloud provider",
+			TargetClusterID: "targetClusterID",
+			ProjectID:       test.GenDefaultProject().Name,
+			ExistingCluster: genTargetCluster(doCloud, "9.9.9"),
 			ExistingAPIUser: test.GenDefaultAPIUser(),
-			ExistingNodes: []*corev1.Node{
-				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "mars"}},
-			},
-			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
-				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
-				genTestMachineDeployment("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, nil, false),
-			},
-			// Even though the machine deployment object was deleted the associated node object was not.
-			// When the client GETs the previously deleted "node" it will get a valid response.
-			// That is only true for testing, but in a real cluster, the node object will get deleted by the garbage-collector as it has a ownerRef set.
-			ExpectedHTTPStatusOnGet:     http.StatusOK,
-			ExpectedNodeDeploymentCount: 1,
+			HTTPStatus:      http.StatusCreated,
+			ExpectCreated:   true,
 		},
-		// scenario 2
 		{
-			Name:              "scenario 2: the admin John can delete any cluster machine deployment",
-			HTTPStatus:        http.StatusOK,
-			MachineIDToDelete: "venus",
-			ClusterIDToSync:   test.GenDefaultCluster().Name,
-			ProjectIDToSync:   test.GenDefaultProject().Name,
-			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
-				test.GenTestSeed(),
-				test.GenDefaultCluster(),
-				test.GenAdminUser("John", "john@acme.com", true),
-			),
-			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
-			ExistingNodes: []*corev1.Node{
-				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "mars"}},
-			},
-			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
-				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
-				genTestMachineDeployment("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, nil, false),
-			},
-			// Even though the machine deployment object was deleted the associated node object was not.
-			// When the client GETs the previously deleted "node" it will get a valid response.
-			// That is only true for testing, but in a real cluster, the node object will get deleted by the garbage-collector as it has a ownerRef set.
-			ExpectedHTTPStatusOnGet:     http.StatusOK,
-			ExpectedNodeDeploymentCount: 1,
+			Name:             "scenario 2: machine deployment cannot be copied to a cluster with another cloud provider",
+			TargetClusterID:  "targetClusterID",
+			ProjectID:        test.GenDefaultProject().Name,
+			ExistingCluster:  genTargetCluster(kubermaticv1.CloudSpec{DatacenterName: "regular-do1", ProviderName: string(kubermaticv1.AWSCloudProvider), AWS: &kubermaticv1.AWSCloudSpec{}}, "9.9.9"),
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"cannot copy machine deployment: cloud provider \"aws\" of the target cluster does not match cloud provider \"digitalocean\" of the source cluster"}}`,
 		},
-		// scenario 3
 		{
-			Name:              "scenario 3: the user John can delete Bob's cluster machine deployment",
-			HTTPStatus:        http.StatusForbidden,
-			MachineIDToDelete: "venus",
-			ClusterIDToSync:   test.GenDefaultCluster().Name,
-			ProjectIDToSync:   test.GenDefaultProject().Name,
-			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
-				test.GenTestSeed(),
-				test.GenDefaultCluster(),
-				test.GenAdminUser("John", "john@acme.com", false),
-			),
-			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
-			ExistingNodes: []*corev1.Node{
-				{ObjectMeta: metav1.ObjectMeta{Name: "venus"}},
-				{ObjectMeta: metav1.ObjectMeta{Name: "mars"}},
+			Name:             "scenario 3: machine deployment cannot be copied to a cluster with an older control plane",
+			TargetClusterID:  "targetClusterID",
+			ProjectID:        test.GenDefaultProject().Name,
+			ExistingCluster:  genTargetCluster(doCloud, "8.8.8"),
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node deployment validation failed: kubelet version 9.9.9 is not compatible with control plane version 8.8.8","reason":"VERSION_SKEW"}}`,
+		},
+		{
+			Name:             "scenario 4: the user cannot copy machine deployments of a project they don't belong to",
+			TargetClusterID:  "targetClusterID",
+			ProjectID:        test.GenDefaultProject().Name,
+			ExistingCluster:  genTargetCluster(doCloud, "9.9.9"),
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			body := fmt.Sprintf(`{"targetClusterID":"%s"}`, tc.TargetClusterID)
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/venus/copy-to", tc.ProjectID, test.GenDefaultCluster().Name), strings.NewReader(body))
+			res := httptest.NewRecorder()
+
+			var created *clusterv1alpha1.MachineDeployment
+			funcs := interceptor.Funcs{
+				Create: func(_ context.Context, _ ctrlruntimeclient.WithWatch, obj ctrlruntimeclient.Object, _ ...ctrlruntimeclient.CreateOption) error {
+					// The fake user cluster client is shared by all clusters, so the copy is only recorded.
+					if md, ok := obj.(*clusterv1alpha1.MachineDeployment); ok {
+						created = md
+					}
+					return nil
+				},
+			}
+
+			// The user cluster client is backed by the same fake client as the seed, so the source machine deployment
+			// is passed along with the kubermatic objects.
+			kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), genTestClusterWithCloud(doCloud, nil), tc.ExistingCluster, genSourceMachineDeployment())
+			ep, err := test.CreateTestEndpointWithUserClusterInterceptor(*tc.ExistingAPIUser, nil, kubermaticObj, nil, hack.NewTestRouting, funcs)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+
+			if !tc.ExpectCreated {
+				if created != nil {
+					t.Fatalf("expected no machine deployment to be created, got %s", created.Name)
+				}
+				return
+			}
+			if created == nil {
+				t.Fatal("expected the machine deployment to be created in the target cluster")
+			}
+			if created.Name != "venus" || created.UID != "" || created.Status.Replicas != 0 {
+				t.Fatalf("expected a fresh copy of the machine deployment, got %+v", created.ObjectMeta)
+			}
+			if created.Spec.Template.Spec.Labels["system/cluster"] != "targetClusterID" || created.Spec.Template.Spec.Labels["pool"] != "gpu" {
+				t.Fatalf("expected the labels to be regenerated for the target cluster, got %v", created.Spec.Template.Spec.Labels)
+			}
+			if created.Annotations["team"] != "ml" || created.Annotations["machinedeployment.clusters.k8s.io/revision"] != "" {
+				t.Fatalf("expected only the user annotations to be copied, got %v", created.Annotations)
+			}
+			if created.Annotations["cluster.k8s.io/cluster-api-autoscaler-node-group-max-size"] != "3" {
+				t.Fatalf("expected the autoscaling configuration to be copied, got %v", created.Annotations)
+			}
+		})
+	}
+}
+
+func genMachineDeploymentRevisions() []ctrlruntimeclient.Object {
+	const providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+
+	md := genTestMachineDeployment("venus", providerSpec, nil, false)
+	md.UID = "venus-uid"
+	md.Annotations = map[string]string{"machinedeployment.clusters.k8s.io/revision": "2"}
+
+	genMachineSet := func(name, owner, revision, kubelet string, replicas int32) *clusterv1alpha1.MachineSet {
+		ms := &clusterv1alpha1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   metav1.NamespaceSystem,
+				Annotations: map[string]string{"machinedeployment.clusters.k8s.io/revision": revision},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: clusterv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "MachineDeployment",
+					Name:       owner,
+					UID:        types.UID(owner + "-uid"),
+					Controller: ptr.To(true),
+				}},
 			},
-			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
-				genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false),
-				genTestMachineDeployment("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, nil, false),
+			Spec: clusterv1alpha1.MachineSetSpec{
+				Replicas: ptr.To(replicas),
+				Template: *md.Spec.Template.DeepCopy(),
 			},
-			ExpectedHTTPStatusOnGet:     http.StatusForbidden,
-			ExpectedNodeDeploymentCount: 2,
+			Status: clusterv1alpha1.MachineSetStatus{
+				ReadyReplicas:     replicas,
+				AvailableReplicas: replicas,
+			},
+		}
+		ms.Spec.Template.Spec.Versions.Kubelet = kubelet
+		return ms
+	}
+
+	return []ctrlruntimeclient.Object{
+		md,
+		genMachineSet("venus-2", "venus", "2", "v9.9.9", 1),
+		genMachineSet("venus-1", "venus", "1", "v9.8.0", 0),
+		genMachineSet("mars-3", "mars", "3", "v9.8.0", 1),
+	}
+}
+
+func TestListMachineDeploymentRevisions(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name             string
+		ExistingAPIUser  *apiv1.User
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: the revisions of the machine deployment are listed with their changes",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `[{"revision":1,"machineSetName":"venus-1","creationTimestamp":"0001-01-01T00:00:00Z","replicas":0,"readyReplicas":0,"availableReplicas":0,"current":false,"changes":["versions.kubelet: v9.8.0 -\u003e v9.9.9"]},{"revision":2,"machineSetName":"venus-2","creationTimestamp":"0001-01-01T00:00:00Z","replicas":1,"readyReplicas":1,"availableReplicas":1,"current":true}]`,
+		},
+		{
+			Name:             "scenario 2: the user John can not list the revisions of Bob's machine deployment",
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
 		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.Name, func(t *testing.T) {
-			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/%s",
-				tc.ProjectIDToSync, tc.ClusterIDToSync, tc.MachineIDToDelete), strings.NewReader(""))
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/venus/revisions", test.GenDefaultProject().Name, test.GenDefaultCluster().Name), nil)
 			res := httptest.NewRecorder()
-			kubermaticObj := []ctrlruntimeclient.Object{}
-			machineDeploymentObjects := []ctrlruntimeclient.Object{}
-			kubernetesObj := []ctrlruntimeclient.Object{}
-			kubermaticObj = append(kubermaticObj, tc.ExistingKubermaticObjs...)
-			for _, existingNode := range tc.ExistingNodes {
-				kubernetesObj = append(kubernetesObj, existingNode)
+
+			kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), genTestCluster(true), test.GenAdminUser("John", "john@acme.com", false))
+			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, nil, genMachineDeploymentRevisions(), kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
 			}
-			for _, existingMachineDeployment := range tc.ExistingMachineDeployments {
-				machineDeploymentObjects = append(machineDeploymentObjects, existingMachineDeployment)
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
 			}
-			ep, clientsSets, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, kubernetesObj, machineDeploymentObjects, kubermaticObj, nil, hack.NewTestRouting)
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func TestRollbackMachineDeployment(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name             string
+		Body             string
+		HTTPStatus       int
+		ExpectedResponse string
+		ExpectedKubelet  string
+	}{
+		{
+			Name:            "scenario 1: the machine deployment is rolled back to the previous kubelet version",
+			Body:            `{"revision":1}`,
+			HTTPStatus:      http.StatusOK,
+			ExpectedKubelet: "v9.8.0",
+		},
+		{
+			Name:             "scenario 2: rolling back to a revision of another machine deployment fails",
+			Body:             `{"revision":3}`,
+			HTTPStatus:       http.StatusNotFound,
+			ExpectedResponse: `{"error":{"code":404,"message":"MachineDeploymentRevision \"3\" not found"}}`,
+			ExpectedKubelet:  "v9.9.9",
+		},
+		{
+			Name:             "scenario 3: the revision is required",
+			Body:             `{}`,
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"'revision' is required but was not provided"}}`,
+			ExpectedKubelet:  "v9.9.9",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/venus/rollback", test.GenDefaultProject().Name, test.GenDefaultCluster().Name), strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+
+			kubermaticObj := test.GenDefaultKubermaticObjects(test.GenTestSeed(), genTestCluster(true))
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, genMachineDeploymentRevisions(), kubermaticObj, nil, hack.NewTestRouting)
 			if err != nil {
 				t.Fatalf("failed to create test endpoint: %v", err)
 			}
@@ -2095,14 +6961,16 @@ func TestDeleteMachineDeployment(t *testing.T) {
 			if res.Code != tc.HTTPStatus {
 				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
 			}
-
-			machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
-			if err := clientsSets.FakeClient.List(context.Background(), machineDeployments); err != nil {
-				t.Fatalf("failed to list MachineDeployments: %v", err)
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
 			}
 
-			if machineDeploymentCount := len(machineDeployments.Items); machineDeploymentCount != tc.ExpectedNodeDeploymentCount {
-				t.Errorf("Expected to find %d  machineDeployments but got %d", tc.ExpectedNodeDeploymentCount, machineDeploymentCount)
+			md := &clusterv1alpha1.MachineDeployment{}
+			if err := clients.FakeClient.Get(context.Background(), types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "venus"}, md); err != nil {
+				t.Fatalf("failed to get machine deployment: %v", err)
+			}
+			if md.Spec.Template.Spec.Versions.Kubelet != tc.ExpectedKubelet {
+				t.Fatalf("Expected kubelet version %s, got %s", tc.ExpectedKubelet, md.Spec.Template.Spec.Versions.Kubelet)
 			}
 		})
 	}
@@ -2132,6 +7000,26 @@ func genTestCluster(isControllerReady bool) *kubermaticv1.Cluster {
 	return cluster
 }
 
+func genOperatingSystemProfile(name string) *osmv1alpha1.OperatingSystemProfile {
+	return &osmv1alpha1.OperatingSystemProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: osmv1alpha1.OperatingSystemProfileSpec{
+			OSName:    osmv1alpha1.OperatingSystemUbuntu,
+			OSVersion: "22.04",
+		},
+	}
+}
+
+func genTestClusterWithCloud(cloud kubermaticv1.CloudSpec, annotations map[string]string) *kubermaticv1.Cluster {
+	cluster := genTestCluster(true)
+	cluster.Annotations = annotations
+	cluster.Spec.Cloud = cloud
+	return cluster
+}
+
 func genTestMachine(name, rawProviderSpec string, labels map[string]string, ownerRef []metav1.OwnerReference) *clusterv1alpha1.Machine {
 	return test.GenTestMachine(name, rawProviderSpec, labels, ownerRef)
 }
@@ -925,6 +925,10 @@ func newAPIPreset(preset *kubermaticv1.Preset, enabled bool) apiv2.Preset {
 			provider := apiv2.PresetProvider{
 				Name:    providerType,
 				Enabled: common.IsPresetProviderEnabled(preset, providerType),
+				Fields:  common.GetPresetProviderFields(preset, providerType),
+			}
+			if providerPreset := common.GetProviderPreset(preset, providerType); providerPreset != nil {
+				provider.Datacenter = providerPreset.Datacenter
 			}
 			if providerType == kubermaticv1.VMwareCloudDirectorCloudProvider && preset.Spec.VMwareCloudDirector != nil {
 				if preset.Spec.VMwareCloudDirector.OVDCNetworks != nil {
@@ -955,7 +959,7 @@ func newAPIPreset(preset *kubermaticv1.Preset, enabled bool) apiv2.Preset {
 		}
 	}
 
-	return apiv2.Preset{Name: preset.Name, Enabled: enabled, Providers: providers}
+	return apiv2.Preset{Name: preset.Name, Enabled: enabled, Providers: providers, RequiredEmails: preset.Spec.RequiredEmails}
 }
 
 func convertAPIToInternalPreset(preset apiv2.PresetBody) *kubermaticv1.Preset {
@@ -41,6 +41,9 @@ import (
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// tokenSet are the fields reported for the provider presets of genPresets, which only set the token.
+var tokenSet = map[string]bool{"token": true}
+
 func boolPtr(value bool) *bool {
 	return &[]bool{value}[0]
 }
@@ -159,12 +162,12 @@ func TestListPresets(t *testing.T) {
 			ExpectedResponse: &apiv2.PresetList{
 				Items: []apiv2.Preset{
 					{Name: "enabled", Enabled: true, Providers: []apiv2.PresetProvider{}},
-					{Name: "enabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-					{Name: "disabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider}}},
-					{Name: "enabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-					{Name: "disabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider}}},
-					{Name: "enabled-do-with-acme-email", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-					{Name: "enabled-multi-provider", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.AnexiaCloudProvider, Enabled: true}, {Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
+					{Name: "enabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}},
+					{Name: "disabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Fields: tokenSet}}},
+					{Name: "enabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Datacenter: "a", Fields: tokenSet}}},
+					{Name: "disabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Datacenter: "a", Fields: tokenSet}}},
+					{Name: "enabled-do-with-acme-email", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}, RequiredEmails: []string{test.RequiredEmailDomain}},
+					{Name: "enabled-multi-provider", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.AnexiaCloudProvider, Enabled: true, Fields: tokenSet}, {Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}},
 				},
 			},
 			HTTPStatus:             http.StatusOK,
@@ -180,12 +183,12 @@ func TestListPresets(t *testing.T) {
 				Items: []apiv2.Preset{
 					{Name: "enabled", Enabled: true, Providers: []apiv2.PresetProvider{}},
 					{Name: "disabled", Providers: []apiv2.PresetProvider{}},
-					{Name: "enabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-					{Name: "disabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider}}},
-					{Name: "enabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-					{Name: "disabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider}}},
-					{Name: "enabled-do-with-acme-email", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-					{Name: "enabled-multi-provider", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.AnexiaCloudProvider, Enabled: true}, {Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
+					{Name: "enabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}},
+					{Name: "disabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Fields: tokenSet}}},
+					{Name: "enabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Datacenter: "a", Fields: tokenSet}}},
+					{Name: "disabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Datacenter: "a", Fields: tokenSet}}},
+					{Name: "enabled-do-with-acme-email", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}, RequiredEmails: []string{test.RequiredEmailDomain}},
+					{Name: "enabled-multi-provider", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.AnexiaCloudProvider, Enabled: true, Fields: tokenSet}, {Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}},
 				},
 			},
 			HTTPStatus:             http.StatusOK,
@@ -244,10 +247,10 @@ func TestListProviderPresets(t *testing.T) {
 			Provider: string(kubermaticv1.DigitaloceanCloudProvider),
 			ExpectedResponse: &apiv2.PresetList{
 				Items: []apiv2.Preset{
-					{Name: "enabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-					{Name: "enabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-					{Name: "enabled-do-with-acme-email", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-					{Name: "enabled-multi-provider", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.AnexiaCloudProvider, Enabled: true}, {Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
+					{Name: "enabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}},
+					{Name: "enabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Datacenter: "a", Fields: tokenSet}}},
+					{Name: "enabled-do-with-acme-email", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}, RequiredEmails: []string{test.RequiredEmailDomain}},
+					{Name: "enabled-multi-provider", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.AnexiaCloudProvider, Enabled: true, Fields: tokenSet}, {Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}},
 				},
 			},
 			HTTPStatus:             http.StatusOK,
@@ -262,12 +265,12 @@ func TestListProviderPresets(t *testing.T) {
 			Provider: string(kubermaticv1.DigitaloceanCloudProvider),
 			ExpectedResponse: &apiv2.PresetList{
 				Items: []apiv2.Preset{
-					{Name: "enabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-					{Name: "enabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-					{Name: "disabled-do-with-dc", Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider}}},
-					{Name: "enabled-do-with-acme-email", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-					{Name: "disabled-do", Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider}}},
-					{Name: "enabled-multi-provider", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.AnexiaCloudProvider, Enabled: true}, {Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
+					{Name: "enabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}},
+					{Name: "enabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Datacenter: "a", Fields: tokenSet}}},
+					{Name: "disabled-do-with-dc", Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Datacenter: "a", Fields: tokenSet}}},
+					{Name: "enabled-do-with-acme-email", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}, RequiredEmails: []string{test.RequiredEmailDomain}},
+					{Name: "disabled-do", Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Fields: tokenSet}}},
+					{Name: "enabled-multi-provider", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.AnexiaCloudProvider, Enabled: true, Fields: tokenSet}, {Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}},
 				},
 			},
 			HTTPStatus:             http.StatusOK,
@@ -282,10 +285,10 @@ func TestListProviderPresets(t *testing.T) {
 			Provider:   string(kubermaticv1.DigitaloceanCloudProvider),
 			Datacenter: "a",
 			ExpectedResponse: &apiv2.PresetList{Items: []apiv2.Preset{
-				{Name: "enabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-				{Name: "enabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-				{Name: "enabled-do-with-acme-email", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-				{Name: "enabled-multi-provider", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.AnexiaCloudProvider, Enabled: true}, {Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
+				{Name: "enabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}},
+				{Name: "enabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Datacenter: "a", Fields: tokenSet}}},
+				{Name: "enabled-do-with-acme-email", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}, RequiredEmails: []string{test.RequiredEmailDomain}},
+				{Name: "enabled-multi-provider", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.AnexiaCloudProvider, Enabled: true, Fields: tokenSet}, {Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}},
 			}},
 			HTTPStatus:             http.StatusOK,
 			ExistingAPIUser:        test.GenDefaultAPIUser(),
@@ -299,12 +302,12 @@ func TestListProviderPresets(t *testing.T) {
 			Provider:   string(kubermaticv1.DigitaloceanCloudProvider),
 			Datacenter: "a",
 			ExpectedResponse: &apiv2.PresetList{Items: []apiv2.Preset{
-				{Name: "enabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-				{Name: "disabled-do", Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider}}},
-				{Name: "enabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-				{Name: "disabled-do-with-dc", Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider}}},
-				{Name: "enabled-do-with-acme-email", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
-				{Name: "enabled-multi-provider", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.AnexiaCloudProvider, Enabled: true}, {Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
+				{Name: "enabled-do", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}},
+				{Name: "disabled-do", Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Fields: tokenSet}}},
+				{Name: "enabled-do-with-dc", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Datacenter: "a", Fields: tokenSet}}},
+				{Name: "disabled-do-with-dc", Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Datacenter: "a", Fields: tokenSet}}},
+				{Name: "enabled-do-with-acme-email", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}, RequiredEmails: []string{test.RequiredEmailDomain}},
+				{Name: "enabled-multi-provider", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.AnexiaCloudProvider, Enabled: true, Fields: tokenSet}, {Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}},
 			}},
 			HTTPStatus:             http.StatusOK,
 			ExistingAPIUser:        test.GenDefaultAPIUser(),
@@ -317,7 +320,7 @@ func TestListProviderPresets(t *testing.T) {
 			Disabled: false,
 			Provider: string(kubermaticv1.AnexiaCloudProvider),
 			ExpectedResponse: &apiv2.PresetList{Items: []apiv2.Preset{
-				{Name: "enabled-multi-provider", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.AnexiaCloudProvider, Enabled: true}, {Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true}}},
+				{Name: "enabled-multi-provider", Enabled: true, Providers: []apiv2.PresetProvider{{Name: kubermaticv1.AnexiaCloudProvider, Enabled: true, Fields: tokenSet}, {Name: kubermaticv1.DigitaloceanCloudProvider, Enabled: true, Fields: tokenSet}}},
 			}},
 			HTTPStatus:             http.StatusOK,
 			ExistingAPIUser:        test.GenDefaultAPIUser(),
@@ -367,6 +370,74 @@ func TestListProviderPresets(t *testing.T) {
 	}
 }
 
+func TestListProjectPresetsHidesCredentials(t *testing.T) {
+	t.Parallel()
+
+	// the network of VMware Cloud Director presets is shown in the UI on purpose
+	exposedFields := map[string]bool{"VMwareCloudDirector.OVDCNetwork": true}
+
+	// fill the string fields of every provider block with a value which must not be returned
+	preset := &kubermaticv1.Preset{ObjectMeta: metav1.ObjectMeta{Name: "all-providers"}}
+	spec := reflect.ValueOf(&preset.Spec).Elem()
+	providerBlocks := 0
+	for i := 0; i < spec.NumField(); i++ {
+		field := spec.Field(i)
+		if field.Kind() != reflect.Ptr || field.Type().Elem().Kind() != reflect.Struct {
+			continue
+		}
+		providerBlocks++
+		field.Set(reflect.New(field.Type().Elem()))
+		provider := field.Elem()
+		for j := 0; j < provider.NumField(); j++ {
+			name := fmt.Sprintf("%s.%s", spec.Type().Field(i).Name, provider.Type().Field(j).Name)
+			if provider.Field(j).Kind() != reflect.String {
+				continue
+			}
+			if exposedFields[name] {
+				provider.Field(j).SetString("exposed")
+				continue
+			}
+			provider.Field(j).SetString("secret-" + name)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/presets", test.GenDefaultProject().Name), nil)
+	res := httptest.NewRecorder()
+	ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), []ctrlruntimeclient.Object{}, test.GenDefaultKubermaticObjects(preset), nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+	if strings.Contains(res.Body.String(), "secret-") {
+		t.Fatalf("response contains credentials: %s", res.Body.String())
+	}
+
+	response := &apiv2.PresetList{}
+	if err := json.Unmarshal(res.Body.Bytes(), response); err != nil {
+		t.Fatal(err)
+	}
+	for _, item := range response.Items {
+		if item.Name != preset.Name {
+			continue
+		}
+		if len(item.Providers) != providerBlocks {
+			t.Fatalf("expected all %d providers to be returned, got %d", providerBlocks, len(item.Providers))
+		}
+		for _, provider := range item.Providers {
+			if len(provider.Fields) == 0 {
+				t.Errorf("expected the fields of the %s provider to be reported", provider.Name)
+			}
+		}
+		return
+	}
+	t.Fatalf("preset %s not found in response: %s", preset.Name, res.Body.String())
+}
+
 func TestUpdatePresetStatus(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
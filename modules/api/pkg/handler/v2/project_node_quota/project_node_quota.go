@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectnodequota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// GetEndpoint returns the node quota of the project. Projects without a quota return an empty one.
+func GetEndpoint(userInfoGetter provider.UserInfoGetter, privilegedProjectProvider provider.PrivilegedProjectProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(projectNodeQuotaReq)
+
+		project, err := getProjectForAdmin(ctx, userInfoGetter, privilegedProjectProvider, req.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		quota, err := machine.GetProjectNodeQuota(project)
+		if err != nil {
+			return nil, err
+		}
+
+		return &quota, nil
+	}
+}
+
+// UpdateEndpoint replaces the node quota of the project.
+func UpdateEndpoint(userInfoGetter provider.UserInfoGetter, privilegedProjectProvider provider.PrivilegedProjectProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateProjectNodeQuotaReq)
+
+		if err := machine.ValidateProjectNodeQuota(req.Body); err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+
+		project, err := getProjectForAdmin(ctx, userInfoGetter, privilegedProjectProvider, req.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := machine.SetProjectNodeQuota(project, req.Body); err != nil {
+			return nil, err
+		}
+		if _, err := privilegedProjectProvider.UpdateUnsecured(ctx, project); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return &req.Body, nil
+	}
+}
+
+// DeleteEndpoint removes the node quota of the project.
+func DeleteEndpoint(userInfoGetter provider.UserInfoGetter, privilegedProjectProvider provider.PrivilegedProjectProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(projectNodeQuotaReq)
+
+		project, err := getProjectForAdmin(ctx, userInfoGetter, privilegedProjectProvider, req.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := machine.SetProjectNodeQuota(project, apiv2.ProjectNodeQuota{}); err != nil {
+			return nil, err
+		}
+		if _, err := privilegedProjectProvider.UpdateUnsecured(ctx, project); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return nil, nil
+	}
+}
+
+func getProjectForAdmin(ctx context.Context, userInfoGetter provider.UserInfoGetter, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID string) (*kubermaticv1.Project, error) {
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if !userInfo.IsAdmin {
+		return nil, utilerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+	}
+
+	project, err := privilegedProjectProvider.GetUnsecured(ctx, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return project, nil
+}
+
+// projectNodeQuotaReq defines HTTP request for getProjectNodeQuota and deleteProjectNodeQuota
+// swagger:parameters getProjectNodeQuota deleteProjectNodeQuota
+type projectNodeQuotaReq struct {
+	common.ProjectReq
+}
+
+func DecodeProjectNodeQuotaReq(c context.Context, r *http.Request) (interface{}, error) {
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectNodeQuotaReq{ProjectReq: projectReq.(common.ProjectReq)}, nil
+}
+
+// updateProjectNodeQuotaReq defines HTTP request for updateProjectNodeQuota
+// swagger:parameters updateProjectNodeQuota
+type updateProjectNodeQuotaReq struct {
+	projectNodeQuotaReq
+	// in: body
+	// required: true
+	Body apiv2.ProjectNodeQuota
+}
+
+func DecodeUpdateProjectNodeQuotaReq(c context.Context, r *http.Request) (interface{}, error) {
+	quotaReq, err := DecodeProjectNodeQuotaReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := updateProjectNodeQuotaReq{projectNodeQuotaReq: quotaReq.(projectNodeQuotaReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse body: %v", err)
+	}
+
+	return req, nil
+}
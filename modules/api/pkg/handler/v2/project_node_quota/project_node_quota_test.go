@@ -0,0 +1,149 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectnodequota_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const quotaPath = "/api/v2/admin/projects/my-first-project-ID/node-quota"
+
+func TestProjectNodeQuotaEndpoints(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		Name             string
+		Method           string
+		Path             string
+		Body             string
+		ExistingQuota    *apiv2.ProjectNodeQuota
+		ExistingAPIUser  *apiv1.User
+		HTTPStatus       int
+		ExpectedResponse string
+		ExpectedQuota    string
+	}{
+		{
+			Name:             "scenario 1: the admin sets the node quota of the project",
+			Method:           http.MethodPut,
+			Path:             quotaPath,
+			Body:             `{"maxNodes":50,"maxMachineDeployments":10}`,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"maxNodes":50,"maxMachineDeployments":10}`,
+			ExpectedQuota:    `{"maxNodes":50,"maxMachineDeployments":10}`,
+		},
+		{
+			Name:             "scenario 2: the admin gets the node quota of the project",
+			Method:           http.MethodGet,
+			Path:             quotaPath,
+			ExistingQuota:    &apiv2.ProjectNodeQuota{MaxNodes: 50},
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"maxNodes":50}`,
+			ExpectedQuota:    `{"maxNodes":50}`,
+		},
+		{
+			Name:             "scenario 3: the admin gets the empty node quota of the project",
+			Method:           http.MethodGet,
+			Path:             quotaPath,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{}`,
+		},
+		{
+			Name:            "scenario 4: the admin deletes the node quota of the project",
+			Method:          http.MethodDelete,
+			Path:            quotaPath,
+			ExistingQuota:   &apiv2.ProjectNodeQuota{MaxNodes: 50},
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:      http.StatusOK,
+		},
+		{
+			Name:             "scenario 5: negative quotas are rejected",
+			Method:           http.MethodPut,
+			Path:             quotaPath,
+			Body:             `{"maxNodes":-1}`,
+			ExistingAPIUser:  test.GenAPIUser("John", "john@acme.com"),
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"node quota must not be negative"}}`,
+		},
+		{
+			Name:             "scenario 6: regular users can not manage node quotas",
+			Method:           http.MethodPut,
+			Path:             quotaPath,
+			Body:             `{"maxNodes":500}`,
+			ExistingQuota:    &apiv2.ProjectNodeQuota{MaxNodes: 50},
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"bob@acme.com\" doesn't have admin rights"}}`,
+			ExpectedQuota:    `{"maxNodes":50}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			project := test.GenDefaultProject()
+			if tc.ExistingQuota != nil {
+				if err := machine.SetProjectNodeQuota(project, *tc.ExistingQuota); err != nil {
+					t.Fatalf("failed to set node quota: %v", err)
+				}
+			}
+			kubermaticObjs := []ctrlruntimeclient.Object{
+				project,
+				test.GenDefaultUser(),
+				test.GenDefaultOwnerBinding(),
+				test.GenTestSeed(),
+				test.GenAdminUser("John", "john@acme.com", true),
+			}
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, nil, nil, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, httptest.NewRequest(tc.Method, tc.Path, strings.NewReader(tc.Body)))
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+
+			project = &kubermaticv1.Project{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(test.GenDefaultProject()), project); err != nil {
+				t.Fatalf("failed to get project: %v", err)
+			}
+			if quota := project.Annotations[machine.ProjectNodeQuotaAnnotation]; quota != tc.ExpectedQuota {
+				t.Fatalf("Expected node quota %q, got %q", tc.ExpectedQuota, quota)
+			}
+		})
+	}
+}
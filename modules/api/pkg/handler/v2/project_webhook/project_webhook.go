@@ -0,0 +1,302 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectwebhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/webhook"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func ListEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	webhookProvider provider.PrivilegedWebhookProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listProjectWebhooksReq)
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		secrets, err := webhookProvider.ListUnsecured(ctx, project.Name)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		result := make(apiv2.ProjectWebhookList, 0, len(secrets.Items))
+		for i := range secrets.Items {
+			result = append(result, convertInternalToAPIWebhook(&secrets.Items[i]))
+		}
+		sort.Slice(result, func(i, j int) bool {
+			return result[i].ID < result[j].ID
+		})
+
+		return result, nil
+	}
+}
+
+func GetEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	webhookProvider provider.PrivilegedWebhookProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getProjectWebhookReq)
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		secret, err := webhookProvider.GetUnsecured(ctx, project.Name, webhook.SecretName(req.WebhookID))
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return convertInternalToAPIWebhook(secret), nil
+	}
+}
+
+func CreateEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	webhookProvider provider.PrivilegedWebhookProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createProjectWebhookReq)
+
+		if err := validateWebhook(req.Body, true); err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		// The webhooks are managed through the privileged provider, so viewers have to be rejected here.
+		if err := common.ValidateUserCanModifyProject(ctx, userInfoGetter, req.ProjectID); err != nil {
+			return nil, err
+		}
+
+		hook := &webhook.Webhook{
+			ID:         rand.String(10),
+			URL:        req.Body.URL,
+			Secret:     req.Body.Secret,
+			EventTypes: req.Body.EventTypes,
+		}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: webhook.SecretName(hook.ID),
+			},
+			Data: hook.SecretData(),
+		}
+
+		secret, err = webhookProvider.CreateUnsecured(ctx, project, secret)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return convertInternalToAPIWebhook(secret), nil
+	}
+}
+
+func UpdateEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	webhookProvider provider.PrivilegedWebhookProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateProjectWebhookReq)
+
+		if err := validateWebhook(req.Body, false); err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if err := common.ValidateUserCanModifyProject(ctx, userInfoGetter, req.ProjectID); err != nil {
+			return nil, err
+		}
+
+		secret, err := webhookProvider.GetUnsecured(ctx, project.Name, webhook.SecretName(req.WebhookID))
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		hook := webhook.FromSecret(secret)
+		hook.URL = req.Body.URL
+		hook.EventTypes = req.Body.EventTypes
+		if req.Body.Secret != "" {
+			hook.Secret = req.Body.Secret
+		}
+		secret.Data = hook.SecretData()
+
+		secret, err = webhookProvider.UpdateUnsecured(ctx, secret)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return convertInternalToAPIWebhook(secret), nil
+	}
+}
+
+func DeleteEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	webhookProvider provider.PrivilegedWebhookProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getProjectWebhookReq)
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if err := common.ValidateUserCanModifyProject(ctx, userInfoGetter, req.ProjectID); err != nil {
+			return nil, err
+		}
+
+		if err := webhookProvider.DeleteUnsecured(ctx, project.Name, webhook.SecretName(req.WebhookID)); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return nil, nil
+	}
+}
+
+// listProjectWebhooksReq defines HTTP request for listProjectWebhooks
+// swagger:parameters listProjectWebhooks
+type listProjectWebhooksReq struct {
+	common.ProjectReq
+}
+
+func DecodeListReq(c context.Context, r *http.Request) (interface{}, error) {
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return listProjectWebhooksReq{ProjectReq: pr.(common.ProjectReq)}, nil
+}
+
+// getProjectWebhookReq defines HTTP request for getProjectWebhook and deleteProjectWebhook
+// swagger:parameters getProjectWebhook deleteProjectWebhook
+type getProjectWebhookReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	WebhookID string `json:"webhook_id"`
+}
+
+func DecodeGetReq(c context.Context, r *http.Request) (interface{}, error) {
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookID := mux.Vars(r)["webhook_id"]
+	if webhookID == "" {
+		return nil, fmt.Errorf("'webhook_id' parameter is required but was not provided")
+	}
+
+	return getProjectWebhookReq{ProjectReq: pr.(common.ProjectReq), WebhookID: webhookID}, nil
+}
+
+// createProjectWebhookReq defines HTTP request for createProjectWebhook
+// swagger:parameters createProjectWebhook
+type createProjectWebhookReq struct {
+	common.ProjectReq
+	// in: body
+	// required: true
+	Body apiv2.ProjectWebhook
+}
+
+func DecodeCreateReq(c context.Context, r *http.Request) (interface{}, error) {
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := createProjectWebhookReq{ProjectReq: pr.(common.ProjectReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse body: %v", err)
+	}
+
+	return req, nil
+}
+
+// updateProjectWebhookReq defines HTTP request for updateProjectWebhook
+// swagger:parameters updateProjectWebhook
+type updateProjectWebhookReq struct {
+	getProjectWebhookReq
+	// in: body
+	// required: true
+	Body apiv2.ProjectWebhook
+}
+
+func DecodeUpdateReq(c context.Context, r *http.Request) (interface{}, error) {
+	gr, err := DecodeGetReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := updateProjectWebhookReq{getProjectWebhookReq: gr.(getProjectWebhookReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse body: %v", err)
+	}
+
+	return req, nil
+}
+
+func validateWebhook(body apiv2.ProjectWebhook, requireSecret bool) error {
+	if err := webhook.ValidateURL(body.URL); err != nil {
+		return err
+	}
+
+	if requireSecret && body.Secret == "" {
+		return fmt.Errorf("webhook secret cannot be empty")
+	}
+
+	if len(body.EventTypes) == 0 {
+		return fmt.Errorf("at least one event type is required, supported event types are %v", sets.List(webhook.EventTypes))
+	}
+	for _, eventType := range body.EventTypes {
+		if !webhook.EventTypes.Has(eventType) {
+			return fmt.Errorf("unsupported event type %q, supported event types are %v", eventType, sets.List(webhook.EventTypes))
+		}
+	}
+
+	return nil
+}
+
+func convertInternalToAPIWebhook(secret *corev1.Secret) apiv2.ProjectWebhook {
+	hook := webhook.FromSecret(secret)
+
+	return apiv2.ProjectWebhook{
+		ID:                hook.ID,
+		URL:               hook.URL,
+		EventTypes:        hook.EventTypes,
+		CreationTimestamp: apiv1.NewTime(hook.CreationTimestamp),
+	}
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectwebhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/webhook"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func genWebhook(projectID, id, url string, eventTypes ...string) *corev1.Secret {
+	hook := &webhook.Webhook{ID: id, URL: url, Secret: "s3cr3t", EventTypes: eventTypes}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      webhook.SecretName(id),
+			Namespace: resources.KubermaticNamespace,
+			Labels: map[string]string{
+				kubermaticv1.ProjectIDLabelKey:      projectID,
+				"kubermatic.k8c.io/project-webhook": "true",
+			},
+			CreationTimestamp: metav1.NewTime(test.DefaultCreationTimestamp()),
+		},
+		Data: hook.SecretData(),
+	}
+}
+
+func TestCreateProjectWebhook(t *testing.T) {
+	t.Parallel()
+	projectID := test.GenDefaultProject().Name
+
+	testcases := []struct {
+		Name             string
+		Body             string
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:       "scenario 1: create a webhook",
+			Body:       `{"url":"https://example.com/hook","secret":"s3cr3t","eventTypes":["cluster.created","cluster.unhealthy"]}`,
+			HTTPStatus: http.StatusCreated,
+		},
+		{
+			Name:             "scenario 2: the secret is required",
+			Body:             `{"url":"https://example.com/hook","eventTypes":["cluster.created"]}`,
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"webhook secret cannot be empty"}}`,
+		},
+		{
+			Name:             "scenario 3: the URL must be an absolute http or https URL",
+			Body:             `{"url":"ftp://example.com/hook","secret":"s3cr3t","eventTypes":["cluster.created"]}`,
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"invalid webhook URL \"ftp://example.com/hook\", an absolute http or https URL is required"}}`,
+		},
+		{
+			Name:             "scenario 4: the URL must not point to the cloud metadata service",
+			Body:             `{"url":"http://169.254.169.254/latest/meta-data","secret":"s3cr3t","eventTypes":["cluster.created"]}`,
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"invalid webhook URL \"http://169.254.169.254/latest/meta-data\", link-local addresses are not allowed"}}`,
+		},
+		{
+			Name:             "scenario 5: the URL must not point to internal addresses",
+			Body:             `{"url":"http://10.0.0.12:8080/hook","secret":"s3cr3t","eventTypes":["cluster.created"]}`,
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"invalid webhook URL \"http://10.0.0.12:8080/hook\", private addresses are not allowed"}}`,
+		},
+		{
+			Name:             "scenario 6: the URL must not point to the API server itself",
+			Body:             `{"url":"http://localhost:8080/api/v1/healthz","secret":"s3cr3t","eventTypes":["cluster.created"]}`,
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"invalid webhook URL \"http://localhost:8080/api/v1/healthz\", loopback addresses are not allowed"}}`,
+		},
+		{
+			Name:             "scenario 7: unknown event types are rejected",
+			Body:             `{"url":"https://example.com/hook","secret":"s3cr3t","eventTypes":["cluster.updated"]}`,
+			HTTPStatus:       http.StatusBadRequest,
+			ExpectedResponse: `{"error":{"code":400,"message":"unsupported event type \"cluster.updated\", supported event types are [cluster.created cluster.deleted cluster.unhealthy]"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/webhooks", projectID), strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), nil, test.GenDefaultKubermaticObjects(), nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			if tc.HTTPStatus != http.StatusCreated {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			if strings.Contains(res.Body.String(), "s3cr3t") {
+				t.Fatalf("the webhook secret must not be returned: %s", res.Body.String())
+			}
+
+			hook := apiv2.ProjectWebhook{}
+			if err := json.Unmarshal(res.Body.Bytes(), &hook); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if hook.ID == "" || hook.URL != "https://example.com/hook" || len(hook.EventTypes) != 2 {
+				t.Fatalf("unexpected webhook %+v", hook)
+			}
+		})
+	}
+}
+
+func TestListProjectWebhooks(t *testing.T) {
+	t.Parallel()
+	projectID := test.GenDefaultProject().Name
+
+	testcases := []struct {
+		Name             string
+		ExistingAPIUser  *apiv1.User
+		ExistingObjects  []ctrlruntimeclient.Object
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:            "scenario 1: list the webhooks of the project",
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExistingObjects: test.GenDefaultKubermaticObjects(
+				genWebhook(projectID, "b", "https://example.com/b", webhook.EventClusterDeleted),
+				genWebhook(projectID, "a", "https://example.com/a", webhook.EventClusterCreated, webhook.EventClusterUnhealthy),
+				genWebhook("other-project", "c", "https://example.com/c", webhook.EventClusterCreated),
+			),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `[{"id":"a","url":"https://example.com/a","eventTypes":["cluster.created","cluster.unhealthy"],"creationTimestamp":"2013-02-03T19:54:00Z"},{"id":"b","url":"https://example.com/b","eventTypes":["cluster.deleted"],"creationTimestamp":"2013-02-03T19:54:00Z"}]`,
+		},
+		{
+			Name:             "scenario 2: users which are not members of the project can't list its webhooks",
+			ExistingAPIUser:  test.GenAPIUser("john", "john@acme.com"),
+			ExistingObjects:  test.GenDefaultKubermaticObjects(test.GenUser("", "john", "john@acme.com")),
+			HTTPStatus:       http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to project my-first-project-ID","reason":"NOT_PROJECT_MEMBER"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/webhooks", projectID), nil)
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*tc.ExistingAPIUser, nil, tc.ExistingObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func TestUpdateProjectWebhook(t *testing.T) {
+	t.Parallel()
+	projectID := test.GenDefaultProject().Name
+
+	testcases := []struct {
+		Name             string
+		WebhookID        string
+		Body             string
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: update a webhook and keep its secret",
+			WebhookID:        "a",
+			Body:             `{"url":"https://example.com/new","eventTypes":["cluster.deleted"]}`,
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"id":"a","url":"https://example.com/new","eventTypes":["cluster.deleted"],"creationTimestamp":"2013-02-03T19:54:00Z"}`,
+		},
+		{
+			Name:             "scenario 2: webhooks of other projects can't be updated",
+			WebhookID:        "c",
+			Body:             `{"url":"https://example.com/new","eventTypes":["cluster.deleted"]}`,
+			HTTPStatus:       http.StatusNotFound,
+			ExpectedResponse: `{"error":{"code":404,"message":"secret \"webhook-c\" not found"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/v2/projects/%s/webhooks/%s", projectID, tc.WebhookID), strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+			existingObjects := test.GenDefaultKubermaticObjects(
+				genWebhook(projectID, "a", "https://example.com/a", webhook.EventClusterCreated),
+				genWebhook("other-project", "c", "https://example.com/c", webhook.EventClusterCreated),
+			)
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, nil, existingObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+
+			if tc.HTTPStatus == http.StatusOK {
+				secret := &corev1.Secret{}
+				if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: resources.KubermaticNamespace, Name: webhook.SecretName(tc.WebhookID)}, secret); err != nil {
+					t.Fatalf("failed to get webhook secret: %v", err)
+				}
+				if secret := webhook.FromSecret(secret).Secret; secret != "s3cr3t" {
+					t.Fatalf("expected the webhook secret to be kept, got %q", secret)
+				}
+			}
+		})
+	}
+}
+
+func TestProjectWebhooksCannotBeChangedByViewers(t *testing.T) {
+	t.Parallel()
+	projectID := test.GenDefaultProject().Name
+
+	testcases := []struct {
+		Name   string
+		Method string
+		Path   string
+		Body   string
+	}{
+		{
+			Name:   "scenario 1: viewers can't create webhooks",
+			Method: http.MethodPost,
+			Path:   fmt.Sprintf("/api/v2/projects/%s/webhooks", projectID),
+			Body:   `{"url":"https://example.com/hook","secret":"s3cr3t","eventTypes":["cluster.created"]}`,
+		},
+		{
+			Name:   "scenario 2: viewers can't update webhooks",
+			Method: http.MethodPut,
+			Path:   fmt.Sprintf("/api/v2/projects/%s/webhooks/a", projectID),
+			Body:   `{"url":"https://example.com/new","eventTypes":["cluster.deleted"]}`,
+		},
+		{
+			Name:   "scenario 3: viewers can't delete webhooks",
+			Method: http.MethodDelete,
+			Path:   fmt.Sprintf("/api/v2/projects/%s/webhooks/a", projectID),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.Method, tc.Path, strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+			existingObjects := test.GenDefaultKubermaticObjects(
+				test.GenUser("", "john", "john@acme.com"),
+				test.GenBinding(projectID, "john@acme.com", "viewers"),
+				genWebhook(projectID, "a", "https://example.com/a", webhook.EventClusterCreated),
+			)
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenAPIUser("john", "john@acme.com"), nil, nil, nil, existingObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != http.StatusForbidden {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusForbidden, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't have privileges to perform this action. Please contact your administrator."}}`)
+
+			secret := &corev1.Secret{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: resources.KubermaticNamespace, Name: webhook.SecretName("a")}, secret); err != nil {
+				t.Fatalf("failed to get webhook secret: %v", err)
+			}
+			if url := webhook.FromSecret(secret).URL; url != "https://example.com/a" {
+				t.Fatalf("expected the webhook to be unchanged, got URL %q", url)
+			}
+		})
+	}
+}
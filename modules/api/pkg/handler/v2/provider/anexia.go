@@ -81,6 +81,30 @@ func AnexiaProjectTemplatesEndpoint(presetProvider provider.PresetProvider, user
 	}
 }
 
+func AnexiaTemplatesEndpoint(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(anexiaTemplateReq)
+
+		token := req.Token
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		if len(req.Credential) > 0 {
+			preset, err := presetProvider.GetPreset(ctx, userInfo, nil, req.Credential)
+			if err != nil {
+				return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
+			}
+			if credentials := preset.Spec.Anexia; credentials != nil {
+				token = credentials.Token
+			}
+		}
+
+		return providercommon.ListAnexiaTemplates(ctx, token, req.Location)
+	}
+}
+
 func AnexiaProjectDiskTypesEndpoint(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(anexiaProjectDiskTypeReq)
@@ -174,6 +198,44 @@ func DecodeAnexiaProjectTemplateReq(c context.Context, r *http.Request) (interfa
 	}, nil
 }
 
+// anexiaTemplateReq represent a request for Anexia template resources
+// swagger:parameters listAnexiaTemplates
+type anexiaTemplateReq struct {
+	// in: header
+	// Token Anexia token
+	Token string
+	// in: header
+	// Credential predefined Kubermatic credential name from the presets
+	Credential string
+	// in: header
+	// Location Anexia location ID
+	Location string
+}
+
+// Validate validates anexiaTemplateReq request.
+func (req anexiaTemplateReq) Validate() error {
+	if len(req.Token) == 0 && len(req.Credential) == 0 {
+		return utilerrors.NewBadRequest("Anexia token or credential is required")
+	}
+	if len(req.Location) == 0 {
+		return utilerrors.NewBadRequest("Anexia location is required")
+	}
+	return nil
+}
+
+func DecodeAnexiaTemplateReq(c context.Context, r *http.Request) (interface{}, error) {
+	req := anexiaTemplateReq{
+		Token:      r.Header.Get("Token"),
+		Credential: r.Header.Get("Credential"),
+		Location:   r.Header.Get("Location"),
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // anexiaProjectDiskTypeReq represent a request for Anexia disk-type resources
 // swagger:parameters listProjectAnexiaDiskTypes
 type anexiaProjectDiskTypeReq struct {
@@ -0,0 +1,233 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	providercommon "k8c.io/dashboard/v2/pkg/handler/common/provider"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testAnexiaToken      = "anexia-token"
+	testAnexiaPresetName = "anexia-preset"
+	testAnexiaLocation   = "anexia-location"
+	testAnexiaDC         = "anexia-dc"
+)
+
+type mockAnexiaClientImpl struct {
+	token string
+}
+
+func mockAnexiaClient(token string) (providercommon.AnexiaClientSet, error) {
+	return &mockAnexiaClientImpl{token: token}, nil
+}
+
+func (m *mockAnexiaClientImpl) ListTemplates(_ context.Context, locationID string) (apiv1.AnexiaTemplateList, error) {
+	if m.token != testAnexiaToken {
+		return nil, errors.New("unauthorized")
+	}
+	if locationID != testAnexiaLocation {
+		return apiv1.AnexiaTemplateList{}, nil
+	}
+
+	return apiv1.AnexiaTemplateList{
+		{ID: "template-1", Name: "Flatcar Linux Stable", Build: "b01"},
+		{ID: "template-2", Name: "Ubuntu 22.04", Build: "b02"},
+	}, nil
+}
+
+const expectedAnexiaTemplates = `[
+	{"id": "template-1", "name": "Flatcar Linux Stable", "build": "b01"},
+	{"id": "template-2", "name": "Ubuntu 22.04", "build": "b02"}
+]`
+
+func TestAnexiaTemplatesEndpoint(t *testing.T) {
+	testcases := []struct {
+		name             string
+		token            string
+		credential       string
+		location         string
+		httpStatus       int
+		expectedResponse string
+	}{
+		{
+			name:       "test missing credentials",
+			location:   testAnexiaLocation,
+			httpStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "test missing location",
+			token:      testAnexiaToken,
+			httpStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "test invalid credential reference",
+			credential: "invalid",
+			location:   testAnexiaLocation,
+			httpStatus: http.StatusInternalServerError,
+		},
+		{
+			name:       "test invalid token",
+			token:      "invalid",
+			location:   testAnexiaLocation,
+			httpStatus: http.StatusInternalServerError,
+		},
+		{
+			name:             "test template list with token",
+			token:            testAnexiaToken,
+			location:         testAnexiaLocation,
+			httpStatus:       http.StatusOK,
+			expectedResponse: expectedAnexiaTemplates,
+		},
+		{
+			name:             "test template list with preset",
+			credential:       testAnexiaPresetName,
+			location:         testAnexiaLocation,
+			httpStatus:       http.StatusOK,
+			expectedResponse: expectedAnexiaTemplates,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/providers/anexia/templates", strings.NewReader(""))
+
+			req.Header.Add("Token", tc.token)
+			req.Header.Add("Credential", tc.credential)
+			req.Header.Add("Location", tc.location)
+
+			providercommon.NewAnexiaClient = mockAnexiaClient
+
+			apiUser := test.GetUser(test.UserEmail, test.UserID, test.UserName)
+			kubermaticObjects := []ctrlruntimeclient.Object{
+				test.APIUserToKubermaticUser(apiUser),
+				genAnexiaPreset(),
+			}
+
+			res := httptest.NewRecorder()
+			router, _, err := test.CreateTestEndpointAndGetClients(apiUser, nil, []ctrlruntimeclient.Object{}, []ctrlruntimeclient.Object{}, kubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			router.ServeHTTP(res, req)
+
+			// validate
+			assert.Equal(t, tc.httpStatus, res.Code)
+
+			if res.Code == http.StatusOK {
+				compareJSON(t, res, tc.expectedResponse)
+			}
+		})
+	}
+}
+
+func TestAnexiaTemplatesNoCredentialsEndpoint(t *testing.T) {
+	testcases := []struct {
+		name             string
+		cluster          *kubermaticv1.Cluster
+		httpStatus       int
+		expectedResponse string
+	}{
+		{
+			name:       "test cluster without Anexia cloud spec",
+			cluster:    test.GenDefaultCluster(),
+			httpStatus: http.StatusNotFound,
+		},
+		{
+			name:             "test template list with the credentials of the cluster",
+			cluster:          genAnexiaCluster(),
+			httpStatus:       http.StatusOK,
+			expectedResponse: expectedAnexiaTemplates,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/providers/anexia/templates", test.GenDefaultProject().Name, tc.cluster.Name), strings.NewReader(""))
+
+			providercommon.NewAnexiaClient = mockAnexiaClient
+
+			seed := test.GenTestSeed(func(seed *kubermaticv1.Seed) {
+				seed.Spec.Datacenters[testAnexiaDC] = kubermaticv1.Datacenter{
+					Spec: kubermaticv1.DatacenterSpec{
+						Anexia: &kubermaticv1.DatacenterSpecAnexia{
+							LocationID: testAnexiaLocation,
+						},
+					},
+				}
+			})
+
+			res := httptest.NewRecorder()
+			router, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, []ctrlruntimeclient.Object{}, test.GenDefaultKubermaticObjects(seed, tc.cluster), nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			router.ServeHTTP(res, req)
+
+			// validate
+			assert.Equal(t, tc.httpStatus, res.Code)
+
+			if res.Code == http.StatusOK {
+				compareJSON(t, res, tc.expectedResponse)
+			}
+		})
+	}
+}
+
+func genAnexiaPreset() *kubermaticv1.Preset {
+	return &kubermaticv1.Preset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testAnexiaPresetName,
+		},
+		Spec: kubermaticv1.PresetSpec{
+			Anexia: &kubermaticv1.Anexia{
+				Token: testAnexiaToken,
+			},
+		},
+	}
+}
+
+func genAnexiaCluster() *kubermaticv1.Cluster {
+	cluster := test.GenDefaultCluster()
+	cluster.Spec.Cloud = kubermaticv1.CloudSpec{
+		DatacenterName: testAnexiaDC,
+		ProviderName:   string(kubermaticv1.AnexiaCloudProvider),
+		Anexia: &kubermaticv1.AnexiaCloudSpec{
+			Token: testAnexiaToken,
+		},
+	}
+
+	return cluster
+}
@@ -44,7 +44,18 @@ func AzureSizeWithClusterCredentialsEndpoint(projectProvider provider.ProjectPro
 func AzureAvailabilityZonesWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(azureAvailabilityZonesNoCredentialsReq)
-		return providercommon.AzureAvailabilityZonesWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, req.ProjectID, req.ClusterID, req.SKUName)
+		size := req.Size
+		if size == "" {
+			size = req.SKUName
+		}
+		return providercommon.AzureAvailabilityZonesWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, req.ProjectID, req.ClusterID, size)
+	}
+}
+
+func AzureProximityPlacementGroupsWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(azureProximityPlacementGroupsNoCredentialsReq)
+		return providercommon.AzureProximityPlacementGroupsWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, req.ProjectID, req.ClusterID)
 	}
 }
 
@@ -333,6 +344,9 @@ type azureAvailabilityZonesNoCredentialsReq struct {
 	// in: header
 	// name: SKUName
 	SKUName string
+	// VM size to list the availability zones for, takes precedence over the SKUName header.
+	// in: query
+	Size string `json:"size,omitempty"`
 }
 
 // GetSeedCluster returns the SeedCluster object.
@@ -350,9 +364,25 @@ func DecodeAzureAvailabilityZonesNoCredentialsReq(c context.Context, r *http.Req
 	}
 	req.azureSizeNoCredentialsReq = lr.(azureSizeNoCredentialsReq)
 	req.SKUName = r.Header.Get("SKUName")
+	req.Size = r.URL.Query().Get("size")
 	return req, nil
 }
 
+// azureProximityPlacementGroupsNoCredentialsReq represent a request for Azure proximity placement groups
+// note that the request doesn't have credentials for authN
+// swagger:parameters listAzureProximityPlacementGroupsNoCredentialsV2
+type azureProximityPlacementGroupsNoCredentialsReq struct {
+	azureSizeNoCredentialsReq
+}
+
+func DecodeAzureProximityPlacementGroupsNoCredentialsReq(c context.Context, r *http.Request) (interface{}, error) {
+	lr, err := DecodeAzureSizesNoCredentialsReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	return azureProximityPlacementGroupsNoCredentialsReq{azureSizeNoCredentialsReq: lr.(azureSizeNoCredentialsReq)}, nil
+}
+
 // azureCommonReq represent a request for Azure support.
 type azureCommonReq struct {
 	// in: header
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/go-kit/kit/endpoint"
 
@@ -35,8 +36,8 @@ import (
 
 func DigitaloceanSizeWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(cluster.GetClusterReq)
-		return providercommon.DigitaloceanSizeWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, settingsProvider, req.ProjectID, req.ClusterID)
+		req := request.(doSizesNoCredentialsReq)
+		return providercommon.DigitaloceanSizeWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, settingsProvider, req.ProjectID, req.ClusterID, req.sizeFilter())
 	}
 }
 
@@ -66,6 +67,8 @@ func DigitaloceanSizeEndpoint(presetProvider provider.PresetProvider, userInfoGe
 		}
 
 		filter := *settings.Spec.MachineDeploymentVMResourceQuota
+		sizeFilter := req.sizeFilter()
+		sizeFilter.Region = req.Region
 		datacenterName := req.DatacenterName
 		if datacenterName != "" {
 			_, datacenter, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, datacenterName)
@@ -74,14 +77,81 @@ func DigitaloceanSizeEndpoint(presetProvider provider.PresetProvider, userInfoGe
 			}
 
 			filter = handlercommon.DetermineMachineFlavorFilter(datacenter.Spec.MachineFlavorFilter, settings.Spec.MachineDeploymentVMResourceQuota)
+			if sizeFilter.Region == "" && datacenter.Spec.Digitalocean != nil {
+				sizeFilter.Region = datacenter.Spec.Digitalocean.Region
+			}
 		}
 
-		return providercommon.DigitaloceanSize(ctx, filter, token)
+		return providercommon.DigitaloceanSize(ctx, filter, sizeFilter, token)
+	}
+}
+
+// DoSizesFilterReq represent the filters for digitalocean sizes.
+type DoSizesFilterReq struct {
+	// MinCPU minimal number of vCPUs of the listed sizes
+	// in: query
+	MinCPU int `json:"min_cpu,omitempty"`
+	// MinMemory minimal memory in GB of the listed sizes
+	// in: query
+	MinMemory int `json:"min_memory,omitempty"`
+}
+
+func (req DoSizesFilterReq) sizeFilter() providercommon.DigitaloceanSizeFilter {
+	return providercommon.DigitaloceanSizeFilter{
+		MinCPU:    req.MinCPU,
+		MinMemory: req.MinMemory,
+	}
+}
+
+func decodeDoSizesFilterReq(r *http.Request) (DoSizesFilterReq, error) {
+	var req DoSizesFilterReq
+	var err error
+
+	if minCPU := r.URL.Query().Get("min_cpu"); minCPU != "" {
+		req.MinCPU, err = strconv.Atoi(minCPU)
+		if err != nil || req.MinCPU < 0 {
+			return req, utilerrors.NewBadRequest("invalid value for `min_cpu` (should be a non-negative number)")
+		}
+	}
+
+	if minMemory := r.URL.Query().Get("min_memory"); minMemory != "" {
+		req.MinMemory, err = strconv.Atoi(minMemory)
+		if err != nil || req.MinMemory < 0 {
+			return req, utilerrors.NewBadRequest("invalid value for `min_memory` (should be a non-negative number)")
+		}
+	}
+
+	return req, nil
+}
+
+// doSizesNoCredentialsReq represent a request for digitalocean sizes of a cluster.
+// The sizes are limited to the ones available in the region of the cluster datacenter.
+// swagger:parameters listDigitaloceanSizesNoCredentialsV2
+type doSizesNoCredentialsReq struct {
+	cluster.GetClusterReq
+	DoSizesFilterReq
+}
+
+func DecodeDoSizesNoCredentialsReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req doSizesNoCredentialsReq
+
+	clusterReq, err := cluster.DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
 	}
+	req.GetClusterReq = clusterReq.(cluster.GetClusterReq)
+
+	req.DoSizesFilterReq, err = decodeDoSizesFilterReq(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
 // DoSizesReq represent a request for digitalocean sizes.
 type DoSizesReq struct {
+	DoSizesFilterReq
 	// in: header
 	// DoToken Digital Ocean token
 	DoToken string
@@ -91,6 +161,9 @@ type DoSizesReq struct {
 	// in: header
 	// DatacenterName datacenter name
 	DatacenterName string
+	// Region slug of the region the sizes have to be available in, defaults to the region of the datacenter
+	// in: query
+	Region string `json:"region,omitempty"`
 }
 
 // DoProjectSizesReq represent a request for digitalocean sizes within a KKP project.
@@ -106,6 +179,13 @@ func DecodeDoSizesReq(c context.Context, r *http.Request) (interface{}, error) {
 	req.DoToken = r.Header.Get("DoToken")
 	req.Credential = r.Header.Get("Credential")
 	req.DatacenterName = r.Header.Get("DatacenterName")
+	req.Region = r.URL.Query().Get("region")
+
+	filterReq, err := decodeDoSizesFilterReq(r)
+	if err != nil {
+		return nil, err
+	}
+	req.DoSizesFilterReq = filterReq
 
 	return req, nil
 }
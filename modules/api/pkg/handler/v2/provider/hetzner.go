@@ -79,6 +79,96 @@ func HetznerProjectSizeEndpoint(presetProvider provider.PresetProvider, userInfo
 	}
 }
 
+func HetznerNetworksEndpoint(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(hetznerReq)
+		token, err := getHetznerToken(ctx, presetProvider, userInfoGetter, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return providercommon.HetznerNetworks(ctx, token)
+	}
+}
+
+func HetznerFirewallsEndpoint(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(hetznerReq)
+		token, err := getHetznerToken(ctx, presetProvider, userInfoGetter, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return providercommon.HetznerFirewalls(ctx, token)
+	}
+}
+
+func getHetznerToken(ctx context.Context, presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, req hetznerReq) (string, error) {
+	token := req.HetznerToken
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return "", common.KubernetesErrorToHTTPError(err)
+	}
+
+	if len(req.Credential) > 0 {
+		preset, err := presetProvider.GetPreset(ctx, userInfo, nil, req.Credential)
+		if err != nil {
+			return "", utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
+		}
+		if credentials := preset.Spec.Hetzner; credentials != nil {
+			token = credentials.Token
+		}
+	}
+
+	return token, nil
+}
+
+func HetznerNetworksWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(cluster.GetClusterReq)
+		return providercommon.HetznerNetworksWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID)
+	}
+}
+
+func HetznerFirewallsWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(cluster.GetClusterReq)
+		return providercommon.HetznerFirewallsWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID)
+	}
+}
+
+// hetznerReq represent a request for Hetzner resources.
+// swagger:parameters listHetznerNetworks listHetznerFirewalls
+type hetznerReq struct {
+	// in: header
+	// HetznerToken Hetzner token
+	HetznerToken string
+	// in: header
+	// Credential predefined Kubermatic credential name from the presets
+	Credential string
+}
+
+// Validate validates hetznerReq request.
+func (req hetznerReq) Validate() error {
+	if len(req.HetznerToken) == 0 && len(req.Credential) == 0 {
+		return utilerrors.NewBadRequest("Hetzner token or credential is required")
+	}
+	return nil
+}
+
+func DecodeHetznerReq(c context.Context, r *http.Request) (interface{}, error) {
+	req := hetznerReq{
+		HetznerToken: r.Header.Get("HetznerToken"),
+		Credential:   r.Header.Get("Credential"),
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // HetznerProjectSizesReq represent a request for Hetzner sizes.
 // swagger:parameters listProjectHetznerSizes
 type HetznerProjectSizesReq struct {
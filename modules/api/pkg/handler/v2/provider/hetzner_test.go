@@ -0,0 +1,263 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	providercommon "k8c.io/dashboard/v2/pkg/handler/common/provider"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/hetzner"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testHetznerToken      = "hetzner-token"
+	testHetznerPresetName = "hetzner-preset"
+	testHetznerDC         = "hetzner-dc"
+)
+
+type mockHetznerClientImpl struct {
+	token string
+}
+
+func mockHetznerClient(token string) hetzner.Client {
+	return &mockHetznerClientImpl{token: token}
+}
+
+func (m *mockHetznerClientImpl) ListNetworks(_ context.Context) (apiv1.HetznerNetworkList, error) {
+	if m.token != testHetznerToken {
+		return nil, errors.New("unauthorized")
+	}
+
+	return apiv1.HetznerNetworkList{
+		{ID: 1, Name: "kubernetes", IPRange: "10.0.0.0/16"},
+		{ID: 2, Name: "databases", IPRange: "10.1.0.0/16"},
+	}, nil
+}
+
+func (m *mockHetznerClientImpl) ListFirewalls(_ context.Context) (apiv1.HetznerFirewallList, error) {
+	if m.token != testHetznerToken {
+		return nil, errors.New("unauthorized")
+	}
+
+	return apiv1.HetznerFirewallList{
+		{ID: 10, Name: "nodes"},
+	}, nil
+}
+
+const (
+	expectedHetznerNetworks = `[
+	{"id": 1, "name": "kubernetes", "ipRange": "10.0.0.0/16"},
+	{"id": 2, "name": "databases", "ipRange": "10.1.0.0/16"}
+]`
+	expectedHetznerFirewalls = `[
+	{"id": 10, "name": "nodes"}
+]`
+)
+
+func TestHetznerNetworksAndFirewallsEndpoints(t *testing.T) {
+	testcases := []struct {
+		name             string
+		resource         string
+		token            string
+		credential       string
+		httpStatus       int
+		expectedResponse string
+	}{
+		{
+			name:       "test missing credentials",
+			resource:   "networks",
+			httpStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "test invalid credential reference",
+			resource:   "networks",
+			credential: "invalid",
+			httpStatus: http.StatusInternalServerError,
+		},
+		{
+			name:       "test invalid token",
+			resource:   "networks",
+			token:      "invalid",
+			httpStatus: http.StatusInternalServerError,
+		},
+		{
+			name:             "test network list with token",
+			resource:         "networks",
+			token:            testHetznerToken,
+			httpStatus:       http.StatusOK,
+			expectedResponse: expectedHetznerNetworks,
+		},
+		{
+			name:             "test network list with preset",
+			resource:         "networks",
+			credential:       testHetznerPresetName,
+			httpStatus:       http.StatusOK,
+			expectedResponse: expectedHetznerNetworks,
+		},
+		{
+			name:             "test firewall list with token",
+			resource:         "firewalls",
+			token:            testHetznerToken,
+			httpStatus:       http.StatusOK,
+			expectedResponse: expectedHetznerFirewalls,
+		},
+		{
+			name:             "test firewall list with preset",
+			resource:         "firewalls",
+			credential:       testHetznerPresetName,
+			httpStatus:       http.StatusOK,
+			expectedResponse: expectedHetznerFirewalls,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/providers/hetzner/%s", tc.resource), strings.NewReader(""))
+
+			req.Header.Add("HetznerToken", tc.token)
+			req.Header.Add("Credential", tc.credential)
+
+			providercommon.NewHetznerClient = mockHetznerClient
+
+			apiUser := test.GetUser(test.UserEmail, test.UserID, test.UserName)
+			kubermaticObjects := []ctrlruntimeclient.Object{
+				test.APIUserToKubermaticUser(apiUser),
+				genHetznerPreset(),
+			}
+
+			res := httptest.NewRecorder()
+			router, _, err := test.CreateTestEndpointAndGetClients(apiUser, nil, []ctrlruntimeclient.Object{}, []ctrlruntimeclient.Object{}, kubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			router.ServeHTTP(res, req)
+
+			// validate
+			assert.Equal(t, tc.httpStatus, res.Code)
+
+			if res.Code == http.StatusOK {
+				compareJSON(t, res, tc.expectedResponse)
+			}
+		})
+	}
+}
+
+func TestHetznerNetworksAndFirewallsNoCredentialsEndpoints(t *testing.T) {
+	testcases := []struct {
+		name             string
+		resource         string
+		cluster          *kubermaticv1.Cluster
+		httpStatus       int
+		expectedResponse string
+	}{
+		{
+			name:       "test cluster without Hetzner cloud spec",
+			resource:   "networks",
+			cluster:    test.GenDefaultCluster(),
+			httpStatus: http.StatusNotFound,
+		},
+		{
+			name:             "test network list with the credentials of the cluster",
+			resource:         "networks",
+			cluster:          genHetznerCluster(),
+			httpStatus:       http.StatusOK,
+			expectedResponse: expectedHetznerNetworks,
+		},
+		{
+			name:             "test firewall list with the credentials of the cluster",
+			resource:         "firewalls",
+			cluster:          genHetznerCluster(),
+			httpStatus:       http.StatusOK,
+			expectedResponse: expectedHetznerFirewalls,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/providers/hetzner/%s", test.GenDefaultProject().Name, tc.cluster.Name, tc.resource), strings.NewReader(""))
+
+			providercommon.NewHetznerClient = mockHetznerClient
+
+			seed := test.GenTestSeed(func(seed *kubermaticv1.Seed) {
+				seed.Spec.Datacenters[testHetznerDC] = kubermaticv1.Datacenter{
+					Spec: kubermaticv1.DatacenterSpec{
+						Hetzner: &kubermaticv1.DatacenterSpecHetzner{
+							Datacenter: "fsn1-dc14",
+						},
+					},
+				}
+			})
+
+			res := httptest.NewRecorder()
+			router, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, []ctrlruntimeclient.Object{}, test.GenDefaultKubermaticObjects(seed, tc.cluster), nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			router.ServeHTTP(res, req)
+
+			// validate
+			assert.Equal(t, tc.httpStatus, res.Code)
+
+			if res.Code == http.StatusOK {
+				compareJSON(t, res, tc.expectedResponse)
+			}
+		})
+	}
+}
+
+func genHetznerPreset() *kubermaticv1.Preset {
+	return &kubermaticv1.Preset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testHetznerPresetName,
+		},
+		Spec: kubermaticv1.PresetSpec{
+			Hetzner: &kubermaticv1.Hetzner{
+				Token: testHetznerToken,
+			},
+		},
+	}
+}
+
+func genHetznerCluster() *kubermaticv1.Cluster {
+	cluster := test.GenDefaultCluster()
+	cluster.Spec.Cloud = kubermaticv1.CloudSpec{
+		DatacenterName: testHetznerDC,
+		ProviderName:   string(kubermaticv1.HetznerCloudProvider),
+		Hetzner: &kubermaticv1.HetznerCloudSpec{
+			Token: testHetznerToken,
+		},
+	}
+
+	return cluster
+}
@@ -37,6 +37,7 @@ import (
 	"k8c.io/machine-controller/sdk/providerconfig"
 
 	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -698,3 +699,60 @@ func TestListStorageClassNoCredentialsEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestListPriorityClassNoCredentialsEndpoint(t *testing.T) {
+	testcases := []struct {
+		Name                       string
+		HTTPRequestURL             string
+		ExpectedResponse           string
+		HTTPStatus                 int
+		ExistingKubermaticObjects  []ctrlruntimeclient.Object
+		ExistingKubevirtK8sObjects []ctrlruntimeclient.Object
+		ExistingAPIUser            apiv1.User
+	}{
+		{
+			Name:           "scenario 1: list priority classes sorted by name",
+			HTTPRequestURL: fmt.Sprintf("/api/v2/projects/%s/clusters/%s/providers/kubevirt/priorityclasses", test.GenDefaultProject().Name, clusterId),
+			HTTPStatus:     http.StatusOK,
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				func() *kubermaticv1.Cluster {
+					cluster := test.GenCluster(clusterId, clusterName, test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+					cluster.Spec.Cloud = kubermaticv1.CloudSpec{
+						DatacenterName: kubevirtDatacenterName,
+						Kubevirt: &kubermaticv1.KubevirtCloudSpec{
+							Kubeconfig: fakeKvConfig,
+						},
+					}
+					return cluster
+				}(),
+			),
+			ExistingKubevirtK8sObjects: []ctrlruntimeclient.Object{
+				&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "vm-low"}, Value: 100},
+				&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "vm-high"}, Value: 1000},
+			},
+			ExistingAPIUser:  *test.GenDefaultAPIUser(),
+			ExpectedResponse: `[{"name":"vm-high","value":1000},{"name":"vm-low","value":100}]`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			setFakeNewKubeVirtClient(tc.ExistingKubevirtK8sObjects)
+
+			req := httptest.NewRequest(http.MethodGet, tc.HTTPRequestURL, strings.NewReader(""))
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(tc.ExistingAPIUser, nil, tc.ExistingKubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	providercommon "k8c.io/dashboard/v2/pkg/handler/common/provider"
+	"k8c.io/dashboard/v2/pkg/handler/v2/cluster"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// providerQuotaReq represents a request for the cloud provider quota of a cluster.
+// swagger:parameters getProviderQuota
+type providerQuotaReq struct {
+	cluster.GetClusterReq
+
+	// in: path
+	// required: true
+	ProviderName string `json:"provider_name"`
+}
+
+func DecodeProviderQuotaReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req providerQuotaReq
+
+	clusterReq, err := cluster.DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.GetClusterReq = clusterReq.(cluster.GetClusterReq)
+
+	req.ProviderName = mux.Vars(r)["provider_name"]
+	if req.ProviderName == "" {
+		return nil, utilerrors.NewBadRequest("'provider_name' parameter is required")
+	}
+
+	return req, nil
+}
+
+func ProviderQuotaEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	seedsGetter provider.SeedsGetter, presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(providerQuotaReq)
+		return providercommon.ProviderQuotaEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, presetProvider, req.ProjectID, req.ClusterID, req.ProviderName, caBundle)
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestProviderQuotaEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name             string
+		providerName     string
+		expectedHTTPCode int
+		expectedResponse string
+	}{
+		{
+			name:             "provider without quota API",
+			providerName:     "fake",
+			expectedHTTPCode: http.StatusNotImplemented,
+			expectedResponse: `{"error":{"code":501,"message":"quotas are not supported for the fake provider"}}`,
+		},
+		{
+			name:             "provider not matching the cluster",
+			providerName:     "aws",
+			expectedHTTPCode: http.StatusBadRequest,
+			expectedResponse: `{"error":{"code":400,"message":"cluster defClusterID is running on fake, not on aws"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/providers/%s/quota", test.GenDefaultProject().Name, test.DefaultClusterID, tc.providerName), nil)
+			res := httptest.NewRecorder()
+
+			kubermaticObjs := test.GenDefaultKubermaticObjects(test.GenTestSeed(), test.GenDefaultCluster())
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), []ctrlruntimeclient.Object{}, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.expectedHTTPCode {
+				t.Fatalf("expected HTTP status code %d, got %d: %s", tc.expectedHTTPCode, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.expectedResponse)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	machineconversions "k8c.io/dashboard/v2/pkg/machine"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// sshUserNamesReq represents a request for the SSH login users of a cloud provider.
+// swagger:parameters listSSHUserNames
+type sshUserNamesReq struct {
+	// in: path
+	// required: true
+	ProviderName string `json:"provider_name"`
+}
+
+func DecodeSSHUserNamesReq(_ context.Context, r *http.Request) (interface{}, error) {
+	req := sshUserNamesReq{
+		ProviderName: mux.Vars(r)["provider_name"],
+	}
+	if req.ProviderName == "" {
+		return nil, utilerrors.NewBadRequest("'provider_name' parameter is required")
+	}
+
+	return req, nil
+}
+
+// SSHUserNamesEndpoint returns the SSH login users of all operating systems for the given cloud provider.
+func SSHUserNamesEndpoint() endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(sshUserNamesReq)
+
+		userNames, err := machineconversions.GetSSHUserNames(req.ProviderName)
+		if err != nil {
+			return nil, utilerrors.NewNotFound("cloud provider", req.ProviderName)
+		}
+
+		return apiv2.SSHUserNames(userNames), nil
+	}
+}
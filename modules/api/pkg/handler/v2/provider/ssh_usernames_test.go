@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestSSHUserNamesEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name             string
+		providerName     string
+		expectedHTTPCode int
+		expectedResponse string
+	}{
+		{
+			name:             "SSH login users of aws",
+			providerName:     "aws",
+			expectedHTTPCode: http.StatusOK,
+			expectedResponse: `{"amzn2":"ec2-user","flatcar":"core","rhel":"ec2-user","rockylinux":"rocky","ubuntu":"ubuntu"}`,
+		},
+		{
+			name:             "SSH login users of a provider whose name differs from the node spec",
+			providerName:     "vmware-cloud-director",
+			expectedHTTPCode: http.StatusOK,
+			expectedResponse: `{"amzn2":"unknown","flatcar":"core","rhel":"cloud-user","rockylinux":"rocky","ubuntu":"ubuntu"}`,
+		},
+		{
+			name:             "provider without nodes",
+			providerName:     "bringyourown",
+			expectedHTTPCode: http.StatusNotFound,
+			expectedResponse: `{"error":{"code":404,"message":"cloud provider \"bringyourown\" not found"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/providers/%s/ssh-usernames", tc.providerName), nil)
+			res := httptest.NewRecorder()
+
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), []ctrlruntimeclient.Object{}, test.GenDefaultKubermaticObjects(), nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.expectedHTTPCode {
+				t.Fatalf("expected HTTP status code %d, got %d: %s", tc.expectedHTTPCode, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.expectedResponse)
+		})
+	}
+}
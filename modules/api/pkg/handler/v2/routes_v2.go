@@ -24,6 +24,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"k8c.io/dashboard/v2/pkg/handler"
+	"k8c.io/dashboard/v2/pkg/handler/metrics"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/handler/v2/addon"
@@ -45,12 +46,15 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/v2/cniversion"
 	"k8c.io/dashboard/v2/pkg/handler/v2/constraint"
 	constrainttemplate "k8c.io/dashboard/v2/pkg/handler/v2/constraint_template"
+	datacenterhealth "k8c.io/dashboard/v2/pkg/handler/v2/datacenter_health"
+	defaultnodespec "k8c.io/dashboard/v2/pkg/handler/v2/default_node_spec"
 	"k8c.io/dashboard/v2/pkg/handler/v2/etcdbackupconfig"
 	"k8c.io/dashboard/v2/pkg/handler/v2/etcdrestore"
 	externalcluster "k8c.io/dashboard/v2/pkg/handler/v2/external_cluster"
 	featuregates "k8c.io/dashboard/v2/pkg/handler/v2/feature_gates"
 	"k8c.io/dashboard/v2/pkg/handler/v2/gatekeeperconfig"
 	groupprojectbinding "k8c.io/dashboard/v2/pkg/handler/v2/group-project-binding"
+	instancetypefilter "k8c.io/dashboard/v2/pkg/handler/v2/instance_type_filter"
 	ipampool "k8c.io/dashboard/v2/pkg/handler/v2/ipampool"
 	kubernetesdashboard "k8c.io/dashboard/v2/pkg/handler/v2/kubernetes-dashboard"
 	policybinding "k8c.io/dashboard/v2/pkg/handler/v2/kyverno/policy-binding"
@@ -60,10 +64,13 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/v2/networkdefaults"
 	operatingsystemprofile "k8c.io/dashboard/v2/pkg/handler/v2/operatingsystemprofile"
 	"k8c.io/dashboard/v2/pkg/handler/v2/preset"
+	projectnodequota "k8c.io/dashboard/v2/pkg/handler/v2/project_node_quota"
+	projectwebhook "k8c.io/dashboard/v2/pkg/handler/v2/project_webhook"
 	"k8c.io/dashboard/v2/pkg/handler/v2/provider"
 	resourcequota "k8c.io/dashboard/v2/pkg/handler/v2/resource_quota"
 	"k8c.io/dashboard/v2/pkg/handler/v2/rulegroup"
 	rulegroupadmin "k8c.io/dashboard/v2/pkg/handler/v2/rulegroup_admin"
+	"k8c.io/dashboard/v2/pkg/handler/v2/search"
 	"k8c.io/dashboard/v2/pkg/handler/v2/seedoverview"
 	"k8c.io/dashboard/v2/pkg/handler/v2/seedsettings"
 	"k8c.io/dashboard/v2/pkg/handler/v2/user"
@@ -170,11 +177,29 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/featuregates").
 		Handler(r.getFeatureGates())
 
+	mux.Methods(http.MethodGet).
+		Path("/search").
+		Handler(r.search())
+
 	// Defines a set of HTTP endpoints for interacting with Baremetal clusters
 	mux.Methods(http.MethodGet).
 		Path("/providers/baremetal/tinkerbell/dc/{dc}/images").
 		Handler(r.listTinkerbellImages())
 
+	// Defines a set of HTTP endpoints for interacting with Anexia clusters
+	mux.Methods(http.MethodGet).
+		Path("/providers/anexia/templates").
+		Handler(r.listAnexiaTemplates())
+
+	// Defines a set of HTTP endpoints for interacting with Hetzner clusters
+	mux.Methods(http.MethodGet).
+		Path("/providers/hetzner/networks").
+		Handler(r.listHetznerNetworks())
+
+	mux.Methods(http.MethodGet).
+		Path("/providers/hetzner/firewalls").
+		Handler(r.listHetznerFirewalls())
+
 	// Defines a set of HTTP endpoints for interacting with KubeVirt clusters
 	mux.Methods(http.MethodGet).
 		Path("/providers/kubevirt/instancetypes").
@@ -207,23 +232,67 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 
 	mux.Methods(http.MethodPost).
 		Path("/projects/{project_id}/clusters").
-		Handler(r.createCluster())
+		Handler(metrics.InstrumentEndpoint("createCluster", r.createCluster()))
 
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters").
-		Handler(r.listClusters())
+		Handler(metrics.InstrumentEndpoint("listClusters", r.listClusters()))
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/usage").
+		Handler(r.getProjectUsage())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/machinedeployments").
+		Handler(r.listProjectMachineDeployments())
 
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}").
-		Handler(r.getCluster())
+		Handler(metrics.InstrumentEndpoint("getCluster", r.getCluster()))
 
 	mux.Methods(http.MethodDelete).
 		Path("/projects/{project_id}/clusters/{cluster_id}").
-		Handler(r.deleteCluster())
+		Handler(metrics.InstrumentEndpoint("deleteCluster", r.deleteCluster()))
 
 	mux.Methods(http.MethodPatch).
 		Path("/projects/{project_id}/clusters/{cluster_id}").
-		Handler(r.patchCluster())
+		Handler(metrics.InstrumentEndpoint("patchCluster", r.patchCluster()))
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/patch-preview").
+		Handler(metrics.InstrumentEndpoint("previewClusterPatch", r.previewClusterPatch()))
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/admissionplugins").
+		Handler(r.getClusterAdmissionPlugins())
+
+	mux.Methods(http.MethodPut).
+		Path("/projects/{project_id}/clusters/{cluster_id}/admissionplugins").
+		Handler(r.updateClusterAdmissionPlugins())
+
+	mux.Methods(http.MethodPut).
+		Path("/projects/{project_id}/clusters/{cluster_id}/maintenance-window").
+		Handler(r.updateClusterMaintenanceWindow())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/advisories").
+		Handler(r.listClusterAdvisories())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/advisories/{advisory_id}/ack").
+		Handler(r.acknowledgeClusterAdvisory())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/crds").
+		Handler(r.listClusterCRDs())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/cni").
+		Handler(r.getClusterCNI())
+
+	mux.Methods(http.MethodPut).
+		Path("/projects/{project_id}/clusters/{cluster_id}/cni").
+		Handler(r.updateClusterCNI())
 
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/events").
@@ -233,6 +302,18 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/health").
 		Handler(r.getClusterHealth())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/health/etcd").
+		Handler(r.getClusterEtcdHealth())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/permissions").
+		Handler(r.getClusterPermissions())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/supportbundle").
+		Handler(r.getClusterSupportBundle())
+
 	mux.Methods(http.MethodPost).
 		Path("/projects/{project_id}/clusters/{cluster_id}/externalccmmigration").
 		Handler(r.migrateClusterToExternalCCM())
@@ -253,6 +334,10 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/oidckubeconfig").
 		Handler(r.getOidcClusterKubeconfig())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/serviceaccountkubeconfig").
+		Handler(r.getServiceAccountClusterKubeconfig())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/oidc").
 		Handler(r.getClusterOidc())
@@ -269,6 +354,14 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/upgrades").
 		Handler(r.getClusterUpgrades())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/upgrades/plan").
+		Handler(r.getClusterUpgradePlan())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/upgrade-preflight").
+		Handler(r.getClusterUpgradePreflight())
+
 	mux.Methods(http.MethodPut).
 		Path("/projects/{project_id}/clusters/{cluster_id}/nodes/upgrades").
 		Handler(r.upgradeClusterNodeDeployments())
@@ -316,19 +409,35 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 	// Defines a set of HTTP endpoint for machine deployments that belong to a cluster
 	mux.Methods(http.MethodPost).
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments").
-		Handler(r.createMachineDeployment())
+		Handler(metrics.InstrumentEndpoint("createMachineDeployment", r.createMachineDeployment()))
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/validate").
+		Handler(metrics.InstrumentEndpoint("validateMachineDeployment", r.validateMachineDeployment()))
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/estimate").
+		Handler(r.estimateMachineDeploymentCost())
 
 	mux.Methods(http.MethodDelete).
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id}").
 		Handler(r.deleteMachineDeploymentNode())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/export").
+		Handler(r.exportMachineDeployments())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/import").
+		Handler(r.importMachineDeployments())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments").
-		Handler(r.listMachineDeployments())
+		Handler(metrics.InstrumentEndpoint("listMachineDeployments", r.listMachineDeployments()))
 
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}").
-		Handler(r.getMachineDeployment())
+		Handler(metrics.InstrumentEndpoint("getMachineDeployment", r.getMachineDeployment()))
 
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/joiningscript").
@@ -338,29 +447,77 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes").
 		Handler(r.listMachineDeploymentNodes())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes/{node_id}/consolelog").
+		Handler(r.getMachineConsoleLog())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/nodes").
 		Handler(r.listNodesForCluster())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/nodes/export").
+		Handler(r.exportNodesForCluster())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/nodes/{node_id}/cordon").
+		Handler(r.cordonNode())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/nodes/{node_id}/uncordon").
+		Handler(r.uncordonNode())
+
+	mux.Methods(http.MethodPatch).
+		Path("/projects/{project_id}/clusters/{cluster_id}/nodes/{node_id}/labels").
+		Handler(r.patchNodeLabels())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/nodes/{node_id}/pods").
+		Handler(r.listNodePods())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/nodes/{node_id}/pods/{namespace}/{pod_name}/evict").
+		Handler(r.evictNodePod())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes/metrics").
 		Handler(r.listMachineDeploymentMetrics())
 
 	mux.Methods(http.MethodPatch).
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}").
-		Handler(r.patchMachineDeployment())
+		Handler(metrics.InstrumentEndpoint("patchMachineDeployment", r.patchMachineDeployment()))
 
 	mux.Methods(http.MethodPost).
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/restart").
 		Handler(r.restartMachineDeployment())
 
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/pause").
+		Handler(r.pauseMachineDeployment())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/resume").
+		Handler(r.resumeMachineDeployment())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/copy-to").
+		Handler(r.copyMachineDeployment())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/revisions").
+		Handler(r.listMachineDeploymentRevisions())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/rollback").
+		Handler(r.rollbackMachineDeployment())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes/events").
 		Handler(r.listMachineDeploymentNodesEvents())
 
 	mux.Methods(http.MethodDelete).
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}").
-		Handler(r.deleteMachineDeployment())
+		Handler(metrics.InstrumentEndpoint("deleteMachineDeployment", r.deleteMachineDeployment()))
 
 	// Defines set of HTTP endpoints for SSH Keys that belong to a cluster
 	mux.Methods(http.MethodPut).
@@ -375,11 +532,19 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/sshkeys").
 		Handler(r.listSSHKeysAssignedToCluster())
 
+	mux.Methods(http.MethodPut).
+		Path("/projects/{project_id}/clusters/{cluster_id}/sshkeys").
+		Handler(r.setSSHKeysAssignedToCluster())
+
 	// Defines a set of HTTP endpoints for external cluster that belong to a project.
 	mux.Methods(http.MethodPost).
 		Path("/projects/{project_id}/kubernetes/clusters").
 		Handler(r.createExternalCluster())
 
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/kubernetes/clusters/validate").
+		Handler(r.validateExternalCluster())
+
 	mux.Methods(http.MethodDelete).
 		Path("/projects/{project_id}/kubernetes/clusters/{cluster_id}").
 		Handler(r.deleteExternalCluster())
@@ -578,6 +743,10 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name}").
 		Handler(r.patchConstraint())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/violations").
+		Handler(r.listClusterViolations())
+
 	// Defines a set of HTTP endpoints for managing gatekeeper config
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config").
@@ -620,6 +789,10 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id}").
 		Handler(r.deleteAddon())
 
+	mux.Methods(http.MethodGet).
+		Path("/addons/{addon_name}/schema").
+		Handler(r.getAddonSchema())
+
 	// Defines a set of HTTP endpoints for managing alertmanager
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/alertmanager/config").
@@ -985,10 +1158,22 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/providers/hetzner/sizes").
 		Handler(r.listHetznerSizesNoCredentials())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/providers/hetzner/networks").
+		Handler(r.listHetznerNetworksNoCredentials())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/providers/hetzner/firewalls").
+		Handler(r.listHetznerFirewallsNoCredentials())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/providers/digitalocean/sizes").
 		Handler(r.listDigitaloceanSizesNoCredentials())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/providers/{provider_name}/quota").
+		Handler(r.getProviderQuota())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/providers/openstack/sizes").
 		Handler(r.listOpenstackSizesNoCredentials())
@@ -1029,6 +1214,10 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/providers/azure/availabilityzones").
 		Handler(r.listAzureAvailabilityZonesNoCredentials())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/providers/azure/proximityplacementgroups").
+		Handler(r.listAzureProximityPlacementGroupsNoCredentials())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/providers/vsphere/networks").
 		Handler(r.listVSphereNetworksNoCredentials())
@@ -1101,6 +1290,10 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/providers/kubevirt/subnets").
 		Handler(r.listKubeVirtSubnetsNoCredentials())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/providers/kubevirt/priorityclasses").
+		Handler(r.listKubeVirtPriorityClassesNoCredentials())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/providers/nutanix/subnets").
 		Handler(r.listNutanixSubnetsNoCredentials())
@@ -1281,6 +1474,11 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/providers/{provider_name}/versions").
 		Handler(r.listVersionsByProvider())
 
+	// Define an endpoint to retrieve the SSH login users of the operating systems for the given provider
+	mux.Methods(http.MethodGet).
+		Path("/providers/{provider_name}/ssh-usernames").
+		Handler(r.listSSHUserNames())
+
 	// Define a set of endpoints for cluster templates management
 	mux.Methods(http.MethodPost).
 		Path("/projects/{project_id}/clustertemplates").
@@ -1303,6 +1501,12 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 	mux.Methods(http.MethodPost).
 		Path("/projects/{project_id}/clustertemplates/{template_id}/instances").
 		Handler(r.createClusterTemplateInstance())
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clustertemplates/{template_id}/instances/{instance_id}/status").
+		Handler(r.getClusterTemplateInstanceStatus())
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clustertemplates/{template_id}/instances/{instance_id}/retry").
+		Handler(r.retryClusterTemplateInstance())
 	mux.Methods(http.MethodPut).
 		Path("/projects/{project_id}/clustertemplates/{template_id}").
 		Handler(r.updateClusterTemplate())
@@ -1474,6 +1678,14 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/etcdbackupconfigs").
 		Handler(r.listProjectEtcdBackupConfig())
 
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/etcdbackups/trigger").
+		Handler(r.triggerEtcdBackup())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/etcdbackups/{ebc_id}/status").
+		Handler(r.getEtcdBackupStatus())
+
 	// Defines a set of HTTP endpoints for managing etcd backup restores
 	mux.Methods(http.MethodPost).
 		Path("/projects/{project_id}/clusters/{cluster_id}/etcdrestores").
@@ -1522,6 +1734,47 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/users").
 		Handler(r.listUser())
 
+	// Defines an endpoint for listing the clusters of all projects for admins
+	mux.Methods(http.MethodGet).
+		Path("/admin/clusters").
+		Handler(r.listAdminClusters())
+
+	// Defines an endpoint for observing the circuit breakers of the seeds for admins
+	mux.Methods(http.MethodGet).
+		Path("/admin/seeds/circuitbreakers").
+		Handler(r.listSeedCircuitBreakers())
+
+	// Defines a set of HTTP endpoints for managing the instance type filters of datacenters for admins
+	mux.Methods(http.MethodGet).
+		Path("/admin/seeds/{seed_name}/datacenters/{dc}/machine-flavor-filter").
+		Handler(r.getInstanceTypeFilter())
+
+	mux.Methods(http.MethodPut).
+		Path("/admin/seeds/{seed_name}/datacenters/{dc}/machine-flavor-filter").
+		Handler(r.updateInstanceTypeFilter())
+
+	mux.Methods(http.MethodDelete).
+		Path("/admin/seeds/{seed_name}/datacenters/{dc}/machine-flavor-filter").
+		Handler(r.deleteInstanceTypeFilter())
+
+	// Defines an HTTP endpoint for managing the capacity hints of datacenters for admins
+	mux.Methods(http.MethodPut).
+		Path("/admin/seeds/{seed_name}/datacenters/{dc}/capacity").
+		Handler(r.updateDatacenterCapacity())
+
+	// Defines a set of HTTP endpoints for managing the node quotas of projects for admins
+	mux.Methods(http.MethodGet).
+		Path("/admin/projects/{project_id}/node-quota").
+		Handler(r.getProjectNodeQuota())
+
+	mux.Methods(http.MethodPut).
+		Path("/admin/projects/{project_id}/node-quota").
+		Handler(r.updateProjectNodeQuota())
+
+	mux.Methods(http.MethodDelete).
+		Path("/admin/projects/{project_id}/node-quota").
+		Handler(r.deleteProjectNodeQuota())
+
 	// Defines a set of HTTP endpoints for managing rule groups for admins
 	mux.Methods(http.MethodGet).
 		Path("/seeds/{seed_name}/rulegroups/{rulegroup_id}").
@@ -1611,6 +1864,20 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/providers/{provider_name}/dc/{dc}/defaultcluster").
 		Handler(r.getDefaultCluster())
 
+	// Defines endpoints to retrieve the seed connectivity and capacity of datacenters.
+	mux.Methods(http.MethodGet).
+		Path("/providers/{provider_name}/dc/{dc}/health").
+		Handler(r.getDatacenterHealth())
+
+	mux.Methods(http.MethodGet).
+		Path("/dc/health").
+		Handler(r.listDatacenterHealth())
+
+	// Defines an endpoint to retrieve the node spec which is suggested for the machine deployments of a datacenter.
+	mux.Methods(http.MethodGet).
+		Path("/dc/{dc}/default-node-spec").
+		Handler(r.getDefaultNodeSpec())
+
 	// Defines endpoints to interact with resource quotas
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/quota").
@@ -1661,6 +1928,27 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/groupbindings/{binding_name}").
 		Handler(r.patchGroupProjectBinding())
 
+	// Defines endpoints to manage project webhooks
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/webhooks").
+		Handler(r.listProjectWebhooks())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/webhooks").
+		Handler(r.createProjectWebhook())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/webhooks/{webhook_id}").
+		Handler(r.getProjectWebhook())
+
+	mux.Methods(http.MethodPut).
+		Path("/projects/{project_id}/webhooks/{webhook_id}").
+		Handler(r.updateProjectWebhook())
+
+	mux.Methods(http.MethodDelete).
+		Path("/projects/{project_id}/webhooks/{webhook_id}").
+		Handler(r.deleteProjectWebhook())
+
 	// Defines endpoints to manage IPAM pools
 	mux.Methods(http.MethodGet).
 		Path("/seeds/{seed_name}/ipampools").
@@ -1712,6 +2000,19 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/serviceaccount/{namespace}/{service_account_id}").
 		Handler(r.deleteClusterServiceAccount())
 
+	// Defines endpoints to manage read-only kubeconfig shares of a cluster
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/kubeconfig/share").
+		Handler(r.createKubeconfigShare())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/kubeconfig/share").
+		Handler(r.listKubeconfigShares())
+
+	mux.Methods(http.MethodDelete).
+		Path("/projects/{project_id}/clusters/{cluster_id}/kubeconfig/share/{share_id}").
+		Handler(r.deleteKubeconfigShare())
+
 	mux.Methods(http.MethodGet).
 		Path("/seeds/{seed_name}/overview").
 		Handler(r.getSeedOverview())
@@ -1985,8 +2286,8 @@ func (r Routing) createCluster() http.Handler {
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.CreateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter,
-			r.presetProvider, r.exposeStrategy, r.userInfoGetter, r.settingsProvider, r.caBundle, r.kubermaticConfigGetter, r.features)),
+		)(cluster.CreateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.clusterProviderGetter,
+			r.presetProvider, r.exposeStrategy, r.userInfoGetter, r.settingsProvider, r.caBundle, r.kubermaticConfigGetter, r.features, r.webhookNotifier)),
 		cluster.DecodeCreateReq,
 		handler.SetStatusCreatedHeader(handler.EncodeJSON),
 		r.defaultServerOptions()...,
@@ -2010,421 +2311,376 @@ func (r Routing) listClusters() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(cluster.ListEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.clusterProviderGetter, r.userInfoGetter, r.kubermaticConfigGetter)),
+		)(cluster.ListEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.clusterProviderGetter, r.userInfoGetter, r.kubermaticConfigGetter, r.seedCircuitBreaker)),
 		cluster.DecodeListClustersReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id} project getClusterV2
+// swagger:route GET /api/v2/projects/{project_id}/usage project getProjectUsage
 //
-//	Gets the cluster with the given name
+//	Gets the current resource usage of the project: the number of clusters, machine deployments and nodes
+//	and the capacity of the nodes, in total and per cluster. Clusters which are not reachable are reported
+//	with an unknown usage and are not part of the totals.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: Cluster
+//	  200: ProjectUsage
 //	  401: empty
 //	  403: empty
-func (r Routing) getCluster() http.Handler {
+func (r Routing) getProjectUsage() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.GetEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.kubermaticConfigGetter)),
-		cluster.DecodeGetClusterReq,
+		)(cluster.GetProjectUsageEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.clusterProviderGetter, r.userInfoGetter)),
+		cluster.DecodeGetProjectUsageReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// Delete the cluster
-// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id} project deleteClusterV2
+// swagger:route GET /api/v2/projects/{project_id}/machinedeployments project listProjectMachineDeployments
 //
-//	Deletes the specified cluster
+//	Lists the machine deployments of all clusters of the project. If query parameter `kubelet_older_than` is set,
+//	only the machine deployments with an older kubelet version are listed. Seeds and clusters which are not
+//	reachable are reported with an error entry.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: empty
+//	  200: []ProjectMachineDeployment
 //	  401: empty
 //	  403: empty
-func (r Routing) deleteCluster() http.Handler {
+func (r Routing) listProjectMachineDeployments() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeDeleteReq,
+		)(machine.ListProjectMachineDeployments(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.clusterProviderGetter, r.userInfoGetter, r.seedCircuitBreaker)),
+		machine.DecodeListProjectMachineDeployments,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id} project patchClusterV2
+// swagger:route GET /api/v2/admin/clusters admin listAdminClusters
 //
-//	Patches the given cluster using JSON Merge Patch method (https://tools.ietf.org/html/rfc7396).
+//	Lists clusters of all projects. Clusters can be filtered by datacenter, version, phase and labels. Only available for admins.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: Cluster
+//	  200: AdminClusterList
 //	  401: empty
 //	  403: empty
-func (r Routing) patchCluster() http.Handler {
+func (r Routing) listAdminClusters() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.PatchEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.caBundle, r.kubermaticConfigGetter, r.features)),
-		cluster.DecodePatchReq,
+		)(cluster.ListAdminEndpoint(r.seedsGetter, r.clusterProviderGetter, r.userInfoGetter, r.kubermaticConfigGetter)),
+		cluster.DecodeListAdminClustersReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// getClusterEvents returns events related to the cluster.
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/events project getClusterEventsV2
+// swagger:route GET /api/v2/admin/seeds/circuitbreakers admin listSeedCircuitBreakers
 //
-//	Gets the events related to the specified cluster.
+//	Lists the circuit breakers of the seeds. Seeds with an open circuit breaker are skipped when listing clusters.
 //
 //	Produces:
-//	- application/yaml
+//	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []Event
+//	  200: []SeedCircuitBreaker
 //	  401: empty
 //	  403: empty
-func (r Routing) getClusterEvents() http.Handler {
+func (r Routing) listSeedCircuitBreakers() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.GetClusterEventsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeGetClusterEvents,
+		)(cluster.ListSeedCircuitBreakersEndpoint(r.seedsGetter, r.userInfoGetter, r.seedCircuitBreaker)),
+		common.DecodeEmptyReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/health project getClusterHealthV2
+// swagger:route GET /api/v2/admin/seeds/{seed_name}/datacenters/{dc}/machine-flavor-filter admin getInstanceTypeFilter
 //
-//	Returns the cluster's component health status
+//	Gets the instance type filter of the datacenter, which restricts the instance types of machine deployments.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ClusterHealth
+//	  200: InstanceTypeFilter
 //	  401: empty
 //	  403: empty
-func (r Routing) getClusterHealth() http.Handler {
+func (r Routing) getInstanceTypeFilter() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.HealthEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeGetClusterReq,
+		)(instancetypefilter.GetEndpoint(r.userInfoGetter, r.seedsGetter)),
+		instancetypefilter.DecodeInstanceTypeFilterReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// getClusterKubeconfig returns the kubeconfig for the cluster.
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/kubeconfig project getClusterKubeconfigV2
+// swagger:route PUT /api/v2/admin/seeds/{seed_name}/datacenters/{dc}/machine-flavor-filter admin updateInstanceTypeFilter
 //
-//	Gets the kubeconfig for the specified cluster.
+//	Replaces the instance type filter of the datacenter. Denied instance types and, if instance types are allowed
+//	explicitly, all others are rejected when machine deployments are created or their instance type is changed.
+//
+//	Consumes:
+//	- application/json
 //
 //	Produces:
-//	- application/octet-stream
+//	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: Kubeconfig
+//	  200: InstanceTypeFilter
 //	  401: empty
 //	  403: empty
-func (r Routing) getClusterKubeconfig() http.Handler {
+func (r Routing) updateInstanceTypeFilter() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.GetAdminKubeconfigEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.settingsProvider)),
-		cluster.DecodeGetClusterReq,
-		cluster.EncodeKubeconfig,
+		)(instancetypefilter.UpdateEndpoint(r.userInfoGetter, r.seedsGetter, r.seedProvider)),
+		instancetypefilter.DecodeUpdateInstanceTypeFilterReq,
+		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// getOidcClusterKubeconfig returns the oidc kubeconfig for the cluster.
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/oidckubeconfig project getOidcClusterKubeconfigV2
-//
-//	Gets the kubeconfig for the specified cluster with oidc authentication.
+// swagger:route DELETE /api/v2/admin/seeds/{seed_name}/datacenters/{dc}/machine-flavor-filter admin deleteInstanceTypeFilter
 //
-//	Produces:
-//	- application/octet-stream
+//	Removes the instance type filter of the datacenter.
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: Kubeconfig
+//	  200: empty
 //	  401: empty
 //	  403: empty
-func (r Routing) getOidcClusterKubeconfig() http.Handler {
+func (r Routing) deleteInstanceTypeFilter() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.GetOidcKubeconfigEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeGetClusterReq,
-		cluster.EncodeKubeconfig,
+		)(instancetypefilter.DeleteEndpoint(r.userInfoGetter, r.seedsGetter, r.seedProvider)),
+		instancetypefilter.DecodeInstanceTypeFilterReq,
+		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// getClusterOidc returns the OIDC spec for the user cluster.
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/oidc project getClusterOidc
+// swagger:route PUT /api/v2/admin/seeds/{seed_name}/datacenters/{dc}/capacity admin updateDatacenterCapacity
 //
-//	Gets the OIDC params for the specified cluster with OIDC authentication.
+//	Replaces the capacity hints of the datacenter, which are returned by the datacenter health endpoints.
+//	Hints without maxClusters remove the capacity hints of the datacenter.
+//
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: OIDCSpec
+//	  200: DatacenterCapacity
 //	  401: empty
 //	  403: empty
-func (r Routing) getClusterOidc() http.Handler {
+func (r Routing) updateDatacenterCapacity() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.GetClusterOidcEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeGetClusterReq,
+		)(datacenterhealth.UpdateCapacityEndpoint(r.userInfoGetter, r.seedsGetter, r.seedProvider)),
+		datacenterhealth.DecodeUpdateDatacenterCapacityReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/metrics project getClusterMetricsV2
+// swagger:route GET /api/v2/admin/projects/{project_id}/node-quota admin getProjectNodeQuota
 //
-//	Gets cluster metrics
+//	Gets the node quota of the project, which limits the nodes and machine deployments of all its clusters.
 //
-//	 Produces:
-//	 - application/json
+//	Produces:
+//	- application/json
 //
-//	 Responses:
-//	   default: errorResponse
-//	   200: ClusterMetrics
-//	   401: empty
-//	   403: empty
-func (r Routing) getClusterMetrics() http.Handler {
+//	Responses:
+//	  default: errorResponse
+//	  200: ProjectNodeQuota
+//	  401: empty
+//	  403: empty
+func (r Routing) getProjectNodeQuota() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.GetMetricsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeGetClusterReq,
+		)(projectnodequota.GetEndpoint(r.userInfoGetter, r.privilegedProjectProvider)),
+		projectnodequota.DecodeProjectNodeQuotaReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/namespaces project listNamespaceV2
+// swagger:route PUT /api/v2/admin/projects/{project_id}/node-quota admin updateProjectNodeQuota
 //
-//	Lists all namespaces in the cluster
+//	Replaces the node quota of the project. Machine deployments which would exceed it are rejected when they are
+//	created or scaled up.
+//
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []Namespace
+//	  200: ProjectNodeQuota
 //	  401: empty
 //	  403: empty
-func (r Routing) listNamespace() http.Handler {
+func (r Routing) updateProjectNodeQuota() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.ListNamespaceEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeGetClusterReq,
-		handler.EncodeJSON,
-		r.defaultServerOptions()...,
-	)
-}
-
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/upgrades project getClusterUpgradesV2
-//
-//	Gets possible cluster upgrades
-//
-//	 Produces:
-//	 - application/json
-//
-//	 Responses:
-//	   default: errorResponse
-//	   200: []MasterVersion
-//	   401: empty
-//	   403: empty
-func (r Routing) getClusterUpgrades() http.Handler {
-	return httptransport.NewServer(
-		endpoint.Chain(
-			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
-			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.GetUpgradesEndpoint(r.kubermaticConfigGetter, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeGetClusterReq,
+		)(projectnodequota.UpdateEndpoint(r.userInfoGetter, r.privilegedProjectProvider)),
+		projectnodequota.DecodeUpdateProjectNodeQuotaReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes/upgrades project upgradeClusterNodeDeploymentsV2
-//
-//	Upgrades node deployments in a cluster
+// swagger:route DELETE /api/v2/admin/projects/{project_id}/node-quota admin deleteProjectNodeQuota
 //
-//	 Produces:
-//	 - application/json
+//	Removes the node quota of the project.
 //
-//	 Responses:
-//	   default: errorResponse
-//	   200: empty
-//	   401: empty
-//	   403: empty
-func (r Routing) upgradeClusterNodeDeployments() http.Handler {
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) deleteProjectNodeQuota() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.UpgradeNodeDeploymentsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeUpgradeNodeDeploymentsReq,
+		)(projectnodequota.DeleteEndpoint(r.userInfoGetter, r.privilegedProjectProvider)),
+		projectnodequota.DecodeProjectNodeQuotaReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/sshkeys/{key_id} project assignSSHKeyToClusterV2
-//
-//	Assigns an existing ssh key to the given cluster
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id} project getClusterV2
 //
-//	Consumes:
-//	- application/json
+//	Gets the cluster with the given name. The resource version of the cluster is returned in the ETag header.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  201: SSHKey
+//	  200: Cluster
 //	  401: empty
 //	  403: empty
-func (r Routing) assignSSHKeyToCluster() http.Handler {
+func (r Routing) getCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.AssignSSHKeyEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeAssignSSHKeyReq,
-		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		)(cluster.GetEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.kubermaticConfigGetter)),
+		cluster.DecodeGetClusterReq,
+		cluster.EncodeClusterWithETag,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/projects/{project_id}/clusters/{cluster_id}/sshkeys/{key_id} project detachSSHKeyFromClusterV2
-//
-//	Unassignes an ssh key from the given cluster
+// Delete the cluster
+// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id} project deleteClusterV2
 //
-//	Consumes:
-//	- application/json
+//	Deletes the specified cluster. With preview=true nothing is deleted, instead the resources which would be
+//	cleaned up are returned.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: empty
+//	  200: ClusterDeletionPreview
 //	  401: empty
 //	  403: empty
-func (r Routing) detachSSHKeyFromCluster() http.Handler {
+func (r Routing) deleteCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.DetachSSHKeyEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeAssignSSHKeyReq,
+		)(cluster.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.webhookNotifier)),
+		cluster.DecodeDeleteReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/sshkeys project listSSHKeysAssignedToClusterV2
-//
-//	Lists ssh keys that are assigned to the cluster
-//	The returned collection is sorted by creation timestamp.
+// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id} project patchClusterV2
 //
-//	Consumes:
-//	- application/json
+//	Patches the given cluster using JSON Merge Patch method (https://tools.ietf.org/html/rfc7396).
+//	If the If-Match header contains a resource version, the patch is only applied if the cluster has not
+//	been modified since, otherwise the request fails with 409.
+//	With upgrade_machine_deployments=true the machine deployments which kubelet is not compatible with the new
+//	control plane version are upgraded to it, the response lists the result for every machine deployment.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []SSHKey
+//	  200: PatchedCluster
 //	  401: empty
 //	  403: empty
-func (r Routing) listSSHKeysAssignedToCluster() http.Handler {
+//	  409: errorResponse
+func (r Routing) patchCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.ListSSHKeysEndpoint(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeListSSHKeysReq,
-		handler.EncodeJSON,
+		)(cluster.PatchEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.caBundle, r.kubermaticConfigGetter, r.features)),
+		cluster.DecodePatchReq,
+		cluster.EncodeClusterWithETag,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/projects/{project_id}/kubernetes/clusters project createExternalCluster
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/patch-preview project previewClusterPatch
 //
-//	Creates an external cluster for the given project.
+//	Applies the JSON Merge Patch to the given cluster like patchClusterV2, including defaulting and validation,
+//	without persisting it and lists the fields which would change. Cloud provider credentials are redacted.
 //
 //	Consumes:
 //	- application/json
@@ -2434,358 +2690,1167 @@ func (r Routing) listSSHKeysAssignedToCluster() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  201: ExternalCluster
+//	  200: ClusterPatchPreview
 //	  401: empty
 //	  403: empty
-func (r Routing) createExternalCluster() http.Handler {
+//	  409: errorResponse
+func (r Routing) previewClusterPatch() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider, r.presetProvider)),
-		externalcluster.DecodeCreateReq,
-		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.PatchPreviewEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.caBundle, r.kubermaticConfigGetter, r.features)),
+		cluster.DecodePatchReq,
+		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// Delete the external cluster
-// swagger:route DELETE /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project deleteExternalCluster
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/admissionplugins project getClusterAdmissionPlugins
 //
-//	Deletes the specified external cluster
+//	Lists the admission plugins enabled for the given cluster.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: empty
+//	  200: AdmissionPluginList
 //	  401: empty
 //	  403: empty
-func (r Routing) deleteExternalCluster() http.Handler {
+func (r Routing) getClusterAdmissionPlugins() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeDeleteReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetAdmissionPluginsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters project listExternalClusters
+// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/admissionplugins project updateClusterAdmissionPlugins
 //
-//	Lists external clusters for the specified project.
+//	Replaces the admission plugins enabled for the given cluster. Plugins which are not supported for the
+//	Kubernetes version of the cluster are rejected.
+//
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []ExternalCluster
+//	  200: AdmissionPluginList
+//	  400: errorResponse
 //	  401: empty
 //	  403: empty
-func (r Routing) listExternalClusters() http.Handler {
+func (r Routing) updateClusterAdmissionPlugins() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeListReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.UpdateAdmissionPluginsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.admissionPluginProvider, r.caBundle, r.kubermaticConfigGetter, r.features)),
+		cluster.DecodeUpdateAdmissionPluginsReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project getExternalCluster
+// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/maintenance-window project updateClusterMaintenanceWindow
 //
-//	Gets an external cluster for the given project.
+//	Replaces the maintenance window of the given cluster. Outside of the window, upgrades of the cluster and the
+//	kubelets and restarts of machine deployments are rejected, unless they are forced. An empty window removes it.
+//	Only project owners and admins can change the window.
 //
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ExternalCluster
+//	  200: MaintenanceWindow
+//	  400: errorResponse
 //	  401: empty
 //	  403: empty
-func (r Routing) getExternalCluster() http.Handler {
+func (r Routing) updateClusterMaintenanceWindow() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeGetReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.UpdateMaintenanceWindowEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeUpdateMaintenanceWindowReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PATCH /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project patchExternalCluster
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/advisories project listClusterAdvisories
 //
-//	Patches the given cluster using JSON Merge Patch method (https://tools.ietf.org/html/rfc7396).
+//	Lists the advisories of the given cluster with their severity and a hint how to remediate them.
 //
+//	The advisories are computed from the conditions of the cluster, the end of life of its Kubernetes version, its
+//	CNI plugin version and the expiry of its CA certificate.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ExternalCluster
+//	  200: []ClusterAdvisory
 //	  401: empty
 //	  403: empty
-func (r Routing) patchExternalCluster() http.Handler {
+func (r Routing) listClusterAdvisories() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodePatchReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.ListAdvisoriesEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeListAdvisoriesReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PUT /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project updateExternalCluster
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/advisories/{advisory_id}/ack project acknowledgeClusterAdvisory
 //
-//	Updates an external cluster for the given project.
+//	Acknowledges an advisory of the given cluster, storing who acknowledged it and when.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ExternalCluster
+//	  200: ClusterAdvisory
 //	  401: empty
 //	  403: empty
-func (r Routing) updateExternalCluster() http.Handler {
+func (r Routing) acknowledgeClusterAdvisory() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.UpdateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeUpdateReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.AcknowledgeAdvisoryEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeAcknowledgeAdvisoryReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/upgrades project getExternalClusterUpgrades
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/crds project listClusterCRDs
 //
-//	Gets an external cluster upgrades.
+//	Lists the custom resource definitions installed in the given cluster.
 //
+//	With count=true the custom resources of every definition are counted as well. Only a limited number of
+//	definitions is counted within a fixed time, the instances of the others are left out.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []MasterVersion
+//	  200: []ClusterCRD
 //	  401: empty
 //	  403: empty
-func (r Routing) getExternalClusterUpgrades() http.Handler {
+func (r Routing) listClusterCRDs() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.GetUpgradesEndpoint(r.kubermaticConfigGetter, r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeGetReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.ListCRDsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeListCRDsReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments project listExternalClusterMachineDeployments
-//
-//	Gets an external cluster machine deployments.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/cni project getClusterCNI
 //
+//	Gets the CNI plugin settings of the given cluster and the CNI plugin types and versions it can be changed to.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []ExternalClusterMachineDeployment
+//	  200: ClusterCNISettings
 //	  401: empty
 //	  403: empty
-func (r Routing) listExternalClusterMachineDeployments() http.Handler {
+func (r Routing) getClusterCNI() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.ListMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
-		externalcluster.DecodeListMachineDeploymentReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetCNIEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.features)),
+		cluster.DecodeGetClusterReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments project createExternalClusterMachineDeployment
+// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/cni project updateClusterCNI
 //
-//	Create an external cluster machine deployments.
+//	Changes the CNI plugin of the given cluster. The CNI plugin version can be upgraded by one minor version at a
+//	time, changing the CNI plugin type requires the CNIMigration feature gate.
 //
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ExternalClusterMachineDeployment
+//	  200: ClusterCNISettings
+//	  400: errorResponse
 //	  401: empty
 //	  403: empty
-func (r Routing) createExternalClusterMachineDeployment() http.Handler {
+func (r Routing) updateClusterCNI() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.CreateMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
-		externalcluster.DecodeCreateMachineDeploymentReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.UpdateCNIEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.caBundle, r.kubermaticConfigGetter, r.features)),
+		cluster.DecodeUpdateCNIReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project deleteExternalClusterMachineDeployment
-//
-//	Delete an external cluster machine deployment.
+// getClusterEvents returns events related to the cluster.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/events project getClusterEventsV2
 //
+//	Gets the events related to the specified cluster.
 //
 //	Produces:
-//	- application/json
+//	- application/yaml
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: empty
+//	  200: []Event
 //	  401: empty
 //	  403: empty
-func (r Routing) deleteExternalClusterMachineDeployment() http.Handler {
+func (r Routing) getClusterEvents() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.DeleteMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
-		externalcluster.DecodeGetMachineDeploymentReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetClusterEventsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterEvents,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes project listExternalClusterNodes
-//
-//	Gets an external cluster nodes.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/health project getClusterHealthV2
 //
+//	Returns the cluster's component health status
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []ExternalClusterNode
+//	  200: ClusterHealth
 //	  401: empty
 //	  403: empty
-func (r Routing) listExternalClusterNodes() http.Handler {
+func (r Routing) getClusterHealth() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.ListNodesEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeListNodesReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.HealthEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id} project getExternalClusterNode
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/health/etcd project getClusterEtcdHealth
 //
-//	Gets an external cluster node.
+//	Returns the health of the cluster's etcd with the size of its database, its members and active alarms.
 //
+//	The details are queried from etcd through the seed. If etcd can not be reached, the state is unknown and only
+//	the health from the cluster status is returned.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ExternalClusterNode
+//	  200: EtcdHealth
 //	  401: empty
 //	  403: empty
-func (r Routing) getExternalClusterNode() http.Handler {
+func (r Routing) getClusterEtcdHealth() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.GetNodeEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeGetNodeReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.EtcdHealthEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/metrics project getExternalClusterMetrics
-//
-//	Gets cluster metrics
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/permissions project getClusterPermissions
 //
+//	Returns the effective permissions of the current user for the cluster.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ClusterMetrics
+//	  200: ClusterPermissions
 //	  401: empty
 //	  403: empty
-func (r Routing) getExternalClusterMetrics() http.Handler {
+func (r Routing) getClusterPermissions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.GetMetricsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeGetReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetClusterPermissionsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodesmetrics project listExternalClusterNodesMetrics
-//
-//	Gets an external cluster nodes metrics.
+// getClusterSupportBundle returns a support bundle of the cluster.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/supportbundle project getClusterSupportBundle
 //
+//	Gets a gzipped tarball with the cluster object, health, events, machine deployments, machines, nodes and recent
+//	node events of the specified cluster. Credentials and tokens are redacted.
 //
 //	Produces:
-//	- application/json
+//	- application/gzip
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []NodeMetric
+//	  200: SupportBundle
 //	  401: empty
 //	  403: empty
-func (r Routing) listExternalClusterNodesMetrics() http.Handler {
+func (r Routing) getClusterSupportBundle() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.ListNodesMetricsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeListNodesReq,
-		handler.EncodeJSON,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.SupportBundleEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		cluster.EncodeSupportBundle,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/events project listExternalClusterEvents
-//
-//	Gets an external cluster events.
+// getClusterKubeconfig returns the kubeconfig for the cluster.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/kubeconfig project getClusterKubeconfigV2
 //
+//	Gets the kubeconfig for the specified cluster.
 //
 //	Produces:
-//	- application/json
+//	- application/octet-stream
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []Event
+//	  200: Kubeconfig
 //	  401: empty
 //	  403: empty
-func (r Routing) listExternalClusterEvents() http.Handler {
+func (r Routing) getClusterKubeconfig() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetAdminKubeconfigEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.settingsProvider)),
+		cluster.DecodeGetClusterReq,
+		cluster.EncodeKubeconfig,
+		r.defaultServerOptions()...,
+	)
+}
+
+// getServiceAccountClusterKubeconfig returns the kubeconfig for the cluster authenticating with the service account token of the request.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/serviceaccountkubeconfig project getServiceAccountClusterKubeconfigV2
+//
+//	Gets the kubeconfig for the specified cluster which authenticates with the project service account token sent in the Authorization header.
+//
+//	Produces:
+//	- application/octet-stream
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: Kubeconfig
+//	  401: empty
+//	  403: empty
+func (r Routing) getServiceAccountClusterKubeconfig() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetServiceAccountKubeconfigEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.saTokenAuthenticator)),
+		cluster.DecodeGetClusterReq,
+		cluster.EncodeKubeconfig,
+		r.defaultServerOptions()...,
+	)
+}
+
+// getOidcClusterKubeconfig returns the oidc kubeconfig for the cluster.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/oidckubeconfig project getOidcClusterKubeconfigV2
+//
+//	Gets the kubeconfig for the specified cluster with oidc authentication.
+//
+//	Produces:
+//	- application/octet-stream
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: Kubeconfig
+//	  401: empty
+//	  403: empty
+func (r Routing) getOidcClusterKubeconfig() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetOidcKubeconfigEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		cluster.EncodeKubeconfig,
+		r.defaultServerOptions()...,
+	)
+}
+
+// getClusterOidc returns the OIDC spec for the user cluster.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/oidc project getClusterOidc
+//
+//	Gets the OIDC params for the specified cluster with OIDC authentication.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: OIDCSpec
+//	  401: empty
+//	  403: empty
+func (r Routing) getClusterOidc() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetClusterOidcEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/metrics project getClusterMetricsV2
+//
+//	Gets cluster metrics
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: ClusterMetrics
+//	   401: empty
+//	   403: empty
+func (r Routing) getClusterMetrics() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetMetricsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/namespaces project listNamespaceV2
+//
+//	Lists all namespaces in the cluster
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []Namespace
+//	  401: empty
+//	  403: empty
+func (r Routing) listNamespace() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.ListNamespaceEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/upgrades project getClusterUpgradesV2
+//
+//	Gets possible cluster upgrades
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: []MasterVersion
+//	   401: empty
+//	   403: empty
+func (r Routing) getClusterUpgrades() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetUpgradesEndpoint(r.kubermaticConfigGetter, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/upgrades/plan project getClusterUpgradePlan
+//
+//	Gets possible cluster upgrades together with the machine deployments which have to be upgraded first
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: []ClusterUpgradePlan
+//	   401: empty
+//	   403: empty
+func (r Routing) getClusterUpgradePlan() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetUpgradePlanEndpoint(r.kubermaticConfigGetter, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/upgrade-preflight project getClusterUpgradePreflight
+//
+//	Runs the checks which tell if the control plane of the cluster can be upgraded to the given version.
+//
+//	Every check reports pass, warn or fail with details. The checks run in parallel with a timeout each and never
+//	change the cluster.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: UpgradePreflightReport
+//	  401: empty
+//	  403: empty
+func (r Routing) getClusterUpgradePreflight() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.UpgradePreflightEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeUpgradePreflightReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes/upgrades project upgradeClusterNodeDeploymentsV2
+//
+//	Upgrades node deployments in a cluster
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: empty
+//	   401: empty
+//	   403: empty
+func (r Routing) upgradeClusterNodeDeployments() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.UpgradeNodeDeploymentsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeUpgradeNodeDeploymentsReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/sshkeys/{key_id} project assignSSHKeyToClusterV2
+//
+//	Assigns an existing ssh key to the given cluster
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: SSHKey
+//	  401: empty
+//	  403: empty
+func (r Routing) assignSSHKeyToCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.AssignSSHKeyEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeAssignSSHKeyReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/projects/{project_id}/clusters/{cluster_id}/sshkeys/{key_id} project detachSSHKeyFromClusterV2
+//
+//	Unassignes an ssh key from the given cluster
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) detachSSHKeyFromCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.DetachSSHKeyEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeAssignSSHKeyReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/sshkeys project listSSHKeysAssignedToClusterV2
+//
+//	Lists ssh keys that are assigned to the cluster
+//	The returned collection is sorted by creation timestamp.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []SSHKey
+//	  401: empty
+//	  403: empty
+func (r Routing) listSSHKeysAssignedToCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.ListSSHKeysEndpoint(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeListSSHKeysReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/sshkeys project setSSHKeysAssignedToClusterV2
+//
+//	Replaces the ssh keys assigned to the cluster with the given ones.
+//	Keys which are not in the list are detached from the cluster.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []SSHKey
+//	  401: empty
+//	  403: empty
+func (r Routing) setSSHKeysAssignedToCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.SetSSHKeysEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeSetSSHKeysReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/kubernetes/clusters project createExternalCluster
+//
+//	Creates an external cluster for the given project.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: ExternalCluster
+//	  401: empty
+//	  403: empty
+func (r Routing) createExternalCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider, r.presetProvider)),
+		externalcluster.DecodeCreateReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/kubernetes/clusters/validate project validateExternalCluster
+//
+//	Checks if an external cluster can be connected with the given kubeconfig, without creating it.
+//	The cluster is checked for connectivity and for the permissions to list nodes and deployments.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ExternalClusterCheckReport
+//	  401: empty
+//	  403: empty
+func (r Routing) validateExternalCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.ValidateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeCreateReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// Delete the external cluster
+// swagger:route DELETE /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project deleteExternalCluster
+//
+//	Deletes the specified external cluster
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) deleteExternalCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeDeleteReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters project listExternalClusters
+//
+//	Lists external clusters for the specified project.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []ExternalCluster
+//	  401: empty
+//	  403: empty
+func (r Routing) listExternalClusters() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeListReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project getExternalCluster
+//
+//	Gets an external cluster for the given project.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ExternalCluster
+//	  401: empty
+//	  403: empty
+func (r Routing) getExternalCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeGetReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PATCH /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project patchExternalCluster
+//
+//	Patches the given cluster using JSON Merge Patch method (https://tools.ietf.org/html/rfc7396).
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ExternalCluster
+//	  401: empty
+//	  403: empty
+func (r Routing) patchExternalCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodePatchReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project updateExternalCluster
+//
+//	Updates an external cluster for the given project.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ExternalCluster
+//	  401: empty
+//	  403: empty
+func (r Routing) updateExternalCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.UpdateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeUpdateReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/upgrades project getExternalClusterUpgrades
+//
+//	Gets an external cluster upgrades.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []MasterVersion
+//	  401: empty
+//	  403: empty
+func (r Routing) getExternalClusterUpgrades() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.GetUpgradesEndpoint(r.kubermaticConfigGetter, r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeGetReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments project listExternalClusterMachineDeployments
+//
+//	Gets an external cluster machine deployments.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []ExternalClusterMachineDeployment
+//	  401: empty
+//	  403: empty
+func (r Routing) listExternalClusterMachineDeployments() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.ListMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
+		externalcluster.DecodeListMachineDeploymentReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments project createExternalClusterMachineDeployment
+//
+//	Create an external cluster machine deployments.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ExternalClusterMachineDeployment
+//	  401: empty
+//	  403: empty
+func (r Routing) createExternalClusterMachineDeployment() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.CreateMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
+		externalcluster.DecodeCreateMachineDeploymentReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project deleteExternalClusterMachineDeployment
+//
+//	Delete an external cluster machine deployment.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) deleteExternalClusterMachineDeployment() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.DeleteMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
+		externalcluster.DecodeGetMachineDeploymentReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes project listExternalClusterNodes
+//
+//	Gets an external cluster nodes.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []ExternalClusterNode
+//	  401: empty
+//	  403: empty
+func (r Routing) listExternalClusterNodes() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.ListNodesEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeListNodesReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id} project getExternalClusterNode
+//
+//	Gets an external cluster node.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ExternalClusterNode
+//	  401: empty
+//	  403: empty
+func (r Routing) getExternalClusterNode() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.GetNodeEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeGetNodeReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/metrics project getExternalClusterMetrics
+//
+//	Gets cluster metrics
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ClusterMetrics
+//	  401: empty
+//	  403: empty
+func (r Routing) getExternalClusterMetrics() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.GetMetricsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeGetReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodesmetrics project listExternalClusterNodesMetrics
+//
+//	Gets an external cluster nodes metrics.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []NodeMetric
+//	  401: empty
+//	  403: empty
+func (r Routing) listExternalClusterNodesMetrics() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.ListNodesMetricsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeListNodesReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/events project listExternalClusterEvents
+//
+//	Gets an external cluster events.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []Event
+//	  401: empty
+//	  403: empty
+func (r Routing) listExternalClusterEvents() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
@@ -2797,9 +3862,403 @@ func (r Routing) listExternalClusterEvents() http.Handler {
 	)
 }
 
-// swagger:route GET /api/v2/constrainttemplates constrainttemplates listConstraintTemplates
+// swagger:route GET /api/v2/constrainttemplates constrainttemplates listConstraintTemplates
+//
+//	List constraint templates.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []ConstraintTemplate
+//	  401: empty
+//	  403: empty
+func (r Routing) listConstraintTemplates() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(constrainttemplate.ListEndpoint(r.constraintTemplateProvider)),
+		common.DecodeEmptyReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/constrainttemplates/{ct_name} constrainttemplates getConstraintTemplate
+//
+//	Get constraint templates specified by name
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ConstraintTemplate
+//	  401: empty
+//	  403: empty
+func (r Routing) getConstraintTemplate() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(constrainttemplate.GetEndpoint(r.constraintTemplateProvider)),
+		constrainttemplate.DecodeConstraintTemplateRequest,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/constrainttemplates constrainttemplates createConstraintTemplate
+//
+//	Create constraint template
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ConstraintTemplate
+//	  401: empty
+//	  403: empty
+func (r Routing) createConstraintTemplate() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(constrainttemplate.CreateEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
+		constrainttemplate.DecodeCreateConstraintTemplateRequest,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PATCH /api/v2/constrainttemplates/{ct_name} constrainttemplates patchConstraintTemplate
+//
+//	Patch a specified constraint template
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ConstraintTemplate
+//	  401: empty
+//	  403: empty
+func (r Routing) patchConstraintTemplate() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(constrainttemplate.PatchEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
+		constrainttemplate.DecodePatchConstraintTemplateReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v2/constrainttemplates/{ct_name} constrainttemplates deleteConstraintTemplate
+//
+//	Deletes the specified cluster
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) deleteConstraintTemplate() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(constrainttemplate.DeleteEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
+		constrainttemplate.DecodeConstraintTemplateRequest,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints project listConstraints
+//
+//	Lists constraints for the specified cluster.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []Constraint
+//	  401: empty
+//	  403: empty
+func (r Routing) listConstraints() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+		)(constraint.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		constraint.DecodeListConstraintsReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/violations project listClusterViolations
 //
-//	List constraint templates.
+//	Lists the violations of the constraints of the specified cluster, grouped by constraint kind.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ClusterViolations
+//	  401: empty
+//	  403: empty
+func (r Routing) listClusterViolations() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+		)(constraint.ListViolationsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		constraint.DecodeListViolationsReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name} project getConstraint
+//
+//	Gets an specified constraint for the given cluster.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: Constraint
+//	  401: empty
+//	  403: empty
+func (r Routing) getConstraint() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+		)(constraint.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		constraint.DecodeConstraintReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name} project deleteConstraint
+//
+//	Deletes a specified constraint for the given cluster.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) deleteConstraint() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+			middleware.PrivilegedConstraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+		)(constraint.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		constraint.DecodeConstraintReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints project createConstraint
+//
+//	Creates a given constraint for the specified cluster.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: Constraint
+//	  401: empty
+//	  403: empty
+func (r Routing) createConstraint() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+			middleware.PrivilegedConstraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+		)(constraint.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.constraintTemplateProvider)),
+		constraint.DecodeCreateConstraintReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/constraints constraint createDefaultConstraint
+//
+//	Creates default constraint
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: Constraint
+//	  401: empty
+//	  403: empty
+func (r Routing) createDefaultConstraint() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(constraint.CreateDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider, r.constraintTemplateProvider)),
+		constraint.DecodeCreateDefaultConstraintReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/constraints constraint listDefaultConstraint
+//
+//	List default constraint.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []Constraint
+//	  401: empty
+//	  403: empty
+func (r Routing) listDefaultConstraint() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(constraint.ListDefaultEndpoint(r.defaultConstraintProvider)),
+		common.DecodeEmptyReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/constraints/{constraint_name} constraint getDefaultConstraint
+//
+//	Gets an specified default constraint
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: Constraint
+//	  401: empty
+//	  403: empty
+func (r Routing) getDefaultConstraint() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(constraint.GetDefaultEndpoint(r.defaultConstraintProvider)),
+		constraint.DecodeDefaultConstraintReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v2/constraints/{constraint_name} constraints deleteDefaultConstraint
+//
+//	Deletes a specified default constraint.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) deleteDefaultConstraint() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(constraint.DeleteDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider)),
+		constraint.DecodeDefaultConstraintReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PATCH /api/v2/constraints/{constraint_name} constraint patchDefaultConstraint
+//
+//	Patch a specified default constraint
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: Constraint
+//	  401: empty
+//	  403: empty
+func (r Routing) patchDefaultConstraint() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(constraint.PatchDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider, r.constraintTemplateProvider)),
+		constraint.DecodePatchDefaultConstraintReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name} project patchConstraint
+//
+//	Patches a given constraint for the specified cluster.
 //
 //
 //	Produces:
@@ -2807,24 +4266,28 @@ func (r Routing) listExternalClusterEvents() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []ConstraintTemplate
+//	  200: Constraint
 //	  401: empty
 //	  403: empty
-func (r Routing) listConstraintTemplates() http.Handler {
+func (r Routing) patchConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constrainttemplate.ListEndpoint(r.constraintTemplateProvider)),
-		common.DecodeEmptyReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+			middleware.PrivilegedConstraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+		)(constraint.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.constraintTemplateProvider)),
+		constraint.DecodePatchConstraintReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/constrainttemplates/{ct_name} constrainttemplates getConstraintTemplate
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project getGatekeeperConfig
 //
-//	Get constraint templates specified by name
+//	Gets the gatekeeper sync config for the specified cluster.
 //
 //
 //	Produces:
@@ -2832,51 +4295,53 @@ func (r Routing) listConstraintTemplates() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ConstraintTemplate
+//	  200: GatekeeperConfig
 //	  401: empty
 //	  403: empty
-func (r Routing) getConstraintTemplate() http.Handler {
+func (r Routing) getGatekeeperConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constrainttemplate.GetEndpoint(r.constraintTemplateProvider)),
-		constrainttemplate.DecodeConstraintTemplateRequest,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(gatekeeperconfig.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		gatekeeperconfig.DecodeGatkeeperConfigReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/constrainttemplates constrainttemplates createConstraintTemplate
+// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project deleteGatekeeperConfig
 //
-//	Create constraint template
+//	Deletes the gatekeeper sync config for the specified cluster.
 //
-//	Consumes:
-//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ConstraintTemplate
+//	  200: empty
 //	  401: empty
 //	  403: empty
-func (r Routing) createConstraintTemplate() http.Handler {
+func (r Routing) deleteGatekeeperConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constrainttemplate.CreateEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
-		constrainttemplate.DecodeCreateConstraintTemplateRequest,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(gatekeeperconfig.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		gatekeeperconfig.DecodeGatkeeperConfigReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PATCH /api/v2/constrainttemplates/{ct_name} constrainttemplates patchConstraintTemplate
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project createGatekeeperConfig
 //
-//	Patch a specified constraint template
+//	Creates a gatekeeper config for the given cluster
 //
 //	Consumes:
 //	- application/json
@@ -2886,508 +4351,638 @@ func (r Routing) createConstraintTemplate() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ConstraintTemplate
+//	  201: GatekeeperConfig
 //	  401: empty
 //	  403: empty
-func (r Routing) patchConstraintTemplate() http.Handler {
+func (r Routing) createGatekeeperConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constrainttemplate.PatchEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
-		constrainttemplate.DecodePatchConstraintTemplateReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(gatekeeperconfig.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		gatekeeperconfig.DecodeCreateGatkeeperConfigReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v2/constrainttemplates/{ct_name} constrainttemplates deleteConstraintTemplate
+// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project patchGatekeeperConfig
+//
+//	Patches the gatekeeper config for the specified cluster.
 //
-//	Deletes the specified cluster
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: empty
+//	  200: GatekeeperConfig
 //	  401: empty
 //	  403: empty
-func (r Routing) deleteConstraintTemplate() http.Handler {
+func (r Routing) patchGatekeeperConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constrainttemplate.DeleteEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
-		constrainttemplate.DecodeConstraintTemplateRequest,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(gatekeeperconfig.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		gatekeeperconfig.DecodePatchGatekeeperConfigReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints project listConstraints
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments project createMachineDeployment
 //
-//	Lists constraints for the specified cluster.
+//	Creates a machine deployment that will belong to the given cluster
+//
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []Constraint
+//	  201: NodeDeployment
 //	  401: empty
 //	  403: empty
-func (r Routing) listConstraints() http.Handler {
+//	  409: errorResponse
+func (r Routing) createMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-		)(constraint.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		constraint.DecodeListConstraintsReq,
-		handler.EncodeJSON,
+		)(machine.CreateMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.clusterProviderGetter, r.caBundle)),
+		machine.DecodeCreateMachineDeployment,
+		handler.SetWarningHeaders(handler.SetStatusCreatedHeader(handler.EncodeJSON)),
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name} project getConstraint
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/validate project validateMachineDeployment
 //
-//	Gets an specified constraint for the given cluster.
+//	Validates a machine deployment for the given cluster and returns it with the defaults applied. Nothing is created.
 //
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: Constraint
+//	  200: NodeDeployment
 //	  401: empty
 //	  403: empty
-func (r Routing) getConstraint() http.Handler {
+//	  409: errorResponse
+func (r Routing) validateMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-		)(constraint.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		constraint.DecodeConstraintReq,
+		)(machine.ValidateMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.caBundle)),
+		machine.DecodeCreateMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name} project deleteConstraint
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/estimate project estimateMachineDeploymentCost
 //
-//	Deletes a specified constraint for the given cluster.
+//	Estimates the monthly cost of a machine deployment for the given cluster. The size is resolved to a price with
+//	the price catalog, if the price is unknown the estimate contains a warning instead of the costs. Nothing is created.
 //
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: empty
+//	  200: MachineDeploymentCostEstimate
 //	  401: empty
 //	  403: empty
-func (r Routing) deleteConstraint() http.Handler {
+func (r Routing) estimateMachineDeploymentCost() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-			middleware.PrivilegedConstraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-		)(constraint.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		constraint.DecodeConstraintReq,
+		)(machine.EstimateMachineDeploymentCost(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.priceCatalog)),
+		machine.DecodeEstimateMachineDeploymentCost,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints project createConstraint
-//
-//	Creates a given constraint for the specified cluster.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/export project exportMachineDeployments
 //
+//	Exports the machine deployments of the cluster as multi-document YAML file, which can be imported again.
 //
 //	Produces:
-//	- application/json
+//	- application/yaml
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: Constraint
+//	  200: []MachineDeploymentManifest
 //	  401: empty
 //	  403: empty
-func (r Routing) createConstraint() http.Handler {
+func (r Routing) exportMachineDeployments() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-			middleware.PrivilegedConstraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-		)(constraint.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.constraintTemplateProvider)),
-		constraint.DecodeCreateConstraintReq,
-		handler.EncodeJSON,
+		)(machine.ExportMachineDeployments(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeExportMachineDeployments,
+		machine.EncodeMachineDeploymentsExport,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/constraints constraint createDefaultConstraint
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/import project importMachineDeployments
 //
-//	Creates default constraint
+//	Creates the machine deployments of an export in the given cluster. Existing machine deployments are skipped,
+//	unless overwrite is set. Every machine deployment is imported on its own, the result of each is returned.
+//
+//	Consumes:
+//	- application/yaml
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: Constraint
+//	  200: MachineDeploymentImportResultList
 //	  401: empty
 //	  403: empty
-func (r Routing) createDefaultConstraint() http.Handler {
+func (r Routing) importMachineDeployments() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constraint.CreateDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider, r.constraintTemplateProvider)),
-		constraint.DecodeCreateDefaultConstraintReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.ImportMachineDeployments(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.clusterProviderGetter, r.caBundle)),
+		machine.DecodeImportMachineDeployments,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/constraints constraint listDefaultConstraint
+// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id} project deleteMachineDeploymentNode
 //
-//	List default constraint.
+//	Deletes the given node that belongs to the machine deployment.
 //
 //
-//	Produces:
-//	- application/json
+//	 Produces:
+//	 - application/json
 //
-//	Responses:
-//	  default: errorResponse
-//	  200: []Constraint
-//	  401: empty
-//	  403: empty
-func (r Routing) listDefaultConstraint() http.Handler {
+//	 Responses:
+//	   default: errorResponse
+//	   200: empty
+//	   401: empty
+//	   403: empty
+func (r Routing) deleteMachineDeploymentNode() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constraint.ListDefaultEndpoint(r.defaultConstraintProvider)),
-		common.DecodeEmptyReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.DeleteMachineDeploymentNode(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeDeleteMachineDeploymentNode,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/constraints/{constraint_name} constraint getDefaultConstraint
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes/{node_id}/cordon project cordonNode
 //
-//	Gets an specified default constraint
+//	Marks the given node as unschedulable.
 //
+//	 Produces:
+//	 - application/json
 //
-//	Produces:
-//	- application/json
+//	 Responses:
+//	   default: errorResponse
+//	   200: Node
+//	   401: empty
+//	   403: empty
+func (r Routing) cordonNode() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.CordonNode(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, true)),
+		machine.DecodeDeleteMachineDeploymentNode,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes/{node_id}/uncordon project uncordonNode
 //
-//	Responses:
-//	  default: errorResponse
-//	  200: Constraint
-//	  401: empty
-//	  403: empty
-func (r Routing) getDefaultConstraint() http.Handler {
+//	Marks the given node as schedulable.
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: Node
+//	   401: empty
+//	   403: empty
+func (r Routing) uncordonNode() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.CordonNode(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, false)),
+		machine.DecodeDeleteMachineDeploymentNode,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes/{node_id}/labels project patchNodeLabels
+//
+//	Sets or removes labels of the given node. Labels with the kubernetes.io/ and node.kubernetes.io/ prefixes can not be changed.
+//
+//	 Consumes:
+//	 - application/json
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: Node
+//	   401: empty
+//	   403: empty
+func (r Routing) patchNodeLabels() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.PatchNodeLabels(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodePatchNodeLabels,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes/{node_id}/pods project listNodePods
+//
+//	Lists the pods which are scheduled on the given node.
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: NodePodList
+//	   401: empty
+//	   403: empty
+func (r Routing) listNodePods() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.ListNodePods(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeDeleteMachineDeploymentNode,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes/{node_id}/pods/{namespace}/{pod_name}/evict project evictNodePod
+//
+//	Evicts the given pod from the node. The eviction honors pod disruption budgets, 429 is returned if one of them blocks it.
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: empty
+//	   401: empty
+//	   403: empty
+//	   429: errorResponse
+func (r Routing) evictNodePod() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constraint.GetDefaultEndpoint(r.defaultConstraintProvider)),
-		constraint.DecodeDefaultConstraintReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.EvictNodePod(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeEvictNodePod,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v2/constraints/{constraint_name} constraints deleteDefaultConstraint
-//
-//	Deletes a specified default constraint.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments project listMachineDeployments
 //
+//	Lists machine deployments that belong to the given cluster. Set show_node_status=true to include a summary
+//	of the readiness of the nodes of every machine deployment.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: empty
+//	  200: []NodeDeployment
 //	  401: empty
 //	  403: empty
-func (r Routing) deleteDefaultConstraint() http.Handler {
+func (r Routing) listMachineDeployments() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constraint.DeleteDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider)),
-		constraint.DecodeDefaultConstraintReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.ListMachineDeployments(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.seedsGetter)),
+		machine.DecodeListMachineDeployments,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PATCH /api/v2/constraints/{constraint_name} constraint patchDefaultConstraint
-//
-//	Patch a specified default constraint
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project getMachineDeployment
 //
-//	Consumes:
-//	- application/json
+//	Gets a machine deployment that is assigned to the given cluster. Set show_machine_errors=true to include the
+//	errors the machine-controller reported for its machines.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: Constraint
+//	  200: NodeDeployment
 //	  401: empty
 //	  403: empty
-func (r Routing) patchDefaultConstraint() http.Handler {
+func (r Routing) getMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constraint.PatchDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider, r.constraintTemplateProvider)),
-		constraint.DecodePatchDefaultConstraintReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.GetMachineDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.seedsGetter)),
+		machine.DecodeGetMachineDeploymentReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name} project patchConstraint
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/joiningscript project getMachineDeploymentJoinScript
 //
-//	Patches a given constraint for the specified cluster.
+//	Gets a machine deployment joining script for the edge provider.
 //
+//	The format query parameter selects between the base64 encoded shell script (default),
+//	a base64 encoded cloud-init document or the raw token data as JSON.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: Constraint
+//	  200: JoiningScript
 //	  401: empty
 //	  403: empty
-func (r Routing) patchConstraint() http.Handler {
+func (r Routing) getMachineDeploymentJoinScript() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-			middleware.PrivilegedConstraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-		)(constraint.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.constraintTemplateProvider)),
-		constraint.DecodePatchConstraintReq,
+		)(machine.GetMachineDeploymentJoiningScript(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeGetMachineDeploymentJoiningScript,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project getGatekeeperConfig
-//
-//	Gets the gatekeeper sync config for the specified cluster.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes project listMachineDeploymentNodes
 //
+//	Lists nodes that belong to the given machine deployment.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: GatekeeperConfig
+//	  200: []Node
 //	  401: empty
 //	  403: empty
-func (r Routing) getGatekeeperConfig() http.Handler {
+func (r Routing) listMachineDeploymentNodes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(gatekeeperconfig.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		gatekeeperconfig.DecodeGatkeeperConfigReq,
+		)(machine.ListMachineDeploymentNodes(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeListMachineDeploymentNodes,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project deleteGatekeeperConfig
-//
-//	Deletes the gatekeeper sync config for the specified cluster.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes/{node_id}/consolelog project getMachineConsoleLog
 //
+//	Gets the tail of the console log of the node's instance from the cloud provider.
 //
 //	Produces:
-//	- application/json
+//	- text/plain
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: empty
+//	  200: ConsoleLog
 //	  401: empty
 //	  403: empty
-func (r Routing) deleteGatekeeperConfig() http.Handler {
+//	  501: empty
+func (r Routing) getMachineConsoleLog() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(gatekeeperconfig.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		gatekeeperconfig.DecodeGatkeeperConfigReq,
-		handler.EncodeJSON,
+		)(machine.GetMachineConsoleLog(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle)),
+		machine.DecodeGetMachineConsoleLog,
+		machine.EncodeConsoleLog,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project createGatekeeperConfig
-//
-//	Creates a gatekeeper config for the given cluster
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes project listNodesForCluster
 //
-//	Consumes:
-//	- application/json
+//	This endpoint is used for kubeadm cluster.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  201: GatekeeperConfig
+//	  200: []Node
 //	  401: empty
 //	  403: empty
-func (r Routing) createGatekeeperConfig() http.Handler {
+func (r Routing) listNodesForCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(gatekeeperconfig.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		gatekeeperconfig.DecodeCreateGatkeeperConfigReq,
+		)(machine.ListNodesForCluster(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeListNodesForCluster,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project patchGatekeeperConfig
-//
-//	Patches the gatekeeper config for the specified cluster.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes/export project exportNodesForCluster
 //
+//	Exports all nodes of the cluster with their sizes, versions and resource usage as CSV or JSON file.
 //
 //	Produces:
+//	- text/csv
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: GatekeeperConfig
+//	  200: []NodeExportRow
 //	  401: empty
 //	  403: empty
-func (r Routing) patchGatekeeperConfig() http.Handler {
+func (r Routing) exportNodesForCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(gatekeeperconfig.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		gatekeeperconfig.DecodePatchGatekeeperConfigReq,
-		handler.EncodeJSON,
+		)(machine.ExportNodesForCluster(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeExportNodesForCluster,
+		machine.EncodeNodesExport,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments project createMachineDeployment
-//
-//	Creates a machine deployment that will belong to the given cluster
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes/metrics metric listMachineDeploymentMetrics
 //
-//	Consumes:
-//	- application/json
+//	Lists metrics that belong to the given machine deployment.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  201: NodeDeployment
+//	  200: []NodeMetric
 //	  401: empty
 //	  403: empty
-func (r Routing) createMachineDeployment() http.Handler {
+func (r Routing) listMachineDeploymentMetrics() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.CreateMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider)),
-		machine.DecodeCreateMachineDeployment,
-		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		)(machine.ListMachineDeploymentMetrics(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeListMachineDeploymentMetrics,
+		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id} project deleteMachineDeploymentNode
+// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project patchMachineDeployment
 //
-//	Deletes the given node that belongs to the machine deployment.
+//	    Patches a machine deployment that is assigned to the given cluster. Please note that at the moment only
+//		   node deployment's spec can be updated by a patch, no other fields can be changed using this endpoint.
+//		   The X-Rollout-Triggered header tells whether the machine template changed, which rolls out new machines.
 //
+//	    Consumes:
+//	    - application/json
 //
-//	 Produces:
-//	 - application/json
+//	    Produces:
+//	    - application/json
 //
-//	 Responses:
-//	   default: errorResponse
-//	   200: empty
-//	   401: empty
-//	   403: empty
-func (r Routing) deleteMachineDeploymentNode() http.Handler {
+//	    Responses:
+//	      default: errorResponse
+//	      200: NodeDeployment
+//	      401: empty
+//	      403: empty
+//	      409: errorResponse
+func (r Routing) patchMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.DeleteMachineDeploymentNode(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		machine.DecodeDeleteMachineDeploymentNode,
-		handler.EncodeJSON,
+		)(machine.PatchMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.clusterProviderGetter, r.caBundle)),
+		machine.DecodePatchMachineDeployment,
+		handler.SetRolloutHeader(handler.SetWarningHeaders(handler.EncodeJSON)),
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments project listMachineDeployments
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project restartMachineDeployment
+//
+//	Schedules rolling restart of a machine deployment that is assigned to the given cluster. Outside of the
+//	maintenance window of the cluster the restart is rejected with 409, unless it is forced.
 //
-//	Lists machine deployments that belong to the given cluster
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []NodeDeployment
+//	  200: NodeDeployment
 //	  401: empty
 //	  403: empty
-func (r Routing) listMachineDeployments() http.Handler {
+//	  409: errorResponse
+func (r Routing) restartMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.ListMachineDeployments(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		machine.DecodeListMachineDeployments,
+		)(machine.RestartMachineDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeRestartMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project getMachineDeployment
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/pause project pauseMachineDeployment
 //
-//	Gets a machine deployment that is assigned to the given cluster.
+//	Pauses a machine deployment that is assigned to the given cluster. Pausing an already paused machine deployment has no effect.
 //
 //	Produces:
 //	- application/json
@@ -3397,157 +4992,106 @@ func (r Routing) listMachineDeployments() http.Handler {
 //	  200: NodeDeployment
 //	  401: empty
 //	  403: empty
-func (r Routing) getMachineDeployment() http.Handler {
+func (r Routing) pauseMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.GetMachineDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(machine.PauseMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.clusterProviderGetter, r.caBundle)),
 		machine.DecodeGetMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/joiningscript project getMachineDeploymentJoinScript
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/resume project resumeMachineDeployment
 //
-//	Gets a machine deployment joining script for the edge provider.
+//	Resumes a paused machine deployment that is assigned to the given cluster. Resuming a machine deployment which is not paused has no effect.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: JoiningScript
+//	  200: NodeDeployment
 //	  401: empty
 //	  403: empty
-func (r Routing) getMachineDeploymentJoinScript() http.Handler {
+func (r Routing) resumeMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.GetMachineDeploymentJoiningScript(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(machine.ResumeMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.clusterProviderGetter, r.caBundle)),
 		machine.DecodeGetMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes project listMachineDeploymentNodes
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/copy-to project copyMachineDeployment
 //
-//	Lists nodes that belong to the given machine deployment.
+//	Creates a copy of the machine deployment in another cluster of the same project. The source machine deployment is left untouched.
 //
-//	Produces:
+//	Consumes:
 //	- application/json
 //
-//	Responses:
-//	  default: errorResponse
-//	  200: []Node
-//	  401: empty
-//	  403: empty
-func (r Routing) listMachineDeploymentNodes() http.Handler {
-	return httptransport.NewServer(
-		endpoint.Chain(
-			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
-			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.ListMachineDeploymentNodes(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		machine.DecodeListMachineDeploymentNodes,
-		handler.EncodeJSON,
-		r.defaultServerOptions()...,
-	)
-}
-
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes project listNodesForCluster
-//
-//	This endpoint is used for kubeadm cluster.
-//
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []Node
+//	  201: NodeDeployment
 //	  401: empty
 //	  403: empty
-func (r Routing) listNodesForCluster() http.Handler {
+//	  409: errorResponse
+func (r Routing) copyMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.ListNodesForCluster(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		machine.DecodeListNodesForCluster,
-		handler.EncodeJSON,
+		)(machine.CopyMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.clusterProviderGetter, r.caBundle)),
+		machine.DecodeCopyMachineDeployment,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes/metrics metric listMachineDeploymentMetrics
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/revisions project listMachineDeploymentRevisions
 //
-//	Lists metrics that belong to the given machine deployment.
+//	Lists the revisions of a machine deployment, i.e. the machine sets it owns, with the changes of their template
+//	compared to the current spec of the machine deployment.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []NodeMetric
+//	  200: MachineDeploymentRevisionList
 //	  401: empty
 //	  403: empty
-func (r Routing) listMachineDeploymentMetrics() http.Handler {
-	return httptransport.NewServer(
-		endpoint.Chain(
-			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
-			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.ListMachineDeploymentMetrics(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		machine.DecodeListMachineDeploymentMetrics,
-		handler.EncodeJSON,
-		r.defaultServerOptions()...,
-	)
-}
-
-// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project patchMachineDeployment
-//
-//	    Patches a machine deployment that is assigned to the given cluster. Please note that at the moment only
-//		   node deployment's spec can be updated by a patch, no other fields can be changed using this endpoint.
-//
-//	    Consumes:
-//	    - application/json
-//
-//	    Produces:
-//	    - application/json
-//
-//	    Responses:
-//	      default: errorResponse
-//	      200: NodeDeployment
-//	      401: empty
-//	      403: empty
-func (r Routing) patchMachineDeployment() http.Handler {
+func (r Routing) listMachineDeploymentRevisions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.PatchMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider)),
-		machine.DecodePatchMachineDeployment,
+		)(machine.ListMachineDeploymentRevisions(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeGetMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project restartMachineDeployment
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/rollback project rollbackMachineDeployment
 //
-//	Schedules rolling restart of a machine deployment that is assigned to the given cluster.
+//	Rolls a machine deployment back to the template of the given revision. The template is validated like any other patch.
 //
 //	Consumes:
 //	- application/json
@@ -3560,15 +5104,15 @@ func (r Routing) patchMachineDeployment() http.Handler {
 //	  200: NodeDeployment
 //	  401: empty
 //	  403: empty
-func (r Routing) restartMachineDeployment() http.Handler {
+func (r Routing) rollbackMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.RestartMachineDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		machine.DecodeGetMachineDeployment,
+		)(machine.RollbackMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.clusterProviderGetter, r.caBundle)),
+		machine.DecodeRollbackMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
@@ -3605,12 +5149,15 @@ func (r Routing) listMachineDeploymentNodesEvents() http.Handler {
 //
 //	Deletes the given machine deployment that belongs to the cluster.
 //
+//	A graceful deletion scales the machine deployment to zero first and deletes it once all machines are removed
+//	or the timeout elapsed. Only a graceful deletion returns a body.
+//
 //	 Produces:
 //	 - application/json
 //
 //	 Responses:
 //	   default: errorResponse
-//	   200: empty
+//	   200: MachineDeploymentDeletion
 //	   401: empty
 //	   403: empty
 func (r Routing) deleteMachineDeployment() http.Handler {
@@ -3949,7 +5496,7 @@ func (r Routing) createAddon() http.Handler {
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
 			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
-		)(addon.CreateAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(addon.CreateAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.addonConfigProvider)),
 		addon.DecodeCreateAddon,
 		handler.SetStatusCreatedHeader(handler.EncodeJSON),
 		r.defaultServerOptions()...,
@@ -3958,7 +5505,8 @@ func (r Routing) createAddon() http.Handler {
 
 // swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/addons addon listAddonsV2
 //
-//	Lists addons that belong to the given cluster
+//	Lists addons that belong to the given cluster. Set show_status=true to include the health of the
+//	Deployments and DaemonSets of every addon in the user cluster.
 //
 //	Produces:
 //	- application/json
@@ -3978,7 +5526,7 @@ func (r Routing) listAddons() http.Handler {
 			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
 			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
 		)(addon.ListAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		addon.DecodeListAddons,
+		addon.DecodeListAddonsWithStatus,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
@@ -3986,7 +5534,8 @@ func (r Routing) listAddons() http.Handler {
 
 // swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id} addon getAddonV2
 //
-//	Gets an addon that is assigned to the given cluster.
+//	Gets an addon that is assigned to the given cluster. Set show_status=true to include the health of the
+//	Deployments and DaemonSets of the addon in the user cluster.
 //
 //	Produces:
 //	- application/json
@@ -4006,7 +5555,7 @@ func (r Routing) getAddon() http.Handler {
 			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
 			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
 		)(addon.GetAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		addon.DecodeGetAddon,
+		addon.DecodeGetAddonWithStatus,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
@@ -4036,7 +5585,7 @@ func (r Routing) patchAddon() http.Handler {
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
 			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
-		)(addon.PatchAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(addon.PatchAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.addonConfigProvider)),
 		addon.DecodePatchAddon,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -4060,12 +5609,36 @@ func (r Routing) deleteAddon() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
-			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
-		)(addon.DeleteAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		addon.DecodeGetAddon,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+		)(addon.DeleteAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		addon.DecodeGetAddon,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/addons/{addon_name}/schema addon getAddonSchema
+//
+//	Gets the JSON schema of the variables of an addon. It is derived from the form controls of the AddonConfig of the addon.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: AddonSchema
+//	  401: empty
+//	  403: empty
+func (r Routing) getAddonSchema() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(addon.GetAddonSchemaEndpoint(r.addonConfigProvider)),
+		addon.DecodeGetAddonSchema,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
@@ -4315,6 +5888,54 @@ func (r Routing) listHetznerSizesNoCredentials() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/providers/hetzner/networks hetzner listHetznerNetworksNoCredentials
+//
+// Lists networks from Hetzner.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: HetznerNetworkList
+func (r Routing) listHetznerNetworksNoCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(provider.HetznerNetworksWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/providers/hetzner/firewalls hetzner listHetznerFirewallsNoCredentials
+//
+// Lists firewalls from Hetzner.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: HetznerFirewallList
+func (r Routing) listHetznerFirewallsNoCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(provider.HetznerFirewallsWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/providers/digitalocean/sizes digitalocean listDigitaloceanSizesNoCredentialsV2
 //
 // Lists sizes from digitalocean
@@ -4333,7 +5954,35 @@ func (r Routing) listDigitaloceanSizesNoCredentials() http.Handler {
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(provider.DigitaloceanSizeWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider)),
-		cluster.DecodeGetClusterReq,
+		provider.DecodeDoSizesNoCredentialsReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/providers/{provider_name}/quota project getProviderQuota
+//
+// Gets the usage and the limits of the cloud provider resources available to the cluster.
+// Only AWS, Azure, GCP and OpenStack are supported.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ProviderQuota
+//	  401: empty
+//	  403: empty
+//	  501: errorResponse
+func (r Routing) getProviderQuota() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(provider.ProviderQuotaEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle)),
+		provider.DecodeProviderQuotaReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
@@ -4606,6 +6255,30 @@ func (r Routing) listAzureAvailabilityZonesNoCredentials() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/providers/azure/proximityplacementgroups azure listAzureProximityPlacementGroupsNoCredentialsV2
+//
+// Lists proximity placement groups in the resource group of the cluster
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: AzureProximityPlacementGroupsList
+func (r Routing) listAzureProximityPlacementGroupsNoCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(provider.AzureProximityPlacementGroupsWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter)),
+		provider.DecodeAzureProximityPlacementGroupsNoCredentialsReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/providers/vsphere/networks vsphere listVSphereNetworksNoCredentialsV2
 //
 // Lists networks from vsphere datacenter
@@ -5038,6 +6711,30 @@ func (r Routing) listKubeVirtSubnetsNoCredentials() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/providers/kubevirt/priorityclasses kubevirt listKubeVirtPriorityClassesNoCredentials
+//
+// List priority classes of the infra cluster which can be used for the VMs of a cluster.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: KubeVirtPriorityClassList
+func (r Routing) listKubeVirtPriorityClassesNoCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(provider.KubeVirtPriorityClassesWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		provider.DecodeKubeVirtGenericNoCredentialReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/providers/nutanix/subnets nutanix listNutanixSubnetsNoCredentials
 //
 // Lists available Nutanix Subnets
@@ -5903,6 +7600,30 @@ func (r Routing) listVersionsByProvider() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/providers/{provider_name}/ssh-usernames provider listSSHUserNames
+//
+//	Lists the SSH login users of the operating systems for the given provider.
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: SSHUserNames
+//	   401: empty
+//	   403: empty
+func (r Routing) listSSHUserNames() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(provider.SSHUserNamesEndpoint()),
+		provider.DecodeSSHUserNamesReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/alertmanager/config project getAlertmanager
 //
 //	Gets the alertmanager configuration for the specified cluster.
@@ -7258,6 +8979,72 @@ func (r Routing) listProjectAnexiaTemplates() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/providers/anexia/templates anexia listAnexiaTemplates
+//
+// Lists templates from Anexia.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: AnexiaTemplateList
+func (r Routing) listAnexiaTemplates() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(provider.AnexiaTemplatesEndpoint(r.presetProvider, r.userInfoGetter)),
+		provider.DecodeAnexiaTemplateReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/providers/hetzner/networks hetzner listHetznerNetworks
+//
+// Lists networks from Hetzner.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: HetznerNetworkList
+func (r Routing) listHetznerNetworks() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(provider.HetznerNetworksEndpoint(r.presetProvider, r.userInfoGetter)),
+		provider.DecodeHetznerReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/providers/hetzner/firewalls hetzner listHetznerFirewalls
+//
+// Lists firewalls from Hetzner.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: HetznerFirewallList
+func (r Routing) listHetznerFirewalls() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(provider.HetznerFirewallsEndpoint(r.presetProvider, r.userInfoGetter)),
+		provider.DecodeHetznerReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/projects/{project_id}/providers/anexia/disk-types anexia listProjectAnexiaDiskTypes
 //
 // Lists disk-types from Anexia.
@@ -7719,6 +9506,54 @@ func (r Routing) createClusterTemplateInstance() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/projects/{project_id}/clustertemplates/{template_id}/instances/{instance_id}/status project getClusterTemplateInstanceStatus
+//
+//	Get the creation status of the clusters of a cluster template instance.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ClusterTemplateInstanceStatus
+//	  401: empty
+//	  403: empty
+func (r Routing) getClusterTemplateInstanceStatus() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(clustertemplate.GetInstanceStatusEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider, r.seedsGetter, r.clusterTemplateInstanceProviderGetter, r.clusterProviderGetter)),
+		clustertemplate.DecodeInstanceReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clustertemplates/{template_id}/instances/{instance_id}/retry project retryClusterTemplateInstance
+//
+//	Retry the creation of the failed clusters of a cluster template instance. The failed replicas are moved to a new instance which is returned.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: ClusterTemplateInstance
+//	  401: empty
+//	  403: empty
+func (r Routing) retryClusterTemplateInstance() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(clustertemplate.RetryInstanceEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider, r.seedsGetter, r.clusterTemplateInstanceProviderGetter, r.clusterProviderGetter)),
+		clustertemplate.DecodeInstanceReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/rulegroups/{rulegroup_id} rulegroup getRuleGroup
 //
 //	Gets a specified rule group for the given cluster.
@@ -8486,6 +10321,67 @@ func (r Routing) createEtcdBackupConfig() http.Handler {
 	)
 }
 
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/etcdbackups/trigger etcdbackupconfig triggerEtcdBackup
+//
+//	Takes an etcd backup of the given cluster right away by creating a one-shot etcd backup config. The returned ID
+//	can be used to poll the status of the backup. Failed manual backups and completed ones beyond the newest 20 of
+//	the cluster are removed when a new backup is triggered.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: EtcdBackupStatus
+//	  401: empty
+//	  403: empty
+func (r Routing) triggerEtcdBackup() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.EtcdBackupConfig(r.clusterProviderGetter, r.etcdBackupConfigProviderGetter, r.seedsGetter),
+			middleware.PrivilegedEtcdBackupConfig(r.clusterProviderGetter, r.etcdBackupConfigProviderGetter, r.seedsGetter),
+		)(etcdbackupconfig.TriggerEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
+		etcdbackupconfig.DecodeTriggerEtcdBackupReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/etcdbackups/{ebc_id}/status etcdbackupconfig getEtcdBackupStatus
+//
+//	Gets the phase, start and completion time of the latest backup of the given etcd backup config.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: EtcdBackupStatus
+//	  401: empty
+//	  403: empty
+func (r Routing) getEtcdBackupStatus() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.EtcdBackupConfig(r.clusterProviderGetter, r.etcdBackupConfigProviderGetter, r.seedsGetter),
+			middleware.PrivilegedEtcdBackupConfig(r.clusterProviderGetter, r.etcdBackupConfigProviderGetter, r.seedsGetter),
+		)(etcdbackupconfig.GetStatusEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
+		etcdbackupconfig.DecodeGetEtcdBackupStatusReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/etcdbackupconfigs/{ebc_id} etcdbackupconfig getEtcdBackupConfig
 //
 //	Gets a etcd backup config for a given cluster based on its id
@@ -8907,7 +10803,8 @@ func (r Routing) deleteMLAAdminSetting() http.Handler {
 
 // swagger:route GET /api/v2/users user listUser
 //
-//	List users
+//	List users. The users can be filtered, sorted and paginated. Without any parameter all users are returned in the
+//	order of the listing. Only available for admins.
 //
 //	Produces:
 //	- application/json
@@ -8923,8 +10820,8 @@ func (r Routing) listUser() http.Handler {
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 		)(user.ListEndpoint(r.userInfoGetter, r.userProvider)),
-		common.DecodeEmptyReq,
-		handler.EncodeJSON,
+		user.DecodeListUsersReq,
+		user.EncodeListUsersResp,
 		r.defaultServerOptions()...,
 	)
 }
@@ -8953,6 +10850,31 @@ func (r Routing) getFeatureGates() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/search search search
+//
+//	Searches the projects, clusters and machine deployments the user has access to. At most 20 results are
+//	returned per category, exact matches of the name or ID first.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: SearchResult
+//	  401: empty
+//	  403: empty
+func (r Routing) search() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(search.SearchEndpoint(r.projectProvider, r.userProjectMapper, r.seedsGetter, r.clusterProviderGetter, r.userInfoGetter)),
+		search.DecodeSearchReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/projects/{project_id}/providers/gke/clusters project listGKEClusters
 //
 // Lists GKE clusters.
@@ -9933,7 +11855,7 @@ func (r Routing) listExternalClusterMachineDeploymentMetrics() http.Handler {
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListMachineDeploymentMetricsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
 		externalcluster.DecodeGetMachineDeploymentReq,
-		handler.EncodeJSON,
+		externalcluster.EncodeNodeMetrics,
 		r.defaultServerOptions()...,
 	)
 }
@@ -10239,6 +12161,80 @@ func (r Routing) getDefaultCluster() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/providers/{provider_name}/dc/{dc}/health datacenter getDatacenterHealth
+//
+//	Retrieves the seed connectivity of the datacenter, the number of clusters it hosts and its capacity hints.
+//	Error details are only returned to admins.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: DatacenterHealth
+//	  401: empty
+//	  403: empty
+func (r Routing) getDatacenterHealth() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(datacenterhealth.GetEndpoint(r.userInfoGetter, r.seedsGetter, r.clusterProviderGetter, r.seedCircuitBreaker)),
+		datacenterhealth.DecodeDatacenterHealthReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/dc/health datacenter listDatacenterHealth
+//
+//	Retrieves the health of all datacenters which are visible to the user. Every seed is checked once.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []DatacenterHealth
+//	  401: empty
+//	  403: empty
+func (r Routing) listDatacenterHealth() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(datacenterhealth.ListEndpoint(r.userInfoGetter, r.seedsGetter, r.clusterProviderGetter, r.seedCircuitBreaker)),
+		common.DecodeEmptyReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/dc/{dc}/default-node-spec datacenter getDefaultNodeSpec
+//
+//	Retrieves the node spec which is suggested for the machine deployments of the datacenter. The node spec
+//	defaults of the datacenter take precedence over the built-in defaults of its provider.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: NodeSpec
+//	  401: empty
+//	  403: empty
+func (r Routing) getDefaultNodeSpec() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(defaultnodespec.GetEndpoint(r.userInfoGetter, r.seedsGetter)),
+		defaultnodespec.DecodeDefaultNodeSpecReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/kubeconfig/secret createOIDCKubeconfigSecret
 //
 //	Starts OIDC flow and generates kubeconfig, the generated config
@@ -10583,6 +12579,157 @@ func (r Routing) patchGroupProjectBinding() http.Handler {
 	)
 }
 
+// swagger:route get /api/v2/projects/{project_id}/webhooks project listProjectWebhooks
+//
+//	Lists webhooks of the given project.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ProjectWebhookList
+//	  401: empty
+//	  403: empty
+func (r Routing) listProjectWebhooks() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(projectwebhook.ListEndpoint(
+			r.userInfoGetter,
+			r.projectProvider,
+			r.privilegedProjectProvider,
+			r.privilegedWebhookProvider,
+		)),
+		projectwebhook.DecodeListReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route post /api/v2/projects/{project_id}/webhooks project createProjectWebhook
+//
+//	Creates a webhook which is notified about cluster lifecycle events of the given project.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: ProjectWebhook
+//	  401: empty
+//	  403: empty
+func (r Routing) createProjectWebhook() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(projectwebhook.CreateEndpoint(
+			r.userInfoGetter,
+			r.projectProvider,
+			r.privilegedProjectProvider,
+			r.privilegedWebhookProvider,
+		)),
+		projectwebhook.DecodeCreateReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route get /api/v2/projects/{project_id}/webhooks/{webhook_id} project getProjectWebhook
+//
+//	Gets the webhook of the given project.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ProjectWebhook
+//	  401: empty
+//	  403: empty
+func (r Routing) getProjectWebhook() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(projectwebhook.GetEndpoint(
+			r.userInfoGetter,
+			r.projectProvider,
+			r.privilegedProjectProvider,
+			r.privilegedWebhookProvider,
+		)),
+		projectwebhook.DecodeGetReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route put /api/v2/projects/{project_id}/webhooks/{webhook_id} project updateProjectWebhook
+//
+//	Updates the webhook of the given project. The secret is kept if it is empty.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ProjectWebhook
+//	  401: empty
+//	  403: empty
+func (r Routing) updateProjectWebhook() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(projectwebhook.UpdateEndpoint(
+			r.userInfoGetter,
+			r.projectProvider,
+			r.privilegedProjectProvider,
+			r.privilegedWebhookProvider,
+		)),
+		projectwebhook.DecodeUpdateReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route delete /api/v2/projects/{project_id}/webhooks/{webhook_id} project deleteProjectWebhook
+//
+//	Deletes the webhook of the given project.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) deleteProjectWebhook() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(projectwebhook.DeleteEndpoint(
+			r.userInfoGetter,
+			r.projectProvider,
+			r.privilegedProjectProvider,
+			r.privilegedWebhookProvider,
+		)),
+		projectwebhook.DecodeGetReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations applications listApplicationInstallations
 //
 //	List ApplicationInstallations which belong to the given cluster
@@ -11206,6 +13353,85 @@ func (r Routing) deleteClusterServiceAccount() http.Handler {
 	)
 }
 
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/kubeconfig/share project createKubeconfigShare
+//
+//	Creates a read-only kubeconfig of the cluster, which expires at the requested time, at most 30 days from now.
+//	The kubeconfig is only returned in this response.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: KubeconfigShare
+//	  401: empty
+//	  403: empty
+func (r Routing) createKubeconfigShare() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.CreateKubeconfigShareEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		cluster.DecodeCreateKubeconfigShareReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/kubeconfig/share project listKubeconfigShares
+//
+//	Lists the read-only kubeconfig shares of the cluster with their expiry.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []KubeconfigShare
+//	  401: empty
+//	  403: empty
+func (r Routing) listKubeconfigShares() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.ListKubeconfigShareEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		cluster.DecodeKubeconfigShareClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/kubeconfig/share/{share_id} project deleteKubeconfigShare
+//
+//	Revokes the read-only kubeconfig share by deleting its service account.
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) deleteKubeconfigShare() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.DeleteKubeconfigShareEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		cluster.DecodeKubeconfigShareReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/seeds/{seed_name}/overview seed admin getSeedOverview
 //
 //	Returns seed's overview.
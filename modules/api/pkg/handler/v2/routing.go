@@ -28,11 +28,13 @@ import (
 	"go.uber.org/zap"
 
 	"k8c.io/dashboard/v2/pkg/handler"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/provider"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
 	"k8c.io/dashboard/v2/pkg/serviceaccount"
 	"k8c.io/dashboard/v2/pkg/watcher"
+	"k8c.io/dashboard/v2/pkg/webhook"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/features"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
@@ -101,8 +103,12 @@ type Routing struct {
 	applicationDefinitionProvider                  provider.ApplicationDefinitionProvider
 	privilegedOperatingSystemProfileProviderGetter provider.PrivilegedOperatingSystemProfileProviderGetter
 	oidcIssuerVerifierProviderGetter               provider.OIDCIssuerVerifierGetter
+	privilegedWebhookProvider                      provider.PrivilegedWebhookProvider
+	webhookNotifier                                *webhook.Notifier
+	priceCatalog                                   provider.PriceCatalog
 	versions                                       kubermatic.Versions
 	caBundle                                       *x509.CertPool
+	seedCircuitBreaker                             *handlercommon.SeedCircuitBreaker
 	features                                       features.FeatureGate
 }
 
@@ -170,9 +176,13 @@ func NewV2Routing(routingParams handler.RoutingParams) Routing {
 		applicationDefinitionProvider:                  routingParams.ApplicationDefinitionProvider,
 		privilegedOperatingSystemProfileProviderGetter: routingParams.PrivilegedOperatingSystemProfileProviderGetter,
 		oidcIssuerVerifierProviderGetter:               routingParams.OIDCIssuerVerifierProviderGetter,
+		privilegedWebhookProvider:                      routingParams.PrivilegedWebhookProvider,
+		webhookNotifier:                                routingParams.WebhookNotifier,
+		priceCatalog:                                   routingParams.PriceCatalog,
 		versions:                                       routingParams.Versions,
 		caBundle:                                       routingParams.CABundle,
 		features:                                       routingParams.Features,
+		seedCircuitBreaker:                             handlercommon.NewSeedCircuitBreaker(handlercommon.SeedCircuitBreakerThreshold, handlercommon.SeedCircuitBreakerCooldown),
 	}
 }
 
@@ -0,0 +1,327 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"go.uber.org/zap"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// maxResults is the maximum number of results returned per category.
+	maxResults = 20
+
+	// machineDeploymentSearchWorkers is the number of clusters of a seed whose machine deployments are searched at
+	// the same time.
+	machineDeploymentSearchWorkers = 10
+	// machineDeploymentSearchTimeout bounds the search of the machine deployments of all clusters of a seed.
+	machineDeploymentSearchTimeout = 10 * time.Second
+)
+
+// SearchEndpoint searches the projects, clusters and machine deployments the user has access to. Admins search
+// all of them.
+func SearchEndpoint(
+	projectProvider provider.ProjectProvider,
+	userProjectMapper provider.ProjectMemberMapper,
+	seedsGetter provider.SeedsGetter,
+	clusterProviderGetter provider.ClusterProviderGetter,
+	userInfoGetter provider.UserInfoGetter,
+) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(searchReq)
+		query := strings.ToLower(req.Query)
+
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		projects, err := projectProvider.List(ctx, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		var projectIDs sets.Set[string]
+		if !userInfo.IsAdmin {
+			projectIDs, err = getMemberProjectIDs(ctx, userProjectMapper, userInfo)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+		}
+		hasAccess := func(projectID string) bool {
+			return userInfo.IsAdmin || projectIDs.Has(projectID)
+		}
+
+		result := apiv2.SearchResult{
+			Projects:           []apiv2.SearchResultProject{},
+			Clusters:           []apiv2.SearchResultCluster{},
+			MachineDeployments: []apiv2.SearchResultMachineDeployment{},
+		}
+
+		for _, project := range projects {
+			if hasAccess(project.Name) && (matches(project.Spec.Name, query) || matches(project.Name, query)) {
+				result.Projects = append(result.Projects, apiv2.SearchResultProject{ID: project.Name, Name: project.Spec.Name})
+			}
+		}
+		sortByRelevance(result.Projects, query, func(p apiv2.SearchResultProject) (string, string) { return p.ID, p.Name })
+
+		seeds, err := seedsGetter()
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		brokenSeeds := []string{}
+		for _, seed := range seeds {
+			if seed.Status.Phase == kubermaticv1.SeedInvalidPhase {
+				kubermaticlog.Logger.Warnf("skipping seed %s as it is in an invalid phase", seed.Name)
+				brokenSeeds = append(brokenSeeds, seed.Name)
+				continue
+			}
+
+			seedClusterProvider, err := clusterProviderGetter(seed)
+			if err != nil {
+				kubermaticlog.Logger.Errorw("failed to create cluster provider", "seed", seed.Name, zap.Error(err))
+				brokenSeeds = append(brokenSeeds, seed.Name)
+				continue
+			}
+
+			clusters, err := seedClusterProvider.ListAll(ctx, labels.Everything())
+			if err != nil {
+				kubermaticlog.Logger.Errorw("failed to get clusters from seed ", "seed", seed.Name, zap.Error(err))
+				brokenSeeds = append(brokenSeeds, seed.Name)
+				continue
+			}
+
+			accessibleClusters := make([]kubermaticv1.Cluster, 0, len(clusters.Items))
+			for _, cluster := range clusters.Items {
+				projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+				if !hasAccess(projectID) {
+					continue
+				}
+				accessibleClusters = append(accessibleClusters, cluster)
+
+				if matchesCluster(&cluster, query) {
+					result.Clusters = append(result.Clusters, apiv2.SearchResultCluster{
+						ID:        cluster.Name,
+						Name:      cluster.Spec.HumanReadableName,
+						ProjectID: projectID,
+						Seed:      seed.Name,
+					})
+				}
+			}
+
+			mds := searchMachineDeployments(ctx, userInfoGetter, seedClusterProvider, accessibleClusters, query)
+			for i := range mds {
+				mds[i].Seed = seed.Name
+			}
+			result.MachineDeployments = append(result.MachineDeployments, mds...)
+		}
+
+		sortByRelevance(result.Clusters, query, func(c apiv2.SearchResultCluster) (string, string) { return c.ID, c.Name })
+		sortByRelevance(result.MachineDeployments, query, func(md apiv2.SearchResultMachineDeployment) (string, string) { return md.ID, md.Name })
+		result.Projects = limit(result.Projects)
+		result.Clusters = limit(result.Clusters)
+		result.MachineDeployments = limit(result.MachineDeployments)
+
+		if len(brokenSeeds) > 0 {
+			errMsg := "Failed to fetch data for one or more seeds. Please contact an administrator."
+			if userInfo.IsAdmin {
+				errMsg = fmt.Sprintf("Failed to fetch data for following seeds: %s.", strings.Join(brokenSeeds, `, `))
+			}
+			result.ErrorMessage = &errMsg
+		}
+
+		return result, nil
+	}
+}
+
+// getMemberProjectIDs returns the IDs of the projects the user is a member of, either directly or via a group.
+func getMemberProjectIDs(ctx context.Context, userProjectMapper provider.ProjectMemberMapper, userInfo *provider.UserInfo) (sets.Set[string], error) {
+	projectIDs := sets.New[string]()
+
+	userMappings, err := userProjectMapper.MappingsFor(ctx, userInfo.Email)
+	if err != nil {
+		return nil, err
+	}
+	for _, mapping := range userMappings {
+		projectIDs.Insert(mapping.Spec.ProjectID)
+	}
+
+	groupMappings, err := userProjectMapper.GroupMappingsFor(ctx, userInfo.Groups)
+	if err != nil {
+		return nil, err
+	}
+	for _, mapping := range groupMappings {
+		projectIDs.Insert(mapping.Spec.ProjectID)
+	}
+
+	return projectIDs, nil
+}
+
+// searchMachineDeployments searches the machine deployments of the clusters with a bounded number of workers. Clusters
+// without a running apiserver or whose machine deployments can't be listed in time are skipped.
+func searchMachineDeployments(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, clusters []kubermaticv1.Cluster, query string) []apiv2.SearchResultMachineDeployment {
+	ctx, cancel := context.WithTimeout(ctx, machineDeploymentSearchTimeout)
+	defer cancel()
+
+	var (
+		wg     sync.WaitGroup
+		lock   sync.Mutex
+		result []apiv2.SearchResultMachineDeployment
+	)
+
+	healthyClusters := make(chan *kubermaticv1.Cluster)
+	for range min(machineDeploymentSearchWorkers, len(clusters)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			for cluster := range healthyClusters {
+				mds := searchClusterMachineDeployments(ctx, userInfoGetter, clusterProvider, cluster, query)
+
+				lock.Lock()
+				result = append(result, mds...)
+				lock.Unlock()
+			}
+		}()
+	}
+
+	for i := range clusters {
+		if clusters[i].Status.ExtendedHealth.Apiserver == kubermaticv1.HealthStatusUp {
+			healthyClusters <- &clusters[i]
+		}
+	}
+	close(healthyClusters)
+	wg.Wait()
+
+	return result
+}
+
+func searchClusterMachineDeployments(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, query string) []apiv2.SearchResultMachineDeployment {
+	projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		kubermaticlog.Logger.Debugw("failed to create client for cluster", "cluster", cluster.Name, zap.Error(err))
+		return nil
+	}
+
+	mds := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, mds, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		kubermaticlog.Logger.Debugw("failed to list machine deployments", "cluster", cluster.Name, zap.Error(err))
+		return nil
+	}
+
+	var result []apiv2.SearchResultMachineDeployment
+	for _, md := range mds.Items {
+		if matches(md.Name, query) {
+			result = append(result, apiv2.SearchResultMachineDeployment{
+				ID:        md.Name,
+				Name:      md.Name,
+				ProjectID: projectID,
+				ClusterID: cluster.Name,
+			})
+		}
+	}
+
+	return result
+}
+
+// matches checks case-insensitively whether the value contains the lower-case query.
+func matches(value, query string) bool {
+	return strings.Contains(strings.ToLower(value), query)
+}
+
+// matchesCluster checks whether the name, ID or one of the labels of the cluster contains the query.
+func matchesCluster(cluster *kubermaticv1.Cluster, query string) bool {
+	if matches(cluster.Spec.HumanReadableName, query) || matches(cluster.Name, query) {
+		return true
+	}
+	for key, value := range cluster.Labels {
+		if matches(fmt.Sprintf("%s=%s", key, value), query) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortByRelevance sorts exact matches of the name or ID first, the rest by name.
+func sortByRelevance[T any](items []T, query string, identity func(T) (id, name string)) {
+	rank := func(item T) int {
+		id, name := identity(item)
+		if strings.EqualFold(name, query) || strings.EqualFold(id, query) {
+			return 0
+		}
+		return 1
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if ri, rj := rank(items[i]), rank(items[j]); ri != rj {
+			return ri < rj
+		}
+		_, ni := identity(items[i])
+		_, nj := identity(items[j])
+		return ni < nj
+	})
+}
+
+func limit[T any](items []T) []T {
+	if len(items) > maxResults {
+		return items[:maxResults]
+	}
+	return items
+}
+
+// searchReq defines HTTP request for search
+// swagger:parameters search
+type searchReq struct {
+	// Query is matched case-insensitively against the names and IDs of projects, clusters and machine deployments
+	// and the labels of clusters.
+	// in: query
+	// required: true
+	Query string `json:"q"`
+}
+
+func DecodeSearchReq(c context.Context, r *http.Request) (interface{}, error) {
+	req := searchReq{Query: strings.TrimSpace(r.URL.Query().Get("q"))}
+	if req.Query == "" {
+		return nil, utilerrors.NewBadRequest("the query parameter 'q' is required")
+	}
+
+	return req, nil
+}
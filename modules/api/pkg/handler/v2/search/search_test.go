@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package search_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestSearchEndpoint(t *testing.T) {
+	t.Parallel()
+
+	const providerSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+
+	otherProject := test.GenProject("other-project", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp())
+	testProject := test.GenProject("test", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp())
+	myTestProject := test.GenProject("my-test", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp())
+
+	adminUser := test.GenAPIAdminUser("John", "john@acme.com", true)
+
+	testcases := []struct {
+		Name             string
+		Query            string
+		ExistingAPIUser  *apiv1.User
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: the user only finds the clusters of the projects they are a member of",
+			Query:            "clustername",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"projects":[],"clusters":[{"id":"defClusterID","name":"defClusterName","projectID":"my-first-project-ID","seed":"us-central1"}],"machineDeployments":[]}`,
+		},
+		{
+			Name:             "scenario 2: the admin finds the clusters of all projects",
+			Query:            "ClusterName",
+			ExistingAPIUser:  adminUser,
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"projects":[],"clusters":[{"id":"defClusterID","name":"defClusterName","projectID":"my-first-project-ID","seed":"us-central1"},{"id":"otherClusterID","name":"otherClusterName","projectID":"other-project-ID","seed":"us-central1"}],"machineDeployments":[]}`,
+		},
+		{
+			Name:             "scenario 3: the user doesn't find projects they are not a member of",
+			Query:            "project",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"projects":[{"id":"my-first-project-ID","name":"my-first-project"}],"clusters":[{"id":"defClusterID","name":"defClusterName","projectID":"my-first-project-ID","seed":"us-central1"}],"machineDeployments":[]}`,
+		},
+		{
+			Name:             "scenario 4: exact name matches are ranked first",
+			Query:            "test",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"projects":[{"id":"test-ID","name":"test"},{"id":"my-test-ID","name":"my-test"}],"clusters":[],"machineDeployments":[]}`,
+		},
+		{
+			Name:             "scenario 5: machine deployments are found with the project, cluster and seed they belong to",
+			Query:            "venus",
+			ExistingAPIUser:  test.GenDefaultAPIUser(),
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"projects":[],"clusters":[],"machineDeployments":[{"id":"venus","name":"venus","projectID":"my-first-project-ID","clusterID":"defClusterID","seed":"us-central1"}]}`,
+		},
+		{
+			Name:            "scenario 6: the query is required",
+			Query:           "",
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			HTTPStatus:      http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/search?q=%s", url.QueryEscape(tc.Query)), nil)
+			res := httptest.NewRecorder()
+
+			kubermaticObjects := test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenAdminUser("John", "john@acme.com", true),
+				otherProject,
+				testProject,
+				myTestProject,
+				test.GenBinding(testProject.Name, test.GenDefaultUser().Spec.Email, "editors"),
+				test.GenBinding(myTestProject.Name, test.GenDefaultUser().Spec.Email, "viewers"),
+				test.GenDefaultCluster(),
+				test.GenCluster("otherClusterID", "otherClusterName", otherProject.Name, test.DefaultCreationTimestamp()),
+				test.GenTestMachineDeployment("venus", providerSpec, nil, false),
+			)
+
+			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, nil, []ctrlruntimeclient.Object{}, kubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if res.Code != http.StatusOK {
+				return
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
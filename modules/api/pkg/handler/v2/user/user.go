@@ -18,19 +18,182 @@ package user
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/go-kit/kit/endpoint"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 )
 
+const (
+	sortByEmail             = "email"
+	sortByCreationTimestamp = "creationTimestamp"
+
+	orderAsc  = "asc"
+	orderDesc = "desc"
+
+	// ContinueHeader is the response header which holds the token for the next page of users. It is not set on the
+	// last page.
+	ContinueHeader = "X-Continue"
+)
+
+// ListUsersReq defines HTTP request for listUser endpoint.
+// swagger:parameters listUser
+type ListUsersReq struct {
+	// Only list users whose email or name contains the query, case insensitive.
+	// in: query
+	Query string `json:"q,omitempty"`
+	// Only list admins, or only users who are no admins.
+	// in: query
+	IsAdmin *bool `json:"is_admin,omitempty"`
+	// Sort the users by email or creationTimestamp. Defaults to email if the users are paginated or ordered.
+	// in: query
+	SortBy string `json:"sort_by,omitempty"`
+	// The sort order, asc or desc. Defaults to asc.
+	// in: query
+	Order string `json:"order,omitempty"`
+	// The maximum number of users to return. The token for the next page is returned in the X-Continue header.
+	// in: query
+	Limit int `json:"limit,omitempty"`
+	// The token of the page to return, taken from the X-Continue header of the previous page.
+	// in: query
+	Continue string `json:"continue,omitempty"`
+
+	offset int
+}
+
+func DecodeListUsersReq(c context.Context, r *http.Request) (interface{}, error) {
+	query := r.URL.Query()
+	req := ListUsersReq{
+		Query:    query.Get("q"),
+		SortBy:   query.Get("sort_by"),
+		Order:    query.Get("order"),
+		Continue: query.Get("continue"),
+	}
+
+	if value := query.Get("is_admin"); value != "" {
+		isAdmin, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid value for `is_admin`: %v", err)
+		}
+		req.IsAdmin = &isAdmin
+	}
+
+	switch req.SortBy {
+	case "", sortByEmail, sortByCreationTimestamp:
+	default:
+		return nil, utilerrors.NewBadRequest("invalid value for `sort_by`: %q, must be one of %s, %s", req.SortBy, sortByEmail, sortByCreationTimestamp)
+	}
+
+	switch req.Order {
+	case "", orderAsc, orderDesc:
+	default:
+		return nil, utilerrors.NewBadRequest("invalid value for `order`: %q, must be one of %s, %s", req.Order, orderAsc, orderDesc)
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 {
+			return nil, utilerrors.NewBadRequest("invalid value for `limit`: %q, must be a positive number", value)
+		}
+		req.Limit = limit
+	}
+
+	if req.Continue != "" {
+		offset, err := decodeContinueToken(req.Continue)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid value for `continue`: %v", err)
+		}
+		req.offset = offset
+	}
+
+	return req, nil
+}
+
+// sorted checks whether the users have to be sorted. Without any sorting or pagination parameter the users are
+// returned in the order of the listing.
+func (req ListUsersReq) sorted() bool {
+	return req.SortBy != "" || req.Order != "" || req.Limit > 0 || req.Continue != ""
+}
+
+// matches checks whether the user matches the filters of the request.
+func (req ListUsersReq) matches(user *kubermaticv1.User) bool {
+	if req.IsAdmin != nil && user.Spec.IsAdmin != *req.IsAdmin {
+		return false
+	}
+	if req.Query != "" {
+		query := strings.ToLower(req.Query)
+		if !strings.Contains(strings.ToLower(user.Spec.Email), query) && !strings.Contains(strings.ToLower(user.Spec.Name), query) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// less orders the users by the sort key of the request. Users with the same key are ordered by their name, so that
+// the order and thereby the pages are stable.
+func (req ListUsersReq) less(a, b *kubermaticv1.User) bool {
+	if req.Order == orderDesc {
+		a, b = b, a
+	}
+
+	if req.SortBy == sortByCreationTimestamp {
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+	} else if a.Spec.Email != b.Spec.Email {
+		return a.Spec.Email < b.Spec.Email
+	}
+
+	return a.Name < b.Name
+}
+
+func encodeContinueToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeContinueToken(token string) (int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("malformed token")
+	}
+	offset, err := strconv.Atoi(string(decoded))
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("malformed token")
+	}
+
+	return offset, nil
+}
+
+type listUsersResponse struct {
+	users         []apiv1.User
+	continueToken string
+}
+
+// EncodeListUsersResp encodes the users as a JSON list and sets the token of the next page in the X-Continue header.
+func EncodeListUsersResp(c context.Context, w http.ResponseWriter, response interface{}) error {
+	rsp := response.(*listUsersResponse)
+	if rsp.continueToken != "" {
+		w.Header().Set(ContinueHeader, rsp.continueToken)
+	}
+
+	return handler.EncodeJSON(c, w, rsp.users)
+}
+
 func ListEndpoint(userInfoGetter provider.UserInfoGetter, userProvider provider.UserProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListUsersReq)
 		userInfo, err := userInfoGetter(ctx, "")
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
@@ -46,12 +209,34 @@ func ListEndpoint(userInfoGetter provider.UserInfoGetter, userProvider provider.
 			return nil, err
 		}
 
-		result := make([]apiv1.User, 0)
-		for _, crdUser := range list {
-			apiUser := apiv1.ConvertInternalUserToExternal(&crdUser, false, nil, nil)
-			result = append(result, *apiUser)
+		users := make([]*kubermaticv1.User, 0, len(list))
+		for i := range list {
+			if req.matches(&list[i]) {
+				users = append(users, &list[i])
+			}
+		}
+
+		if req.sorted() {
+			sort.SliceStable(users, func(i, j int) bool {
+				return req.less(users[i], users[j])
+			})
+		}
+
+		rsp := &listUsersResponse{users: make([]apiv1.User, 0)}
+		if req.offset >= len(users) {
+			return rsp, nil
+		}
+		users = users[req.offset:]
+		if req.Limit > 0 && len(users) > req.Limit {
+			users = users[:req.Limit]
+			rsp.continueToken = encodeContinueToken(req.offset + req.Limit)
+		}
+
+		for _, crdUser := range users {
+			apiUser := apiv1.ConvertInternalUserToExternal(crdUser, false, nil, nil)
+			rsp.users = append(rsp.users, *apiUser)
 		}
 
-		return result, nil
+		return rsp, nil
 	}
 }
@@ -17,14 +17,20 @@ limitations under the License.
 package user_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
+	"time"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/handler/v2/user"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -83,3 +89,153 @@ func TestListEndpoint(t *testing.T) {
 		})
 	}
 }
+
+// genListUsers generates 20 users besides the default admin. The users with an odd number have an acme.com email,
+// the others an example.com email, every fifth user is an admin and the users with a lower number are newer.
+func genListUsers() []ctrlruntimeclient.Object {
+	created := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	users := []ctrlruntimeclient.Object{test.GenDefaultAdminUser()}
+	for i := 1; i <= 20; i++ {
+		domain := "example.com"
+		if i%2 == 1 {
+			domain = "acme.com"
+		}
+		u := test.GenUser("", fmt.Sprintf("User %02d", i), fmt.Sprintf("user%02d@%s", i, domain))
+		u.Spec.IsAdmin = i%5 == 0
+		u.CreationTimestamp = metav1.NewTime(created.Add(time.Duration(20-i) * time.Hour))
+		users = append(users, u)
+	}
+	return users
+}
+
+func TestListEndpointFilterSortAndPaginate(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name                   string
+		Query                  string
+		ExpectedHTTPStatusCode int
+		ExpectedPages          [][]string
+		ExpectedResponse       string
+	}{
+		{
+			Name:                   "filter by email and admin, sort by creation timestamp in descending order and paginate",
+			Query:                  "q=example.com&is_admin=false&sort_by=creationTimestamp&order=desc&limit=3",
+			ExpectedHTTPStatusCode: http.StatusOK,
+			ExpectedPages: [][]string{
+				{"user02@example.com", "user04@example.com", "user06@example.com"},
+				{"user08@example.com", "user12@example.com", "user14@example.com"},
+				{"user16@example.com", "user18@example.com"},
+			},
+		},
+		{
+			Name:                   "sort by creation timestamp in ascending order",
+			Query:                  "is_admin=true&sort_by=creationTimestamp",
+			ExpectedHTTPStatusCode: http.StatusOK,
+			ExpectedPages: [][]string{
+				{"bob@acme.com", "user20@example.com", "user15@acme.com", "user10@example.com", "user05@acme.com"},
+			},
+		},
+		{
+			Name:                   "filter by name case insensitive and sort by email in descending order",
+			Query:                  "q=USER%201&is_admin=true&sort_by=email&order=desc",
+			ExpectedHTTPStatusCode: http.StatusOK,
+			ExpectedPages: [][]string{
+				{"user15@acme.com", "user10@example.com"},
+			},
+		},
+		{
+			Name:                   "paginated users are sorted by email by default",
+			Query:                  "q=acme.com&limit=6",
+			ExpectedHTTPStatusCode: http.StatusOK,
+			ExpectedPages: [][]string{
+				{"bob@acme.com", "user01@acme.com", "user03@acme.com", "user05@acme.com", "user07@acme.com", "user09@acme.com"},
+				{"user11@acme.com", "user13@acme.com", "user15@acme.com", "user17@acme.com", "user19@acme.com"},
+			},
+		},
+		{
+			Name:                   "a page limit matching the number of users returns a single page",
+			Query:                  "q=user1&is_admin=false&limit=8",
+			ExpectedHTTPStatusCode: http.StatusOK,
+			ExpectedPages: [][]string{
+				{"user11@acme.com", "user12@example.com", "user13@acme.com", "user14@example.com", "user16@example.com", "user17@acme.com", "user18@example.com", "user19@acme.com"},
+			},
+		},
+		{
+			Name:                   "no users match the filter",
+			Query:                  "q=nobody&limit=5",
+			ExpectedHTTPStatusCode: http.StatusOK,
+			ExpectedPages:          [][]string{{}},
+		},
+		{
+			Name:                   "invalid sort key",
+			Query:                  "sort_by=name",
+			ExpectedHTTPStatusCode: http.StatusBadRequest,
+			ExpectedResponse:       `{"error":{"code":400,"message":"invalid value for ` + "`sort_by`" + `: \"name\", must be one of email, creationTimestamp"}}`,
+		},
+		{
+			Name:                   "invalid order",
+			Query:                  "sort_by=email&order=up",
+			ExpectedHTTPStatusCode: http.StatusBadRequest,
+			ExpectedResponse:       `{"error":{"code":400,"message":"invalid value for ` + "`order`" + `: \"up\", must be one of asc, desc"}}`,
+		},
+		{
+			Name:                   "invalid limit",
+			Query:                  "limit=0",
+			ExpectedHTTPStatusCode: http.StatusBadRequest,
+			ExpectedResponse:       `{"error":{"code":400,"message":"invalid value for ` + "`limit`" + `: \"0\", must be a positive number"}}`,
+		},
+		{
+			Name:                   "invalid continue token",
+			Query:                  "limit=5&continue=not-a-token",
+			ExpectedHTTPStatusCode: http.StatusBadRequest,
+			ExpectedResponse:       `{"error":{"code":400,"message":"invalid value for ` + "`continue`" + `: malformed token"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAdminAPIUser(), nil, genListUsers(), nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			pages := [][]string{}
+			query := tc.Query
+			for {
+				resp := httptest.NewRecorder()
+				ep.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/v2/users?"+query, nil))
+
+				if resp.Code != tc.ExpectedHTTPStatusCode {
+					t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.ExpectedHTTPStatusCode, resp.Code, resp.Body.String())
+				}
+				if resp.Code != http.StatusOK {
+					test.CompareWithResult(t, resp, tc.ExpectedResponse)
+					return
+				}
+
+				users := []apiv1.User{}
+				if err := json.Unmarshal(resp.Body.Bytes(), &users); err != nil {
+					t.Fatalf("failed to decode users: %v", err)
+				}
+				emails := []string{}
+				for _, u := range users {
+					emails = append(emails, u.Email)
+				}
+				pages = append(pages, emails)
+
+				token := resp.Header().Get(user.ContinueHeader)
+				if token == "" {
+					break
+				}
+				if len(pages) > len(tc.ExpectedPages) {
+					t.Fatalf("Expected %d pages, got more: %v", len(tc.ExpectedPages), pages)
+				}
+				query = tc.Query + "&continue=" + token
+			}
+
+			if !reflect.DeepEqual(pages, tc.ExpectedPages) {
+				t.Fatalf("Expected pages %v, got %v", tc.ExpectedPages, pages)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"context"
+
+	"github.com/gorilla/websocket"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/log"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WriteMachineDeployments streams the machine deployments of the user cluster and their nodes until the context is
+// done or the connection is closed. The stream is read-only, messages of the client are discarded.
+func WriteMachineDeployments(ctx context.Context, ws *websocket.Conn, client ctrlruntimeclient.WithWatch, userInfo *provider.UserInfo) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := ws.ReadMessage(); err != nil {
+				log.Logger.Debug(err)
+				return
+			}
+		}
+	}()
+
+	err := handlercommon.WatchMachineDeployments(ctx, client, userInfo, func(event *apiv2.MachineDeploymentWatchEvent) error {
+		return ws.WriteJSON(event)
+	})
+	if err != nil {
+		log.Logger.Debug(err)
+	}
+
+	_ = writeCloseMessage(ws, websocket.CloseNormalClosure)
+}
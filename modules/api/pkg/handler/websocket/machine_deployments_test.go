@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	wsh "k8c.io/dashboard/v2/pkg/handler/websocket"
+	"k8c.io/dashboard/v2/pkg/provider"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const doProviderSpec = `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`
+
+func TestWriteMachineDeployments(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(clusterv1alpha1.AddToScheme(scheme))
+
+	selector := map[string]string{"name": "venus"}
+	client := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(test.GenTestMachineDeployment("venus", doProviderSpec, selector, false)).
+		Build()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		upgrader := websocket.Upgrader{}
+		ws, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade the connection: %v", err)
+			return
+		}
+		defer ws.Close()
+
+		wsh.WriteMachineDeployments(context.Background(), ws, client, &provider.UserInfo{Email: "bob@acme.com"})
+	}))
+	defer server.Close()
+
+	ch, err := createWSClient("ws" + strings.TrimPrefix(server.URL, "http"))
+	if err != nil {
+		t.Fatalf("failed to initialize websocket client: %v", err)
+	}
+
+	event := readMachineDeploymentWatchEvent(t, ch)
+	if event.Type != apiv2.MachineDeploymentWatchEventSync || len(event.NodeDeployments) != 1 || event.NodeDeployments[0].Spec.Replicas != 1 {
+		t.Fatalf("expected a SYNC event with the machine deployment venus, got %+v", event)
+	}
+
+	ctx := context.Background()
+	md := &clusterv1alpha1.MachineDeployment{}
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: "kube-system", Name: "venus"}, md); err != nil {
+		t.Fatalf("failed to get machine deployment: %v", err)
+	}
+	replicas := int32(3)
+	md.Spec.Replicas = &replicas
+	if err := client.Update(ctx, md); err != nil {
+		t.Fatalf("failed to update machine deployment: %v", err)
+	}
+
+	event = readMachineDeploymentWatchEvent(t, ch)
+	if event.Type != apiv2.MachineDeploymentWatchEventModified || event.NodeDeployment == nil || event.NodeDeployment.Spec.Replicas != 3 {
+		t.Fatalf("expected a MODIFIED event with 3 replicas, got %+v", event)
+	}
+
+	if err := client.Create(ctx, test.GenTestMachine("venus-1", doProviderSpec, selector, nil)); err != nil {
+		t.Fatalf("failed to create machine: %v", err)
+	}
+
+	event = readMachineDeploymentWatchEvent(t, ch)
+	if event.Type != apiv2.MachineDeploymentWatchEventAdded || event.Node == nil || event.Node.ID != "venus-1" || event.MachineDeploymentID != "venus" {
+		t.Fatalf("expected an ADDED event with the node venus-1 of venus, got %+v", event)
+	}
+}
+
+func readMachineDeploymentWatchEvent(t *testing.T, ch chan wsMessage) *apiv2.MachineDeploymentWatchEvent {
+	var msg wsMessage
+	select {
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for ws message")
+	case msg = <-ch:
+	}
+	if msg.err != nil {
+		t.Fatalf("error reading ws message: %v", msg.err)
+	}
+
+	event := &apiv2.MachineDeploymentWatchEvent{}
+	if err := json.Unmarshal(msg.p, event); err != nil {
+		t.Fatalf("failed to decode ws message: %v", err)
+	}
+
+	return event
+}
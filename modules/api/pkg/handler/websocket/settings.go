@@ -37,7 +37,13 @@ func WriteSettings(ctx context.Context, providers watcher.Providers, ws *websock
 		return
 	}
 
-	initialResponse, err := json.Marshal(admin.ConvertCRDSettingsToAPISettingsSpec(&initialSettings.Spec))
+	initialAPISettings, err := admin.ConvertCRDSettingsToAPISettings(initialSettings)
+	if err != nil {
+		log.Logger.Debug(err)
+		return
+	}
+
+	initialResponse, err := json.Marshal(initialAPISettings)
 	if err != nil {
 		log.Logger.Debug(err)
 		return
@@ -54,7 +60,11 @@ func WriteSettings(ctx context.Context, providers watcher.Providers, ws *websock
 			var externalSettings apiv2.GlobalSettings
 			internalSettings, ok := settings.(*kubermaticv1.KubermaticSetting)
 			if ok {
-				externalSettings = admin.ConvertCRDSettingsToAPISettingsSpec(&internalSettings.Spec)
+				externalSettings, err = admin.ConvertCRDSettingsToAPISettings(internalSettings)
+				if err != nil {
+					log.Logger.Debug(err)
+					return
+				}
 			} else {
 				log.Logger.Debug("cannot convert settings: %v", settings)
 			}
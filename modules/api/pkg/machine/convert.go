@@ -50,6 +50,60 @@ import (
 	"k8s.io/utils/ptr"
 )
 
+// UbuntuConfig is the Ubuntu specific part of the operating system spec of a machine. It extends the machine-controller
+// config with the Ubuntu Pro token, which the machine-controller does not know about.
+type UbuntuConfig struct {
+	ubuntu.Config  `json:",inline"`
+	UbuntuProToken string `json:"ubuntuProToken,omitempty"`
+}
+
+// AdditionalUserDataConfig is the part of the operating system spec of a machine which holds the additional user
+// data. The operating system configs of the machine-controller ignore unknown fields, so it can be stored next to them.
+type AdditionalUserDataConfig struct {
+	AdditionalUserData string `json:"additionalUserData,omitempty"`
+}
+
+// GetAPIV1AdditionalUserData returns the additional user data of the given machine.
+func GetAPIV1AdditionalUserData(machineSpec clusterv1alpha1.MachineSpec) (string, error) {
+	decodedProviderSpec, err := providerconfig.GetConfig(machineSpec.ProviderSpec)
+	if err != nil {
+		return "", fmt.Errorf("failed to get machine providerConfig: %w", err)
+	}
+	if len(decodedProviderSpec.OperatingSystemSpec.Raw) == 0 {
+		return "", nil
+	}
+
+	config := &AdditionalUserDataConfig{}
+	if err := json.Unmarshal(decodedProviderSpec.OperatingSystemSpec.Raw, config); err != nil {
+		return "", fmt.Errorf("failed to parse operating system spec: %w", err)
+	}
+
+	return config.AdditionalUserData, nil
+}
+
+// GPUConfig is the part of the operating system spec of a machine which holds the GPU driver settings.
+type GPUConfig struct {
+	GPU *apiv1.GPUSpec `json:"gpu,omitempty"`
+}
+
+// GetAPIV1GPUSpec returns the GPU driver settings of the given machine.
+func GetAPIV1GPUSpec(machineSpec clusterv1alpha1.MachineSpec) (*apiv1.GPUSpec, error) {
+	decodedProviderSpec, err := providerconfig.GetConfig(machineSpec.ProviderSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get machine providerConfig: %w", err)
+	}
+	if len(decodedProviderSpec.OperatingSystemSpec.Raw) == 0 {
+		return nil, nil
+	}
+
+	config := &GPUConfig{}
+	if err := json.Unmarshal(decodedProviderSpec.OperatingSystemSpec.Raw, config); err != nil {
+		return nil, fmt.Errorf("failed to parse operating system spec: %w", err)
+	}
+
+	return config.GPU, nil
+}
+
 // GetAPIV1OperatingSystemSpec returns the api compatible OperatingSystemSpec for the given machine.
 func GetAPIV1OperatingSystemSpec(machineSpec clusterv1alpha1.MachineSpec) (*apiv1.OperatingSystemSpec, error) {
 	decodedProviderSpec, err := providerconfig.GetConfig(machineSpec.ProviderSpec)
@@ -74,12 +128,13 @@ func GetAPIV1OperatingSystemSpec(machineSpec clusterv1alpha1.MachineSpec) (*apiv
 		}
 
 	case providerconfig.OperatingSystemUbuntu:
-		config := &ubuntu.Config{}
+		config := &UbuntuConfig{}
 		if err := json.Unmarshal(decodedProviderSpec.OperatingSystemSpec.Raw, &config); err != nil {
 			return nil, fmt.Errorf("failed to parse ubuntu config: %w", err)
 		}
 		operatingSystemSpec.Ubuntu = &apiv1.UbuntuSpec{
 			DistUpgradeOnBoot: config.DistUpgradeOnBoot,
+			UbuntuProToken:    apiv1.SecretString(config.UbuntuProToken),
 		}
 
 	case providerconfig.OperatingSystemRHEL:
@@ -89,9 +144,9 @@ func GetAPIV1OperatingSystemSpec(machineSpec clusterv1alpha1.MachineSpec) (*apiv
 		}
 		operatingSystemSpec.RHEL = &apiv1.RHELSpec{
 			DistUpgradeOnBoot:               config.DistUpgradeOnBoot,
-			RHELSubscriptionManagerUser:     config.RHELSubscriptionManagerUser,
-			RHELSubscriptionManagerPassword: config.RHELSubscriptionManagerPassword,
-			RHSMOfflineToken:                config.RHSMOfflineToken,
+			RHELSubscriptionManagerUser:     apiv1.SecretString(config.RHELSubscriptionManagerUser),
+			RHELSubscriptionManagerPassword: apiv1.SecretString(config.RHELSubscriptionManagerPassword),
+			RHSMOfflineToken:                apiv1.SecretString(config.RHSMOfflineToken),
 		}
 
 	case providerconfig.OperatingSystemRockyLinux:
@@ -232,6 +287,9 @@ func GetAPIV2NodeCloudSpec(machineSpec clusterv1alpha1.MachineSpec) (*apiv1.Node
 		if len(config.Networks) > 0 {
 			cloudSpec.Hetzner.Network = config.Networks[0].Value
 		}
+		if len(config.Firewalls) > 0 {
+			cloudSpec.Hetzner.Firewall = config.Firewalls[0].Value
+		}
 	case providerconfig.CloudProviderVsphere:
 		config := &vsphere.RawConfig{}
 		if err := json.Unmarshal(decodedProviderSpec.CloudProviderSpec.Raw, &config); err != nil {
@@ -495,9 +553,16 @@ func extractSpotInstanceConfigs(config *aws.RawConfig) (*string, *string, *bool)
 	if config.IsSpotInstance != nil &&
 		*config.IsSpotInstance &&
 		config.SpotInstanceConfig != nil {
-		return &config.SpotInstanceConfig.MaxPrice.Value,
-			&config.SpotInstanceConfig.InterruptionBehavior.Value,
-			config.SpotInstanceConfig.PersistentRequest.Value
+		// Unset values are returned as nil, so that the node spec round-trips through the provider config.
+		var maxPrice, interruptionBehavior *string
+		if config.SpotInstanceConfig.MaxPrice.Value != "" {
+			maxPrice = &config.SpotInstanceConfig.MaxPrice.Value
+		}
+		if config.SpotInstanceConfig.InterruptionBehavior.Value != "" {
+			interruptionBehavior = &config.SpotInstanceConfig.InterruptionBehavior.Value
+		}
+
+		return maxPrice, interruptionBehavior, config.SpotInstanceConfig.PersistentRequest.Value
 	}
 
 	return nil, nil, nil
@@ -0,0 +1,198 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/machine"
+	resourcesmachine "k8c.io/dashboard/v2/pkg/resources/machine"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+	"k8c.io/machine-controller/sdk/providerconfig"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/utils/ptr"
+)
+
+func genMachineSpec(t *testing.T, cloudProvider providerconfig.CloudProvider, cloudProviderSpec interface{}) clusterv1alpha1.MachineSpec {
+	rawCloudProviderSpec, err := resourcesmachine.EncodeAsRawExtension(cloudProviderSpec)
+	if err != nil {
+		t.Fatalf("failed to encode cloud provider spec: %v", err)
+	}
+
+	rawConfig, err := json.Marshal(providerconfig.Config{
+		CloudProvider:     cloudProvider,
+		CloudProviderSpec: *rawCloudProviderSpec,
+	})
+	if err != nil {
+		t.Fatalf("failed to encode provider config: %v", err)
+	}
+
+	return clusterv1alpha1.MachineSpec{
+		ProviderSpec: clusterv1alpha1.ProviderSpec{Value: &runtime.RawExtension{Raw: rawConfig}},
+	}
+}
+
+func TestAWSSpotInstanceConversion(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		name     string
+		nodeSpec apiv1.AWSNodeSpec
+	}{
+		{
+			name: "on-demand instance",
+			nodeSpec: apiv1.AWSNodeSpec{
+				InstanceType: "t3.medium",
+				VolumeSize:   25,
+				VolumeType:   "gp2",
+				AMI:          "ami-123",
+			},
+		},
+		{
+			name: "spot instance with max price",
+			nodeSpec: apiv1.AWSNodeSpec{
+				InstanceType:                     "p3.2xlarge",
+				VolumeSize:                       25,
+				VolumeType:                       "gp2",
+				AMI:                              "ami-123",
+				IsSpotInstance:                   ptr.To(true),
+				SpotInstanceMaxPrice:             ptr.To("0.75"),
+				SpotInstancePersistentRequest:    ptr.To(true),
+				SpotInstanceInterruptionBehavior: ptr.To("stop"),
+			},
+		},
+		{
+			name: "spot instance without max price",
+			nodeSpec: apiv1.AWSNodeSpec{
+				InstanceType:   "t3.medium",
+				VolumeSize:     25,
+				VolumeType:     "gp2",
+				AMI:            "ami-123",
+				IsSpotInstance: ptr.To(true),
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster-abc"},
+				Spec: kubermaticv1.ClusterSpec{
+					Cloud: kubermaticv1.CloudSpec{AWS: &kubermaticv1.AWSCloudSpec{}},
+				},
+			}
+			dc := &kubermaticv1.Datacenter{
+				Spec: kubermaticv1.DatacenterSpec{AWS: &kubermaticv1.DatacenterSpecAWS{Region: "eu-central-1"}},
+			}
+			nodeSpec := apiv1.NodeSpec{
+				Cloud:           apiv1.NodeCloudSpec{AWS: &tc.nodeSpec},
+				OperatingSystem: apiv1.OperatingSystemSpec{Ubuntu: &apiv1.UbuntuSpec{}},
+			}
+
+			config, err := resourcesmachine.GetAWSProviderConfig(cluster, nodeSpec, dc)
+			if err != nil {
+				t.Fatalf("failed to generate provider config: %v", err)
+			}
+			// Tags are extended by the cluster ownership tags, which is not relevant for the round trip.
+			config.Tags = nil
+
+			cloudSpec, err := machine.GetAPIV2NodeCloudSpec(genMachineSpec(t, providerconfig.CloudProviderAWS, config))
+			if err != nil {
+				t.Fatalf("failed to convert machine spec: %v", err)
+			}
+
+			if diff := cmp.Diff(&tc.nodeSpec, cloudSpec.AWS); diff != "" {
+				t.Errorf("AWS node spec did not round-trip (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGCPPreemptibleConversion(t *testing.T) {
+	t.Parallel()
+	for _, preemptible := range []bool{true, false} {
+		nodeSpec := apiv1.GCPNodeSpec{
+			Zone:        "europe-west3-c",
+			MachineType: "a2-highgpu-1g",
+			DiskSize:    50,
+			DiskType:    "pd-standard",
+			Preemptible: preemptible,
+			Labels:      map[string]string{"team": "ml"},
+		}
+		cluster := &kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-abc"},
+			Spec: kubermaticv1.ClusterSpec{
+				Cloud: kubermaticv1.CloudSpec{GCP: &kubermaticv1.GCPCloudSpec{}},
+			},
+		}
+
+		config, err := resourcesmachine.GetGCPProviderConfig(cluster, apiv1.NodeSpec{Cloud: apiv1.NodeCloudSpec{GCP: &nodeSpec}}, &kubermaticv1.Datacenter{})
+		if err != nil {
+			t.Fatalf("failed to generate provider config: %v", err)
+		}
+		// Tags are extended by the cluster ownership tags, which is not relevant for the round trip.
+		config.Tags = nil
+
+		cloudSpec, err := machine.GetAPIV2NodeCloudSpec(genMachineSpec(t, providerconfig.CloudProviderGoogle, config))
+		if err != nil {
+			t.Fatalf("failed to convert machine spec: %v", err)
+		}
+
+		if diff := cmp.Diff(&nodeSpec, cloudSpec.GCP); diff != "" {
+			t.Errorf("GCP node spec with preemptible=%t did not round-trip (-want +got):\n%s", preemptible, diff)
+		}
+	}
+}
+
+func TestGCPCustomMachineTypeConversion(t *testing.T) {
+	t.Parallel()
+	for _, machineType := range []string{"custom-6-20480", "n2-custom-8-16384", "custom-2-32768-ext"} {
+		nodeSpec := apiv1.GCPNodeSpec{
+			Zone:        "europe-west3-c",
+			MachineType: machineType,
+			DiskSize:    50,
+			DiskType:    "pd-standard",
+		}
+		cluster := &kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-abc"},
+			Spec: kubermaticv1.ClusterSpec{
+				Cloud: kubermaticv1.CloudSpec{GCP: &kubermaticv1.GCPCloudSpec{}},
+			},
+		}
+
+		config, err := resourcesmachine.GetGCPProviderConfig(cluster, apiv1.NodeSpec{Cloud: apiv1.NodeCloudSpec{GCP: &nodeSpec}}, &kubermaticv1.Datacenter{})
+		if err != nil {
+			t.Fatalf("failed to generate provider config: %v", err)
+		}
+		config.Tags = nil
+
+		cloudSpec, err := machine.GetAPIV2NodeCloudSpec(genMachineSpec(t, providerconfig.CloudProviderGoogle, config))
+		if err != nil {
+			t.Fatalf("failed to convert machine spec: %v", err)
+		}
+
+		if diff := cmp.Diff(&nodeSpec, cloudSpec.GCP); diff != "" {
+			t.Errorf("GCP node spec with machine type %s did not round-trip (-want +got):\n%s", machineType, diff)
+		}
+	}
+}
@@ -19,42 +19,130 @@ package machine
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 )
 
-var userNameMap = map[string]string{
-	"Digitalocean:Ubuntu":                "root",
-	"Digitalocean:ContainerLinux":        "core",
-	"Digitalocean:Flatcar":               "core",
-	"Hetzner:Ubuntu":                     "root",
-	"Azure:Ubuntu":                       "ubuntu",
-	"Azure:ContainerLinux":               "core",
-	"Azure:Flatcar":                      "core",
-	"Azure:RHEL":                         "rhel",
-	"VSphere:Ubuntu":                     "ubuntu",
-	"VSphere:ContainerLinux":             "core",
-	"VSphere:Flatcar":                    "core",
-	"VSphere:RHEL":                       "cloud-user",
-	"AWS:Ubuntu":                         "ubuntu",
-	"AWS:ContainerLinux":                 "core",
-	"AWS:Flatcar":                        "core",
-	"AWS:RHEL":                           "ec2-user",
-	"Openstack:RHEL":                     "cloud-user",
-	"Openstack:Ubuntu":                   "ubuntu",
-	"Openstack:ContainerLinux":           "core",
-	"Openstack:Flatcar":                  "core",
-	"Packet:Ubuntu":                      "root",
-	"Packet:ContainerLinux":              "core",
-	"Packet:Flatcar":                     "core",
-	"GCP:Ubuntu":                         "ubuntu",
-	"GCP:RHEL":                           "cloud-user",
-	"GCP:ContainerLinux":                 "core",
-	"GCP:Flatcar":                        "core",
-	"VMwareCloudDirector:Ubuntu":         "ubuntu",
-	"VMwareCloudDirector:ContainerLinux": "core",
-	"VMwareCloudDirector:Flatcar":        "core",
-	"VMwareCloudDirector:RHEL":           "cloud-user",
+// UnknownSSHUserName is returned for operating systems which have no known SSH login user on a cloud provider.
+const UnknownSSHUserName = "unknown"
+
+// sshUserNames maps the cloud providers of apiv1.NodeCloudSpec and the operating systems of
+// apiv1.OperatingSystemSpec, both by field name, to the SSH login user of the default images. Every
+// combination is listed, combinations without a default image are explicitly marked as unknown.
+var sshUserNames = map[string]map[string]string{
+	"Digitalocean": {
+		"Ubuntu":      "root",
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        UnknownSSHUserName,
+		"Flatcar":     "core",
+		"RockyLinux":  "root",
+	},
+	"AWS": {
+		"Ubuntu":      "ubuntu",
+		"AmazonLinux": "ec2-user",
+		"RHEL":        "ec2-user",
+		"Flatcar":     "core",
+		"RockyLinux":  "rocky",
+	},
+	"Azure": {
+		"Ubuntu":      "ubuntu",
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        "rhel",
+		"Flatcar":     "core",
+		"RockyLinux":  "rocky",
+	},
+	"Openstack": {
+		"Ubuntu":      "ubuntu",
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        "cloud-user",
+		"Flatcar":     "core",
+		"RockyLinux":  "rocky",
+	},
+	"Packet": {
+		"Ubuntu":      "root",
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        UnknownSSHUserName,
+		"Flatcar":     "core",
+		"RockyLinux":  "root",
+	},
+	"Baremetal": {
+		"Ubuntu":      UnknownSSHUserName,
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        UnknownSSHUserName,
+		"Flatcar":     "core",
+		"RockyLinux":  UnknownSSHUserName,
+	},
+	"Edge": {
+		"Ubuntu":      UnknownSSHUserName,
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        UnknownSSHUserName,
+		"Flatcar":     UnknownSSHUserName,
+		"RockyLinux":  UnknownSSHUserName,
+	},
+	"Hetzner": {
+		"Ubuntu":      "root",
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        UnknownSSHUserName,
+		"Flatcar":     UnknownSSHUserName,
+		"RockyLinux":  "root",
+	},
+	"VSphere": {
+		"Ubuntu":      "ubuntu",
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        "cloud-user",
+		"Flatcar":     "core",
+		"RockyLinux":  "rocky",
+	},
+	"GCP": {
+		"Ubuntu":      "ubuntu",
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        "cloud-user",
+		"Flatcar":     "core",
+		"RockyLinux":  "rocky",
+	},
+	"Kubevirt": {
+		"Ubuntu":      "ubuntu",
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        "cloud-user",
+		"Flatcar":     "core",
+		"RockyLinux":  "rocky",
+	},
+	"Alibaba": {
+		"Ubuntu":      "root",
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        UnknownSSHUserName,
+		"Flatcar":     UnknownSSHUserName,
+		"RockyLinux":  UnknownSSHUserName,
+	},
+	"Anexia": {
+		"Ubuntu":      UnknownSSHUserName,
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        UnknownSSHUserName,
+		"Flatcar":     "core",
+		"RockyLinux":  UnknownSSHUserName,
+	},
+	"Nutanix": {
+		"Ubuntu":      "ubuntu",
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        "cloud-user",
+		"Flatcar":     "core",
+		"RockyLinux":  "rocky",
+	},
+	"OpenNebula": {
+		"Ubuntu":      UnknownSSHUserName,
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        UnknownSSHUserName,
+		"Flatcar":     "core",
+		"RockyLinux":  UnknownSSHUserName,
+	},
+	"VMwareCloudDirector": {
+		"Ubuntu":      "ubuntu",
+		"AmazonLinux": UnknownSSHUserName,
+		"RHEL":        "cloud-user",
+		"Flatcar":     "core",
+		"RockyLinux":  "rocky",
+	},
 }
 
 // GetSSHUserName returns SSH login name for the provider and distribution.
@@ -69,13 +157,41 @@ func GetSSHUserName(distribution *apiv1.OperatingSystemSpec, cloudProvider *apiv
 		return "", err
 	}
 
-	loginName, ok := userNameMap[fmt.Sprintf("%s:%s", providerName, distributionName)]
+	return lookupSSHUserName(providerName, distributionName), nil
+}
+
+// GetSSHUserNames returns the SSH login names of all operating systems for the given cloud provider, e.g. aws or
+// vmware-cloud-director. The operating systems are identified by their name in apiv1.OperatingSystemSpec.
+func GetSSHUserNames(providerName string) (map[string]string, error) {
+	cloudType := reflect.TypeOf(apiv1.NodeCloudSpec{})
+
+	for i := 0; i < cloudType.NumField(); i++ {
+		field := cloudType.Field(i)
+		if jsonName(field) != strings.ReplaceAll(providerName, "-", "") {
+			continue
+		}
+
+		userNames := map[string]string{}
+		osType := reflect.TypeOf(apiv1.OperatingSystemSpec{})
+		for j := 0; j < osType.NumField(); j++ {
+			userNames[jsonName(osType.Field(j))] = lookupSSHUserName(field.Name, osType.Field(j).Name)
+		}
+		return userNames, nil
+	}
 
-	if ok {
-		return loginName, nil
+	return nil, fmt.Errorf("unknown cloud provider %s", providerName)
+}
+
+func lookupSSHUserName(providerName, distributionName string) string {
+	if loginName, ok := sshUserNames[providerName][distributionName]; ok {
+		return loginName
 	}
+	return UnknownSSHUserName
+}
 
-	return "unknown", nil
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	return name
 }
 
 func getDistributionName(distribution *apiv1.OperatingSystemSpec) (string, error) {
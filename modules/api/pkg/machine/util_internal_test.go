@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"reflect"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+)
+
+// TestSSHUserNamesComplete ensures that every operating system has an explicit SSH login name for every cloud
+// provider, so that new fields in the specs can not silently fall back to unknown.
+func TestSSHUserNamesComplete(t *testing.T) {
+	t.Parallel()
+
+	cloudType := reflect.TypeOf(apiv1.NodeCloudSpec{})
+	osType := reflect.TypeOf(apiv1.OperatingSystemSpec{})
+
+	for i := 0; i < cloudType.NumField(); i++ {
+		providerName := cloudType.Field(i).Name
+		userNames, ok := sshUserNames[providerName]
+		if !ok {
+			t.Errorf("no SSH login names defined for cloud provider %s", providerName)
+			continue
+		}
+
+		for j := 0; j < osType.NumField(); j++ {
+			distributionName := osType.Field(j).Name
+			if userName, ok := userNames[distributionName]; !ok || userName == "" {
+				t.Errorf("no SSH login name defined for %s on %s, use %q if there is none", distributionName, providerName, UnknownSSHUserName)
+			}
+		}
+
+		if len(userNames) != osType.NumField() {
+			t.Errorf("SSH login names for %s contain operating systems which are not part of the operating system spec: %v", providerName, userNames)
+		}
+	}
+
+	if len(sshUserNames) != cloudType.NumField() {
+		t.Errorf("SSH login names contain cloud providers which are not part of the node cloud spec")
+	}
+}
+
+// TestGetSSHUserNameForAllSpecs sets every field of the specs via reflection to ensure the field names used by
+// GetSSHUserName match the SSH login names.
+func TestGetSSHUserNameForAllSpecs(t *testing.T) {
+	t.Parallel()
+
+	for i := 0; i < reflect.TypeOf(apiv1.NodeCloudSpec{}).NumField(); i++ {
+		for j := 0; j < reflect.TypeOf(apiv1.OperatingSystemSpec{}).NumField(); j++ {
+			cloudSpec := &apiv1.NodeCloudSpec{}
+			cloudField := reflect.ValueOf(cloudSpec).Elem().Field(i)
+			cloudField.Set(reflect.New(cloudField.Type().Elem()))
+
+			osSpec := &apiv1.OperatingSystemSpec{}
+			osField := reflect.ValueOf(osSpec).Elem().Field(j)
+			osField.Set(reflect.New(osField.Type().Elem()))
+
+			providerName := reflect.TypeOf(*cloudSpec).Field(i).Name
+			distributionName := reflect.TypeOf(*osSpec).Field(j).Name
+
+			userName, err := GetSSHUserName(osSpec, cloudSpec)
+			if err != nil {
+				t.Fatalf("failed to get SSH login name for %s on %s: %v", distributionName, providerName, err)
+			}
+			if expected := sshUserNames[providerName][distributionName]; userName != expected {
+				t.Errorf("expected SSH login name %q for %s on %s, got %q", expected, distributionName, providerName, userName)
+			}
+		}
+	}
+}
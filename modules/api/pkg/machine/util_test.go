@@ -17,6 +17,7 @@ limitations under the License.
 package machine_test
 
 import (
+	"reflect"
 	"testing"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
@@ -42,6 +43,36 @@ func TestCredentialEndpoint(t *testing.T) {
 			},
 			expectedResult: "ubuntu",
 		},
+		{
+			name: "test SSH login name for AWS:AmazonLinux",
+			distribution: &apiv1.OperatingSystemSpec{
+				AmazonLinux: &apiv1.AmazonLinuxSpec{},
+			},
+			cloudProvider: &apiv1.NodeCloudSpec{
+				AWS: &apiv1.AWSNodeSpec{},
+			},
+			expectedResult: "ec2-user",
+		},
+		{
+			name: "test SSH login name for Openstack:RockyLinux",
+			distribution: &apiv1.OperatingSystemSpec{
+				RockyLinux: &apiv1.RockyLinuxSpec{},
+			},
+			cloudProvider: &apiv1.NodeCloudSpec{
+				Openstack: &apiv1.OpenstackNodeSpec{},
+			},
+			expectedResult: "rocky",
+		},
+		{
+			name: "test SSH login name for Edge:Ubuntu",
+			distribution: &apiv1.OperatingSystemSpec{
+				Ubuntu: &apiv1.UbuntuSpec{},
+			},
+			cloudProvider: &apiv1.NodeCloudSpec{
+				Edge: &apiv1.EdgeNodeSpec{},
+			},
+			expectedResult: machine.UnknownSSHUserName,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -56,3 +87,53 @@ func TestCredentialEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSSHUserNames(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		name           string
+		providerName   string
+		expectedResult map[string]string
+		expectedError  bool
+	}{
+		{
+			name:         "test SSH login names for aws",
+			providerName: "aws",
+			expectedResult: map[string]string{
+				"ubuntu":     "ubuntu",
+				"amzn2":      "ec2-user",
+				"rhel":       "ec2-user",
+				"flatcar":    "core",
+				"rockylinux": "rocky",
+			},
+		},
+		{
+			name:         "test SSH login names for vmware-cloud-director",
+			providerName: "vmware-cloud-director",
+			expectedResult: map[string]string{
+				"ubuntu":     "ubuntu",
+				"amzn2":      machine.UnknownSSHUserName,
+				"rhel":       "cloud-user",
+				"flatcar":    "core",
+				"rockylinux": "rocky",
+			},
+		},
+		{
+			name:          "test SSH login names for an unknown provider",
+			providerName:  "bringyourown",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := machine.GetSSHUserNames(tc.providerName)
+			if (err != nil) != tc.expectedError {
+				t.Fatalf("expected error: %v, got %v", tc.expectedError, err)
+			}
+			if !reflect.DeepEqual(tc.expectedResult, result) {
+				t.Fatalf("expected %v got %v", tc.expectedResult, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ListAvailabilityZones returns the availability zones of the location in which VMs of the given size can be created.
+func ListAvailabilityZones(ctx context.Context, credentials Credentials, location, size string) ([]string, error) {
+	cred, err := credentials.ToAzureCredential()
+	if err != nil {
+		return nil, err
+	}
+
+	skusClient, err := armcompute.NewResourceSKUsClient(credentials.SubscriptionID, cred, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := fmt.Sprintf("location eq '%s'", location)
+	pager := skusClient.NewListPager(&armcompute.ResourceSKUsClientListOptions{Filter: &filter})
+
+	skus := []armcompute.ResourceSKU{}
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list SKU resource: %w", err)
+		}
+
+		for i := range page.Value {
+			skus = append(skus, *page.Value[i])
+		}
+	}
+
+	return AvailabilityZones(skus, location, size), nil
+}
+
+// AvailabilityZones returns the sorted availability zones of the location in which the VM size is offered. Zones
+// which are restricted for the subscription are left out. Nil is returned if the size is not zonal in the location.
+func AvailabilityZones(skus []armcompute.ResourceSKU, location, size string) []string {
+	for _, sku := range skus {
+		if sku.Name == nil || *sku.Name != size {
+			continue
+		}
+		if sku.ResourceType != nil && *sku.ResourceType != "virtualMachines" {
+			continue
+		}
+
+		zones := sets.New[string]()
+		for _, info := range sku.LocationInfo {
+			if info.Location == nil || !strings.EqualFold(*info.Location, location) {
+				continue
+			}
+			for _, zone := range info.Zones {
+				zones.Insert(*zone)
+			}
+		}
+
+		for _, restriction := range sku.Restrictions {
+			if restriction.Type == nil || *restriction.Type != armcompute.ResourceSKURestrictionsTypeZone || restriction.RestrictionInfo == nil {
+				continue
+			}
+			for _, zone := range restriction.RestrictionInfo.Zones {
+				zones.Delete(*zone)
+			}
+		}
+
+		if zones.Len() == 0 {
+			return nil
+		}
+
+		return sets.List(zones)
+	}
+
+	return nil
+}
+
+// ValidateAvailabilityZones checks that all zones are in the available zones of the VM size.
+func ValidateAvailabilityZones(zones, available []string, location, size string) error {
+	if len(zones) == 0 {
+		return nil
+	}
+	if len(available) == 0 {
+		return fmt.Errorf("VM size %q does not support availability zones in location %q", size, location)
+	}
+
+	availableZones := sets.New(available...)
+	for _, zone := range zones {
+		if !availableZones.Has(zone) {
+			return fmt.Errorf("availability zone %q is not available for VM size %q in location %q, available zones are %v", zone, size, location, available)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestAvailabilityZones(t *testing.T) {
+	skus := []armcompute.ResourceSKU{
+		{
+			Name:         ptr.To("Standard_D2s_v3"),
+			ResourceType: ptr.To("disks"),
+			LocationInfo: []*armcompute.ResourceSKULocationInfo{
+				{Location: ptr.To("westeurope"), Zones: []*string{ptr.To("1")}},
+			},
+		},
+		{
+			Name:         ptr.To("Standard_D2s_v3"),
+			ResourceType: ptr.To("virtualMachines"),
+			LocationInfo: []*armcompute.ResourceSKULocationInfo{
+				{Location: ptr.To("WestEurope"), Zones: []*string{ptr.To("3"), ptr.To("1"), ptr.To("2")}},
+			},
+		},
+		{
+			Name:         ptr.To("Standard_NC6"),
+			ResourceType: ptr.To("virtualMachines"),
+			LocationInfo: []*armcompute.ResourceSKULocationInfo{
+				{Location: ptr.To("westeurope"), Zones: []*string{ptr.To("1"), ptr.To("2"), ptr.To("3")}},
+			},
+			Restrictions: []*armcompute.ResourceSKURestrictions{
+				{
+					Type:            ptr.To(armcompute.ResourceSKURestrictionsTypeZone),
+					ReasonCode:      ptr.To(armcompute.ResourceSKURestrictionsReasonCodeNotAvailableForSubscription),
+					RestrictionInfo: &armcompute.ResourceSKURestrictionInfo{Zones: []*string{ptr.To("2")}},
+				},
+			},
+		},
+		{
+			Name:         ptr.To("Standard_A1"),
+			ResourceType: ptr.To("virtualMachines"),
+			LocationInfo: []*armcompute.ResourceSKULocationInfo{
+				{Location: ptr.To("westeurope")},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		location string
+		size     string
+		want     []string
+	}{
+		{
+			name:     "list zones of the VM size",
+			location: "westeurope",
+			size:     "Standard_D2s_v3",
+			want:     []string{"1", "2", "3"},
+		},
+		{
+			name:     "leave out restricted zones",
+			location: "westeurope",
+			size:     "Standard_NC6",
+			want:     []string{"1", "3"},
+		},
+		{
+			name:     "return no zones for a non-zonal VM size",
+			location: "westeurope",
+			size:     "Standard_A1",
+		},
+		{
+			name:     "return no zones for another location",
+			location: "northeurope",
+			size:     "Standard_D2s_v3",
+		},
+		{
+			name:     "return no zones for an unknown VM size",
+			location: "westeurope",
+			size:     "Standard_B1s",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AvailabilityZones(skus, tt.location, tt.size); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AvailabilityZones() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateAvailabilityZones(t *testing.T) {
+	tests := []struct {
+		name      string
+		zones     []string
+		available []string
+		wantErr   bool
+	}{
+		{
+			name:      "accept available zones",
+			zones:     []string{"1", "3"},
+			available: []string{"1", "2", "3"},
+		},
+		{
+			name: "accept no zones for a non-zonal VM size",
+		},
+		{
+			name:      "reject an unavailable zone",
+			zones:     []string{"1", "4"},
+			available: []string{"1", "2", "3"},
+			wantErr:   true,
+		},
+		{
+			name:    "reject zones for a non-zonal VM size",
+			zones:   []string{"1"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAvailabilityZones(tt.zones, tt.available, "westeurope", "Standard_D2s_v3")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAvailabilityZones() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
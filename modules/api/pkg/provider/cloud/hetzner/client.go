@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hetzner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+)
+
+// Client lists the networks and firewalls of a Hetzner project, which node deployments can reference.
+type Client interface {
+	ListNetworks(ctx context.Context) (apiv1.HetznerNetworkList, error)
+	ListFirewalls(ctx context.Context) (apiv1.HetznerFirewallList, error)
+}
+
+type client struct {
+	hcloud *hcloud.Client
+}
+
+// NewClient creates a client for the Hetzner API with the given token.
+func NewClient(token string) Client {
+	return &client{hcloud: hcloud.NewClient(hcloud.WithToken(token))}
+}
+
+func (c *client) ListNetworks(ctx context.Context) (apiv1.HetznerNetworkList, error) {
+	networks, err := c.hcloud.Network.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networks: %w", err)
+	}
+
+	networkList := apiv1.HetznerNetworkList{}
+	for _, network := range networks {
+		n := apiv1.HetznerNetwork{
+			ID:   network.ID,
+			Name: network.Name,
+		}
+		if network.IPRange != nil {
+			n.IPRange = network.IPRange.String()
+		}
+		networkList = append(networkList, n)
+	}
+
+	return networkList, nil
+}
+
+func (c *client) ListFirewalls(ctx context.Context) (apiv1.HetznerFirewallList, error) {
+	firewalls, err := c.hcloud.Firewall.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list firewalls: %w", err)
+	}
+
+	firewallList := apiv1.HetznerFirewallList{}
+	for _, firewall := range firewalls {
+		firewallList = append(firewallList, apiv1.HetznerFirewall{
+			ID:   firewall.ID,
+			Name: firewall.Name,
+		})
+	}
+
+	return firewallList, nil
+}
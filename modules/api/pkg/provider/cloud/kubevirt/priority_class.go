@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubevirt
+
+import (
+	"context"
+	"sort"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListPriorityClasses returns the priority classes of the infra cluster sorted by name.
+func ListPriorityClasses(ctx context.Context, client ctrlruntimeclient.Client) (apiv2.KubeVirtPriorityClassList, error) {
+	priorityClassList := schedulingv1.PriorityClassList{}
+	if err := client.List(ctx, &priorityClassList); err != nil {
+		return nil, err
+	}
+
+	res := apiv2.KubeVirtPriorityClassList{}
+	for _, pc := range priorityClassList.Items {
+		res = append(res, apiv2.KubeVirtPriorityClass{Name: pc.Name, Value: pc.Value})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return res, nil
+}
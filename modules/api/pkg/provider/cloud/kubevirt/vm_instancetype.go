@@ -17,10 +17,14 @@ limitations under the License.
 package kubevirt
 
 import (
+	"context"
+
 	kvinstancetypev1alpha1 "kubevirt.io/api/instancetype/v1alpha1"
 
 	kvmanifests "k8c.io/dashboard/v2/pkg/provider/cloud/kubevirt/manifests"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -33,3 +37,24 @@ func GetKubermaticStandardInstancetypes(client ctrlruntimeclient.Client, getter
 	}
 	return instancetypes
 }
+
+// ListInstancetypeNames returns the sorted names of the custom (cluster-wide) VirtualMachineClusterInstancetypes
+// and, unless they are disabled for the datacenter, of the Kubermatic standard VirtualMachineInstancetypes.
+func ListInstancetypeNames(ctx context.Context, client ctrlruntimeclient.Client, datacenter *kubermaticv1.Datacenter) ([]string, error) {
+	customInstancetypes := kvinstancetypev1alpha1.VirtualMachineClusterInstancetypeList{}
+	if err := client.List(ctx, &customInstancetypes); err != nil {
+		return nil, err
+	}
+
+	names := sets.New[string]()
+	for _, it := range customInstancetypes.Items {
+		names.Insert(it.Name)
+	}
+	if datacenter.Spec.Kubevirt != nil && !datacenter.Spec.Kubevirt.DisableDefaultInstanceTypes {
+		for _, it := range GetKubermaticStandardInstancetypes(client, &kvmanifests.StandardInstancetypeGetter{}) {
+			names.Insert(it.Name)
+		}
+	}
+
+	return sets.List(names), nil
+}
@@ -17,10 +17,14 @@ limitations under the License.
 package kubevirt
 
 import (
+	"context"
+
 	kvinstancetypev1alpha1 "kubevirt.io/api/instancetype/v1alpha1"
 
 	kvmanifests "k8c.io/dashboard/v2/pkg/provider/cloud/kubevirt/manifests"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -33,3 +37,24 @@ func GetKubermaticStandardPreferences(client ctrlruntimeclient.Client, getter kv
 	}
 	return preferences
 }
+
+// ListPreferenceNames returns the sorted names of the custom (cluster-wide) VirtualMachineClusterPreferences
+// and, unless they are disabled for the datacenter, of the Kubermatic standard VirtualMachinePreferences.
+func ListPreferenceNames(ctx context.Context, client ctrlruntimeclient.Client, datacenter *kubermaticv1.Datacenter) ([]string, error) {
+	customPreferences := kvinstancetypev1alpha1.VirtualMachineClusterPreferenceList{}
+	if err := client.List(ctx, &customPreferences); err != nil {
+		return nil, err
+	}
+
+	names := sets.New[string]()
+	for _, p := range customPreferences.Items {
+		names.Insert(p.Name)
+	}
+	if datacenter.Spec.Kubevirt != nil && !datacenter.Spec.Kubevirt.DisableDefaultPreferences {
+		for _, p := range GetKubermaticStandardPreferences(client, &kvmanifests.StandardPreferenceGetter{}) {
+			names.Insert(p.Name)
+		}
+	}
+
+	return sets.List(names), nil
+}
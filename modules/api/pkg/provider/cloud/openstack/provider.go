@@ -23,12 +23,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 
 	"github.com/gophercloud/gophercloud"
 	goopenstack "github.com/gophercloud/gophercloud/openstack"
 	osavailabilityzones "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
 	ossservergroups "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
 	osflavors "github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	osservers "github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	osprojects "github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
 	ossecuritygroups "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	osecuritygrouprules "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
@@ -169,6 +171,69 @@ func GetSeverGroups(ctx context.Context, authURL, region string, credentials *re
 	return groups, nil
 }
 
+var (
+	// ErrServerGroupNotFound is returned by EnsureServerGroup if the server group does not exist and should not be created.
+	ErrServerGroupNotFound = errors.New("server group not found")
+	// ErrServerGroupNameNotUnique is returned by EnsureServerGroup if several server groups have the given name.
+	ErrServerGroupNameNotUnique = errors.New("server group name is not unique, please use the ID instead")
+)
+
+// EnsureServerGroup returns the server group with the given name or ID. If it does not exist and create is set,
+// a server group with that name and the anti-affinity policy is created, so that the VMs using it are spread across
+// hypervisors.
+func EnsureServerGroup(ctx context.Context, authURL, region string, credentials *resources.OpenstackCredentials, caBundle *x509.CertPool, nameOrID string, create bool) (*ossservergroups.ServerGroup, error) {
+	computeClient, err := getComputeClient(ctx, authURL, region, credentials, caBundle)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get auth client: %w", err)
+	}
+
+	page, err := ossservergroups.List(computeClient, ossservergroups.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list server groups: %w", err)
+	}
+	groups, err := ossservergroups.ExtractServerGroups(page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract server groups: %w", err)
+	}
+
+	group, err := findServerGroup(groups, nameOrID)
+	if !errors.Is(err, ErrServerGroupNotFound) || !create {
+		return group, err
+	}
+
+	group, err = ossservergroups.Create(computeClient, ossservergroups.CreateOpts{
+		Name:     nameOrID,
+		Policies: []string{"anti-affinity"},
+	}).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create server group %q: %w", nameOrID, err)
+	}
+	return group, nil
+}
+
+// findServerGroup returns the server group with the given ID or, if there is none, the one with the given name.
+// Names are not unique in OpenStack, so an error is returned if several server groups share the name.
+func findServerGroup(groups []ossservergroups.ServerGroup, nameOrID string) (*ossservergroups.ServerGroup, error) {
+	var found []ossservergroups.ServerGroup
+	for _, group := range groups {
+		if group.ID == nameOrID {
+			return &group, nil
+		}
+		if group.Name == nameOrID {
+			found = append(found, group)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("%w: %q", ErrServerGroupNotFound, nameOrID)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("%w: found %d server groups named %q", ErrServerGroupNameNotUnique, len(found), nameOrID)
+	}
+}
+
 // GetAvailabilityZones lists availability zones for the given CloudSpec.DatacenterName and OpenstackSpec.Region.
 func GetAvailabilityZones(ctx context.Context, authURL, region string, credentials *resources.OpenstackCredentials, caBundle *x509.CertPool) ([]osavailabilityzones.AvailabilityZone, error) {
 	computeClient, err := getComputeClient(ctx, authURL, region, credentials, caBundle)
@@ -284,6 +349,29 @@ func getComputeClient(ctx context.Context, authURL, region string, credentials *
 	return serviceClient, err
 }
 
+// GetServerConsoleOutput returns the console output of the server with the given name.
+func GetServerConsoleOutput(ctx context.Context, authURL, region, serverName string, credentials *resources.OpenstackCredentials, caBundle *x509.CertPool) (string, error) {
+	computeClient, err := getComputeClient(ctx, authURL, region, credentials, caBundle)
+	if err != nil {
+		return "", fmt.Errorf("couldn't get compute client: %w", err)
+	}
+
+	// the name filter is a regular expression
+	allPages, err := osservers.List(computeClient, osservers.ListOpts{Name: fmt.Sprintf("^%s$", regexp.QuoteMeta(serverName))}).AllPages()
+	if err != nil {
+		return "", fmt.Errorf("failed to list servers: %w", err)
+	}
+	servers, err := osservers.ExtractServers(allPages)
+	if err != nil {
+		return "", fmt.Errorf("failed to extract servers: %w", err)
+	}
+	if len(servers) == 0 {
+		return "", fmt.Errorf("server %s not found", serverName)
+	}
+
+	return osservers.ShowConsoleOutput(computeClient, servers[0].ID, osservers.ShowConsoleOutputOpts{}).Extract()
+}
+
 // GetSubnets list all available subnet ids for a given CloudSpec.
 func GetSubnets(ctx context.Context, authURL, region, networkID string, credentials *resources.OpenstackCredentials, caBundle *x509.CertPool) ([]ossubnets.Subnet, error) {
 	serviceClient, err := getNetClient(ctx, authURL, region, credentials, caBundle)
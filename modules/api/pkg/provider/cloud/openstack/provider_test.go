@@ -17,9 +17,12 @@ limitations under the License.
 package openstack
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
+	ossservergroups "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/test"
@@ -146,3 +149,56 @@ func TestGetCredentialsForCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestFindServerGroup(t *testing.T) {
+	groups := []ossservergroups.ServerGroup{
+		{ID: "0c5e3fb6-5ae7-4c35-9b2c-e1f0f2b1d5a1", Name: "workers"},
+		{ID: "5b7a2c1e-0d4f-4e0b-8f3a-9c6d2e1f7a42", Name: "masters"},
+		{ID: "8d3f6a2b-1c4e-4f5a-9b7c-2e8d1f0a6c33", Name: "masters"},
+	}
+
+	tests := []struct {
+		name     string
+		nameOrID string
+		wantID   string
+		wantErr  error
+	}{
+		{
+			name:     "find server group by ID",
+			nameOrID: "5b7a2c1e-0d4f-4e0b-8f3a-9c6d2e1f7a42",
+			wantID:   "5b7a2c1e-0d4f-4e0b-8f3a-9c6d2e1f7a42",
+		},
+		{
+			name:     "find server group by name",
+			nameOrID: "workers",
+			wantID:   "0c5e3fb6-5ae7-4c35-9b2c-e1f0f2b1d5a1",
+		},
+		{
+			name:     "reject ambiguous server group name",
+			nameOrID: "masters",
+			wantErr:  ErrServerGroupNameNotUnique,
+		},
+		{
+			name:     "report missing server group",
+			nameOrID: "gpu-workers",
+			wantErr:  ErrServerGroupNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findServerGroup(groups, tt.nameOrID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("findServerGroup() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("findServerGroup() unexpected error = %v", err)
+			}
+			if got.ID != tt.wantID {
+				t.Errorf("findServerGroup() got ID = %s, want %s", got.ID, tt.wantID)
+			}
+		})
+	}
+}
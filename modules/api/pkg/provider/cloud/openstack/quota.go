@@ -0,0 +1,142 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	goopenstack "github.com/gophercloud/gophercloud/openstack"
+	osblockstoragequotasets "github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+	oscomputequotasets "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/quotasets"
+	ostokens "github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	osnetworkingquotas "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+)
+
+// QuotaUsage is the usage and the limit of a single resource. A limit of -1 means the resource is unlimited.
+type QuotaUsage struct {
+	InUse int
+	Limit int
+}
+
+// Quotas contains the usage of the project resources which are relevant for cluster nodes.
+// Volume and floating IP usages are only set if the block storage and the network services are available.
+type Quotas struct {
+	Instances   QuotaUsage
+	Cores       QuotaUsage
+	Volumes     *QuotaUsage
+	Gigabytes   *QuotaUsage
+	FloatingIPs *QuotaUsage
+}
+
+// GetQuotas returns the quota usage of the project the credentials are scoped to.
+func GetQuotas(ctx context.Context, authURL, region string, credentials *resources.OpenstackCredentials, caBundle *x509.CertPool) (*Quotas, error) {
+	authClient, err := getAuthClient(authURL, credentials, caBundle)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get auth client: %w", err)
+	}
+
+	projectID, err := getAuthProjectID(authClient, credentials, region)
+	if err != nil {
+		return nil, err
+	}
+
+	computeClient, err := newServiceClient(ctx, authClient, region, goopenstack.NewComputeV2)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get compute client: %w", err)
+	}
+
+	computeQuotas, err := oscomputequotasets.GetDetail(computeClient, projectID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get compute quotas: %w", err)
+	}
+
+	quotas := &Quotas{
+		Instances: QuotaUsage{InUse: computeQuotas.Instances.InUse, Limit: computeQuotas.Instances.Limit},
+		Cores:     QuotaUsage{InUse: computeQuotas.Cores.InUse, Limit: computeQuotas.Cores.Limit},
+	}
+
+	blockStorageClient, err := newServiceClient(ctx, authClient, region, goopenstack.NewBlockStorageV3)
+	switch {
+	case err == nil:
+		blockStorageQuotas, err := osblockstoragequotasets.GetUsage(blockStorageClient, projectID).Extract()
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get block storage quotas: %w", err)
+		}
+		quotas.Volumes = &QuotaUsage{InUse: blockStorageQuotas.Volumes.InUse, Limit: blockStorageQuotas.Volumes.Limit}
+		quotas.Gigabytes = &QuotaUsage{InUse: blockStorageQuotas.Gigabytes.InUse, Limit: blockStorageQuotas.Gigabytes.Limit}
+	case !isEndpointNotFoundErr(err):
+		return nil, fmt.Errorf("couldn't get block storage client: %w", err)
+	}
+
+	netClient, err := newServiceClient(ctx, authClient, region, goopenstack.NewNetworkV2)
+	switch {
+	case err == nil:
+		networkQuotas, err := osnetworkingquotas.GetDetail(netClient, projectID).Extract()
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get network quotas: %w", err)
+		}
+		quotas.FloatingIPs = &QuotaUsage{InUse: networkQuotas.FloatingIP.Used, Limit: networkQuotas.FloatingIP.Limit}
+	case !isEndpointNotFoundErr(err):
+		return nil, fmt.Errorf("couldn't get network client: %w", err)
+	}
+
+	return quotas, nil
+}
+
+// getAuthProjectID returns the ID of the project the credentials are scoped to. Application credentials
+// do not carry the project, so it is taken from the token in that case.
+func getAuthProjectID(authClient *gophercloud.ProviderClient, credentials *resources.OpenstackCredentials, region string) (string, error) {
+	if credentials.ProjectID != "" {
+		return credentials.ProjectID, nil
+	}
+
+	if credentials.Project != "" {
+		project, err := getProjectByName(authClient, credentials.Project, region)
+		if err != nil {
+			return "", err
+		}
+		return project.ID, nil
+	}
+
+	if result, ok := authClient.GetAuthResult().(ostokens.CreateResult); ok {
+		project, err := result.ExtractProject()
+		if err == nil && project != nil {
+			return project.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("couldn't determine the project of the credentials")
+}
+
+func newServiceClient(ctx context.Context, authClient *gophercloud.ProviderClient, region string, newClient func(*gophercloud.ProviderClient, gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error)) (*gophercloud.ServiceClient, error) {
+	serviceClient, err := newClient(authClient, gophercloud.EndpointOpts{Region: region})
+	// this is special case for services that span only one region.
+	if isEndpointNotFoundErr(err) {
+		serviceClient, err = newClient(authClient, gophercloud.EndpointOpts{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	serviceClient.Context = ctx
+	return serviceClient, nil
+}
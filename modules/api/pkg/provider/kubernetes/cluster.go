@@ -26,6 +26,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	k8cuserclusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
@@ -108,11 +109,34 @@ type ClusterProvider struct {
 	seedKubeconfig       *restclient.Config
 	versions             kubermatic.Versions
 	seed                 *kubermaticv1.Seed
+
+	// listCache is an optional cache for the cluster lists of the projects.
+	listCache *ClusterListCache
 }
 
 var _ provider.ClusterProvider = &ClusterProvider{}
 var _ provider.PrivilegedClusterProvider = &ClusterProvider{}
 
+// WithListCache configures the provider to cache the cluster lists of the projects in the given cache.
+func (p *ClusterProvider) WithListCache(cache *ClusterListCache) *ClusterProvider {
+	p.listCache = cache
+	return p
+}
+
+func (p *ClusterProvider) seedName() string {
+	if p.seed == nil {
+		return ""
+	}
+	return p.seed.Name
+}
+
+// invalidateListCache removes the cached cluster list of the given project, if the cache is enabled.
+func (p *ClusterProvider) invalidateListCache(projectID string) {
+	if p.listCache != nil {
+		p.listCache.Invalidate(p.seedName(), projectID)
+	}
+}
+
 // New creates a brand new cluster that is bound to the given project.
 //
 // Note that the admin privileges are used to set the cluster status.
@@ -134,6 +158,8 @@ func (p *ClusterProvider) New(ctx context.Context, project *kubermaticv1.Project
 	if err := seedImpersonatedClient.Create(ctx, newCluster); err != nil {
 		return nil, err
 	}
+	// invalidate once the new cluster is visible in the seed client cache
+	defer p.invalidateListCache(project.Name)
 
 	if err := p.waitForCluster(ctx, seedImpersonatedClient, newCluster); err != nil {
 		return nil, fmt.Errorf("failed waiting for the new cluster to appear in the cache: %w", err)
@@ -168,6 +194,8 @@ func (p *ClusterProvider) NewUnsecured(ctx context.Context, project *kubermaticv
 	if err != nil {
 		return nil, err
 	}
+	// invalidate once the new cluster is visible in the seed client cache
+	defer p.invalidateListCache(project.Name)
 
 	if err := p.waitForCluster(ctx, p.client, newCluster); err != nil {
 		return nil, fmt.Errorf("failed waiting for the new cluster to appear in the cache: %w", err)
@@ -240,13 +268,29 @@ func (p *ClusterProvider) List(ctx context.Context, project *kubermaticv1.Projec
 		return nil, errors.New("project is missing but required")
 	}
 
-	projectClusters := &kubermaticv1.ClusterList{}
-	selector := labels.SelectorFromSet(map[string]string{kubermaticv1.ProjectIDLabelKey: project.Name})
-	listOpts := &ctrlruntimeclient.ListOptions{LabelSelector: selector}
-	if err := p.client.List(ctx, projectClusters, listOpts); err != nil {
+	listProjectClusters := func(ctx context.Context) (*kubermaticv1.ClusterList, error) {
+		projectClusters := &kubermaticv1.ClusterList{}
+		selector := labels.SelectorFromSet(map[string]string{kubermaticv1.ProjectIDLabelKey: project.Name})
+		listOpts := &ctrlruntimeclient.ListOptions{LabelSelector: selector}
+		if err := p.client.List(ctx, projectClusters, listOpts); err != nil {
+			return nil, err
+		}
+		return projectClusters, nil
+	}
+
+	var (
+		projectClusters *kubermaticv1.ClusterList
+		err             error
+	)
+	if p.listCache != nil {
+		projectClusters, err = p.listCache.List(ctx, p.seedName(), project.Name, listProjectClusters)
+	} else {
+		projectClusters, err = listProjectClusters(ctx)
+	}
+	if err != nil {
 		// ignore error if cluster is unreachable
 		if kubenetutil.IsConnectionRefused(err) {
-			return projectClusters, nil
+			return &kubermaticv1.ClusterList{}, nil
 		}
 		return nil, fmt.Errorf("failed to list clusters: %w", err)
 	}
@@ -288,11 +332,11 @@ func (p *ClusterProvider) Get(ctx context.Context, userInfo *provider.UserInfo,
 			if !healthCheck.ControlPlaneHealthy() ||
 				healthCheck.CloudProviderInfrastructure != kubermaticv1.HealthStatusUp ||
 				healthCheck.UserClusterControllerManager != kubermaticv1.HealthStatusUp {
-				return nil, apierrors.NewServiceUnavailable("Cluster components are not ready yet")
+				return nil, errClusterNotReady()
 			}
 		} else {
 			if !healthCheck.AllHealthy() {
-				return nil, apierrors.NewServiceUnavailable("Cluster components are not ready yet")
+				return nil, errClusterNotReady()
 			}
 		}
 	}
@@ -321,7 +365,12 @@ func (p *ClusterProvider) Delete(ctx context.Context, userInfo *provider.UserInf
 	delOpts := &ctrlruntimeclient.DeleteOptions{
 		PropagationPolicy: &policy,
 	}
-	return seedImpersonatedClient.Delete(ctx, cluster, delOpts)
+	if err := seedImpersonatedClient.Delete(ctx, cluster, delOpts); err != nil {
+		return err
+	}
+
+	p.invalidateListCache(cluster.Labels[kubermaticv1.ProjectIDLabelKey])
+	return nil
 }
 
 // Update updates a cluster.
@@ -335,6 +384,8 @@ func (p *ClusterProvider) Update(ctx context.Context, project *kubermaticv1.Proj
 	if err := seedImpersonatedClient.Update(ctx, newCluster); err != nil {
 		return nil, err
 	}
+
+	p.invalidateListCache(project.Name)
 	return newCluster, nil
 }
 
@@ -448,6 +499,24 @@ func (p *ClusterProvider) GetClientForUserCluster(ctx context.Context, userInfo
 	return p.userClusterConnProvider.GetClient(ctx, c, p.withImpersonation(userInfo))
 }
 
+// GetWatchClientForUserCluster returns a client which can also watch the resources in the given cluster
+//
+// Note that the client authn/authz as userInfo(email, group) like GetClientForUserCluster, unless the user is an admin.
+// The client is not cached, it should only be used for long-running watches.
+func (p *ClusterProvider) GetWatchClientForUserCluster(ctx context.Context, userInfo *provider.UserInfo, c *kubermaticv1.Cluster) (ctrlruntimeclient.WithWatch, error) {
+	var options []k8cuserclusterclient.ConfigOption
+	if !userInfo.IsAdmin {
+		options = append(options, p.withImpersonation(userInfo))
+	}
+
+	cfg, err := p.userClusterConnProvider.GetClientConfig(ctx, c, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ctrlruntimeclient.NewWithWatch(cfg, ctrlruntimeclient.Options{})
+}
+
 func (p *ClusterProvider) GetTokenForUserCluster(ctx context.Context, userInfo *provider.UserInfo, cluster *kubermaticv1.Cluster) (string, error) {
 	if userInfo.Roles.Has("viewers") && userInfo.Roles.Len() == 1 {
 		s := &corev1.Secret{}
@@ -512,11 +581,11 @@ func (p *ClusterProvider) GetUnsecured(ctx context.Context, project *kubermaticv
 				if !healthCheck.ControlPlaneHealthy() ||
 					healthCheck.CloudProviderInfrastructure != kubermaticv1.HealthStatusUp ||
 					healthCheck.UserClusterControllerManager != kubermaticv1.HealthStatusUp {
-					return nil, apierrors.NewServiceUnavailable("Cluster components are not ready yet")
+					return nil, errClusterNotReady()
 				}
 			} else {
 				if !healthCheck.AllHealthy() {
-					return nil, apierrors.NewServiceUnavailable("Cluster components are not ready yet")
+					return nil, errClusterNotReady()
 				}
 			}
 		}
@@ -539,6 +608,8 @@ func (p *ClusterProvider) UpdateUnsecured(ctx context.Context, project *kubermat
 	if err != nil {
 		return nil, err
 	}
+
+	p.invalidateListCache(project.Name)
 	return cluster, nil
 }
 
@@ -552,7 +623,12 @@ func (p *ClusterProvider) DeleteUnsecured(ctx context.Context, cluster *kubermat
 	delOpts := &ctrlruntimeclient.DeleteOptions{
 		PropagationPolicy: &policy,
 	}
-	return p.client.Delete(ctx, cluster, delOpts)
+	if err := p.client.Delete(ctx, cluster, delOpts); err != nil {
+		return err
+	}
+
+	p.invalidateListCache(cluster.Labels[kubermaticv1.ProjectIDLabelKey])
+	return nil
 }
 
 // SeedAdminConfig return an admin kubeconfig for the seed. This function does not perform any kind
@@ -584,3 +660,9 @@ func (p *ClusterProvider) ListAll(ctx context.Context, labelSelector labels.Sele
 func (p *ClusterProvider) GetSeedName() string {
 	return p.seed.Name
 }
+
+// errClusterNotReady is returned if the cluster is requested with CheckInitStatus and not all of its components
+// are healthy yet.
+func errClusterNotReady() error {
+	return common.WithReason(common.ReasonClusterNotReady, apierrors.NewServiceUnavailable("Cluster components are not ready yet"))
+}
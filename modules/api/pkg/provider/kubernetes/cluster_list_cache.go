@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+// DefaultClusterListCacheTTL is the default time for which the cluster list of a project is cached.
+const DefaultClusterListCacheTTL = 10 * time.Second
+
+// clusterListTimeout is the maximum time for a listing shared between callers.
+const clusterListTimeout = 30 * time.Second
+
+// ClusterListCache caches the clusters of a project per seed. It is shared between the
+// cluster providers of all seeds, so that repeated listings within the TTL don't hit
+// the seed API servers. Concurrent listings of the same clusters are merged into a
+// single request. Entries are invalidated when a cluster is created, updated or deleted
+// through a cluster provider using the cache, and removed once they are expired.
+type ClusterListCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	group singleflight.Group
+	// joined is called after a caller started or joined a shared listing; only used in tests.
+	joined func()
+
+	lock      sync.Mutex
+	entries   map[clusterListCacheKey]*clusterListCacheEntry
+	lastSweep time.Time
+}
+
+type clusterListCacheKey struct {
+	seed    string
+	project string
+}
+
+type clusterListCacheEntry struct {
+	clusters *kubermaticv1.ClusterList
+	expires  time.Time
+	// generation is increased on every invalidation. A listing which was started
+	// before an invalidation must not be stored in the cache.
+	generation uint64
+}
+
+// NewClusterListCache returns a new cluster list cache with the given TTL.
+func NewClusterListCache(ttl time.Duration) *ClusterListCache {
+	return &ClusterListCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[clusterListCacheKey]*clusterListCacheEntry{},
+	}
+}
+
+// List returns the cached clusters of the project on the seed. If there are no cached
+// clusters, list is called and its result is cached. Callers listing the same clusters
+// at the same time share the result of a single call. The returned list is always a
+// copy and can be modified by the caller.
+//
+// The shared call is not bound to the context of a single caller, as all other callers
+// would fail if that caller went away. It runs with a context which is detached from ctx
+// and limited by clusterListTimeout, while ctx only stops the caller from waiting.
+func (c *ClusterListCache) List(ctx context.Context, seed, project string, list func(ctx context.Context) (*kubermaticv1.ClusterList, error)) (*kubermaticv1.ClusterList, error) {
+	key := clusterListCacheKey{seed: seed, project: project}
+
+	c.lock.Lock()
+	c.evictExpired()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &clusterListCacheEntry{}
+		c.entries[key] = entry
+	}
+	if entry.clusters != nil && c.now().Before(entry.expires) {
+		clusters := entry.clusters.DeepCopy()
+		c.lock.Unlock()
+		return clusters, nil
+	}
+	generation := entry.generation
+	c.lock.Unlock()
+
+	// The generation is part of the key, so that callers never join a listing which
+	// was started before an invalidation.
+	results := c.group.DoChan(fmt.Sprintf("%s/%s/%d", seed, project, generation), func() (interface{}, error) {
+		listCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), clusterListTimeout)
+		defer cancel()
+
+		clusters, err := list(listCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		// The entry might have been removed or invalidated while listing.
+		if current, ok := c.entries[key]; ok && current == entry && entry.generation == generation {
+			entry.clusters = clusters.DeepCopy()
+			entry.expires = c.now().Add(c.ttl)
+		}
+
+		return clusters, nil
+	})
+	if c.joined != nil {
+		c.joined()
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-results:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.(*kubermaticv1.ClusterList).DeepCopy(), nil
+	}
+}
+
+// Invalidate removes the cached clusters of the project on the seed.
+func (c *ClusterListCache) Invalidate(seed, project string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if entry, ok := c.entries[clusterListCacheKey{seed: seed, project: project}]; ok {
+		entry.clusters = nil
+		entry.generation++
+	}
+}
+
+// evictExpired removes the expired entries at most once per TTL, so that the cache
+// doesn't keep the clusters of projects which aren't listed anymore. The caller must
+// hold the lock.
+func (c *ClusterListCache) evictExpired() {
+	now := c.now()
+	if now.Sub(c.lastSweep) < c.ttl {
+		return
+	}
+	c.lastSweep = now
+
+	for key, entry := range c.entries {
+		if entry.clusters != nil && !now.Before(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+}
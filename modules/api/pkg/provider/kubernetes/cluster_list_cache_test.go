@@ -0,0 +1,324 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func genCachedClusterList(project string, names ...string) *kubermaticv1.ClusterList {
+	list := &kubermaticv1.ClusterList{}
+	for _, name := range names {
+		list.Items = append(list.Items, kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: project},
+			},
+		})
+	}
+	return list
+}
+
+func countingLister(calls *int32, list *kubermaticv1.ClusterList) func(context.Context) (*kubermaticv1.ClusterList, error) {
+	return func(context.Context) (*kubermaticv1.ClusterList, error) {
+		atomic.AddInt32(calls, 1)
+		return list.DeepCopy(), nil
+	}
+}
+
+func TestClusterListCacheTTL(t *testing.T) {
+	now := time.Now()
+	cache := NewClusterListCache(10 * time.Second)
+	cache.now = func() time.Time { return now }
+
+	var calls int32
+	lister := countingLister(&calls, genCachedClusterList("project-a", "cluster-a"))
+
+	for i := 0; i < 3; i++ {
+		if _, err := cache.List(context.Background(), "seed", "project-a", lister); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected the clusters to be listed once within the TTL, but they were listed %d times", calls)
+	}
+
+	now = now.Add(11 * time.Second)
+	if _, err := cache.List(context.Background(), "seed", "project-a", lister); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected the clusters to be listed again after the TTL, but they were listed %d times", calls)
+	}
+}
+
+func TestClusterListCacheInvalidate(t *testing.T) {
+	cache := NewClusterListCache(time.Minute)
+
+	var calls int32
+	lister := countingLister(&calls, genCachedClusterList("project-a", "cluster-a"))
+
+	if _, err := cache.List(context.Background(), "seed", "project-a", lister); err != nil {
+		t.Fatal(err)
+	}
+
+	// invalidating another project or seed must not affect the cached list
+	cache.Invalidate("seed", "project-b")
+	cache.Invalidate("other-seed", "project-a")
+	if _, err := cache.List(context.Background(), "seed", "project-a", lister); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected the clusters to be listed once, but they were listed %d times", calls)
+	}
+
+	cache.Invalidate("seed", "project-a")
+	if _, err := cache.List(context.Background(), "seed", "project-a", lister); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected the clusters to be listed again after the invalidation, but they were listed %d times", calls)
+	}
+}
+
+func TestClusterListCacheReturnsCopies(t *testing.T) {
+	cache := NewClusterListCache(time.Minute)
+	lister := func(context.Context) (*kubermaticv1.ClusterList, error) {
+		return genCachedClusterList("project-a", "cluster-a"), nil
+	}
+
+	clusters, err := cache.List(context.Background(), "seed", "project-a", lister)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clusters.Items[0].Name = "modified"
+
+	clusters, err = cache.List(context.Background(), "seed", "project-a", lister)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clusters.Items[0].Name != "cluster-a" {
+		t.Fatalf("expected the cached cluster to be unchanged, got %q", clusters.Items[0].Name)
+	}
+}
+
+func TestClusterListCacheInvalidateWhileListing(t *testing.T) {
+	cache := NewClusterListCache(time.Minute)
+
+	listing := make(chan struct{})
+	invalidated := make(chan struct{})
+	staleLister := func(context.Context) (*kubermaticv1.ClusterList, error) {
+		close(listing)
+		<-invalidated
+		return genCachedClusterList("project-a", "cluster-a"), nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, err := cache.List(context.Background(), "seed", "project-a", staleLister); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	<-listing
+	cache.Invalidate("seed", "project-a")
+	close(invalidated)
+	<-done
+
+	// the list which was started before the invalidation must not have been cached
+	clusters, err := cache.List(context.Background(), "seed", "project-a", func(context.Context) (*kubermaticv1.ClusterList, error) {
+		return genCachedClusterList("project-a", "cluster-a", "cluster-b"), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clusters.Items) != 2 {
+		t.Fatalf("expected 2 clusters, got %d", len(clusters.Items))
+	}
+}
+
+func TestClusterListCacheConcurrentAccess(t *testing.T) {
+	cache := NewClusterListCache(time.Minute)
+
+	projects := []string{"project-a", "project-b", "project-c"}
+	seeds := []string{"seed-1", "seed-2"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		for _, seed := range seeds {
+			for _, project := range projects {
+				wg.Add(1)
+				go func(seed, project string, invalidate bool) {
+					defer wg.Done()
+
+					if invalidate {
+						cache.Invalidate(seed, project)
+						return
+					}
+
+					clusters, err := cache.List(context.Background(), seed, project, func(context.Context) (*kubermaticv1.ClusterList, error) {
+						return genCachedClusterList(project, fmt.Sprintf("%s-%s", seed, project)), nil
+					})
+					if err != nil {
+						t.Error(err)
+						return
+					}
+
+					// clusters must never leak across projects or seeds
+					for _, cluster := range clusters.Items {
+						if cluster.Labels[kubermaticv1.ProjectIDLabelKey] != project || cluster.Name != fmt.Sprintf("%s-%s", seed, project) {
+							t.Errorf("got cluster %s of project %s when listing project %s on seed %s", cluster.Name, cluster.Labels[kubermaticv1.ProjectIDLabelKey], project, seed)
+						}
+					}
+				}(seed, project, i%5 == 0)
+			}
+		}
+	}
+	wg.Wait()
+}
+
+func TestClusterListCacheMergesConcurrentListings(t *testing.T) {
+	cache := NewClusterListCache(time.Minute)
+
+	const callers = 10
+
+	var joined sync.WaitGroup
+	joined.Add(callers)
+	cache.joined = joined.Done
+
+	var calls int32
+	release := make(chan struct{})
+	lister := func(context.Context) (*kubermaticv1.ClusterList, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return genCachedClusterList("project-a", "cluster-a"), nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			clusters, err := cache.List(context.Background(), "seed", "project-a", lister)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			// every caller gets its own copy
+			clusters.Items[0].Name = "modified"
+		}()
+	}
+
+	// all callers must have joined the listing before it completes
+	joined.Wait()
+	cache.joined = nil
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("expected the concurrent listings to be merged, but the clusters were listed %d times", calls)
+	}
+
+	clusters, err := cache.List(context.Background(), "seed", "project-a", lister)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clusters.Items[0].Name != "cluster-a" {
+		t.Fatalf("expected the cached cluster to be unchanged, got %q", clusters.Items[0].Name)
+	}
+}
+
+func TestClusterListCacheSurvivesCancelledCaller(t *testing.T) {
+	cache := NewClusterListCache(time.Minute)
+
+	var joined sync.WaitGroup
+	joined.Add(2)
+	cache.joined = joined.Done
+
+	release := make(chan struct{})
+	lister := func(ctx context.Context) (*kubermaticv1.ClusterList, error) {
+		<-release
+		// the shared listing must not use the context of the cancelled caller
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return genCachedClusterList("project-a", "cluster-a"), nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := make(chan error)
+	go func() {
+		_, err := cache.List(ctx, "seed", "project-a", lister)
+		cancelled <- err
+	}()
+
+	listed := make(chan error)
+	go func() {
+		clusters, err := cache.List(context.Background(), "seed", "project-a", lister)
+		if err == nil && len(clusters.Items) != 1 {
+			err = fmt.Errorf("expected 1 cluster, got %d", len(clusters.Items))
+		}
+		listed <- err
+	}()
+
+	joined.Wait()
+	cache.joined = nil
+	cancel()
+	if err := <-cancelled; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the cancelled caller to stop waiting, got %v", err)
+	}
+
+	close(release)
+	if err := <-listed; err != nil {
+		t.Fatalf("expected the remaining caller to get the clusters, got %v", err)
+	}
+}
+
+func TestClusterListCacheEvictsExpiredEntries(t *testing.T) {
+	now := time.Now()
+	cache := NewClusterListCache(10 * time.Second)
+	cache.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		project := fmt.Sprintf("project-%d", i)
+		if _, err := cache.List(context.Background(), "seed", project, countingLister(new(int32), genCachedClusterList(project))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	now = now.Add(11 * time.Second)
+	if _, err := cache.List(context.Background(), "seed", "project-0", countingLister(new(int32), genCachedClusterList("project-0"))); err != nil {
+		t.Fatal(err)
+	}
+
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	if len(cache.entries) != 1 {
+		t.Fatalf("expected the expired entries to be removed, got %d entries", len(cache.entries))
+	}
+}
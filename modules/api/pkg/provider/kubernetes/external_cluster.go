@@ -34,6 +34,7 @@ import (
 	"k8c.io/reconciler/pkg/reconciling"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -336,7 +337,7 @@ func (p *ExternalClusterProvider) IsMetricServerAvailable(ctx context.Context, m
 
 	allNodeMetricsList := &v1beta1.NodeMetricsList{}
 	if err := client.List(ctx, allNodeMetricsList); err != nil {
-		if meta.IsNoMatchError(err) {
+		if meta.IsNoMatchError(err) || apierrors.IsServiceUnavailable(err) {
 			return false, nil
 		}
 		return false, err
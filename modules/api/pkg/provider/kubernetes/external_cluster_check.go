@@ -0,0 +1,201 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// kubeconfigCheckTimeout limits the time the checks of an external cluster may take, so that unreachable
+// clusters don't block the request.
+const kubeconfigCheckTimeout = 10 * time.Second
+
+// The checks of an external cluster in the order they are run.
+const (
+	ExternalClusterCheckKubeconfig            = "kubeconfig"
+	ExternalClusterCheckConnection            = "connection"
+	ExternalClusterCheckListNodes             = "listNodes"
+	ExternalClusterCheckListNodesAccess       = "listNodesPermission"
+	ExternalClusterCheckListDeploymentsAccess = "listDeploymentsPermission"
+)
+
+var externalClusterChecks = []string{
+	ExternalClusterCheckKubeconfig,
+	ExternalClusterCheckConnection,
+	ExternalClusterCheckListNodes,
+	ExternalClusterCheckListNodesAccess,
+	ExternalClusterCheckListDeploymentsAccess,
+}
+
+// CheckKubeconfig checks if the external cluster of the kubeconfig can be imported: the kubeconfig has to be usable
+// without exec plugins, the cluster has to be reachable and the credentials need the permissions the dashboard
+// relies on. Nothing is created.
+func (p *ExternalClusterProvider) CheckKubeconfig(ctx context.Context, kubeconfig []byte) *apiv2.ExternalClusterCheckReport {
+	cfg, err := LoadKubeconfigForCheck(kubeconfig)
+	if err != nil {
+		return KubeconfigCheckFailed(err)
+	}
+
+	restConfig, err := getRestConfig(cfg)
+	if err != nil {
+		return KubeconfigCheckFailed(err)
+	}
+	restConfig.Timeout = kubeconfigCheckTimeout
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return KubeconfigCheckFailed(err)
+	}
+
+	return CheckClusterAccess(ctx, client)
+}
+
+// LoadKubeconfigForCheck loads the kubeconfig and makes sure that its current context can be used by the
+// dashboard. Exec and auth provider plugins are rejected, as their binaries are not available in the dashboard
+// and running them might block forever.
+func LoadKubeconfigForCheck(kubeconfig []byte) (*clientcmdapi.Config, error) {
+	cfg, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the kubeconfig: %w", err)
+	}
+
+	kubeContext, ok := cfg.Contexts[cfg.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("the current context %q of the kubeconfig does not exist", cfg.CurrentContext)
+	}
+
+	authInfo, ok := cfg.AuthInfos[kubeContext.AuthInfo]
+	if !ok {
+		return nil, fmt.Errorf("the user %q of the current context does not exist", kubeContext.AuthInfo)
+	}
+	if authInfo.Exec != nil {
+		return nil, fmt.Errorf("the user %q authenticates with the exec plugin %q, which can not be run by the dashboard, use a token or a client certificate instead", kubeContext.AuthInfo, authInfo.Exec.Command)
+	}
+	if authInfo.AuthProvider != nil {
+		return nil, fmt.Errorf("the user %q authenticates with the auth provider %q, which is not supported by the dashboard, use a token or a client certificate instead", kubeContext.AuthInfo, authInfo.AuthProvider.Name)
+	}
+
+	return cfg, nil
+}
+
+// KubeconfigCheckFailed returns the report of a kubeconfig which can not be used, the checks of the cluster are
+// skipped.
+func KubeconfigCheckFailed(err error) *apiv2.ExternalClusterCheckReport {
+	report := newExternalClusterCheckReport()
+	report.set(ExternalClusterCheckKubeconfig, apiv2.ExternalClusterCheckFailed, err.Error())
+
+	return report.done()
+}
+
+// CheckClusterAccess checks the connection to the external cluster and the permissions of the credentials. All
+// checks together are limited to 10 seconds.
+func CheckClusterAccess(ctx context.Context, client kubernetes.Interface) *apiv2.ExternalClusterCheckReport {
+	ctx, cancel := context.WithTimeout(ctx, kubeconfigCheckTimeout)
+	defer cancel()
+
+	report := newExternalClusterCheckReport()
+	report.set(ExternalClusterCheckKubeconfig, apiv2.ExternalClusterCheckPassed, "")
+
+	version, err := client.Discovery().ServerVersion()
+	if err != nil {
+		report.set(ExternalClusterCheckConnection, apiv2.ExternalClusterCheckFailed, fmt.Sprintf("the cluster is not reachable: %v", err))
+		return report.done()
+	}
+	report.set(ExternalClusterCheckConnection, apiv2.ExternalClusterCheckPassed, fmt.Sprintf("connected to Kubernetes %s", version.GitVersion))
+
+	if _, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{Limit: 1}); err != nil {
+		report.set(ExternalClusterCheckListNodes, apiv2.ExternalClusterCheckFailed, fmt.Sprintf("failed to list nodes: %v", err))
+	} else {
+		report.set(ExternalClusterCheckListNodes, apiv2.ExternalClusterCheckPassed, "")
+	}
+
+	report.setAccess(ctx, client, ExternalClusterCheckListNodesAccess, authorizationv1.ResourceAttributes{
+		Verb:     "list",
+		Resource: "nodes",
+	})
+	report.setAccess(ctx, client, ExternalClusterCheckListDeploymentsAccess, authorizationv1.ResourceAttributes{
+		Namespace: metav1.NamespaceSystem,
+		Verb:      "list",
+		Group:     "apps",
+		Resource:  "deployments",
+	})
+
+	return report.done()
+}
+
+type externalClusterCheckReport struct {
+	checks map[string]apiv2.ExternalClusterCheck
+}
+
+func newExternalClusterCheckReport() *externalClusterCheckReport {
+	return &externalClusterCheckReport{checks: map[string]apiv2.ExternalClusterCheck{}}
+}
+
+func (r *externalClusterCheckReport) set(name, status, message string) {
+	r.checks[name] = apiv2.ExternalClusterCheck{Name: name, Status: status, Message: message}
+}
+
+// setAccess checks with a SelfSubjectAccessReview whether the credentials are allowed to access the resource.
+func (r *externalClusterCheckReport) setAccess(ctx context.Context, client kubernetes.Interface, name string, attributes authorizationv1.ResourceAttributes) {
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &attributes,
+		},
+	}
+
+	resource := attributes.Resource
+	if attributes.Namespace != "" {
+		resource = fmt.Sprintf("%s in the %s namespace", resource, attributes.Namespace)
+	}
+
+	review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	switch {
+	case err != nil:
+		r.set(name, apiv2.ExternalClusterCheckFailed, fmt.Sprintf("failed to check the permission to %s %s: %v", attributes.Verb, resource, err))
+	case !review.Status.Allowed:
+		r.set(name, apiv2.ExternalClusterCheckFailed, fmt.Sprintf("the credentials are not allowed to %s %s", attributes.Verb, resource))
+	default:
+		r.set(name, apiv2.ExternalClusterCheckPassed, "")
+	}
+}
+
+// done returns the report with all checks in their order, checks which were not run are skipped.
+func (r *externalClusterCheckReport) done() *apiv2.ExternalClusterCheckReport {
+	report := &apiv2.ExternalClusterCheckReport{Valid: true}
+	for _, name := range externalClusterChecks {
+		check, ok := r.checks[name]
+		if !ok {
+			check = apiv2.ExternalClusterCheck{Name: name, Status: apiv2.ExternalClusterCheckSkipped}
+		}
+		if check.Status != apiv2.ExternalClusterCheckPassed {
+			report.Valid = false
+		}
+		report.Checks = append(report.Checks, check)
+	}
+
+	return report
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakerestclient "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+const (
+	tokenKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://localhost:6443
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+users:
+- name: test
+  user:
+    token: abc
+`
+	execKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://localhost:6443
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+users:
+- name: test
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: gke-gcloud-auth-plugin
+`
+	missingContextKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://localhost:6443
+current-context: test
+`
+)
+
+func TestLoadKubeconfigForCheck(t *testing.T) {
+	testCases := []struct {
+		name          string
+		kubeconfig    string
+		expectedError string
+	}{
+		{
+			name:       "kubeconfig with a token can be used",
+			kubeconfig: tokenKubeconfig,
+		},
+		{
+			name:          "kubeconfig with an exec plugin is rejected",
+			kubeconfig:    execKubeconfig,
+			expectedError: `the user "test" authenticates with the exec plugin "gke-gcloud-auth-plugin", which can not be run by the dashboard, use a token or a client certificate instead`,
+		},
+		{
+			name:          "kubeconfig without the current context is rejected",
+			kubeconfig:    missingContextKubeconfig,
+			expectedError: `the current context "test" of the kubeconfig does not exist`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := kubernetes.LoadKubeconfigForCheck([]byte(tc.kubeconfig))
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expectedError {
+				t.Fatalf("expected error %q, got: %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestCheckClusterAccess(t *testing.T) {
+	testCases := []struct {
+		name           string
+		discoveryError error
+		allowed        map[string]bool
+		expectedReport *apiv2.ExternalClusterCheckReport
+	}{
+		{
+			name:    "all checks pass",
+			allowed: map[string]bool{"nodes": true, "deployments": true},
+			expectedReport: &apiv2.ExternalClusterCheckReport{
+				Valid: true,
+				Checks: []apiv2.ExternalClusterCheck{
+					{Name: kubernetes.ExternalClusterCheckKubeconfig, Status: apiv2.ExternalClusterCheckPassed},
+					{Name: kubernetes.ExternalClusterCheckConnection, Status: apiv2.ExternalClusterCheckPassed, Message: "connected to Kubernetes v1.31.1"},
+					{Name: kubernetes.ExternalClusterCheckListNodes, Status: apiv2.ExternalClusterCheckPassed},
+					{Name: kubernetes.ExternalClusterCheckListNodesAccess, Status: apiv2.ExternalClusterCheckPassed},
+					{Name: kubernetes.ExternalClusterCheckListDeploymentsAccess, Status: apiv2.ExternalClusterCheckPassed},
+				},
+			},
+		},
+		{
+			name:    "missing permission to list deployments fails",
+			allowed: map[string]bool{"nodes": true},
+			expectedReport: &apiv2.ExternalClusterCheckReport{
+				Checks: []apiv2.ExternalClusterCheck{
+					{Name: kubernetes.ExternalClusterCheckKubeconfig, Status: apiv2.ExternalClusterCheckPassed},
+					{Name: kubernetes.ExternalClusterCheckConnection, Status: apiv2.ExternalClusterCheckPassed, Message: "connected to Kubernetes v1.31.1"},
+					{Name: kubernetes.ExternalClusterCheckListNodes, Status: apiv2.ExternalClusterCheckPassed},
+					{Name: kubernetes.ExternalClusterCheckListNodesAccess, Status: apiv2.ExternalClusterCheckPassed},
+					{Name: kubernetes.ExternalClusterCheckListDeploymentsAccess, Status: apiv2.ExternalClusterCheckFailed, Message: "the credentials are not allowed to list deployments in the kube-system namespace"},
+				},
+			},
+		},
+		{
+			name:           "unreachable cluster skips the remaining checks",
+			discoveryError: errors.New("connection refused"),
+			expectedReport: &apiv2.ExternalClusterCheckReport{
+				Checks: []apiv2.ExternalClusterCheck{
+					{Name: kubernetes.ExternalClusterCheckKubeconfig, Status: apiv2.ExternalClusterCheckPassed},
+					{Name: kubernetes.ExternalClusterCheckConnection, Status: apiv2.ExternalClusterCheckFailed, Message: "the cluster is not reachable: connection refused"},
+					{Name: kubernetes.ExternalClusterCheckListNodes, Status: apiv2.ExternalClusterCheckSkipped},
+					{Name: kubernetes.ExternalClusterCheckListNodesAccess, Status: apiv2.ExternalClusterCheckSkipped},
+					{Name: kubernetes.ExternalClusterCheckListDeploymentsAccess, Status: apiv2.ExternalClusterCheckSkipped},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fakerestclient.NewSimpleClientset()
+			client.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: "v1.31.1"}
+			client.PrependReactor("get", "version", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				return tc.discoveryError != nil, nil, tc.discoveryError
+			})
+			client.PrependReactor("create", "selfsubjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				review := action.(clienttesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+				review.Status.Allowed = tc.allowed[review.Spec.ResourceAttributes.Resource]
+				return true, review, nil
+			})
+
+			report := kubernetes.CheckClusterAccess(context.Background(), client)
+			if !diff.SemanticallyEqual(tc.expectedReport, report) {
+				t.Fatalf("Got unexpected report:\n%v", diff.ObjectDiff(tc.expectedReport, report))
+			}
+		})
+	}
+}
+
+func TestKubeconfigCheckFailed(t *testing.T) {
+	report := kubernetes.KubeconfigCheckFailed(errors.New("invalid"))
+
+	if report.Valid {
+		t.Fatal("expected the report to be invalid")
+	}
+	for _, check := range report.Checks[1:] {
+		if check.Status != apiv2.ExternalClusterCheckSkipped {
+			t.Fatalf("expected check %s to be skipped, got %s", check.Name, check.Status)
+		}
+	}
+}
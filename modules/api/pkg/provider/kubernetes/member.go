@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
@@ -210,11 +211,11 @@ func (p *ProjectMemberProvider) MapUserToGroups(ctx context.Context, user *kuber
 	if groups.Len() > 0 {
 		return groups, nil
 	} else {
-		return nil, apierrors.NewForbidden(
+		return nil, common.WithReason(common.ReasonNotProjectMember, apierrors.NewForbidden(
 			schema.GroupResource{},
 			projectID,
 			fmt.Errorf("%q doesn't belong to project %s", user.Spec.Email, projectID),
-		)
+		))
 	}
 }
 
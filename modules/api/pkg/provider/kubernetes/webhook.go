@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// webhookLabelKey marks the secrets which store project webhooks.
+const webhookLabelKey = "kubermatic.k8c.io/project-webhook"
+
+// PrivilegedWebhookProvider struct that holds required components in order to manage project webhooks.
+type PrivilegedWebhookProvider struct {
+	clientPrivileged ctrlruntimeclient.Client
+}
+
+var _ provider.PrivilegedWebhookProvider = &PrivilegedWebhookProvider{}
+
+// NewPrivilegedWebhookProvider returns a project webhook provider.
+func NewPrivilegedWebhookProvider(client ctrlruntimeclient.Client) *PrivilegedWebhookProvider {
+	return &PrivilegedWebhookProvider{
+		clientPrivileged: client,
+	}
+}
+
+func (p *PrivilegedWebhookProvider) CreateUnsecured(ctx context.Context, project *kubermaticv1.Project, webhook *corev1.Secret) (*corev1.Secret, error) {
+	if project == nil {
+		return nil, apierrors.NewBadRequest("project cannot be nil")
+	}
+
+	webhook.Namespace = resources.KubermaticNamespace
+	if webhook.Labels == nil {
+		webhook.Labels = map[string]string{}
+	}
+	webhook.Labels[kubermaticv1.ProjectIDLabelKey] = project.Name
+	webhook.Labels[webhookLabelKey] = "true"
+	webhook.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+			Kind:       kubermaticv1.ProjectKindName,
+			UID:        project.GetUID(),
+			Name:       project.Name,
+		},
+	}
+	webhook.Type = corev1.SecretTypeOpaque
+
+	if err := p.clientPrivileged.Create(ctx, webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (p *PrivilegedWebhookProvider) GetUnsecured(ctx context.Context, projectID, name string) (*corev1.Secret, error) {
+	webhook := &corev1.Secret{}
+	if err := p.clientPrivileged.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: name}, webhook); err != nil {
+		return nil, err
+	}
+
+	// Never expose the webhooks of other projects or other secrets of the namespace.
+	if webhook.Labels[webhookLabelKey] != "true" || webhook.Labels[kubermaticv1.ProjectIDLabelKey] != projectID {
+		return nil, apierrors.NewNotFound(corev1.Resource("secret"), name)
+	}
+
+	return webhook, nil
+}
+
+func (p *PrivilegedWebhookProvider) ListUnsecured(ctx context.Context, projectID string) (*corev1.SecretList, error) {
+	webhooks := &corev1.SecretList{}
+	if err := p.clientPrivileged.List(ctx, webhooks,
+		ctrlruntimeclient.InNamespace(resources.KubermaticNamespace),
+		ctrlruntimeclient.MatchingLabels{kubermaticv1.ProjectIDLabelKey: projectID, webhookLabelKey: "true"},
+	); err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+func (p *PrivilegedWebhookProvider) UpdateUnsecured(ctx context.Context, webhook *corev1.Secret) (*corev1.Secret, error) {
+	if err := p.clientPrivileged.Update(ctx, webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (p *PrivilegedWebhookProvider) DeleteUnsecured(ctx context.Context, projectID, name string) error {
+	webhook, err := p.GetUnsecured(ctx, projectID, name)
+	if err != nil {
+		return err
+	}
+	return p.clientPrivileged.Delete(ctx, webhook)
+}
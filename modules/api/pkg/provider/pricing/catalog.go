@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricing
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+)
+
+// anyRegion is the region of sizes which cost the same in all regions of a cloud provider.
+const anyRegion = "*"
+
+// staticCatalogSource is shown to the user as the source of the prices of the static catalog.
+const staticCatalogSource = "the price catalog shipped with the dashboard, on-demand prices without taxes, discounts, storage and traffic"
+
+//go:embed catalogs
+var catalogs embed.FS
+
+// providerCatalog is the price catalog of a cloud provider, the prices are hourly prices per region and size.
+type providerCatalog struct {
+	Currency string                        `json:"currency"`
+	Regions  map[string]map[string]float64 `json:"regions"`
+}
+
+// StaticCatalog is the PriceCatalog with the prices shipped with the dashboard. There is a JSON file per cloud
+// provider in the catalogs directory, the files are loaded on first use.
+type StaticCatalog struct {
+	once      sync.Once
+	providers map[string]providerCatalog
+	err       error
+}
+
+var _ provider.PriceCatalog = &StaticCatalog{}
+
+// NewStaticCatalog returns the PriceCatalog with the prices shipped with the dashboard.
+func NewStaticCatalog() *StaticCatalog {
+	return &StaticCatalog{}
+}
+
+// InstancePrice returns the price of the size in the region of the cloud provider. Prices listed for all regions
+// are used if the region has no price of its own.
+func (c *StaticCatalog) InstancePrice(_ context.Context, cloudProvider, region, size string) (*provider.InstancePrice, error) {
+	c.once.Do(c.load)
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	catalog, ok := c.providers[cloudProvider]
+	if !ok {
+		return nil, provider.ErrNotFound
+	}
+
+	price, ok := catalog.Regions[region][size]
+	if !ok {
+		price, ok = catalog.Regions[anyRegion][size]
+	}
+	if !ok {
+		return nil, provider.ErrNotFound
+	}
+
+	return &provider.InstancePrice{
+		Hourly:   price,
+		Currency: catalog.Currency,
+		Source:   staticCatalogSource,
+	}, nil
+}
+
+func (c *StaticCatalog) load() {
+	c.providers, c.err = loadCatalogs(catalogs, "catalogs")
+}
+
+func loadCatalogs(fsys fs.FS, dir string) (map[string]providerCatalog, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the price catalogs: %w", err)
+	}
+
+	providers := map[string]providerCatalog{}
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		data, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the price catalog %s: %w", entry.Name(), err)
+		}
+
+		var catalog providerCatalog
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			return nil, fmt.Errorf("failed to parse the price catalog %s: %w", entry.Name(), err)
+		}
+		if catalog.Currency == "" {
+			return nil, fmt.Errorf("the price catalog %s has no currency", entry.Name())
+		}
+
+		providers[strings.TrimSuffix(entry.Name(), ".json")] = catalog
+	}
+
+	return providers, nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/provider/pricing"
+)
+
+func TestStaticCatalog(t *testing.T) {
+	testCases := []struct {
+		name           string
+		cloudProvider  string
+		region         string
+		size           string
+		expectedHourly float64
+		expectedError  error
+	}{
+		{
+			name:           "AWS size in a listed region",
+			cloudProvider:  "aws",
+			region:         "eu-central-1",
+			size:           "t3.medium",
+			expectedHourly: 0.048,
+		},
+		{
+			name:           "AWS prices differ between regions",
+			cloudProvider:  "aws",
+			region:         "us-east-1",
+			size:           "t3.medium",
+			expectedHourly: 0.0416,
+		},
+		{
+			name:          "AWS size in a region which is not listed",
+			cloudProvider: "aws",
+			region:        "ap-south-2",
+			size:          "t3.medium",
+			expectedError: provider.ErrNotFound,
+		},
+		{
+			name:          "unknown AWS size",
+			cloudProvider: "aws",
+			region:        "eu-central-1",
+			size:          "x9.huge",
+			expectedError: provider.ErrNotFound,
+		},
+		{
+			name:           "DigitalOcean sizes cost the same in all regions",
+			cloudProvider:  "digitalocean",
+			region:         "ams2",
+			size:           "s-2vcpu-4gb",
+			expectedHourly: 0.03571,
+		},
+		{
+			name:          "unknown DigitalOcean size",
+			cloudProvider: "digitalocean",
+			region:        "fra1",
+			size:          "s-64vcpu-512gb",
+			expectedError: provider.ErrNotFound,
+		},
+		{
+			name:          "cloud provider without catalog",
+			cloudProvider: "vsphere",
+			region:        "dc1",
+			size:          "large",
+			expectedError: provider.ErrNotFound,
+		},
+	}
+
+	catalog := pricing.NewStaticCatalog()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := catalog.InstancePrice(context.Background(), tc.cloudProvider, tc.region, tc.size)
+			if tc.expectedError != nil {
+				if !errors.Is(err, tc.expectedError) {
+					t.Fatalf("expected error %v, got %v", tc.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if price.Hourly != tc.expectedHourly {
+				t.Errorf("expected hourly price %v, got %v", tc.expectedHourly, price.Hourly)
+			}
+			if price.Currency != "USD" {
+				t.Errorf("expected currency USD, got %s", price.Currency)
+			}
+		})
+	}
+}
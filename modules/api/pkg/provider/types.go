@@ -245,6 +245,11 @@ type ClusterProvider interface {
 	// Note that the client doesn't use admin account instead it authn/authz as userInfo(email, group)
 	GetClientForUserCluster(context.Context, *UserInfo, *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error)
 
+	// GetWatchClientForUserCluster returns a client which can also watch the resources in the given cluster
+	//
+	// Note that the client authn/authz as userInfo(email, group) like GetClientForUserCluster, unless the user is an admin
+	GetWatchClientForUserCluster(context.Context, *UserInfo, *kubermaticv1.Cluster) (ctrlruntimeclient.WithWatch, error)
+
 	// GetTokenForUserCluster returns a token for the given cluster with permissions granted to group that
 	// user belongs to.
 	GetTokenForUserCluster(context.Context, *UserInfo, *kubermaticv1.Cluster) (string, error)
@@ -728,6 +733,8 @@ type ExternalClusterProvider interface {
 
 	ValidateKubeconfig(ctx context.Context, kubeconfig []byte) error
 
+	CheckKubeconfig(ctx context.Context, kubeconfig []byte) *apiv2.ExternalClusterCheckReport
+
 	ListNodes(ctx context.Context, masterClient ctrlruntimeclient.Client, cluster *kubermaticv1.ExternalCluster) (*corev1.NodeList, error)
 
 	GetNode(ctx context.Context, masterClient ctrlruntimeclient.Client, cluster *kubermaticv1.ExternalCluster, nodeName string) (*corev1.Node, error)
@@ -1423,3 +1430,45 @@ type PolicyBindingProvider interface {
 	// is unsafe in a sense that it uses privileged account to get the resources
 	DeleteUnsecured(ctx context.Context, name string, clusterID string) error
 }
+
+// PrivilegedWebhookProvider declares the set of methods for interacting with project webhooks.
+// Webhooks are stored as secrets, as they contain the secret used to sign the payloads.
+type PrivilegedWebhookProvider interface {
+	// CreateUnsecured creates the webhook secret for the given project.
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to create the resource
+	CreateUnsecured(ctx context.Context, project *kubermaticv1.Project, webhook *corev1.Secret) (*corev1.Secret, error)
+	// GetUnsecured gets the webhook secret with the given name of the project.
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to get the resource
+	GetUnsecured(ctx context.Context, projectID, name string) (*corev1.Secret, error)
+	// ListUnsecured gets the webhook secrets of the project.
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to get the resources
+	ListUnsecured(ctx context.Context, projectID string) (*corev1.SecretList, error)
+	// UpdateUnsecured updates the webhook secret.
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to update the resource
+	UpdateUnsecured(ctx context.Context, webhook *corev1.Secret) (*corev1.Secret, error)
+	// DeleteUnsecured deletes the webhook secret with the given name of the project.
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to delete the resource
+	DeleteUnsecured(ctx context.Context, projectID, name string) error
+}
+
+// InstancePrice is the price of a single instance of a size.
+type InstancePrice struct {
+	// Hourly is the price of running the instance for one hour.
+	Hourly float64
+	// Currency of the price, e.g. USD.
+	Currency string
+	// Source describes where the price comes from, it is shown to the user as an assumption of the estimate.
+	Source string
+}
+
+// PriceCatalog resolves the prices of instance sizes, it is used to estimate the costs of machine deployments.
+type PriceCatalog interface {
+	// InstancePrice returns the price of the size in the region of the cloud provider. ErrNotFound is returned if
+	// the catalog doesn't know the price.
+	InstancePrice(ctx context.Context, cloudProvider, region, size string) (*InstancePrice, error)
+}
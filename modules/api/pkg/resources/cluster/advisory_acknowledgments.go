@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+// AdvisoryAcknowledgmentsAnnotation holds the acknowledged advisories of a cluster as JSON on the Cluster, mapping
+// the advisory IDs to the acknowledgments.
+const AdvisoryAcknowledgmentsAnnotation = "kubermatic.io/acknowledged-advisories"
+
+// GetAdvisoryAcknowledgments returns the acknowledged advisories of the cluster. Without the annotation an empty map
+// is returned.
+func GetAdvisoryAcknowledgments(cluster *kubermaticv1.Cluster) (map[string]*apiv2.ClusterAdvisoryAcknowledgment, error) {
+	acknowledgments := map[string]*apiv2.ClusterAdvisoryAcknowledgment{}
+
+	value, ok := cluster.Annotations[AdvisoryAcknowledgmentsAnnotation]
+	if !ok || value == "" {
+		return acknowledgments, nil
+	}
+
+	if err := json.Unmarshal([]byte(value), &acknowledgments); err != nil {
+		return nil, fmt.Errorf("failed to parse acknowledged advisories of cluster %s: %w", cluster.Name, err)
+	}
+
+	return acknowledgments, nil
+}
+
+// SetAdvisoryAcknowledgments sets the acknowledged advisories of the cluster. An empty map removes the annotation.
+func SetAdvisoryAcknowledgments(cluster *kubermaticv1.Cluster, acknowledgments map[string]*apiv2.ClusterAdvisoryAcknowledgment) error {
+	if len(acknowledgments) == 0 {
+		delete(cluster.Annotations, AdvisoryAcknowledgmentsAnnotation)
+		return nil
+	}
+
+	value, err := json.Marshal(acknowledgments)
+	if err != nil {
+		return err
+	}
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[AdvisoryAcknowledgmentsAnnotation] = string(value)
+
+	return nil
+}
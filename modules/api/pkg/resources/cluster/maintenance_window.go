@@ -0,0 +1,143 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+// MaintenanceWindowAnnotation holds the maintenance window of a cluster as JSON on the Cluster.
+const MaintenanceWindowAnnotation = "kubermatic.io/maintenance-window"
+
+// maxMaintenanceWindowDuration is the longest window, longer windows would overlap with the next week.
+const maxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
+// GetMaintenanceWindow returns the maintenance window of the cluster. Without the annotation there is no window
+// and nil is returned.
+func GetMaintenanceWindow(cluster *kubermaticv1.Cluster) (*apiv1.MaintenanceWindow, error) {
+	value, ok := cluster.Annotations[MaintenanceWindowAnnotation]
+	if !ok || value == "" {
+		return nil, nil
+	}
+
+	window := &apiv1.MaintenanceWindow{}
+	if err := json.Unmarshal([]byte(value), window); err != nil {
+		return nil, fmt.Errorf("failed to parse maintenance window of cluster %s: %w", cluster.Name, err)
+	}
+
+	return window, nil
+}
+
+// SetMaintenanceWindow sets the maintenance window of the cluster. A nil window removes the annotation.
+func SetMaintenanceWindow(cluster *kubermaticv1.Cluster, window *apiv1.MaintenanceWindow) error {
+	if window == nil {
+		delete(cluster.Annotations, MaintenanceWindowAnnotation)
+		return nil
+	}
+
+	value, err := json.Marshal(window)
+	if err != nil {
+		return err
+	}
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[MaintenanceWindowAnnotation] = string(value)
+
+	return nil
+}
+
+// ValidateMaintenanceWindow checks that the weekdays, start time, duration and timezone of the window can be parsed.
+func ValidateMaintenanceWindow(window apiv1.MaintenanceWindow) error {
+	if _, err := parseWeekdays(window.Weekdays); err != nil {
+		return err
+	}
+	if _, err := time.Parse("15:04", window.StartTime); err != nil {
+		return fmt.Errorf("startTime %q must be in the HH:MM format", window.StartTime)
+	}
+	duration, err := time.ParseDuration(window.Duration)
+	if err != nil {
+		return fmt.Errorf("duration %q is invalid: %w", window.Duration, err)
+	}
+	if duration <= 0 || duration > maxMaintenanceWindowDuration {
+		return fmt.Errorf("duration %q must be positive and at most %s", window.Duration, maxMaintenanceWindowDuration)
+	}
+	// Local would depend on the timezone of the API server.
+	if _, err := time.LoadLocation(window.Timezone); err != nil || window.Timezone == "Local" {
+		return fmt.Errorf("timezone %q is unknown", window.Timezone)
+	}
+
+	return nil
+}
+
+// InMaintenanceWindow returns whether the given time is inside of the window. If it isn't, the start of the next
+// window is returned as well.
+func InMaintenanceWindow(window apiv1.MaintenanceWindow, now time.Time) (bool, time.Time, error) {
+	if err := ValidateMaintenanceWindow(window); err != nil {
+		return false, time.Time{}, err
+	}
+	weekdays, _ := parseWeekdays(window.Weekdays)
+	start, _ := time.Parse("15:04", window.StartTime)
+	duration, _ := time.ParseDuration(window.Duration)
+	location, _ := time.LoadLocation(window.Timezone)
+
+	now = now.In(location)
+	// Windows can last up to a week, so the ones which started during the last week have to be checked as well.
+	for day := -7; day <= 7; day++ {
+		date := now.AddDate(0, 0, day)
+		windowStart := time.Date(date.Year(), date.Month(), date.Day(), start.Hour(), start.Minute(), 0, 0, location)
+		if len(weekdays) > 0 && !weekdays[windowStart.Weekday()] {
+			continue
+		}
+		if windowStart.After(now) {
+			return false, windowStart, nil
+		}
+		if now.Before(windowStart.Add(duration)) {
+			return true, time.Time{}, nil
+		}
+	}
+
+	return false, time.Time{}, errors.New("maintenance window has no weekday")
+}
+
+func parseWeekdays(names []string) (map[time.Weekday]bool, error) {
+	weekdays := map[time.Weekday]bool{}
+	for _, name := range names {
+		found := false
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if strings.EqualFold(name, day.String()) {
+				if weekdays[day] {
+					return nil, fmt.Errorf("weekday %q is set more than once", name)
+				}
+				weekdays[day] = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("weekday %q is invalid, it must be one of Monday, Tuesday, Wednesday, Thursday, Friday, Saturday and Sunday", name)
+		}
+	}
+
+	return weekdays, nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+)
+
+func TestValidateMaintenanceWindow(t *testing.T) {
+	testCases := []struct {
+		name        string
+		window      apiv1.MaintenanceWindow
+		expectError bool
+	}{
+		{
+			name:   "valid window",
+			window: apiv1.MaintenanceWindow{Weekdays: []string{"Monday", "saturday"}, StartTime: "22:30", Duration: "4h", Timezone: "Europe/Berlin"},
+		},
+		{
+			name:   "every day in UTC",
+			window: apiv1.MaintenanceWindow{StartTime: "02:00", Duration: "90m"},
+		},
+		{
+			name:        "unknown weekday",
+			window:      apiv1.MaintenanceWindow{Weekdays: []string{"Mon"}, StartTime: "02:00", Duration: "1h"},
+			expectError: true,
+		},
+		{
+			name:        "duplicate weekday",
+			window:      apiv1.MaintenanceWindow{Weekdays: []string{"Monday", "monday"}, StartTime: "02:00", Duration: "1h"},
+			expectError: true,
+		},
+		{
+			name:        "invalid start time",
+			window:      apiv1.MaintenanceWindow{StartTime: "25:00", Duration: "1h"},
+			expectError: true,
+		},
+		{
+			name:        "negative duration",
+			window:      apiv1.MaintenanceWindow{StartTime: "02:00", Duration: "-1h"},
+			expectError: true,
+		},
+		{
+			name:        "duration longer than a week",
+			window:      apiv1.MaintenanceWindow{StartTime: "02:00", Duration: "169h"},
+			expectError: true,
+		},
+		{
+			name:        "unknown timezone",
+			window:      apiv1.MaintenanceWindow{StartTime: "02:00", Duration: "1h", Timezone: "Mars/Olympus"},
+			expectError: true,
+		},
+		{
+			name:        "local timezone",
+			window:      apiv1.MaintenanceWindow{StartTime: "02:00", Duration: "1h", Timezone: "Local"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateMaintenanceWindow(tc.window)
+			if tc.expectError != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tc.expectError, err)
+			}
+		})
+	}
+}
+
+func TestInMaintenanceWindow(t *testing.T) {
+	// 2025-01-06 is a Monday.
+	testCases := []struct {
+		name           string
+		window         apiv1.MaintenanceWindow
+		now            time.Time
+		expectedInside bool
+		expectedNext   time.Time
+	}{
+		{
+			name:           "inside of a daily window",
+			window:         apiv1.MaintenanceWindow{StartTime: "02:00", Duration: "2h"},
+			now:            time.Date(2025, 1, 6, 3, 0, 0, 0, time.UTC),
+			expectedInside: true,
+		},
+		{
+			name:         "after a daily window",
+			window:       apiv1.MaintenanceWindow{StartTime: "02:00", Duration: "2h"},
+			now:          time.Date(2025, 1, 6, 4, 0, 0, 0, time.UTC),
+			expectedNext: time.Date(2025, 1, 7, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "window lasting past midnight",
+			window:         apiv1.MaintenanceWindow{Weekdays: []string{"Sunday"}, StartTime: "22:00", Duration: "4h"},
+			now:            time.Date(2025, 1, 6, 1, 0, 0, 0, time.UTC),
+			expectedInside: true,
+		},
+		{
+			name:         "next window on the next weekday",
+			window:       apiv1.MaintenanceWindow{Weekdays: []string{"Wednesday", "Saturday"}, StartTime: "02:00", Duration: "1h"},
+			now:          time.Date(2025, 1, 8, 3, 0, 0, 0, time.UTC),
+			expectedNext: time.Date(2025, 1, 11, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "window in another timezone",
+			window:         apiv1.MaintenanceWindow{Weekdays: []string{"Monday"}, StartTime: "08:00", Duration: "1h", Timezone: "Europe/Berlin"},
+			now:            time.Date(2025, 1, 6, 7, 30, 0, 0, time.UTC),
+			expectedInside: true,
+		},
+		{
+			name:         "before a window in another timezone",
+			window:       apiv1.MaintenanceWindow{Weekdays: []string{"Monday"}, StartTime: "08:00", Duration: "1h", Timezone: "Europe/Berlin"},
+			now:          time.Date(2025, 1, 6, 6, 30, 0, 0, time.UTC),
+			expectedNext: time.Date(2025, 1, 6, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "week long window",
+			window:         apiv1.MaintenanceWindow{Weekdays: []string{"Tuesday"}, StartTime: "00:00", Duration: "168h"},
+			now:            time.Date(2025, 1, 6, 23, 0, 0, 0, time.UTC),
+			expectedInside: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inside, next, err := InMaintenanceWindow(tc.window, tc.now)
+			if err != nil {
+				t.Fatalf("failed to check the maintenance window: %v", err)
+			}
+			if inside != tc.expectedInside {
+				t.Fatalf("expected inside to be %v, got %v", tc.expectedInside, inside)
+			}
+			if !next.Equal(tc.expectedNext) {
+				t.Fatalf("expected the next window to start at %s, got %s", tc.expectedNext, next)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+)
+
+const (
+	AutoRepairEnabledAnnotation          = "kubermatic.io/auto-repair-enabled"
+	AutoRepairUnhealthyTimeoutAnnotation = "kubermatic.io/auto-repair-unhealthy-timeout"
+
+	// minAutoRepairUnhealthyTimeout prevents machines from being replaced while their nodes are still joining
+	// the cluster or are only briefly NotReady, e.g. during a kubelet restart.
+	minAutoRepairUnhealthyTimeout = 5 * time.Minute
+)
+
+// ValidateAutoRepair validates the auto-repair settings of the node deployment spec. The unhealthy timeout has to
+// be a duration of at least 5 minutes.
+func ValidateAutoRepair(spec apiv1.NodeDeploymentSpec) error {
+	if spec.AutoRepair == nil {
+		return nil
+	}
+
+	timeout, err := time.ParseDuration(spec.AutoRepair.UnhealthyTimeout)
+	if err != nil {
+		return fmt.Errorf("auto-repair unhealthyTimeout '%s' must be a duration, e.g. 10m", spec.AutoRepair.UnhealthyTimeout)
+	}
+	if timeout < minAutoRepairUnhealthyTimeout {
+		return fmt.Errorf("auto-repair unhealthyTimeout '%s' must be at least %s", spec.AutoRepair.UnhealthyTimeout, minAutoRepairUnhealthyTimeout)
+	}
+
+	return nil
+}
+
+func setAutoRepairAnnotations(annotations map[string]string, autoRepair *apiv1.NodeDeploymentAutoRepair) {
+	delete(annotations, AutoRepairEnabledAnnotation)
+	delete(annotations, AutoRepairUnhealthyTimeoutAnnotation)
+
+	if autoRepair == nil {
+		return
+	}
+
+	annotations[AutoRepairEnabledAnnotation] = strconv.FormatBool(autoRepair.Enabled)
+	annotations[AutoRepairUnhealthyTimeoutAnnotation] = autoRepair.UnhealthyTimeout
+}
+
+// GetAutoRepair returns the auto-repair settings stored in the machine deployment annotations.
+func GetAutoRepair(annotations map[string]string) *apiv1.NodeDeploymentAutoRepair {
+	enabled, ok := annotations[AutoRepairEnabledAnnotation]
+	if !ok {
+		return nil
+	}
+
+	return &apiv1.NodeDeploymentAutoRepair{
+		Enabled:          enabled == "true",
+		UnhealthyTimeout: annotations[AutoRepairUnhealthyTimeoutAnnotation],
+	}
+}
@@ -26,6 +26,7 @@ import (
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	machineconversions "k8c.io/dashboard/v2/pkg/machine"
 	nutanixprovider "k8c.io/dashboard/v2/pkg/provider/cloud/nutanix"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
@@ -243,6 +244,18 @@ func GetVSphereProviderConfig(c *kubermaticv1.Cluster, nodeSpec apiv1.NodeSpec,
 		}
 	}
 
+	if len(nodeSpec.Cloud.VSphere.AdditionalNetworks) > 0 {
+		// The deprecated single network of the cluster is moved into the list, so that the machines are still
+		// attached to it.
+		if len(config.Networks) == 0 && config.VMNetName.Value != "" {
+			config.Networks = []providerconfig.ConfigVarString{config.VMNetName}
+			config.VMNetName = providerconfig.ConfigVarString{}
+		}
+		for _, network := range nodeSpec.Cloud.VSphere.AdditionalNetworks {
+			config.Networks = append(config.Networks, providerconfig.ConfigVarString{Value: network})
+		}
+	}
+
 	config.Tags = []vsphere.Tag{}
 	for _, tag := range nodeSpec.Cloud.VSphere.Tags {
 		vsphereTag := vsphere.Tag{
@@ -462,6 +475,12 @@ func GetHetznerProviderConfig(c *kubermaticv1.Cluster, nodeSpec apiv1.NodeSpec,
 		ServerType: providerconfig.ConfigVarString{Value: nodeSpec.Cloud.Hetzner.Type},
 	}
 
+	// The firewalls are only set if one is selected, so that the provider spec of existing machine deployments
+	// doesn't change.
+	if firewall := nodeSpec.Cloud.Hetzner.Firewall; firewall != "" {
+		config.Firewalls = []providerconfig.ConfigVarString{{Value: firewall}}
+	}
+
 	return config, nil
 }
 
@@ -818,17 +837,20 @@ func getNutanixProviderSpec(c *kubermaticv1.Cluster, nodeSpec apiv1.NodeSpec, dc
 }
 
 func getUbuntuOperatingSystemSpec(nodeSpec apiv1.NodeSpec) (*runtime.RawExtension, error) {
-	return EncodeAsRawExtension(ubuntu.Config{
-		DistUpgradeOnBoot: nodeSpec.OperatingSystem.Ubuntu.DistUpgradeOnBoot,
+	return EncodeAsRawExtension(machineconversions.UbuntuConfig{
+		Config: ubuntu.Config{
+			DistUpgradeOnBoot: nodeSpec.OperatingSystem.Ubuntu.DistUpgradeOnBoot,
+		},
+		UbuntuProToken: nodeSpec.OperatingSystem.Ubuntu.UbuntuProToken.Value(),
 	})
 }
 
 func getRHELOperatingSystemSpec(nodeSpec apiv1.NodeSpec) (*runtime.RawExtension, error) {
 	return EncodeAsRawExtension(rhel.Config{
 		DistUpgradeOnBoot:               nodeSpec.OperatingSystem.RHEL.DistUpgradeOnBoot,
-		RHELSubscriptionManagerUser:     nodeSpec.OperatingSystem.RHEL.RHELSubscriptionManagerUser,
-		RHELSubscriptionManagerPassword: nodeSpec.OperatingSystem.RHEL.RHELSubscriptionManagerPassword,
-		RHSMOfflineToken:                nodeSpec.OperatingSystem.RHEL.RHSMOfflineToken,
+		RHELSubscriptionManagerUser:     nodeSpec.OperatingSystem.RHEL.RHELSubscriptionManagerUser.Value(),
+		RHELSubscriptionManagerPassword: nodeSpec.OperatingSystem.RHEL.RHELSubscriptionManagerPassword.Value(),
+		RHSMOfflineToken:                nodeSpec.OperatingSystem.RHEL.RHSMOfflineToken.Value(),
 	})
 }
 
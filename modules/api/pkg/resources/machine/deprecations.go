@@ -0,0 +1,114 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"fmt"
+
+	semverlib "github.com/Masterminds/semver/v3"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+)
+
+// fieldDeprecation is a deprecated or ignored field of the node deployment API.
+type fieldDeprecation struct {
+	// field is the JSON path of the field.
+	field string
+	// reason tells users why the field is deprecated and what to use instead.
+	reason string
+	// versions is a constraint of the kubelet versions the deprecation applies to, it applies to all
+	// versions if empty.
+	versions string
+	// isSet returns whether the field is used by the node deployment.
+	isSet func(nd *apiv1.NodeDeployment) bool
+}
+
+var fieldDeprecations = []fieldDeprecation{
+	{
+		field:    "spec.dynamicConfig",
+		reason:   "dynamic kubelet configuration is deprecated since Kubernetes 1.22 and removed in 1.24",
+		versions: ">= 1.22, < 1.24",
+		isSet: func(nd *apiv1.NodeDeployment) bool {
+			return nd.Spec.DynamicConfig != nil && *nd.Spec.DynamicConfig
+		},
+	},
+	{
+		field:  "spec.template.cloud.digitalocean.ipv6",
+		reason: "IPv6 is enabled automatically based on the IP family of the cluster",
+		isSet: func(nd *apiv1.NodeDeployment) bool {
+			return nd.Spec.Template.Cloud.Digitalocean != nil && nd.Spec.Template.Cloud.Digitalocean.IPv6
+		},
+	},
+	{
+		field:  "spec.template.cloud.kubevirt.flavorName",
+		reason: "the field is ignored, use instancetype and preference instead",
+		isSet: func(nd *apiv1.NodeDeployment) bool {
+			return nd.Spec.Template.Cloud.Kubevirt != nil && nd.Spec.Template.Cloud.Kubevirt.FlavorName != ""
+		},
+	},
+	{
+		field:  "spec.template.cloud.kubevirt.flavorProfile",
+		reason: "the field is ignored, use instancetype and preference instead",
+		isSet: func(nd *apiv1.NodeDeployment) bool {
+			return nd.Spec.Template.Cloud.Kubevirt != nil && nd.Spec.Template.Cloud.Kubevirt.FlavorProfile != ""
+		},
+	},
+	{
+		field:  "spec.template.cloud.kubevirt.podAffinityPreset",
+		reason: "the field is ignored, use topologySpreadConstraints instead",
+		isSet: func(nd *apiv1.NodeDeployment) bool {
+			return nd.Spec.Template.Cloud.Kubevirt != nil && nd.Spec.Template.Cloud.Kubevirt.PodAffinityPreset != ""
+		},
+	},
+	{
+		field:  "spec.template.cloud.kubevirt.podAntiAffinityPreset",
+		reason: "the field is ignored, use topologySpreadConstraints instead",
+		isSet: func(nd *apiv1.NodeDeployment) bool {
+			return nd.Spec.Template.Cloud.Kubevirt != nil && nd.Spec.Template.Cloud.Kubevirt.PodAntiAffinityPreset != ""
+		},
+	},
+	{
+		field:  "spec.template.cloud.anexia.diskSize",
+		reason: "use disks instead",
+		isSet: func(nd *apiv1.NodeDeployment) bool {
+			return nd.Spec.Template.Cloud.Anexia != nil && nd.Spec.Template.Cloud.Anexia.DiskSize != nil
+		},
+	},
+}
+
+// DeprecationWarnings returns a warning for each deprecated or ignored field used by the node deployment.
+// Deprecations which only apply to some Kubernetes versions are checked against the given kubelet version
+// and skipped if it can't be parsed.
+func DeprecationWarnings(nd *apiv1.NodeDeployment, kubeletVersion string) []string {
+	version, versionErr := semverlib.NewVersion(kubeletVersion)
+
+	var warnings []string
+	for _, deprecation := range fieldDeprecations {
+		if !deprecation.isSet(nd) {
+			continue
+		}
+		if deprecation.versions != "" {
+			constraint, err := semverlib.NewConstraint(deprecation.versions)
+			if err != nil || versionErr != nil || !constraint.Check(version) {
+				continue
+			}
+		}
+		warnings = append(warnings, fmt.Sprintf("%s is deprecated: %s", deprecation.field, deprecation.reason))
+	}
+
+	return warnings
+}
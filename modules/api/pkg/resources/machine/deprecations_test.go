@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"reflect"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestDeprecationWarnings(t *testing.T) {
+	tests := []struct {
+		name           string
+		nd             *apiv1.NodeDeployment
+		kubeletVersion string
+		want           []string
+	}{
+		{
+			name:           "no warnings without deprecated fields",
+			nd:             &apiv1.NodeDeployment{},
+			kubeletVersion: "1.31.1",
+		},
+		{
+			name:           "warn about dynamic config on versions which still support it",
+			nd:             &apiv1.NodeDeployment{Spec: apiv1.NodeDeploymentSpec{DynamicConfig: ptr.To(true)}},
+			kubeletVersion: "1.23.5",
+			want:           []string{"spec.dynamicConfig is deprecated: dynamic kubelet configuration is deprecated since Kubernetes 1.22 and removed in 1.24"},
+		},
+		{
+			name:           "skip dynamic config on versions before the deprecation",
+			nd:             &apiv1.NodeDeployment{Spec: apiv1.NodeDeploymentSpec{DynamicConfig: ptr.To(true)}},
+			kubeletVersion: "1.21.0",
+		},
+		{
+			name:           "skip version specific deprecations for an invalid version",
+			nd:             &apiv1.NodeDeployment{Spec: apiv1.NodeDeploymentSpec{DynamicConfig: ptr.To(true)}},
+			kubeletVersion: "latest",
+		},
+		{
+			name: "warn about all deprecated fields of the cloud spec",
+			nd: &apiv1.NodeDeployment{Spec: apiv1.NodeDeploymentSpec{Template: apiv1.NodeSpec{Cloud: apiv1.NodeCloudSpec{
+				Kubevirt: &apiv1.KubevirtNodeSpec{FlavorName: "small", PodAntiAffinityPreset: "hard"},
+			}}}},
+			kubeletVersion: "1.31.1",
+			want: []string{
+				"spec.template.cloud.kubevirt.flavorName is deprecated: the field is ignored, use instancetype and preference instead",
+				"spec.template.cloud.kubevirt.podAntiAffinityPreset is deprecated: the field is ignored, use topologySpreadConstraints instead",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DeprecationWarnings(tt.nd, tt.kubeletVersion); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DeprecationWarnings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+// InstanceTypeFiltersAnnotation holds the instance type filters of the datacenters of a seed as JSON,
+// keyed by the datacenter name.
+const InstanceTypeFiltersAnnotation = "kubermatic.io/instance-type-filters"
+
+// GetInstanceType returns the instance type, size or flavor of the node cloud spec. It is empty for providers
+// which don't use named instance types.
+func GetInstanceType(cloud apiv1.NodeCloudSpec) string {
+	switch {
+	case cloud.AWS != nil:
+		return cloud.AWS.InstanceType
+	case cloud.Azure != nil:
+		return cloud.Azure.Size
+	case cloud.Digitalocean != nil:
+		return cloud.Digitalocean.Size
+	case cloud.GCP != nil:
+		return cloud.GCP.MachineType
+	case cloud.Hetzner != nil:
+		return cloud.Hetzner.Type
+	case cloud.Openstack != nil:
+		return cloud.Openstack.Flavor
+	case cloud.Packet != nil:
+		return cloud.Packet.InstanceType
+	case cloud.Alibaba != nil:
+		return cloud.Alibaba.InstanceType
+	case cloud.Kubevirt != nil && cloud.Kubevirt.Instancetype != nil:
+		return cloud.Kubevirt.Instancetype.Name
+	default:
+		return ""
+	}
+}
+
+func getInstanceTypeFilters(seed *kubermaticv1.Seed) (map[string]apiv2.InstanceTypeFilter, error) {
+	filters := map[string]apiv2.InstanceTypeFilter{}
+
+	value, ok := seed.Annotations[InstanceTypeFiltersAnnotation]
+	if !ok || value == "" {
+		return filters, nil
+	}
+	if err := json.Unmarshal([]byte(value), &filters); err != nil {
+		return nil, fmt.Errorf("failed to parse instance type filters of seed %s: %w", seed.Name, err)
+	}
+
+	return filters, nil
+}
+
+// GetInstanceTypeFilter returns the instance type filter of the datacenter or nil if the datacenter has none.
+func GetInstanceTypeFilter(seed *kubermaticv1.Seed, datacenter string) (*apiv2.InstanceTypeFilter, error) {
+	filters, err := getInstanceTypeFilters(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, ok := filters[datacenter]
+	if !ok {
+		return nil, nil
+	}
+
+	return &filter, nil
+}
+
+// SetInstanceTypeFilter sets the instance type filter of the datacenter on the seed. A nil filter removes it.
+func SetInstanceTypeFilter(seed *kubermaticv1.Seed, datacenter string, filter *apiv2.InstanceTypeFilter) error {
+	filters, err := getInstanceTypeFilters(seed)
+	if err != nil {
+		return err
+	}
+
+	if filter == nil {
+		delete(filters, datacenter)
+	} else {
+		filters[datacenter] = *filter
+	}
+
+	if len(filters) == 0 {
+		delete(seed.Annotations, InstanceTypeFiltersAnnotation)
+		return nil
+	}
+
+	value, err := json.Marshal(filters)
+	if err != nil {
+		return err
+	}
+	if seed.Annotations == nil {
+		seed.Annotations = map[string]string{}
+	}
+	seed.Annotations[InstanceTypeFiltersAnnotation] = string(value)
+
+	return nil
+}
+
+// ValidateInstanceType checks the instance type of the node cloud spec against the instance type filter of the
+// datacenter. Denied instance types are rejected and, if the filter has an allowlist, so is every instance type
+// which is not on it.
+func ValidateInstanceType(filter *apiv2.InstanceTypeFilter, datacenter string, cloud apiv1.NodeCloudSpec) error {
+	instanceType := GetInstanceType(cloud)
+	if filter == nil || instanceType == "" {
+		return nil
+	}
+
+	if slices.Contains(filter.Denied, instanceType) {
+		return fmt.Errorf("instance type %q is denied by the instance type filter of datacenter %q", instanceType, datacenter)
+	}
+	if len(filter.Allowed) > 0 && !slices.Contains(filter.Allowed, instanceType) {
+		return fmt.Errorf("instance type %q is not allowed by the instance type filter of datacenter %q, allowed instance types are %v", instanceType, datacenter, filter.Allowed)
+	}
+
+	return nil
+}
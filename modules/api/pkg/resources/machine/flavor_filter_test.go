@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+)
+
+func TestValidateInstanceType(t *testing.T) {
+	filter := &apiv2.InstanceTypeFilter{
+		Allowed: []string{"t3.medium", "m5.large"},
+		Denied:  []string{"m5.large"},
+	}
+
+	tests := []struct {
+		name    string
+		filter  *apiv2.InstanceTypeFilter
+		cloud   apiv1.NodeCloudSpec
+		wantErr bool
+	}{
+		{
+			name:  "no filter",
+			cloud: apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "p3.16xlarge"}},
+		},
+		{
+			name:   "allowed instance type",
+			filter: filter,
+			cloud:  apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "t3.medium"}},
+		},
+		{
+			name:    "denied instance type",
+			filter:  filter,
+			cloud:   apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "m5.large"}},
+			wantErr: true,
+		},
+		{
+			name:    "instance type which is not allowed",
+			filter:  filter,
+			cloud:   apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "p3.16xlarge"}},
+			wantErr: true,
+		},
+		{
+			name:   "denylist only",
+			filter: &apiv2.InstanceTypeFilter{Denied: []string{"Standard_D64s_v3"}},
+			cloud:  apiv1.NodeCloudSpec{Azure: &apiv1.AzureNodeSpec{Size: "Standard_B2s"}},
+		},
+		{
+			name:   "provider without instance types",
+			filter: filter,
+			cloud:  apiv1.NodeCloudSpec{VSphere: &apiv1.VSphereNodeSpec{CPUs: 64}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateInstanceType(tt.filter, "aws-eu-central-1a", tt.cloud)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateInstanceType() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
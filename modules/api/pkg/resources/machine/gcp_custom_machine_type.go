@@ -0,0 +1,275 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+)
+
+// gcpCustomMachineTypeMemoryStep is the granularity of the memory of GCP custom machine types in MB.
+const gcpCustomMachineTypeMemoryStep = 256
+
+var (
+	// Custom machine types have the form [SERIES-]custom-VCPUS-MEMORY[-ext], the series defaults to N1.
+	// See https://cloud.google.com/compute/docs/instances/creating-instance-with-custom-machine-type
+	gcpCustomMachineTypeRegexp = regexp.MustCompile(`^(?:([a-z0-9]+)-)?custom-([0-9]+)-([0-9]+)(-ext)?$`)
+	gcpCustomMachineTypePrefix = regexp.MustCompile(`^(?:[a-z0-9]+-)?custom-`)
+)
+
+// gcpCustomMachineTypeSeries describes the shapes GCP allows for the custom machine types of a machine series.
+type gcpCustomMachineTypeSeries struct {
+	name     string
+	maxVCPUs int64
+	// validVCPUs reports whether the vCPU count is allowed, counts above maxVCPUs are never allowed.
+	validVCPUs       func(vcpus int64) bool
+	vcpusDescription string
+	// Memory bounds in MB, GCP uses 1 GB = 1024 MB.
+	minMemoryPerVCPU float64
+	maxMemoryPerVCPU float64
+	maxMemory        int64
+	extendedMemory   bool
+}
+
+var gcpCustomMachineTypeSeriesList = []gcpCustomMachineTypeSeries{
+	{
+		name:     "n1",
+		maxVCPUs: 96,
+		validVCPUs: func(vcpus int64) bool {
+			return vcpus == 1 || vcpus%2 == 0
+		},
+		vcpusDescription: "1 or an even number of vCPUs up to 96",
+		minMemoryPerVCPU: 0.9 * 1024,
+		maxMemoryPerVCPU: 6.5 * 1024,
+		maxMemory:        624 * 1024,
+		extendedMemory:   true,
+	},
+	{
+		name:     "n2",
+		maxVCPUs: 128,
+		validVCPUs: func(vcpus int64) bool {
+			switch {
+			case vcpus <= 32:
+				return vcpus%2 == 0
+			case vcpus <= 80:
+				return vcpus%4 == 0
+			default:
+				return vcpus%8 == 0
+			}
+		},
+		vcpusDescription: "a multiple of 2 vCPUs up to 32, a multiple of 4 up to 80 or a multiple of 8 up to 128",
+		minMemoryPerVCPU: 0.5 * 1024,
+		maxMemoryPerVCPU: 8 * 1024,
+		maxMemory:        864 * 1024,
+		extendedMemory:   true,
+	},
+	{
+		name:     "n2d",
+		maxVCPUs: 96,
+		validVCPUs: func(vcpus int64) bool {
+			return vcpus == 2 || vcpus == 4 || vcpus == 8 || vcpus%16 == 0
+		},
+		vcpusDescription: "2, 4, 8 or a multiple of 16 vCPUs up to 96",
+		minMemoryPerVCPU: 0.5 * 1024,
+		maxMemoryPerVCPU: 8 * 1024,
+		maxMemory:        768 * 1024,
+		extendedMemory:   true,
+	},
+	{
+		name:     "e2",
+		maxVCPUs: 32,
+		validVCPUs: func(vcpus int64) bool {
+			return vcpus%2 == 0
+		},
+		vcpusDescription: "an even number of vCPUs from 2 up to 32",
+		minMemoryPerVCPU: 0.5 * 1024,
+		maxMemoryPerVCPU: 8 * 1024,
+		maxMemory:        128 * 1024,
+	},
+}
+
+// GCPCustomMachineType is a parsed GCP custom machine type, e.g. n2-custom-6-20480.
+type GCPCustomMachineType struct {
+	Series         string
+	VCPUs          int64
+	Memory         int64
+	ExtendedMemory bool
+}
+
+// IsGCPCustomMachineType reports whether the machine type is meant to be a custom machine type. It does not
+// validate the machine type, see ParseGCPCustomMachineType.
+func IsGCPCustomMachineType(machineType string) bool {
+	return gcpCustomMachineTypePrefix.MatchString(machineType)
+}
+
+// ParseGCPCustomMachineType parses and validates a custom machine type of the form [SERIES-]custom-VCPUS-MEMORY[-ext]
+// with the memory in MB. The vCPU count and the memory have to satisfy the rules of the machine series.
+func ParseGCPCustomMachineType(machineType string) (*GCPCustomMachineType, error) {
+	match := gcpCustomMachineTypeRegexp.FindStringSubmatch(machineType)
+	if match == nil {
+		return nil, fmt.Errorf("custom machine type '%s' must have the form [SERIES-]custom-VCPUS-MEMORY[-ext] with the memory in MB, e.g. custom-6-20480", machineType)
+	}
+
+	seriesName := match[1]
+	if seriesName == "" {
+		seriesName = "n1"
+	}
+	series := getGCPCustomMachineTypeSeries(seriesName)
+	if series == nil {
+		return nil, fmt.Errorf("custom machine type '%s' is not supported for the %s series, supported series: %s", machineType, seriesName, strings.Join(gcpCustomMachineTypeSeriesNames(), ", "))
+	}
+
+	vcpus, err := strconv.ParseInt(match[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("custom machine type '%s' has an invalid vCPU count: %w", machineType, err)
+	}
+	memory, err := strconv.ParseInt(match[3], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("custom machine type '%s' has an invalid memory: %w", machineType, err)
+	}
+
+	customType := &GCPCustomMachineType{
+		Series:         series.name,
+		VCPUs:          vcpus,
+		Memory:         memory,
+		ExtendedMemory: match[4] != "",
+	}
+	if err := series.validate(customType); err != nil {
+		return nil, fmt.Errorf("custom machine type '%s' is invalid: %w", machineType, err)
+	}
+
+	return customType, nil
+}
+
+func (s *gcpCustomMachineTypeSeries) validate(customType *GCPCustomMachineType) error {
+	if customType.VCPUs < 1 || customType.VCPUs > s.maxVCPUs || !s.validVCPUs(customType.VCPUs) {
+		return fmt.Errorf("%s custom machine types need %s, got %d", strings.ToUpper(s.name), s.vcpusDescription, customType.VCPUs)
+	}
+
+	if customType.Memory%gcpCustomMachineTypeMemoryStep != 0 {
+		return fmt.Errorf("the memory of %d MB must be a multiple of %d MB", customType.Memory, gcpCustomMachineTypeMemoryStep)
+	}
+
+	if customType.ExtendedMemory && !s.extendedMemory {
+		return fmt.Errorf("%s custom machine types do not support extended memory", strings.ToUpper(s.name))
+	}
+
+	vcpus := float64(customType.VCPUs)
+	memory := float64(customType.Memory)
+	if memory < vcpus*s.minMemoryPerVCPU {
+		return fmt.Errorf("the memory of %d MB is less than the minimum of %g MB per vCPU for %d vCPUs", customType.Memory, s.minMemoryPerVCPU, customType.VCPUs)
+	}
+	if !customType.ExtendedMemory && memory > vcpus*s.maxMemoryPerVCPU {
+		extended := ""
+		if s.extendedMemory {
+			extended = ", use the -ext suffix for extended memory"
+		}
+		return fmt.Errorf("the memory of %d MB is more than the maximum of %g MB per vCPU for %d vCPUs%s", customType.Memory, s.maxMemoryPerVCPU, customType.VCPUs, extended)
+	}
+	if customType.Memory > s.maxMemory {
+		return fmt.Errorf("the memory of %d MB is more than the maximum of %d MB", customType.Memory, s.maxMemory)
+	}
+
+	return nil
+}
+
+// ValidateGCPMachineType validates the machine type of the GCP node spec if it is a custom machine type. Predefined
+// machine types are left to GCP.
+func ValidateGCPMachineType(cloud apiv1.NodeCloudSpec) error {
+	if cloud.GCP == nil || !IsGCPCustomMachineType(cloud.GCP.MachineType) {
+		return nil
+	}
+
+	_, err := ParseGCPCustomMachineType(cloud.GCP.MachineType)
+	return err
+}
+
+// GCPCustomMachineTypeSizes returns an entry with the bounds of the custom machine types for every machine series
+// which is available according to the given predefined machine types, e.g. n2 custom machine types are only
+// returned if a n2 machine type is available in the zone.
+func GCPCustomMachineTypeSizes(available apiv1.GCPMachineSizeList) apiv1.GCPMachineSizeList {
+	sizes := apiv1.GCPMachineSizeList{}
+	for i := range gcpCustomMachineTypeSeriesList {
+		series := &gcpCustomMachineTypeSeriesList[i]
+		if !hasGCPMachineSeries(available, series.name) {
+			continue
+		}
+
+		name := series.name + "-custom"
+		if series.name == "n1" {
+			name = "custom"
+		}
+		sizes = append(sizes, apiv1.GCPMachineSize{
+			Name:        name,
+			Description: fmt.Sprintf("Custom %s machine type", strings.ToUpper(series.name)),
+			Custom:      series.bounds(),
+		})
+	}
+
+	return sizes
+}
+
+func (s *gcpCustomMachineTypeSeries) bounds() *apiv1.GCPCustomMachineTypeBounds {
+	bounds := &apiv1.GCPCustomMachineTypeBounds{
+		Series:           s.name,
+		MemoryStep:       gcpCustomMachineTypeMemoryStep,
+		MinMemoryPerVCPU: s.minMemoryPerVCPU,
+		MaxMemoryPerVCPU: s.maxMemoryPerVCPU,
+		MaxMemory:        s.maxMemory,
+		ExtendedMemory:   s.extendedMemory,
+	}
+	for vcpus := int64(1); vcpus <= s.maxVCPUs; vcpus++ {
+		if s.validVCPUs(vcpus) {
+			bounds.VCPUs = append(bounds.VCPUs, vcpus)
+		}
+	}
+
+	return bounds
+}
+
+func hasGCPMachineSeries(sizes apiv1.GCPMachineSizeList, series string) bool {
+	for _, size := range sizes {
+		if strings.HasPrefix(size.Name, series+"-") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getGCPCustomMachineTypeSeries(name string) *gcpCustomMachineTypeSeries {
+	for i := range gcpCustomMachineTypeSeriesList {
+		if gcpCustomMachineTypeSeriesList[i].name == name {
+			return &gcpCustomMachineTypeSeriesList[i]
+		}
+	}
+
+	return nil
+}
+
+func gcpCustomMachineTypeSeriesNames() []string {
+	names := make([]string, 0, len(gcpCustomMachineTypeSeriesList))
+	for _, series := range gcpCustomMachineTypeSeriesList {
+		names = append(names, series.name)
+	}
+
+	return names
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+)
+
+func TestParseGCPCustomMachineType(t *testing.T) {
+	tests := []struct {
+		name        string
+		machineType string
+		want        *GCPCustomMachineType
+		wantErr     string
+	}{
+		{
+			name:        "N1 custom machine type without series",
+			machineType: "custom-6-20480",
+			want:        &GCPCustomMachineType{Series: "n1", VCPUs: 6, Memory: 20480},
+		},
+		{
+			name:        "N1 custom machine type with a single vCPU",
+			machineType: "custom-1-1024",
+			want:        &GCPCustomMachineType{Series: "n1", VCPUs: 1, Memory: 1024},
+		},
+		{
+			name:        "N2 custom machine type",
+			machineType: "n2-custom-36-65536",
+			want:        &GCPCustomMachineType{Series: "n2", VCPUs: 36, Memory: 65536},
+		},
+		{
+			name:        "N1 custom machine type with extended memory",
+			machineType: "custom-2-32768-ext",
+			want:        &GCPCustomMachineType{Series: "n1", VCPUs: 2, Memory: 32768, ExtendedMemory: true},
+		},
+		{
+			name:        "malformed custom machine type",
+			machineType: "custom-6",
+			wantErr:     "custom machine type 'custom-6' must have the form [SERIES-]custom-VCPUS-MEMORY[-ext] with the memory in MB, e.g. custom-6-20480",
+		},
+		{
+			name:        "unsupported series",
+			machineType: "c2-custom-4-16384",
+			wantErr:     "custom machine type 'c2-custom-4-16384' is not supported for the c2 series, supported series: n1, n2, n2d, e2",
+		},
+		{
+			name:        "odd vCPU count",
+			machineType: "custom-3-4096",
+			wantErr:     "custom machine type 'custom-3-4096' is invalid: N1 custom machine types need 1 or an even number of vCPUs up to 96, got 3",
+		},
+		{
+			name:        "too many vCPUs",
+			machineType: "e2-custom-34-34816",
+			wantErr:     "custom machine type 'e2-custom-34-34816' is invalid: E2 custom machine types need an even number of vCPUs from 2 up to 32, got 34",
+		},
+		{
+			name:        "memory is not a multiple of 256 MB",
+			machineType: "custom-2-4000",
+			wantErr:     "custom machine type 'custom-2-4000' is invalid: the memory of 4000 MB must be a multiple of 256 MB",
+		},
+		{
+			name:        "memory below the minimum per vCPU",
+			machineType: "custom-4-3584",
+			wantErr:     "custom machine type 'custom-4-3584' is invalid: the memory of 3584 MB is less than the minimum of 921.6 MB per vCPU for 4 vCPUs",
+		},
+		{
+			name:        "memory above the maximum per vCPU",
+			machineType: "custom-2-16384",
+			wantErr:     "custom machine type 'custom-2-16384' is invalid: the memory of 16384 MB is more than the maximum of 6656 MB per vCPU for 2 vCPUs, use the -ext suffix for extended memory",
+		},
+		{
+			name:        "extended memory is not supported",
+			machineType: "e2-custom-2-32768-ext",
+			wantErr:     "custom machine type 'e2-custom-2-32768-ext' is invalid: E2 custom machine types do not support extended memory",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseGCPCustomMachineType(test.machineType)
+			if test.wantErr != "" {
+				if err == nil || err.Error() != test.wantErr {
+					t.Fatalf("expected error %q, got: %v", test.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if *got != *test.want {
+				t.Fatalf("expected %+v, got %+v", *test.want, *got)
+			}
+		})
+	}
+}
+
+func TestValidateGCPMachineType(t *testing.T) {
+	tests := []struct {
+		name    string
+		cloud   apiv1.NodeCloudSpec
+		wantErr bool
+	}{
+		{
+			name:  "predefined machine type",
+			cloud: apiv1.NodeCloudSpec{GCP: &apiv1.GCPNodeSpec{MachineType: "n1-standard-2"}},
+		},
+		{
+			name:  "valid custom machine type",
+			cloud: apiv1.NodeCloudSpec{GCP: &apiv1.GCPNodeSpec{MachineType: "n2d-custom-16-32768"}},
+		},
+		{
+			name:    "invalid custom machine type",
+			cloud:   apiv1.NodeCloudSpec{GCP: &apiv1.GCPNodeSpec{MachineType: "n2d-custom-6-12288"}},
+			wantErr: true,
+		},
+		{
+			name:  "other provider",
+			cloud: apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "custom-3-1"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateGCPMachineType(test.cloud)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error: %v, got: %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestGCPCustomMachineTypeSizes(t *testing.T) {
+	available := apiv1.GCPMachineSizeList{
+		{Name: "n1-standard-2"},
+		{Name: "e2-medium"},
+		{Name: "n2d-standard-2"},
+	}
+
+	sizes := GCPCustomMachineTypeSizes(available)
+
+	var names []string
+	for _, size := range sizes {
+		if size.Custom == nil {
+			t.Fatalf("expected custom bounds for %s", size.Name)
+		}
+		names = append(names, size.Name)
+	}
+	if len(names) != 3 || names[0] != "custom" || names[1] != "n2d-custom" || names[2] != "e2-custom" {
+		t.Fatalf("expected custom, n2d-custom and e2-custom, got %v", names)
+	}
+
+	n2d := sizes[1].Custom
+	if n2d.MemoryStep != 256 || n2d.MinMemoryPerVCPU != 512 || n2d.MaxMemoryPerVCPU != 8192 || !n2d.ExtendedMemory {
+		t.Fatalf("unexpected N2D bounds: %+v", *n2d)
+	}
+	wantVCPUs := []int64{2, 4, 8, 16, 32, 48, 64, 80, 96}
+	if len(n2d.VCPUs) != len(wantVCPUs) {
+		t.Fatalf("expected vCPUs %v, got %v", wantVCPUs, n2d.VCPUs)
+	}
+	for i := range wantVCPUs {
+		if n2d.VCPUs[i] != wantVCPUs[i] {
+			t.Fatalf("expected vCPUs %v, got %v", wantVCPUs, n2d.VCPUs)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// generateNameSuffixLength is the length of the random suffix appended to the generateName of a node deployment.
+	generateNameSuffixLength = 5
+
+	// maxGenerateNameAttempts is the number of names which are tried before giving up on creating a machine
+	// deployment with a generated name.
+	maxGenerateNameAttempts = 5
+)
+
+// generateNameSuffix returns the random suffix of a generated name, it is replaced in tests.
+var generateNameSuffix = func() string {
+	return rand.String(generateNameSuffixLength)
+}
+
+// ValidateGenerateName validates that only one of name and generateName is set and that the names generated
+// from generateName are valid machine deployment names.
+func ValidateGenerateName(nd *apiv1.NodeDeployment) error {
+	if nd.GenerateName == "" {
+		return nil
+	}
+	if nd.Name != "" {
+		return fmt.Errorf("name and generateName are mutually exclusive")
+	}
+
+	// The suffix consists of lowercase alphanumeric characters, so any of them can stand in for it.
+	if errs := validation.IsDNS1123Label(nd.GenerateName + strings.Repeat("x", generateNameSuffixLength)); len(errs) > 0 {
+		return fmt.Errorf("invalid generateName %q, it must be a valid DNS label of at most %d characters including the %d character suffix: %s", nd.GenerateName, validation.DNS1123LabelMaxLength, generateNameSuffixLength, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// CreateWithGeneratedName creates the machine deployment under a name consisting of generateName and a random
+// suffix. Names which are already taken are skipped, so the machine deployment never collides with an existing one.
+// The generated name is set on the machine deployment.
+func CreateWithGeneratedName(ctx context.Context, client ctrlruntimeclient.Client, md *clusterv1alpha1.MachineDeployment, generateName string) error {
+	md.GenerateName = ""
+	for i := 0; i < maxGenerateNameAttempts; i++ {
+		md.Name = generateName + generateNameSuffix()
+		if err := client.Create(ctx, md); !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("failed to find an unused name for generateName %q after %d attempts", generateName, maxGenerateNameAttempts)
+}
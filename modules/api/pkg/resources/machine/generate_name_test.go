@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestValidateGenerateName(t *testing.T) {
+	tests := []struct {
+		name    string
+		nd      apiv1.NodeDeployment
+		wantErr bool
+	}{
+		{
+			name: "neither name nor generateName",
+		},
+		{
+			name: "name only",
+			nd:   apiv1.NodeDeployment{ObjectMeta: apiv1.ObjectMeta{Name: "workers"}},
+		},
+		{
+			name: "generateName only",
+			nd:   apiv1.NodeDeployment{GenerateName: "workers-eu-central-1a-"},
+		},
+		{
+			name:    "name and generateName",
+			nd:      apiv1.NodeDeployment{ObjectMeta: apiv1.ObjectMeta{Name: "workers"}, GenerateName: "workers-"},
+			wantErr: true,
+		},
+		{
+			name: "generateName at the length limit",
+			nd:   apiv1.NodeDeployment{GenerateName: strings.Repeat("a", 58)},
+		},
+		{
+			name:    "generateName exceeding the length limit with the suffix",
+			nd:      apiv1.NodeDeployment{GenerateName: strings.Repeat("a", 59)},
+			wantErr: true,
+		},
+		{
+			name:    "generateName with invalid characters",
+			nd:      apiv1.NodeDeployment{GenerateName: "Workers_"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGenerateName(&tt.nd)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCreateWithGeneratedName(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clusterv1alpha1.AddToScheme(scheme))
+
+	existing := func(name string) *clusterv1alpha1.MachineDeployment {
+		return &clusterv1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceSystem}}
+	}
+
+	defaultSuffix := generateNameSuffix
+	defer func() { generateNameSuffix = defaultSuffix }()
+
+	tests := []struct {
+		name         string
+		existing     []*clusterv1alpha1.MachineDeployment
+		suffixes     []string
+		expectedName string
+		wantErr      bool
+	}{
+		{
+			name:         "name is generated",
+			suffixes:     []string{"abcde"},
+			expectedName: "workers-abcde",
+		},
+		{
+			name:         "taken names are skipped",
+			existing:     []*clusterv1alpha1.MachineDeployment{existing("workers-abcde"), existing("workers-fghij")},
+			suffixes:     []string{"abcde", "fghij", "klmno"},
+			expectedName: "workers-klmno",
+		},
+		{
+			name:     "giving up after too many collisions",
+			existing: []*clusterv1alpha1.MachineDeployment{existing("workers-abcde")},
+			suffixes: []string{"abcde", "abcde", "abcde", "abcde", "abcde"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fakectrlruntimeclient.NewClientBuilder().WithScheme(scheme)
+			for _, md := range tt.existing {
+				builder.WithObjects(md)
+			}
+			client := builder.Build()
+
+			suffixes := tt.suffixes
+			generateNameSuffix = func() string {
+				if len(suffixes) == 0 {
+					t.Fatal("more suffixes were generated than expected")
+				}
+				suffix := suffixes[0]
+				suffixes = suffixes[1:]
+				return suffix
+			}
+
+			md := &clusterv1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, GenerateName: "workers-"}}
+			err := CreateWithGeneratedName(context.Background(), client, md, "workers-")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if md.Name != tt.expectedName {
+				t.Fatalf("expected name %q, got %q", tt.expectedName, md.Name)
+			}
+			if err := client.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(md), &clusterv1alpha1.MachineDeployment{}); err != nil {
+				t.Fatalf("failed to get the created machine deployment: %v", err)
+			}
+		})
+	}
+}
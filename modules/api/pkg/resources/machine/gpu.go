@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	ec2 "github.com/cristim/ec2-instances-info"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/machine-controller/sdk/providerconfig"
+)
+
+const (
+	GPUMIGStrategyNone   = "none"
+	GPUMIGStrategySingle = "single"
+	GPUMIGStrategyMixed  = "mixed"
+)
+
+var (
+	gpuDriverVersionRegexp = regexp.MustCompile(`^[0-9]+(\.[0-9]+)*$`)
+
+	// GCP machine families which always come with GPUs attached.
+	// See https://cloud.google.com/compute/docs/accelerator-optimized-machines
+	gcpGPUMachineFamilies = []string{"a2-", "a3-", "a4-", "g2-"}
+
+	// The AWS instance type data is big, so it is only loaded when it is needed for the first time.
+	awsInstanceData = sync.OnceValues(ec2.Data)
+)
+
+// InstanceTypeHasGPU checks whether the instance type of the given node cloud spec has GPUs attached.
+// The second return value is false if the provider does not expose accelerator info for the instance type.
+func InstanceTypeHasGPU(cloud apiv1.NodeCloudSpec) (hasGPU bool, known bool, err error) {
+	switch {
+	case cloud.AWS != nil:
+		return awsInstanceTypeHasGPU(cloud.AWS.InstanceType)
+	case cloud.GCP != nil:
+		hasGPU, known := gcpMachineTypeHasGPU(cloud.GCP.MachineType)
+		return hasGPU, known, nil
+	default:
+		return false, false, nil
+	}
+}
+
+func awsInstanceTypeHasGPU(instanceType string) (bool, bool, error) {
+	data, err := awsInstanceData()
+	if err != nil {
+		return false, false, fmt.Errorf("failed to load the AWS instance types: %w", err)
+	}
+
+	for _, i := range *data {
+		if i.InstanceType == instanceType {
+			return i.GPU > 0, true, nil
+		}
+	}
+
+	return false, false, nil
+}
+
+func gcpMachineTypeHasGPU(machineType string) (bool, bool) {
+	if machineType == "" {
+		return false, false
+	}
+
+	for _, family := range gcpGPUMachineFamilies {
+		if strings.HasPrefix(machineType, family) {
+			return true, true
+		}
+	}
+
+	return false, true
+}
+
+// ValidateGPU validates the GPU settings of the node spec against the selected instance type.
+func ValidateGPU(spec apiv1.NodeSpec) error {
+	gpu := spec.GPU
+	if gpu == nil {
+		return nil
+	}
+
+	switch gpu.MIGStrategy {
+	case "", GPUMIGStrategyNone, GPUMIGStrategySingle, GPUMIGStrategyMixed:
+	default:
+		return fmt.Errorf("GPU MIG strategy '%s' not allowed. Allowed: %s, %s, %s", gpu.MIGStrategy, GPUMIGStrategyNone, GPUMIGStrategySingle, GPUMIGStrategyMixed)
+	}
+
+	if gpu.DriverVersion != "" && !gpuDriverVersionRegexp.MatchString(gpu.DriverVersion) {
+		return fmt.Errorf("invalid GPU driver version '%s'", gpu.DriverVersion)
+	}
+
+	if !gpu.Enabled {
+		return nil
+	}
+
+	hasGPU, known, err := InstanceTypeHasGPU(spec.Cloud)
+	if err != nil {
+		return err
+	}
+	if known && !hasGPU {
+		return fmt.Errorf("GPU driver cannot be enabled, the selected instance type has no GPUs")
+	}
+
+	return nil
+}
+
+// GPUWarning returns a warning if a GPU instance type was selected without GPU driver settings.
+func GPUWarning(spec apiv1.NodeSpec) string {
+	if spec.GPU != nil {
+		return ""
+	}
+
+	// The warning is only a hint, so it is skipped if the instance types can't be loaded.
+	if hasGPU, _, err := InstanceTypeHasGPU(spec.Cloud); err == nil && hasGPU {
+		return "the selected instance type has GPUs but no GPU driver settings were provided"
+	}
+
+	return ""
+}
+
+// setGPU stores the GPU settings in the operating system spec of the provider config, where the operating system
+// profiles pick them up to install the GPU driver while provisioning the nodes.
+func setGPU(config *providerconfig.Config, gpu *apiv1.GPUSpec) error {
+	if gpu == nil {
+		return nil
+	}
+
+	return setOperatingSystemSpecField(config, "gpu", gpu)
+}
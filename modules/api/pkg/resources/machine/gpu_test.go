@@ -0,0 +1,202 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/machine-controller/sdk/providerconfig"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestInstanceTypeHasGPU(t *testing.T) {
+	tests := []struct {
+		name       string
+		cloud      apiv1.NodeCloudSpec
+		wantHasGPU bool
+		wantKnown  bool
+	}{
+		{
+			name:       "AWS GPU instance type",
+			cloud:      apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "p3.2xlarge"}},
+			wantHasGPU: true,
+			wantKnown:  true,
+		},
+		{
+			name:       "AWS instance type without GPUs",
+			cloud:      apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "t3.medium"}},
+			wantHasGPU: false,
+			wantKnown:  true,
+		},
+		{
+			name:       "unknown AWS instance type",
+			cloud:      apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "x99.unknown"}},
+			wantHasGPU: false,
+			wantKnown:  false,
+		},
+		{
+			name:       "GCP accelerator-optimized machine type",
+			cloud:      apiv1.NodeCloudSpec{GCP: &apiv1.GCPNodeSpec{MachineType: "a2-highgpu-1g"}},
+			wantHasGPU: true,
+			wantKnown:  true,
+		},
+		{
+			name:       "GCP machine type without GPUs",
+			cloud:      apiv1.NodeCloudSpec{GCP: &apiv1.GCPNodeSpec{MachineType: "n1-standard-2"}},
+			wantHasGPU: false,
+			wantKnown:  true,
+		},
+		{
+			name:       "provider without accelerator info",
+			cloud:      apiv1.NodeCloudSpec{Hetzner: &apiv1.HetznerNodeSpec{Type: "cx21"}},
+			wantHasGPU: false,
+			wantKnown:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hasGPU, known, err := InstanceTypeHasGPU(test.cloud)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hasGPU != test.wantHasGPU || known != test.wantKnown {
+				t.Fatalf("expected (hasGPU=%v, known=%v), got (hasGPU=%v, known=%v)", test.wantHasGPU, test.wantKnown, hasGPU, known)
+			}
+		})
+	}
+}
+
+func TestValidateGPU(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        apiv1.NodeSpec
+		wantErr     bool
+		wantWarning bool
+	}{
+		{
+			name: "AWS GPU instance type with GPU driver enabled",
+			spec: apiv1.NodeSpec{
+				Cloud: apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "p3.2xlarge"}},
+				GPU:   &apiv1.GPUSpec{Enabled: true, DriverVersion: "550.54.15", MIGStrategy: GPUMIGStrategyNone},
+			},
+		},
+		{
+			name: "AWS instance type without GPUs with GPU driver enabled",
+			spec: apiv1.NodeSpec{
+				Cloud: apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "t3.medium"}},
+				GPU:   &apiv1.GPUSpec{Enabled: true},
+			},
+			wantErr: true,
+		},
+		{
+			name: "AWS GPU instance type without GPU settings",
+			spec: apiv1.NodeSpec{
+				Cloud: apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "p3.2xlarge"}},
+			},
+			wantWarning: true,
+		},
+		{
+			name: "GCP GPU machine type with MIG strategy",
+			spec: apiv1.NodeSpec{
+				Cloud: apiv1.NodeCloudSpec{GCP: &apiv1.GCPNodeSpec{MachineType: "a2-highgpu-1g"}},
+				GPU:   &apiv1.GPUSpec{Enabled: true, MIGStrategy: GPUMIGStrategyMixed},
+			},
+		},
+		{
+			name: "GCP machine type without GPUs with GPU driver enabled",
+			spec: apiv1.NodeSpec{
+				Cloud: apiv1.NodeCloudSpec{GCP: &apiv1.GCPNodeSpec{MachineType: "n1-standard-2"}},
+				GPU:   &apiv1.GPUSpec{Enabled: true},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCP GPU machine type without GPU settings",
+			spec: apiv1.NodeSpec{
+				Cloud: apiv1.NodeCloudSpec{GCP: &apiv1.GCPNodeSpec{MachineType: "g2-standard-4"}},
+			},
+			wantWarning: true,
+		},
+		{
+			name: "GCP machine type without GPUs with GPU driver disabled",
+			spec: apiv1.NodeSpec{
+				Cloud: apiv1.NodeCloudSpec{GCP: &apiv1.GCPNodeSpec{MachineType: "n1-standard-2"}},
+				GPU:   &apiv1.GPUSpec{Enabled: false},
+			},
+		},
+		{
+			name: "invalid MIG strategy",
+			spec: apiv1.NodeSpec{
+				Cloud: apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "p3.2xlarge"}},
+				GPU:   &apiv1.GPUSpec{Enabled: true, MIGStrategy: "all"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid driver version",
+			spec: apiv1.NodeSpec{
+				Cloud: apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "p3.2xlarge"}},
+				GPU:   &apiv1.GPUSpec{Enabled: true, DriverVersion: "latest"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateGPU(test.spec)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error: %v, got: %v", test.wantErr, err)
+			}
+
+			warning := GPUWarning(test.spec)
+			if (warning != "") != test.wantWarning {
+				t.Fatalf("expected warning: %v, got: %q", test.wantWarning, warning)
+			}
+		})
+	}
+}
+
+func TestSetGPU(t *testing.T) {
+	config := &providerconfig.Config{
+		OperatingSystemSpec: runtime.RawExtension{Raw: []byte(`{"distUpgradeOnBoot":true}`)},
+	}
+
+	gpu := &apiv1.GPUSpec{Enabled: true, DriverVersion: "550.54.15", MIGStrategy: GPUMIGStrategySingle}
+	if err := setGPU(config, gpu); err != nil {
+		t.Fatal(err)
+	}
+
+	osSpec := struct {
+		DistUpgradeOnBoot bool           `json:"distUpgradeOnBoot"`
+		GPU               *apiv1.GPUSpec `json:"gpu"`
+	}{}
+	if err := json.Unmarshal(config.OperatingSystemSpec.Raw, &osSpec); err != nil {
+		t.Fatal(err)
+	}
+	if !osSpec.DistUpgradeOnBoot {
+		t.Fatal("expected the existing operating system settings to be kept")
+	}
+	if !reflect.DeepEqual(osSpec.GPU, gpu) {
+		t.Fatalf("expected GPU settings %+v, got %+v", gpu, osSpec.GPU)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+)
+
+// PendingInitialMachineDeploymentsAnnotation holds the initial machine deployments of a cluster as a JSON list,
+// which have not been handed over to the initial machine deployment controller yet. The controller only processes
+// a single machine deployment from the kubermaticv1.InitialMachineDeploymentRequestAnnotation, so the remaining
+// ones are queued here and promoted one at a time.
+const PendingInitialMachineDeploymentsAnnotation = "kubermatic.io/pending-initial-machinedeployments-request"
+
+// SetInitialMachineDeployments stores the initial machine deployments on the cluster. The first one is requested
+// from the initial machine deployment controller right away, the others are queued.
+func SetInitialMachineDeployments(cluster *kubermaticv1.Cluster, mds []*clusterv1alpha1.MachineDeployment) error {
+	if len(mds) == 0 {
+		return nil
+	}
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+
+	data, err := json.Marshal(mds[0])
+	if err != nil {
+		return fmt.Errorf("cannot marshal initial machine deployment: %w", err)
+	}
+	cluster.Annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation] = string(data)
+
+	return setPendingInitialMachineDeployments(cluster, mds[1:])
+}
+
+// PromotePendingInitialMachineDeployment requests the next queued initial machine deployment from the initial
+// machine deployment controller, once the controller has processed the previous one. It returns true if the
+// cluster was changed and needs to be updated.
+func PromotePendingInitialMachineDeployment(cluster *kubermaticv1.Cluster) (bool, error) {
+	value := cluster.Annotations[PendingInitialMachineDeploymentsAnnotation]
+	if value == "" || cluster.Annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation] != "" {
+		return false, nil
+	}
+
+	var pending []json.RawMessage
+	if err := json.Unmarshal([]byte(value), &pending); err != nil {
+		return false, fmt.Errorf("failed to parse pending initial machine deployments of cluster %s: %w", cluster.Name, err)
+	}
+	if len(pending) == 0 {
+		delete(cluster.Annotations, PendingInitialMachineDeploymentsAnnotation)
+		return true, nil
+	}
+
+	cluster.Annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation] = string(pending[0])
+	if len(pending) == 1 {
+		delete(cluster.Annotations, PendingInitialMachineDeploymentsAnnotation)
+		return true, nil
+	}
+
+	data, err := json.Marshal(pending[1:])
+	if err != nil {
+		return false, err
+	}
+	cluster.Annotations[PendingInitialMachineDeploymentsAnnotation] = string(data)
+
+	return true, nil
+}
+
+// GetInitialMachineDeployments returns the initial machine deployments of the cluster which were not created yet,
+// the one requested from the initial machine deployment controller followed by the queued ones.
+func GetInitialMachineDeployments(cluster *kubermaticv1.Cluster) ([]*clusterv1alpha1.MachineDeployment, error) {
+	var mds []*clusterv1alpha1.MachineDeployment
+
+	if value := cluster.Annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation]; value != "" {
+		md := &clusterv1alpha1.MachineDeployment{}
+		if err := json.Unmarshal([]byte(value), md); err != nil {
+			return nil, fmt.Errorf("failed to parse initial machine deployment of cluster %s: %w", cluster.Name, err)
+		}
+		mds = append(mds, md)
+	}
+
+	if value := cluster.Annotations[PendingInitialMachineDeploymentsAnnotation]; value != "" {
+		var pending []*clusterv1alpha1.MachineDeployment
+		if err := json.Unmarshal([]byte(value), &pending); err != nil {
+			return nil, fmt.Errorf("failed to parse pending initial machine deployments of cluster %s: %w", cluster.Name, err)
+		}
+		mds = append(mds, pending...)
+	}
+
+	return mds, nil
+}
+
+func setPendingInitialMachineDeployments(cluster *kubermaticv1.Cluster, mds []*clusterv1alpha1.MachineDeployment) error {
+	if len(mds) == 0 {
+		delete(cluster.Annotations, PendingInitialMachineDeploymentsAnnotation)
+		return nil
+	}
+
+	data, err := json.Marshal(mds)
+	if err != nil {
+		return fmt.Errorf("cannot marshal pending initial machine deployments: %w", err)
+	}
+	cluster.Annotations[PendingInitialMachineDeploymentsAnnotation] = string(data)
+
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"encoding/json"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPromotePendingInitialMachineDeployment(t *testing.T) {
+	cluster := &kubermaticv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster-abc"}}
+	var mds []*clusterv1alpha1.MachineDeployment
+	for _, name := range []string{"first", "second", "third"} {
+		mds = append(mds, &clusterv1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+
+	if err := SetInitialMachineDeployments(cluster, mds); err != nil {
+		t.Fatalf("failed to set initial machine deployments: %v", err)
+	}
+
+	initial, err := GetInitialMachineDeployments(cluster)
+	if err != nil {
+		t.Fatalf("failed to get initial machine deployments: %v", err)
+	}
+	if len(initial) != 3 || initial[0].Name != "first" || initial[2].Name != "third" {
+		t.Fatalf("expected the requested and the queued machine deployments, got %v", initial)
+	}
+
+	// the previous machine deployment has not been processed by the controller yet
+	promoted, err := PromotePendingInitialMachineDeployment(cluster)
+	if err != nil {
+		t.Fatalf("failed to promote initial machine deployment: %v", err)
+	}
+	if promoted {
+		t.Fatal("expected no machine deployment to be promoted while the previous one is still requested")
+	}
+
+	for _, expected := range []string{"first", "second", "third"} {
+		md := &clusterv1alpha1.MachineDeployment{}
+		if err := json.Unmarshal([]byte(cluster.Annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation]), md); err != nil {
+			t.Fatalf("failed to unmarshal initial machine deployment: %v", err)
+		}
+		if md.Name != expected {
+			t.Fatalf("expected initial machine deployment %q, got %q", expected, md.Name)
+		}
+
+		// simulate the controller processing the request
+		delete(cluster.Annotations, kubermaticv1.InitialMachineDeploymentRequestAnnotation)
+
+		promoted, err := PromotePendingInitialMachineDeployment(cluster)
+		if err != nil {
+			t.Fatalf("failed to promote initial machine deployment: %v", err)
+		}
+		if promoted != (expected != "third") {
+			t.Fatalf("unexpected promotion result %t after %q", promoted, expected)
+		}
+	}
+
+	if _, ok := cluster.Annotations[PendingInitialMachineDeploymentsAnnotation]; ok {
+		t.Fatal("expected the pending machine deployments annotation to be removed")
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	clustercommon "k8c.io/machine-controller/sdk/apis/cluster/common"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	KubeletConfigSystemReserved      = "systemReserved"
+	KubeletConfigKubeReserved        = "kubeReserved"
+	KubeletConfigEvictionHard        = "evictionHard"
+	KubeletConfigMaxPods             = "maxPods"
+	KubeletConfigContainerLogMaxSize = "containerLogMaxSize"
+
+	minKubeletMaxPods = 10
+	maxKubeletMaxPods = 500
+)
+
+var (
+	// kubeletConfigNames are the names of the kubelet configs in the machine deployment annotations, which
+	// operating-system-manager uses to render the kubelet configuration of the nodes.
+	kubeletConfigNames = map[string]string{
+		KubeletConfigSystemReserved:      clustercommon.SystemReservedKubeletConfig,
+		KubeletConfigKubeReserved:        clustercommon.KubeReservedKubeletConfig,
+		KubeletConfigEvictionHard:        clustercommon.EvictionHardKubeletConfig,
+		KubeletConfigMaxPods:             clustercommon.MaxPodsKubeletConfig,
+		KubeletConfigContainerLogMaxSize: clustercommon.ContainerLogMaxSizeKubeletConfig,
+	}
+
+	reservedResourceNames = sets.New("cpu", "memory", "ephemeral-storage", "pid")
+
+	evictionSignals = sets.New(
+		"memory.available",
+		"nodefs.available",
+		"nodefs.inodesFree",
+		"imagefs.available",
+		"imagefs.inodesFree",
+		"containerfs.available",
+		"containerfs.inodesFree",
+		"pid.available",
+	)
+
+	evictionThresholdPercentageRegexp = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?%$`)
+)
+
+// ValidateKubeletConfig validates the kubelet config of the node spec. The reserved resources are lists like
+// cpu=200m,memory=1Gi, the hard eviction thresholds lists like memory.available<500Mi,nodefs.available<10%.
+func ValidateKubeletConfig(spec apiv1.NodeSpec) error {
+	for _, key := range sets.List(sets.KeySet(spec.KubeletConfig)) {
+		value := spec.KubeletConfig[key]
+		if _, ok := kubeletConfigNames[key]; !ok {
+			return fmt.Errorf("kubelet config '%s' not supported. Supported: %s", key, strings.Join(sets.List(sets.KeySet(kubeletConfigNames)), ", "))
+		}
+
+		var err error
+		switch key {
+		case KubeletConfigSystemReserved, KubeletConfigKubeReserved:
+			err = validateReservedResources(value)
+		case KubeletConfigEvictionHard:
+			err = validateEvictionThresholds(value)
+		case KubeletConfigMaxPods:
+			err = validateMaxPods(value)
+		case KubeletConfigContainerLogMaxSize:
+			_, err = resource.ParseQuantity(value)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid kubelet config %s '%s': %w", key, value, err)
+		}
+	}
+
+	return nil
+}
+
+func validateReservedResources(value string) error {
+	for _, pair := range strings.Split(value, ",") {
+		name, quantity, ok := strings.Cut(pair, "=")
+		if !ok {
+			return fmt.Errorf("'%s' must be a resource=quantity pair", pair)
+		}
+		if !reservedResourceNames.Has(name) {
+			return fmt.Errorf("resource '%s' not allowed. Allowed: %s", name, strings.Join(sets.List(reservedResourceNames), ", "))
+		}
+		if _, err := resource.ParseQuantity(quantity); err != nil {
+			return fmt.Errorf("quantity of %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func validateEvictionThresholds(value string) error {
+	for _, pair := range strings.Split(value, ",") {
+		signal, threshold, ok := strings.Cut(pair, "<")
+		if !ok {
+			return fmt.Errorf("'%s' must be a signal<threshold pair", pair)
+		}
+		if !evictionSignals.Has(signal) {
+			return fmt.Errorf("eviction signal '%s' not allowed. Allowed: %s", signal, strings.Join(sets.List(evictionSignals), ", "))
+		}
+		if evictionThresholdPercentageRegexp.MatchString(threshold) {
+			continue
+		}
+		if _, err := resource.ParseQuantity(threshold); err != nil {
+			return fmt.Errorf("threshold of %s must be a quantity or a percentage", signal)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxPods(value string) error {
+	maxPods, err := strconv.Atoi(value)
+	if err != nil {
+		return errors.New("must be an integer")
+	}
+	if maxPods < minKubeletMaxPods || maxPods > maxKubeletMaxPods {
+		return fmt.Errorf("must be between %d and %d", minKubeletMaxPods, maxKubeletMaxPods)
+	}
+
+	return nil
+}
+
+func kubeletConfigAnnotation(name string) string {
+	return fmt.Sprintf("%s/%s", clustercommon.KubeletConfigAnnotationPrefixV1, name)
+}
+
+// setKubeletConfigAnnotations replaces the kubelet configs of the node spec in the machine deployment annotations.
+// Other kubelet configs, which are not supported by the API, are kept.
+func setKubeletConfigAnnotations(annotations map[string]string, kubeletConfig map[string]string) {
+	for key, name := range kubeletConfigNames {
+		delete(annotations, kubeletConfigAnnotation(name))
+
+		if value, ok := kubeletConfig[key]; ok {
+			annotations[kubeletConfigAnnotation(name)] = value
+		}
+	}
+}
+
+// GetKubeletConfig returns the kubelet config stored in the machine deployment annotations.
+func GetKubeletConfig(annotations map[string]string) map[string]string {
+	var kubeletConfig map[string]string
+	for key, name := range kubeletConfigNames {
+		value, ok := annotations[kubeletConfigAnnotation(name)]
+		if !ok {
+			continue
+		}
+		if kubeletConfig == nil {
+			kubeletConfig = map[string]string{}
+		}
+		kubeletConfig[key] = value
+	}
+
+	return kubeletConfig
+}
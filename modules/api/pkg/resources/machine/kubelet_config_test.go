@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"reflect"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+)
+
+func TestValidateKubeletConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		kubeletConfig map[string]string
+		wantErr       string
+	}{
+		{
+			name: "all supported configs",
+			kubeletConfig: map[string]string{
+				KubeletConfigSystemReserved:      "cpu=200m,memory=1Gi,ephemeral-storage=1Gi",
+				KubeletConfigKubeReserved:        "cpu=100m,memory=500Mi",
+				KubeletConfigEvictionHard:        "memory.available<500Mi,nodefs.available<10%,imagefs.available<15.5%",
+				KubeletConfigMaxPods:             "110",
+				KubeletConfigContainerLogMaxSize: "50Mi",
+			},
+		},
+		{
+			name:          "no kubelet config",
+			kubeletConfig: nil,
+		},
+		{
+			name:          "unknown config",
+			kubeletConfig: map[string]string{"cpuManagerPolicy": "static"},
+			wantErr:       "kubelet config 'cpuManagerPolicy' not supported. Supported: containerLogMaxSize, evictionHard, kubeReserved, maxPods, systemReserved",
+		},
+		{
+			name:          "reserved resource without quantity",
+			kubeletConfig: map[string]string{KubeletConfigSystemReserved: "cpu"},
+			wantErr:       "invalid kubelet config systemReserved 'cpu': 'cpu' must be a resource=quantity pair",
+		},
+		{
+			name:          "unknown reserved resource",
+			kubeletConfig: map[string]string{KubeletConfigKubeReserved: "gpu=1"},
+			wantErr:       "invalid kubelet config kubeReserved 'gpu=1': resource 'gpu' not allowed. Allowed: cpu, ephemeral-storage, memory, pid",
+		},
+		{
+			name:          "unknown eviction signal",
+			kubeletConfig: map[string]string{KubeletConfigEvictionHard: "memory.free<1Gi"},
+			wantErr:       "invalid kubelet config evictionHard 'memory.free<1Gi': eviction signal 'memory.free' not allowed. Allowed: containerfs.available, containerfs.inodesFree, imagefs.available, imagefs.inodesFree, memory.available, nodefs.available, nodefs.inodesFree, pid.available",
+		},
+		{
+			name:          "invalid eviction threshold",
+			kubeletConfig: map[string]string{KubeletConfigEvictionHard: "nodefs.available<ten percent"},
+			wantErr:       "invalid kubelet config evictionHard 'nodefs.available<ten percent': threshold of nodefs.available must be a quantity or a percentage",
+		},
+		{
+			name:          "eviction threshold with another operator",
+			kubeletConfig: map[string]string{KubeletConfigEvictionHard: "memory.available=1Gi"},
+			wantErr:       "invalid kubelet config evictionHard 'memory.available=1Gi': 'memory.available=1Gi' must be a signal<threshold pair",
+		},
+		{
+			name:          "maxPods is not an integer",
+			kubeletConfig: map[string]string{KubeletConfigMaxPods: "many"},
+			wantErr:       "invalid kubelet config maxPods 'many': must be an integer",
+		},
+		{
+			name:          "maxPods below the minimum",
+			kubeletConfig: map[string]string{KubeletConfigMaxPods: "5"},
+			wantErr:       "invalid kubelet config maxPods '5': must be between 10 and 500",
+		},
+		{
+			name:          "invalid container log size",
+			kubeletConfig: map[string]string{KubeletConfigContainerLogMaxSize: "50 MB"},
+			wantErr:       "invalid kubelet config containerLogMaxSize '50 MB': quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateKubeletConfig(apiv1.NodeSpec{KubeletConfig: test.kubeletConfig})
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != test.wantErr {
+				t.Fatalf("expected error %q, got: %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestKubeletConfigAnnotations(t *testing.T) {
+	annotations := map[string]string{
+		"v1.kubelet-config.machine-controller.kubermatic.io/KubeReserved":         "cpu=100m",
+		"v1.kubelet-config.machine-controller.kubermatic.io/ContainerLogMaxFiles": "5",
+		"k8c.io/other": "value",
+	}
+	kubeletConfig := map[string]string{
+		KubeletConfigSystemReserved: "cpu=200m,memory=1Gi",
+		KubeletConfigEvictionHard:   "memory.available<500Mi",
+		KubeletConfigMaxPods:        "200",
+	}
+
+	setKubeletConfigAnnotations(annotations, kubeletConfig)
+
+	expectedAnnotations := map[string]string{
+		"v1.kubelet-config.machine-controller.kubermatic.io/SystemReserved":       "cpu=200m,memory=1Gi",
+		"v1.kubelet-config.machine-controller.kubermatic.io/EvictionHard":         "memory.available<500Mi",
+		"v1.kubelet-config.machine-controller.kubermatic.io/MaxPods":              "200",
+		"v1.kubelet-config.machine-controller.kubermatic.io/ContainerLogMaxFiles": "5",
+		"k8c.io/other": "value",
+	}
+	if !reflect.DeepEqual(annotations, expectedAnnotations) {
+		t.Fatalf("expected annotations %v, got %v", expectedAnnotations, annotations)
+	}
+
+	if got := GetKubeletConfig(annotations); !reflect.DeepEqual(got, kubeletConfig) {
+		t.Fatalf("expected kubelet config %v, got %v", kubeletConfig, got)
+	}
+
+	if got := GetKubeletConfig(map[string]string{"k8c.io/other": "value"}); got != nil {
+		t.Fatalf("expected no kubelet config, got %v", got)
+	}
+}
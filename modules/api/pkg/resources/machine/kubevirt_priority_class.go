@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+)
+
+// KubeVirtPriorityClassNameAnnotation holds the priority class of the VM pods of a KubeVirt machine deployment,
+// as the machine-controller provider spec has no field for it.
+const KubeVirtPriorityClassNameAnnotation = "kubermatic.io/kubevirt-priority-class-name"
+
+func setKubeVirtPriorityClassAnnotation(annotations map[string]string, cloud apiv1.NodeCloudSpec) {
+	delete(annotations, KubeVirtPriorityClassNameAnnotation)
+
+	if cloud.Kubevirt == nil || cloud.Kubevirt.PriorityClassName == "" {
+		return
+	}
+
+	annotations[KubeVirtPriorityClassNameAnnotation] = cloud.Kubevirt.PriorityClassName
+}
+
+// SetKubeVirtPriorityClassName sets the priority class stored in the machine deployment annotations on the
+// KubeVirt node spec.
+func SetKubeVirtPriorityClassName(cloud *apiv1.NodeCloudSpec, annotations map[string]string) {
+	if cloud.Kubevirt == nil {
+		return
+	}
+
+	cloud.Kubevirt.PriorityClassName = annotations[KubeVirtPriorityClassNameAnnotation]
+}
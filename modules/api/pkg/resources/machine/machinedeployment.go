@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -31,6 +32,7 @@ import (
 	"k8c.io/dashboard/v2/pkg/provider"
 	"k8c.io/dashboard/v2/pkg/validation"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
 	"k8c.io/kubermatic/v2/pkg/validation/nodeupdate"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 	machinecontrollernet "k8c.io/machine-controller/sdk/net"
@@ -47,6 +49,17 @@ import (
 const (
 	AutoscalerMinSizeAnnotation = "cluster.k8s.io/cluster-api-autoscaler-node-group-min-size"
 	AutoscalerMaxSizeAnnotation = "cluster.k8s.io/cluster-api-autoscaler-node-group-max-size"
+
+	// AutoscalerAnnotationPrefix is the prefix of all cluster-autoscaler node group annotations. These
+	// annotations are managed through the minReplicas and maxReplicas fields of the node deployment.
+	AutoscalerAnnotationPrefix = "cluster.k8s.io/cluster-api-autoscaler-node-group-"
+
+	// InternalAnnotationPrefix is the prefix of annotations which are used internally by KKP.
+	InternalAnnotationPrefix = "kubermatic.k8c.io/"
+
+	// AllowMixedCloudProvidersAnnotation can be set on a cluster whose nodes legitimately run on
+	// a different cloud provider than the control plane, e.g. clusters with externally managed nodes.
+	AllowMixedCloudProvidersAnnotation = "kubermatic.io/allow-mixed-cloud-providers"
 )
 
 // Deployment returns a Machine Deployment object for the given Node Deployment spec.
@@ -60,6 +73,8 @@ func Deployment(ctx context.Context, c *kubermaticv1.Cluster, nd *apiv1.NodeDepl
 
 	if nd.Name != "" {
 		md.Name = nd.Name
+	} else if nd.GenerateName != "" {
+		md.GenerateName = nd.GenerateName
 	} else {
 		// GenerateName can be set only if Name is empty to avoid confusing error:
 		// https://github.com/kubernetes/kubernetes/issues/32220
@@ -69,11 +84,20 @@ func Deployment(ctx context.Context, c *kubermaticv1.Cluster, nd *apiv1.NodeDepl
 	// Add Annotations to Machine Deployment
 	md.Annotations = nd.Annotations
 
+	// An explicitly selected OSP takes precedence over the annotation.
+	if nd.Spec.Template.OSProfile != "" {
+		if md.Annotations == nil {
+			md.Annotations = make(map[string]string)
+		}
+
+		md.Annotations[osmresources.MachineDeploymentOSPAnnotation] = nd.Spec.Template.OSProfile
+	}
+
 	// OSP is an optional value passed via annotations with fallback logic:
 	// 1. Use existing non-empty annotation if present
 	// 2. Fall back to datacenter-level defaults when annotation is missing/empty
 	// 3. Allow empty value to let OSM apply its defaulting logic
-	if osp := nd.Annotations[osmresources.MachineDeploymentOSPAnnotation]; osp == "" {
+	if osp := md.Annotations[osmresources.MachineDeploymentOSPAnnotation]; osp == "" {
 		osp = getOperatingSystemProfile(nd, dc)
 		if osp != "" {
 			if md.Annotations == nil {
@@ -136,6 +160,12 @@ func Deployment(ctx context.Context, c *kubermaticv1.Cluster, nd *apiv1.NodeDepl
 		delete(md.Annotations, AutoscalerMinSizeAnnotation)
 	}
 
+	setAutoRepairAnnotations(md.Annotations, nd.Spec.AutoRepair)
+	setKubeletConfigAnnotations(md.Annotations, nd.Spec.Template.KubeletConfig)
+	setKubeVirtPriorityClassAnnotation(md.Annotations, nd.Spec.Template.Cloud)
+	setVSphereAdditionalNetworksAnnotation(md.Annotations, nd.Spec.Template.Cloud)
+	setNetworkAnnotations(md.Annotations, nd.Spec.Template.Network)
+
 	md.Spec.Template.Spec.Versions.Kubelet = nd.Spec.Template.Versions.Kubelet
 
 	// Deprecated: This is not supported for 1.24 and higher and is blocked by
@@ -166,6 +196,8 @@ func Deployment(ctx context.Context, c *kubermaticv1.Cluster, nd *apiv1.NodeDepl
 		md.Spec.Paused = *nd.Spec.Paused
 	}
 
+	setRollingUpdateStrategy(md, nd.Spec)
+
 	config, err := getProviderConfig(c, nd, dc, keys)
 	if err != nil {
 		return nil, err
@@ -176,6 +208,16 @@ func Deployment(ctx context.Context, c *kubermaticv1.Cluster, nd *apiv1.NodeDepl
 		return nil, err
 	}
 
+	err = setAdditionalUserData(config, nd.Spec.Template.AdditionalUserData)
+	if err != nil {
+		return nil, err
+	}
+
+	err = setGPU(config, nd.Spec.Template.GPU)
+	if err != nil {
+		return nil, err
+	}
+
 	b, err := json.Marshal(config)
 	if err != nil {
 		return nil, err
@@ -309,16 +351,7 @@ func getProviderConfig(c *kubermaticv1.Cluster, nd *apiv1.NodeDeployment, dc *ku
 		config.Network = &providerconfig.NetworkConfig{}
 	}
 
-	switch {
-	case c.IsIPv4Only():
-		config.Network.IPFamily = machinecontrollernet.IPFamilyIPv4
-	case c.IsIPv6Only():
-		config.Network.IPFamily = machinecontrollernet.IPFamilyIPv6
-	case c.IsDualStack():
-		config.Network.IPFamily = machinecontrollernet.IPFamilyIPv4IPv6
-	default:
-		config.Network.IPFamily = machinecontrollernet.IPFamilyUnspecified
-	}
+	config.Network.IPFamily = getNetworkIPFamily(c, nd.Spec.Template.Network)
 
 	return &config, nil
 }
@@ -391,6 +424,130 @@ func getProviderOS(config *providerconfig.Config, nd *apiv1.NodeDeployment) erro
 	return nil
 }
 
+// NodeCloudProviderName returns the provider name for the given NodeCloudSpec.
+func NodeCloudProviderName(spec apiv1.NodeCloudSpec) (string, error) {
+	var clouds []kubermaticv1.ProviderType
+	if spec.AWS != nil {
+		clouds = append(clouds, kubermaticv1.AWSCloudProvider)
+	}
+	if spec.Alibaba != nil {
+		clouds = append(clouds, kubermaticv1.AlibabaCloudProvider)
+	}
+	if spec.Anexia != nil {
+		clouds = append(clouds, kubermaticv1.AnexiaCloudProvider)
+	}
+	if spec.Azure != nil {
+		clouds = append(clouds, kubermaticv1.AzureCloudProvider)
+	}
+	if spec.Baremetal != nil {
+		clouds = append(clouds, kubermaticv1.BaremetalCloudProvider)
+	}
+	if spec.Edge != nil {
+		clouds = append(clouds, kubermaticv1.EdgeCloudProvider)
+	}
+	if spec.Digitalocean != nil {
+		clouds = append(clouds, kubermaticv1.DigitaloceanCloudProvider)
+	}
+	if spec.GCP != nil {
+		clouds = append(clouds, kubermaticv1.GCPCloudProvider)
+	}
+	if spec.Hetzner != nil {
+		clouds = append(clouds, kubermaticv1.HetznerCloudProvider)
+	}
+	if spec.Kubevirt != nil {
+		clouds = append(clouds, kubermaticv1.KubevirtCloudProvider)
+	}
+	if spec.Openstack != nil {
+		clouds = append(clouds, kubermaticv1.OpenstackCloudProvider)
+	}
+	if spec.Packet != nil {
+		clouds = append(clouds, kubermaticv1.PacketCloudProvider)
+	}
+	if spec.VSphere != nil {
+		clouds = append(clouds, kubermaticv1.VSphereCloudProvider)
+	}
+	if spec.Nutanix != nil {
+		clouds = append(clouds, kubermaticv1.NutanixCloudProvider)
+	}
+	if spec.OpenNebula != nil {
+		// OpenNebula has no cluster cloud provider, so there is no ProviderType constant for it.
+		clouds = append(clouds, kubermaticv1.ProviderType("opennebula"))
+	}
+	if spec.VMwareCloudDirector != nil {
+		clouds = append(clouds, kubermaticv1.VMwareCloudDirectorCloudProvider)
+	}
+	if len(clouds) == 0 {
+		return "", nil
+	}
+	if len(clouds) != 1 {
+		return "", fmt.Errorf("only one cloud provider can be set in NodeCloudSpec, but found the following providers: %v", clouds)
+	}
+	return string(clouds[0]), nil
+}
+
+// ValidateCloudProvider ensures that the node deployment runs on the same cloud provider as the cluster.
+// The check is skipped for clusters annotated with AllowMixedCloudProvidersAnnotation.
+func ValidateCloudProvider(c *kubermaticv1.Cluster, nd *apiv1.NodeDeployment) error {
+	if c.Annotations[AllowMixedCloudProvidersAnnotation] == "true" {
+		return nil
+	}
+
+	clusterProvider, err := kubermaticv1helper.ClusterCloudProviderName(c.Spec.Cloud)
+	if err != nil {
+		return err
+	}
+	// Nothing to compare against, e.g. for clusters without a cloud spec.
+	if clusterProvider == "" {
+		return nil
+	}
+
+	nodeProvider, err := NodeCloudProviderName(nd.Spec.Template.Cloud)
+	if err != nil {
+		return err
+	}
+	if nodeProvider == "" {
+		return fmt.Errorf("machine deployment has no cloud provider, but the cluster provider is %s", clusterProvider)
+	}
+	if nodeProvider != clusterProvider {
+		return fmt.Errorf("machine deployment cloud provider %s does not match cluster provider %s", nodeProvider, clusterProvider)
+	}
+
+	return nil
+}
+
+// ValidateAllowMixedCloudProvidersAnnotation ensures that only admins set, change or remove the
+// AllowMixedCloudProvidersAnnotation of a cluster, as it disables the cloud provider check of its machine deployments.
+func ValidateAllowMixedCloudProvidersAnnotation(isAdmin bool, current, annotations map[string]string) error {
+	if isAdmin {
+		return nil
+	}
+
+	currentValue, currentlySet := current[AllowMixedCloudProvidersAnnotation]
+	value, set := annotations[AllowMixedCloudProvidersAnnotation]
+	if currentlySet != set || currentValue != value {
+		return fmt.Errorf("only admins can set the %s annotation", AllowMixedCloudProvidersAnnotation)
+	}
+
+	return nil
+}
+
+// ValidateAutoscalerAnnotations ensures that the cluster-autoscaler annotations are not set directly. Annotations
+// which are unchanged compared to the current annotations of the machine deployment are allowed, so that clients
+// can send back the annotations they received.
+func ValidateAutoscalerAnnotations(current, annotations map[string]string) error {
+	for key, value := range annotations {
+		if !strings.HasPrefix(key, AutoscalerAnnotationPrefix) {
+			continue
+		}
+		if currentValue, ok := current[key]; ok && currentValue == value {
+			continue
+		}
+		return fmt.Errorf("annotation %s cannot be set directly, use minReplicas and maxReplicas instead", key)
+	}
+
+	return nil
+}
+
 // Validate if the node deployment structure fulfills certain requirements. It returns node deployment with updated
 // kubelet version if it wasn't specified.
 func Validate(nd *apiv1.NodeDeployment, controlPlaneVersion *semverlib.Version) (*apiv1.NodeDeployment, error) {
@@ -459,9 +616,61 @@ func Validate(nd *apiv1.NodeDeployment, controlPlaneVersion *semverlib.Version)
 		}
 	}
 
+	if err := ValidateNodeLabelsAndTaints(nd.Spec.Template); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateGPU(nd.Spec.Template); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateSpotInstance(nd.Spec.Template.Cloud); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateGCPMachineType(nd.Spec.Template.Cloud); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateRollingUpdate(nd.Spec); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateAutoRepair(nd.Spec); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateAdditionalUserData(nd.Spec.Template); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateKubeletConfig(nd.Spec.Template); err != nil {
+		return nil, err
+	}
+
 	return nd, nil
 }
 
+var spotInstanceMaxPriceRegexp = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// ValidateSpotInstance validates the spot instance settings of the node cloud spec. The maximum price of AWS spot
+// instances has to be a positive decimal, e.g. 0.05.
+func ValidateSpotInstance(cloud apiv1.NodeCloudSpec) error {
+	if cloud.AWS == nil || cloud.AWS.SpotInstanceMaxPrice == nil || *cloud.AWS.SpotInstanceMaxPrice == "" {
+		return nil
+	}
+
+	maxPrice := *cloud.AWS.SpotInstanceMaxPrice
+	if !spotInstanceMaxPriceRegexp.MatchString(maxPrice) {
+		return fmt.Errorf("spot instance max price '%s' must be a positive decimal", maxPrice)
+	}
+	if price, err := strconv.ParseFloat(maxPrice, 64); err != nil || price <= 0 {
+		return fmt.Errorf("spot instance max price '%s' must be a positive decimal", maxPrice)
+	}
+
+	return nil
+}
+
 // validateAutoUpdateMDEnforcement validates if auto-update settings of node deployment are aligned with the
 // admin settings of machine deployment auto updates.
 func validateAutoUpdateMDEnforcement(ctx context.Context, nd *apiv1.NodeDeployment, settingsProvider provider.SettingsProvider) error {
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateSpotInstance(t *testing.T) {
+	tests := []struct {
+		name    string
+		cloud   apiv1.NodeCloudSpec
+		wantErr bool
+	}{
+		{
+			name:  "non-AWS node",
+			cloud: apiv1.NodeCloudSpec{GCP: &apiv1.GCPNodeSpec{Preemptible: true}},
+		},
+		{
+			name:  "spot instance without max price",
+			cloud: apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{IsSpotInstance: ptr.To(true)}},
+		},
+		{
+			name:  "decimal max price",
+			cloud: apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{IsSpotInstance: ptr.To(true), SpotInstanceMaxPrice: ptr.To("0.05")}},
+		},
+		{
+			name:  "integer max price",
+			cloud: apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{IsSpotInstance: ptr.To(true), SpotInstanceMaxPrice: ptr.To("2")}},
+		},
+		{
+			name:    "zero max price",
+			cloud:   apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{IsSpotInstance: ptr.To(true), SpotInstanceMaxPrice: ptr.To("0.00")}},
+			wantErr: true,
+		},
+		{
+			name:    "negative max price",
+			cloud:   apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{IsSpotInstance: ptr.To(true), SpotInstanceMaxPrice: ptr.To("-1")}},
+			wantErr: true,
+		},
+		{
+			name:    "max price with currency",
+			cloud:   apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{IsSpotInstance: ptr.To(true), SpotInstanceMaxPrice: ptr.To("$0.05")}},
+			wantErr: true,
+		},
+		{
+			name:    "max price in exponent notation",
+			cloud:   apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{IsSpotInstance: ptr.To(true), SpotInstanceMaxPrice: ptr.To("1e-2")}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSpotInstance(tt.cloud)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSpotInstance() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAllowMixedCloudProvidersAnnotation(t *testing.T) {
+	allowed := map[string]string{AllowMixedCloudProvidersAnnotation: "true"}
+
+	tests := []struct {
+		name        string
+		isAdmin     bool
+		current     map[string]string
+		annotations map[string]string
+		wantErr     bool
+	}{
+		{
+			name:        "user without the annotation",
+			annotations: map[string]string{"foo": "bar"},
+		},
+		{
+			name:        "user keeps the annotation set by an admin",
+			current:     allowed,
+			annotations: allowed,
+		},
+		{
+			name:        "user sets the annotation",
+			annotations: allowed,
+			wantErr:     true,
+		},
+		{
+			name:        "user changes the annotation",
+			current:     allowed,
+			annotations: map[string]string{AllowMixedCloudProvidersAnnotation: "false"},
+			wantErr:     true,
+		},
+		{
+			name:    "user removes the annotation",
+			current: allowed,
+			wantErr: true,
+		},
+		{
+			name:        "admin sets the annotation",
+			isAdmin:     true,
+			annotations: allowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAllowMixedCloudProvidersAnnotation(tt.isAdmin, tt.current, tt.annotations)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAllowMixedCloudProvidersAnnotation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"fmt"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	machinecontrollernet "k8c.io/machine-controller/sdk/net"
+
+	netutils "k8s.io/utils/net"
+)
+
+// The machine-controller network config only has a single CIDR and gateway, so the ones of the secondary
+// IP family of dual-stack machines are kept in the machine deployment annotations.
+const (
+	NetworkSecondaryCIDRAnnotation    = "kubermatic.io/network-secondary-cidr"
+	NetworkSecondaryGatewayAnnotation = "kubermatic.io/network-secondary-gateway"
+)
+
+// ValidateNetwork validates the network spec of a node deployment against the cluster. The IP family has to be one
+// of IPv4, IPv6 and IPv4+IPv6 and all of its families have to be enabled in the cluster network. The CIDRs and
+// gateways have to belong to the family they are set for.
+func ValidateNetwork(c *kubermaticv1.Cluster, network *apiv1.NetworkSpec) error {
+	if network == nil {
+		return nil
+	}
+
+	ipFamily := machinecontrollernet.IPFamily(network.IPFamily)
+	switch ipFamily {
+	case machinecontrollernet.IPFamilyUnspecified, machinecontrollernet.IPFamilyIPv4, machinecontrollernet.IPFamilyIPv6, machinecontrollernet.IPFamilyIPv4IPv6:
+	default:
+		return fmt.Errorf("ipFamily %q is not supported, supported values are %s, %s and %s", network.IPFamily, machinecontrollernet.IPFamilyIPv4, machinecontrollernet.IPFamilyIPv6, machinecontrollernet.IPFamilyIPv4IPv6)
+	}
+
+	if ipFamily != machinecontrollernet.IPFamilyIPv4IPv6 && (network.SecondaryCIDR != "" || network.SecondaryGateway != "") {
+		return fmt.Errorf("secondaryCIDR and secondaryGateway can only be set for the %s ipFamily", machinecontrollernet.IPFamilyIPv4IPv6)
+	}
+	// The IP family of the cluster is used for the machines then.
+	if ipFamily == machinecontrollernet.IPFamilyUnspecified {
+		return nil
+	}
+
+	if clusterFamily := clusterIPFamily(c); clusterFamily != machinecontrollernet.IPFamilyUnspecified &&
+		clusterFamily != machinecontrollernet.IPFamilyIPv4IPv6 && ipFamily != clusterFamily {
+		return fmt.Errorf("ipFamily %s is not enabled in the cluster network, the cluster only supports %s", ipFamily, clusterFamily)
+	}
+
+	primaryIPv6 := ipFamily == machinecontrollernet.IPFamilyIPv6
+	if err := validateNetworkAddresses(network.CIDR, network.Gateway, primaryIPv6, "cidr", "gateway"); err != nil {
+		return err
+	}
+
+	return validateNetworkAddresses(network.SecondaryCIDR, network.SecondaryGateway, true, "secondaryCIDR", "secondaryGateway")
+}
+
+// clusterIPFamily returns the IP family of the cluster network, which is unspecified if it can't be determined
+// from the pod CIDRs.
+func clusterIPFamily(c *kubermaticv1.Cluster) machinecontrollernet.IPFamily {
+	switch {
+	case c.IsIPv4Only():
+		return machinecontrollernet.IPFamilyIPv4
+	case c.IsIPv6Only():
+		return machinecontrollernet.IPFamilyIPv6
+	case c.IsDualStack():
+		return machinecontrollernet.IPFamilyIPv4IPv6
+	default:
+		return machinecontrollernet.IPFamilyUnspecified
+	}
+}
+
+func validateNetworkAddresses(cidr, gateway string, ipv6 bool, cidrField, gatewayField string) error {
+	family := machinecontrollernet.IPFamilyIPv4
+	isCIDR, isIP := netutils.IsIPv4CIDRString, netutils.IsIPv4String
+	if ipv6 {
+		family = machinecontrollernet.IPFamilyIPv6
+		isCIDR, isIP = netutils.IsIPv6CIDRString, netutils.IsIPv6String
+	}
+
+	if cidr != "" && !isCIDR(cidr) {
+		return fmt.Errorf("%s %q is not a valid %s CIDR", cidrField, cidr, family)
+	}
+	if gateway != "" && !isIP(gateway) {
+		return fmt.Errorf("%s %q is not a valid %s address", gatewayField, gateway, family)
+	}
+
+	return nil
+}
+
+// getNetworkIPFamily returns the IP family of the machines, which defaults to the one of the cluster.
+func getNetworkIPFamily(c *kubermaticv1.Cluster, network *apiv1.NetworkSpec) machinecontrollernet.IPFamily {
+	if network != nil && network.IPFamily != "" {
+		return machinecontrollernet.IPFamily(network.IPFamily)
+	}
+
+	return clusterIPFamily(c)
+}
+
+func setNetworkAnnotations(annotations map[string]string, network *apiv1.NetworkSpec) {
+	delete(annotations, NetworkSecondaryCIDRAnnotation)
+	delete(annotations, NetworkSecondaryGatewayAnnotation)
+
+	if network == nil {
+		return
+	}
+
+	if network.SecondaryCIDR != "" {
+		annotations[NetworkSecondaryCIDRAnnotation] = network.SecondaryCIDR
+	}
+	if network.SecondaryGateway != "" {
+		annotations[NetworkSecondaryGatewayAnnotation] = network.SecondaryGateway
+	}
+}
+
+// SetSecondaryNetwork sets the secondary CIDR and gateway stored in the machine deployment annotations on the
+// network spec.
+func SetSecondaryNetwork(network *apiv1.NetworkSpec, annotations map[string]string) {
+	if network == nil {
+		return
+	}
+
+	network.SecondaryCIDR = annotations[NetworkSecondaryCIDRAnnotation]
+	network.SecondaryGateway = annotations[NetworkSecondaryGatewayAnnotation]
+}
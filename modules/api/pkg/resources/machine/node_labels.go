@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var (
+	// Labels in these namespaces are reserved for Kubernetes, see the NodeRestriction admission plugin.
+	protectedNodeLabelDomains = []string{"kubernetes.io", "k8s.io"}
+
+	// Labels in the protected namespaces which the kubelet is still allowed to set on its node.
+	allowedProtectedNodeLabelDomains = []string{"kubelet.kubernetes.io", "node.kubernetes.io"}
+	allowedProtectedNodeLabels       = []string{
+		"kubernetes.io/hostname",
+		"kubernetes.io/arch",
+		"kubernetes.io/os",
+		"beta.kubernetes.io/arch",
+		"beta.kubernetes.io/os",
+		"beta.kubernetes.io/instance-type",
+		"failure-domain.beta.kubernetes.io/region",
+		"failure-domain.beta.kubernetes.io/zone",
+		"topology.kubernetes.io/region",
+		"topology.kubernetes.io/zone",
+	}
+)
+
+// ValidateNodeLabelsAndTaints validates the keys and values of the node labels and taints of the node spec. Labels
+// in the kubernetes.io and k8s.io namespaces are only allowed if the kubelet may set them, the nodes would fail to
+// register otherwise.
+func ValidateNodeLabelsAndTaints(spec apiv1.NodeSpec) error {
+	keys := make([]string, 0, len(spec.Labels))
+	for key := range spec.Labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("label key '%s' is invalid: %s", key, strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(spec.Labels[key]); len(errs) > 0 {
+			return fmt.Errorf("value of label '%s' is invalid: %s", key, strings.Join(errs, ", "))
+		}
+		if isProtectedNodeLabel(key) {
+			return fmt.Errorf("label '%s' is in a namespace reserved for Kubernetes and cannot be set on nodes", key)
+		}
+	}
+
+	for _, taint := range spec.Taints {
+		if errs := validation.IsQualifiedName(taint.Key); len(errs) > 0 {
+			return fmt.Errorf("taint key '%s' is invalid: %s", taint.Key, strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(taint.Value); len(errs) > 0 {
+			return fmt.Errorf("value of taint '%s' is invalid: %s", taint.Key, strings.Join(errs, ", "))
+		}
+	}
+
+	return nil
+}
+
+func isProtectedNodeLabel(key string) bool {
+	domain, _, found := strings.Cut(key, "/")
+	if !found || !inDomains(domain, protectedNodeLabelDomains) {
+		return false
+	}
+
+	for _, allowed := range allowedProtectedNodeLabels {
+		if key == allowed {
+			return false
+		}
+	}
+
+	return !inDomains(domain, allowedProtectedNodeLabelDomains)
+}
+
+// inDomains checks whether the domain is one of the domains or a subdomain of them.
+func inDomains(domain string, domains []string) bool {
+	for _, d := range domains {
+		if domain == d || strings.HasSuffix(domain, "."+d) {
+			return true
+		}
+	}
+
+	return false
+}
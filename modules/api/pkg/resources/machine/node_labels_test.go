@@ -0,0 +1,77 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+)
+
+func TestValidateNodeLabelsAndTaints(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    apiv1.NodeSpec
+		wantErr bool
+	}{
+		{
+			name: "custom labels and taints",
+			spec: apiv1.NodeSpec{
+				Labels: map[string]string{"team": "ml", "example.com/tier": "gpu"},
+				Taints: []apiv1.TaintSpec{{Key: "dedicated", Value: "ml", Effect: "NoSchedule"}},
+			},
+		},
+		{
+			name: "labels the kubelet may set",
+			spec: apiv1.NodeSpec{Labels: map[string]string{"node.kubernetes.io/pool": "a", "topology.kubernetes.io/zone": "fra1"}},
+		},
+		{
+			name:    "node role label",
+			spec:    apiv1.NodeSpec{Labels: map[string]string{"node-role.kubernetes.io/worker": ""}},
+			wantErr: true,
+		},
+		{
+			name:    "label in the k8s.io namespace",
+			spec:    apiv1.NodeSpec{Labels: map[string]string{"example.k8s.io/foo": "bar"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid label key",
+			spec:    apiv1.NodeSpec{Labels: map[string]string{"foo bar": "baz"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid label value",
+			spec:    apiv1.NodeSpec{Labels: map[string]string{"foo": "bar baz"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid taint key",
+			spec:    apiv1.NodeSpec{Taints: []apiv1.TaintSpec{{Key: "-foo", Value: "bar", Effect: "NoSchedule"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateNodeLabelsAndTaints(test.spec)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error: %v, got: %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+)
+
+// ProjectNodeQuotaAnnotation holds the node quota of a project as JSON on the Project.
+const ProjectNodeQuotaAnnotation = "kubermatic.io/node-quota"
+
+// NodeQuotaUsage is the number of nodes and machine deployments which are counted against a node quota.
+type NodeQuotaUsage struct {
+	Nodes              int32
+	MachineDeployments int32
+}
+
+// GetProjectNodeQuota returns the node quota of the project. Without the annotation there is no quota.
+func GetProjectNodeQuota(project *kubermaticv1.Project) (apiv2.ProjectNodeQuota, error) {
+	quota := apiv2.ProjectNodeQuota{}
+
+	value, ok := project.Annotations[ProjectNodeQuotaAnnotation]
+	if !ok || value == "" {
+		return quota, nil
+	}
+	if err := json.Unmarshal([]byte(value), &quota); err != nil {
+		return quota, fmt.Errorf("failed to parse node quota of project %s: %w", project.Name, err)
+	}
+
+	return quota, nil
+}
+
+// SetProjectNodeQuota sets the node quota of the project. An empty quota removes the annotation.
+func SetProjectNodeQuota(project *kubermaticv1.Project, quota apiv2.ProjectNodeQuota) error {
+	if quota == (apiv2.ProjectNodeQuota{}) {
+		delete(project.Annotations, ProjectNodeQuotaAnnotation)
+		return nil
+	}
+
+	value, err := json.Marshal(quota)
+	if err != nil {
+		return err
+	}
+	if project.Annotations == nil {
+		project.Annotations = map[string]string{}
+	}
+	project.Annotations[ProjectNodeQuotaAnnotation] = string(value)
+
+	return nil
+}
+
+// ValidateProjectNodeQuota checks that the quota is not negative.
+func ValidateProjectNodeQuota(quota apiv2.ProjectNodeQuota) error {
+	if quota.MaxNodes < 0 || quota.MaxMachineDeployments < 0 {
+		return errors.New("node quota must not be negative")
+	}
+
+	return nil
+}
+
+// NodeDeploymentNodes returns the number of nodes the node deployment is counted with against a node quota, which
+// is the autoscaler maximum if it is higher than the replicas.
+func NodeDeploymentNodes(spec *apiv1.NodeDeploymentSpec) int32 {
+	nodes := spec.Replicas
+	if spec.MaxReplicas != nil && int32(*spec.MaxReplicas) > nodes {
+		nodes = int32(*spec.MaxReplicas)
+	}
+
+	return nodes
+}
+
+// MachineDeploymentNodes returns the number of nodes the machine deployment is counted with against a node quota,
+// which is the autoscaler maximum if it is higher than the replicas.
+func MachineDeploymentNodes(md *clusterv1alpha1.MachineDeployment) int32 {
+	var nodes int32
+	if md.Spec.Replicas != nil {
+		nodes = *md.Spec.Replicas
+	}
+	if maxSize, err := strconv.ParseInt(md.Annotations[AutoscalerMaxSizeAnnotation], 10, 32); err == nil && int32(maxSize) > nodes {
+		nodes = int32(maxSize)
+	}
+
+	return nodes
+}
+
+// ValidateNodeQuota checks that adding the given number of nodes and machine deployments to the usage stays within
+// the quota. Nothing is checked for values which are not increased, so that projects which exceed a lowered quota
+// can still scale down.
+func ValidateNodeQuota(quota apiv2.ProjectNodeQuota, usage NodeQuotaUsage, addedNodes, addedMachineDeployments int32) error {
+	if quota.MaxMachineDeployments > 0 && addedMachineDeployments > 0 && usage.MachineDeployments+addedMachineDeployments > quota.MaxMachineDeployments {
+		return fmt.Errorf("the project has %d of %d machine deployments, %d more would exceed the quota", usage.MachineDeployments, quota.MaxMachineDeployments, addedMachineDeployments)
+	}
+	if quota.MaxNodes > 0 && addedNodes > 0 && usage.Nodes+addedNodes > quota.MaxNodes {
+		return fmt.Errorf("the project has %d of %d nodes, %d more would exceed the quota", usage.Nodes, quota.MaxNodes, addedNodes)
+	}
+
+	return nil
+}
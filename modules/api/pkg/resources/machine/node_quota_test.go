@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateNodeQuota(t *testing.T) {
+	quota := apiv2.ProjectNodeQuota{MaxNodes: 10, MaxMachineDeployments: 3}
+	usage := NodeQuotaUsage{Nodes: 8, MachineDeployments: 2}
+
+	testCases := []struct {
+		name                    string
+		quota                   apiv2.ProjectNodeQuota
+		addedNodes              int32
+		addedMachineDeployments int32
+		expectedError           string
+	}{
+		{
+			name:                    "within the quota",
+			quota:                   quota,
+			addedNodes:              2,
+			addedMachineDeployments: 1,
+		},
+		{
+			name:          "nodes above the quota",
+			quota:         quota,
+			addedNodes:    3,
+			expectedError: "the project has 8 of 10 nodes, 3 more would exceed the quota",
+		},
+		{
+			name:                    "machine deployments above the quota",
+			quota:                   quota,
+			addedMachineDeployments: 2,
+			expectedError:           "the project has 2 of 3 machine deployments, 2 more would exceed the quota",
+		},
+		{
+			name:       "scaling down a project above the quota",
+			quota:      apiv2.ProjectNodeQuota{MaxNodes: 5},
+			addedNodes: -1,
+		},
+		{
+			name:       "no quota",
+			addedNodes: 100,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateNodeQuota(tc.quota, usage, tc.addedNodes, tc.addedMachineDeployments)
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expectedError {
+				t.Fatalf("expected error %q, got: %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestNodeQuotaNodes(t *testing.T) {
+	if nodes := NodeDeploymentNodes(&apiv1.NodeDeploymentSpec{Replicas: 2, MaxReplicas: ptr.To[uint32](5)}); nodes != 5 {
+		t.Fatalf("expected the autoscaler maximum of 5 nodes to be counted, got %d", nodes)
+	}
+	if nodes := NodeDeploymentNodes(&apiv1.NodeDeploymentSpec{Replicas: 2}); nodes != 2 {
+		t.Fatalf("expected the 2 replicas to be counted, got %d", nodes)
+	}
+
+	md := &clusterv1alpha1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AutoscalerMaxSizeAnnotation: "7"}},
+		Spec:       clusterv1alpha1.MachineDeploymentSpec{Replicas: ptr.To[int32](3)},
+	}
+	if nodes := MachineDeploymentNodes(md); nodes != 7 {
+		t.Fatalf("expected the autoscaler maximum of 7 nodes to be counted, got %d", nodes)
+	}
+	md.Annotations = nil
+	if nodes := MachineDeploymentNodes(md); nodes != 3 {
+		t.Fatalf("expected the 3 replicas to be counted, got %d", nodes)
+	}
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
+	providerconfig "k8c.io/machine-controller/sdk/providerconfig"
+
+	"k8s.io/utils/ptr"
+)
+
+// NodeSpecDefaultsAnnotation holds the node spec defaults of the datacenters of a seed as JSON, keyed by the
+// datacenter name.
+const NodeSpecDefaultsAnnotation = "kubermatic.io/node-spec-defaults"
+
+// The built-in node spec defaults, which are used unless the admins configured others for the datacenter.
+const (
+	defaultAWSInstanceType             = "t3.medium"
+	defaultAWSVolumeType               = "gp3"
+	defaultAzureSize                   = "Standard_D2s_v3"
+	defaultDigitaloceanSize            = "s-2vcpu-4gb"
+	defaultGCPMachineType              = "e2-standard-2"
+	defaultGCPDiskType                 = "pd-standard"
+	defaultHetznerType                 = "cx22"
+	defaultOpenstackFlavor             = "m1.medium"
+	defaultPacketInstanceType          = "c3.small.x86"
+	defaultAlibabaInstanceType         = "ecs.c6.large"
+	defaultAlibabaDiskType             = "cloud_efficiency"
+	defaultAlibabaInternetBandwidthOut = "10"
+	defaultDiskSize                    = 25
+	defaultAzureDiskSize               = 30
+	defaultAlibabaDiskSize             = 40
+)
+
+// ErrNoDefaultNodeSpec is returned for datacenters of providers without a default node spec.
+var ErrNoDefaultNodeSpec = errors.New("no default node spec is available")
+
+func getNodeSpecDefaults(seed *kubermaticv1.Seed) (map[string]apiv1.NodeSpecDefaults, error) {
+	defaults := map[string]apiv1.NodeSpecDefaults{}
+
+	value, ok := seed.Annotations[NodeSpecDefaultsAnnotation]
+	if !ok || value == "" {
+		return defaults, nil
+	}
+	if err := json.Unmarshal([]byte(value), &defaults); err != nil {
+		return nil, fmt.Errorf("failed to parse node spec defaults of seed %s: %w", seed.Name, err)
+	}
+
+	return defaults, nil
+}
+
+// GetNodeSpecDefaults returns the node spec defaults which the admins configured for the datacenter or nil if
+// there are none.
+func GetNodeSpecDefaults(seed *kubermaticv1.Seed, datacenter string) (*apiv1.NodeSpecDefaults, error) {
+	defaults, err := getNodeSpecDefaults(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	dcDefaults, ok := defaults[datacenter]
+	if !ok {
+		return nil, nil
+	}
+
+	return &dcDefaults, nil
+}
+
+// SetNodeSpecDefaults sets the node spec defaults of the datacenter on the seed. Nil or empty defaults remove them.
+func SetNodeSpecDefaults(seed *kubermaticv1.Seed, datacenter string, dcDefaults *apiv1.NodeSpecDefaults) error {
+	defaults, err := getNodeSpecDefaults(seed)
+	if err != nil {
+		return err
+	}
+
+	if dcDefaults == nil || *dcDefaults == (apiv1.NodeSpecDefaults{}) {
+		delete(defaults, datacenter)
+	} else {
+		defaults[datacenter] = *dcDefaults
+	}
+
+	if len(defaults) == 0 {
+		delete(seed.Annotations, NodeSpecDefaultsAnnotation)
+		return nil
+	}
+
+	value, err := json.Marshal(defaults)
+	if err != nil {
+		return err
+	}
+	if seed.Annotations == nil {
+		seed.Annotations = map[string]string{}
+	}
+	seed.Annotations[NodeSpecDefaultsAnnotation] = string(value)
+
+	return nil
+}
+
+// ValidateNodeSpecDefaults checks the node spec defaults which the admins configure for a datacenter.
+func ValidateNodeSpecDefaults(defaults *apiv1.NodeSpecDefaults) error {
+	if defaults != nil && defaults.DiskSize < 0 {
+		return fmt.Errorf("the disk size of the node spec defaults must not be negative, got %d", defaults.DiskSize)
+	}
+
+	return nil
+}
+
+// DefaultNodeSpec returns the node spec which is suggested for the machine deployments of the datacenter. It uses
+// Ubuntu and the node spec defaults of the datacenter, fields without a default fall back to the built-in default
+// of the provider. The image defaults to the Ubuntu image of the datacenter, if it has one.
+func DefaultNodeSpec(seed *kubermaticv1.Seed, datacenter string) (*apiv1.NodeSpec, error) {
+	dc, ok := seed.Spec.Datacenters[datacenter]
+	if !ok {
+		return nil, fmt.Errorf("datacenter %q not found in seed %s", datacenter, seed.Name)
+	}
+
+	defaults, err := GetNodeSpecDefaults(seed, datacenter)
+	if err != nil {
+		return nil, err
+	}
+	if defaults == nil {
+		defaults = &apiv1.NodeSpecDefaults{}
+	}
+
+	spec := &apiv1.NodeSpec{
+		OperatingSystem: apiv1.OperatingSystemSpec{
+			Ubuntu: &apiv1.UbuntuSpec{},
+		},
+	}
+
+	switch {
+	case dc.Spec.AWS != nil:
+		spec.Cloud.AWS = &apiv1.AWSNodeSpec{
+			InstanceType: valueOrDefault(defaults.InstanceType, defaultAWSInstanceType),
+			VolumeSize:   int32(valueOrDefault(defaults.DiskSize, defaultDiskSize)),
+			VolumeType:   defaultAWSVolumeType,
+			AMI:          valueOrDefault(defaults.Image, dc.Spec.AWS.Images[providerconfig.OperatingSystemUbuntu]),
+		}
+	case dc.Spec.Azure != nil:
+		spec.Cloud.Azure = &apiv1.AzureNodeSpec{
+			Size:       valueOrDefault(defaults.InstanceType, defaultAzureSize),
+			OSDiskSize: int32(valueOrDefault(defaults.DiskSize, defaultAzureDiskSize)),
+			ImageID:    valueOrDefault(defaults.Image, dc.Spec.Azure.Images[providerconfig.OperatingSystemUbuntu]),
+		}
+	case dc.Spec.Digitalocean != nil:
+		spec.Cloud.Digitalocean = &apiv1.DigitaloceanNodeSpec{
+			Size: valueOrDefault(defaults.InstanceType, defaultDigitaloceanSize),
+		}
+	case dc.Spec.GCP != nil:
+		spec.Cloud.GCP = &apiv1.GCPNodeSpec{
+			MachineType: valueOrDefault(defaults.InstanceType, defaultGCPMachineType),
+			DiskSize:    int64(valueOrDefault(defaults.DiskSize, defaultDiskSize)),
+			DiskType:    defaultGCPDiskType,
+			CustomImage: defaults.Image,
+		}
+		if len(dc.Spec.GCP.ZoneSuffixes) > 0 {
+			spec.Cloud.GCP.Zone = dc.Spec.GCP.Region + "-" + dc.Spec.GCP.ZoneSuffixes[0]
+		}
+	case dc.Spec.Hetzner != nil:
+		spec.Cloud.Hetzner = &apiv1.HetznerNodeSpec{
+			Type:    valueOrDefault(defaults.InstanceType, defaultHetznerType),
+			Network: dc.Spec.Hetzner.Network,
+		}
+	case dc.Spec.Openstack != nil:
+		spec.Cloud.Openstack = &apiv1.OpenstackNodeSpec{
+			Flavor:           valueOrDefault(defaults.InstanceType, defaultOpenstackFlavor),
+			Image:            valueOrDefault(defaults.Image, dc.Spec.Openstack.Images[providerconfig.OperatingSystemUbuntu]),
+			AvailabilityZone: dc.Spec.Openstack.AvailabilityZone,
+		}
+		// The root disk of the flavor is used, unless the admins configured a disk size.
+		if defaults.DiskSize > 0 {
+			spec.Cloud.Openstack.RootDiskSizeGB = ptr.To(defaults.DiskSize)
+		}
+	case dc.Spec.Packet != nil:
+		spec.Cloud.Packet = &apiv1.PacketNodeSpec{
+			InstanceType: valueOrDefault(defaults.InstanceType, defaultPacketInstanceType),
+		}
+	case dc.Spec.Alibaba != nil:
+		spec.Cloud.Alibaba = &apiv1.AlibabaNodeSpec{
+			InstanceType:            valueOrDefault(defaults.InstanceType, defaultAlibabaInstanceType),
+			DiskSize:                strconv.Itoa(valueOrDefault(defaults.DiskSize, defaultAlibabaDiskSize)),
+			DiskType:                defaultAlibabaDiskType,
+			InternetMaxBandwidthOut: defaultAlibabaInternetBandwidthOut,
+		}
+	default:
+		providerName, err := kubermaticv1helper.DatacenterCloudProviderName(dc.Spec.DeepCopy())
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w for provider %q of datacenter %q", ErrNoDefaultNodeSpec, providerName, datacenter)
+	}
+
+	return spec, nil
+}
+
+// MergeNodeSpecDefaults fills the fields of the sparse node spec which are not set with the default node spec. The
+// cloud spec of the default node spec is only used if the node spec has none or one of the same provider.
+func MergeNodeSpecDefaults(spec *apiv1.NodeSpec, defaults *apiv1.NodeSpec) {
+	if spec.OperatingSystem == (apiv1.OperatingSystemSpec{}) {
+		spec.OperatingSystem = defaults.OperatingSystem
+	}
+
+	cloud, defaultCloud := &spec.Cloud, defaults.Cloud
+	switch {
+	case *cloud == (apiv1.NodeCloudSpec{}):
+		spec.Cloud = defaultCloud
+	case cloud.AWS != nil && defaultCloud.AWS != nil:
+		setIfEmpty(&cloud.AWS.InstanceType, defaultCloud.AWS.InstanceType)
+		setIfEmpty(&cloud.AWS.VolumeSize, defaultCloud.AWS.VolumeSize)
+		setIfEmpty(&cloud.AWS.VolumeType, defaultCloud.AWS.VolumeType)
+		setIfEmpty(&cloud.AWS.AMI, defaultCloud.AWS.AMI)
+	case cloud.Azure != nil && defaultCloud.Azure != nil:
+		setIfEmpty(&cloud.Azure.Size, defaultCloud.Azure.Size)
+		setIfEmpty(&cloud.Azure.OSDiskSize, defaultCloud.Azure.OSDiskSize)
+		setIfEmpty(&cloud.Azure.ImageID, defaultCloud.Azure.ImageID)
+	case cloud.Digitalocean != nil && defaultCloud.Digitalocean != nil:
+		setIfEmpty(&cloud.Digitalocean.Size, defaultCloud.Digitalocean.Size)
+	case cloud.GCP != nil && defaultCloud.GCP != nil:
+		setIfEmpty(&cloud.GCP.MachineType, defaultCloud.GCP.MachineType)
+		setIfEmpty(&cloud.GCP.DiskSize, defaultCloud.GCP.DiskSize)
+		setIfEmpty(&cloud.GCP.DiskType, defaultCloud.GCP.DiskType)
+		setIfEmpty(&cloud.GCP.CustomImage, defaultCloud.GCP.CustomImage)
+		setIfEmpty(&cloud.GCP.Zone, defaultCloud.GCP.Zone)
+	case cloud.Hetzner != nil && defaultCloud.Hetzner != nil:
+		setIfEmpty(&cloud.Hetzner.Type, defaultCloud.Hetzner.Type)
+		setIfEmpty(&cloud.Hetzner.Network, defaultCloud.Hetzner.Network)
+	case cloud.Openstack != nil && defaultCloud.Openstack != nil:
+		setIfEmpty(&cloud.Openstack.Flavor, defaultCloud.Openstack.Flavor)
+		setIfEmpty(&cloud.Openstack.Image, defaultCloud.Openstack.Image)
+		setIfEmpty(&cloud.Openstack.AvailabilityZone, defaultCloud.Openstack.AvailabilityZone)
+		if cloud.Openstack.RootDiskSizeGB == nil {
+			cloud.Openstack.RootDiskSizeGB = defaultCloud.Openstack.RootDiskSizeGB
+		}
+	case cloud.Packet != nil && defaultCloud.Packet != nil:
+		setIfEmpty(&cloud.Packet.InstanceType, defaultCloud.Packet.InstanceType)
+	case cloud.Alibaba != nil && defaultCloud.Alibaba != nil:
+		setIfEmpty(&cloud.Alibaba.InstanceType, defaultCloud.Alibaba.InstanceType)
+		setIfEmpty(&cloud.Alibaba.DiskSize, defaultCloud.Alibaba.DiskSize)
+		setIfEmpty(&cloud.Alibaba.DiskType, defaultCloud.Alibaba.DiskType)
+		setIfEmpty(&cloud.Alibaba.InternetMaxBandwidthOut, defaultCloud.Alibaba.InternetMaxBandwidthOut)
+	}
+}
+
+func valueOrDefault[T comparable](value, defaultValue T) T {
+	var zero T
+	if value == zero {
+		return defaultValue
+	}
+	return value
+}
+
+func setIfEmpty[T comparable](value *T, defaultValue T) {
+	*value = valueOrDefault(*value, defaultValue)
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	providerconfig "k8c.io/machine-controller/sdk/providerconfig"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestDefaultNodeSpec(t *testing.T) {
+	seed := &kubermaticv1.Seed{
+		ObjectMeta: metav1.ObjectMeta{Name: "europe-west3"},
+		Spec: kubermaticv1.SeedSpec{
+			Datacenters: map[string]kubermaticv1.Datacenter{
+				"aws-eu-central-1a": {
+					Spec: kubermaticv1.DatacenterSpec{
+						AWS: &kubermaticv1.DatacenterSpecAWS{
+							Region: "eu-central-1",
+							Images: kubermaticv1.ImageList{providerconfig.OperatingSystemUbuntu: "ami-ubuntu"},
+						},
+					},
+				},
+				"openstack-hamburg": {
+					Spec: kubermaticv1.DatacenterSpec{
+						Openstack: &kubermaticv1.DatacenterSpecOpenstack{
+							AvailabilityZone: "hamburg-1",
+							Images:           kubermaticv1.ImageList{providerconfig.OperatingSystemUbuntu: "ubuntu-22.04"},
+						},
+					},
+				},
+				"vsphere-ger": {
+					Spec: kubermaticv1.DatacenterSpec{
+						VSphere: &kubermaticv1.DatacenterSpecVSphere{},
+					},
+				},
+			},
+		},
+	}
+	ubuntu := apiv1.OperatingSystemSpec{Ubuntu: &apiv1.UbuntuSpec{}}
+
+	tests := []struct {
+		name       string
+		datacenter string
+		defaults   *apiv1.NodeSpecDefaults
+		want       *apiv1.NodeSpec
+		wantErr    error
+	}{
+		{
+			name:       "built-in defaults with the image of the datacenter",
+			datacenter: "aws-eu-central-1a",
+			want: &apiv1.NodeSpec{
+				Cloud:           apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "t3.medium", VolumeSize: 25, VolumeType: "gp3", AMI: "ami-ubuntu"}},
+				OperatingSystem: ubuntu,
+			},
+		},
+		{
+			name:       "defaults configured by the admins",
+			datacenter: "aws-eu-central-1a",
+			defaults:   &apiv1.NodeSpecDefaults{InstanceType: "m5.large", DiskSize: 50, Image: "ami-custom"},
+			want: &apiv1.NodeSpec{
+				Cloud:           apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "m5.large", VolumeSize: 50, VolumeType: "gp3", AMI: "ami-custom"}},
+				OperatingSystem: ubuntu,
+			},
+		},
+		{
+			name:       "the root disk of the flavor is used without a configured disk size",
+			datacenter: "openstack-hamburg",
+			defaults:   &apiv1.NodeSpecDefaults{InstanceType: "m1.large"},
+			want: &apiv1.NodeSpec{
+				Cloud:           apiv1.NodeCloudSpec{Openstack: &apiv1.OpenstackNodeSpec{Flavor: "m1.large", Image: "ubuntu-22.04", AvailabilityZone: "hamburg-1"}},
+				OperatingSystem: ubuntu,
+			},
+		},
+		{
+			name:       "the configured disk size is used as root disk",
+			datacenter: "openstack-hamburg",
+			defaults:   &apiv1.NodeSpecDefaults{DiskSize: 40},
+			want: &apiv1.NodeSpec{
+				Cloud:           apiv1.NodeCloudSpec{Openstack: &apiv1.OpenstackNodeSpec{Flavor: "m1.medium", Image: "ubuntu-22.04", AvailabilityZone: "hamburg-1", RootDiskSizeGB: ptr.To(40)}},
+				OperatingSystem: ubuntu,
+			},
+		},
+		{
+			name:       "provider without defaults",
+			datacenter: "vsphere-ger",
+			wantErr:    ErrNoDefaultNodeSpec,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seed := seed.DeepCopy()
+			if err := SetNodeSpecDefaults(seed, tt.datacenter, tt.defaults); err != nil {
+				t.Fatalf("failed to set node spec defaults: %v", err)
+			}
+
+			spec, err := DefaultNodeSpec(seed, tt.datacenter)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DefaultNodeSpec() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(spec, tt.want) {
+				t.Errorf("DefaultNodeSpec() = %+v, want %+v", spec, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeNodeSpecDefaults(t *testing.T) {
+	defaults := &apiv1.NodeSpec{
+		Cloud:           apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "t3.medium", VolumeSize: 25, VolumeType: "gp3", AMI: "ami-ubuntu"}},
+		OperatingSystem: apiv1.OperatingSystemSpec{Ubuntu: &apiv1.UbuntuSpec{}},
+	}
+
+	tests := []struct {
+		name string
+		spec apiv1.NodeSpec
+		want apiv1.NodeSpec
+	}{
+		{
+			name: "empty node spec",
+			want: *defaults,
+		},
+		{
+			name: "fields which are set are kept",
+			spec: apiv1.NodeSpec{
+				Cloud:           apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "m5.large", SubnetID: "subnet-1"}},
+				OperatingSystem: apiv1.OperatingSystemSpec{Flatcar: &apiv1.FlatcarSpec{}},
+			},
+			want: apiv1.NodeSpec{
+				Cloud:           apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "m5.large", VolumeSize: 25, VolumeType: "gp3", AMI: "ami-ubuntu", SubnetID: "subnet-1"}},
+				OperatingSystem: apiv1.OperatingSystemSpec{Flatcar: &apiv1.FlatcarSpec{}},
+			},
+		},
+		{
+			name: "cloud spec of another provider",
+			spec: apiv1.NodeSpec{
+				Cloud: apiv1.NodeCloudSpec{Azure: &apiv1.AzureNodeSpec{Size: "Standard_B2s"}},
+			},
+			want: apiv1.NodeSpec{
+				Cloud:           apiv1.NodeCloudSpec{Azure: &apiv1.AzureNodeSpec{Size: "Standard_B2s"}},
+				OperatingSystem: defaults.OperatingSystem,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			MergeNodeSpecDefaults(&tt.spec, defaults)
+			if !reflect.DeepEqual(tt.spec, tt.want) {
+				t.Errorf("MergeNodeSpecDefaults() = %+v, want %+v", tt.spec, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"strconv"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// OSRebootRequiredAnnotation is set to "true" or "false" by update agents of the nodes, which report whether
+	// the node has to be rebooted to apply installed updates.
+	OSRebootRequiredAnnotation = "kubermatic.io/os-reboot-required"
+	// OSLastUpdateAnnotation is the time of the last operating system update of the node in RFC 3339 format.
+	OSLastUpdateAnnotation = "kubermatic.io/os-last-update"
+	// OSPendingSecurityUpdatesAnnotation is the number of security updates available for the node.
+	OSPendingSecurityUpdatesAnnotation = "kubermatic.io/os-pending-security-updates"
+
+	// flatcarRebootNeededAnnotation is set by the Flatcar Linux update operator.
+	flatcarRebootNeededAnnotation = "flatcar-linux-update.v1.flatcar-linux.net/reboot-needed"
+	// kuredRebootNeededAnnotation is set by kured with --annotate-nodes while the reboot sentinel of the node exists.
+	kuredRebootNeededAnnotation = "weave.works/kured-most-recent-reboot-needed"
+)
+
+// GetNodeOSUpdateStatus returns the state of the operating system updates of the node from the annotations of the
+// update agents. Malformed annotation values are ignored, nodes without usable annotations are reported as unknown.
+func GetNodeOSUpdateStatus(node *corev1.Node) *apiv1.NodeOSUpdateStatus {
+	status := &apiv1.NodeOSUpdateStatus{State: apiv1.NodeOSUpdateUnknown}
+	if node == nil {
+		return status
+	}
+	annotations := node.Annotations
+
+	if rebootRequired, err := strconv.ParseBool(annotations[OSRebootRequiredAnnotation]); err == nil {
+		status.RebootRequired = &rebootRequired
+	} else if rebootRequired, err := strconv.ParseBool(annotations[flatcarRebootNeededAnnotation]); err == nil {
+		status.RebootRequired = &rebootRequired
+	} else if _, ok := annotations[kuredRebootNeededAnnotation]; ok {
+		rebootRequired := true
+		status.RebootRequired = &rebootRequired
+	}
+
+	if lastUpdate, err := time.Parse(time.RFC3339, annotations[OSLastUpdateAnnotation]); err == nil {
+		t := apiv1.NewTime(lastUpdate)
+		status.LastUpdate = &t
+	}
+
+	if pending, err := strconv.Atoi(annotations[OSPendingSecurityUpdatesAnnotation]); err == nil && pending >= 0 {
+		status.PendingSecurityUpdates = &pending
+	}
+
+	switch {
+	case status.RebootRequired != nil && *status.RebootRequired:
+		status.State = apiv1.NodeOSRebootRequired
+	case status.PendingSecurityUpdates != nil && *status.PendingSecurityUpdates > 0:
+		status.State = apiv1.NodeOSUpdatesPending
+	case status.RebootRequired != nil || status.PendingSecurityUpdates != nil:
+		status.State = apiv1.NodeOSUpToDate
+	}
+
+	return status
+}
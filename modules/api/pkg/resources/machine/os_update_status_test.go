@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestGetNodeOSUpdateStatus(t *testing.T) {
+	node := func(annotations map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node", Annotations: annotations}}
+	}
+
+	tests := []struct {
+		name               string
+		node               *corev1.Node
+		wantState          string
+		wantRebootRequired *bool
+		wantLastUpdate     bool
+		wantPendingUpdates *int
+	}{
+		{
+			name:      "no node",
+			wantState: apiv1.NodeOSUpdateUnknown,
+		},
+		{
+			name:      "no annotations",
+			node:      node(nil),
+			wantState: apiv1.NodeOSUpdateUnknown,
+		},
+		{
+			name: "up to date",
+			node: node(map[string]string{
+				OSRebootRequiredAnnotation:         "false",
+				OSLastUpdateAnnotation:             "2025-03-01T12:00:00Z",
+				OSPendingSecurityUpdatesAnnotation: "0",
+			}),
+			wantState:          apiv1.NodeOSUpToDate,
+			wantRebootRequired: ptr.To(false),
+			wantLastUpdate:     true,
+			wantPendingUpdates: ptr.To(0),
+		},
+		{
+			name:               "pending security updates",
+			node:               node(map[string]string{OSPendingSecurityUpdatesAnnotation: "5"}),
+			wantState:          apiv1.NodeOSUpdatesPending,
+			wantPendingUpdates: ptr.To(5),
+		},
+		{
+			name: "a required reboot takes precedence over pending updates",
+			node: node(map[string]string{
+				OSRebootRequiredAnnotation:         "true",
+				OSPendingSecurityUpdatesAnnotation: "5",
+			}),
+			wantState:          apiv1.NodeOSRebootRequired,
+			wantRebootRequired: ptr.To(true),
+			wantPendingUpdates: ptr.To(5),
+		},
+		{
+			name:               "reboot required by the flatcar update operator",
+			node:               node(map[string]string{flatcarRebootNeededAnnotation: "true"}),
+			wantState:          apiv1.NodeOSRebootRequired,
+			wantRebootRequired: ptr.To(true),
+		},
+		{
+			name:               "reboot required by kured",
+			node:               node(map[string]string{kuredRebootNeededAnnotation: ""}),
+			wantState:          apiv1.NodeOSRebootRequired,
+			wantRebootRequired: ptr.To(true),
+		},
+		{
+			name: "malformed values are ignored",
+			node: node(map[string]string{
+				OSRebootRequiredAnnotation:         "maybe",
+				OSLastUpdateAnnotation:             "yesterday",
+				OSPendingSecurityUpdatesAnnotation: "-1",
+			}),
+			wantState: apiv1.NodeOSUpdateUnknown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status := GetNodeOSUpdateStatus(test.node)
+			if status.State != test.wantState {
+				t.Errorf("expected state %q, got %q", test.wantState, status.State)
+			}
+			if !ptr.Equal(status.RebootRequired, test.wantRebootRequired) {
+				t.Errorf("expected reboot required %v, got %v", test.wantRebootRequired, status.RebootRequired)
+			}
+			if (status.LastUpdate != nil) != test.wantLastUpdate {
+				t.Errorf("expected last update to be set: %t, got %v", test.wantLastUpdate, status.LastUpdate)
+			}
+			if !ptr.Equal(status.PendingSecurityUpdates, test.wantPendingUpdates) {
+				t.Errorf("expected pending security updates %v, got %v", test.wantPendingUpdates, status.PendingSecurityUpdates)
+			}
+		})
+	}
+}
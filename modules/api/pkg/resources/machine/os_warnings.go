@@ -0,0 +1,191 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/machine-controller/sdk/providerconfig"
+)
+
+// osEndOfLifeWarningPeriod is how long before the end of life of an operating system version users get warned.
+const osEndOfLifeWarningPeriod = 90 * 24 * time.Hour
+
+// osEndOfLife contains the end of life dates of the operating system versions supported for the nodes.
+var osEndOfLife = map[providerconfig.OperatingSystem]map[string]time.Time{
+	providerconfig.OperatingSystemUbuntu: {
+		"18.04": time.Date(2023, time.May, 31, 0, 0, 0, 0, time.UTC),
+		"20.04": time.Date(2025, time.May, 31, 0, 0, 0, 0, time.UTC),
+		"22.04": time.Date(2027, time.June, 1, 0, 0, 0, 0, time.UTC),
+		"24.04": time.Date(2029, time.May, 31, 0, 0, 0, 0, time.UTC),
+	},
+	providerconfig.OperatingSystemRHEL: {
+		"8": time.Date(2029, time.May, 31, 0, 0, 0, 0, time.UTC),
+		"9": time.Date(2032, time.May, 31, 0, 0, 0, 0, time.UTC),
+	},
+	providerconfig.OperatingSystemRockyLinux: {
+		"8": time.Date(2029, time.May, 31, 0, 0, 0, 0, time.UTC),
+		"9": time.Date(2032, time.May, 31, 0, 0, 0, 0, time.UTC),
+	},
+	providerconfig.OperatingSystemAmazonLinux2: {
+		"2": time.Date(2026, time.June, 30, 0, 0, 0, 0, time.UTC),
+	},
+}
+
+var (
+	ubuntuVersionRegex = regexp.MustCompile(`(\d{2})[._-](04|10)`)
+	// ubuntuCodenames maps the codenames used in image names, e.g. by the Azure marketplace, to the versions.
+	ubuntuCodenames = map[string]string{
+		"bionic": "18.04",
+		"focal":  "20.04",
+		"jammy":  "22.04",
+		"noble":  "24.04",
+	}
+	rhelVersionRegex       = regexp.MustCompile(`rhel\D{0,10}?(\d{1,2})\b`)
+	rockyLinuxVersionRegex = regexp.MustCompile(`rocky\D{0,10}?(\d{1,2})\b`)
+)
+
+// NodeImage returns the image referenced by the cloud spec of a node, or an empty string if the image is not
+// set, e.g. because the provider picks it automatically.
+func NodeImage(cloud apiv1.NodeCloudSpec) string {
+	switch {
+	case cloud.Azure != nil:
+		return cloud.Azure.ImageID
+	case cloud.Openstack != nil:
+		return cloud.Openstack.Image
+	case cloud.GCP != nil:
+		return cloud.GCP.CustomImage
+	case cloud.VSphere != nil:
+		return cloud.VSphere.Template
+	case cloud.Kubevirt != nil:
+		return cloud.Kubevirt.PrimaryDiskOSImage
+	case cloud.Baremetal != nil && cloud.Baremetal.Tinkerbell != nil:
+		return cloud.Baremetal.Tinkerbell.OsImageUrl
+	case cloud.Nutanix != nil:
+		return cloud.Nutanix.ImageName
+	case cloud.OpenNebula != nil:
+		return cloud.OpenNebula.Image
+	case cloud.VMwareCloudDirector != nil:
+		return cloud.VMwareCloudDirector.Template
+	case cloud.Anexia != nil:
+		return cloud.Anexia.Template
+	default:
+		return ""
+	}
+}
+
+// operatingSystemVersion derives the version of the operating system from the image name, it returns an empty
+// string if the version can't be determined.
+func operatingSystemVersion(osName providerconfig.OperatingSystem, image string) string {
+	image = strings.ToLower(image)
+
+	switch osName {
+	case providerconfig.OperatingSystemUbuntu:
+		if match := ubuntuVersionRegex.FindStringSubmatch(image); match != nil {
+			return match[1] + "." + match[2]
+		}
+		for codename, version := range ubuntuCodenames {
+			if strings.Contains(image, codename) {
+				return version
+			}
+		}
+	case providerconfig.OperatingSystemRHEL:
+		if match := rhelVersionRegex.FindStringSubmatch(image); match != nil {
+			return match[1]
+		}
+	case providerconfig.OperatingSystemRockyLinux:
+		if match := rockyLinuxVersionRegex.FindStringSubmatch(image); match != nil {
+			return match[1]
+		}
+	case providerconfig.OperatingSystemAmazonLinux2:
+		return "2"
+	}
+
+	return ""
+}
+
+// OperatingSystemWarnings returns a warning if the operating system version of the node deployment reached its
+// end of life or reaches it within the next 90 days. The version is derived from the image of the node deployment,
+// no warning is returned if it can't be determined.
+func OperatingSystemWarnings(nd *apiv1.NodeDeployment, now time.Time) []string {
+	osName, err := getOsName(nd.Spec.Template)
+	if err != nil {
+		return nil
+	}
+
+	version := operatingSystemVersion(osName, NodeImage(nd.Spec.Template.Cloud))
+	endOfLife, ok := osEndOfLife[osName][version]
+	if !ok {
+		return nil
+	}
+
+	date := endOfLife.Format(time.DateOnly)
+	switch {
+	case !now.Before(endOfLife):
+		return []string{fmt.Sprintf("operating system %s %s reached its end of life on %s", osName, version, date)}
+	case endOfLife.Sub(now) <= osEndOfLifeWarningPeriod:
+		days := int(math.Ceil(endOfLife.Sub(now).Hours() / 24))
+		return []string{fmt.Sprintf("operating system %s %s reaches its end of life on %s, in %d days", osName, version, date, days)}
+	default:
+		return nil
+	}
+}
+
+// ImageAvailabilityWarnings returns a warning if the image of the node deployment is no longer offered by the
+// datacenter. Only the providers listing their images in the datacenter, i.e. KubeVirt and Tinkerbell, are
+// checked and only images downloaded via HTTP, as other sources can't be listed.
+func ImageAvailabilityWarnings(nd *apiv1.NodeDeployment, dc *kubermaticv1.Datacenter) []string {
+	image := NodeImage(nd.Spec.Template.Cloud)
+	if !strings.HasPrefix(image, "http://") && !strings.HasPrefix(image, "https://") {
+		return nil
+	}
+
+	osName, err := getOsName(nd.Spec.Template)
+	if err != nil {
+		return nil
+	}
+
+	var offered kubermaticv1.OSVersions
+	switch {
+	case nd.Spec.Template.Cloud.Kubevirt != nil:
+		if dc.Spec.Kubevirt != nil && dc.Spec.Kubevirt.Images.HTTP != nil {
+			offered = dc.Spec.Kubevirt.Images.HTTP.OperatingSystems[osName]
+		}
+	case nd.Spec.Template.Cloud.Baremetal != nil:
+		if dc.Spec.Baremetal != nil && dc.Spec.Baremetal.Tinkerbell != nil && dc.Spec.Baremetal.Tinkerbell.Images.HTTP != nil {
+			offered = dc.Spec.Baremetal.Tinkerbell.Images.HTTP.OperatingSystems[osName]
+		}
+	}
+
+	// Datacenters without an image list allow any image.
+	if len(offered) == 0 {
+		return nil
+	}
+	for _, url := range offered {
+		if url == image {
+			return nil
+		}
+	}
+
+	return []string{fmt.Sprintf("image %s is no longer offered by the datacenter for %s", image, osName)}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/machine-controller/sdk/providerconfig"
+)
+
+func TestOperatingSystemWarnings(t *testing.T) {
+	defaultEndOfLife := osEndOfLife
+	defer func() { osEndOfLife = defaultEndOfLife }()
+
+	osEndOfLife = map[providerconfig.OperatingSystem]map[string]time.Time{
+		providerconfig.OperatingSystemUbuntu: {
+			"20.04": time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+			"22.04": time.Date(2030, time.March, 1, 0, 0, 0, 0, time.UTC),
+			"24.04": time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		providerconfig.OperatingSystemRockyLinux: {
+			"8": time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		providerconfig.OperatingSystemAmazonLinux2: {
+			"2": time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	now := time.Date(2030, time.January, 15, 0, 0, 0, 0, time.UTC)
+
+	nodeDeployment := func(os apiv1.OperatingSystemSpec, cloud apiv1.NodeCloudSpec) *apiv1.NodeDeployment {
+		return &apiv1.NodeDeployment{Spec: apiv1.NodeDeploymentSpec{Template: apiv1.NodeSpec{OperatingSystem: os, Cloud: cloud}}}
+	}
+	ubuntu := apiv1.OperatingSystemSpec{Ubuntu: &apiv1.UbuntuSpec{}}
+
+	tests := []struct {
+		name string
+		nd   *apiv1.NodeDeployment
+		want []string
+	}{
+		{
+			name: "warn about an operating system version past its end of life",
+			nd:   nodeDeployment(ubuntu, apiv1.NodeCloudSpec{Openstack: &apiv1.OpenstackNodeSpec{Image: "Ubuntu Focal 20.04 (2024-01-01)"}}),
+			want: []string{"operating system ubuntu 20.04 reached its end of life on 2030-01-01"},
+		},
+		{
+			name: "warn about an operating system version reaching its end of life soon",
+			nd:   nodeDeployment(ubuntu, apiv1.NodeCloudSpec{Azure: &apiv1.AzureNodeSpec{ImageID: "/subscriptions/id/images/ubuntu-22_04-lts"}}),
+			want: []string{"operating system ubuntu 22.04 reaches its end of life on 2030-03-01, in 45 days"},
+		},
+		{
+			name: "derive the version from the codename",
+			nd:   nodeDeployment(ubuntu, apiv1.NodeCloudSpec{GCP: &apiv1.GCPNodeSpec{CustomImage: "ubuntu-focal-server"}}),
+			want: []string{"operating system ubuntu 20.04 reached its end of life on 2030-01-01"},
+		},
+		{
+			name: "no warning for a supported operating system version",
+			nd:   nodeDeployment(ubuntu, apiv1.NodeCloudSpec{Openstack: &apiv1.OpenstackNodeSpec{Image: "ubuntu-24.04"}}),
+		},
+		{
+			name: "warn about rocky linux",
+			nd: nodeDeployment(apiv1.OperatingSystemSpec{RockyLinux: &apiv1.RockyLinuxSpec{}},
+				apiv1.NodeCloudSpec{Openstack: &apiv1.OpenstackNodeSpec{Image: "Rocky-8-GenericCloud"}}),
+			want: []string{"operating system rockylinux 8 reached its end of life on 2030-01-01"},
+		},
+		{
+			name: "amazon linux has a single version",
+			nd:   nodeDeployment(apiv1.OperatingSystemSpec{AmazonLinux: &apiv1.AmazonLinuxSpec{}}, apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{}}),
+			want: []string{"operating system amzn2 2 reached its end of life on 2030-01-01"},
+		},
+		{
+			name: "no warning if the image is not set",
+			nd:   nodeDeployment(ubuntu, apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{}}),
+		},
+		{
+			name: "no warning if the version can't be derived from the image",
+			nd:   nodeDeployment(ubuntu, apiv1.NodeCloudSpec{Openstack: &apiv1.OpenstackNodeSpec{Image: "custom-image"}}),
+		},
+		{
+			name: "no warning for versions missing in the table",
+			nd:   nodeDeployment(ubuntu, apiv1.NodeCloudSpec{Openstack: &apiv1.OpenstackNodeSpec{Image: "ubuntu-16.04"}}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OperatingSystemWarnings(tt.nd, now); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OperatingSystemWarnings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImageAvailabilityWarnings(t *testing.T) {
+	dc := &kubermaticv1.Datacenter{Spec: kubermaticv1.DatacenterSpec{Kubevirt: &kubermaticv1.DatacenterSpecKubevirt{
+		Images: kubermaticv1.KubeVirtImageSources{HTTP: &kubermaticv1.KubeVirtHTTPSource{
+			OperatingSystems: map[providerconfig.OperatingSystem]kubermaticv1.OSVersions{
+				providerconfig.OperatingSystemUbuntu: {"22.04": "https://images.example.com/ubuntu-22.04.img"},
+			},
+		}},
+	}}}
+	nodeDeployment := func(os apiv1.OperatingSystemSpec, image string) *apiv1.NodeDeployment {
+		return &apiv1.NodeDeployment{Spec: apiv1.NodeDeploymentSpec{Template: apiv1.NodeSpec{
+			OperatingSystem: os,
+			Cloud:           apiv1.NodeCloudSpec{Kubevirt: &apiv1.KubevirtNodeSpec{PrimaryDiskOSImage: image}},
+		}}}
+	}
+	ubuntu := apiv1.OperatingSystemSpec{Ubuntu: &apiv1.UbuntuSpec{}}
+
+	tests := []struct {
+		name string
+		nd   *apiv1.NodeDeployment
+		dc   *kubermaticv1.Datacenter
+		want []string
+	}{
+		{
+			name: "no warning for an offered image",
+			nd:   nodeDeployment(ubuntu, "https://images.example.com/ubuntu-22.04.img"),
+			dc:   dc,
+		},
+		{
+			name: "warn about an image which is no longer offered",
+			nd:   nodeDeployment(ubuntu, "https://images.example.com/ubuntu-20.04.img"),
+			dc:   dc,
+			want: []string{"image https://images.example.com/ubuntu-20.04.img is no longer offered by the datacenter for ubuntu"},
+		},
+		{
+			name: "no warning for images from other sources",
+			nd:   nodeDeployment(ubuntu, "docker://registry.example.com/ubuntu:20.04"),
+			dc:   dc,
+		},
+		{
+			name: "no warning if the datacenter lists no images for the operating system",
+			nd:   nodeDeployment(apiv1.OperatingSystemSpec{Flatcar: &apiv1.FlatcarSpec{}}, "https://images.example.com/flatcar.img"),
+			dc:   dc,
+		},
+		{
+			name: "no warning if the datacenter lists no images",
+			nd:   nodeDeployment(ubuntu, "https://images.example.com/ubuntu-20.04.img"),
+			dc:   &kubermaticv1.Datacenter{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ImageAvailabilityWarnings(tt.nd, tt.dc); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ImageAvailabilityWarnings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
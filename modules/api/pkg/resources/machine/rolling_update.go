@@ -0,0 +1,114 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/machine-controller/sdk/apis/cluster/common"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// defaultMaxSurge and defaultMaxUnavailable are the values the machine-controller defaults the
+	// rolling update parameters of a machine deployment to.
+	defaultMaxSurge       = "1"
+	defaultMaxUnavailable = "0"
+)
+
+// ValidateRollingUpdate validates the rolling update parameters of the node deployment spec. Both have to be
+// either an absolute number or a percentage and they can't be both zero, as the rollout would never progress.
+// Unset parameters are validated with the machine-controller defaults.
+func ValidateRollingUpdate(spec apiv1.NodeDeploymentSpec) error {
+	if spec.MaxSurge == "" && spec.MaxUnavailable == "" {
+		return nil
+	}
+
+	maxSurge, err := parseRollingUpdateValue("maxSurge", spec.MaxSurge, defaultMaxSurge)
+	if err != nil {
+		return err
+	}
+	maxUnavailable, err := parseRollingUpdateValue("maxUnavailable", spec.MaxUnavailable, defaultMaxUnavailable)
+	if err != nil {
+		return err
+	}
+	if maxSurge == 0 && maxUnavailable == 0 {
+		return fmt.Errorf("maxSurge and maxUnavailable cannot be both zero")
+	}
+
+	return nil
+}
+
+// parseRollingUpdateValue returns the number or the percentage of the int-or-percent value.
+func parseRollingUpdateValue(field, value, defaultValue string) (int, error) {
+	if value == "" {
+		value = defaultValue
+	}
+
+	number, err := strconv.Atoi(strings.TrimSuffix(value, "%"))
+	if err != nil || number < 0 {
+		return 0, fmt.Errorf("%s '%s' must be a non-negative number or percentage, e.g. 1 or 25%%", field, value)
+	}
+
+	return number, nil
+}
+
+// setRollingUpdateStrategy sets the rolling update strategy of the machine deployment from the node deployment
+// spec. The strategy is left to the machine-controller defaults if neither parameter is set.
+func setRollingUpdateStrategy(md *clusterv1alpha1.MachineDeployment, spec apiv1.NodeDeploymentSpec) {
+	if spec.MaxSurge == "" && spec.MaxUnavailable == "" {
+		md.Spec.Strategy = nil
+		return
+	}
+
+	rollingUpdate := &clusterv1alpha1.MachineRollingUpdateDeployment{}
+	if spec.MaxSurge != "" {
+		maxSurge := intstr.Parse(spec.MaxSurge)
+		rollingUpdate.MaxSurge = &maxSurge
+	}
+	if spec.MaxUnavailable != "" {
+		maxUnavailable := intstr.Parse(spec.MaxUnavailable)
+		rollingUpdate.MaxUnavailable = &maxUnavailable
+	}
+
+	md.Spec.Strategy = &clusterv1alpha1.MachineDeploymentStrategy{
+		Type:          common.RollingUpdateMachineDeploymentStrategyType,
+		RollingUpdate: rollingUpdate,
+	}
+}
+
+// GetRollingUpdateStrategy returns the rolling update parameters of the machine deployment. They are empty if the
+// machine deployment has no explicit rolling update strategy.
+func GetRollingUpdateStrategy(md *clusterv1alpha1.MachineDeployment) (maxSurge, maxUnavailable string) {
+	if md.Spec.Strategy == nil || md.Spec.Strategy.RollingUpdate == nil {
+		return "", ""
+	}
+
+	if md.Spec.Strategy.RollingUpdate.MaxSurge != nil {
+		maxSurge = md.Spec.Strategy.RollingUpdate.MaxSurge.String()
+	}
+	if md.Spec.Strategy.RollingUpdate.MaxUnavailable != nil {
+		maxUnavailable = md.Spec.Strategy.RollingUpdate.MaxUnavailable.String()
+	}
+
+	return maxSurge, maxUnavailable
+}
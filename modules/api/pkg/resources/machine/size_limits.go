@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+// MachineDeploymentSizeLimitsAnnotation holds the global size limits of machine deployments as JSON on the
+// KubermaticSetting.
+const MachineDeploymentSizeLimitsAnnotation = "kubermatic.io/machine-deployment-size-limits"
+
+// GetMachineDeploymentSizeLimits returns the global size limits of machine deployments. Without the annotation
+// there are no limits.
+func GetMachineDeploymentSizeLimits(settings *kubermaticv1.KubermaticSetting) (apiv2.MachineDeploymentSizeLimits, error) {
+	limits := apiv2.MachineDeploymentSizeLimits{}
+
+	value, ok := settings.Annotations[MachineDeploymentSizeLimitsAnnotation]
+	if !ok || value == "" {
+		return limits, nil
+	}
+	if err := json.Unmarshal([]byte(value), &limits); err != nil {
+		return limits, fmt.Errorf("failed to parse machine deployment size limits: %w", err)
+	}
+
+	return limits, nil
+}
+
+// SetMachineDeploymentSizeLimits sets the global size limits of machine deployments on the settings. Empty limits
+// remove the annotation.
+func SetMachineDeploymentSizeLimits(settings *kubermaticv1.KubermaticSetting, limits apiv2.MachineDeploymentSizeLimits) error {
+	if limits == (apiv2.MachineDeploymentSizeLimits{}) {
+		delete(settings.Annotations, MachineDeploymentSizeLimitsAnnotation)
+		return nil
+	}
+
+	value, err := json.Marshal(limits)
+	if err != nil {
+		return err
+	}
+	if settings.Annotations == nil {
+		settings.Annotations = map[string]string{}
+	}
+	settings.Annotations[MachineDeploymentSizeLimitsAnnotation] = string(value)
+
+	return nil
+}
+
+// ValidateMachineDeploymentSizeLimits checks that the limits are consistent, the default number of replicas must
+// not exceed the maximum.
+func ValidateMachineDeploymentSizeLimits(limits apiv2.MachineDeploymentSizeLimits) error {
+	if limits.MaxReplicas < 0 || limits.DefaultReplicas < 0 || limits.MaxAutoscalerMax < 0 {
+		return errors.New("machine deployment size limits must not be negative")
+	}
+	if limits.MaxReplicas > 0 && limits.DefaultReplicas > limits.MaxReplicas {
+		return fmt.Errorf("default replicas (%d) must not exceed the max replicas (%d)", limits.DefaultReplicas, limits.MaxReplicas)
+	}
+
+	return nil
+}
+
+// DefaultReplicas sets the replicas of a new node deployment to the global default, if neither the replicas nor
+// the autoscaler minimum are set.
+func DefaultReplicas(limits apiv2.MachineDeploymentSizeLimits, spec *apiv1.NodeDeploymentSpec) {
+	if limits.DefaultReplicas > 0 && spec.Replicas == 0 && spec.MinReplicas == nil {
+		spec.Replicas = limits.DefaultReplicas
+	}
+}
+
+// ValidateMachineDeploymentSize checks the replicas and the autoscaler maximum of the node deployment against the
+// global limits. If the existing spec is given, only values which are increased are checked, so that machine
+// deployments which were created before a limit was lowered can still be changed.
+func ValidateMachineDeploymentSize(limits apiv2.MachineDeploymentSizeLimits, spec, existing *apiv1.NodeDeploymentSpec) error {
+	if limits.MaxReplicas > 0 && spec.Replicas > limits.MaxReplicas && (existing == nil || spec.Replicas > existing.Replicas) {
+		return fmt.Errorf("replica count (%d) exceeds the global limit of %d replicas per machine deployment", spec.Replicas, limits.MaxReplicas)
+	}
+
+	if limits.MaxAutoscalerMax > 0 && spec.MaxReplicas != nil && int64(*spec.MaxReplicas) > int64(limits.MaxAutoscalerMax) {
+		if existing == nil || existing.MaxReplicas == nil || *spec.MaxReplicas > *existing.MaxReplicas {
+			return fmt.Errorf("autoscaler maxreplicas (%d) exceeds the global limit of %d", *spec.MaxReplicas, limits.MaxAutoscalerMax)
+		}
+	}
+
+	return nil
+}
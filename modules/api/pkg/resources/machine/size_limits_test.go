@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateMachineDeploymentSize(t *testing.T) {
+	limits := apiv2.MachineDeploymentSizeLimits{MaxReplicas: 5, MaxAutoscalerMax: 8}
+
+	testCases := []struct {
+		name          string
+		spec          apiv1.NodeDeploymentSpec
+		existing      *apiv1.NodeDeploymentSpec
+		expectedError string
+	}{
+		{
+			name: "replicas within the limit",
+			spec: apiv1.NodeDeploymentSpec{Replicas: 5, MaxReplicas: ptr.To[uint32](8)},
+		},
+		{
+			name:          "replicas above the limit",
+			spec:          apiv1.NodeDeploymentSpec{Replicas: 6},
+			expectedError: "replica count (6) exceeds the global limit of 5 replicas per machine deployment",
+		},
+		{
+			name:          "autoscaler maximum above the limit",
+			spec:          apiv1.NodeDeploymentSpec{Replicas: 1, MaxReplicas: ptr.To[uint32](9)},
+			expectedError: "autoscaler maxreplicas (9) exceeds the global limit of 8",
+		},
+		{
+			name:     "existing machine deployment above the limit can be scaled down",
+			spec:     apiv1.NodeDeploymentSpec{Replicas: 7, MaxReplicas: ptr.To[uint32](10)},
+			existing: &apiv1.NodeDeploymentSpec{Replicas: 9, MaxReplicas: ptr.To[uint32](10)},
+		},
+		{
+			name:          "existing machine deployment above the limit can not be scaled up",
+			spec:          apiv1.NodeDeploymentSpec{Replicas: 10},
+			existing:      &apiv1.NodeDeploymentSpec{Replicas: 9},
+			expectedError: "replica count (10) exceeds the global limit of 5 replicas per machine deployment",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateMachineDeploymentSize(limits, &tc.spec, tc.existing)
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expectedError {
+				t.Fatalf("expected error %q, got: %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestDefaultReplicas(t *testing.T) {
+	limits := apiv2.MachineDeploymentSizeLimits{DefaultReplicas: 3}
+
+	spec := &apiv1.NodeDeploymentSpec{}
+	DefaultReplicas(limits, spec)
+	if spec.Replicas != 3 {
+		t.Fatalf("expected the default of 3 replicas, got %d", spec.Replicas)
+	}
+
+	spec = &apiv1.NodeDeploymentSpec{MinReplicas: ptr.To[uint32](0)}
+	DefaultReplicas(limits, spec)
+	if spec.Replicas != 0 {
+		t.Fatalf("expected the replicas of an autoscaled machine deployment to be kept, got %d", spec.Replicas)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"sort"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// spotInterruptionReasons are the event reasons the AWS node termination handler and Karpenter use for the notices
+// about spot instances.
+var spotInterruptionReasons = map[string]string{
+	"SpotInterruption":        apiv1.NodeSpotTerminationNotice,
+	"SpotInterrupted":         apiv1.NodeSpotTerminationNotice,
+	"RebalanceRecommendation": apiv1.NodeSpotRebalanceRecommendation,
+}
+
+// IsAWSSpotInstance tells whether the nodes of the cloud spec run on AWS spot instances.
+func IsAWSSpotInstance(cloud apiv1.NodeCloudSpec) bool {
+	return cloud.AWS != nil && cloud.AWS.IsSpotInstance != nil && *cloud.AWS.IsSpotInstance
+}
+
+// SpotInterruptionEvents are the events about spot interruptions by the kind and name of the object they belong to.
+type SpotInterruptionEvents map[string][]corev1.Event
+
+// NewSpotInterruptionEvents keeps the events about spot interruptions of machines and nodes.
+func NewSpotInterruptionEvents(events []corev1.Event) SpotInterruptionEvents {
+	result := SpotInterruptionEvents{}
+	for _, event := range events {
+		if _, ok := spotInterruptionReasons[event.Reason]; !ok {
+			continue
+		}
+		if kind := event.InvolvedObject.Kind; kind != "Machine" && kind != "Node" {
+			continue
+		}
+		key := spotInterruptionEventsKey(event.InvolvedObject.Kind, event.InvolvedObject.Name)
+		result[key] = append(result[key], event)
+	}
+
+	return result
+}
+
+// ForMachine returns the events about spot interruptions of the machine and its node. The node may be nil.
+func (e SpotInterruptionEvents) ForMachine(machine *clusterv1alpha1.Machine, node *corev1.Node) []corev1.Event {
+	events := e[spotInterruptionEventsKey("Machine", machine.Name)]
+	if node != nil {
+		events = append(events[:len(events):len(events)], e[spotInterruptionEventsKey("Node", node.Name)]...)
+	}
+
+	return events
+}
+
+func spotInterruptionEventsKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+// GetNodeSpotInterruptions returns the latest notice of every type from the events about spot interruptions.
+func GetNodeSpotInterruptions(events []corev1.Event) []apiv1.NodeSpotInterruption {
+	latest := map[string]apiv1.NodeSpotInterruption{}
+	for _, event := range events {
+		interruptionType := spotInterruptionReasons[event.Reason]
+		eventTime := spotInterruptionEventTime(event)
+		if existing, ok := latest[interruptionType]; ok && !eventTime.After(existing.Time.Time) {
+			continue
+		}
+		latest[interruptionType] = apiv1.NodeSpotInterruption{
+			Type:    interruptionType,
+			Message: event.Message,
+			Time:    apiv1.NewTime(eventTime),
+		}
+	}
+
+	var interruptions []apiv1.NodeSpotInterruption
+	for _, interruption := range latest {
+		interruptions = append(interruptions, interruption)
+	}
+	sort.Slice(interruptions, func(i, j int) bool {
+		return interruptions[i].Type < interruptions[j].Type
+	})
+
+	return interruptions
+}
+
+// HasSpotTerminationNoticeSince tells whether a termination notice was received after the given time.
+func HasSpotTerminationNoticeSince(events []corev1.Event, since time.Time) bool {
+	for _, event := range events {
+		if spotInterruptionReasons[event.Reason] == apiv1.NodeSpotTerminationNotice && spotInterruptionEventTime(event).After(since) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// spotInterruptionEventTime returns the time the event was observed last, events created with the events API only
+// have an event time.
+func spotInterruptionEventTime(event corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
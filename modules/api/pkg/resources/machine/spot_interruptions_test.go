@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSpotInterruptions(t *testing.T) {
+	now := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	event := func(kind, name, reason string, eventTime time.Time) corev1.Event {
+		return corev1.Event{
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: name},
+			Reason:         reason,
+			Message:        reason + " of " + name,
+			LastTimestamp:  metav1.NewTime(eventTime),
+		}
+	}
+
+	events := NewSpotInterruptionEvents([]corev1.Event{
+		event("Machine", "worker-1", "RebalanceRecommendation", now.Add(-3*time.Hour)),
+		event("Machine", "worker-1", "RebalanceRecommendation", now.Add(-2*time.Hour)),
+		event("Node", "ip-10-0-0-1", "SpotInterruption", now.Add(-time.Hour)),
+		event("Node", "worker-1", "SpotInterruption", now.Add(-time.Minute)),
+		event("Machine", "worker-2", "SpotInterrupted", now.Add(-48*time.Hour)),
+		event("Machine", "worker-2", "Created", now),
+		event("Pod", "worker-2", "SpotInterruption", now),
+	})
+
+	tests := []struct {
+		name                  string
+		machine               string
+		node                  string
+		wantInterruptions     []apiv1.NodeSpotInterruption
+		wantTerminationNotice bool
+	}{
+		{
+			name:    "latest notices of the machine and its node",
+			machine: "worker-1",
+			node:    "ip-10-0-0-1",
+			wantInterruptions: []apiv1.NodeSpotInterruption{
+				{Type: apiv1.NodeSpotRebalanceRecommendation, Message: "RebalanceRecommendation of worker-1", Time: apiv1.NewTime(now.Add(-2 * time.Hour))},
+				{Type: apiv1.NodeSpotTerminationNotice, Message: "SpotInterruption of ip-10-0-0-1", Time: apiv1.NewTime(now.Add(-time.Hour))},
+			},
+			wantTerminationNotice: true,
+		},
+		{
+			name:    "old termination notice without a node",
+			machine: "worker-2",
+			wantInterruptions: []apiv1.NodeSpotInterruption{
+				{Type: apiv1.NodeSpotTerminationNotice, Message: "SpotInterrupted of worker-2", Time: apiv1.NewTime(now.Add(-48 * time.Hour))},
+			},
+		},
+		{
+			name:    "no notices",
+			machine: "worker-3",
+			node:    "worker-3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			machine := &clusterv1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Name: test.machine}}
+			var node *corev1.Node
+			if test.node != "" {
+				node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: test.node}}
+			}
+			machineEvents := events.ForMachine(machine, node)
+
+			interruptions := GetNodeSpotInterruptions(machineEvents)
+			if len(interruptions) != len(test.wantInterruptions) {
+				t.Fatalf("expected interruptions %+v, got %+v", test.wantInterruptions, interruptions)
+			}
+			for i := range interruptions {
+				want := test.wantInterruptions[i]
+				if interruptions[i].Type != want.Type || interruptions[i].Message != want.Message || !interruptions[i].Time.Equal(want.Time.Time) {
+					t.Errorf("expected interruption %+v, got %+v", want, interruptions[i])
+				}
+			}
+
+			if notice := HasSpotTerminationNoticeSince(machineEvents, now.Add(-24*time.Hour)); notice != test.wantTerminationNotice {
+				t.Errorf("expected a termination notice in the last 24 hours to be %v, got %v", test.wantTerminationNotice, notice)
+			}
+		})
+	}
+}
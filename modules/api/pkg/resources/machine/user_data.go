@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	machineconversions "k8c.io/dashboard/v2/pkg/machine"
+	"k8c.io/machine-controller/sdk/providerconfig"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// maxAdditionalUserDataSize keeps the user data of the machines below the limits of the cloud providers.
+	maxAdditionalUserDataSize = 16 * 1024
+
+	cloudConfigHeader = "#cloud-config"
+)
+
+// cloudConfigUsers is the part of a cloud-config document which defines the users of the machine. Entries are
+// either the string "default" or a user definition.
+type cloudConfigUsers struct {
+	Users []interface{} `json:"users"`
+}
+
+// ValidateAdditionalUserData validates the additional user data of the node spec. It is limited to 16KB and, if it
+// is a cloud-config document, has to be valid YAML which does not redefine the SSH login user of the nodes.
+func ValidateAdditionalUserData(spec apiv1.NodeSpec) error {
+	if spec.AdditionalUserData == "" {
+		return nil
+	}
+
+	if len(spec.AdditionalUserData) > maxAdditionalUserDataSize {
+		return fmt.Errorf("additional user data must not be larger than %d bytes, got %d bytes", maxAdditionalUserDataSize, len(spec.AdditionalUserData))
+	}
+
+	if !strings.HasPrefix(spec.AdditionalUserData, cloudConfigHeader) {
+		return nil
+	}
+
+	config := cloudConfigUsers{}
+	if err := yaml.Unmarshal([]byte(spec.AdditionalUserData), &config); err != nil {
+		return fmt.Errorf("additional user data is not a valid cloud-config document: %w", err)
+	}
+
+	sshUserName, err := machineconversions.GetSSHUserName(&spec.OperatingSystem, &spec.Cloud)
+	if err != nil {
+		// the operating system and cloud provider are validated separately
+		return nil
+	}
+
+	for _, user := range config.Users {
+		definition, ok := user.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _ := definition["name"].(string); name == sshUserName {
+			return fmt.Errorf("additional user data must not redefine the SSH login user '%s'", sshUserName)
+		}
+	}
+
+	return nil
+}
+
+// setAdditionalUserData stores the additional user data in the operating system spec of the provider config.
+func setAdditionalUserData(config *providerconfig.Config, userData string) error {
+	if userData == "" {
+		return nil
+	}
+
+	return setOperatingSystemSpecField(config, "additionalUserData", userData)
+}
+
+// setOperatingSystemSpecField sets a field of the operating system spec of the provider config, which the operating
+// system configs of the machine-controller don't know about.
+func setOperatingSystemSpecField(config *providerconfig.Config, field string, value interface{}) error {
+	osSpec := map[string]interface{}{}
+	if len(config.OperatingSystemSpec.Raw) > 0 {
+		if err := json.Unmarshal(config.OperatingSystemSpec.Raw, &osSpec); err != nil {
+			return fmt.Errorf("failed to parse operating system spec: %w", err)
+		}
+	}
+	osSpec[field] = value
+
+	raw, err := json.Marshal(osSpec)
+	if err != nil {
+		return err
+	}
+	config.OperatingSystemSpec.Raw = raw
+
+	return nil
+}
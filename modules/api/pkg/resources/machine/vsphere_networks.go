@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// VSphereAdditionalNetworksAnnotation holds the comma separated additional networks of a VSphere machine deployment.
+// The machine-controller provider spec only has a single list of networks, which also contains the ones of the
+// cluster, so the additional networks couldn't be told apart from them otherwise.
+const VSphereAdditionalNetworksAnnotation = "kubermatic.io/vsphere-additional-networks"
+
+// ValidateVSphereAdditionalNetworks validates the additional networks of a VSphere node deployment. The names have
+// to be unique and must not be one of the networks the cluster already attaches to all machines.
+func ValidateVSphereAdditionalNetworks(c *kubermaticv1.Cluster, cloud apiv1.NodeCloudSpec) error {
+	if cloud.VSphere == nil || len(cloud.VSphere.AdditionalNetworks) == 0 {
+		return nil
+	}
+
+	clusterNetworks := sets.New[string]()
+	if c.Spec.Cloud.VSphere != nil {
+		clusterNetworks.Insert(c.Spec.Cloud.VSphere.Networks...)
+		if c.Spec.Cloud.VSphere.VMNetName != "" {
+			clusterNetworks.Insert(c.Spec.Cloud.VSphere.VMNetName)
+		}
+	}
+
+	seen := sets.New[string]()
+	for _, network := range cloud.VSphere.AdditionalNetworks {
+		switch {
+		case network == "":
+			return errors.New("additional network names must not be empty")
+		case strings.Contains(network, ","):
+			return fmt.Errorf("additional network %q must not contain a comma", network)
+		case seen.Has(network):
+			return fmt.Errorf("additional network %q is set more than once", network)
+		case clusterNetworks.Has(network):
+			return fmt.Errorf("additional network %q is already attached to all machines of the cluster", network)
+		}
+		seen.Insert(network)
+	}
+
+	return nil
+}
+
+func setVSphereAdditionalNetworksAnnotation(annotations map[string]string, cloud apiv1.NodeCloudSpec) {
+	delete(annotations, VSphereAdditionalNetworksAnnotation)
+
+	if cloud.VSphere == nil || len(cloud.VSphere.AdditionalNetworks) == 0 {
+		return
+	}
+
+	annotations[VSphereAdditionalNetworksAnnotation] = strings.Join(cloud.VSphere.AdditionalNetworks, ",")
+}
+
+// SetVSphereAdditionalNetworks sets the additional networks stored in the machine deployment annotations on the
+// VSphere node spec.
+func SetVSphereAdditionalNetworks(cloud *apiv1.NodeCloudSpec, annotations map[string]string) {
+	if cloud.VSphere == nil || annotations[VSphereAdditionalNetworksAnnotation] == "" {
+		return
+	}
+
+	cloud.VSphere.AdditionalNetworks = strings.Split(annotations[VSphereAdditionalNetworksAnnotation], ",")
+}
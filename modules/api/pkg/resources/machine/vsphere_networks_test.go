@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	machineconversions "k8c.io/dashboard/v2/pkg/machine"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+	"k8c.io/machine-controller/sdk/providerconfig"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func TestVSphereAdditionalNetworksRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name               string
+		clusterSpec        kubermaticv1.VSphereCloudSpec
+		additionalNetworks []string
+		expectedVMNetName  string
+		expectedNetworks   []string
+	}{
+		{
+			name:               "additional networks are attached after the ones of the cluster",
+			clusterSpec:        kubermaticv1.VSphereCloudSpec{Networks: []string{"VM Network"}},
+			additionalNetworks: []string{"storage-vlan", "backup-vlan"},
+			expectedNetworks:   []string{"VM Network", "storage-vlan", "backup-vlan"},
+		},
+		{
+			name:               "the deprecated network of the cluster is kept",
+			clusterSpec:        kubermaticv1.VSphereCloudSpec{VMNetName: "VM Network"},
+			additionalNetworks: []string{"storage-vlan"},
+			expectedNetworks:   []string{"VM Network", "storage-vlan"},
+		},
+		{
+			name:              "no additional networks",
+			clusterSpec:       kubermaticv1.VSphereCloudSpec{VMNetName: "VM Network"},
+			expectedVMNetName: "VM Network",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{Spec: kubermaticv1.ClusterSpec{Cloud: kubermaticv1.CloudSpec{VSphere: &tc.clusterSpec}}}
+			dc := &kubermaticv1.Datacenter{Spec: kubermaticv1.DatacenterSpec{VSphere: &kubermaticv1.DatacenterSpecVSphere{Datacenter: "dc-1"}}}
+			nodeSpec := apiv1.NodeSpec{Cloud: apiv1.NodeCloudSpec{VSphere: &apiv1.VSphereNodeSpec{
+				CPUs:               2,
+				Memory:             2048,
+				DiskSizeGB:         ptr.To[int64](10),
+				Template:           "ubuntu-template",
+				AdditionalNetworks: tc.additionalNetworks,
+			}}}
+
+			config, err := GetVSphereProviderConfig(cluster, nodeSpec, dc)
+			if err != nil {
+				t.Fatalf("failed to get provider config: %v", err)
+			}
+			if config.VMNetName.Value != tc.expectedVMNetName {
+				t.Errorf("expected VM network %q, got %q", tc.expectedVMNetName, config.VMNetName.Value)
+			}
+			var networks []string
+			for _, network := range config.Networks {
+				networks = append(networks, network.Value)
+			}
+			if !reflect.DeepEqual(networks, tc.expectedNetworks) {
+				t.Errorf("expected networks %v, got %v", tc.expectedNetworks, networks)
+			}
+
+			cloudProviderSpec, err := EncodeAsRawExtension(config)
+			if err != nil {
+				t.Fatalf("failed to encode provider config: %v", err)
+			}
+			providerSpec, err := json.Marshal(providerconfig.Config{
+				CloudProvider:     providerconfig.CloudProviderVsphere,
+				CloudProviderSpec: *cloudProviderSpec,
+			})
+			if err != nil {
+				t.Fatalf("failed to marshal provider spec: %v", err)
+			}
+			annotations := map[string]string{}
+			setVSphereAdditionalNetworksAnnotation(annotations, nodeSpec.Cloud)
+
+			cloud, err := machineconversions.GetAPIV2NodeCloudSpec(clusterv1alpha1.MachineSpec{
+				ProviderSpec: clusterv1alpha1.ProviderSpec{Value: &runtime.RawExtension{Raw: providerSpec}},
+			})
+			if err != nil {
+				t.Fatalf("failed to convert provider spec: %v", err)
+			}
+			SetVSphereAdditionalNetworks(cloud, annotations)
+
+			if !reflect.DeepEqual(cloud.VSphere.AdditionalNetworks, tc.additionalNetworks) {
+				t.Errorf("expected additional networks %v after the round trip, got %v", tc.additionalNetworks, cloud.VSphere.AdditionalNetworks)
+			}
+		})
+	}
+}
@@ -68,11 +68,21 @@ type CustomTokenClaim struct {
 	TokenID   string `json:"token_id,omitempty"`
 }
 
+// DefaultExpiry returns the expiry of a token generated now, unless another expiry was requested.
+func DefaultExpiry() time.Time {
+	return Now().AddDate(3, 0, 0)
+}
+
 func Claims(email, projectID, tokenID string) (*jwt.Claims, *CustomTokenClaim) {
+	return ClaimsWithExpiry(email, projectID, tokenID, DefaultExpiry())
+}
+
+// ClaimsWithExpiry returns the claims of a token which expires at the given time.
+func ClaimsWithExpiry(email, projectID, tokenID string, expiry time.Time) (*jwt.Claims, *CustomTokenClaim) {
 	sc := &jwt.Claims{
 		IssuedAt:  jwt.NewNumericDate(Now()),
 		NotBefore: jwt.NewNumericDate(Now()),
-		Expiry:    jwt.NewNumericDate(Now().AddDate(3, 0, 0)),
+		Expiry:    jwt.NewNumericDate(expiry),
 	}
 	pc := &CustomTokenClaim{
 		Email:     email,
@@ -36,6 +36,8 @@ type ClientService interface {
 
 	ListKubeVirtPreferencesNoCredentials(params *ListKubeVirtPreferencesNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListKubeVirtPreferencesNoCredentialsOK, error)
 
+	ListKubeVirtPriorityClassesNoCredentials(params *ListKubeVirtPriorityClassesNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListKubeVirtPriorityClassesNoCredentialsOK, error)
+
 	ListKubeVirtStorageClasses(params *ListKubeVirtStorageClassesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListKubeVirtStorageClassesOK, error)
 
 	ListKubeVirtSubnetsNoCredentials(params *ListKubeVirtSubnetsNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListKubeVirtSubnetsNoCredentialsOK, error)
@@ -211,6 +213,44 @@ func (a *Client) ListKubeVirtPreferencesNoCredentials(params *ListKubeVirtPrefer
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+ListKubeVirtPriorityClassesNoCredentials List priority classes of the infra cluster which can be used for the VMs of a cluster
+*/
+func (a *Client) ListKubeVirtPriorityClassesNoCredentials(params *ListKubeVirtPriorityClassesNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListKubeVirtPriorityClassesNoCredentialsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListKubeVirtPriorityClassesNoCredentialsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listKubeVirtPriorityClassesNoCredentials",
+		Method:             "GET",
+		PathPattern:        "/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/kubevirt/priorityclasses",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListKubeVirtPriorityClassesNoCredentialsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListKubeVirtPriorityClassesNoCredentialsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListKubeVirtPriorityClassesNoCredentialsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 ListKubeVirtStorageClasses lists available k8s storage classes in the kubevirt cluster
 */
@@ -71,6 +71,9 @@ type DeleteClusterV2Params struct {
 	// ClusterID.
 	ClusterID string
 
+	// Preview.
+	Preview *bool
+
 	// ProjectID.
 	ProjectID string
 
@@ -160,6 +163,17 @@ func (o *DeleteClusterV2Params) SetClusterID(clusterID string) {
 	o.ClusterID = clusterID
 }
 
+// WithPreview adds the preview to the delete cluster v2 params
+func (o *DeleteClusterV2Params) WithPreview(preview *bool) *DeleteClusterV2Params {
+	o.SetPreview(preview)
+	return o
+}
+
+// SetPreview adds the preview to the delete cluster v2 params
+func (o *DeleteClusterV2Params) SetPreview(preview *bool) {
+	o.Preview = preview
+}
+
 // WithProjectID adds the projectID to the delete cluster v2 params
 func (o *DeleteClusterV2Params) WithProjectID(projectID string) *DeleteClusterV2Params {
 	o.SetProjectID(projectID)
@@ -200,6 +214,23 @@ func (o *DeleteClusterV2Params) WriteToRequest(r runtime.ClientRequest, reg strf
 		return err
 	}
 
+	if o.Preview != nil {
+
+		// query param preview
+		var qrPreview bool
+
+		if o.Preview != nil {
+			qrPreview = *o.Preview
+		}
+		qPreview := swag.FormatBool(qrPreview)
+		if qPreview != "" {
+
+			if err := r.SetQueryParam("preview", qPreview); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param project_id
 	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
 		return err
@@ -61,9 +61,10 @@ func NewDeleteClusterV2OK() *DeleteClusterV2OK {
 /*
 DeleteClusterV2OK describes a response with status code 200, with default header values.
 
-EmptyResponse is a empty response
+ClusterDeletionPreview
 */
 type DeleteClusterV2OK struct {
+	Payload *models.ClusterDeletionPreview
 }
 
 // IsSuccess returns true when this delete cluster v2 o k response has a 2xx status code
@@ -92,15 +93,26 @@ func (o *DeleteClusterV2OK) IsCode(code int) bool {
 }
 
 func (o *DeleteClusterV2OK) Error() string {
-	return fmt.Sprintf("[DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}][%d] deleteClusterV2OK ", 200)
+	return fmt.Sprintf("[DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}][%d] deleteClusterV2OK  %+v", 200, o.Payload)
 }
 
 func (o *DeleteClusterV2OK) String() string {
-	return fmt.Sprintf("[DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}][%d] deleteClusterV2OK ", 200)
+	return fmt.Sprintf("[DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}][%d] deleteClusterV2OK  %+v", 200, o.Payload)
+}
+
+func (o *DeleteClusterV2OK) GetPayload() *models.ClusterDeletionPreview {
+	return o.Payload
 }
 
 func (o *DeleteClusterV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	o.Payload = new(models.ClusterDeletionPreview)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
 	return nil
 }
 
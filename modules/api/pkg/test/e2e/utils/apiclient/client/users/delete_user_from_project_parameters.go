@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewDeleteUserFromProjectParams creates a new DeleteUserFromProjectParams object,
@@ -64,6 +65,12 @@ type DeleteUserFromProjectParams struct {
 	// ProjectID.
 	ProjectID string
 
+	/* RevokeTokens.
+
+	   RevokeTokens if true the viewer tokens of the project clusters are rotated as well
+	*/
+	RevokeTokens *bool
+
 	// UserID.
 	UserID string
 
@@ -131,6 +138,17 @@ func (o *DeleteUserFromProjectParams) SetProjectID(projectID string) {
 	o.ProjectID = projectID
 }
 
+// WithRevokeTokens adds the revokeTokens to the delete user from project params
+func (o *DeleteUserFromProjectParams) WithRevokeTokens(revokeTokens *bool) *DeleteUserFromProjectParams {
+	o.SetRevokeTokens(revokeTokens)
+	return o
+}
+
+// SetRevokeTokens adds the revokeTokens to the delete user from project params
+func (o *DeleteUserFromProjectParams) SetRevokeTokens(revokeTokens *bool) {
+	o.RevokeTokens = revokeTokens
+}
+
 // WithUserID adds the userID to the delete user from project params
 func (o *DeleteUserFromProjectParams) WithUserID(userID string) *DeleteUserFromProjectParams {
 	o.SetUserID(userID)
@@ -155,6 +173,14 @@ func (o *DeleteUserFromProjectParams) WriteToRequest(r runtime.ClientRequest, re
 		return err
 	}
 
+	if o.RevokeTokens != nil {
+
+		// header param RevokeTokens
+		if err := r.SetHeaderParam("RevokeTokens", swag.FormatBool(*o.RevokeTokens)); err != nil {
+			return err
+		}
+	}
+
 	// path param user_id
 	if err := r.SetPathParam("user_id", o.UserID); err != nil {
 		return err
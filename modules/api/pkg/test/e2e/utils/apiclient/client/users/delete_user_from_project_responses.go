@@ -61,10 +61,10 @@ func NewDeleteUserFromProjectOK() *DeleteUserFromProjectOK {
 /*
 DeleteUserFromProjectOK describes a response with status code 200, with default header values.
 
-User
+ProjectMemberRemoval
 */
 type DeleteUserFromProjectOK struct {
-	Payload *models.User
+	Payload *models.ProjectMemberRemoval
 }
 
 // IsSuccess returns true when this delete user from project o k response has a 2xx status code
@@ -100,13 +100,13 @@ func (o *DeleteUserFromProjectOK) String() string {
 	return fmt.Sprintf("[DELETE /api/v1/projects/{project_id}/users/{user_id}][%d] deleteUserFromProjectOK  %+v", 200, o.Payload)
 }
 
-func (o *DeleteUserFromProjectOK) GetPayload() *models.User {
+func (o *DeleteUserFromProjectOK) GetPayload() *models.ProjectMemberRemoval {
 	return o.Payload
 }
 
 func (o *DeleteUserFromProjectOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
-	o.Payload = new(models.User)
+	o.Payload = new(models.ProjectMemberRemoval)
 
 	// response payload
 	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
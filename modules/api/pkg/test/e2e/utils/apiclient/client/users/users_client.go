@@ -84,7 +84,7 @@ func (a *Client) AddUserToProject(params *AddUserToProjectParams, authInfo runti
 }
 
 /*
-DeleteUserFromProject Removes the given member from the project
+DeleteUserFromProject Removes the given member from the project and revokes the access of the member to the clusters of the project
 */
 func (a *Client) DeleteUserFromProject(params *DeleteUserFromProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteUserFromProjectOK, error) {
 	// TODO: Validate the params before sending
@@ -37,6 +37,9 @@ type Cluster struct {
 	// Format: date-time
 	DeletionTimestamp strfmt.DateTime `json:"deletionTimestamp,omitempty"`
 
+	// FetchStatus is set to unknown if the cluster couldn't be fetched from its seed and the last known data is shown.
+	FetchStatus string `json:"fetchStatus,omitempty"`
+
 	// ID unique value that identifies the resource generated by the server. Read-Only.
 	ID string `json:"id,omitempty"`
 
@@ -55,6 +58,9 @@ type Cluster struct {
 	// Type is deprecated and not used anymore.
 	Type string `json:"type,omitempty"`
 
+	// fetch error
+	FetchError *ClusterFetchError `json:"fetchError,omitempty"`
+
 	// spec
 	Spec *ClusterSpec `json:"spec,omitempty"`
 
@@ -74,6 +80,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateFetchError(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSpec(formats); err != nil {
 		res = append(res, err)
 	}
@@ -112,6 +122,25 @@ func (m *Cluster) validateDeletionTimestamp(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateFetchError(formats strfmt.Registry) error {
+	if swag.IsZero(m.FetchError) { // not required
+		return nil
+	}
+
+	if m.FetchError != nil {
+		if err := m.FetchError.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("fetchError")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("fetchError")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateSpec(formats strfmt.Registry) error {
 	if swag.IsZero(m.Spec) { // not required
 		return nil
@@ -154,6 +183,10 @@ func (m *Cluster) validateStatus(formats strfmt.Registry) error {
 func (m *Cluster) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateFetchError(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateSpec(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -168,6 +201,22 @@ func (m *Cluster) ContextValidate(ctx context.Context, formats strfmt.Registry)
 	return nil
 }
 
+func (m *Cluster) contextValidateFetchError(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.FetchError != nil {
+		if err := m.FetchError.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("fetchError")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("fetchError")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cluster) contextValidateSpec(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Spec != nil {
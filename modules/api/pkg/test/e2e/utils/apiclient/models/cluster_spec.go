@@ -91,6 +91,9 @@ type ClusterSpec struct {
 	// kyverno
 	Kyverno *KyvernoSettings `json:"kyverno,omitempty"`
 
+	// maintenance window
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
 	// mla
 	Mla *MLASettings `json:"mla,omitempty"`
 
@@ -162,6 +165,10 @@ func (m *ClusterSpec) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMaintenanceWindow(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMla(formats); err != nil {
 		res = append(res, err)
 	}
@@ -425,6 +432,25 @@ func (m *ClusterSpec) validateKyverno(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ClusterSpec) validateMaintenanceWindow(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaintenanceWindow) { // not required
+		return nil
+	}
+
+	if m.MaintenanceWindow != nil {
+		if err := m.MaintenanceWindow.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maintenanceWindow")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maintenanceWindow")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterSpec) validateMla(formats strfmt.Registry) error {
 	if swag.IsZero(m.Mla) { // not required
 		return nil
@@ -589,6 +615,10 @@ func (m *ClusterSpec) ContextValidate(ctx context.Context, formats strfmt.Regist
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateMaintenanceWindow(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateMla(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -813,6 +843,22 @@ func (m *ClusterSpec) contextValidateKyverno(ctx context.Context, formats strfmt
 	return nil
 }
 
+func (m *ClusterSpec) contextValidateMaintenanceWindow(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MaintenanceWindow != nil {
+		if err := m.MaintenanceWindow.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maintenanceWindow")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maintenanceWindow")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterSpec) contextValidateMla(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Mla != nil {
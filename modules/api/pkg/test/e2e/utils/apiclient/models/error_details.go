@@ -29,6 +29,9 @@ type ErrorDetails struct {
 	// The error message
 	// Required: true
 	Message *string `json:"message"`
+
+	// The machine-readable reason of the error, e.g. CLUSTER_NOT_READY
+	Reason string `json:"reason,omitempty"`
 }
 
 // Validate validates this error details
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -17,6 +18,9 @@ import (
 // swagger:model GCPMachineSize
 type GCPMachineSize struct {
 
+	// custom
+	Custom *GCPCustomMachineTypeBounds `json:"custom,omitempty"`
+
 	// description
 	Description string `json:"description,omitempty"`
 
@@ -32,11 +36,69 @@ type GCPMachineSize struct {
 
 // Validate validates this g c p machine size
 func (m *GCPMachineSize) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCustom(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GCPMachineSize) validateCustom(formats strfmt.Registry) error {
+	if swag.IsZero(m.Custom) { // not required
+		return nil
+	}
+
+	if m.Custom != nil {
+		if err := m.Custom.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("custom")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("custom")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ContextValidate validates this g c p machine size based on context it is used
+// ContextValidate validate this g c p machine size based on the context it is used
 func (m *GCPMachineSize) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCustom(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GCPMachineSize) contextValidateCustom(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Custom != nil {
+
+		if swag.IsZero(m.Custom) { // not required
+			return nil
+		}
+
+		if err := m.Custom.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("custom")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("custom")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
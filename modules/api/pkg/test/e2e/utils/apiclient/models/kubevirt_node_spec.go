@@ -67,6 +67,9 @@ type KubevirtNodeSpec struct {
 	// Required: true
 	PrimaryDiskStorageClassName *string `json:"primaryDiskStorageClassName"`
 
+	// PriorityClassName is the name of the PriorityClass of the infra cluster used for the VM pods.
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
 	// SecondaryDisks contains list of secondary-disks
 	SecondaryDisks []*SecondaryDisks `json:"secondaryDisks"`
 
@@ -12,7 +12,8 @@ import (
 	"github.com/go-openapi/swag"
 )
 
-// MachineDeploymentOptions machine deployment options
+// MachineDeploymentOptions MachineDeploymentOptions are the global options of machine deployments. The size limits are not part of the
+// KubermaticSetting spec, they are stored in an annotation of it.
 //
 // swagger:model MachineDeploymentOptions
 type MachineDeploymentOptions struct {
@@ -26,6 +27,15 @@ type MachineDeploymentOptions struct {
 	// In case of flatcar linux, this will enable automatic updates through update engine and for other operating systems,
 	// this will enable package updates on boot for the machines.
 	AutoUpdatesEnforced bool `json:"autoUpdatesEnforced,omitempty"`
+
+	// DefaultReplicas is the number of replicas of new machine deployments which don't specify any.
+	DefaultReplicas int32 `json:"defaultReplicas,omitempty"`
+
+	// MaxAutoscalerMax is the maximum the autoscaler may scale a machine deployment to.
+	MaxAutoscalerMax int32 `json:"maxAutoscalerMax,omitempty"`
+
+	// MaxReplicas is the maximum number of replicas of a machine deployment.
+	MaxReplicas int32 `json:"maxReplicas,omitempty"`
 }
 
 // Validate validates this machine deployment options
@@ -24,9 +24,16 @@ type NetworkSpec struct {
 	// gateway
 	Gateway string `json:"gateway,omitempty"`
 
-	// IP family
+	// IPFamily of the machines, one of IPv4, IPv6 and IPv4+IPv6. The families have to be enabled in the cluster
+	// network. Defaults to the IP family of the cluster.
 	IPFamily string `json:"ipFamily,omitempty"`
 
+	// SecondaryCIDR is the IPv6 CIDR of the machines if the IPv4+IPv6 IP family is used.
+	SecondaryCIDR string `json:"secondaryCIDR,omitempty"`
+
+	// SecondaryGateway is the IPv6 gateway of the machines if the IPv4+IPv6 IP family is used.
+	SecondaryGateway string `json:"secondaryGateway,omitempty"`
+
 	// dns
 	DNS *DNSConfig `json:"dns,omitempty"`
 }
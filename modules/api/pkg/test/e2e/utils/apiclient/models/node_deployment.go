@@ -30,6 +30,10 @@ type NodeDeployment struct {
 	// Format: date-time
 	DeletionTimestamp strfmt.DateTime `json:"deletionTimestamp,omitempty"`
 
+	// GenerateName is used to generate a unique name for the node deployment when the name is not set. A random
+	// suffix is appended to it, the generated name is returned in the response.
+	GenerateName string `json:"generateName,omitempty"`
+
 	// ID unique value that identifies the resource generated by the server. Read-Only.
 	ID string `json:"id,omitempty"`
 
@@ -41,6 +45,10 @@ type NodeDeployment struct {
 
 	// status
 	Status *MachineDeploymentStatus `json:"status,omitempty"`
+
+	// Warnings about the node deployment, e.g. about an operating system version reaching its end of life or an
+	// image which is no longer available.
+	Warnings []string `json:"warnings"`
 }
 
 // Validate validates this node deployment
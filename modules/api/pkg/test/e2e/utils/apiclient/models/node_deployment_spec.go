@@ -25,6 +25,14 @@ type NodeDeploymentSpec struct {
 	// max replicas
 	MaxReplicas uint32 `json:"maxReplicas,omitempty"`
 
+	// MaxSurge is the maximum number of machines that can be created above the desired number of replicas
+	// during a rollout. It is an absolute number (e.g. 1) or a percentage (e.g. 25%).
+	MaxSurge string `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable is the maximum number of machines that can be unavailable during a rollout. It is an
+	// absolute number (e.g. 1) or a percentage (e.g. 25%).
+	MaxUnavailable string `json:"maxUnavailable,omitempty"`
+
 	// min replicas
 	MinReplicas uint32 `json:"minReplicas,omitempty"`
 
@@ -35,6 +43,9 @@ type NodeDeploymentSpec struct {
 	// Required: true
 	Replicas *int32 `json:"replicas"`
 
+	// auto repair
+	AutoRepair *NodeDeploymentAutoRepair `json:"autoRepair,omitempty"`
+
 	// template
 	// Required: true
 	Template *NodeSpec `json:"template"`
@@ -48,6 +59,10 @@ func (m *NodeDeploymentSpec) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateAutoRepair(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTemplate(formats); err != nil {
 		res = append(res, err)
 	}
@@ -67,6 +82,25 @@ func (m *NodeDeploymentSpec) validateReplicas(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *NodeDeploymentSpec) validateAutoRepair(formats strfmt.Registry) error {
+	if swag.IsZero(m.AutoRepair) { // not required
+		return nil
+	}
+
+	if m.AutoRepair != nil {
+		if err := m.AutoRepair.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("autoRepair")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("autoRepair")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *NodeDeploymentSpec) validateTemplate(formats strfmt.Registry) error {
 
 	if err := validate.Required("template", "body", m.Template); err != nil {
@@ -91,6 +125,10 @@ func (m *NodeDeploymentSpec) validateTemplate(formats strfmt.Registry) error {
 func (m *NodeDeploymentSpec) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateAutoRepair(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateTemplate(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -101,6 +139,22 @@ func (m *NodeDeploymentSpec) ContextValidate(ctx context.Context, formats strfmt
 	return nil
 }
 
+func (m *NodeDeploymentSpec) contextValidateAutoRepair(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.AutoRepair != nil {
+		if err := m.AutoRepair.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("autoRepair")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("autoRepair")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *NodeDeploymentSpec) contextValidateTemplate(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Template != nil {
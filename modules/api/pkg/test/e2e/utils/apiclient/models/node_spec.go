@@ -20,6 +20,10 @@ import (
 // swagger:model NodeSpec
 type NodeSpec struct {
 
+	// AdditionalUserData is appended to the user data of the nodes, e.g. a #cloud-config snippet which installs
+	// extra packages. It is limited to 16KB and must not redefine the SSH login user.
+	AdditionalUserData string `json:"additionalUserData,omitempty"`
+
 	// annotations
 	Annotations map[string]string `json:"annotations,omitempty"`
 
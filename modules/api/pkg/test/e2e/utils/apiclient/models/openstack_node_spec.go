@@ -25,6 +25,9 @@ type OpenstackNodeSpec struct {
 	// ConfigDrive enables a configuration drive that will be attached to the instance when it boots.
 	ConfigDrive bool `json:"configDrive,omitempty"`
 
+	// If set, the server group is created with the anti-affinity policy when it does not exist yet
+	CreateServerGroup bool `json:"createServerGroup,omitempty"`
+
 	// instance flavor
 	// Required: true
 	Flavor *string `json:"flavor"`
@@ -42,7 +45,8 @@ type OpenstackNodeSpec struct {
 	// if set, the rootDisk will be a volume. If not, the rootDisk will be on ephemeral storage and its size will be derived from the flavor
 	RootDiskSizeGB int64 `json:"diskSize,omitempty"`
 
-	// UUID of the server group, used to configure affinity or anti-affinity of the VM instances relative to hypervisor
+	// Name or UUID of the server group, used to configure affinity or anti-affinity of the VM instances relative to hypervisor.
+	// A name is replaced by the UUID of the server group when the node deployment is created.
 	ServerGroup string `json:"serverGroup,omitempty"`
 
 	// Additional metadata to set
@@ -27,6 +27,9 @@ type Preset struct {
 
 	// providers
 	Providers []*PresetProvider `json:"providers"`
+
+	// RequiredEmails are the emails and domains of the users who can use the preset.
+	RequiredEmails []string `json:"requiredEmails"`
 }
 
 // Validate validates this preset
@@ -18,9 +18,16 @@ import (
 // swagger:model PresetProvider
 type PresetProvider struct {
 
+	// Datacenter is the datacenter the provider configuration is limited to, empty if it applies to all datacenters.
+	Datacenter string `json:"datacenter,omitempty"`
+
 	// enabled
 	Enabled bool `json:"enabled,omitempty"`
 
+	// Fields reports which fields of the provider configuration, e.g. the credentials, are set. Their values are
+	// never returned.
+	Fields map[string]bool `json:"fields,omitempty"`
+
 	// is customizable
 	IsCustomizable bool `json:"isCustomizable,omitempty"`
 
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -21,22 +22,149 @@ type RHELSpec struct {
 	DistUpgradeOnBoot bool `json:"distUpgradeOnBoot,omitempty"`
 
 	// r h e l subscription manager password
-	RHELSubscriptionManagerPassword string `json:"rhelSubscriptionManagerPassword,omitempty"`
+	RHELSubscriptionManagerPassword SecretString `json:"rhelSubscriptionManagerPassword,omitempty"`
 
 	// r h e l subscription manager user
-	RHELSubscriptionManagerUser string `json:"rhelSubscriptionManagerUser,omitempty"`
+	RHELSubscriptionManagerUser SecretString `json:"rhelSubscriptionManagerUser,omitempty"`
 
 	// RHS m offline token
-	RHSMOfflineToken string `json:"rhsmOfflineToken,omitempty"`
+	RHSMOfflineToken SecretString `json:"rhsmOfflineToken,omitempty"`
 }
 
 // Validate validates this r h e l spec
 func (m *RHELSpec) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRHELSubscriptionManagerPassword(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRHELSubscriptionManagerUser(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRHSMOfflineToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RHELSpec) validateRHELSubscriptionManagerPassword(formats strfmt.Registry) error {
+	if swag.IsZero(m.RHELSubscriptionManagerPassword) { // not required
+		return nil
+	}
+
+	if err := m.RHELSubscriptionManagerPassword.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("rhelSubscriptionManagerPassword")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("rhelSubscriptionManagerPassword")
+		}
+		return err
+	}
+
 	return nil
 }
 
-// ContextValidate validates this r h e l spec based on context it is used
+func (m *RHELSpec) validateRHELSubscriptionManagerUser(formats strfmt.Registry) error {
+	if swag.IsZero(m.RHELSubscriptionManagerUser) { // not required
+		return nil
+	}
+
+	if err := m.RHELSubscriptionManagerUser.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("rhelSubscriptionManagerUser")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("rhelSubscriptionManagerUser")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *RHELSpec) validateRHSMOfflineToken(formats strfmt.Registry) error {
+	if swag.IsZero(m.RHSMOfflineToken) { // not required
+		return nil
+	}
+
+	if err := m.RHSMOfflineToken.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("rhsmOfflineToken")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("rhsmOfflineToken")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this r h e l spec based on the context it is used
 func (m *RHELSpec) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRHELSubscriptionManagerPassword(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRHELSubscriptionManagerUser(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRHSMOfflineToken(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RHELSpec) contextValidateRHELSubscriptionManagerPassword(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.RHELSubscriptionManagerPassword.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("rhelSubscriptionManagerPassword")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("rhelSubscriptionManagerPassword")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *RHELSpec) contextValidateRHELSubscriptionManagerUser(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.RHELSubscriptionManagerUser.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("rhelSubscriptionManagerUser")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("rhelSubscriptionManagerUser")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *RHELSpec) contextValidateRHSMOfflineToken(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.RHSMOfflineToken.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("rhsmOfflineToken")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("rhsmOfflineToken")
+		}
+		return err
+	}
+
 	return nil
 }
 
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -19,15 +20,67 @@ type UbuntuSpec struct {
 
 	// do a dist-upgrade on boot and reboot it required afterwards
 	DistUpgradeOnBoot bool `json:"distUpgradeOnBoot,omitempty"`
+
+	// ubuntu pro token
+	UbuntuProToken SecretString `json:"ubuntuProToken,omitempty"`
 }
 
 // Validate validates this ubuntu spec
 func (m *UbuntuSpec) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateUbuntuProToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UbuntuSpec) validateUbuntuProToken(formats strfmt.Registry) error {
+	if swag.IsZero(m.UbuntuProToken) { // not required
+		return nil
+	}
+
+	if err := m.UbuntuProToken.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("ubuntuProToken")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("ubuntuProToken")
+		}
+		return err
+	}
+
 	return nil
 }
 
-// ContextValidate validates this ubuntu spec based on context it is used
+// ContextValidate validate this ubuntu spec based on the context it is used
 func (m *UbuntuSpec) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateUbuntuProToken(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UbuntuSpec) contextValidateUbuntuProToken(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.UbuntuProToken.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("ubuntuProToken")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("ubuntuProToken")
+		}
+		return err
+	}
+
 	return nil
 }
 
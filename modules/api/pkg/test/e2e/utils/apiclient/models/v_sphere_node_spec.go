@@ -19,6 +19,10 @@ import (
 // swagger:model VSphereNodeSpec
 type VSphereNodeSpec struct {
 
+	// AdditionalNetworks are attached to the machines in addition to the networks of the cluster,
+	// e.g. a storage VLAN.
+	AdditionalNetworks []string `json:"additionalNetworks"`
+
 	// c p us
 	CPUs int64 `json:"cpus,omitempty"`
 
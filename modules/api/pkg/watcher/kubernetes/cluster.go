@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultClusterResyncInterval is the interval in which the ClusterWatcher lists the clusters of all seeds.
+const DefaultClusterResyncInterval = 30 * time.Second
+
+// ClusterWatcherLeaseName is the name of the lease which the API replicas elect the one running the ClusterWatcher with.
+const ClusterWatcherLeaseName = "kubermatic-api-cluster-watcher"
+
+// SeedCluster is a cluster together with the client of the seed it lives in.
+type SeedCluster struct {
+	Cluster    *kubermaticv1.Cluster
+	SeedClient ctrlruntimeclient.Client
+}
+
+// ClusterObserver is invoked by the ClusterWatcher with the clusters of all reachable seeds on every resync.
+type ClusterObserver interface {
+	ObserveClusters(ctx context.Context, clusters []SeedCluster)
+}
+
+// ClusterWatcher periodically lists the clusters of all seeds and passes them to its observers. It is meant to run
+// through RunLeaderElected, so that only a single API replica lists the clusters and the observers act only once.
+type ClusterWatcher struct {
+	log              *zap.SugaredLogger
+	seedsGetter      provider.SeedsGetter
+	seedClientGetter provider.SeedClientGetter
+	interval         time.Duration
+	observers        []ClusterObserver
+}
+
+// NewClusterWatcher returns a new cluster watcher.
+func NewClusterWatcher(log *zap.SugaredLogger, seedsGetter provider.SeedsGetter, seedClientGetter provider.SeedClientGetter, interval time.Duration, observers ...ClusterObserver) *ClusterWatcher {
+	return &ClusterWatcher{
+		log:              log,
+		seedsGetter:      seedsGetter,
+		seedClientGetter: seedClientGetter,
+		interval:         interval,
+		observers:        observers,
+	}
+}
+
+// Run lists the clusters until the context is cancelled.
+func (watcher *ClusterWatcher) Run(ctx context.Context) {
+	wait.UntilWithContext(ctx, watcher.resync, watcher.interval)
+}
+
+func (watcher *ClusterWatcher) resync(ctx context.Context) {
+	seeds, err := watcher.seedsGetter()
+	if err != nil {
+		watcher.log.Warnw("Failed to get seeds", zap.Error(err))
+		return
+	}
+
+	var clusters []SeedCluster
+	for _, seed := range seeds {
+		seedClient, err := watcher.seedClientGetter(seed)
+		if err != nil {
+			watcher.log.Debugw("Failed to get seed client", "seed", seed.Name, zap.Error(err))
+			continue
+		}
+
+		clusterList := &kubermaticv1.ClusterList{}
+		if err := seedClient.List(ctx, clusterList); err != nil {
+			watcher.log.Debugw("Failed to list clusters", "seed", seed.Name, zap.Error(err))
+			continue
+		}
+
+		for i := range clusterList.Items {
+			clusters = append(clusters, SeedCluster{Cluster: &clusterList.Items[i], SeedClient: seedClient})
+		}
+	}
+
+	for _, observer := range watcher.observers {
+		observer.ObserveClusters(ctx, clusters)
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+
+	"k8c.io/dashboard/v2/pkg/webhook"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ClusterHealthObserver passes the health of the clusters to the webhook notifier, which notifies the project
+// webhooks about clusters which became unhealthy.
+type ClusterHealthObserver struct {
+	notifier *webhook.Notifier
+}
+
+// NewClusterHealthObserver returns a new cluster health observer.
+func NewClusterHealthObserver(notifier *webhook.Notifier) *ClusterHealthObserver {
+	return &ClusterHealthObserver{notifier: notifier}
+}
+
+func (observer *ClusterHealthObserver) ObserveClusters(_ context.Context, clusters []SeedCluster) {
+	clusterNames := sets.New[string]()
+	for _, seedCluster := range clusters {
+		cluster := seedCluster.Cluster
+		projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+		if projectID == "" || cluster.DeletionTimestamp != nil {
+			continue
+		}
+
+		clusterNames.Insert(cluster.Name)
+		observer.notifier.ObserveHealth(projectID, cluster)
+	}
+
+	// Clusters which were deleted are not tracked anymore. This includes the clusters of seeds which could not
+	// be reached, they are observed as new clusters once the seed is back.
+	observer.notifier.Retain(clusterNames)
+}
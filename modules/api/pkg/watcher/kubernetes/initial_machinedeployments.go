@@ -0,0 +1,64 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/resources/machine"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// InitialMachineDeploymentPromoter hands the queued initial machine deployments of a cluster over to the initial
+// machine deployment controller in KKP, which only processes a single one at a time.
+type InitialMachineDeploymentPromoter struct {
+	log *zap.SugaredLogger
+}
+
+// NewInitialMachineDeploymentPromoter returns a new initial machine deployment promoter.
+func NewInitialMachineDeploymentPromoter(log *zap.SugaredLogger) *InitialMachineDeploymentPromoter {
+	return &InitialMachineDeploymentPromoter{log: log}
+}
+
+func (promoter *InitialMachineDeploymentPromoter) ObserveClusters(ctx context.Context, clusters []SeedCluster) {
+	for _, seedCluster := range clusters {
+		cluster := seedCluster.Cluster
+		if _, ok := cluster.Annotations[machine.PendingInitialMachineDeploymentsAnnotation]; !ok {
+			continue
+		}
+
+		oldCluster := cluster.DeepCopy()
+		promoted, err := machine.PromotePendingInitialMachineDeployment(cluster)
+		if err != nil {
+			promoter.log.Warnw("Failed to promote pending initial machine deployment", "cluster", cluster.Name, zap.Error(err))
+			continue
+		}
+		if !promoted {
+			continue
+		}
+
+		// The optimistic lock makes sure that a concurrent change of the cluster, e.g. by a former leader, isn't
+		// overwritten and the same machine deployment isn't promoted twice.
+		patch := ctrlruntimeclient.MergeFromWithOptions(oldCluster, ctrlruntimeclient.MergeFromWithOptimisticLock{})
+		if err := seedCluster.SeedClient.Patch(ctx, cluster, patch); err != nil {
+			promoter.log.Debugw("Failed to promote pending initial machine deployment", "cluster", cluster.Name, zap.Error(err))
+		}
+	}
+}
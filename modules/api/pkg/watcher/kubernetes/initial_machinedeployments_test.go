@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/resources/machine"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClusterWatcherPromotesInitialMachineDeployments(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := kubermaticv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "abcd",
+			Annotations: map[string]string{
+				machine.PendingInitialMachineDeploymentsAnnotation: `[{"metadata":{"name":"second"}},{"metadata":{"name":"third"}}]`,
+			},
+		},
+	}
+	seedClient := fakectrlruntimeclient.NewClientBuilder().WithScheme(scheme).WithObjects(cluster).Build()
+
+	seed := &kubermaticv1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "us-central1"}}
+	watcher := NewClusterWatcher(
+		zap.NewNop().Sugar(),
+		func() (map[string]*kubermaticv1.Seed, error) {
+			return map[string]*kubermaticv1.Seed{seed.Name: seed}, nil
+		},
+		func(*kubermaticv1.Seed) (ctrlruntimeclient.Client, error) {
+			return seedClient, nil
+		},
+		DefaultClusterResyncInterval,
+		NewInitialMachineDeploymentPromoter(zap.NewNop().Sugar()),
+	)
+
+	expected := []string{`{"metadata":{"name":"second"}}`, `{"metadata":{"name":"third"}}`, ""}
+	for _, expectedRequest := range expected {
+		watcher.resync(context.Background())
+
+		current := &kubermaticv1.Cluster{}
+		if err := seedClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(cluster), current); err != nil {
+			t.Fatal(err)
+		}
+		if request := current.Annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation]; request != expectedRequest {
+			t.Fatalf("expected initial machine deployment request %q, got %q", expectedRequest, request)
+		}
+
+		// simulate the initial machine deployment controller in KKP
+		delete(current.Annotations, kubermaticv1.InitialMachineDeploymentRequestAnnotation)
+		if err := seedClient.Update(context.Background(), current); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	current := &kubermaticv1.Cluster{}
+	if err := seedClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(cluster), current); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := current.Annotations[machine.PendingInitialMachineDeploymentsAnnotation]; ok {
+		t.Fatal("expected the pending initial machine deployments to be removed")
+	}
+}
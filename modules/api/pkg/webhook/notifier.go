@@ -0,0 +1,233 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// deliveryTimeout is the total time available to deliver an event to all webhooks of a project.
+	deliveryTimeout = time.Minute
+	// requestTimeout is the time available for a single delivery attempt.
+	requestTimeout = 10 * time.Second
+	// defaultRetries is the number of retries after a failed delivery attempt.
+	defaultRetries = 2
+	defaultBackoff = 2 * time.Second
+)
+
+// Payload is the body of the requests which are sent to the webhooks.
+type Payload struct {
+	Event     string    `json:"event"`
+	Timestamp time.Time `json:"timestamp"`
+	ProjectID string    `json:"projectID"`
+	Cluster   Cluster   `json:"cluster"`
+}
+
+// Cluster describes the cluster an event refers to.
+type Cluster struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Notifier sends cluster lifecycle events to the webhooks of a project. Delivery is best-effort:
+// it happens in the background, failed attempts are retried a few times and are then dropped.
+// A nil Notifier is valid and doesn't send any events.
+//
+// The health of the clusters is tracked in memory by every API replica on its own. Each replica
+// therefore sends its own cluster.unhealthy event, and a replica which starts while a cluster is
+// unhealthy doesn't send one.
+type Notifier struct {
+	webhookProvider provider.PrivilegedWebhookProvider
+	client          *http.Client
+	log             *zap.SugaredLogger
+	retries         int
+	backoff         time.Duration
+	now             func() time.Time
+
+	lock sync.Mutex
+	// healthy holds the last observed health of the clusters.
+	healthy map[string]bool
+}
+
+// NewNotifier returns a notifier which sends the events to the webhooks returned by the provider.
+func NewNotifier(webhookProvider provider.PrivilegedWebhookProvider, log *zap.SugaredLogger) *Notifier {
+	return &Notifier{
+		webhookProvider: webhookProvider,
+		client:          newClient(),
+		log:             log,
+		retries:         defaultRetries,
+		backoff:         defaultBackoff,
+		now:             time.Now,
+		healthy:         map[string]bool{},
+	}
+}
+
+// Notify sends the event for the cluster to all webhooks of the project which are subscribed to it.
+// It never blocks the caller.
+func (n *Notifier) Notify(projectID, event string, cluster *kubermaticv1.Cluster) {
+	if n == nil || n.webhookProvider == nil {
+		return
+	}
+
+	payload := Payload{
+		Event:     event,
+		Timestamp: n.now().UTC(),
+		ProjectID: projectID,
+		Cluster: Cluster{
+			ID:   cluster.Name,
+			Name: cluster.Spec.HumanReadableName,
+		},
+	}
+
+	go n.notify(payload)
+}
+
+// ObserveHealth records the health of the cluster and notifies the project webhooks when a cluster,
+// which was healthy when it was observed the last time, became unhealthy.
+func (n *Notifier) ObserveHealth(projectID string, cluster *kubermaticv1.Cluster) {
+	if n == nil {
+		return
+	}
+
+	healthy := cluster.Status.ExtendedHealth.AllHealthy()
+
+	n.lock.Lock()
+	wasHealthy, observed := n.healthy[cluster.Name]
+	n.healthy[cluster.Name] = healthy
+	n.lock.Unlock()
+
+	if observed && wasHealthy && !healthy {
+		n.Notify(projectID, EventClusterUnhealthy, cluster)
+	}
+}
+
+// Retain removes the recorded health of all clusters except the given ones, e.g. the clusters which
+// still exist.
+func (n *Notifier) Retain(clusterNames sets.Set[string]) {
+	if n == nil {
+		return
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for name := range n.healthy {
+		if !clusterNames.Has(name) {
+			delete(n.healthy, name)
+		}
+	}
+}
+
+// Forget removes the recorded health of the cluster, e.g. once it was deleted.
+func (n *Notifier) Forget(cluster *kubermaticv1.Cluster) {
+	if n == nil {
+		return
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	delete(n.healthy, cluster.Name)
+}
+
+func (n *Notifier) notify(payload Payload) {
+	ctx, cancel := context.WithTimeout(context.Background(), deliveryTimeout)
+	defer cancel()
+
+	log := n.log.With("project", payload.ProjectID, "cluster", payload.Cluster.ID, "event", payload.Event)
+
+	secrets, err := n.webhookProvider.ListUnsecured(ctx, payload.ProjectID)
+	if err != nil {
+		log.Warnw("Failed to list project webhooks", zap.Error(err))
+		return
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		log.Warnw("Failed to encode webhook payload", zap.Error(err))
+		return
+	}
+
+	for i := range secrets.Items {
+		webhook := FromSecret(&secrets.Items[i])
+		if !webhook.Subscribed(payload.Event) {
+			continue
+		}
+
+		if err := n.deliver(ctx, webhook, payload.Event, body); err != nil {
+			log.Warnw("Failed to deliver event to project webhook", "webhook", webhook.ID, zap.Error(err))
+		}
+	}
+}
+
+func (n *Notifier) deliver(ctx context.Context, webhook *Webhook, event string, body []byte) error {
+	var err error
+	for attempt := 0; attempt <= n.retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(attempt) * n.backoff):
+			}
+		}
+
+		var retry bool
+		if retry, err = n.send(ctx, webhook, event, body); err == nil || !retry {
+			return err
+		}
+	}
+	return err
+}
+
+// send sends a single request to the webhook. It returns whether a failed request should be retried.
+func (n *Notifier) send(ctx context.Context, webhook *Webhook, event string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event)
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, body))
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+
+	// Client errors won't go away by retrying, except for rate limiting.
+	retry := resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const testProjectID = "my-project"
+
+type fakeWebhookProvider struct {
+	webhooks []Webhook
+}
+
+func (p *fakeWebhookProvider) CreateUnsecured(_ context.Context, _ *kubermaticv1.Project, secret *corev1.Secret) (*corev1.Secret, error) {
+	return secret, nil
+}
+
+func (p *fakeWebhookProvider) GetUnsecured(_ context.Context, _, _ string) (*corev1.Secret, error) {
+	return nil, nil
+}
+
+func (p *fakeWebhookProvider) ListUnsecured(_ context.Context, projectID string) (*corev1.SecretList, error) {
+	list := &corev1.SecretList{}
+	for _, webhook := range p.webhooks {
+		list.Items = append(list.Items, corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   SecretName(webhook.ID),
+				Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: projectID},
+			},
+			Data: webhook.SecretData(),
+		})
+	}
+	return list, nil
+}
+
+func (p *fakeWebhookProvider) UpdateUnsecured(_ context.Context, secret *corev1.Secret) (*corev1.Secret, error) {
+	return secret, nil
+}
+
+func (p *fakeWebhookProvider) DeleteUnsecured(_ context.Context, _, _ string) error {
+	return nil
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// receiver is a webhook endpoint which records all requests and responds with the given status codes.
+type receiver struct {
+	server *httptest.Server
+
+	lock     sync.Mutex
+	statuses []int
+	requests chan receivedRequest
+}
+
+func newReceiver(t *testing.T, statuses ...int) *receiver {
+	r := &receiver{
+		statuses: statuses,
+		requests: make(chan receivedRequest, 10),
+	}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Errorf("failed to read request body: %v", err)
+		}
+
+		status := http.StatusOK
+		r.lock.Lock()
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		r.lock.Unlock()
+
+		r.requests <- receivedRequest{header: req.Header.Clone(), body: body}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(r.server.Close)
+
+	return r
+}
+
+func (r *receiver) expectRequest(t *testing.T) receivedRequest {
+	t.Helper()
+
+	select {
+	case req := <-r.requests:
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the webhook to be called")
+	}
+	return receivedRequest{}
+}
+
+func (r *receiver) expectNoRequest(t *testing.T) {
+	t.Helper()
+
+	select {
+	case req := <-r.requests:
+		t.Fatalf("expected no webhook call, but got %s", req.body)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func newTestNotifier(webhooks ...Webhook) *Notifier {
+	n := NewNotifier(&fakeWebhookProvider{webhooks: webhooks}, zap.NewNop().Sugar())
+	n.backoff = time.Millisecond
+	// the receivers listen on the loopback interface, which the default client refuses to connect to
+	n.client = &http.Client{Timeout: requestTimeout}
+	n.now = func() time.Time {
+		return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	}
+	return n
+}
+
+func newTestCluster(healthy bool) *kubermaticv1.Cluster {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "abcd1234"},
+		Spec:       kubermaticv1.ClusterSpec{HumanReadableName: "my-cluster"},
+	}
+	if healthy {
+		cluster.Status.ExtendedHealth = kubermaticv1.ExtendedClusterHealth{
+			Apiserver:                    kubermaticv1.HealthStatusUp,
+			Scheduler:                    kubermaticv1.HealthStatusUp,
+			Controller:                   kubermaticv1.HealthStatusUp,
+			MachineController:            kubermaticv1.HealthStatusUp,
+			Etcd:                         kubermaticv1.HealthStatusUp,
+			CloudProviderInfrastructure:  kubermaticv1.HealthStatusUp,
+			UserClusterControllerManager: kubermaticv1.HealthStatusUp,
+		}
+	}
+	return cluster
+}
+
+func TestNotify(t *testing.T) {
+	r := newReceiver(t)
+	n := newTestNotifier(Webhook{ID: "hook", URL: r.server.URL, Secret: "s3cr3t", EventTypes: []string{EventClusterCreated}})
+
+	n.Notify(testProjectID, EventClusterCreated, newTestCluster(true))
+	req := r.expectRequest(t)
+
+	if got := req.header.Get(SignatureHeader); got != Sign("s3cr3t", req.body) {
+		t.Errorf("expected signature %q, got %q", Sign("s3cr3t", req.body), got)
+	}
+	if got := req.header.Get(EventHeader); got != EventClusterCreated {
+		t.Errorf("expected event header %q, got %q", EventClusterCreated, got)
+	}
+	if got := req.header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("expected content type application/json, got %q", got)
+	}
+
+	expected := `{"event":"cluster.created","timestamp":"2025-01-02T03:04:05Z","projectID":"my-project","cluster":{"id":"abcd1234","name":"my-cluster"}}`
+	if string(req.body) != expected {
+		t.Errorf("expected payload %s, got %s", expected, req.body)
+	}
+
+	payload := Payload{}
+	if err := json.Unmarshal(req.body, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if payload.Cluster.ID != "abcd1234" || payload.ProjectID != testProjectID {
+		t.Errorf("unexpected payload %+v", payload)
+	}
+}
+
+func TestNotifySkipsUnsubscribedWebhooks(t *testing.T) {
+	r := newReceiver(t)
+	n := newTestNotifier(Webhook{ID: "hook", URL: r.server.URL, Secret: "s3cr3t", EventTypes: []string{EventClusterDeleted}})
+
+	n.Notify(testProjectID, EventClusterCreated, newTestCluster(true))
+	r.expectNoRequest(t)
+}
+
+func TestNotifyRetries(t *testing.T) {
+	testCases := []struct {
+		name             string
+		statuses         []int
+		expectedRequests int
+	}{
+		{
+			name:             "retry on server errors until the delivery succeeds",
+			statuses:         []int{http.StatusInternalServerError, http.StatusTooManyRequests, http.StatusOK},
+			expectedRequests: 3,
+		},
+		{
+			name:             "give up after the retries are exhausted",
+			statuses:         []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			expectedRequests: defaultRetries + 1,
+		},
+		{
+			name:             "don't retry client errors",
+			statuses:         []int{http.StatusBadRequest},
+			expectedRequests: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newReceiver(t, tc.statuses...)
+			n := newTestNotifier(Webhook{ID: "hook", URL: r.server.URL, Secret: "s3cr3t", EventTypes: []string{EventClusterDeleted}})
+
+			n.Notify(testProjectID, EventClusterDeleted, newTestCluster(true))
+			for i := 0; i < tc.expectedRequests; i++ {
+				r.expectRequest(t)
+			}
+			r.expectNoRequest(t)
+		})
+	}
+}
+
+func TestObserveHealth(t *testing.T) {
+	r := newReceiver(t)
+	n := newTestNotifier(Webhook{ID: "hook", URL: r.server.URL, Secret: "s3cr3t", EventTypes: []string{EventClusterUnhealthy}})
+
+	// The first observation only records the health, even if the cluster is unhealthy.
+	n.ObserveHealth(testProjectID, newTestCluster(false))
+	r.expectNoRequest(t)
+
+	n.ObserveHealth(testProjectID, newTestCluster(true))
+	n.ObserveHealth(testProjectID, newTestCluster(true))
+	r.expectNoRequest(t)
+
+	n.ObserveHealth(testProjectID, newTestCluster(false))
+	req := r.expectRequest(t)
+	if got := req.header.Get(EventHeader); got != EventClusterUnhealthy {
+		t.Errorf("expected event header %q, got %q", EventClusterUnhealthy, got)
+	}
+
+	// A cluster which stays unhealthy is reported only once.
+	n.ObserveHealth(testProjectID, newTestCluster(false))
+	r.expectNoRequest(t)
+
+	// Forgotten clusters are treated as if they were never observed.
+	n.ObserveHealth(testProjectID, newTestCluster(true))
+	n.Forget(newTestCluster(true))
+	n.ObserveHealth(testProjectID, newTestCluster(false))
+	r.expectNoRequest(t)
+}
+
+func TestRetain(t *testing.T) {
+	r := newReceiver(t)
+	n := newTestNotifier(Webhook{ID: "hook", URL: r.server.URL, Secret: "s3cr3t", EventTypes: []string{EventClusterUnhealthy}})
+
+	// Clusters which are kept are still reported.
+	n.ObserveHealth(testProjectID, newTestCluster(true))
+	n.Retain(sets.New("abcd1234"))
+	n.ObserveHealth(testProjectID, newTestCluster(false))
+	r.expectRequest(t)
+
+	// Clusters which are gone are treated as if they were never observed.
+	n.ObserveHealth(testProjectID, newTestCluster(true))
+	n.Retain(sets.New[string]())
+	if len(n.healthy) != 0 {
+		t.Fatalf("expected the health of removed clusters to be forgotten, got %v", n.healthy)
+	}
+	n.ObserveHealth(testProjectID, newTestCluster(false))
+	r.expectNoRequest(t)
+}
+
+func TestDefaultClientRefusesInternalAddresses(t *testing.T) {
+	r := newReceiver(t)
+
+	_, err := newClient().Get(r.server.URL)
+	if err == nil || !strings.Contains(err.Error(), "loopback addresses are not allowed") {
+		t.Fatalf("expected the request to the loopback address to be refused, got %v", err)
+	}
+	r.expectNoRequest(t)
+}
+
+func TestValidateURL(t *testing.T) {
+	testCases := []struct {
+		url   string
+		valid bool
+	}{
+		{url: "https://example.com/hook", valid: true},
+		{url: "http://203.0.113.10:8080/hook", valid: true},
+		{url: "ftp://example.com/hook"},
+		{url: "/hook"},
+		{url: "http://localhost/hook"},
+		{url: "http://127.0.0.1/hook"},
+		{url: "http://[::1]/hook"},
+		{url: "http://169.254.169.254/latest/meta-data"},
+		{url: "http://10.96.0.1/hook"},
+		{url: "http://192.168.1.1/hook"},
+		{url: "http://100.64.0.1/hook"},
+		{url: "http://[::ffff:10.0.0.1]/hook"},
+		{url: "http://0.0.0.0/hook"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			err := ValidateURL(tc.url)
+			if tc.valid && err != nil {
+				t.Fatalf("expected the URL to be valid, got %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Fatal("expected the URL to be rejected")
+			}
+		})
+	}
+}
+
+func TestNilNotifier(t *testing.T) {
+	var n *Notifier
+	n.Notify(testProjectID, EventClusterCreated, newTestCluster(true))
+	n.ObserveHealth(testProjectID, newTestCluster(true))
+	n.Forget(newTestCluster(true))
+	n.Retain(sets.New[string]())
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+)
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which is not covered by netip.Addr.IsPrivate.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// ValidateURL checks that the URL is an absolute http or https URL, which doesn't point to the API server
+// itself or to the internal network, e.g. the cloud metadata service or cluster internal services.
+// Host names are resolved only when a request is sent, so the resolved address is checked once more then.
+func ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook URL %q, an absolute http or https URL is required", rawURL)
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("invalid webhook URL %q, loopback addresses are not allowed", rawURL)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if err := checkAddr(addr); err != nil {
+			return fmt.Errorf("invalid webhook URL %q, %w", rawURL, err)
+		}
+	}
+
+	return nil
+}
+
+func checkAddr(addr netip.Addr) error {
+	addr = addr.Unmap()
+	switch {
+	case addr.IsLoopback():
+		return fmt.Errorf("loopback addresses are not allowed")
+	case addr.IsLinkLocalUnicast(), addr.IsLinkLocalMulticast():
+		return fmt.Errorf("link-local addresses are not allowed")
+	case addr.IsPrivate(), sharedAddressSpace.Contains(addr):
+		return fmt.Errorf("private addresses are not allowed")
+	case addr.IsUnspecified(), addr.IsMulticast(), addr.IsInterfaceLocalMulticast():
+		return fmt.Errorf("address %s is not allowed", addr)
+	}
+
+	return nil
+}
+
+// newClient returns a client which refuses to connect to the addresses rejected by ValidateURL. This covers
+// host names which resolve to internal addresses as well as redirects.
+func newClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: requestTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			return checkAddr(addrPort.Addr())
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   requestTimeout,
+		Transport: transport,
+	}
+}
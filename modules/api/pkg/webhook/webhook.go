@@ -0,0 +1,111 @@
+/*
+Copyright 2025 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// EventClusterCreated is sent when a cluster was created.
+	EventClusterCreated = "cluster.created"
+	// EventClusterDeleted is sent when the deletion of a cluster was requested.
+	EventClusterDeleted = "cluster.deleted"
+	// EventClusterUnhealthy is sent when a healthy cluster becomes unhealthy.
+	EventClusterUnhealthy = "cluster.unhealthy"
+
+	// SignatureHeader contains the HMAC-SHA256 signature of the request body, in the form "sha256=<hex>".
+	SignatureHeader = "X-Kubermatic-Signature"
+	// EventHeader contains the type of the event.
+	EventHeader = "X-Kubermatic-Event"
+
+	secretNamePrefix = "webhook-"
+
+	urlKey        = "url"
+	secretKey     = "secret"
+	eventTypesKey = "eventTypes"
+)
+
+// EventTypes contains all event types webhooks can subscribe to.
+var EventTypes = sets.New(EventClusterCreated, EventClusterDeleted, EventClusterUnhealthy)
+
+// Webhook is a project webhook which is notified about cluster lifecycle events.
+type Webhook struct {
+	ID                string
+	ProjectID         string
+	URL               string
+	Secret            string
+	EventTypes        []string
+	CreationTimestamp time.Time
+}
+
+// Subscribed returns true if the webhook wants to receive the given event.
+func (w *Webhook) Subscribed(event string) bool {
+	for _, eventType := range w.EventTypes {
+		if eventType == event {
+			return true
+		}
+	}
+	return false
+}
+
+// FromSecret returns the webhook which is stored in the given secret.
+func FromSecret(secret *corev1.Secret) *Webhook {
+	var eventTypes []string
+	if data := string(secret.Data[eventTypesKey]); data != "" {
+		eventTypes = strings.Split(data, ",")
+	}
+
+	return &Webhook{
+		ID:                strings.TrimPrefix(secret.Name, secretNamePrefix),
+		ProjectID:         secret.Labels[kubermaticv1.ProjectIDLabelKey],
+		URL:               string(secret.Data[urlKey]),
+		Secret:            string(secret.Data[secretKey]),
+		EventTypes:        eventTypes,
+		CreationTimestamp: secret.CreationTimestamp.Time,
+	}
+}
+
+// SecretName returns the name of the secret which stores the webhook with the given ID.
+func SecretName(id string) string {
+	return secretNamePrefix + id
+}
+
+// SecretData returns the data of the secret which stores the webhook.
+func (w *Webhook) SecretData() map[string][]byte {
+	return map[string][]byte{
+		urlKey:        []byte(w.URL),
+		secretKey:     []byte(w.Secret),
+		eventTypesKey: []byte(strings.Join(w.EventTypes, ",")),
+	}
+}
+
+// Sign returns the signature of the body which is sent in the SignatureHeader.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}